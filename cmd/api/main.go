@@ -1,17 +1,36 @@
 package main
 
 import (
+	"context"
 	"database/sql"
+	"go-sqlc-starter/internal/api/v1/address"
 	"go-sqlc-starter/internal/api/v1/auth"
 	"go-sqlc-starter/internal/api/v1/brand"
+	"go-sqlc-starter/internal/api/v1/cart"
+	"go-sqlc-starter/internal/api/v1/cartreminder"
 	"go-sqlc-starter/internal/api/v1/category"
 	"go-sqlc-starter/internal/api/v1/cloudinary"
+	"go-sqlc-starter/internal/api/v1/inventory"
+	"go-sqlc-starter/internal/api/v1/invoice"
+	"go-sqlc-starter/internal/api/v1/order"
 	"go-sqlc-starter/internal/api/v1/product"
 	"go-sqlc-starter/internal/api/v1/review"
+	"go-sqlc-starter/internal/api/v1/rma"
+	"go-sqlc-starter/internal/api/v1/shipment"
+	"go-sqlc-starter/internal/api/v1/shipping"
+	"go-sqlc-starter/internal/api/v1/subscription"
+	"go-sqlc-starter/internal/api/v1/tax"
+	"go-sqlc-starter/internal/api/v1/voucher"
+	"go-sqlc-starter/internal/api/v1/wishlist"
 	"go-sqlc-starter/internal/bootstrap"
 	"go-sqlc-starter/internal/dbgen"
+	"go-sqlc-starter/internal/pkg/moderation"
+	"go-sqlc-starter/internal/pkg/notifier"
+	"go-sqlc-starter/internal/pkg/signing"
+	"go-sqlc-starter/internal/scheduler"
 	"log"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -25,6 +44,11 @@ func main() {
 		log.Println("Warning: .env file not found")
 	}
 
+	// Secret HMAC untuk token yang ditandatangani server wajib terisi
+	if err := signing.Validate(); err != nil {
+		log.Fatal(err)
+	}
+
 	// DB
 	db, err := sql.Open("postgres", os.Getenv("DB_URL"))
 	if err != nil {
@@ -44,10 +68,6 @@ func main() {
 	}
 
 	// DI
-	authController := auth.NewController(
-		auth.NewService(auth.NewRepository(queries)),
-	)
-
 	categoryRepo := category.NewRepository(queries)
 	categoryController := category.NewController(
 		category.NewService(db, categoryRepo, cloudinaryService),
@@ -58,22 +78,95 @@ func main() {
 		brand.NewService(db, brandRepo, cloudinaryService),
 	)
 
+	// Langganan stok kembali / harga turun: hook di inventory & product, email via SMTP_* (kosong = log)
+	subscriptionService := subscription.NewService(
+		subscription.NewRepository(queries),
+		notifier.NewEmailNotifier(notifier.NewMailerFromEnv()),
+	)
+	subscriptionController := subscription.NewController(subscriptionService)
+
+	inventoryService := inventory.NewService(db, inventory.NewRepository(queries), subscriptionService)
+	inventoryController := inventory.NewController(inventoryService)
+
 	productRepo := product.NewRepository(queries)
 
+	// Moderasi review: REVIEW_AUTO_APPROVE=false -> review baru menunggu approval admin,
+	// REVIEW_BLOCKED_WORDS (dipisah koma) -> review yang mengandung kata tsb selalu masuk antrian
 	reviewController := review.NewController(
-		review.NewService(db, review.NewRepository(queries), productRepo),
+		review.NewService(db, review.NewRepository(queries), productRepo, cloudinaryService,
+			moderation.NewWordListFilterFromEnv(), os.Getenv("REVIEW_AUTO_APPROVE") != "false"),
 	)
 
 	productController := product.NewController(
-		product.NewService(db, productRepo, categoryRepo, review.NewRepository(queries), cloudinaryService),
+		product.NewService(db, productRepo, categoryRepo, review.NewRepository(queries), cloudinaryService, inventoryService, subscriptionService),
+	)
+
+	// GUEST_CART_TTL: umur cart guest sejak aktivitas terakhir (default 30 hari)
+	guestCartTTL, _ := time.ParseDuration(os.Getenv("GUEST_CART_TTL"))
+	cartService := cart.NewService(db, cart.NewRepository(queries), guestCartTTL)
+	cartController := cart.NewController(cartService)
+
+	// Pengingat cart terbengkalai: CART_ABANDON_AFTER (default 24h), CART_REMINDER_MAX (default 2)
+	cartAbandonAfter, _ := time.ParseDuration(os.Getenv("CART_ABANDON_AFTER"))
+	cartReminderMax, _ := strconv.Atoi(os.Getenv("CART_REMINDER_MAX"))
+	cartReminderService := cartreminder.NewService(db, cartreminder.NewRepository(queries), cartreminder.Config{
+		IdleAfter:    cartAbandonAfter,
+		MaxReminders: int32(cartReminderMax),
+	})
+	cartReminderController := cartreminder.NewController(cartReminderService)
+
+	// Cart guest digabung ke cart user saat login / register
+	authController := auth.NewController(
+		auth.NewService(auth.NewRepository(queries)),
+		cartService,
+	)
+
+	wishlistController := wishlist.NewController(
+		wishlist.NewService(wishlist.NewRepository(queries), productRepo, cartService),
+	)
+
+	voucherService := voucher.NewService(db, voucher.NewRepository(queries), cartService)
+	voucherController := voucher.NewController(voucherService)
+
+	addressRepo := address.NewRepository(queries)
+	shippingRepo := shipping.NewRepository(queries)
+	shippingService := shipping.NewService(shippingRepo, addressRepo, cartService, shipping.NewTableRateProvider(shippingRepo))
+	shippingController := shipping.NewController(shippingService)
+
+	shipmentService := shipment.NewService(db, shipment.NewRepository(queries), os.Getenv("COURIER_WEBHOOK_SECRET"))
+	shipmentController := shipment.NewController(shipmentService)
+
+	// TAX_PRICES_INCLUDE_TAX=true -> harga katalog sudah termasuk PPN
+	taxService := tax.NewService(tax.NewRepository(queries), os.Getenv("TAX_PRICES_INCLUDE_TAX") == "true")
+	taxController := tax.NewController(taxService)
+
+	orderService := order.NewService(db, order.NewRepository(queries), cartService, inventoryService, voucherService, shippingService, shipmentService, taxService, addressRepo)
+
+	rmaController := rma.NewController(
+		rma.NewService(db, rma.NewRepository(queries), cloudinaryService, inventoryService),
+	)
+
+	invoiceController := invoice.NewController(
+		invoice.NewService(invoice.NewRepository(queries)),
 	)
 
 	registry := ControllerRegistry{
-		Auth:     authController,
-		Brand:    brandController,
-		Category: categoryController,
-		Product:  productController,
-		Review:   reviewController,
+		Auth:         authController,
+		Brand:        brandController,
+		Category:     categoryController,
+		Product:      productController,
+		Review:       reviewController,
+		Cart:         cartController,
+		Inventory:    inventoryController,
+		Voucher:      voucherController,
+		Shipping:     shippingController,
+		Shipment:     shipmentController,
+		RMA:          rmaController,
+		Invoice:      invoiceController,
+		Tax:          taxController,
+		Wishlist:     wishlistController,
+		Subscription: subscriptionController,
+		CartReminder: cartReminderController,
 	}
 
 	// Router
@@ -83,6 +176,25 @@ func main() {
 	// Audit logger
 	auditLogger := bootstrap.NewStdoutAuditLogger()
 
+	// Background jobs (leader election via advisory lock, aman untuk multi replika)
+	jobCtx, stopJobs := context.WithCancel(context.Background())
+	if os.Getenv("SCHEDULER_ENABLED") != "false" {
+		jobCfg := scheduler.LoadOrderJobConfig()
+		cartJobCfg := scheduler.LoadCartJobConfig()
+		notificationJobCfg := scheduler.LoadNotificationJobConfig()
+		jobs := scheduler.New(
+			scheduler.NewAdvisoryLocker(db),
+			scheduler.OrderExpiryJob(orderService, auditLogger, jobCfg),
+			scheduler.OrderAutoCompleteJob(orderService, auditLogger, jobCfg),
+			scheduler.GuestCartPurgeJob(cartService, auditLogger, cartJobCfg),
+			scheduler.CartReminderJob(cartReminderService, auditLogger, cartJobCfg),
+			scheduler.NotificationDeliveryJob(subscriptionService, auditLogger, notificationJobCfg),
+		)
+		jobs.Start(jobCtx)
+		defer jobs.Wait()
+	}
+	defer stopJobs()
+
 	// Server config
 	port := os.Getenv("PORT")
 	if port == "" {
@@ -5,24 +5,44 @@ import (
 	"go-sqlc-starter/internal/api/v1/auth"
 	"go-sqlc-starter/internal/api/v1/brand"
 	"go-sqlc-starter/internal/api/v1/cart"
+	"go-sqlc-starter/internal/api/v1/cartreminder"
 	"go-sqlc-starter/internal/api/v1/category"
+	"go-sqlc-starter/internal/api/v1/inventory"
+	"go-sqlc-starter/internal/api/v1/invoice"
 	"go-sqlc-starter/internal/api/v1/order"
 	"go-sqlc-starter/internal/api/v1/product"
 	"go-sqlc-starter/internal/api/v1/review"
+	"go-sqlc-starter/internal/api/v1/rma"
+	"go-sqlc-starter/internal/api/v1/shipment"
+	"go-sqlc-starter/internal/api/v1/shipping"
+	"go-sqlc-starter/internal/api/v1/subscription"
+	"go-sqlc-starter/internal/api/v1/tax"
+	"go-sqlc-starter/internal/api/v1/voucher"
+	"go-sqlc-starter/internal/api/v1/wishlist"
 	"go-sqlc-starter/internal/middleware"
 
 	"github.com/gin-gonic/gin"
 )
 
 type ControllerRegistry struct {
-	Auth     *auth.Controller
-	Category *category.Controller
-	Brand    *brand.Controller
-	Product  *product.Controller
-	Review   *review.Controller
-	Cart     *cart.Controller
-	Address  *address.Controller
-	Order    *order.Controller
+	Auth         *auth.Controller
+	Category     *category.Controller
+	Brand        *brand.Controller
+	Product      *product.Controller
+	Review       *review.Controller
+	Cart         *cart.Controller
+	Address      *address.Controller
+	Order        *order.Controller
+	Inventory    *inventory.Controller
+	Voucher      *voucher.Controller
+	Shipping     *shipping.Controller
+	Shipment     *shipment.Controller
+	RMA          *rma.Controller
+	Invoice      *invoice.Controller
+	Tax          *tax.Controller
+	Wishlist     *wishlist.Controller
+	Subscription *subscription.Controller
+	CartReminder *cartreminder.Controller
 }
 
 func setupRoutes(r *gin.Engine, reg ControllerRegistry) {
@@ -41,6 +61,8 @@ func setupRoutes(r *gin.Engine, reg ControllerRegistry) {
 		categories := v1.Group("/categories")
 		{
 			categories.GET("", reg.Category.ListPublic)
+			categories.GET("/tree", reg.Category.GetTree)
+			categories.GET("/slug/:slug", reg.Category.GetBySlug)
 			categories.GET("/:id", reg.Category.GetByID)
 		}
 
@@ -58,6 +80,7 @@ func setupRoutes(r *gin.Engine, reg ControllerRegistry) {
 		brands := v1.Group("/brands")
 		{
 			brands.GET("", reg.Brand.ListPublic)
+			brands.GET("/slug/:slug", reg.Brand.GetBySlug)
 			brands.GET("/:id", reg.Brand.GetByID)
 		}
 
@@ -77,31 +100,116 @@ func setupRoutes(r *gin.Engine, reg ControllerRegistry) {
 		products := v1.Group("/products")
 		{
 			products.GET("", reg.Product.GetPublicList)
+			products.GET("/suggest", reg.Product.Suggest)
+			products.GET("/slug/:slug", reg.Product.GetBySlug)
 			products.GET("/:id", reg.Product.GetByID)
 		}
 
+		// Review produk: listing publik hanya review APPROVED
+		productReviews := v1.Group("/products/slug/:slug/reviews")
+		{
+			productReviews.GET("", reg.Review.GetReviewsByProductSlug)
+			productReviews.GET("/eligibility", middleware.OptionalAuthMiddleware(), reg.Review.CheckReviewEligibility)
+			productReviews.POST("", middleware.AuthMiddleware(), reg.Review.Create)
+		}
+
+		reviews := v1.Group("/reviews")
+		reviews.Use(middleware.AuthMiddleware())
+		{
+			reviews.GET("/me", reg.Review.GetReviewsByUserID)
+			reviews.PUT("/:id", reg.Review.UpdateReview)
+			reviews.DELETE("/:id", reg.Review.DeleteReview)
+			reviews.PUT("/:id/vote", reg.Review.Vote)
+			reviews.DELETE("/:id/vote", reg.Review.DeleteVote)
+			reviews.POST("/:id/report", reg.Review.Report)
+		}
+
+		// Antrian moderasi review
+		adminReviews := v1.Group("/admin/reviews")
+		adminReviews.Use(
+			middleware.AuthMiddleware(),
+			middleware.RoleMiddleware("ADMIN", "SUPERADMIN"),
+		)
+		{
+			adminReviews.GET("", reg.Review.ListAdmin)
+			adminReviews.GET("/:id", reg.Review.GetAdmin)
+			adminReviews.PATCH("/:id/approve", reg.Review.Approve)
+			adminReviews.PATCH("/:id/reject", reg.Review.Reject)
+			adminReviews.PATCH("/:id/hide", reg.Review.Hide)
+			adminReviews.POST("/:id/reply", reg.Review.CreateReply)
+			adminReviews.PUT("/:id/reply", reg.Review.UpdateReply)
+			adminReviews.DELETE("/:id/reply", reg.Review.DeleteReply)
+		}
+
 		adminProducts := v1.Group("/admin/products")
 		adminProducts.Use(middleware.AuthMiddleware())
 		adminProducts.Use(middleware.RoleMiddleware("ADMIN", "SUPERADMIN"))
 		{
 			adminProducts.GET("", reg.Product.GetAdminList)
 			adminProducts.POST("", reg.Product.Create)
+			adminProducts.POST("/import", reg.Product.Import)
+			adminProducts.GET("/export", reg.Product.Export)
 			adminProducts.PUT("/:id", reg.Product.Update)
 			adminProducts.DELETE("/:id", reg.Product.Delete)
 			adminProducts.PATCH("/:id/restore", reg.Product.Restore)
+			adminProducts.GET("/:id/prices", reg.Product.ListPrices)
+			adminProducts.POST("/:id/prices", reg.Product.CreatePrice)
+			adminProducts.DELETE("/:id/prices/:priceId", reg.Product.DeletePrice)
+		}
+
+		adminInventory := v1.Group("/admin/inventory")
+		adminInventory.Use(
+			middleware.AuthMiddleware(),
+			middleware.RoleMiddleware("ADMIN", "SUPERADMIN"),
+		)
+		{
+			adminInventory.GET("/low-stock", reg.Inventory.ListLowStock)
+			adminInventory.POST("/products/:id/adjustments", reg.Inventory.Adjust)
+			adminInventory.GET("/products/:id/movements", reg.Inventory.ListMovements)
+			adminInventory.PUT("/products/:id/threshold", reg.Inventory.SetThreshold)
 		}
 
+		// Cart boleh dipakai guest (cart token), user login pakai cart miliknya
 		cart := v1.Group("/cart")
-		cart.Use(middleware.AuthMiddleware())
+		cart.Use(middleware.OptionalAuthMiddleware())
 		{
 			cart.POST("", reg.Cart.Create)
 			cart.GET("", reg.Cart.Detail)
 			cart.GET("/count", reg.Cart.Count)
+			cart.POST("/items", reg.Cart.AddItem)
 			cart.DELETE("", reg.Cart.Delete)
+			cart.POST("/apply-voucher", middleware.AuthMiddleware(), reg.Voucher.Apply)
+		}
+
+		adminVouchers := v1.Group("/admin/vouchers")
+		adminVouchers.Use(
+			middleware.AuthMiddleware(),
+			middleware.RoleMiddleware("ADMIN", "SUPERADMIN"),
+		)
+		{
+			adminVouchers.GET("", reg.Voucher.ListAdmin)
+			adminVouchers.POST("", reg.Voucher.Create)
+			adminVouchers.GET("/:id", reg.Voucher.GetByID)
+			adminVouchers.PUT("/:id", reg.Voucher.Update)
+			adminVouchers.DELETE("/:id", reg.Voucher.Delete)
+			adminVouchers.GET("/:id/usage", reg.Voucher.Usage)
 		}
 
+		// Laporan cart terbengkalai
+		adminCarts := v1.Group("/admin/carts")
+		adminCarts.Use(
+			middleware.AuthMiddleware(),
+			middleware.RoleMiddleware("ADMIN", "SUPERADMIN"),
+		)
+		{
+			adminCarts.GET("/abandoned", reg.CartReminder.Report)
+		}
+
+		// Link unsubscribe di email pengingat cart (public, diverifikasi lewat token bertanda tangan)
+		v1.GET("/cart-reminders/unsubscribe", reg.CartReminder.Unsubscribe)
+
 		cartItems := v1.Group("/cart-items")
-		cartItems.Use(middleware.AuthMiddleware())
+		cartItems.Use(middleware.OptionalAuthMiddleware())
 		{
 			cartItems.PUT("/:id", reg.Cart.UpdateQty)
 			cartItems.POST("/:id/increment", reg.Cart.Increment)
@@ -109,6 +217,55 @@ func setupRoutes(r *gin.Engine, reg ControllerRegistry) {
 			cartItems.DELETE("/:id", reg.Cart.DeleteItem)
 		}
 
+		// ========================
+		// WISHLIST
+		// ========================
+		wishlist := v1.Group("/wishlist")
+		wishlist.Use(middleware.AuthMiddleware())
+		{
+			wishlist.GET("", reg.Wishlist.List)
+			wishlist.POST("/items", reg.Wishlist.AddItem)
+			wishlist.DELETE("/items/:id", reg.Wishlist.RemoveItem)
+			wishlist.POST("/items/:id/move-to-cart", reg.Wishlist.MoveToCart)
+			wishlist.POST("/from-cart/:id", reg.Wishlist.MoveFromCart)
+			wishlist.POST("/share", reg.Wishlist.Share)
+			wishlist.DELETE("/share", reg.Wishlist.Unshare)
+		}
+
+		// Wishlist yang dibagikan (public, read-only)
+		v1.GET("/wishlists/shared/:token", reg.Wishlist.GetShared)
+
+		// Notifikasi stok kembali / harga turun
+		subscriptions := v1.Group("/subscriptions")
+		subscriptions.Use(middleware.AuthMiddleware())
+		{
+			subscriptions.GET("", reg.Subscription.ListMine)
+			subscriptions.POST("", reg.Subscription.Subscribe)
+			subscriptions.DELETE("/:id", reg.Subscription.Unsubscribe)
+		}
+
+		shipping := v1.Group("/shipping")
+		shipping.Use(middleware.AuthMiddleware())
+		{
+			shipping.POST("/quote", reg.Shipping.Quote)
+		}
+
+		adminTax := v1.Group("/admin/tax-classes")
+		adminTax.Use(
+			middleware.AuthMiddleware(),
+			middleware.RoleMiddleware("ADMIN", "SUPERADMIN"),
+		)
+		{
+			adminTax.GET("", reg.Tax.ListClasses)
+			adminTax.PUT("/:code", reg.Tax.UpsertClass)
+		}
+
+		// Webhook kurir (public, diverifikasi lewat signature HMAC)
+		webhooks := v1.Group("/webhooks")
+		{
+			webhooks.POST("/courier", reg.Shipment.Webhook)
+		}
+
 		address := v1.Group("/address")
 		address.Use(middleware.AuthMiddleware())
 		{
@@ -135,6 +292,9 @@ func setupRoutes(r *gin.Engine, reg ControllerRegistry) {
 			orders.POST("/checkout", reg.Order.Checkout)
 			orders.GET("", reg.Order.List)
 			orders.GET("/:id", reg.Order.Detail)
+			orders.GET("/:id/tracking", reg.Shipment.Tracking)
+			orders.GET("/:id/invoice.pdf", reg.Invoice.Download)
+			orders.POST("/:id/returns", reg.RMA.Create)
 			orders.PATCH("/:id/cancel", reg.Order.Cancel)
 			orders.PATCH("/:id/status", reg.Order.UpdateStatusByCustomer)
 
@@ -143,9 +303,36 @@ func setupRoutes(r *gin.Engine, reg ControllerRegistry) {
 			adminOrders.Use(middleware.RoleMiddleware("ADMIN", "SUPERADMIN"))
 			{
 				adminOrders.GET("", reg.Order.ListAdmin)
+				adminOrders.GET("/:id", reg.Order.DetailAdmin)
+				adminOrders.PATCH("/:id/cancel", reg.Order.CancelAdmin)
 				adminOrders.PATCH("/:id/status", reg.Order.UpdateStatusByAdmin)
 			}
 		}
 
+		// ========================
+		// RETURNS (RMA)
+		// ========================
+		returns := v1.Group("/returns")
+		returns.Use(middleware.AuthMiddleware())
+		{
+			returns.GET("", reg.RMA.ListMine)
+			returns.GET("/:id", reg.RMA.GetMine)
+			returns.PATCH("/:id/ship", reg.RMA.Ship)
+		}
+
+		adminReturns := v1.Group("/admin/returns")
+		adminReturns.Use(
+			middleware.AuthMiddleware(),
+			middleware.RoleMiddleware("ADMIN", "SUPERADMIN"),
+		)
+		{
+			adminReturns.GET("", reg.RMA.ListAdmin)
+			adminReturns.GET("/:id", reg.RMA.GetByID)
+			adminReturns.PATCH("/:id/approve", reg.RMA.Approve)
+			adminReturns.PATCH("/:id/reject", reg.RMA.Reject)
+			adminReturns.PATCH("/:id/receive", reg.RMA.Receive)
+			adminReturns.POST("/:id/refund", reg.RMA.Refund)
+		}
+
 	}
 }
@@ -0,0 +1,222 @@
+package main
+
+import (
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	orderMock "go-sqlc-starter/internal/api/v1/mock/order"
+	"go-sqlc-starter/internal/api/v1/order"
+	"go-sqlc-starter/internal/dbgen"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+const testJWTSecret = "routes-test-secret"
+
+type actor struct {
+	userID uuid.UUID
+	role   string
+}
+
+func (a *actor) cookie(t *testing.T) *http.Cookie {
+	t.Helper()
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"user_id": a.userID.String(),
+		"role":    a.role,
+		"exp":     time.Now().Add(time.Hour).Unix(),
+	}).SignedString([]byte(testJWTSecret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &http.Cookie{Name: "access_token", Value: token}
+}
+
+// TestOrderRoutes_Authorization menguji router asli (middleware + controller + service order)
+// dengan repository mock: tiap route customer di-scope ke pemilik, route admin hanya untuk admin.
+func TestOrderRoutes_Authorization(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Setenv("JWT_SECRET", testJWTSecret)
+
+	owner := &actor{userID: uuid.New(), role: "CUSTOMER"}
+	stranger := &actor{userID: uuid.New(), role: "CUSTOMER"}
+	admin := &actor{userID: uuid.New(), role: "ADMIN"}
+
+	orderID := uuid.New()
+	pending := dbgen.Order{ID: orderID, UserID: owner.userID, OrderNumber: "ORD-OWNER", Status: "PENDING"}
+	completed := pending
+	completed.Status = "COMPLETED"
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		as     *actor
+		setup  func(repo *orderMock.MockRepository, db sqlmock.Sqlmock)
+		want   int
+	}{
+		// POST /orders/checkout
+		{name: "checkout anonymous", method: http.MethodPost, path: "/api/v1/orders/checkout", want: http.StatusUnauthorized},
+
+		// GET /orders
+		{name: "list anonymous", method: http.MethodGet, path: "/api/v1/orders", want: http.StatusUnauthorized},
+		{
+			name: "list scoped to caller with status filter", method: http.MethodGet, path: "/api/v1/orders?status=PAID", as: stranger,
+			setup: func(repo *orderMock.MockRepository, _ sqlmock.Sqlmock) {
+				repo.EXPECT().List(gomock.Any(), dbgen.ListOrdersParams{
+					UserID: stranger.userID,
+					Status: sql.NullString{String: "PAID", Valid: true},
+					Limit:  10,
+				}).Return(nil, nil)
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "list cursor scoped to caller", method: http.MethodGet, path: "/api/v1/orders?pagination=cursor&status=PENDING", as: owner,
+			setup: func(repo *orderMock.MockRepository, _ sqlmock.Sqlmock) {
+				repo.EXPECT().ListKeyset(gomock.Any(), dbgen.ListOrdersKeysetParams{
+					UserID:  owner.userID,
+					Status:  sql.NullString{String: "PENDING", Valid: true},
+					Limit:   11,
+					SortDir: "desc",
+				}).Return(nil, nil)
+			},
+			want: http.StatusOK,
+		},
+
+		// GET /orders/:id
+		{name: "detail anonymous", method: http.MethodGet, path: "/api/v1/orders/" + orderID.String(), want: http.StatusUnauthorized},
+		{
+			name: "detail by owner", method: http.MethodGet, path: "/api/v1/orders/" + orderID.String(), as: owner,
+			setup: func(repo *orderMock.MockRepository, _ sqlmock.Sqlmock) {
+				repo.EXPECT().GetByID(gomock.Any(), orderID).Return(pending, nil)
+				repo.EXPECT().GetItems(gomock.Any(), orderID).Return(nil, nil)
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "detail by other customer", method: http.MethodGet, path: "/api/v1/orders/" + orderID.String(), as: stranger,
+			setup: func(repo *orderMock.MockRepository, _ sqlmock.Sqlmock) {
+				repo.EXPECT().GetByID(gomock.Any(), orderID).Return(pending, nil)
+			},
+			want: http.StatusNotFound,
+		},
+		{
+			name: "detail by admin on customer route is still scoped", method: http.MethodGet, path: "/api/v1/orders/" + orderID.String(), as: admin,
+			setup: func(repo *orderMock.MockRepository, _ sqlmock.Sqlmock) {
+				repo.EXPECT().GetByID(gomock.Any(), orderID).Return(pending, nil)
+			},
+			want: http.StatusNotFound,
+		},
+
+		// PATCH /orders/:id/cancel
+		{name: "cancel anonymous", method: http.MethodPatch, path: "/api/v1/orders/" + orderID.String() + "/cancel", want: http.StatusUnauthorized},
+		{
+			name: "cancel by other customer", method: http.MethodPatch, path: "/api/v1/orders/" + orderID.String() + "/cancel", as: stranger,
+			setup: func(repo *orderMock.MockRepository, _ sqlmock.Sqlmock) {
+				repo.EXPECT().GetByID(gomock.Any(), orderID).Return(pending, nil)
+			},
+			want: http.StatusNotFound,
+		},
+		{
+			name: "cancel by owner reaches status check", method: http.MethodPatch, path: "/api/v1/orders/" + orderID.String() + "/cancel", as: owner,
+			setup: func(repo *orderMock.MockRepository, _ sqlmock.Sqlmock) {
+				repo.EXPECT().GetByID(gomock.Any(), orderID).Return(completed, nil)
+			},
+			want: http.StatusBadRequest,
+		},
+
+		// PATCH /orders/:id/status
+		{name: "complete anonymous", method: http.MethodPatch, path: "/api/v1/orders/" + orderID.String() + "/status", want: http.StatusUnauthorized},
+		{
+			name: "complete by other customer", method: http.MethodPatch, path: "/api/v1/orders/" + orderID.String() + "/status", as: stranger,
+			setup: func(repo *orderMock.MockRepository, db sqlmock.Sqlmock) {
+				db.ExpectBegin()
+				repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+				repo.EXPECT().GetByID(gomock.Any(), orderID).Return(pending, nil)
+				db.ExpectRollback()
+			},
+			want: http.StatusNotFound,
+		},
+
+		// GET /orders/admin
+		{name: "admin list anonymous", method: http.MethodGet, path: "/api/v1/orders/admin", want: http.StatusUnauthorized},
+		{name: "admin list by customer", method: http.MethodGet, path: "/api/v1/orders/admin", as: owner, want: http.StatusForbidden},
+		{
+			name: "admin list by admin", method: http.MethodGet, path: "/api/v1/orders/admin", as: admin,
+			setup: func(repo *orderMock.MockRepository, _ sqlmock.Sqlmock) {
+				repo.EXPECT().ListAdmin(gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			want: http.StatusOK,
+		},
+
+		// GET /orders/admin/:id
+		{name: "admin detail by customer", method: http.MethodGet, path: "/api/v1/orders/admin/" + orderID.String(), as: owner, want: http.StatusForbidden},
+		{
+			name: "admin detail by admin", method: http.MethodGet, path: "/api/v1/orders/admin/" + orderID.String(), as: admin,
+			setup: func(repo *orderMock.MockRepository, _ sqlmock.Sqlmock) {
+				repo.EXPECT().GetByID(gomock.Any(), orderID).Return(pending, nil)
+				repo.EXPECT().GetItems(gomock.Any(), orderID).Return(nil, nil)
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "admin detail missing order", method: http.MethodGet, path: "/api/v1/orders/admin/" + orderID.String(), as: admin,
+			setup: func(repo *orderMock.MockRepository, _ sqlmock.Sqlmock) {
+				repo.EXPECT().GetByID(gomock.Any(), orderID).Return(dbgen.Order{}, sql.ErrNoRows)
+			},
+			want: http.StatusNotFound,
+		},
+
+		// PATCH /orders/admin/:id/cancel
+		{name: "admin cancel by customer", method: http.MethodPatch, path: "/api/v1/orders/admin/" + orderID.String() + "/cancel", as: owner, want: http.StatusForbidden},
+		{
+			name: "admin cancel by admin reaches status check", method: http.MethodPatch, path: "/api/v1/orders/admin/" + orderID.String() + "/cancel", as: admin,
+			setup: func(repo *orderMock.MockRepository, _ sqlmock.Sqlmock) {
+				repo.EXPECT().GetByID(gomock.Any(), orderID).Return(completed, nil)
+			},
+			want: http.StatusBadRequest,
+		},
+
+		// PATCH /orders/admin/:id/status
+		{name: "admin status by customer", method: http.MethodPatch, path: "/api/v1/orders/admin/" + orderID.String() + "/status", as: owner, want: http.StatusForbidden},
+		{name: "admin status anonymous", method: http.MethodPatch, path: "/api/v1/orders/admin/" + orderID.String() + "/status", want: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := orderMock.NewMockRepository(ctrl)
+			db, dbMock, _ := sqlmock.New()
+			defer db.Close()
+			if tt.setup != nil {
+				tt.setup(repo, dbMock)
+			}
+
+			// Dependency lain tidak boleh tersentuh pada skenario otorisasi
+			svc := order.NewService(db, repo, nil, nil, nil, nil, nil, nil, nil)
+			r := gin.New()
+			setupRoutes(r, ControllerRegistry{Order: order.NewController(svc)})
+
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(`{}`))
+			req.Header.Set("Content-Type", "application/json")
+			if tt.as != nil {
+				req.AddCookie(tt.as.cookie(t))
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.want, w.Code, w.Body.String())
+			assert.NoError(t, dbMock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,14 @@
+DROP TRIGGER IF EXISTS trg_brands_search_vector ON brands;
+DROP TRIGGER IF EXISTS trg_categories_search_vector ON categories;
+DROP TRIGGER IF EXISTS trg_products_search_vector ON products;
+
+DROP FUNCTION IF EXISTS products_search_vector_touch_brand();
+DROP FUNCTION IF EXISTS products_search_vector_touch_category();
+DROP FUNCTION IF EXISTS products_search_vector_refresh();
+
+DROP INDEX IF EXISTS idx_products_name_trgm;
+DROP INDEX IF EXISTS idx_products_search_vector;
+DROP INDEX IF EXISTS idx_products_brand;
+
+ALTER TABLE products DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE products DROP COLUMN IF EXISTS brand_id;
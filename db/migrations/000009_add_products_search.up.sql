@@ -0,0 +1,54 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+ALTER TABLE products ADD COLUMN brand_id UUID REFERENCES brands(id);
+ALTER TABLE products ADD COLUMN search_vector TSVECTOR;
+
+-- search_vector diisi lewat trigger (bukan GENERATED column) karena
+-- nama category & brand berada di tabel lain.
+CREATE OR REPLACE FUNCTION products_search_vector_refresh() RETURNS trigger AS $$
+BEGIN
+    NEW.search_vector :=
+        setweight(to_tsvector('simple', coalesce(NEW.name, '')), 'A') ||
+        setweight(to_tsvector('simple', coalesce(NEW.sku, '')), 'A') ||
+        setweight(to_tsvector('simple', coalesce((SELECT name FROM categories WHERE id = NEW.category_id), '')), 'B') ||
+        setweight(to_tsvector('simple', coalesce((SELECT name FROM brands WHERE id = NEW.brand_id), '')), 'B') ||
+        setweight(to_tsvector('simple', coalesce(NEW.description, '')), 'C');
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_products_search_vector
+    BEFORE INSERT OR UPDATE OF name, sku, description, category_id, brand_id ON products
+    FOR EACH ROW EXECUTE FUNCTION products_search_vector_refresh();
+
+-- Rename category / brand harus ikut memperbarui search_vector produk terkait
+CREATE OR REPLACE FUNCTION products_search_vector_touch_category() RETURNS trigger AS $$
+BEGIN
+    UPDATE products SET name = name WHERE category_id = NEW.id;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION products_search_vector_touch_brand() RETURNS trigger AS $$
+BEGIN
+    UPDATE products SET name = name WHERE brand_id = NEW.id;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_categories_search_vector
+    AFTER UPDATE OF name ON categories
+    FOR EACH ROW WHEN (OLD.name IS DISTINCT FROM NEW.name)
+    EXECUTE FUNCTION products_search_vector_touch_category();
+
+CREATE TRIGGER trg_brands_search_vector
+    AFTER UPDATE OF name ON brands
+    FOR EACH ROW WHEN (OLD.name IS DISTINCT FROM NEW.name)
+    EXECUTE FUNCTION products_search_vector_touch_brand();
+
+-- Backfill data yang sudah ada
+UPDATE products SET name = name;
+
+CREATE INDEX idx_products_brand ON products(brand_id);
+CREATE INDEX idx_products_search_vector ON products USING GIN (search_vector);
+CREATE INDEX idx_products_name_trgm ON products USING GIN (name gin_trgm_ops);
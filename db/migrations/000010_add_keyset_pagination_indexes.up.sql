@@ -0,0 +1,6 @@
+-- Index pendukung keyset pagination (sort key + id sebagai tie-breaker)
+CREATE INDEX idx_products_created_id ON products (created_at DESC, id DESC) WHERE deleted_at IS NULL;
+CREATE INDEX idx_products_price_id ON products (price, id) WHERE deleted_at IS NULL;
+CREATE INDEX idx_orders_user_placed_id ON orders (user_id, placed_at DESC, id DESC);
+CREATE INDEX idx_orders_placed_id ON orders (placed_at DESC, id DESC);
+CREATE INDEX idx_reviews_product_created_id ON reviews (product_id, created_at DESC, id DESC) WHERE deleted_at IS NULL;
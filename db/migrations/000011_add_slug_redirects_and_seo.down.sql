@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS slug_redirects;
+
+ALTER TABLE brands DROP COLUMN IF EXISTS meta_description;
+ALTER TABLE brands DROP COLUMN IF EXISTS meta_title;
+ALTER TABLE categories DROP COLUMN IF EXISTS meta_description;
+ALTER TABLE categories DROP COLUMN IF EXISTS meta_title;
+ALTER TABLE products DROP COLUMN IF EXISTS meta_description;
+ALTER TABLE products DROP COLUMN IF EXISTS meta_title;
@@ -0,0 +1,19 @@
+-- Meta SEO per entitas katalog
+ALTER TABLE products ADD COLUMN meta_title VARCHAR(255);
+ALTER TABLE products ADD COLUMN meta_description TEXT;
+ALTER TABLE categories ADD COLUMN meta_title VARCHAR(255);
+ALTER TABLE categories ADD COLUMN meta_description TEXT;
+ALTER TABLE brands ADD COLUMN meta_title VARCHAR(255);
+ALTER TABLE brands ADD COLUMN meta_description TEXT;
+
+-- Riwayat slug: slug lama tetap bisa diakses (301 ke slug terbaru) setelah rename
+CREATE TABLE slug_redirects (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    entity_type VARCHAR(20) NOT NULL CHECK (entity_type IN ('product', 'category', 'brand')),
+    old_slug VARCHAR(200) NOT NULL,
+    entity_id UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT uniq_slug_redirect UNIQUE(entity_type, old_slug)
+);
+
+CREATE INDEX idx_slug_redirects_entity ON slug_redirects(entity_type, entity_id);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_categories_parent_id;
+
+ALTER TABLE categories DROP CONSTRAINT IF EXISTS chk_categories_parent_not_self;
+ALTER TABLE categories DROP COLUMN IF EXISTS parent_id;
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS stock_movements;
+
+ALTER TABLE products DROP CONSTRAINT IF EXISTS chk_products_stock_non_negative;
+ALTER TABLE products DROP CONSTRAINT IF EXISTS chk_products_low_stock_threshold;
+ALTER TABLE products DROP COLUMN IF EXISTS low_stock_threshold;
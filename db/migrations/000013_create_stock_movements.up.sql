@@ -0,0 +1,27 @@
+-- Batas stok menipis per produk (laporan low-stock admin)
+ALTER TABLE products
+    ADD COLUMN low_stock_threshold INTEGER NOT NULL DEFAULT 5,
+    ADD CONSTRAINT chk_products_low_stock_threshold CHECK (low_stock_threshold >= 0),
+    ADD CONSTRAINT chk_products_stock_non_negative CHECK (stock >= 0);
+
+-- Ledger stok: setiap perubahan products.stock tercatat di sini
+CREATE TABLE stock_movements (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    product_id UUID NOT NULL REFERENCES products(id),
+    delta INTEGER NOT NULL CHECK (delta <> 0),
+    stock_after INTEGER NOT NULL,
+    reason VARCHAR(20) NOT NULL CHECK (reason IN ('INITIAL', 'PURCHASE', 'CANCEL', 'RESTOCK', 'ADJUSTMENT', 'RETURN')),
+    order_id UUID REFERENCES orders(id),
+    actor_id UUID REFERENCES users(id),
+    note VARCHAR(255),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_stock_movements_product ON stock_movements(product_id, created_at DESC);
+CREATE INDEX idx_stock_movements_order ON stock_movements(order_id) WHERE order_id IS NOT NULL;
+
+-- Saldo awal dari stok yang sudah ada agar ledger konsisten dengan products.stock
+INSERT INTO stock_movements (product_id, delta, stock_after, reason, note)
+SELECT id, stock, stock, 'INITIAL', 'opening balance'
+FROM products
+WHERE stock > 0;
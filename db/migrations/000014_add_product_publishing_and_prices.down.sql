@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS product_prices;
+
+ALTER TABLE products DROP CONSTRAINT IF EXISTS chk_products_publish_window;
+ALTER TABLE products DROP COLUMN IF EXISTS unpublished_at;
+ALTER TABLE products DROP COLUMN IF EXISTS published_at;
@@ -0,0 +1,21 @@
+-- Jadwal tayang produk (NULL = langsung tayang / tidak pernah berakhir)
+ALTER TABLE products
+    ADD COLUMN published_at TIMESTAMP,
+    ADD COLUMN unpublished_at TIMESTAMP,
+    ADD CONSTRAINT chk_products_publish_window CHECK (
+        published_at IS NULL OR unpublished_at IS NULL OR unpublished_at > published_at
+    );
+
+-- Jadwal harga (sale price) per produk; compare_at_price untuk harga coret
+CREATE TABLE product_prices (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    price DECIMAL(12, 2) NOT NULL CHECK (price > 0),
+    compare_at_price DECIMAL(12, 2) CHECK (compare_at_price IS NULL OR compare_at_price > price),
+    starts_at TIMESTAMP NOT NULL,
+    ends_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_product_prices_window CHECK (ends_at IS NULL OR ends_at > starts_at)
+);
+
+CREATE INDEX idx_product_prices_product_starts ON product_prices(product_id, starts_at DESC);
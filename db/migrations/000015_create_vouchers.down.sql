@@ -0,0 +1,6 @@
+ALTER TABLE orders DROP COLUMN IF EXISTS voucher_code;
+
+DROP TABLE IF EXISTS voucher_redemptions;
+DROP TABLE IF EXISTS voucher_categories;
+DROP TABLE IF EXISTS voucher_products;
+DROP TABLE IF EXISTS vouchers;
@@ -0,0 +1,51 @@
+-- Voucher promo: diskon persentase / nominal tetap
+CREATE TABLE vouchers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    code VARCHAR(50) NOT NULL, -- disimpan uppercase
+    description VARCHAR(255),
+    discount_type VARCHAR(10) NOT NULL CHECK (discount_type IN ('PERCENTAGE', 'FIXED')),
+    discount_value DECIMAL(12, 2) NOT NULL CHECK (discount_value > 0),
+    max_discount DECIMAL(12, 2), -- batas atas untuk PERCENTAGE (NULL = tanpa batas)
+    min_spend DECIMAL(12, 2) NOT NULL DEFAULT 0,
+    usage_limit INTEGER CHECK (usage_limit IS NULL OR usage_limit > 0), -- global (NULL = tanpa batas)
+    per_user_limit INTEGER CHECK (per_user_limit IS NULL OR per_user_limit > 0),
+    used_count INTEGER NOT NULL DEFAULT 0,
+    starts_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    ends_at TIMESTAMP,
+    is_active BOOLEAN NOT NULL DEFAULT true,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP,
+    CONSTRAINT chk_vouchers_percentage CHECK (discount_type <> 'PERCENTAGE' OR discount_value <= 100),
+    CONSTRAINT chk_vouchers_window CHECK (ends_at IS NULL OR ends_at > starts_at)
+);
+
+-- Kode unik hanya di antara voucher yang belum dihapus
+CREATE UNIQUE INDEX idx_vouchers_code_active ON vouchers(code) WHERE deleted_at IS NULL;
+
+-- Batasan produk / kategori (kosong semua = berlaku untuk semua produk)
+CREATE TABLE voucher_products (
+    voucher_id UUID NOT NULL REFERENCES vouchers(id) ON DELETE CASCADE,
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    PRIMARY KEY (voucher_id, product_id)
+);
+
+CREATE TABLE voucher_categories (
+    voucher_id UUID NOT NULL REFERENCES vouchers(id) ON DELETE CASCADE,
+    category_id UUID NOT NULL REFERENCES categories(id) ON DELETE CASCADE,
+    PRIMARY KEY (voucher_id, category_id)
+);
+
+-- Pemakaian voucher per order (dihapus jika order dibatalkan)
+CREATE TABLE voucher_redemptions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    voucher_id UUID NOT NULL REFERENCES vouchers(id),
+    user_id UUID NOT NULL REFERENCES users(id),
+    order_id UUID NOT NULL UNIQUE REFERENCES orders(id),
+    discount_amount DECIMAL(12, 2) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_voucher_redemptions_voucher_user ON voucher_redemptions(voucher_id, user_id);
+
+ALTER TABLE orders ADD COLUMN voucher_code VARCHAR(50);
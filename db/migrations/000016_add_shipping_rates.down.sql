@@ -0,0 +1,9 @@
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS shipping_service,
+    DROP COLUMN IF EXISTS shipping_courier;
+
+DROP TABLE IF EXISTS shipping_rates;
+
+ALTER TABLE products
+    DROP CONSTRAINT IF EXISTS chk_products_weight_non_negative,
+    DROP COLUMN IF EXISTS weight_grams;
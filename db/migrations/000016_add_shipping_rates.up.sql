@@ -0,0 +1,29 @@
+-- Berat produk untuk perhitungan ongkir
+ALTER TABLE products
+    ADD COLUMN weight_grams INT NOT NULL DEFAULT 0,
+    ADD CONSTRAINT chk_products_weight_non_negative CHECK (weight_grams >= 0);
+
+-- Tabel tarif ongkir: zona (province/city, NULL = semua) x tier berat
+CREATE TABLE shipping_rates (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    courier VARCHAR(50) NOT NULL,
+    service VARCHAR(50) NOT NULL,
+    province VARCHAR(120),
+    city VARCHAR(120),
+    min_weight_grams INT NOT NULL DEFAULT 0 CHECK (min_weight_grams >= 0),
+    max_weight_grams INT, -- NULL = tanpa batas atas
+    price DECIMAL(12, 2) NOT NULL CHECK (price >= 0),
+    etd VARCHAR(20), -- estimasi hari, contoh: "2-3"
+    is_active BOOLEAN NOT NULL DEFAULT true,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_shipping_rates_weight_range CHECK (max_weight_grams IS NULL OR max_weight_grams >= min_weight_grams),
+    CONSTRAINT chk_shipping_rates_city_needs_province CHECK (city IS NULL OR province IS NOT NULL)
+);
+
+CREATE INDEX idx_shipping_rates_lookup ON shipping_rates (courier, service, province, city) WHERE is_active = true;
+
+-- Kurir & layanan yang dipilih saat checkout
+ALTER TABLE orders
+    ADD COLUMN shipping_courier VARCHAR(50),
+    ADD COLUMN shipping_service VARCHAR(50);
@@ -0,0 +1,30 @@
+-- Data pengiriman per order (dibuat saat admin menandai SHIPPED)
+CREATE TABLE shipments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    order_id UUID NOT NULL UNIQUE REFERENCES orders(id) ON DELETE CASCADE,
+    courier VARCHAR(50) NOT NULL,
+    service VARCHAR(50) NOT NULL DEFAULT '',
+    receipt_no VARCHAR(100) NOT NULL,
+    shipped_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    delivered_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Webhook kurir mencari shipment lewat kurir + nomor resi
+CREATE UNIQUE INDEX idx_shipments_courier_receipt ON shipments (LOWER(courier), receipt_no);
+
+-- Riwayat tracking dari kurir (append-only)
+CREATE TABLE shipment_tracking_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    shipment_id UUID NOT NULL REFERENCES shipments(id) ON DELETE CASCADE,
+    status VARCHAR(30) NOT NULL, -- PICKED_UP, IN_TRANSIT, OUT_FOR_DELIVERY, DELIVERED, FAILED, ...
+    description TEXT NOT NULL DEFAULT '',
+    location VARCHAR(120),
+    occurred_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    -- Webhook yang dikirim ulang tidak menggandakan event
+    CONSTRAINT uq_shipment_tracking_event UNIQUE (shipment_id, status, occurred_at)
+);
+
+CREATE INDEX idx_shipment_tracking_events_timeline ON shipment_tracking_events (shipment_id, occurred_at DESC);
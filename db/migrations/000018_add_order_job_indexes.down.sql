@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_orders_delivered_updated_at;
+DROP INDEX IF EXISTS idx_orders_pending_placed_at;
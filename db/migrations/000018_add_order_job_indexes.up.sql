@@ -0,0 +1,3 @@
+-- Index untuk job scheduler: expiry order PENDING & auto-complete order DELIVERED
+CREATE INDEX idx_orders_pending_placed_at ON orders (placed_at) WHERE status = 'PENDING';
+CREATE INDEX idx_orders_delivered_updated_at ON orders (updated_at) WHERE status = 'DELIVERED';
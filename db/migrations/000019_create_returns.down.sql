@@ -0,0 +1,7 @@
+ALTER TABLE orders
+    DROP CONSTRAINT IF EXISTS chk_orders_refunded_amount,
+    DROP COLUMN IF EXISTS refunded_amount;
+
+DROP TABLE IF EXISTS refunds;
+DROP TABLE IF EXISTS return_photos;
+DROP TABLE IF EXISTS return_requests;
@@ -0,0 +1,59 @@
+-- Pengajuan retur per item order (RMA)
+CREATE TABLE return_requests (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    rma_number VARCHAR(32) NOT NULL UNIQUE,
+    order_id UUID NOT NULL REFERENCES orders(id),
+    order_item_id UUID NOT NULL REFERENCES order_items(id),
+    user_id UUID NOT NULL REFERENCES users(id),
+    product_id UUID NOT NULL REFERENCES products(id),
+    name_snapshot VARCHAR(200) NOT NULL,
+    unit_price DECIMAL(12, 2) NOT NULL,
+    quantity INT NOT NULL CHECK (quantity > 0),
+    reason VARCHAR(30) NOT NULL, -- DAMAGED, WRONG_ITEM, NOT_AS_DESCRIBED, MISSING_PARTS, OTHER
+    description TEXT,
+    status VARCHAR(20) NOT NULL DEFAULT 'REQUESTED', -- REQUESTED, APPROVED, REJECTED, SHIPPED_BACK, RECEIVED, REFUNDED
+    admin_note TEXT,
+    return_courier VARCHAR(50),
+    return_receipt_no VARCHAR(100),
+    approved_at TIMESTAMP,
+    rejected_at TIMESTAMP,
+    shipped_back_at TIMESTAMP,
+    received_at TIMESTAMP,
+    refunded_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_return_requests_user ON return_requests (user_id, created_at DESC);
+CREATE INDEX idx_return_requests_status ON return_requests (status, created_at DESC);
+CREATE INDEX idx_return_requests_order_item ON return_requests (order_item_id);
+
+-- Foto bukti (disimpan di Cloudinary)
+CREATE TABLE return_photos (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    return_id UUID NOT NULL REFERENCES return_requests(id) ON DELETE CASCADE,
+    image_url TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_return_photos_return ON return_photos (return_id);
+
+-- Catatan refund (satu per retur, boleh sebagian)
+CREATE TABLE refunds (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    return_id UUID NOT NULL UNIQUE REFERENCES return_requests(id),
+    order_id UUID NOT NULL REFERENCES orders(id),
+    amount DECIMAL(12, 2) NOT NULL CHECK (amount > 0),
+    method VARCHAR(20) NOT NULL, -- ORIGINAL_PAYMENT, BANK_TRANSFER, STORE_CREDIT
+    reference VARCHAR(100),
+    note TEXT,
+    processed_by UUID REFERENCES users(id),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_refunds_order ON refunds (order_id);
+
+-- Total refund per order; payment_status ikut: PARTIAL_REFUND / REFUNDED
+ALTER TABLE orders
+    ADD COLUMN refunded_amount DECIMAL(12, 2) NOT NULL DEFAULT 0,
+    ADD CONSTRAINT chk_orders_refunded_amount CHECK (refunded_amount >= 0 AND refunded_amount <= total_price);
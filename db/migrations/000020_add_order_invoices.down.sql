@@ -0,0 +1,8 @@
+DROP TRIGGER IF EXISTS trg_orders_invoice_number ON orders;
+DROP FUNCTION IF EXISTS orders_assign_invoice_number();
+
+DROP TABLE IF EXISTS invoice_sequences;
+
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS invoiced_at,
+    DROP COLUMN IF EXISTS invoice_number;
@@ -0,0 +1,60 @@
+ALTER TABLE orders
+    ADD COLUMN invoice_number VARCHAR(32) UNIQUE,
+    ADD COLUMN invoiced_at TIMESTAMP;
+
+-- Counter nomor invoice per tahun. Di-increment di transaksi yang sama dengan
+-- perubahan status order: jika transaksi rollback, nomornya ikut batal
+-- sehingga penomoran tidak pernah bolong (beda dengan SEQUENCE).
+CREATE TABLE invoice_sequences (
+    year INT PRIMARY KEY,
+    last_number INT NOT NULL DEFAULT 0
+);
+
+-- Backfill order yang sudah dibayar sebelum migration ini (urut waktu bayar)
+WITH numbered AS (
+    SELECT id,
+           COALESCE(paid_at, placed_at) AS issued_at,
+           EXTRACT(YEAR FROM COALESCE(paid_at, placed_at))::int AS yr,
+           ROW_NUMBER() OVER (
+               PARTITION BY EXTRACT(YEAR FROM COALESCE(paid_at, placed_at))
+               ORDER BY COALESCE(paid_at, placed_at), id
+           ) AS n
+    FROM orders
+    WHERE paid_at IS NOT NULL
+       OR payment_status IN ('PAID', 'PARTIAL_REFUND', 'REFUNDED')
+       OR status IN ('PAID', 'PROCESSING', 'SHIPPED', 'DELIVERED', 'COMPLETED', 'REFUNDED')
+)
+UPDATE orders o
+SET invoice_number = 'INV/' || numbered.yr || '/' || LPAD(numbered.n::text, 6, '0'),
+    invoiced_at = numbered.issued_at
+FROM numbered
+WHERE o.id = numbered.id;
+
+INSERT INTO invoice_sequences (year, last_number)
+SELECT EXTRACT(YEAR FROM invoiced_at)::int, COUNT(*)
+FROM orders
+WHERE invoice_number IS NOT NULL
+GROUP BY 1;
+
+-- Nomor invoice dialokasikan saat order menjadi PAID (status maupun payment_status),
+-- apa pun jalurnya (admin, payment gateway, dsb). Baris counter terkunci sampai commit.
+CREATE OR REPLACE FUNCTION orders_assign_invoice_number() RETURNS trigger AS $$
+DECLARE
+    seq_year INT := EXTRACT(YEAR FROM NOW())::int;
+    next_number INT;
+BEGIN
+    INSERT INTO invoice_sequences (year, last_number) VALUES (seq_year, 1)
+    ON CONFLICT (year) DO UPDATE SET last_number = invoice_sequences.last_number + 1
+    RETURNING last_number INTO next_number;
+
+    NEW.invoice_number := 'INV/' || seq_year || '/' || LPAD(next_number::text, 6, '0');
+    NEW.invoiced_at := NOW();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_orders_invoice_number
+    BEFORE INSERT OR UPDATE OF status, payment_status ON orders
+    FOR EACH ROW
+    WHEN (NEW.invoice_number IS NULL AND (NEW.status = 'PAID' OR NEW.payment_status = 'PAID'))
+    EXECUTE FUNCTION orders_assign_invoice_number();
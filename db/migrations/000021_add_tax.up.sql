@@ -0,0 +1,27 @@
+-- Kelas pajak produk, tarif dalam persen (PPN standar 11%)
+CREATE TABLE tax_classes (
+    code VARCHAR(32) PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    rate DECIMAL(5, 2) NOT NULL CHECK (rate >= 0 AND rate <= 100),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO tax_classes (code, name, rate) VALUES
+    ('STANDARD', 'PPN', 11.00),
+    ('EXEMPT', 'Bebas PPN', 0.00);
+
+ALTER TABLE products
+    ADD COLUMN tax_class VARCHAR(32) NOT NULL DEFAULT 'STANDARD'
+        CONSTRAINT fk_products_tax_class REFERENCES tax_classes(code);
+
+-- Pajak tersimpan per order & per item (snapshot tarif saat checkout).
+-- prices_include_tax = mode toko saat checkout: true -> tax_price sudah
+-- termasuk di harga (informasi saja), false -> tax_price ditambahkan ke total.
+ALTER TABLE orders
+    ADD COLUMN tax_price DECIMAL(12, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN prices_include_tax BOOLEAN NOT NULL DEFAULT false;
+
+ALTER TABLE order_items
+    ADD COLUMN tax_rate DECIMAL(5, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN tax_amount DECIMAL(12, 2) NOT NULL DEFAULT 0;
@@ -0,0 +1,11 @@
+DELETE FROM carts WHERE user_id IS NULL;
+
+DROP INDEX IF EXISTS idx_carts_guest_expires_at;
+
+ALTER TABLE carts
+    DROP CONSTRAINT IF EXISTS carts_owner_check,
+    DROP CONSTRAINT IF EXISTS carts_guest_id_unique,
+    DROP COLUMN IF EXISTS expires_at,
+    DROP COLUMN IF EXISTS guest_id;
+
+ALTER TABLE carts ALTER COLUMN user_id SET NOT NULL;
@@ -0,0 +1,12 @@
+-- Cart guest: belum login, diidentifikasi guest_id dari cart token bertanda tangan
+ALTER TABLE carts ALTER COLUMN user_id DROP NOT NULL;
+
+ALTER TABLE carts
+    ADD COLUMN guest_id UUID,
+    ADD COLUMN expires_at TIMESTAMP,
+    ADD CONSTRAINT carts_guest_id_unique UNIQUE (guest_id),
+    -- Tepat satu pemilik: user atau guest
+    ADD CONSTRAINT carts_owner_check CHECK ((user_id IS NULL) <> (guest_id IS NULL));
+
+-- Purge job: cari cart guest yang sudah lewat expires_at
+CREATE INDEX idx_carts_guest_expires_at ON carts (expires_at) WHERE guest_id IS NOT NULL;
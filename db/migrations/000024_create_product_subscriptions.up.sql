@@ -0,0 +1,34 @@
+-- Langganan notifikasi produk: stok kembali / harga turun
+CREATE TABLE product_subscriptions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('BACK_IN_STOCK', 'PRICE_DROP')),
+    price_at_subscribe DECIMAL(12,2) NOT NULL, -- acuan PRICE_DROP
+    notified_at TIMESTAMP,                    -- NULL = masih menunggu
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT product_subscriptions_unique UNIQUE (user_id, product_id, type)
+);
+
+-- Hook stok / harga: cari langganan yang belum dikirim per produk
+CREATE INDEX idx_product_subscriptions_pending ON product_subscriptions (product_id, type) WHERE notified_at IS NULL;
+
+-- Outbox notifikasi: ditulis di transaksi yang sama dengan perubahan stok / harga,
+-- dikirim belakangan oleh job delivery
+CREATE TABLE notifications (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    channel VARCHAR(20) NOT NULL DEFAULT 'EMAIL',
+    type VARCHAR(30) NOT NULL,
+    recipient VARCHAR(255) NOT NULL,
+    subject VARCHAR(255) NOT NULL,
+    body TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'SENT', 'FAILED')),
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    sent_at TIMESTAMP
+);
+
+CREATE INDEX idx_notifications_pending ON notifications (created_at) WHERE status = 'PENDING';
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_cart_items_cart_updated;
+DROP TABLE IF EXISTS cart_reminders;
+DROP TABLE IF EXISTS cart_reminder_opt_outs;
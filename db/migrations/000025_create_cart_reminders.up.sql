@@ -0,0 +1,22 @@
+-- Customer yang berhenti menerima pengingat cart (link unsubscribe di email)
+CREATE TABLE cart_reminder_opt_outs (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Riwayat pengingat cart terbengkalai. cart_activity_at = aktivitas cart terakhir saat
+-- pengingat dikirim; cart yang diubah lagi mulai hitungan pengingat dari awal.
+CREATE TABLE cart_reminders (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    cart_id UUID NOT NULL REFERENCES carts(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reminder_number INT NOT NULL CHECK (reminder_number > 0),
+    cart_activity_at TIMESTAMP NOT NULL,
+    item_count INT NOT NULL,
+    cart_value DECIMAL(12,2) NOT NULL,
+    sent_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    -- Job paralel / retry tidak mengirim pengingat yang sama dua kali
+    CONSTRAINT cart_reminders_unique UNIQUE (cart_id, cart_activity_at, reminder_number)
+);
+
+CREATE INDEX idx_cart_items_cart_updated ON cart_items (cart_id, updated_at);
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_reviews_status_created;
+DROP INDEX IF EXISTS idx_reviews_product_approved;
+
+ALTER TABLE reviews
+    DROP COLUMN IF EXISTS moderated_at,
+    DROP COLUMN IF EXISTS moderated_by,
+    DROP COLUMN IF EXISTS moderation_note,
+    DROP COLUMN IF EXISTS status;
@@ -0,0 +1,16 @@
+-- Moderasi review: review lama dianggap sudah tayang, review baru default menunggu moderasi
+ALTER TABLE reviews
+    ADD COLUMN status VARCHAR(16) NOT NULL DEFAULT 'APPROVED'
+        CHECK (status IN ('PENDING', 'APPROVED', 'REJECTED', 'HIDDEN')),
+    ADD COLUMN moderation_note TEXT,
+    ADD COLUMN moderated_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    ADD COLUMN moderated_at TIMESTAMP;
+
+ALTER TABLE reviews ALTER COLUMN status SET DEFAULT 'PENDING';
+
+-- Listing publik & rata-rata rating hanya review APPROVED
+CREATE INDEX idx_reviews_product_approved ON reviews (product_id, created_at DESC, id DESC)
+    WHERE deleted_at IS NULL AND status = 'APPROVED';
+
+-- Antrian moderasi admin
+CREATE INDEX idx_reviews_status_created ON reviews (status, created_at) WHERE deleted_at IS NULL;
@@ -0,0 +1,21 @@
+-- Foto review dari customer (disimpan di Cloudinary)
+CREATE TABLE review_photos (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    review_id UUID NOT NULL REFERENCES reviews(id) ON DELETE CASCADE,
+    image_url TEXT NOT NULL,
+    public_id TEXT NOT NULL, -- untuk hapus di Cloudinary saat review dihapus
+    sort_order INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_review_photos_review ON review_photos (review_id, sort_order);
+
+-- Balasan publik dari merchant (satu per review)
+CREATE TABLE review_replies (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    review_id UUID NOT NULL UNIQUE REFERENCES reviews(id) ON DELETE CASCADE,
+    admin_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    body TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
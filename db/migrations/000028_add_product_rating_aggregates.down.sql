@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_products_rating;
+
+ALTER TABLE products
+    DROP COLUMN IF EXISTS rating_5_count,
+    DROP COLUMN IF EXISTS rating_4_count,
+    DROP COLUMN IF EXISTS rating_3_count,
+    DROP COLUMN IF EXISTS rating_2_count,
+    DROP COLUMN IF EXISTS rating_1_count,
+    DROP COLUMN IF EXISTS rating_count,
+    DROP COLUMN IF EXISTS rating_avg;
@@ -0,0 +1,36 @@
+-- Agregat rating denormalisasi (hanya review APPROVED), dijaga oleh review service
+ALTER TABLE products
+    ADD COLUMN rating_avg DOUBLE PRECISION NOT NULL DEFAULT 0,
+    ADD COLUMN rating_count INT NOT NULL DEFAULT 0,
+    ADD COLUMN rating_1_count INT NOT NULL DEFAULT 0,
+    ADD COLUMN rating_2_count INT NOT NULL DEFAULT 0,
+    ADD COLUMN rating_3_count INT NOT NULL DEFAULT 0,
+    ADD COLUMN rating_4_count INT NOT NULL DEFAULT 0,
+    ADD COLUMN rating_5_count INT NOT NULL DEFAULT 0;
+
+-- Sort "rating" di listing publik
+CREATE INDEX idx_products_rating ON products (rating_avg DESC, rating_count DESC) WHERE deleted_at IS NULL;
+
+-- Isi awal dari review yang sudah ada
+UPDATE products p
+SET rating_avg = agg.rating_avg,
+    rating_count = agg.rating_count,
+    rating_1_count = agg.r1,
+    rating_2_count = agg.r2,
+    rating_3_count = agg.r3,
+    rating_4_count = agg.r4,
+    rating_5_count = agg.r5
+FROM (
+    SELECT product_id,
+        ROUND(AVG(rating)::numeric, 2)::float8 AS rating_avg,
+        COUNT(*) AS rating_count,
+        COUNT(*) FILTER (WHERE rating = 1) AS r1,
+        COUNT(*) FILTER (WHERE rating = 2) AS r2,
+        COUNT(*) FILTER (WHERE rating = 3) AS r3,
+        COUNT(*) FILTER (WHERE rating = 4) AS r4,
+        COUNT(*) FILTER (WHERE rating = 5) AS r5
+    FROM reviews
+    WHERE deleted_at IS NULL AND status = 'APPROVED'
+    GROUP BY product_id
+) agg
+WHERE p.id = agg.product_id;
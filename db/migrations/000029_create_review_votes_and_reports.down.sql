@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_reviews_reported;
+DROP INDEX IF EXISTS idx_reviews_product_helpful;
+
+ALTER TABLE reviews
+    DROP COLUMN IF EXISTS report_count,
+    DROP COLUMN IF EXISTS not_helpful_count,
+    DROP COLUMN IF EXISTS helpful_count;
+
+DROP TABLE IF EXISTS review_reports;
+DROP TABLE IF EXISTS review_votes;
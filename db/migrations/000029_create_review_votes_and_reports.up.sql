@@ -0,0 +1,34 @@
+-- Vote "membantu / tidak membantu" per user (satu vote per user per review, bisa diganti)
+CREATE TABLE review_votes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    review_id UUID NOT NULL REFERENCES reviews(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    helpful BOOLEAN NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_review_votes_review_user UNIQUE (review_id, user_id)
+);
+
+-- Laporan review bermasalah dari user; resolved_at diisi saat admin memoderasi review
+CREATE TABLE review_reports (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    review_id UUID NOT NULL REFERENCES reviews(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reason VARCHAR(16) NOT NULL
+        CHECK (reason IN ('SPAM', 'ABUSIVE', 'OFFENSIVE', 'IRRELEVANT', 'OTHER')),
+    note TEXT,
+    resolved_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_review_reports_review_user UNIQUE (review_id, user_id)
+);
+
+-- Counter denormalisasi untuk sorting "paling membantu" & antrian laporan admin
+ALTER TABLE reviews
+    ADD COLUMN helpful_count INT NOT NULL DEFAULT 0,
+    ADD COLUMN not_helpful_count INT NOT NULL DEFAULT 0,
+    ADD COLUMN report_count INT NOT NULL DEFAULT 0;
+
+CREATE INDEX idx_reviews_product_helpful ON reviews (product_id, helpful_count DESC, created_at DESC)
+    WHERE deleted_at IS NULL AND status = 'APPROVED';
+
+CREATE INDEX idx_reviews_reported ON reviews (report_count DESC) WHERE deleted_at IS NULL AND report_count > 0;
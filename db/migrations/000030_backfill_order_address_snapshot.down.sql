@@ -0,0 +1,2 @@
+-- Backfill data; snapshot lengkap tetap valid sehingga tidak perlu dikembalikan
+SELECT 1;
@@ -0,0 +1,18 @@
+-- Snapshot lama hanya berisi address_id; lengkapi dari alamat yang masih tersimpan
+-- agar invoice cukup membaca snapshot
+UPDATE orders o
+SET address_snapshot = jsonb_build_object(
+        'address_id', a.id::text,
+        'recipient_name', a.recipient_name,
+        'recipient_phone', a.recipient_phone,
+        'street', a.street,
+        'subdistrict', COALESCE(a.subdistrict, ''),
+        'district', COALESCE(a.district, ''),
+        'city', COALESCE(a.city, ''),
+        'province', COALESCE(a.province, ''),
+        'postal_code', COALESCE(a.postal_code, '')
+    )
+FROM addresses a
+WHERE o.address_snapshot ->> 'recipient_name' IS NULL
+    AND a.id::text = o.address_snapshot ->> 'address_id'
+    AND a.user_id = o.user_id;
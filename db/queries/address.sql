@@ -53,3 +53,10 @@ WHERE a.deleted_at IS NULL
 ORDER BY a.created_at DESC
 LIMIT $1 OFFSET $2;
 
+
+-- name: GetAddressByIDForUser :one
+SELECT * FROM addresses
+WHERE id = $1
+  AND user_id = $2
+  AND deleted_at IS NULL
+LIMIT 1;
@@ -41,14 +41,18 @@ LIMIT $1 OFFSET $2;
 -- name: GetBrandByID :one
 SELECT * FROM brands WHERE id = $1 AND deleted_at IS NULL LIMIT 1;
 
+-- name: GetBrandBySlug :one
+SELECT * FROM brands WHERE slug = $1 AND deleted_at IS NULL LIMIT 1;
+
 -- name: CreateBrand :one
-INSERT INTO brands (name, slug, description, image_url)
-VALUES ($1, $2, $3, $4)
+INSERT INTO brands (name, slug, description, image_url, meta_title, meta_description)
+VALUES ($1, $2, $3, $4, $5, $6)
 RETURNING *;
 
 -- name: UpdateBrand :one
 UPDATE brands 
-SET name = $2, slug = $3, description = $4, image_url = $5, is_active = $6, updated_at = NOW()
+SET name = $2, slug = $3, description = $4, image_url = $5, is_active = $6,
+    meta_title = $7, meta_description = $8, updated_at = NOW()
 WHERE id = $1 AND deleted_at IS NULL
 RETURNING *;
 
@@ -56,4 +60,20 @@ RETURNING *;
 UPDATE brands SET deleted_at = NOW() WHERE id = $1;
 
 -- name: RestoreBrand :one
-UPDATE brands SET deleted_at = NULL WHERE id = $1 RETURNING *;
\ No newline at end of file
+UPDATE brands SET deleted_at = NULL WHERE id = $1 RETURNING *;
+
+-- name: BrandSlugExists :one
+SELECT EXISTS (
+    SELECT 1 FROM brands t
+    WHERE t.slug = sqlc.arg('slug')::text AND t.id <> sqlc.arg('exclude_id')::uuid
+    UNION ALL
+    SELECT 1 FROM slug_redirects r
+    WHERE r.entity_type = 'brand' AND r.old_slug = sqlc.arg('slug')::text AND r.entity_id <> sqlc.arg('exclude_id')::uuid
+) AS slug_exists;
+
+-- name: GetBrandSlugRedirect :one
+SELECT t.slug
+FROM slug_redirects r
+JOIN brands t ON t.id = r.entity_id
+WHERE r.entity_type = 'brand' AND r.old_slug = $1 AND t.deleted_at IS NULL
+LIMIT 1;
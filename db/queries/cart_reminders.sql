@@ -0,0 +1,108 @@
+-- name: ListDueCartReminders :many
+-- Cart user berisi item, tidak disentuh sejak idle_before, belum ada order setelah aktivitas terakhir,
+-- dan jatah pengingat untuk aktivitas tersebut belum habis (jarak antar pengingat = durasi idle)
+SELECT
+  c.id AS cart_id,
+  c.user_id::uuid AS user_id,
+  u.email,
+  u.first_name,
+  act.last_activity_at,
+  act.item_count,
+  act.cart_value,
+  COALESCE(r.sent_count, 0)::int AS reminders_sent
+FROM carts c
+JOIN users u ON u.id = c.user_id
+JOIN LATERAL (
+  SELECT
+    MAX(ci.updated_at)::timestamp AS last_activity_at,
+    COALESCE(SUM(ci.quantity), 0)::int AS item_count,
+    COALESCE(SUM(ci.quantity * ci.price_at_add), 0)::decimal AS cart_value
+  FROM cart_items ci
+  WHERE ci.cart_id = c.id
+) act ON act.item_count > 0
+LEFT JOIN LATERAL (
+  SELECT COUNT(*)::int AS sent_count, MAX(cr.sent_at)::timestamp AS last_sent_at
+  FROM cart_reminders cr
+  WHERE cr.cart_id = c.id AND cr.cart_activity_at = act.last_activity_at
+) r ON true
+WHERE c.user_id IS NOT NULL
+  AND NOT EXISTS (SELECT 1 FROM cart_reminder_opt_outs oo WHERE oo.user_id = c.user_id)
+  AND act.last_activity_at <= sqlc.arg('idle_before')::timestamp
+  AND COALESCE(r.sent_count, 0) < sqlc.arg('max_reminders')::int
+  AND (r.last_sent_at IS NULL OR r.last_sent_at <= sqlc.arg('idle_before')::timestamp)
+  AND NOT EXISTS (
+    SELECT 1 FROM orders o
+    WHERE o.user_id = c.user_id AND o.created_at >= act.last_activity_at
+  )
+ORDER BY act.last_activity_at ASC
+LIMIT sqlc.arg('batch_size')::int;
+
+-- name: CreateCartReminder :execrows
+-- 0 row = pengingat ke-N untuk aktivitas ini sudah tercatat
+INSERT INTO cart_reminders (cart_id, user_id, reminder_number, cart_activity_at, item_count, cart_value)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (cart_id, cart_activity_at, reminder_number) DO NOTHING;
+
+-- name: OptOutCartReminders :exec
+-- Idempotent: klik link unsubscribe berkali-kali tetap sukses
+INSERT INTO cart_reminder_opt_outs (user_id)
+VALUES ($1)
+ON CONFLICT (user_id) DO NOTHING;
+
+-- name: GetAbandonedCartSummary :one
+-- Laporan admin: semua cart user yang terbengkalai (termasuk yang sudah opt-out)
+SELECT
+  COUNT(*)::bigint AS cart_count,
+  COALESCE(SUM(act.item_count), 0)::bigint AS item_count,
+  COALESCE(SUM(act.cart_value), 0)::decimal AS total_value,
+  COUNT(*) FILTER (WHERE EXISTS (
+    SELECT 1 FROM cart_reminders cr
+    WHERE cr.cart_id = c.id AND cr.cart_activity_at = act.last_activity_at
+  ))::bigint AS reminded_count
+FROM carts c
+JOIN LATERAL (
+  SELECT
+    MAX(ci.updated_at)::timestamp AS last_activity_at,
+    COALESCE(SUM(ci.quantity), 0)::int AS item_count,
+    COALESCE(SUM(ci.quantity * ci.price_at_add), 0)::decimal AS cart_value
+  FROM cart_items ci
+  WHERE ci.cart_id = c.id
+) act ON act.item_count > 0
+WHERE c.user_id IS NOT NULL
+  AND act.last_activity_at <= sqlc.arg('idle_before')::timestamp
+  AND NOT EXISTS (
+    SELECT 1 FROM orders o
+    WHERE o.user_id = c.user_id AND o.created_at >= act.last_activity_at
+  );
+
+-- name: ListAbandonedCarts :many
+-- Cart dengan nilai terbesar di atas
+SELECT
+  c.id AS cart_id,
+  c.user_id::uuid AS user_id,
+  u.email,
+  act.last_activity_at,
+  act.item_count,
+  act.cart_value,
+  (
+    SELECT COUNT(*) FROM cart_reminders cr
+    WHERE cr.cart_id = c.id AND cr.cart_activity_at = act.last_activity_at
+  )::int AS reminders_sent
+FROM carts c
+JOIN users u ON u.id = c.user_id
+JOIN LATERAL (
+  SELECT
+    MAX(ci.updated_at)::timestamp AS last_activity_at,
+    COALESCE(SUM(ci.quantity), 0)::int AS item_count,
+    COALESCE(SUM(ci.quantity * ci.price_at_add), 0)::decimal AS cart_value
+  FROM cart_items ci
+  WHERE ci.cart_id = c.id
+) act ON act.item_count > 0
+WHERE c.user_id IS NOT NULL
+  AND act.last_activity_at <= sqlc.arg('idle_before')::timestamp
+  AND NOT EXISTS (
+    SELECT 1 FROM orders o
+    WHERE o.user_id = c.user_id AND o.created_at >= act.last_activity_at
+  )
+ORDER BY act.cart_value DESC, act.last_activity_at ASC
+LIMIT sqlc.arg('limit')::int OFFSET sqlc.arg('offset')::int;
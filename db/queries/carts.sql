@@ -1,32 +1,142 @@
 -- name: CreateCart :one
 INSERT INTO carts (user_id)
-VALUES ($1)
+VALUES (sqlc.arg('user_id')::uuid)
 ON CONFLICT (user_id) DO UPDATE SET user_id = EXCLUDED.user_id
 RETURNING *;
 
 -- name: GetCartByUserID :one
 SELECT *
 FROM carts
-WHERE user_id = $1
+WHERE user_id = sqlc.arg('user_id')::uuid
 LIMIT 1;
 
+-- name: CreateGuestCart :one
+-- Dipanggil setiap guest menambah item: sekaligus memperpanjang masa berlaku cart
+INSERT INTO carts (guest_id, expires_at)
+VALUES (sqlc.arg('guest_id')::uuid, sqlc.arg('expires_at')::timestamp)
+ON CONFLICT (guest_id) DO UPDATE
+SET expires_at = EXCLUDED.expires_at,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: GetCartByGuestID :one
+SELECT *
+FROM carts
+WHERE guest_id = sqlc.arg('guest_id')::uuid
+  AND expires_at > NOW()
+LIMIT 1;
+
+-- name: LockGuestCart :one
+-- Merge: kunci cart guest agar login paralel tidak menggabungkan item dua kali
+SELECT *
+FROM carts
+WHERE guest_id = sqlc.arg('guest_id')::uuid
+  AND expires_at > NOW()
+FOR UPDATE;
+
+-- name: MergeCartItems :execrows
+-- Pindahkan item cart guest ke cart user: qty dijumlah lalu dibatasi stok produk & max_qty.
+-- Produk yang dihapus / nonaktif / di luar jadwal tayang / stok habis dilewati.
+INSERT INTO cart_items (cart_id, product_id, quantity, price_at_add)
+SELECT sqlc.arg('target_cart_id')::uuid, gi.product_id, LEAST(gi.quantity, p.stock, sqlc.arg('max_qty')::int), gi.price_at_add
+FROM cart_items gi
+JOIN products p ON p.id = gi.product_id
+WHERE gi.cart_id = sqlc.arg('source_cart_id')::uuid
+  AND p.deleted_at IS NULL
+  AND p.is_active = true
+  AND (p.published_at IS NULL OR p.published_at <= NOW())
+  AND (p.unpublished_at IS NULL OR p.unpublished_at > NOW())
+  AND p.stock > 0
+ON CONFLICT (cart_id, product_id)
+DO UPDATE SET
+  quantity = LEAST(
+    cart_items.quantity + EXCLUDED.quantity,
+    (SELECT stock FROM products WHERE id = EXCLUDED.product_id),
+    sqlc.arg('max_qty')::int
+  ),
+  updated_at = NOW();
+
+-- name: DeleteExpiredGuestCarts :execrows
+-- cart_items ikut terhapus (ON DELETE CASCADE)
+DELETE FROM carts
+WHERE id IN (
+  SELECT id FROM carts
+  WHERE guest_id IS NOT NULL
+    AND expires_at <= sqlc.arg('before')::timestamp
+  ORDER BY expires_at
+  LIMIT sqlc.arg('batch_size')::int
+);
+
 -- name: CountCartItems :one
 SELECT COALESCE(SUM(quantity), 0)::bigint
 FROM cart_items
 WHERE cart_id = $1;
 
--- name: AddCartItem :exec
+-- name: AddCartItem :one
+-- Qty akhir (baru / dijumlah dengan yang sudah ada) dibatasi max_qty & stok produk.
+-- price_at_add selalu diambil dari harga efektif produk (jadwal harga aktif / harga dasar), bukan dari client.
+-- Tidak ada row = produk tidak tersedia (termasuk di luar jadwal tayang) atau qty melebihi batas.
 INSERT INTO cart_items (cart_id, product_id, quantity, price_at_add)
-VALUES ($1, $2, $3, $4)
+SELECT sqlc.arg('cart_id')::uuid, p.id, sqlc.arg('quantity')::int, ROUND(COALESCE(ap.price, p.price))::int
+FROM products p
+LEFT JOIN LATERAL (
+  SELECT pp.price
+  FROM product_prices pp
+  WHERE pp.product_id = p.id
+    AND pp.starts_at <= NOW()
+    AND (pp.ends_at IS NULL OR pp.ends_at > NOW())
+  ORDER BY pp.starts_at DESC
+  LIMIT 1
+) ap ON true
+WHERE p.id = sqlc.arg('product_id')::uuid
+  AND p.deleted_at IS NULL
+  AND p.is_active = true
+  AND (p.published_at IS NULL OR p.published_at <= NOW())
+  AND (p.unpublished_at IS NULL OR p.unpublished_at > NOW())
+  AND sqlc.arg('quantity')::int <= LEAST(sqlc.arg('max_qty')::int, p.stock)
 ON CONFLICT (cart_id, product_id)
 DO UPDATE SET
   quantity = cart_items.quantity + EXCLUDED.quantity,
-  updated_at = NOW();
+  updated_at = NOW()
+WHERE cart_items.quantity + EXCLUDED.quantity <= LEAST(
+  sqlc.arg('max_qty')::int,
+  (SELECT stock FROM products WHERE id = EXCLUDED.product_id)
+)
+RETURNING cart_items.*;
+
+-- name: GetCartItem :one
+SELECT * FROM cart_items
+WHERE cart_id = $1 AND product_id = $2;
 
 -- name: UpdateCartItemQty :one
+-- Set qty absolut; menaikkan qty dibatasi max_qty & stok, menurunkan selalu boleh
+UPDATE cart_items
+SET quantity = sqlc.arg('quantity')::int, updated_at = NOW()
+WHERE cart_id = sqlc.arg('cart_id')::uuid
+  AND product_id = sqlc.arg('product_id')::uuid
+  AND (
+    sqlc.arg('quantity')::int <= quantity
+    OR sqlc.arg('quantity')::int <= LEAST(
+      sqlc.arg('max_qty')::int,
+      COALESCE((SELECT stock FROM products WHERE id = cart_items.product_id), 0)
+    )
+  )
+RETURNING *;
+
+-- name: AdjustCartItemQty :one
+-- Ubah qty relatif secara atomic (quantity = quantity + delta).
+-- delta > 0 dibatasi max_qty & stok; hasil <= 0 tetap dikembalikan, service yang menghapus item.
 UPDATE cart_items
-SET quantity = $3, updated_at = NOW()
-WHERE cart_id = $1 AND product_id = $2
+SET quantity = quantity + sqlc.arg('delta')::int, updated_at = NOW()
+WHERE cart_id = sqlc.arg('cart_id')::uuid
+  AND product_id = sqlc.arg('product_id')::uuid
+  AND (
+    sqlc.arg('delta')::int <= 0
+    OR quantity + sqlc.arg('delta')::int <= LEAST(
+      sqlc.arg('max_qty')::int,
+      COALESCE((SELECT stock FROM products WHERE id = cart_items.product_id), 0)
+    )
+  )
 RETURNING *;
 
 -- name: DeleteCartItem :exec
@@ -38,13 +148,35 @@ DELETE FROM carts
 WHERE id = $1;
 
 -- name: GetCartDetail :many
+-- Data produk live (harga aktif, stok, status); LEFT JOIN agar item dengan produk terhapus tetap muncul
 SELECT
   ci.id,
   ci.product_id,
   ci.quantity,
   ci.price_at_add,
-  ci.created_at
-FROM carts c
-JOIN cart_items ci ON ci.cart_id = c.id
-WHERE c.user_id = $1
+  ci.created_at,
+  COALESCE(p.name, '')::text AS product_name,
+  COALESCE(p.slug, '')::text AS product_slug,
+  COALESCE(p.image_url, '')::text AS image_url,
+  COALESCE(ap.price, p.price, 0)::decimal AS current_price,
+  COALESCE(p.stock, 0)::int AS stock,
+  (p.id IS NOT NULL AND p.deleted_at IS NULL)::boolean AS product_exists,
+  (
+    COALESCE(p.is_active, false)
+    AND (p.published_at IS NULL OR p.published_at <= NOW())
+    AND (p.unpublished_at IS NULL OR p.unpublished_at > NOW())
+  )::boolean AS is_active
+FROM cart_items ci
+LEFT JOIN products p ON p.id = ci.product_id
+LEFT JOIN LATERAL (
+  -- Jadwal harga aktif; jika overlap, yang mulai paling akhir menang
+  SELECT pp.price
+  FROM product_prices pp
+  WHERE pp.product_id = p.id
+    AND pp.starts_at <= NOW()
+    AND (pp.ends_at IS NULL OR pp.ends_at > NOW())
+  ORDER BY pp.starts_at DESC
+  LIMIT 1
+) ap ON true
+WHERE ci.cart_id = $1
 ORDER BY ci.created_at DESC;
@@ -45,13 +45,14 @@ SELECT * FROM categories WHERE id = $1 AND deleted_at IS NULL LIMIT 1;
 SELECT * FROM categories WHERE slug = $1 AND deleted_at IS NULL LIMIT 1;
 
 -- name: CreateCategory :one
-INSERT INTO categories (name, slug, description, image_url)
-VALUES ($1, $2, $3, $4)
+INSERT INTO categories (name, slug, description, image_url, meta_title, meta_description, parent_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
 RETURNING *;
 
 -- name: UpdateCategory :one
 UPDATE categories 
-SET name = $2, slug = $3, description = $4, image_url = $5, is_active = $6, updated_at = NOW()
+SET name = $2, slug = $3, description = $4, image_url = $5, is_active = $6,
+    meta_title = $7, meta_description = $8, parent_id = $9, updated_at = NOW()
 WHERE id = $1 AND deleted_at IS NULL
 RETURNING *;
 
@@ -59,4 +60,55 @@ RETURNING *;
 UPDATE categories SET deleted_at = NOW() WHERE id = $1;
 
 -- name: RestoreCategory :one
-UPDATE categories SET deleted_at = NULL WHERE id = $1 RETURNING *;
\ No newline at end of file
+UPDATE categories SET deleted_at = NULL WHERE id = $1 RETURNING *;
+
+-- name: CategorySlugExists :one
+SELECT EXISTS (
+    SELECT 1 FROM categories t
+    WHERE t.slug = sqlc.arg('slug')::text AND t.id <> sqlc.arg('exclude_id')::uuid
+    UNION ALL
+    SELECT 1 FROM slug_redirects r
+    WHERE r.entity_type = 'category' AND r.old_slug = sqlc.arg('slug')::text AND r.entity_id <> sqlc.arg('exclude_id')::uuid
+) AS slug_exists;
+
+-- name: GetCategorySlugRedirect :one
+SELECT t.slug
+FROM slug_redirects r
+JOIN categories t ON t.id = r.entity_id
+WHERE r.entity_type = 'category' AND r.old_slug = $1 AND t.deleted_at IS NULL
+LIMIT 1;
+
+-- name: ListCategoryTree :many
+-- Semua kategori aktif, dirangkai menjadi tree di service
+SELECT id, parent_id, name, slug, image_url
+FROM categories
+WHERE deleted_at IS NULL AND is_active = true
+ORDER BY name ASC;
+
+-- name: IsCategoryDescendant :one
+-- TRUE jika candidate_id adalah category_id sendiri atau turunannya (cegah siklus)
+WITH RECURSIVE descendants AS (
+    SELECT c.id FROM categories c WHERE c.id = sqlc.arg('category_id')::uuid
+    UNION
+    SELECT ch.id FROM categories ch
+    JOIN descendants d ON ch.parent_id = d.id
+)
+SELECT EXISTS (
+    SELECT 1 FROM descendants WHERE id = sqlc.arg('candidate_id')::uuid
+) AS is_descendant;
+
+-- name: GetCategoryBreadcrumbs :many
+-- Jalur dari root sampai kategori tersebut
+WITH RECURSIVE ancestors AS (
+    SELECT c.id, c.parent_id, c.name, c.slug, 0::int AS depth
+    FROM categories c
+    WHERE c.id = $1
+    UNION ALL
+    SELECT p.id, p.parent_id, p.name, p.slug, a.depth + 1
+    FROM categories p
+    JOIN ancestors a ON p.id = a.parent_id
+    WHERE a.depth < 20
+)
+SELECT id, name, slug
+FROM ancestors
+ORDER BY depth DESC;
@@ -0,0 +1,6 @@
+-- name: TryAdvisoryLock :one
+-- Lock level session: harus dipanggil & dilepas di koneksi yang sama
+SELECT pg_try_advisory_lock(sqlc.arg('key')::bigint)::boolean AS acquired;
+
+-- name: AdvisoryUnlock :exec
+SELECT pg_advisory_unlock(sqlc.arg('key')::bigint);
@@ -0,0 +1,23 @@
+-- name: CreateNotification :exec
+INSERT INTO notifications (user_id, channel, type, recipient, subject, body)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: ListPendingNotifications :many
+SELECT * FROM notifications
+WHERE status = 'PENDING'
+ORDER BY created_at ASC
+LIMIT $1;
+
+-- name: MarkNotificationSent :exec
+UPDATE notifications
+SET status = 'SENT', attempts = attempts + 1, last_error = NULL, sent_at = NOW()
+WHERE id = $1;
+
+-- name: MarkNotificationFailed :exec
+-- Gagal kirim: dicoba lagi di run berikutnya sampai max_attempts
+UPDATE notifications
+SET
+  attempts = attempts + 1,
+  last_error = sqlc.arg('last_error'),
+  status = CASE WHEN attempts + 1 >= sqlc.arg('max_attempts')::int THEN 'FAILED' ELSE 'PENDING' END
+WHERE id = sqlc.arg('id');
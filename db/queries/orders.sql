@@ -1,14 +1,17 @@
 -- name: CreateOrder :one
 INSERT INTO orders (
     order_number, user_id, status, address_snapshot, 
-    subtotal_price, shipping_price, total_price, note, placed_at
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW())
+    subtotal_price, shipping_price, total_price, note,
+    discount_price, voucher_code, shipping_courier, shipping_service,
+    tax_price, prices_include_tax, placed_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, NOW())
 RETURNING *;
 
 -- name: CreateOrderItem :exec
 INSERT INTO order_items (
-    order_id, product_id, name_snapshot, unit_price, quantity, total_price
-) VALUES ($1, $2, $3, $4, $5, $6);
+    order_id, product_id, name_snapshot, unit_price, quantity, total_price,
+    tax_rate, tax_amount
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
 
 -- name: ListOrders :many
 SELECT o.*, count(*) OVER() AS total_count
@@ -26,12 +29,57 @@ WHERE (sqlc.narg('status')::text IS NULL OR o.status = sqlc.narg('status')::text
 ORDER BY o.placed_at DESC
 LIMIT $1 OFFSET $2;
 
+-- name: ListOrdersKeyset :many
+SELECT o.*
+FROM orders o
+WHERE o.user_id = sqlc.arg('user_id')
+  AND (sqlc.narg('status')::text IS NULL OR o.status = sqlc.narg('status')::text)
+  AND (
+    sqlc.narg('cursor_id')::uuid IS NULL
+    OR (sqlc.arg('sort_dir')::text = 'desc'
+        AND (o.placed_at, o.id) < (sqlc.narg('cursor_placed_at')::timestamp, sqlc.narg('cursor_id')::uuid))
+    OR (sqlc.arg('sort_dir')::text = 'asc'
+        AND (o.placed_at, o.id) > (sqlc.narg('cursor_placed_at')::timestamp, sqlc.narg('cursor_id')::uuid))
+  )
+ORDER BY
+    CASE WHEN sqlc.arg('sort_dir')::text = 'asc' THEN o.placed_at END ASC,
+    CASE WHEN sqlc.arg('sort_dir')::text = 'asc' THEN o.id END ASC,
+    o.placed_at DESC,
+    o.id DESC
+LIMIT $1;
+
+-- name: ListOrdersAdminKeyset :many
+SELECT o.*
+FROM orders o
+WHERE (sqlc.narg('status')::text IS NULL OR o.status = sqlc.narg('status')::text)
+  AND (sqlc.narg('search')::text IS NULL OR o.order_number ILIKE '%' || sqlc.narg('search')::text || '%')
+  AND (
+    sqlc.narg('cursor_id')::uuid IS NULL
+    OR (sqlc.arg('sort_dir')::text = 'desc'
+        AND (o.placed_at, o.id) < (sqlc.narg('cursor_placed_at')::timestamp, sqlc.narg('cursor_id')::uuid))
+    OR (sqlc.arg('sort_dir')::text = 'asc'
+        AND (o.placed_at, o.id) > (sqlc.narg('cursor_placed_at')::timestamp, sqlc.narg('cursor_id')::uuid))
+  )
+ORDER BY
+    CASE WHEN sqlc.arg('sort_dir')::text = 'asc' THEN o.placed_at END ASC,
+    CASE WHEN sqlc.arg('sort_dir')::text = 'asc' THEN o.id END ASC,
+    o.placed_at DESC,
+    o.id DESC
+LIMIT $1;
+
 -- name: GetOrderByID :one
 SELECT * FROM orders WHERE id = $1 LIMIT 1;
 
 -- name: GetOrderItems :many
 SELECT * FROM order_items WHERE order_id = $1;
 
+-- name: SetOrderReceiptNo :one
+UPDATE orders
+SET receipt_no = $2,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
 -- name: UpdateOrderStatus :one
 UPDATE orders 
 SET status = $2, 
@@ -39,4 +87,64 @@ SET status = $2,
     completed_at = CASE WHEN $2 = 'COMPLETED' THEN NOW() ELSE completed_at END,
     cancelled_at = CASE WHEN $2 = 'CANCELLED' THEN NOW() ELSE cancelled_at END
 WHERE id = $1
-RETURNING *;
\ No newline at end of file
+RETURNING *;
+-- name: ListExpiredPendingOrderIDs :many
+-- Order PENDING yang belum dibayar melewati TTL (diproses per batch oleh scheduler)
+SELECT id FROM orders
+WHERE status = 'PENDING'
+  AND payment_status = 'UNPAID'
+  AND placed_at < sqlc.arg('cutoff')::timestamp
+ORDER BY placed_at
+LIMIT sqlc.arg('batch_size')::int;
+
+-- name: ExpireOrder :one
+-- Guard status: order yang keburu dibayar tidak ikut dibatalkan
+UPDATE orders
+SET status = 'CANCELLED',
+    cancel_reason = sqlc.arg('cancel_reason'),
+    cancelled_at = NOW(),
+    updated_at = NOW()
+WHERE id = sqlc.arg('id')
+  AND status = 'PENDING'
+  AND payment_status = 'UNPAID'
+RETURNING *;
+
+-- name: CancelOrder :one
+-- Guard status: cancel ganda / balapan dengan expire / pembayaran masuk tidak mengubah apa pun
+UPDATE orders
+SET status = 'CANCELLED',
+    cancelled_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1
+  AND status = 'PENDING'
+  AND payment_status = 'UNPAID'
+RETURNING *;
+
+-- name: CompleteOrder :one
+-- Guard status: customer hanya boleh menyelesaikan order yang sudah DELIVERED
+UPDATE orders
+SET status = 'COMPLETED',
+    completed_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1
+  AND status = 'DELIVERED'
+RETURNING *;
+
+-- name: CompleteDeliveredOrders :many
+-- Waktu terima diambil dari shipment, fallback ke updated_at order
+UPDATE orders
+SET status = 'COMPLETED',
+    completed_at = NOW(),
+    updated_at = NOW()
+WHERE id IN (
+    SELECT o.id
+    FROM orders o
+    LEFT JOIN shipments s ON s.order_id = o.id
+    WHERE o.status = 'DELIVERED'
+      AND COALESCE(s.delivered_at, o.updated_at) < sqlc.arg('cutoff')::timestamp
+    ORDER BY o.updated_at
+    LIMIT sqlc.arg('batch_size')::int
+    FOR UPDATE OF o SKIP LOCKED
+)
+  AND status = 'DELIVERED'
+RETURNING *;
@@ -0,0 +1,15 @@
+-- name: CreateProductPrice :one
+INSERT INTO product_prices (product_id, price, compare_at_price, starts_at, ends_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: ListProductPrices :many
+-- Seluruh jadwal harga (lampau, aktif, mendatang), terbaru di atas
+SELECT * FROM product_prices
+WHERE product_id = $1
+ORDER BY starts_at DESC, created_at DESC;
+
+-- name: DeleteProductPrice :one
+DELETE FROM product_prices
+WHERE id = $1 AND product_id = $2
+RETURNING id;
@@ -0,0 +1,90 @@
+-- name: GetSubscribableProduct :one
+-- Stok dan harga aktif saat ini (acuan langganan baru)
+SELECT
+  p.id,
+  p.name,
+  p.stock,
+  COALESCE(ap.price, p.price)::decimal AS current_price
+FROM products p
+LEFT JOIN LATERAL (
+  SELECT pp.price
+  FROM product_prices pp
+  WHERE pp.product_id = p.id
+    AND pp.starts_at <= NOW()
+    AND (pp.ends_at IS NULL OR pp.ends_at > NOW())
+  ORDER BY pp.starts_at DESC
+  LIMIT 1
+) ap ON true
+WHERE p.id = $1 AND p.deleted_at IS NULL;
+
+-- name: UpsertProductSubscription :one
+-- Subscribe ulang = reset acuan harga dan status kirim
+INSERT INTO product_subscriptions (user_id, product_id, type, price_at_subscribe)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (user_id, product_id, type)
+DO UPDATE SET
+  price_at_subscribe = EXCLUDED.price_at_subscribe,
+  notified_at = NULL,
+  updated_at = NOW()
+RETURNING *;
+
+-- name: DeleteProductSubscription :execrows
+DELETE FROM product_subscriptions
+WHERE id = $1 AND user_id = $2;
+
+-- name: ListProductSubscriptionsByUser :many
+SELECT
+  s.id,
+  s.product_id,
+  s.type,
+  s.price_at_subscribe,
+  s.notified_at,
+  s.created_at,
+  p.name AS product_name,
+  p.slug AS product_slug
+FROM product_subscriptions s
+JOIN products p ON p.id = s.product_id
+WHERE s.user_id = $1
+ORDER BY s.created_at DESC;
+
+-- name: ClaimBackInStockSubscriptions :many
+-- Tandai terkirim sekaligus ambil penerima (dipanggil di transaksi perubahan stok)
+UPDATE product_subscriptions s
+SET notified_at = NOW(), updated_at = NOW()
+FROM users u, products p
+WHERE s.product_id = $1
+  AND s.type = 'BACK_IN_STOCK'
+  AND s.notified_at IS NULL
+  AND u.id = s.user_id
+  AND p.id = s.product_id
+RETURNING s.id, s.user_id, u.email, p.name AS product_name, p.slug AS product_slug;
+
+-- name: ClaimPriceDropSubscriptions :many
+-- Harga aktif < harga saat subscribe; product_id NULL = sapu semua produk (jadwal harga yang baru mulai)
+UPDATE product_subscriptions s
+SET notified_at = NOW(), updated_at = NOW()
+FROM users u, products p
+LEFT JOIN LATERAL (
+  SELECT pp.price
+  FROM product_prices pp
+  WHERE pp.product_id = p.id
+    AND pp.starts_at <= NOW()
+    AND (pp.ends_at IS NULL OR pp.ends_at > NOW())
+  ORDER BY pp.starts_at DESC
+  LIMIT 1
+) ap ON true
+WHERE (sqlc.narg('product_id')::uuid IS NULL OR s.product_id = sqlc.narg('product_id')::uuid)
+  AND s.type = 'PRICE_DROP'
+  AND s.notified_at IS NULL
+  AND u.id = s.user_id
+  AND p.id = s.product_id
+  AND p.deleted_at IS NULL
+  AND COALESCE(ap.price, p.price) < s.price_at_subscribe
+RETURNING
+  s.id,
+  s.user_id,
+  u.email,
+  p.name AS product_name,
+  p.slug AS product_slug,
+  s.price_at_subscribe,
+  COALESCE(ap.price, p.price)::decimal AS current_price;
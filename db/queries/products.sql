@@ -1,23 +1,119 @@
 
 -- name: ListProductsPublic :many
-SELECT p.*, c.name as category_name, count(*) OVER() AS total_count
+-- Filter kategori mencakup seluruh sub-kategori (recursive CTE)
+WITH RECURSIVE category_tree AS (
+    SELECT c.id FROM categories c WHERE c.id = sqlc.narg('category_id')::uuid
+    UNION
+    SELECT ch.id FROM categories ch
+    JOIN category_tree t ON ch.parent_id = t.id
+    WHERE ch.deleted_at IS NULL
+)
+SELECT
+    p.*,
+    c.name as category_name,
+    COALESCE(ap.price, p.price)::decimal AS effective_price,
+    ap.compare_at_price,
+    count(*) OVER() AS total_count
 FROM products p
 JOIN categories c ON p.category_id = c.id
+LEFT JOIN LATERAL (
+    -- Jadwal harga aktif; jika overlap, yang mulai paling akhir menang
+    SELECT pp.price, pp.compare_at_price
+    FROM product_prices pp
+    WHERE pp.product_id = p.id
+      AND pp.starts_at <= NOW()
+      AND (pp.ends_at IS NULL OR pp.ends_at > NOW())
+    ORDER BY pp.starts_at DESC
+    LIMIT 1
+) ap ON true
 WHERE p.deleted_at IS NULL 
   AND p.is_active = true
+  AND (p.published_at IS NULL OR p.published_at <= NOW())
+  AND (p.unpublished_at IS NULL OR p.unpublished_at > NOW())
   -- Gunakan sintaks ini agar sqlc membuat field CategoryID (NullUUID)
-  AND (sqlc.narg('category_id')::uuid IS NULL OR p.category_id = sqlc.narg('category_id')::uuid)
-  AND (sqlc.narg('search')::text IS NULL OR p.name ILIKE '%' || sqlc.narg('search')::text || '%')
-  AND (p.price >= sqlc.arg('min_price')::decimal)
-  AND (p.price <= sqlc.arg('max_price')::decimal)
+  AND (sqlc.narg('category_id')::uuid IS NULL OR p.category_id IN (SELECT id FROM category_tree))
+  -- Full-text (prefix) match, fallback ke trigram similarity untuk typo
+  AND (
+    sqlc.narg('search')::text IS NULL
+    OR p.search_vector @@ to_tsquery('simple', sqlc.narg('search_query')::text)
+    OR p.name % sqlc.narg('search')::text
+  )
+  AND (COALESCE(ap.price, p.price) >= sqlc.arg('min_price')::decimal)
+  AND (COALESCE(ap.price, p.price) <= sqlc.arg('max_price')::decimal)
+  AND p.rating_avg >= sqlc.arg('min_rating')::float8
 ORDER BY 
+    CASE WHEN sqlc.arg('sort_by')::text = 'relevance' THEN
+        ts_rank(p.search_vector, to_tsquery('simple', sqlc.narg('search_query')::text))
+        + similarity(p.name, sqlc.narg('search')::text)
+    END DESC,
     CASE WHEN sqlc.arg('sort_by')::text = 'newest' THEN p.created_at END DESC,
     CASE WHEN sqlc.arg('sort_by')::text = 'oldest' THEN p.created_at END ASC,
-    CASE WHEN sqlc.arg('sort_by')::text = 'price_high' THEN p.price END DESC,
-    CASE WHEN sqlc.arg('sort_by')::text = 'price_low' THEN p.price END ASC,
+    CASE WHEN sqlc.arg('sort_by')::text = 'price_high' THEN COALESCE(ap.price, p.price) END DESC,
+    CASE WHEN sqlc.arg('sort_by')::text = 'price_low' THEN COALESCE(ap.price, p.price) END ASC,
+    CASE WHEN sqlc.arg('sort_by')::text = 'rating' THEN p.rating_avg END DESC,
+    CASE WHEN sqlc.arg('sort_by')::text = 'rating' THEN p.rating_count END DESC,
     p.created_at DESC
 LIMIT $1 OFFSET $2;
 
+-- name: ListProductsPublicKeyset :many
+-- Keyset pagination: sort_by sudah dinormalisasi service (arah dibalik untuk prev page)
+WITH RECURSIVE category_tree AS (
+    SELECT c.id FROM categories c WHERE c.id = sqlc.narg('category_id')::uuid
+    UNION
+    SELECT ch.id FROM categories ch
+    JOIN category_tree t ON ch.parent_id = t.id
+    WHERE ch.deleted_at IS NULL
+)
+SELECT
+    p.*,
+    c.name as category_name,
+    COALESCE(ap.price, p.price)::decimal AS effective_price,
+    ap.compare_at_price
+FROM products p
+JOIN categories c ON p.category_id = c.id
+LEFT JOIN LATERAL (
+    -- Jadwal harga aktif; jika overlap, yang mulai paling akhir menang
+    SELECT pp.price, pp.compare_at_price
+    FROM product_prices pp
+    WHERE pp.product_id = p.id
+      AND pp.starts_at <= NOW()
+      AND (pp.ends_at IS NULL OR pp.ends_at > NOW())
+    ORDER BY pp.starts_at DESC
+    LIMIT 1
+) ap ON true
+WHERE p.deleted_at IS NULL 
+  AND p.is_active = true
+  AND (p.published_at IS NULL OR p.published_at <= NOW())
+  AND (p.unpublished_at IS NULL OR p.unpublished_at > NOW())
+  AND (sqlc.narg('category_id')::uuid IS NULL OR p.category_id IN (SELECT id FROM category_tree))
+  AND (
+    sqlc.narg('search')::text IS NULL
+    OR p.search_vector @@ to_tsquery('simple', sqlc.narg('search_query')::text)
+    OR p.name % sqlc.narg('search')::text
+  )
+  AND (COALESCE(ap.price, p.price) >= sqlc.arg('min_price')::decimal)
+  AND (COALESCE(ap.price, p.price) <= sqlc.arg('max_price')::decimal)
+  AND p.rating_avg >= sqlc.arg('min_rating')::float8
+  AND (
+    sqlc.narg('cursor_id')::uuid IS NULL
+    OR (sqlc.arg('sort_by')::text = 'newest'
+        AND (p.created_at, p.id) < (sqlc.narg('cursor_created_at')::timestamp, sqlc.narg('cursor_id')::uuid))
+    OR (sqlc.arg('sort_by')::text = 'oldest'
+        AND (p.created_at, p.id) > (sqlc.narg('cursor_created_at')::timestamp, sqlc.narg('cursor_id')::uuid))
+    OR (sqlc.arg('sort_by')::text = 'price_high'
+        AND (COALESCE(ap.price, p.price), p.id) < (sqlc.narg('cursor_price')::decimal, sqlc.narg('cursor_id')::uuid))
+    OR (sqlc.arg('sort_by')::text = 'price_low'
+        AND (COALESCE(ap.price, p.price), p.id) > (sqlc.narg('cursor_price')::decimal, sqlc.narg('cursor_id')::uuid))
+  )
+ORDER BY 
+    CASE WHEN sqlc.arg('sort_by')::text = 'newest' THEN p.created_at END DESC,
+    CASE WHEN sqlc.arg('sort_by')::text = 'oldest' THEN p.created_at END ASC,
+    CASE WHEN sqlc.arg('sort_by')::text = 'price_high' THEN COALESCE(ap.price, p.price) END DESC,
+    CASE WHEN sqlc.arg('sort_by')::text = 'price_low' THEN COALESCE(ap.price, p.price) END ASC,
+    CASE WHEN sqlc.arg('sort_by')::text IN ('oldest', 'price_low') THEN p.id END ASC,
+    p.id DESC
+LIMIT $1;
+
 -- name: ListProductsAdmin :many
 SELECT
     p.*,
@@ -68,6 +164,49 @@ ORDER BY
 LIMIT $1 OFFSET $2;
 
 
+-- name: ListProductsAdminKeyset :many
+SELECT p.*, c.name AS category_name
+FROM products p
+JOIN categories c ON p.category_id = c.id
+WHERE
+    (sqlc.narg('category_id')::uuid IS NULL OR p.category_id = sqlc.narg('category_id')::uuid)
+    AND (
+        sqlc.narg('search')::text IS NULL
+        OR p.name ILIKE '%' || sqlc.narg('search')::text || '%'
+        OR p.sku  ILIKE '%' || sqlc.narg('search')::text || '%'
+    )
+    AND (
+        sqlc.narg('cursor_id')::uuid IS NULL
+        OR (sqlc.arg('sort_col')::text = 'name' AND sqlc.arg('sort_dir')::text = 'asc'
+            AND (p.name, p.id) > (sqlc.narg('cursor_name')::text, sqlc.narg('cursor_id')::uuid))
+        OR (sqlc.arg('sort_col')::text = 'name' AND sqlc.arg('sort_dir')::text = 'desc'
+            AND (p.name, p.id) < (sqlc.narg('cursor_name')::text, sqlc.narg('cursor_id')::uuid))
+        OR (sqlc.arg('sort_col')::text = 'stock' AND sqlc.arg('sort_dir')::text = 'asc'
+            AND (p.stock, p.id) > (sqlc.narg('cursor_stock')::int, sqlc.narg('cursor_id')::uuid))
+        OR (sqlc.arg('sort_col')::text = 'stock' AND sqlc.arg('sort_dir')::text = 'desc'
+            AND (p.stock, p.id) < (sqlc.narg('cursor_stock')::int, sqlc.narg('cursor_id')::uuid))
+        OR (sqlc.arg('sort_col')::text = 'price' AND sqlc.arg('sort_dir')::text = 'asc'
+            AND (p.price, p.id) > (sqlc.narg('cursor_price')::decimal, sqlc.narg('cursor_id')::uuid))
+        OR (sqlc.arg('sort_col')::text = 'price' AND sqlc.arg('sort_dir')::text = 'desc'
+            AND (p.price, p.id) < (sqlc.narg('cursor_price')::decimal, sqlc.narg('cursor_id')::uuid))
+        OR (sqlc.arg('sort_col')::text = 'created_at' AND sqlc.arg('sort_dir')::text = 'asc'
+            AND (p.created_at, p.id) > (sqlc.narg('cursor_created_at')::timestamp, sqlc.narg('cursor_id')::uuid))
+        OR (sqlc.arg('sort_col')::text = 'created_at' AND sqlc.arg('sort_dir')::text = 'desc'
+            AND (p.created_at, p.id) < (sqlc.narg('cursor_created_at')::timestamp, sqlc.narg('cursor_id')::uuid))
+    )
+ORDER BY
+    CASE WHEN sqlc.arg('sort_col')::text = 'name' AND sqlc.arg('sort_dir')::text = 'asc' THEN p.name END ASC,
+    CASE WHEN sqlc.arg('sort_col')::text = 'name' AND sqlc.arg('sort_dir')::text = 'desc' THEN p.name END DESC,
+    CASE WHEN sqlc.arg('sort_col')::text = 'stock' AND sqlc.arg('sort_dir')::text = 'asc' THEN p.stock END ASC,
+    CASE WHEN sqlc.arg('sort_col')::text = 'stock' AND sqlc.arg('sort_dir')::text = 'desc' THEN p.stock END DESC,
+    CASE WHEN sqlc.arg('sort_col')::text = 'price' AND sqlc.arg('sort_dir')::text = 'asc' THEN p.price END ASC,
+    CASE WHEN sqlc.arg('sort_col')::text = 'price' AND sqlc.arg('sort_dir')::text = 'desc' THEN p.price END DESC,
+    CASE WHEN sqlc.arg('sort_col')::text = 'created_at' AND sqlc.arg('sort_dir')::text = 'asc' THEN p.created_at END ASC,
+    CASE WHEN sqlc.arg('sort_col')::text = 'created_at' AND sqlc.arg('sort_dir')::text = 'desc' THEN p.created_at END DESC,
+    CASE WHEN sqlc.arg('sort_dir')::text = 'asc' THEN p.id END ASC,
+    p.id DESC
+LIMIT $1;
+
 -- name: GetProductByID :one
 SELECT p.*, c.name as category_name 
 FROM products p
@@ -75,33 +214,185 @@ JOIN categories c ON p.category_id = c.id
 WHERE p.id = $1 AND p.deleted_at IS NULL LIMIT 1;
 
 -- name: GetProductBySlug :one
-SELECT p.*, c.name as category_name 
+-- Publik: hanya produk dalam jendela tayang
+SELECT
+    p.*,
+    c.name as category_name,
+    b.name as brand_name,
+    COALESCE(ap.price, p.price)::decimal AS effective_price,
+    ap.compare_at_price
 FROM products p
 JOIN categories c ON p.category_id = c.id
-WHERE p.slug = $1 AND p.deleted_at IS NULL LIMIT 1;
+LEFT JOIN brands b ON p.brand_id = b.id
+LEFT JOIN LATERAL (
+    -- Jadwal harga aktif; jika overlap, yang mulai paling akhir menang
+    SELECT pp.price, pp.compare_at_price
+    FROM product_prices pp
+    WHERE pp.product_id = p.id
+      AND pp.starts_at <= NOW()
+      AND (pp.ends_at IS NULL OR pp.ends_at > NOW())
+    ORDER BY pp.starts_at DESC
+    LIMIT 1
+) ap ON true
+WHERE p.slug = $1 AND p.deleted_at IS NULL
+  AND (p.published_at IS NULL OR p.published_at <= NOW())
+  AND (p.unpublished_at IS NULL OR p.unpublished_at > NOW())
+LIMIT 1;
 
 -- name: CreateProduct :one
-INSERT INTO products (category_id, name, slug, description, price, stock, sku, image_url)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+-- Stok awal selalu 0; stok masuk dicatat lewat ledger inventory
+INSERT INTO products (category_id, name, slug, description, price, sku, image_url, brand_id, meta_title, meta_description, published_at, unpublished_at, weight_grams, tax_class)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
 RETURNING *;
 
 -- name: UpdateProduct :one
+-- Stok tidak diubah di sini; gunakan ledger inventory
 UPDATE products
 SET 
     category_id = $2,
     name = $3,
     description = $4,
     price = $5,
-    stock = $6,
-    sku = $7,
-    image_url = $8,
-    is_active = $9,
+    sku = $6,
+    image_url = $7,
+    is_active = $8,
+    brand_id = $9,
+    slug = $10,
+    meta_title = $11,
+    meta_description = $12,
+    published_at = $13,
+    unpublished_at = $14,
+    weight_grams = $15,
+    tax_class = $16,
     updated_at = NOW()
 WHERE id = $1
 RETURNING *;
 
+-- name: LockProductStock :one
+-- Kunci baris produk dalam transaksi; stok terkini untuk menghitung selisih ledger
+SELECT stock FROM products WHERE id = $1 FOR UPDATE;
+
 -- name: SoftDeleteProduct :exec
 UPDATE products SET deleted_at = NOW() WHERE id = $1;
 
 -- name: RestoreProduct :one
-UPDATE products SET deleted_at = NULL WHERE id = $1 RETURNING *;
\ No newline at end of file
+UPDATE products SET deleted_at = NULL WHERE id = $1 RETURNING *;
+
+-- name: SuggestProducts :many
+SELECT p.id, p.name, p.slug, p.image_url
+FROM products p
+WHERE p.deleted_at IS NULL
+  AND p.is_active = true
+  AND (p.published_at IS NULL OR p.published_at <= NOW())
+  AND (p.unpublished_at IS NULL OR p.unpublished_at > NOW())
+  AND (
+    p.search_vector @@ to_tsquery('simple', sqlc.arg('search_query')::text)
+    OR p.name % sqlc.arg('search')::text
+  )
+ORDER BY
+    ts_rank(p.search_vector, to_tsquery('simple', sqlc.arg('search_query')::text)) DESC,
+    similarity(p.name, sqlc.arg('search')::text) DESC,
+    p.name ASC
+LIMIT $1;
+
+-- name: ProductSlugExists :one
+-- Slug dianggap terpakai jika dipakai produk lain (termasuk soft-deleted)
+-- atau masih tercatat sebagai slug lama produk lain di slug_redirects
+SELECT EXISTS (
+    SELECT 1 FROM products p
+    WHERE p.slug = sqlc.arg('slug')::text AND p.id <> sqlc.arg('exclude_id')::uuid
+    UNION ALL
+    SELECT 1 FROM slug_redirects r
+    WHERE r.entity_type = 'product' AND r.old_slug = sqlc.arg('slug')::text AND r.entity_id <> sqlc.arg('exclude_id')::uuid
+) AS slug_exists;
+
+-- name: GetProductSlugRedirect :one
+-- Mengembalikan slug terbaru untuk slug lama (rantai rename langsung ke slug terakhir)
+SELECT p.slug
+FROM slug_redirects r
+JOIN products p ON p.id = r.entity_id
+WHERE r.entity_type = 'product' AND r.old_slug = $1 AND p.deleted_at IS NULL
+LIMIT 1;
+
+-- name: ListProductSKUsExisting :many
+-- SKU yang sudah ada (untuk laporan dry-run: create vs update)
+SELECT sku::text AS sku
+FROM products
+WHERE sku = ANY(sqlc.arg('skus')::text[]);
+
+-- name: UpsertProductsBatch :many
+-- Bulk import: upsert per batch berdasarkan SKU (produk terhapus ikut dipulihkan)
+-- Stok tidak ditulis di sini; selisih ke target stok dicatat lewat ledger inventory.
+-- Slug hanya dipakai untuk produk baru; price_decreased = harga produk lama turun
+WITH input AS (
+    SELECT
+        unnest(sqlc.arg('skus')::text[])::text AS sku,
+        unnest(sqlc.arg('names')::text[])::text AS name,
+        unnest(sqlc.arg('slugs')::text[])::text AS slug,
+        unnest(sqlc.arg('descriptions')::text[])::text AS description,
+        unnest(sqlc.arg('prices')::decimal[])::decimal AS price,
+        unnest(sqlc.arg('category_ids')::uuid[])::uuid AS category_id,
+        unnest(sqlc.arg('brand_ids')::text[])::text AS brand_id,
+        unnest(sqlc.arg('is_actives')::boolean[])::boolean AS is_active
+),
+previous AS (
+    SELECT p.sku, p.price
+    FROM products p
+    JOIN input i ON i.sku = p.sku
+),
+upserted AS (
+    INSERT INTO products (sku, name, slug, description, price, category_id, brand_id, is_active)
+    SELECT
+        i.sku,
+        i.name,
+        i.slug,
+        NULLIF(i.description, ''),
+        i.price,
+        i.category_id,
+        NULLIF(i.brand_id, '')::uuid,
+        i.is_active
+    FROM input i
+    ON CONFLICT (sku) DO UPDATE SET
+        name = EXCLUDED.name,
+        description = EXCLUDED.description,
+        price = EXCLUDED.price,
+        category_id = EXCLUDED.category_id,
+        brand_id = EXCLUDED.brand_id,
+        is_active = EXCLUDED.is_active,
+        deleted_at = NULL,
+        updated_at = NOW()
+    RETURNING id, sku, stock, price, (xmax = 0)::boolean AS inserted
+)
+SELECT
+    u.id,
+    u.sku::text AS sku,
+    u.stock,
+    u.inserted,
+    COALESCE(u.price < pv.price, false)::boolean AS price_decreased
+FROM upserted u
+LEFT JOIN previous pv ON pv.sku = u.sku;
+
+-- name: ExportProducts :many
+-- Kolom sama dengan format import agar file bisa di-roundtrip
+SELECT
+    p.id,
+    p.sku,
+    p.name,
+    p.description,
+    p.price,
+    p.stock,
+    c.slug AS category_slug,
+    b.slug AS brand_slug,
+    p.is_active
+FROM products p
+JOIN categories c ON p.category_id = c.id
+LEFT JOIN brands b ON p.brand_id = b.id
+WHERE p.deleted_at IS NULL
+    AND (sqlc.narg('category_id')::uuid IS NULL OR p.category_id = sqlc.narg('category_id')::uuid)
+    AND (
+        sqlc.narg('search')::text IS NULL
+        OR p.name ILIKE '%' || sqlc.narg('search')::text || '%'
+        OR p.sku  ILIKE '%' || sqlc.narg('search')::text || '%'
+    )
+ORDER BY p.created_at DESC, p.id DESC
+LIMIT $1 OFFSET $2;
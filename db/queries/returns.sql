@@ -0,0 +1,129 @@
+-- name: GetOrderItemForReturn :one
+-- Item dikunci agar pengajuan retur paralel untuk item yang sama diproses berurutan
+SELECT oi.id, oi.order_id, oi.product_id, oi.name_snapshot, oi.unit_price, oi.quantity,
+       o.user_id, o.status AS order_status, o.payment_status
+FROM order_items oi
+JOIN orders o ON o.id = oi.order_id
+WHERE oi.id = sqlc.arg('order_item_id') AND oi.order_id = sqlc.arg('order_id')
+FOR UPDATE OF oi;
+
+-- name: SumActiveReturnQuantity :one
+-- Qty yang sedang / sudah diretur (retur ditolak tidak dihitung)
+SELECT COALESCE(SUM(quantity), 0)::int AS total
+FROM return_requests
+WHERE order_item_id = $1 AND status <> 'REJECTED';
+
+-- name: CreateReturnRequest :one
+-- id dibuat service agar foto bukti bisa diupload sebelum transaksi dimulai
+INSERT INTO return_requests (
+    id, rma_number, order_id, order_item_id, user_id, product_id,
+    name_snapshot, unit_price, quantity, reason, description
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+)
+RETURNING *;
+
+-- name: CreateReturnPhoto :exec
+INSERT INTO return_photos (return_id, image_url)
+VALUES ($1, $2);
+
+-- name: ListReturnPhotos :many
+SELECT * FROM return_photos
+WHERE return_id = $1
+ORDER BY created_at;
+
+-- name: GetReturnByID :one
+SELECT * FROM return_requests WHERE id = $1 LIMIT 1;
+
+-- name: GetReturnByIDForUpdate :one
+SELECT * FROM return_requests WHERE id = $1 LIMIT 1 FOR UPDATE;
+
+-- name: ListReturnsByUser :many
+SELECT * FROM return_requests
+WHERE user_id = $1
+ORDER BY created_at DESC
+LIMIT $2 OFFSET $3;
+
+-- name: CountReturnsByUser :one
+SELECT COUNT(*) FROM return_requests WHERE user_id = $1;
+
+-- name: ListReturnsAdmin :many
+SELECT * FROM return_requests
+WHERE (sqlc.narg('status')::text IS NULL OR status = sqlc.narg('status'))
+ORDER BY created_at DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountReturnsAdmin :one
+SELECT COUNT(*) FROM return_requests
+WHERE (sqlc.narg('status')::text IS NULL OR status = sqlc.narg('status'));
+
+-- name: ApproveReturn :one
+UPDATE return_requests
+SET status = 'APPROVED',
+    admin_note = $2,
+    approved_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1 AND status = 'REQUESTED'
+RETURNING *;
+
+-- name: RejectReturn :one
+UPDATE return_requests
+SET status = 'REJECTED',
+    admin_note = $2,
+    rejected_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1 AND status = 'REQUESTED'
+RETURNING *;
+
+-- name: ShipReturn :one
+UPDATE return_requests
+SET status = 'SHIPPED_BACK',
+    return_courier = $2,
+    return_receipt_no = $3,
+    shipped_back_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1 AND status = 'APPROVED'
+RETURNING *;
+
+-- name: ReceiveReturn :one
+UPDATE return_requests
+SET status = 'RECEIVED',
+    received_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1 AND status IN ('APPROVED', 'SHIPPED_BACK')
+RETURNING *;
+
+-- name: MarkReturnRefunded :one
+UPDATE return_requests
+SET status = 'REFUNDED',
+    refunded_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1 AND status = 'RECEIVED'
+RETURNING *;
+
+-- name: CreateRefund :one
+INSERT INTO refunds (return_id, order_id, amount, method, reference, note, processed_by)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: GetRefundByReturnID :one
+SELECT * FROM refunds WHERE return_id = $1 LIMIT 1;
+
+-- name: ApplyOrderRefund :one
+-- Guard: hanya order yang sudah dibayar, dan total refund tidak boleh melebihi total order.
+-- Refund penuh memindahkan order ke REFUNDED.
+UPDATE orders
+SET refunded_amount = refunded_amount + sqlc.arg('amount')::numeric,
+    payment_status = CASE
+        WHEN refunded_amount + sqlc.arg('amount')::numeric >= total_price THEN 'REFUNDED'
+        ELSE 'PARTIAL_REFUND'
+    END,
+    status = CASE
+        WHEN refunded_amount + sqlc.arg('amount')::numeric >= total_price THEN 'REFUNDED'
+        ELSE status
+    END,
+    updated_at = NOW()
+WHERE id = sqlc.arg('id')
+  AND payment_status IN ('PAID', 'PARTIAL_REFUND')
+  AND refunded_amount + sqlc.arg('amount')::numeric <= total_price
+RETURNING *;
@@ -1,22 +1,71 @@
 -- name: CreateReview :one
-INSERT INTO reviews (user_id, product_id, order_id, rating, comment, is_verified_purchase)
-VALUES ($1, $2, $3, $4, $5, $6)
+-- status: APPROVED (auto-approve) atau PENDING (antrian moderasi)
+-- id dibuat service agar foto bisa diupload sebelum transaksi dimulai
+INSERT INTO reviews (id, user_id, product_id, order_id, rating, comment, is_verified_purchase, status, moderation_note)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 RETURNING *;
 
 -- name: GetReviewByID :one
-SELECT r.*, u.first_name as user_name, u.email as user_email
+-- Foto & balasan merchant ikut di-select supaya tidak perlu query terpisah per review
+SELECT r.*, u.first_name as user_name, u.email as user_email, p.name as product_name, p.slug as product_slug,
+  COALESCE((SELECT array_agg(rp.image_url ORDER BY rp.sort_order) FROM review_photos rp WHERE rp.review_id = r.id), '{}')::text[] AS photo_urls,
+  rr.body AS reply_body,
+  rr.created_at AS reply_created_at,
+  rr.updated_at AS reply_updated_at
 FROM reviews r
 JOIN users u ON r.user_id = u.id
+JOIN products p ON r.product_id = p.id
+LEFT JOIN review_replies rr ON rr.review_id = r.id
 WHERE r.id = $1 AND r.deleted_at IS NULL
 LIMIT 1;
 
 -- name: GetReviewsByProductID :many
-SELECT r.*, u.first_name as user_name
+-- Listing publik: hanya review yang sudah disetujui.
+-- sort_by: newest | helpful | rating_high | rating_low (dinormalisasi service); filter rating & with_photos opsional
+SELECT r.*, u.first_name as user_name,
+  COALESCE((SELECT array_agg(rp.image_url ORDER BY rp.sort_order) FROM review_photos rp WHERE rp.review_id = r.id), '{}')::text[] AS photo_urls,
+  rr.body AS reply_body,
+  rr.created_at AS reply_created_at,
+  rr.updated_at AS reply_updated_at
 FROM reviews r
 JOIN users u ON r.user_id = u.id
-WHERE r.product_id = $1 AND r.deleted_at IS NULL
-ORDER BY r.created_at DESC
-LIMIT $2 OFFSET $3;
+LEFT JOIN review_replies rr ON rr.review_id = r.id
+WHERE r.product_id = sqlc.arg('product_id') AND r.deleted_at IS NULL AND r.status = 'APPROVED'
+  AND (sqlc.narg('rating')::int IS NULL OR r.rating = sqlc.narg('rating')::int)
+  AND (NOT sqlc.arg('with_photos')::bool OR EXISTS (SELECT 1 FROM review_photos rp WHERE rp.review_id = r.id))
+ORDER BY
+    CASE WHEN sqlc.arg('sort_by')::text = 'helpful' THEN r.helpful_count END DESC,
+    CASE WHEN sqlc.arg('sort_by')::text = 'rating_high' THEN r.rating END DESC,
+    CASE WHEN sqlc.arg('sort_by')::text = 'rating_low' THEN r.rating END ASC,
+    r.created_at DESC,
+    r.id DESC
+LIMIT sqlc.arg('limit')::int OFFSET sqlc.arg('offset')::int;
+
+-- name: GetReviewsByProductIDKeyset :many
+SELECT r.*, u.first_name as user_name,
+  COALESCE((SELECT array_agg(rp.image_url ORDER BY rp.sort_order) FROM review_photos rp WHERE rp.review_id = r.id), '{}')::text[] AS photo_urls,
+  rr.body AS reply_body,
+  rr.created_at AS reply_created_at,
+  rr.updated_at AS reply_updated_at
+FROM reviews r
+JOIN users u ON r.user_id = u.id
+LEFT JOIN review_replies rr ON rr.review_id = r.id
+WHERE r.product_id = sqlc.arg('product_id') AND r.deleted_at IS NULL AND r.status = 'APPROVED'
+  AND (sqlc.narg('rating')::int IS NULL OR r.rating = sqlc.narg('rating')::int)
+  AND (NOT sqlc.arg('with_photos')::bool OR EXISTS (SELECT 1 FROM review_photos rp WHERE rp.review_id = r.id))
+  AND (
+    sqlc.narg('cursor_id')::uuid IS NULL
+    OR (sqlc.arg('sort_dir')::text = 'desc'
+        AND (r.created_at, r.id) < (sqlc.narg('cursor_created_at')::timestamp, sqlc.narg('cursor_id')::uuid))
+    OR (sqlc.arg('sort_dir')::text = 'asc'
+        AND (r.created_at, r.id) > (sqlc.narg('cursor_created_at')::timestamp, sqlc.narg('cursor_id')::uuid))
+  )
+ORDER BY
+    CASE WHEN sqlc.arg('sort_dir')::text = 'asc' THEN r.created_at END ASC,
+    CASE WHEN sqlc.arg('sort_dir')::text = 'asc' THEN r.id END ASC,
+    r.created_at DESC,
+    r.id DESC
+LIMIT $1;
 
 -- name: GetReviewsByUserID :many
 SELECT r.*, p.name as product_name, p.slug as product_slug
@@ -27,8 +76,11 @@ ORDER BY r.created_at DESC
 LIMIT $2 OFFSET $3;
 
 -- name: CountReviewsByProductID :one
-SELECT COUNT(*) FROM reviews
-WHERE product_id = $1 AND deleted_at IS NULL;
+-- Filter sama dengan GetReviewsByProductID supaya total halaman konsisten
+SELECT COUNT(*) FROM reviews r
+WHERE r.product_id = sqlc.arg('product_id') AND r.deleted_at IS NULL AND r.status = 'APPROVED'
+  AND (sqlc.narg('rating')::int IS NULL OR r.rating = sqlc.narg('rating')::int)
+  AND (NOT sqlc.arg('with_photos')::bool OR EXISTS (SELECT 1 FROM review_photos rp WHERE rp.review_id = r.id));
 
 -- name: CountReviewsByUserID :one
 SELECT COUNT(*) FROM reviews
@@ -37,7 +89,7 @@ WHERE user_id = $1 AND deleted_at IS NULL;
 -- name: GetAverageRatingByProductID :one
 SELECT COALESCE(AVG(rating), 0) as average_rating
 FROM reviews
-WHERE product_id = $1 AND deleted_at IS NULL;
+WHERE product_id = $1 AND deleted_at IS NULL AND status = 'APPROVED';
 
 -- name: CheckReviewExists :one
 SELECT EXISTS(
@@ -67,14 +119,207 @@ ORDER BY o.completed_at DESC
 LIMIT 1;
 
 -- name: UpdateReview :one
+-- Edit oleh pemilik; status & catatan dihitung service dari status saat ini.
+-- Data moderasi hanya dikosongkan jika review masuk antrian lagi (reset_moderation).
+-- Guard from_status: status berubah sejak dibaca (mis. dilaporkan / dimoderasi) -> 0 row
 UPDATE reviews
-SET rating = $2,
-    comment = $3,
+SET rating = sqlc.arg('rating'),
+    comment = sqlc.arg('comment'),
+    status = sqlc.arg('status'),
+    moderation_note = sqlc.narg('moderation_note'),
+    moderated_by = CASE WHEN sqlc.arg('reset_moderation')::boolean THEN NULL ELSE moderated_by END,
+    moderated_at = CASE WHEN sqlc.arg('reset_moderation')::boolean THEN NULL ELSE moderated_at END,
     updated_at = NOW()
-WHERE id = $1 AND deleted_at IS NULL
+WHERE id = sqlc.arg('id')
+  AND deleted_at IS NULL
+  AND status = sqlc.arg('from_status')::text
 RETURNING *;
 
 -- name: DeleteReview :exec
 UPDATE reviews
 SET deleted_at = NOW()
-WHERE id = $1;
\ No newline at end of file
+WHERE id = $1;
+
+-- name: ListReviewsAdmin :many
+-- Antrian moderasi: filter opsional status / produk / rating, terlama dulu.
+-- reported=true: hanya review dengan laporan terbuka, laporan terbanyak dulu
+SELECT
+  r.*,
+  u.first_name AS user_name,
+  u.email AS user_email,
+  p.name AS product_name,
+  p.slug AS product_slug,
+  COALESCE((SELECT array_agg(rp.image_url ORDER BY rp.sort_order) FROM review_photos rp WHERE rp.review_id = r.id), '{}')::text[] AS photo_urls,
+  rr.body AS reply_body,
+  rr.created_at AS reply_created_at,
+  rr.updated_at AS reply_updated_at
+FROM reviews r
+JOIN users u ON u.id = r.user_id
+JOIN products p ON p.id = r.product_id
+LEFT JOIN review_replies rr ON rr.review_id = r.id
+WHERE r.deleted_at IS NULL
+  AND (sqlc.narg('status')::text IS NULL OR r.status = sqlc.narg('status')::text)
+  AND (sqlc.narg('product_id')::uuid IS NULL OR r.product_id = sqlc.narg('product_id')::uuid)
+  AND (sqlc.narg('rating')::int IS NULL OR r.rating = sqlc.narg('rating')::int)
+  AND (NOT sqlc.arg('reported')::bool OR r.report_count > 0)
+ORDER BY
+    CASE WHEN sqlc.arg('reported')::bool THEN r.report_count END DESC,
+    r.created_at ASC,
+    r.id ASC
+LIMIT sqlc.arg('limit')::int OFFSET sqlc.arg('offset')::int;
+
+-- name: CountReviewsAdmin :one
+SELECT COUNT(*)
+FROM reviews r
+WHERE r.deleted_at IS NULL
+  AND (sqlc.narg('status')::text IS NULL OR r.status = sqlc.narg('status')::text)
+  AND (sqlc.narg('product_id')::uuid IS NULL OR r.product_id = sqlc.narg('product_id')::uuid)
+  AND (sqlc.narg('rating')::int IS NULL OR r.rating = sqlc.narg('rating')::int)
+  AND (NOT sqlc.arg('reported')::bool OR r.report_count > 0);
+
+-- name: SetReviewStatus :one
+-- Guard transisi: hanya berubah jika status saat ini termasuk from_statuses
+UPDATE reviews
+SET status = sqlc.arg('status'),
+    moderation_note = sqlc.narg('moderation_note'),
+    moderated_by = sqlc.narg('moderated_by'),
+    moderated_at = NOW()
+WHERE id = sqlc.arg('id')
+  AND deleted_at IS NULL
+  AND status = ANY(sqlc.arg('from_statuses')::text[])
+RETURNING *;
+
+-- name: CreateReviewPhoto :exec
+INSERT INTO review_photos (review_id, image_url, public_id, sort_order)
+VALUES ($1, $2, $3, $4);
+
+-- name: DeleteReviewPhotos :many
+-- Dipanggil saat review dihapus; public_id dipakai untuk hapus file di Cloudinary
+DELETE FROM review_photos
+WHERE review_id = $1
+RETURNING public_id;
+
+-- name: GetReviewReply :one
+SELECT * FROM review_replies
+WHERE review_id = $1
+LIMIT 1;
+
+-- name: CreateReviewReply :one
+INSERT INTO review_replies (review_id, admin_id, body)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: UpdateReviewReply :one
+UPDATE review_replies
+SET body = $2,
+    admin_id = $3,
+    updated_at = NOW()
+WHERE review_id = $1
+RETURNING *;
+
+-- name: DeleteReviewReply :execrows
+DELETE FROM review_replies
+WHERE review_id = $1;
+
+-- name: LockProductForRating :exec
+-- Serialisasi recompute agregat per produk (dipanggil dalam transaksi sebelum RecomputeProductRating)
+SELECT id FROM products WHERE id = $1 FOR UPDATE;
+
+-- name: RecomputeProductRating :exec
+-- Hitung ulang agregat rating produk dari review APPROVED yang belum dihapus
+UPDATE products p
+SET rating_avg = agg.rating_avg,
+    rating_count = agg.rating_count,
+    rating_1_count = agg.r1,
+    rating_2_count = agg.r2,
+    rating_3_count = agg.r3,
+    rating_4_count = agg.r4,
+    rating_5_count = agg.r5
+FROM (
+    SELECT
+        COALESCE(ROUND(AVG(r.rating)::numeric, 2), 0)::float8 AS rating_avg,
+        COUNT(*)::int AS rating_count,
+        (COUNT(*) FILTER (WHERE r.rating = 1))::int AS r1,
+        (COUNT(*) FILTER (WHERE r.rating = 2))::int AS r2,
+        (COUNT(*) FILTER (WHERE r.rating = 3))::int AS r3,
+        (COUNT(*) FILTER (WHERE r.rating = 4))::int AS r4,
+        (COUNT(*) FILTER (WHERE r.rating = 5))::int AS r5
+    FROM reviews r
+    WHERE r.product_id = $1 AND r.deleted_at IS NULL AND r.status = 'APPROVED'
+) agg
+WHERE p.id = $1;
+
+-- name: RecomputeAllProductRatings :execrows
+-- Backfill: hitung ulang agregat semua produk (produk tanpa review -> 0)
+UPDATE products p
+SET rating_avg = COALESCE(agg.rating_avg, 0),
+    rating_count = COALESCE(agg.rating_count, 0),
+    rating_1_count = COALESCE(agg.r1, 0),
+    rating_2_count = COALESCE(agg.r2, 0),
+    rating_3_count = COALESCE(agg.r3, 0),
+    rating_4_count = COALESCE(agg.r4, 0),
+    rating_5_count = COALESCE(agg.r5, 0)
+FROM products p2
+LEFT JOIN (
+    SELECT r.product_id,
+        ROUND(AVG(r.rating)::numeric, 2)::float8 AS rating_avg,
+        COUNT(*)::int AS rating_count,
+        (COUNT(*) FILTER (WHERE r.rating = 1))::int AS r1,
+        (COUNT(*) FILTER (WHERE r.rating = 2))::int AS r2,
+        (COUNT(*) FILTER (WHERE r.rating = 3))::int AS r3,
+        (COUNT(*) FILTER (WHERE r.rating = 4))::int AS r4,
+        (COUNT(*) FILTER (WHERE r.rating = 5))::int AS r5
+    FROM reviews r
+    WHERE r.deleted_at IS NULL AND r.status = 'APPROVED'
+    GROUP BY r.product_id
+) agg ON agg.product_id = p2.id
+WHERE p.id = p2.id;
+
+-- name: UpsertReviewVote :exec
+-- Satu vote per user per review; vote ulang mengganti pilihan sebelumnya
+INSERT INTO review_votes (review_id, user_id, helpful)
+VALUES ($1, $2, $3)
+ON CONFLICT (review_id, user_id)
+DO UPDATE SET helpful = EXCLUDED.helpful, updated_at = NOW();
+
+-- name: DeleteReviewVote :execrows
+DELETE FROM review_votes
+WHERE review_id = $1 AND user_id = $2;
+
+-- name: RecomputeReviewVotes :one
+-- Hitung ulang counter dari tabel vote (update baris review sekaligus mengunci vote bersamaan)
+UPDATE reviews r
+SET helpful_count = (SELECT COUNT(*) FROM review_votes v WHERE v.review_id = r.id AND v.helpful),
+    not_helpful_count = (SELECT COUNT(*) FROM review_votes v WHERE v.review_id = r.id AND NOT v.helpful)
+WHERE r.id = $1
+RETURNING r.helpful_count, r.not_helpful_count;
+
+-- name: CreateReviewReport :one
+INSERT INTO review_reports (review_id, user_id, reason, note)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: IncrementReviewReportCount :one
+UPDATE reviews
+SET report_count = report_count + 1
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING *;
+
+-- name: ListReviewReports :many
+-- Laporan terbuka (belum ditangani admin), terbaru dulu
+SELECT rr.*, u.email AS user_email
+FROM review_reports rr
+JOIN users u ON u.id = rr.user_id
+WHERE rr.review_id = $1 AND rr.resolved_at IS NULL
+ORDER BY rr.created_at DESC;
+
+-- name: ResolveReviewReports :exec
+-- Dipanggil saat admin memoderasi review: laporan terbuka ditutup & counter direset
+WITH resolved AS (
+    UPDATE review_reports
+    SET resolved_at = NOW()
+    WHERE review_id = $1 AND resolved_at IS NULL
+)
+UPDATE reviews
+SET report_count = 0
+WHERE id = $1;
@@ -0,0 +1,38 @@
+-- name: CreateShipment :one
+INSERT INTO shipments (order_id, courier, service, receipt_no)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetShipmentByOrderID :one
+SELECT * FROM shipments WHERE order_id = $1 LIMIT 1;
+
+-- name: GetShipmentByReceiptForUpdate :one
+-- Dikunci agar webhook paralel untuk resi yang sama diproses berurutan
+SELECT * FROM shipments
+WHERE LOWER(courier) = LOWER(sqlc.arg('courier')::text)
+  AND receipt_no = sqlc.arg('receipt_no')::text
+LIMIT 1
+FOR UPDATE;
+
+-- name: CreateShipmentTrackingEvent :execrows
+INSERT INTO shipment_tracking_events (shipment_id, status, description, location, occurred_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (shipment_id, status, occurred_at) DO NOTHING;
+
+-- name: ListShipmentTrackingEvents :many
+SELECT * FROM shipment_tracking_events
+WHERE shipment_id = $1
+ORDER BY occurred_at DESC, created_at DESC;
+
+-- name: MarkShipmentDelivered :execrows
+UPDATE shipments
+SET delivered_at = $2,
+    updated_at = NOW()
+WHERE id = $1 AND delivered_at IS NULL;
+
+-- name: MarkOrderDelivered :execrows
+-- Hanya order yang masih SHIPPED yang dipindah ke DELIVERED
+UPDATE orders
+SET status = 'DELIVERED',
+    updated_at = NOW()
+WHERE id = $1 AND status = 'SHIPPED';
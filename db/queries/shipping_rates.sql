@@ -0,0 +1,15 @@
+-- name: ListShippingRatesForDestination :many
+-- Tarif paling spesifik (kota > provinsi > default) muncul pertama per kurir/layanan
+SELECT r.*
+FROM shipping_rates r
+WHERE r.is_active = true
+  AND (r.province IS NULL OR LOWER(r.province) = LOWER(sqlc.arg('province')::text))
+  AND (r.city IS NULL OR LOWER(r.city) = LOWER(sqlc.arg('city')::text))
+  AND r.min_weight_grams <= sqlc.arg('weight_grams')::int
+  AND (r.max_weight_grams IS NULL OR r.max_weight_grams >= sqlc.arg('weight_grams')::int)
+ORDER BY r.courier, r.service, (r.city IS NOT NULL) DESC, (r.province IS NOT NULL) DESC;
+
+-- name: ListProductWeights :many
+SELECT id, weight_grams
+FROM products
+WHERE id = ANY(sqlc.arg('product_ids')::uuid[]);
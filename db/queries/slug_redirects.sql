@@ -0,0 +1,8 @@
+-- name: UpsertSlugRedirect :exec
+INSERT INTO slug_redirects (entity_type, old_slug, entity_id)
+VALUES ($1, $2, $3)
+ON CONFLICT (entity_type, old_slug)
+DO UPDATE SET entity_id = EXCLUDED.entity_id, created_at = NOW();
+
+-- name: DeleteSlugRedirect :exec
+DELETE FROM slug_redirects WHERE entity_type = $1 AND old_slug = $2;
@@ -0,0 +1,54 @@
+-- name: AdjustProductStock :one
+-- Perubahan relatif atomik; 0 row (sql.ErrNoRows) jika produk tidak ada atau stok tidak cukup.
+-- Produk yang sudah di-soft-delete tetap menerima delta positif (restock cancel / expire / retur).
+UPDATE products
+SET stock = stock + sqlc.arg('delta')::int,
+    updated_at = NOW()
+WHERE id = sqlc.arg('product_id')::uuid
+  AND (deleted_at IS NULL OR sqlc.arg('delta')::int > 0)
+  AND stock + sqlc.arg('delta')::int >= 0
+RETURNING id, stock;
+
+-- name: GetProductStock :one
+-- Hanya dipakai membedakan produk tidak ada vs stok kurang saat delta negatif ditolak
+SELECT id, stock, low_stock_threshold
+FROM products
+WHERE id = $1 AND deleted_at IS NULL
+LIMIT 1;
+
+-- name: CreateStockMovement :one
+INSERT INTO stock_movements (product_id, delta, stock_after, reason, order_id, actor_id, note)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: ListStockMovementsByProduct :many
+SELECT
+    m.*,
+    COUNT(*) OVER() AS total_count
+FROM stock_movements m
+WHERE m.product_id = $1
+ORDER BY m.created_at DESC, m.id DESC
+LIMIT $2 OFFSET $3;
+
+-- name: ListLowStockProducts :many
+-- Produk aktif dengan stok <= threshold, paling kritis di atas
+SELECT
+    p.id,
+    p.name,
+    p.sku,
+    p.stock,
+    p.low_stock_threshold,
+    COUNT(*) OVER() AS total_count
+FROM products p
+WHERE p.deleted_at IS NULL
+  AND p.is_active = true
+  AND p.stock <= p.low_stock_threshold
+ORDER BY p.stock ASC, p.name ASC
+LIMIT $1 OFFSET $2;
+
+-- name: UpdateProductLowStockThreshold :one
+UPDATE products
+SET low_stock_threshold = $2,
+    updated_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING id, stock, low_stock_threshold;
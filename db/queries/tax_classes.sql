@@ -0,0 +1,18 @@
+-- name: ListTaxClasses :many
+SELECT * FROM tax_classes ORDER BY code;
+
+-- name: UpsertTaxClass :one
+INSERT INTO tax_classes (code, name, rate)
+VALUES ($1, $2, $3)
+ON CONFLICT (code) DO UPDATE
+SET name = EXCLUDED.name,
+    rate = EXCLUDED.rate,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: ListProductTaxRates :many
+-- Tarif pajak per produk (dipakai checkout)
+SELECT p.id, p.tax_class, tc.rate
+FROM products p
+JOIN tax_classes tc ON tc.code = p.tax_class
+WHERE p.id = ANY(sqlc.arg('product_ids')::uuid[]);
@@ -0,0 +1,142 @@
+-- name: CreateVoucher :one
+INSERT INTO vouchers (
+    code, description, discount_type, discount_value, max_discount,
+    min_spend, usage_limit, per_user_limit, starts_at, ends_at, is_active
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+RETURNING *;
+
+-- name: UpdateVoucher :one
+UPDATE vouchers
+SET code = $2,
+    description = $3,
+    discount_type = $4,
+    discount_value = $5,
+    max_discount = $6,
+    min_spend = $7,
+    usage_limit = $8,
+    per_user_limit = $9,
+    starts_at = $10,
+    ends_at = $11,
+    is_active = $12,
+    updated_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING *;
+
+-- name: SoftDeleteVoucher :execrows
+UPDATE vouchers SET deleted_at = NOW(), is_active = false
+WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: GetVoucherByID :one
+SELECT * FROM vouchers
+WHERE id = $1 AND deleted_at IS NULL
+LIMIT 1;
+
+-- name: GetVoucherByCodeForUpdate :one
+-- Row voucher dikunci sampai transaksi checkout selesai agar limit tidak terlewati
+SELECT * FROM vouchers
+WHERE code = UPPER(sqlc.arg('code')::text) AND deleted_at IS NULL
+LIMIT 1
+FOR UPDATE;
+
+-- name: ListVouchersAdmin :many
+SELECT v.*, COUNT(*) OVER() AS total_count
+FROM vouchers v
+WHERE v.deleted_at IS NULL
+  AND (sqlc.narg('search')::text IS NULL OR v.code ILIKE '%' || sqlc.narg('search')::text || '%')
+ORDER BY v.created_at DESC
+LIMIT $1 OFFSET $2;
+
+-- name: ListVoucherProductIDs :many
+SELECT product_id FROM voucher_products WHERE voucher_id = $1;
+
+-- name: ListVoucherCategoryIDs :many
+SELECT category_id FROM voucher_categories WHERE voucher_id = $1;
+
+-- name: DeleteVoucherProducts :exec
+DELETE FROM voucher_products WHERE voucher_id = $1;
+
+-- name: DeleteVoucherCategories :exec
+DELETE FROM voucher_categories WHERE voucher_id = $1;
+
+-- name: AddVoucherProducts :exec
+INSERT INTO voucher_products (voucher_id, product_id)
+SELECT sqlc.arg('voucher_id')::uuid, unnest(sqlc.arg('product_ids')::uuid[])
+ON CONFLICT DO NOTHING;
+
+-- name: AddVoucherCategories :exec
+INSERT INTO voucher_categories (voucher_id, category_id)
+SELECT sqlc.arg('voucher_id')::uuid, unnest(sqlc.arg('category_ids')::uuid[])
+ON CONFLICT DO NOTHING;
+
+-- name: ListEligibleVoucherProducts :many
+-- Produk di keranjang yang boleh didiskon; tanpa batasan produk/kategori = semua produk
+WITH RECURSIVE allowed_categories AS (
+    SELECT vc.category_id AS id FROM voucher_categories vc
+    WHERE vc.voucher_id = sqlc.arg('voucher_id')::uuid
+    UNION
+    SELECT ch.id FROM categories ch
+    JOIN allowed_categories a ON ch.parent_id = a.id
+    WHERE ch.deleted_at IS NULL
+)
+SELECT p.id
+FROM products p
+WHERE p.id = ANY(sqlc.arg('product_ids')::uuid[])
+  AND (
+    (
+        NOT EXISTS (SELECT 1 FROM voucher_products vp WHERE vp.voucher_id = sqlc.arg('voucher_id')::uuid)
+        AND NOT EXISTS (SELECT 1 FROM voucher_categories vc WHERE vc.voucher_id = sqlc.arg('voucher_id')::uuid)
+    )
+    OR EXISTS (
+        SELECT 1 FROM voucher_products vp
+        WHERE vp.voucher_id = sqlc.arg('voucher_id')::uuid AND vp.product_id = p.id
+    )
+    OR p.category_id IN (SELECT ac.id FROM allowed_categories ac)
+  );
+
+-- name: CountVoucherRedemptionsByUser :one
+SELECT COUNT(*) FROM voucher_redemptions
+WHERE voucher_id = $1 AND user_id = $2;
+
+-- name: IncrementVoucherUsage :one
+-- 0 row (sql.ErrNoRows) jika kuota global sudah habis
+UPDATE vouchers
+SET used_count = used_count + 1,
+    updated_at = NOW()
+WHERE id = $1
+  AND (usage_limit IS NULL OR used_count < usage_limit)
+RETURNING used_count;
+
+-- name: DecrementVoucherUsage :exec
+UPDATE vouchers
+SET used_count = GREATEST(used_count - 1, 0),
+    updated_at = NOW()
+WHERE id = $1;
+
+-- name: CreateVoucherRedemption :one
+INSERT INTO voucher_redemptions (voucher_id, user_id, order_id, discount_amount)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: DeleteVoucherRedemptionByOrder :one
+DELETE FROM voucher_redemptions
+WHERE order_id = $1
+RETURNING voucher_id;
+
+-- name: GetVoucherUsageSummary :one
+SELECT
+    COUNT(*) AS redemption_count,
+    COUNT(DISTINCT r.user_id) AS unique_users,
+    COALESCE(SUM(r.discount_amount), 0)::decimal AS total_discount
+FROM voucher_redemptions r
+WHERE r.voucher_id = $1;
+
+-- name: ListVoucherRedemptions :many
+SELECT
+    r.*,
+    o.order_number,
+    COUNT(*) OVER() AS total_count
+FROM voucher_redemptions r
+JOIN orders o ON o.id = r.order_id
+WHERE r.voucher_id = $1
+ORDER BY r.created_at DESC, r.id DESC
+LIMIT $2 OFFSET $3;
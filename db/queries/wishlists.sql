@@ -0,0 +1,95 @@
+-- name: UpsertWishlist :one
+INSERT INTO wishlists (user_id)
+VALUES ($1)
+ON CONFLICT (user_id)
+DO UPDATE SET updated_at = wishlists.updated_at
+RETURNING *;
+
+-- name: GetWishlistByUserID :one
+SELECT * FROM wishlists
+WHERE user_id = $1;
+
+-- name: GetWishlistByShareToken :one
+SELECT * FROM wishlists
+WHERE share_token = $1;
+
+-- name: SetWishlistShareToken :one
+-- share_token NULL = link share dicabut
+UPDATE wishlists
+SET share_token = sqlc.narg('share_token'), updated_at = NOW()
+WHERE id = sqlc.arg('id')
+RETURNING *;
+
+-- name: AddWishlistItem :exec
+-- Produk yang sudah ada di wishlist diabaikan (idempotent)
+INSERT INTO wishlist_items (wishlist_id, product_id)
+VALUES ($1, $2)
+ON CONFLICT (wishlist_id, product_id) DO NOTHING;
+
+-- name: DeleteWishlistItem :execrows
+DELETE FROM wishlist_items
+WHERE wishlist_id = $1 AND product_id = $2;
+
+-- name: ListWishlistItems :many
+-- Ringkasan produk live (harga aktif, stok, status tayang)
+SELECT
+  wi.id,
+  wi.product_id,
+  wi.created_at,
+  p.name AS product_name,
+  p.slug AS product_slug,
+  COALESCE(p.image_url, '')::text AS image_url,
+  COALESCE(ap.price, p.price)::decimal AS current_price,
+  ap.compare_at_price,
+  p.stock,
+  (
+    p.deleted_at IS NULL
+    AND COALESCE(p.is_active, false)
+    AND (p.published_at IS NULL OR p.published_at <= NOW())
+    AND (p.unpublished_at IS NULL OR p.unpublished_at > NOW())
+  )::boolean AS is_available
+FROM wishlist_items wi
+JOIN products p ON p.id = wi.product_id
+LEFT JOIN LATERAL (
+  -- Jadwal harga aktif; jika overlap, yang mulai paling akhir menang
+  SELECT pp.price, pp.compare_at_price
+  FROM product_prices pp
+  WHERE pp.product_id = p.id
+    AND pp.starts_at <= NOW()
+    AND (pp.ends_at IS NULL OR pp.ends_at > NOW())
+  ORDER BY pp.starts_at DESC
+  LIMIT 1
+) ap ON true
+WHERE wi.wishlist_id = $1
+ORDER BY wi.created_at DESC;
+
+-- name: GetWishlistItem :one
+SELECT
+  wi.id,
+  wi.product_id,
+  wi.created_at,
+  p.name AS product_name,
+  p.slug AS product_slug,
+  COALESCE(p.image_url, '')::text AS image_url,
+  COALESCE(ap.price, p.price)::decimal AS current_price,
+  ap.compare_at_price,
+  p.stock,
+  (
+    p.deleted_at IS NULL
+    AND COALESCE(p.is_active, false)
+    AND (p.published_at IS NULL OR p.published_at <= NOW())
+    AND (p.unpublished_at IS NULL OR p.unpublished_at > NOW())
+  )::boolean AS is_available
+FROM wishlist_items wi
+JOIN products p ON p.id = wi.product_id
+LEFT JOIN LATERAL (
+  -- Jadwal harga aktif; jika overlap, yang mulai paling akhir menang
+  SELECT pp.price, pp.compare_at_price
+  FROM product_prices pp
+  WHERE pp.product_id = p.id
+    AND pp.starts_at <= NOW()
+    AND (pp.ends_at IS NULL OR pp.ends_at > NOW())
+  ORDER BY pp.starts_at DESC
+  LIMIT 1
+) ap ON true
+WHERE wi.wishlist_id = $1 AND wi.product_id = $2;
@@ -64,3 +64,18 @@ type AddressAdminResponse struct {
 	IsPrimary      bool   `json:"is_primary"`
 	CreatedAt      string `json:"createdAt"`
 }
+
+// ========= SNAPSHOT =========
+
+// Snapshot salinan alamat yang disimpan di orders.address_snapshot saat checkout
+type Snapshot struct {
+	AddressID      string `json:"address_id"`
+	RecipientName  string `json:"recipient_name"`
+	RecipientPhone string `json:"recipient_phone"`
+	Street         string `json:"street"`
+	Subdistrict    string `json:"subdistrict"`
+	District       string `json:"district"`
+	City           string `json:"city"`
+	Province       string `json:"province"`
+	PostalCode     string `json:"postal_code"`
+}
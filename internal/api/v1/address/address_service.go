@@ -158,6 +158,21 @@ func mapToResponse(a dbgen.Address) AddressResponse {
 	}
 }
 
+// NewSnapshot menyalin alamat apa adanya untuk disimpan bersama order
+func NewSnapshot(a dbgen.Address) Snapshot {
+	return Snapshot{
+		AddressID:      a.ID.String(),
+		RecipientName:  a.RecipientName,
+		RecipientPhone: a.RecipientPhone,
+		Street:         a.Street,
+		Subdistrict:    a.Subdistrict.String,
+		District:       a.District.String,
+		City:           a.City.String,
+		Province:       a.Province.String,
+		PostalCode:     a.PostalCode.String,
+	}
+}
+
 func mapRowToResponse(r dbgen.ListAddressesByUserRow) AddressResponse {
 	return AddressResponse{
 		ID:             r.ID.String(),
@@ -1,20 +1,29 @@
 package auth
 
 import (
+	"context"
+	"go-sqlc-starter/internal/pkg/constants"
 	"go-sqlc-starter/internal/pkg/platform"
 	"go-sqlc-starter/internal/pkg/response"
+	"log"
 	"net/http"
 	"os"
 
 	"github.com/gin-gonic/gin"
 )
 
+// CartMerger menggabungkan cart guest ke cart user setelah login / register
+type CartMerger interface {
+	MergeGuest(ctx context.Context, userID, guestToken string) error
+}
+
 type Controller struct {
 	service Service // Perbaikan: Gunakan Interface, bukan pointer ke Interface
+	cart    CartMerger
 }
 
-func NewController(s Service) *Controller {
-	return &Controller{service: s}
+func NewController(s Service, m CartMerger) *Controller {
+	return &Controller{service: s, cart: m}
 }
 
 func (ctrl *Controller) Login(c *gin.Context) {
@@ -37,6 +46,8 @@ func (ctrl *Controller) Login(c *gin.Context) {
 	}
 	isProd := os.Getenv("APP_ENV") == "production"
 
+	ctrl.mergeGuestCart(c, userResp.ID)
+
 	if platform.IsWebClient(clientType) {
 		c.SetCookie(
 			"access_token",
@@ -80,6 +91,8 @@ func (ctrl *Controller) Register(c *gin.Context) {
 		return
 	}
 
+	ctrl.mergeGuestCart(c, res.ID)
+
 	response.Success(c, http.StatusCreated, res, nil)
 }
 
@@ -87,3 +100,27 @@ func (ctrl *Controller) Logout(c *gin.Context) {
 	c.SetCookie("access_token", "", -1, "/", "", false, true)
 	response.Success(c, http.StatusOK, "Logout berhasil", nil)
 }
+
+// mergeGuestCart memindahkan isi cart guest (jika ada cart token) ke cart user.
+// Gagal merge tidak menggagalkan login / register, cart guest tetap tersimpan sampai expired.
+func (ctrl *Controller) mergeGuestCart(c *gin.Context, userID string) {
+	if ctrl.cart == nil || userID == "" {
+		return
+	}
+
+	token := c.GetHeader(constants.CartTokenHeader)
+	if token == "" {
+		token, _ = c.Cookie(constants.CartTokenCookie)
+	}
+	if token == "" {
+		return
+	}
+
+	if err := ctrl.cart.MergeGuest(c.Request.Context(), userID, token); err != nil {
+		log.Printf("merge guest cart user %s: %v", userID, err)
+		return
+	}
+
+	// Token guest sudah tidak dipakai
+	c.SetCookie(constants.CartTokenCookie, "", -1, "/", "", os.Getenv("APP_ENV") == "production", true)
+}
@@ -78,6 +78,7 @@ func (s *service) Register(ctx context.Context, req RegisterRequest) (AuthRespon
 	}
 
 	return AuthResponse{
+		ID:    user.ID.String(),
 		Email: user.Email,
 		Role:  user.Role,
 	}, nil
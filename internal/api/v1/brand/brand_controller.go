@@ -1,6 +1,10 @@
 package brand
 
 import (
+	"errors"
+	branderrors "go-sqlc-starter/internal/api/v1/brand/errors"
+	"go-sqlc-starter/internal/pkg/apperror"
+	"go-sqlc-starter/internal/pkg/httpx"
 	"go-sqlc-starter/internal/pkg/response"
 	"go-sqlc-starter/internal/pkg/utils"
 	"log"
@@ -110,6 +114,27 @@ func (ctrl *Controller) GetByID(c *gin.Context) {
 	response.Success(c, http.StatusOK, res, nil)
 }
 
+// GET BY SLUG (Public)
+// Slug lama hasil rename di-redirect 301 ke slug terbaru
+func (ctrl *Controller) GetBySlug(c *gin.Context) {
+	slug := c.Param("slug")
+
+	res, err := ctrl.service.GetBySlug(c.Request.Context(), slug)
+	if err != nil {
+		if errors.Is(err, branderrors.ErrBrandNotFound) {
+			if newSlug, rerr := ctrl.service.ResolveSlug(c.Request.Context(), slug); rerr == nil {
+				httpx.RedirectSlug(c, slug, newSlug)
+				return
+			}
+		}
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, nil)
+}
+
 // 3. CREATE BRAND
 func (ctrl *Controller) Create(c *gin.Context) {
 	ctx := c.Request.Context()
@@ -131,9 +156,11 @@ func (ctrl *Controller) Create(c *gin.Context) {
 	description := c.PostForm("description")
 
 	req := CreateBrandRequest{
-		Name:        name,
-		Slug:        utils.GenerateSlug(name),
-		Description: description,
+		Name:            name,
+		Slug:            utils.GenerateSlug(name),
+		Description:     description,
+		MetaTitle:       c.PostForm("meta_title"),
+		MetaDescription: c.PostForm("meta_description"),
 	}
 	// 3. Validate required fields
 	if req.Name == "" {
@@ -227,9 +254,16 @@ func (ctrl *Controller) Update(c *gin.Context) {
 	// 2. Parse form fields (sesuaikan dengan struct UpdateBrandRequest Anda)
 	// Karena multipart/form-data, kita ambil via PostForm, bukan BindJSON
 	req := UpdateBrandRequest{
-		Name:        name,
-		Slug:        utils.GenerateSlug(name),
-		Description: description,
+		Name:            name,
+		Slug:            utils.GenerateSlug(name),
+		Description:     description,
+		MetaTitle:       c.PostForm("meta_title"),
+		MetaDescription: c.PostForm("meta_description"),
+	}
+
+	if isActiveStr := c.PostForm("is_active"); isActiveStr != "" {
+		isActive := isActiveStr == "true"
+		req.IsActive = &isActive
 	}
 
 	// 3. Get uploaded file (optional)
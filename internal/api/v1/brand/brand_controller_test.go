@@ -28,6 +28,9 @@ type fakeBrandService struct {
 	UpdateFn     func(ctx context.Context, id string, req brand.UpdateBrandRequest, file multipart.File, filename string) (brand.BrandAdminResponse, error)
 	DeleteFn     func(ctx context.Context, id string) error
 	RestoreFn    func(ctx context.Context, id string) (brand.BrandAdminResponse, error)
+
+	GetBySlugFn   func(ctx context.Context, slug string) (brand.BrandPublicResponse, error)
+	ResolveSlugFn func(ctx context.Context, oldSlug string) (string, error)
 }
 
 func (f *fakeBrandService) Create(ctx context.Context, req brand.CreateBrandRequest, file multipart.File, filename string) (brand.BrandAdminResponse, error) {
@@ -51,6 +54,12 @@ func (f *fakeBrandService) Delete(ctx context.Context, id string) error {
 func (f *fakeBrandService) Restore(ctx context.Context, id string) (brand.BrandAdminResponse, error) {
 	return f.RestoreFn(ctx, id)
 }
+func (f *fakeBrandService) GetBySlug(ctx context.Context, slug string) (brand.BrandPublicResponse, error) {
+	return f.GetBySlugFn(ctx, slug)
+}
+func (f *fakeBrandService) ResolveSlug(ctx context.Context, oldSlug string) (string, error) {
+	return f.ResolveSlugFn(ctx, oldSlug)
+}
 
 // ==================== HELPERS ====================
 
@@ -9,6 +9,9 @@ type CreateBrandRequest struct {
 	Slug        string `json:"slug"`
 	Description string `json:"description" validate:"max=500"`
 	ImageUrl    string `json:"imageUrl" validate:"omitempty,url"`
+
+	MetaTitle       string `json:"metaTitle" validate:"max=255"`
+	MetaDescription string `json:"metaDescription" validate:"max=500"`
 }
 
 type UpdateBrandRequest struct {
@@ -16,7 +19,10 @@ type UpdateBrandRequest struct {
 	Slug        string `json:"slug"`
 	Description string `json:"description" validate:"max=500"`
 	ImageUrl    string `json:"imageUrl" validate:"omitempty,url"`
-	IsActive    *bool  `json:"isActive" binding:"required" validate:"required"`
+	IsActive    *bool  `json:"isActive"` // nil = tidak diubah
+
+	MetaTitle       string `json:"metaTitle" validate:"max=255"`
+	MetaDescription string `json:"metaDescription" validate:"max=500"`
 }
 
 type ListBrandRequest struct {
@@ -34,6 +40,9 @@ type BrandPublicResponse struct {
 	Slug        string `json:"slug"`
 	Description string `json:"description,omitempty"`
 	ImageUrl    string `json:"imageUrl,omitempty"`
+
+	MetaTitle       string `json:"metaTitle,omitempty"`
+	MetaDescription string `json:"metaDescription,omitempty"`
 }
 
 type BrandAdminResponse struct {
@@ -46,4 +55,7 @@ type BrandAdminResponse struct {
 	CreatedAt   time.Time  `json:"createdAt"`
 	UpdatedAt   time.Time  `json:"updatedAt"`
 	DeletedAt   *time.Time `json:"deletedAt,omitempty"`
+
+	MetaTitle       string `json:"metaTitle"`
+	MetaDescription string `json:"metaDescription"`
 }
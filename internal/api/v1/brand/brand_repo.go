@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"go-sqlc-starter/internal/dbgen"
+	"go-sqlc-starter/internal/pkg/constants"
 
 	"github.com/google/uuid"
 )
@@ -18,6 +19,13 @@ type Repository interface {
 	Update(ctx context.Context, arg dbgen.UpdateBrandParams) (dbgen.Brand, error)
 	Delete(ctx context.Context, id uuid.UUID) error
 	Restore(ctx context.Context, id uuid.UUID) (dbgen.Brand, error)
+
+	// Slug & riwayat slug (301 redirect setelah rename)
+	GetBySlug(ctx context.Context, slug string) (dbgen.Brand, error)
+	SlugExists(ctx context.Context, slug string, excludeID uuid.UUID) (bool, error)
+	GetSlugRedirect(ctx context.Context, oldSlug string) (string, error)
+	CreateSlugRedirect(ctx context.Context, oldSlug string, brandID uuid.UUID) error
+	DeleteSlugRedirect(ctx context.Context, slug string) error
 }
 
 type repository struct {
@@ -56,6 +64,36 @@ func (r *repository) Restore(ctx context.Context, id uuid.UUID) (dbgen.Brand, er
 	return r.queries.RestoreBrand(ctx, id)
 }
 
+func (r *repository) GetBySlug(ctx context.Context, slug string) (dbgen.Brand, error) {
+	return r.queries.GetBrandBySlug(ctx, slug)
+}
+
+func (r *repository) SlugExists(ctx context.Context, slug string, excludeID uuid.UUID) (bool, error) {
+	return r.queries.BrandSlugExists(ctx, dbgen.BrandSlugExistsParams{
+		Slug:      slug,
+		ExcludeID: excludeID,
+	})
+}
+
+func (r *repository) GetSlugRedirect(ctx context.Context, oldSlug string) (string, error) {
+	return r.queries.GetBrandSlugRedirect(ctx, oldSlug)
+}
+
+func (r *repository) CreateSlugRedirect(ctx context.Context, oldSlug string, brandID uuid.UUID) error {
+	return r.queries.UpsertSlugRedirect(ctx, dbgen.UpsertSlugRedirectParams{
+		EntityType: constants.SlugEntityBrand,
+		OldSlug:    oldSlug,
+		EntityID:   brandID,
+	})
+}
+
+func (r *repository) DeleteSlugRedirect(ctx context.Context, slug string) error {
+	return r.queries.DeleteSlugRedirect(ctx, dbgen.DeleteSlugRedirectParams{
+		EntityType: constants.SlugEntityBrand,
+		OldSlug:    slug,
+	})
+}
+
 func (r *repository) WithTx(tx dbgen.DBTX) Repository {
 	if sqlTx, ok := tx.(*sql.Tx); ok {
 		return &repository{
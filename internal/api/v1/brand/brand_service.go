@@ -8,6 +8,7 @@ import (
 	"go-sqlc-starter/internal/api/v1/cloudinary"
 	"go-sqlc-starter/internal/dbgen"
 	"go-sqlc-starter/internal/pkg/constants"
+	"go-sqlc-starter/internal/pkg/utils"
 	"mime/multipart"
 	"strings"
 
@@ -25,6 +26,8 @@ type Service interface {
 	ListPublic(ctx context.Context, page, limit int) ([]BrandPublicResponse, int64, error)
 	ListAdmin(ctx context.Context, req ListBrandRequest) ([]BrandAdminResponse, int64, error)
 	GetByID(ctx context.Context, id string) (BrandAdminResponse, error)
+	GetBySlug(ctx context.Context, slug string) (BrandPublicResponse, error)
+	ResolveSlug(ctx context.Context, oldSlug string) (string, error)
 	Update(ctx context.Context, id string, req UpdateBrandRequest, file multipart.File, filename string) (BrandAdminResponse, error)
 	Delete(ctx context.Context, id string) error
 	Restore(ctx context.Context, id string) (BrandAdminResponse, error)
@@ -129,7 +132,45 @@ func (s *service) GetByID(ctx context.Context, idStr string) (BrandAdminResponse
 	return mapToResponse(brand), err
 }
 
+func (s *service) GetBySlug(ctx context.Context, slug string) (BrandPublicResponse, error) {
+	brand, err := s.repo.GetBySlug(ctx, slug)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return BrandPublicResponse{}, branderrors.ErrBrandNotFound
+		}
+		return BrandPublicResponse{}, branderrors.ErrBrandFailed
+	}
+
+	return BrandPublicResponse{
+		ID:              brand.ID.String(),
+		Name:            brand.Name,
+		Slug:            brand.Slug,
+		Description:     brand.Description.String,
+		ImageUrl:        brand.ImageUrl.String,
+		MetaTitle:       fallback(brand.MetaTitle.String, brand.Name),
+		MetaDescription: fallback(brand.MetaDescription.String, brand.Description.String),
+	}, nil
+}
+
+// ResolveSlug mencari slug terbaru dari slug lama (untuk 301 redirect)
+func (s *service) ResolveSlug(ctx context.Context, oldSlug string) (string, error) {
+	slug, err := s.repo.GetSlugRedirect(ctx, oldSlug)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", branderrors.ErrBrandNotFound
+		}
+		return "", branderrors.ErrBrandFailed
+	}
+	return slug, nil
+}
+
 func (s *service) Create(ctx context.Context, req CreateBrandRequest, file multipart.File, filename string) (BrandAdminResponse, error) {
+	// Slug unik (retry dengan suffix jika bentrok)
+	slug, err := s.uniqueSlug(ctx, req.Slug, req.Name, uuid.Nil)
+	if err != nil {
+		return BrandAdminResponse{}, branderrors.ErrBrandFailed
+	}
+
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return BrandAdminResponse{}, err
@@ -138,10 +179,12 @@ func (s *service) Create(ctx context.Context, req CreateBrandRequest, file multi
 
 	qtx := s.repo.WithTx(tx)
 	brand, err := qtx.Create(ctx, dbgen.CreateBrandParams{
-		Name:        req.Name,
-		Slug:        req.Slug,
-		Description: dbgen.NewNullString(req.Description),
-		ImageUrl:    sql.NullString{},
+		Name:            req.Name,
+		Slug:            slug,
+		Description:     dbgen.NewNullString(req.Description),
+		ImageUrl:        sql.NullString{},
+		MetaTitle:       dbgen.NewNullString(req.MetaTitle),
+		MetaDescription: dbgen.NewNullString(req.MetaDescription),
 	})
 	if err != nil {
 		return BrandAdminResponse{}, err
@@ -161,12 +204,14 @@ func (s *service) Create(ctx context.Context, req CreateBrandRequest, file multi
 
 		// 5. Update brand dengan image URL yang didapat
 		_, err = qtx.Update(ctx, dbgen.UpdateBrandParams{
-			ID:          brand.ID,
-			Name:        brand.Name,
-			Slug:        brand.Slug,
-			Description: brand.Description,
-			ImageUrl:    dbgen.NewNullString(imageURL),
-			IsActive:    brand.IsActive,
+			ID:              brand.ID,
+			Name:            brand.Name,
+			Slug:            brand.Slug,
+			Description:     brand.Description,
+			ImageUrl:        dbgen.NewNullString(imageURL),
+			IsActive:        brand.IsActive,
+			MetaTitle:       brand.MetaTitle,
+			MetaDescription: brand.MetaDescription,
 		})
 		if err != nil {
 			// Update gagal, hapus image yang sudah terlanjur diupload
@@ -244,19 +289,56 @@ func (s *service) Update(
 		newImageURL = brand.ImageUrl
 	}
 
-	// 3. Update DB
-	_, err = s.repo.Update(ctx, dbgen.UpdateBrandParams{
-		ID:          brand.ID,
-		Name:        req.Name,
-		Slug:        req.Slug,
-		Description: dbgen.NewNullString(req.Description),
-		ImageUrl:    newImageURL,
-		IsActive:    brand.IsActive,
+	// 3. Slug hanya berubah jika nama berubah
+	slug := brand.Slug
+	if req.Name != brand.Name {
+		slug, err = s.uniqueSlug(ctx, req.Slug, req.Name, brand.ID)
+		if err != nil {
+			return BrandAdminResponse{}, branderrors.ErrBrandFailed
+		}
+	}
+
+	isActive := brand.IsActive
+	if req.IsActive != nil {
+		isActive = sql.NullBool{Bool: *req.IsActive, Valid: true}
+	}
+
+	// 4. Update DB + riwayat slug dalam satu transaksi
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return BrandAdminResponse{}, branderrors.ErrBrandFailed
+	}
+	defer tx.Rollback()
+
+	qtx := s.repo.WithTx(tx)
+	_, err = qtx.Update(ctx, dbgen.UpdateBrandParams{
+		ID:              brand.ID,
+		Name:            req.Name,
+		Slug:            slug,
+		Description:     dbgen.NewNullString(req.Description),
+		ImageUrl:        newImageURL,
+		IsActive:        isActive,
+		MetaTitle:       dbgen.NewNullString(req.MetaTitle),
+		MetaDescription: dbgen.NewNullString(req.MetaDescription),
 	})
 	if err != nil {
 		return BrandAdminResponse{}, branderrors.ErrBrandFailed
 	}
 
+	// 5. Slug lama disimpan agar URL lama 301 ke slug baru
+	if slug != brand.Slug {
+		if err := qtx.DeleteSlugRedirect(ctx, slug); err != nil {
+			return BrandAdminResponse{}, branderrors.ErrBrandFailed
+		}
+		if err := qtx.CreateSlugRedirect(ctx, brand.Slug, brand.ID); err != nil {
+			return BrandAdminResponse{}, branderrors.ErrBrandFailed
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return BrandAdminResponse{}, branderrors.ErrBrandFailed
+	}
+
 	return s.GetByID(ctx, brand.ID.String())
 }
 
@@ -299,14 +381,35 @@ func (s *service) Restore(ctx context.Context, idStr string) (BrandAdminResponse
 
 func mapToResponse(brand dbgen.Brand) BrandAdminResponse {
 	return BrandAdminResponse{
-		ID:        brand.ID.String(),
-		Name:      brand.Name,
-		ImageUrl:  brand.ImageUrl.String,
-		Slug:      brand.Slug,
-		CreatedAt: brand.CreatedAt,
+		ID:              brand.ID.String(),
+		Name:            brand.Name,
+		ImageUrl:        brand.ImageUrl.String,
+		Slug:            brand.Slug,
+		CreatedAt:       brand.CreatedAt,
+		MetaTitle:       brand.MetaTitle.String,
+		MetaDescription: brand.MetaDescription.String,
 	}
 }
 
+// uniqueSlug memakai slug dari request (jika ada) atau nama sebagai dasar,
+// excludeID agar slug milik brand itu sendiri tidak dianggap bentrok
+func (s *service) uniqueSlug(ctx context.Context, slug, name string, excludeID uuid.UUID) (string, error) {
+	text := slug
+	if text == "" {
+		text = name
+	}
+	return utils.UniqueSlug(ctx, text, func(ctx context.Context, candidate string) (bool, error) {
+		return s.repo.SlugExists(ctx, candidate, excludeID)
+	})
+}
+
+func fallback(value, def string) string {
+	if value != "" {
+		return value
+	}
+	return def
+}
+
 func (s *service) mapAdminRowsToResponse(rows []dbgen.ListBrandsAdminRow) []BrandAdminResponse {
 	res := make([]BrandAdminResponse, 0)
 	for _, row := range rows {
@@ -320,6 +423,9 @@ func (s *service) mapAdminRowsToResponse(rows []dbgen.ListBrandsAdminRow) []Bran
 			CreatedAt:   row.CreatedAt,
 			UpdatedAt:   row.UpdatedAt,
 			DeletedAt:   nil, // Bisa diisi row.DeletedAt jika tipenya cocok
+
+			MetaTitle:       row.MetaTitle.String,
+			MetaDescription: row.MetaDescription.String,
 		})
 	}
 	return res
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"go-sqlc-starter/internal/api/v1/brand"
+	branderrors "go-sqlc-starter/internal/api/v1/brand/errors"
 	"go-sqlc-starter/internal/dbgen"
 	"go-sqlc-starter/internal/pkg/constants"
 
@@ -200,6 +201,7 @@ func TestBrandService_Create(t *testing.T) {
 		filename := "logo.png"
 		imgURL := "https://cloudinary.com/apple.png"
 
+		deps.repo.EXPECT().SlugExists(ctx, "apple", uuid.Nil).Return(false, nil)
 		expectTx(t, deps.sqlMock, true)
 		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
 
@@ -224,6 +226,7 @@ func TestBrandService_Create(t *testing.T) {
 
 	t.Run("negative - upload image failed (rollback)", func(t *testing.T) {
 		fakeFile := &mockFile{}
+		deps.repo.EXPECT().SlugExists(ctx, "apple", uuid.Nil).Return(false, nil)
 		expectTx(t, deps.sqlMock, false) // Expect Rollback
 		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
 
@@ -239,6 +242,7 @@ func TestBrandService_Create(t *testing.T) {
 	})
 
 	t.Run("negative - database create failed", func(t *testing.T) {
+		deps.repo.EXPECT().SlugExists(ctx, "apple", uuid.Nil).Return(false, nil)
 		expectTx(t, deps.sqlMock, false)
 		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
 
@@ -263,13 +267,26 @@ func TestBrandService_Update(t *testing.T) {
 			Return(dbgen.Brand{
 				ID:       id,
 				Name:     "Apple",
+				Slug:     "apple",
 				ImageUrl: sql.NullString{},
 				IsActive: dbgen.NewNullBool(true),
 			}, nil)
 
+		// Nama berubah -> slug baru + slug lama disimpan sebagai redirect
+		deps.repo.EXPECT().SlugExists(ctx, "updated-apple", id).Return(false, nil)
+
+		expectTx(t, deps.sqlMock, true)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+
 		deps.repo.EXPECT().
 			Update(ctx, gomock.Any()).
-			Return(dbgen.Brand{ID: id}, nil)
+			DoAndReturn(func(_ context.Context, arg dbgen.UpdateBrandParams) (dbgen.Brand, error) {
+				assert.Equal(t, "updated-apple", arg.Slug)
+				return dbgen.Brand{ID: id}, nil
+			})
+
+		deps.repo.EXPECT().DeleteSlugRedirect(ctx, "updated-apple").Return(nil)
+		deps.repo.EXPECT().CreateSlugRedirect(ctx, "apple", id).Return(nil)
 
 		deps.repo.EXPECT().
 			GetByID(ctx, id).
@@ -290,7 +307,9 @@ func TestBrandService_Update(t *testing.T) {
 		deps.repo.EXPECT().
 			GetByID(ctx, id).
 			Return(dbgen.Brand{
-				ID: id,
+				ID:   id,
+				Name: req.Name,
+				Slug: "updated-apple",
 				ImageUrl: dbgen.NewNullString(
 					"https://res.cloudinary.com/demo/image/upload/v1769161193/go-gadget/brands/brand-old.png",
 				),
@@ -305,6 +324,10 @@ func TestBrandService_Update(t *testing.T) {
 			UploadImage(ctx, fakeFile, gomock.Any(), constants.CloudinaryBrandFolder).
 			Return(imgURL, nil)
 
+		// Nama tidak berubah -> tanpa cek slug & redirect
+		expectTx(t, deps.sqlMock, true)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+
 		deps.repo.EXPECT().
 			Update(ctx, gomock.Any()).
 			Return(dbgen.Brand{ID: id}, nil)
@@ -337,6 +360,61 @@ func TestBrandService_Update(t *testing.T) {
 	})
 }
 
+func TestBrandService_GetBySlug(t *testing.T) {
+	deps := setupServiceTest(t)
+	defer deps.db.Close()
+
+	ctx := context.Background()
+	id := uuid.New()
+
+	t.Run("success - meta fallback to name and description", func(t *testing.T) {
+		deps.repo.EXPECT().
+			GetBySlug(ctx, "apple").
+			Return(dbgen.Brand{
+				ID:          id,
+				Name:        "Apple",
+				Slug:        "apple",
+				Description: dbgen.NewNullString("Premium Tech"),
+			}, nil)
+
+		res, err := deps.service.GetBySlug(ctx, "apple")
+		assert.NoError(t, err)
+		assert.Equal(t, "Apple", res.MetaTitle)
+		assert.Equal(t, "Premium Tech", res.MetaDescription)
+	})
+
+	t.Run("fail - not found", func(t *testing.T) {
+		deps.repo.EXPECT().
+			GetBySlug(ctx, "unknown").
+			Return(dbgen.Brand{}, sql.ErrNoRows)
+
+		_, err := deps.service.GetBySlug(ctx, "unknown")
+		assert.ErrorIs(t, err, branderrors.ErrBrandNotFound)
+	})
+}
+
+func TestBrandService_ResolveSlug(t *testing.T) {
+	deps := setupServiceTest(t)
+	defer deps.db.Close()
+
+	ctx := context.Background()
+
+	t.Run("success - old slug resolved", func(t *testing.T) {
+		deps.repo.EXPECT().GetSlugRedirect(ctx, "apple").Return("apple-inc", nil)
+
+		slug, err := deps.service.ResolveSlug(ctx, "apple")
+		assert.NoError(t, err)
+		assert.Equal(t, "apple-inc", slug)
+	})
+
+	t.Run("fail - no redirect", func(t *testing.T) {
+		deps.repo.EXPECT().GetSlugRedirect(ctx, "unknown").Return("", sql.ErrNoRows)
+
+		_, err := deps.service.ResolveSlug(ctx, "unknown")
+		assert.ErrorIs(t, err, branderrors.ErrBrandNotFound)
+	})
+}
+
 func TestBrandService_Delete(t *testing.T) {
 	deps := setupServiceTest(t)
 	defer deps.db.Close()
@@ -1,8 +1,12 @@
 package cart
 
 import (
+	"go-sqlc-starter/internal/pkg/apperror"
+	"go-sqlc-starter/internal/pkg/constants"
 	"go-sqlc-starter/internal/pkg/response"
 	"net/http"
+	"os"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
@@ -15,8 +19,49 @@ func NewController(s Service) *Controller {
 	return &Controller{service: s}
 }
 
+// owner tentukan pemilik cart: user login, atau guest dari cart token (header / cookie).
+// issue = true: guest tanpa token (atau token tidak valid) dibuatkan identitas baru
+// dan token-nya dikirim balik lewat cookie & header.
+func (c *Controller) owner(ctx *gin.Context, issue bool) (Owner, bool) {
+	if userID := ctx.GetString("user_id"); userID != "" {
+		return UserOwner(userID), true
+	}
+
+	token := ctx.GetHeader(constants.CartTokenHeader)
+	if token == "" {
+		token, _ = ctx.Cookie(constants.CartTokenCookie)
+	}
+	if token != "" {
+		guestID, err := ParseGuestToken(token)
+		if err == nil {
+			return GuestOwner(guestID), true
+		}
+		if !issue {
+			httpErr := apperror.ToHTTP(err)
+			response.Error(ctx, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+			return Owner{}, false
+		}
+	}
+
+	// Guest tanpa token: operasi baca cukup pakai owner kosong (cart kosong)
+	if !issue {
+		return Owner{}, true
+	}
+
+	guest := c.service.NewGuest()
+	maxAge := int(time.Until(guest.ExpiresAt).Seconds())
+	ctx.SetCookie(constants.CartTokenCookie, guest.Token, maxAge, "/", "", os.Getenv("APP_ENV") == "production", true)
+	ctx.Header(constants.CartTokenHeader, guest.Token)
+	return guest.Owner, true
+}
+
 func (c *Controller) Create(ctx *gin.Context) {
-	if err := c.service.Create(ctx, ctx.Param("userId")); err != nil {
+	owner, ok := c.owner(ctx, true)
+	if !ok {
+		return
+	}
+
+	if err := c.service.Create(ctx, owner); err != nil {
 		response.Error(ctx, http.StatusInternalServerError, "CREATE_ERROR", "Gagal membuat cart", err.Error())
 		return
 	}
@@ -24,7 +69,12 @@ func (c *Controller) Create(ctx *gin.Context) {
 }
 
 func (c *Controller) Count(ctx *gin.Context) {
-	count, err := c.service.Count(ctx, ctx.Param("userId"))
+	owner, ok := c.owner(ctx, false)
+	if !ok {
+		return
+	}
+
+	count, err := c.service.Count(ctx, owner)
 	if err != nil {
 		response.Error(ctx, http.StatusInternalServerError, "COUNT_ERROR", "Gagal hitung cart", err.Error())
 		return
@@ -33,7 +83,12 @@ func (c *Controller) Count(ctx *gin.Context) {
 }
 
 func (c *Controller) Detail(ctx *gin.Context) {
-	res, err := c.service.Detail(ctx, ctx.Param("userId"))
+	owner, ok := c.owner(ctx, false)
+	if !ok {
+		return
+	}
+
+	res, err := c.service.Detail(ctx, owner)
 	if err != nil {
 		response.Error(ctx, http.StatusInternalServerError, "DETAIL_ERROR", "Gagal mengambil detail cart", err.Error())
 		return
@@ -41,6 +96,27 @@ func (c *Controller) Detail(ctx *gin.Context) {
 	response.Success(ctx, http.StatusOK, res, nil)
 }
 
+func (c *Controller) AddItem(ctx *gin.Context) {
+	var req AddItemRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		response.Error(ctx, http.StatusBadRequest, "BAD_REQUEST", "Input tidak valid", err.Error())
+		return
+	}
+
+	// Guest tanpa token dibuatkan cart token baru
+	owner, ok := c.owner(ctx, true)
+	if !ok {
+		return
+	}
+
+	if err := c.service.AddItem(ctx, owner, req); err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(ctx, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+	response.Success(ctx, http.StatusCreated, nil, nil)
+}
+
 func (c *Controller) UpdateQty(ctx *gin.Context) {
 	var req UpdateQtyRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
@@ -48,13 +124,19 @@ func (c *Controller) UpdateQty(ctx *gin.Context) {
 		return
 	}
 
+	owner, ok := c.owner(ctx, false)
+	if !ok {
+		return
+	}
+
 	if err := c.service.UpdateQty(
 		ctx,
-		ctx.Param("userId"),
-		ctx.Param("productId"),
+		owner,
+		ctx.Param("id"),
 		req,
 	); err != nil {
-		response.Error(ctx, http.StatusInternalServerError, "UPDATE_ERROR", "Gagal update quantity", err.Error())
+		httpErr := apperror.ToHTTP(err)
+		response.Error(ctx, httpErr.Status, httpErr.Code, httpErr.Message, nil)
 		return
 	}
 
@@ -62,23 +144,40 @@ func (c *Controller) UpdateQty(ctx *gin.Context) {
 }
 
 func (c *Controller) Increment(ctx *gin.Context) {
-	if err := c.service.Increment(ctx, ctx.Param("userId"), ctx.Param("productId")); err != nil {
-		response.Error(ctx, http.StatusInternalServerError, "INCREMENT_ERROR", "Gagal menambah item", err.Error())
+	owner, ok := c.owner(ctx, false)
+	if !ok {
+		return
+	}
+
+	if err := c.service.Increment(ctx, owner, ctx.Param("id")); err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(ctx, httpErr.Status, httpErr.Code, httpErr.Message, nil)
 		return
 	}
 	response.Success(ctx, http.StatusOK, nil, nil)
 }
 
 func (c *Controller) Decrement(ctx *gin.Context) {
-	if err := c.service.Decrement(ctx, ctx.Param("userId"), ctx.Param("productId")); err != nil {
-		response.Error(ctx, http.StatusInternalServerError, "DECREMENT_ERROR", "Gagal mengurangi item", err.Error())
+	owner, ok := c.owner(ctx, false)
+	if !ok {
+		return
+	}
+
+	if err := c.service.Decrement(ctx, owner, ctx.Param("id")); err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(ctx, httpErr.Status, httpErr.Code, httpErr.Message, nil)
 		return
 	}
 	response.Success(ctx, http.StatusOK, nil, nil)
 }
 
 func (c *Controller) DeleteItem(ctx *gin.Context) {
-	if err := c.service.DeleteItem(ctx, ctx.Param("userId"), ctx.Param("productId")); err != nil {
+	owner, ok := c.owner(ctx, false)
+	if !ok {
+		return
+	}
+
+	if err := c.service.DeleteItem(ctx, owner, ctx.Param("id")); err != nil {
 		response.Error(ctx, http.StatusInternalServerError, "DELETE_ITEM_ERROR", "Gagal menghapus item", err.Error())
 		return
 	}
@@ -86,7 +185,12 @@ func (c *Controller) DeleteItem(ctx *gin.Context) {
 }
 
 func (c *Controller) Delete(ctx *gin.Context) {
-	if err := c.service.Delete(ctx, ctx.Param("userId")); err != nil {
+	owner, ok := c.owner(ctx, false)
+	if !ok {
+		return
+	}
+
+	if err := c.service.Delete(ctx, owner); err != nil {
 		response.Error(ctx, http.StatusInternalServerError, "DELETE_ERROR", "Gagal hapus cart", err.Error())
 		return
 	}
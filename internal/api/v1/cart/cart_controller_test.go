@@ -3,90 +3,117 @@ package cart
 import (
 	"context"
 	"errors"
+	carterrors "go-sqlc-starter/internal/api/v1/cart/errors"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
 type fakeCartService struct {
-	CreateFn func(ctx context.Context, userID string) error
-	CountFn  func(ctx context.Context, userID string) (int64, error)
-	DetailFn func(ctx context.Context, userID string) (CartDetailResponse, error)
+	CreateFn func(ctx context.Context, owner Owner) error
+	CountFn  func(ctx context.Context, owner Owner) (int64, error)
+	DetailFn func(ctx context.Context, owner Owner) (CartDetailResponse, error)
 
-	AddItemFn   func(ctx context.Context, userID string, req AddItemRequest) error
-	UpdateQtyFn func(ctx context.Context, userID, productID string, req UpdateQtyRequest) error
-	IncrementFn func(ctx context.Context, userID, productID string) error
-	DecrementFn func(ctx context.Context, userID, productID string) error
+	AddItemFn   func(ctx context.Context, owner Owner, req AddItemRequest) error
+	UpdateQtyFn func(ctx context.Context, owner Owner, productID string, req UpdateQtyRequest) error
+	IncrementFn func(ctx context.Context, owner Owner, productID string) error
+	DecrementFn func(ctx context.Context, owner Owner, productID string) error
 
-	DeleteItemFn func(ctx context.Context, userID, productID string) error
-	DeleteFn     func(ctx context.Context, userID string) error
+	DeleteItemFn func(ctx context.Context, owner Owner, productID string) error
+	DeleteFn     func(ctx context.Context, owner Owner) error
+
+	NewGuestFn   func() GuestSession
+	MergeGuestFn func(ctx context.Context, userID, guestToken string) error
 }
 
-func (f *fakeCartService) Create(ctx context.Context, userID string) error {
-	return f.CreateFn(ctx, userID)
+func (f *fakeCartService) Create(ctx context.Context, owner Owner) error {
+	return f.CreateFn(ctx, owner)
 }
 
-func (f *fakeCartService) Count(ctx context.Context, userID string) (int64, error) {
-	return f.CountFn(ctx, userID)
+func (f *fakeCartService) Count(ctx context.Context, owner Owner) (int64, error) {
+	return f.CountFn(ctx, owner)
 }
 
-func (f *fakeCartService) Detail(ctx context.Context, userID string) (CartDetailResponse, error) {
-	return f.DetailFn(ctx, userID)
+func (f *fakeCartService) Detail(ctx context.Context, owner Owner) (CartDetailResponse, error) {
+	return f.DetailFn(ctx, owner)
 }
 
 func (f *fakeCartService) AddItem(
 	ctx context.Context,
-	userID string,
+	owner Owner,
 	req AddItemRequest,
 ) error {
 	if f.AddItemFn == nil {
 		return nil // supaya test lain tidak panic
 	}
-	return f.AddItemFn(ctx, userID, req)
+	return f.AddItemFn(ctx, owner, req)
 }
 
 func (f *fakeCartService) UpdateQty(
 	ctx context.Context,
-	userID, productID string,
+	owner Owner, productID string,
 	req UpdateQtyRequest,
 ) error {
-	return f.UpdateQtyFn(ctx, userID, productID, req)
+	return f.UpdateQtyFn(ctx, owner, productID, req)
+}
+
+func (f *fakeCartService) Increment(ctx context.Context, owner Owner, productID string) error {
+	return f.IncrementFn(ctx, owner, productID)
+}
+
+func (f *fakeCartService) Decrement(ctx context.Context, owner Owner, productID string) error {
+	return f.DecrementFn(ctx, owner, productID)
 }
 
-func (f *fakeCartService) Increment(ctx context.Context, userID, productID string) error {
-	return f.IncrementFn(ctx, userID, productID)
+func (f *fakeCartService) DeleteItem(ctx context.Context, owner Owner, productID string) error {
+	return f.DeleteItemFn(ctx, owner, productID)
 }
 
-func (f *fakeCartService) Decrement(ctx context.Context, userID, productID string) error {
-	return f.DecrementFn(ctx, userID, productID)
+func (f *fakeCartService) Delete(ctx context.Context, owner Owner) error {
+	return f.DeleteFn(ctx, owner)
 }
 
-func (f *fakeCartService) DeleteItem(ctx context.Context, userID, productID string) error {
-	return f.DeleteItemFn(ctx, userID, productID)
+func (f *fakeCartService) NewGuest() GuestSession {
+	return f.NewGuestFn()
 }
 
-func (f *fakeCartService) Delete(ctx context.Context, userID string) error {
-	return f.DeleteFn(ctx, userID)
+func (f *fakeCartService) MergeGuest(ctx context.Context, userID, guestToken string) error {
+	return f.MergeGuestFn(ctx, userID, guestToken)
+}
+
+func (f *fakeCartService) PurgeExpiredGuests(ctx context.Context, before time.Time, limit int32) (int64, error) {
+	return 0, nil
+}
+
+// withUser simulasikan AuthMiddleware / OptionalAuthMiddleware untuk user login
+func withUser(userID string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set("user_id", userID)
+		c.Next()
+	}
 }
 
 func TestCartController_Create(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
 	svc := &fakeCartService{
-		CreateFn: func(ctx context.Context, userID string) error {
+		CreateFn: func(ctx context.Context, owner Owner) error {
 			return nil
 		},
 	}
 
 	ctrl := NewController(svc)
 	r := gin.New()
-	r.POST("/cart/:userId", ctrl.Create)
+	r.Use(withUser("user-1"))
+	r.POST("/cart", ctrl.Create)
 
-	req := httptest.NewRequest(http.MethodPost, "/cart/user-123", nil)
+	req := httptest.NewRequest(http.MethodPost, "/cart", nil)
 	w := httptest.NewRecorder()
 
 	r.ServeHTTP(w, req)
@@ -98,16 +125,17 @@ func TestCartController_Count(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		svc := &fakeCartService{
-			CountFn: func(ctx context.Context, userID string) (int64, error) {
+			CountFn: func(ctx context.Context, owner Owner) (int64, error) {
 				return 5, nil
 			},
 		}
 
 		ctrl := NewController(svc)
 		r := gin.New()
-		r.GET("/cart/:userId/count", ctrl.Count)
+		r.Use(withUser("user-1"))
+		r.GET("/cart/count", ctrl.Count)
 
-		req := httptest.NewRequest(http.MethodGet, "/cart/user-123/count", nil)
+		req := httptest.NewRequest(http.MethodGet, "/cart/count", nil)
 		w := httptest.NewRecorder()
 
 		r.ServeHTTP(w, req)
@@ -117,16 +145,17 @@ func TestCartController_Count(t *testing.T) {
 
 	t.Run("service_error", func(t *testing.T) {
 		svc := &fakeCartService{
-			CountFn: func(ctx context.Context, userID string) (int64, error) {
+			CountFn: func(ctx context.Context, owner Owner) (int64, error) {
 				return 0, errors.New("db error")
 			},
 		}
 
 		ctrl := NewController(svc)
 		r := gin.New()
-		r.GET("/cart/:userId/count", ctrl.Count)
+		r.Use(withUser("user-1"))
+		r.GET("/cart/count", ctrl.Count)
 
-		req := httptest.NewRequest(http.MethodGet, "/cart/user-123/count", nil)
+		req := httptest.NewRequest(http.MethodGet, "/cart/count", nil)
 		w := httptest.NewRecorder()
 
 		r.ServeHTTP(w, req)
@@ -139,17 +168,18 @@ func TestCartController_UpdateQty(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		svc := &fakeCartService{
-			UpdateQtyFn: func(ctx context.Context, userID, productID string, req UpdateQtyRequest) error {
+			UpdateQtyFn: func(ctx context.Context, owner Owner, productID string, req UpdateQtyRequest) error {
 				return nil
 			},
 		}
 
 		ctrl := NewController(svc)
 		r := gin.New()
-		r.PUT("/cart/:userId/items/:productId", ctrl.UpdateQty)
+		r.Use(withUser("user-1"))
+		r.PUT("/cart/items/:id", ctrl.UpdateQty)
 
 		body := `{"qty":2}`
-		req := httptest.NewRequest(http.MethodPut, "/cart/user-1/items/prod-1", strings.NewReader(body))
+		req := httptest.NewRequest(http.MethodPut, "/cart/items/prod-1", strings.NewReader(body))
 		req.Header.Set("Content-Type", "application/json")
 
 		w := httptest.NewRecorder()
@@ -161,9 +191,10 @@ func TestCartController_UpdateQty(t *testing.T) {
 	t.Run("bad_request", func(t *testing.T) {
 		ctrl := NewController(&fakeCartService{})
 		r := gin.New()
-		r.PUT("/cart/:userId/items/:productId", ctrl.UpdateQty)
+		r.Use(withUser("user-1"))
+		r.PUT("/cart/items/:id", ctrl.UpdateQty)
 
-		req := httptest.NewRequest(http.MethodPut, "/cart/user-1/items/prod-1", strings.NewReader(`{"qty":"x"}`))
+		req := httptest.NewRequest(http.MethodPut, "/cart/items/prod-1", strings.NewReader(`{"qty":"x"}`))
 		req.Header.Set("Content-Type", "application/json")
 
 		w := httptest.NewRecorder()
@@ -177,22 +208,23 @@ func TestCartController_IncrementDecrement(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
 	svc := &fakeCartService{
-		IncrementFn: func(ctx context.Context, userID, productID string) error { return nil },
-		DecrementFn: func(ctx context.Context, userID, productID string) error { return nil },
+		IncrementFn: func(ctx context.Context, owner Owner, productID string) error { return nil },
+		DecrementFn: func(ctx context.Context, owner Owner, productID string) error { return nil },
 	}
 
 	ctrl := NewController(svc)
 	r := gin.New()
+	r.Use(withUser("user-1"))
 
-	r.POST("/cart/:userId/items/:productId/increment", ctrl.Increment)
-	r.POST("/cart/:userId/items/:productId/decrement", ctrl.Decrement)
+	r.POST("/cart/items/:id/increment", ctrl.Increment)
+	r.POST("/cart/items/:id/decrement", ctrl.Decrement)
 
-	req := httptest.NewRequest(http.MethodPost, "/cart/u/items/p/increment", nil)
+	req := httptest.NewRequest(http.MethodPost, "/cart/items/p/increment", nil)
 	w := httptest.NewRecorder()
 	r.ServeHTTP(w, req)
 	assert.Equal(t, http.StatusOK, w.Code)
 
-	req = httptest.NewRequest(http.MethodPost, "/cart/u/items/p/decrement", nil)
+	req = httptest.NewRequest(http.MethodPost, "/cart/items/p/decrement", nil)
 	w = httptest.NewRecorder()
 	r.ServeHTTP(w, req)
 	assert.Equal(t, http.StatusOK, w.Code)
@@ -202,23 +234,136 @@ func TestCartController_Delete(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
 	svc := &fakeCartService{
-		DeleteItemFn: func(ctx context.Context, userID, productID string) error { return nil },
-		DeleteFn:     func(ctx context.Context, userID string) error { return nil },
+		DeleteItemFn: func(ctx context.Context, owner Owner, productID string) error { return nil },
+		DeleteFn:     func(ctx context.Context, owner Owner) error { return nil },
 	}
 
 	ctrl := NewController(svc)
 	r := gin.New()
+	r.Use(withUser("user-1"))
 
-	r.DELETE("/cart/:userId/items/:productId", ctrl.DeleteItem)
-	r.DELETE("/cart/:userId", ctrl.Delete)
+	r.DELETE("/cart/items/:id", ctrl.DeleteItem)
+	r.DELETE("/cart", ctrl.Delete)
 
-	req := httptest.NewRequest(http.MethodDelete, "/cart/u/items/p", nil)
+	req := httptest.NewRequest(http.MethodDelete, "/cart/items/p", nil)
 	w := httptest.NewRecorder()
 	r.ServeHTTP(w, req)
 	assert.Equal(t, http.StatusOK, w.Code)
 
-	req = httptest.NewRequest(http.MethodDelete, "/cart/u", nil)
+	req = httptest.NewRequest(http.MethodDelete, "/cart", nil)
 	w = httptest.NewRecorder()
 	r.ServeHTTP(w, req)
 	assert.Equal(t, http.StatusOK, w.Code)
 }
+
+func TestCartController_Guest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Setenv("CART_TOKEN_SECRET", "cart-test-secret")
+
+	t.Run("create without token issues cart token", func(t *testing.T) {
+		guestID := uuid.New()
+		token := SignGuestToken(guestID)
+		svc := &fakeCartService{
+			NewGuestFn: func() GuestSession {
+				return GuestSession{Owner: GuestOwner(guestID), Token: token, ExpiresAt: time.Now().Add(time.Hour)}
+			},
+			CreateFn: func(ctx context.Context, owner Owner) error {
+				assert.True(t, owner.IsGuest())
+				assert.Equal(t, guestID, owner.GuestID)
+				return nil
+			},
+		}
+
+		r := gin.New()
+		r.POST("/cart", NewController(svc).Create)
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/cart", nil))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, token, w.Header().Get("X-Cart-Token"))
+		assert.Contains(t, w.Header().Get("Set-Cookie"), "cart_token="+token)
+	})
+
+	t.Run("count with valid cookie uses guest owner", func(t *testing.T) {
+		guestID := uuid.New()
+		svc := &fakeCartService{
+			CountFn: func(ctx context.Context, owner Owner) (int64, error) {
+				assert.Equal(t, GuestOwner(guestID), owner)
+				return 2, nil
+			},
+		}
+
+		r := gin.New()
+		r.GET("/cart/count", NewController(svc).Count)
+
+		req := httptest.NewRequest(http.MethodGet, "/cart/count", nil)
+		req.AddCookie(&http.Cookie{Name: "cart_token", Value: SignGuestToken(guestID)})
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"count":2`)
+	})
+
+	t.Run("tampered token rejected", func(t *testing.T) {
+		r := gin.New()
+		r.GET("/cart/count", NewController(&fakeCartService{}).Count)
+
+		req := httptest.NewRequest(http.MethodGet, "/cart/count", nil)
+		req.Header.Set("X-Cart-Token", uuid.NewString()+".forged")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestCartController_AddItem(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Setenv("CART_TOKEN_SECRET", "cart-test-secret")
+
+	t.Run("guest add issues cart token", func(t *testing.T) {
+		guestID := uuid.New()
+		svc := &fakeCartService{
+			NewGuestFn: func() GuestSession {
+				return GuestSession{Owner: GuestOwner(guestID), Token: SignGuestToken(guestID), ExpiresAt: time.Now().Add(time.Hour)}
+			},
+			AddItemFn: func(ctx context.Context, owner Owner, req AddItemRequest) error {
+				assert.Equal(t, guestID, owner.GuestID)
+				assert.Equal(t, int32(2), req.Qty)
+				return nil
+			},
+		}
+
+		r := gin.New()
+		r.POST("/cart/items", NewController(svc).AddItem)
+
+		req := httptest.NewRequest(http.MethodPost, "/cart/items", strings.NewReader(`{"productId":"p-1","qty":2}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.NotEmpty(t, w.Header().Get("X-Cart-Token"))
+	})
+
+	t.Run("quantity over stock", func(t *testing.T) {
+		svc := &fakeCartService{
+			AddItemFn: func(ctx context.Context, owner Owner, req AddItemRequest) error {
+				return carterrors.ErrQtyExceedsLimit
+			},
+		}
+
+		r := gin.New()
+		r.Use(withUser("user-1"))
+		r.POST("/cart/items", NewController(svc).AddItem)
+
+		req := httptest.NewRequest(http.MethodPost, "/cart/items", strings.NewReader(`{"productId":"p-1","qty":50}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
@@ -1,9 +1,11 @@
 package cart
 
+import "time"
+
+// AddItemRequest harga tidak diterima dari client; price_at_add diisi dari harga efektif produk
 type AddItemRequest struct {
-	ProductID string `json:"book_id" binding:"required"`
+	ProductID string `json:"productId" binding:"required"`
 	Qty       int32  `json:"qty" binding:"required,min=1"`
-	Price     int32  `json:"priceCents" binding:"required"`
 }
 
 type UpdateQtyRequest struct {
@@ -18,10 +20,37 @@ type CartItemDetailResponse struct {
 	ID        string `json:"id"`
 	ProductID string `json:"productId"`
 	Qty       int32  `json:"qty"`
-	Price     int32  `json:"priceCents"`
+	Price     int32  `json:"priceCents"` // harga saat item ditambahkan
 	CreatedAt string `json:"createdAt"`
+
+	// Data produk live
+	Name         string  `json:"name"`
+	Slug         string  `json:"slug"`
+	ImageURL     string  `json:"imageUrl"`
+	CurrentPrice float64 `json:"currentPrice"`
+	Stock        int32   `json:"stock"`
+	IsActive     bool    `json:"isActive"`
+	LineTotal    float64 `json:"lineTotal"`
+
+	Warnings []CartItemWarning `json:"warnings"`
+}
+
+type CartItemWarning struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
 }
 
 type CartDetailResponse struct {
-	Items []CartItemDetailResponse `json:"items"`
+	Items     []CartItemDetailResponse `json:"items"`
+	ItemCount int32                    `json:"itemCount"` // total qty
+	Subtotal  float64                  `json:"subtotal"`  // harga terkini item yang masih bisa dibeli
+	// HasWarnings = true -> storefront minta konfirmasi sebelum checkout
+	HasWarnings bool `json:"hasWarnings"`
+}
+
+// GuestSession identitas guest baru; Token dikirim ke client (cookie / header)
+type GuestSession struct {
+	Owner     Owner
+	Token     string
+	ExpiresAt time.Time
 }
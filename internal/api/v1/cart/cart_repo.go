@@ -2,21 +2,34 @@ package cart
 
 import (
 	"context"
+	"database/sql"
 	"go-sqlc-starter/internal/dbgen"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 //go:generate mockgen -source=cart_repo.go -destination=../mock/cart/cart_repo_mock.go -package=mock
 type Repository interface {
+	WithTx(tx dbgen.DBTX) Repository
+
 	CreateCart(ctx context.Context, userID uuid.UUID) (dbgen.Cart, error)
 	GetByUserID(ctx context.Context, userID uuid.UUID) (dbgen.Cart, error)
 
+	// Guest
+	CreateGuestCart(ctx context.Context, guestID uuid.UUID, expiresAt time.Time) (dbgen.Cart, error)
+	GetByGuestID(ctx context.Context, guestID uuid.UUID) (dbgen.Cart, error)
+	LockGuestCart(ctx context.Context, guestID uuid.UUID) (dbgen.Cart, error)
+	MergeItems(ctx context.Context, targetCartID, sourceCartID uuid.UUID, maxQty int32) (int64, error)
+	DeleteExpiredGuests(ctx context.Context, before time.Time, limit int32) (int64, error)
+
 	Count(ctx context.Context, cartID uuid.UUID) (int64, error)
-	GetDetail(ctx context.Context, userID uuid.UUID) ([]dbgen.GetCartDetailRow, error)
+	GetDetail(ctx context.Context, cartID uuid.UUID) ([]dbgen.GetCartDetailRow, error)
 
-	AddItem(ctx context.Context, arg dbgen.AddCartItemParams) error
+	GetItem(ctx context.Context, cartID, productID uuid.UUID) (dbgen.CartItem, error)
+	AddItem(ctx context.Context, arg dbgen.AddCartItemParams) (dbgen.CartItem, error)
 	UpdateQty(ctx context.Context, arg dbgen.UpdateCartItemQtyParams) (dbgen.CartItem, error)
+	AdjustQty(ctx context.Context, arg dbgen.AdjustCartItemQtyParams) (dbgen.CartItem, error)
 
 	DeleteItem(ctx context.Context, cartID, productID uuid.UUID) error
 	Delete(ctx context.Context, cartID uuid.UUID) error
@@ -30,6 +43,13 @@ func NewRepository(q *dbgen.Queries) Repository {
 	return &repository{q: q}
 }
 
+func (r *repository) WithTx(tx dbgen.DBTX) Repository {
+	if sqlTx, ok := tx.(*sql.Tx); ok {
+		return &repository{q: r.q.WithTx(sqlTx)}
+	}
+	return r
+}
+
 func (r *repository) CreateCart(ctx context.Context, userID uuid.UUID) (dbgen.Cart, error) {
 	return r.q.CreateCart(ctx, userID)
 }
@@ -38,15 +58,52 @@ func (r *repository) GetByUserID(ctx context.Context, userID uuid.UUID) (dbgen.C
 	return r.q.GetCartByUserID(ctx, userID)
 }
 
+func (r *repository) CreateGuestCart(ctx context.Context, guestID uuid.UUID, expiresAt time.Time) (dbgen.Cart, error) {
+	return r.q.CreateGuestCart(ctx, dbgen.CreateGuestCartParams{
+		GuestID:   guestID,
+		ExpiresAt: expiresAt,
+	})
+}
+
+func (r *repository) GetByGuestID(ctx context.Context, guestID uuid.UUID) (dbgen.Cart, error) {
+	return r.q.GetCartByGuestID(ctx, guestID)
+}
+
+func (r *repository) LockGuestCart(ctx context.Context, guestID uuid.UUID) (dbgen.Cart, error) {
+	return r.q.LockGuestCart(ctx, guestID)
+}
+
+func (r *repository) MergeItems(ctx context.Context, targetCartID, sourceCartID uuid.UUID, maxQty int32) (int64, error) {
+	return r.q.MergeCartItems(ctx, dbgen.MergeCartItemsParams{
+		TargetCartID: targetCartID,
+		SourceCartID: sourceCartID,
+		MaxQty:       maxQty,
+	})
+}
+
+func (r *repository) DeleteExpiredGuests(ctx context.Context, before time.Time, limit int32) (int64, error) {
+	return r.q.DeleteExpiredGuestCarts(ctx, dbgen.DeleteExpiredGuestCartsParams{
+		Before:    before,
+		BatchSize: limit,
+	})
+}
+
 func (r *repository) Count(ctx context.Context, cartID uuid.UUID) (int64, error) {
 	return r.q.CountCartItems(ctx, cartID)
 }
 
-func (r *repository) GetDetail(ctx context.Context, userID uuid.UUID) ([]dbgen.GetCartDetailRow, error) {
-	return r.q.GetCartDetail(ctx, userID)
+func (r *repository) GetDetail(ctx context.Context, cartID uuid.UUID) ([]dbgen.GetCartDetailRow, error) {
+	return r.q.GetCartDetail(ctx, cartID)
+}
+
+func (r *repository) GetItem(ctx context.Context, cartID, productID uuid.UUID) (dbgen.CartItem, error) {
+	return r.q.GetCartItem(ctx, dbgen.GetCartItemParams{
+		CartID:    cartID,
+		ProductID: productID,
+	})
 }
 
-func (r *repository) AddItem(ctx context.Context, arg dbgen.AddCartItemParams) error {
+func (r *repository) AddItem(ctx context.Context, arg dbgen.AddCartItemParams) (dbgen.CartItem, error) {
 	return r.q.AddCartItem(ctx, arg)
 }
 
@@ -54,6 +111,10 @@ func (r *repository) UpdateQty(ctx context.Context, arg dbgen.UpdateCartItemQtyP
 	return r.q.UpdateCartItemQty(ctx, arg)
 }
 
+func (r *repository) AdjustQty(ctx context.Context, arg dbgen.AdjustCartItemQtyParams) (dbgen.CartItem, error) {
+	return r.q.AdjustCartItemQty(ctx, arg)
+}
+
 func (r *repository) DeleteItem(ctx context.Context, cartID, productID uuid.UUID) error {
 	return r.q.DeleteCartItem(ctx, dbgen.DeleteCartItemParams{
 		CartID:    cartID,
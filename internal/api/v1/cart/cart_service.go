@@ -3,10 +3,13 @@ package cart
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	autherrors "go-sqlc-starter/internal/api/v1/auth/errors"
 	carterrors "go-sqlc-starter/internal/api/v1/cart/errors"
 	producterrors "go-sqlc-starter/internal/api/v1/product/errors"
 	"go-sqlc-starter/internal/dbgen"
+	"go-sqlc-starter/internal/pkg/constants"
+	"strconv"
 	"time"
 
 	"github.com/go-playground/validator/v10"
@@ -15,28 +18,41 @@ import (
 
 //go:generate mockgen -source=cart_service.go -destination=../mock/cart/cart_service_mock.go -package=mock
 type Service interface {
-	Create(ctx context.Context, userID string) error
-	Count(ctx context.Context, userID string) (int64, error)
-	Detail(ctx context.Context, userID string) (CartDetailResponse, error)
+	Create(ctx context.Context, owner Owner) error
+	Count(ctx context.Context, owner Owner) (int64, error)
+	Detail(ctx context.Context, owner Owner) (CartDetailResponse, error)
 
-	AddItem(ctx context.Context, userID string, req AddItemRequest) error
-	UpdateQty(ctx context.Context, userID, productID string, req UpdateQtyRequest) error
+	AddItem(ctx context.Context, owner Owner, req AddItemRequest) error
+	UpdateQty(ctx context.Context, owner Owner, productID string, req UpdateQtyRequest) error
 
-	Increment(ctx context.Context, userID, productID string) error
-	Decrement(ctx context.Context, userID, productID string) error
+	Increment(ctx context.Context, owner Owner, productID string) error
+	Decrement(ctx context.Context, owner Owner, productID string) error
 
-	DeleteItem(ctx context.Context, userID, productID string) error
-	Delete(ctx context.Context, userID string) error
+	DeleteItem(ctx context.Context, owner Owner, productID string) error
+	Delete(ctx context.Context, owner Owner) error
+
+	// Guest cart
+	NewGuest() GuestSession
+	MergeGuest(ctx context.Context, userID string, guestToken string) error
+	PurgeExpiredGuests(ctx context.Context, before time.Time, limit int32) (int64, error)
 }
 
 type service struct {
+	db       *sql.DB
 	repo     Repository
+	guestTTL time.Duration
 	validate *validator.Validate
 }
 
-func NewService(r Repository) Service {
+// NewService guestTTL = masa berlaku cart guest sejak item terakhir ditambahkan
+func NewService(db *sql.DB, r Repository, guestTTL time.Duration) Service {
+	if guestTTL <= 0 {
+		guestTTL = constants.GuestCartDefaultTTL
+	}
 	return &service{
+		db:       db,
 		repo:     r,
+		guestTTL: guestTTL,
 		validate: validator.New(),
 	}
 }
@@ -61,8 +77,25 @@ func (s *service) parseProductID(productID string) (uuid.UUID, error) {
 	return id, nil
 }
 
-func (s *service) getCartOnly(ctx context.Context, uid uuid.UUID) (uuid.UUID, error) {
-	cart, err := s.repo.GetByUserID(ctx, uid)
+func (s *service) getCartOnly(ctx context.Context, owner Owner) (uuid.UUID, error) {
+	var (
+		cart dbgen.Cart
+		err  error
+	)
+	switch {
+	case owner.IsGuest():
+		cart, err = s.repo.GetByGuestID(ctx, owner.GuestID)
+	case owner.UserID != "":
+		uid, perr := s.parseUserID(owner.UserID)
+		if perr != nil {
+			return uuid.Nil, perr
+		}
+		cart, err = s.repo.GetByUserID(ctx, uid)
+	default:
+		// Guest tanpa token belum punya cart
+		return uuid.Nil, carterrors.ErrCartNotFound
+	}
+
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return uuid.Nil, carterrors.ErrCartNotFound
@@ -72,7 +105,21 @@ func (s *service) getCartOnly(ctx context.Context, uid uuid.UUID) (uuid.UUID, er
 	return cart.ID, nil
 }
 
-func (s *service) getOrCreateCart(ctx context.Context, uid uuid.UUID) (uuid.UUID, error) {
+func (s *service) getOrCreateCart(ctx context.Context, owner Owner) (uuid.UUID, error) {
+	// Guest: upsert sekaligus memperpanjang expires_at
+	if owner.IsGuest() {
+		cart, err := s.repo.CreateGuestCart(ctx, owner.GuestID, time.Now().Add(s.guestTTL))
+		if err != nil {
+			return uuid.Nil, err
+		}
+		return cart.ID, nil
+	}
+
+	uid, err := s.parseUserID(owner.UserID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
 	cart, err := s.repo.GetByUserID(ctx, uid)
 	if err == nil {
 		return cart.ID, nil
@@ -89,22 +136,13 @@ func (s *service) getOrCreateCart(ctx context.Context, uid uuid.UUID) (uuid.UUID
 // service methods
 // ========================
 
-func (s *service) Create(ctx context.Context, userID string) error {
-	uid, err := s.parseUserID(userID)
-	if err != nil {
-		return err
-	}
-	_, err = s.getOrCreateCart(ctx, uid)
+func (s *service) Create(ctx context.Context, owner Owner) error {
+	_, err := s.getOrCreateCart(ctx, owner)
 	return err
 }
 
-func (s *service) Count(ctx context.Context, userID string) (int64, error) {
-	uid, err := s.parseUserID(userID)
-	if err != nil {
-		return 0, err
-	}
-
-	cartID, err := s.getCartOnly(ctx, uid)
+func (s *service) Count(ctx context.Context, owner Owner) (int64, error) {
+	cartID, err := s.getCartOnly(ctx, owner)
 	if err != nil {
 		return 0, err
 	}
@@ -112,39 +150,119 @@ func (s *service) Count(ctx context.Context, userID string) (int64, error) {
 	return s.repo.Count(ctx, cartID)
 }
 
-func (s *service) Detail(ctx context.Context, userID string) (CartDetailResponse, error) {
-	uid, err := s.parseUserID(userID)
+func (s *service) Detail(ctx context.Context, owner Owner) (CartDetailResponse, error) {
+	cartID, err := s.getCartOnly(ctx, owner)
 	if err != nil {
+		// Belum punya cart = cart kosong
+		if err == carterrors.ErrCartNotFound {
+			return CartDetailResponse{Items: []CartItemDetailResponse{}}, nil
+		}
 		return CartDetailResponse{}, err
 	}
 
-	rows, err := s.repo.GetDetail(ctx, uid)
+	rows, err := s.repo.GetDetail(ctx, cartID)
 	if err != nil {
 		return CartDetailResponse{}, err
 	}
 
-	items := make([]CartItemDetailResponse, 0, len(rows))
+	res := CartDetailResponse{Items: make([]CartItemDetailResponse, 0, len(rows))}
 	for _, r := range rows {
-		items = append(items, CartItemDetailResponse{
-			ID:        r.ID.String(),
-			ProductID: r.ProductID.String(),
-			Qty:       r.Quantity,
-			Price:     r.PriceAtAdd,
-			CreatedAt: r.CreatedAt.Format(time.RFC3339),
+		currentPrice, _ := strconv.ParseFloat(r.CurrentPrice, 64)
+
+		item := CartItemDetailResponse{
+			ID:           r.ID.String(),
+			ProductID:    r.ProductID.String(),
+			Qty:          r.Quantity,
+			Price:        r.PriceAtAdd,
+			CreatedAt:    r.CreatedAt.Format(time.RFC3339),
+			Name:         r.ProductName,
+			Slug:         r.ProductSlug,
+			ImageURL:     r.ImageUrl,
+			CurrentPrice: currentPrice,
+			Stock:        r.Stock,
+			IsActive:     r.ProductExists && r.IsActive,
+			Warnings:     itemWarnings(r, currentPrice),
+		}
+
+		// Subtotal hanya dari item yang masih bisa dibeli, pakai harga terkini
+		if item.IsActive {
+			item.LineTotal = currentPrice * float64(r.Quantity)
+			res.Subtotal += item.LineTotal
+		}
+		res.ItemCount += r.Quantity
+		if len(item.Warnings) > 0 {
+			res.HasWarnings = true
+		}
+
+		res.Items = append(res.Items, item)
+	}
+
+	return res, nil
+}
+
+// itemWarnings validasi item terhadap data produk terkini
+func itemWarnings(r dbgen.GetCartDetailRow, currentPrice float64) []CartItemWarning {
+	warnings := []CartItemWarning{}
+
+	// Produk hilang / nonaktif: warning lain tidak relevan
+	if !r.ProductExists {
+		return append(warnings, CartItemWarning{
+			Code:    constants.CartWarningProductRemoved,
+			Message: "Product is no longer available",
+		})
+	}
+	if !r.IsActive {
+		return append(warnings, CartItemWarning{
+			Code:    constants.CartWarningProductInactive,
+			Message: "Product is currently not for sale",
+		})
+	}
+
+	switch {
+	case r.Stock <= 0:
+		warnings = append(warnings, CartItemWarning{
+			Code:    constants.CartWarningOutOfStock,
+			Message: "Product is out of stock",
+		})
+	case r.Stock < r.Quantity:
+		warnings = append(warnings, CartItemWarning{
+			Code:    constants.CartWarningInsufficientStock,
+			Message: fmt.Sprintf("Only %d left in stock", r.Stock),
+		})
+	}
+
+	if currentPrice != float64(r.PriceAtAdd) {
+		warnings = append(warnings, CartItemWarning{
+			Code:    constants.CartWarningPriceChanged,
+			Message: fmt.Sprintf("Price changed from %d to %.0f", r.PriceAtAdd, currentPrice),
 		})
 	}
 
-	return CartDetailResponse{Items: items}, nil
+	return warnings
 }
 
-func (s *service) AddItem(ctx context.Context, userID string, req AddItemRequest) error {
+// HasBlockingWarning item tidak bisa di-checkout (produk hilang / nonaktif / stok kurang).
+// PRICE_CHANGED hanya informasi: checkout tetap jalan dengan harga terkini.
+func HasBlockingWarning(item CartItemDetailResponse) bool {
+	for _, w := range item.Warnings {
+		switch w.Code {
+		case constants.CartWarningProductRemoved, constants.CartWarningProductInactive,
+			constants.CartWarningOutOfStock, constants.CartWarningInsufficientStock:
+			return true
+		}
+	}
+	return false
+}
+
+func (s *service) AddItem(ctx context.Context, owner Owner, req AddItemRequest) error {
 	if err := s.validate.Struct(req); err != nil {
 		return carterrors.MapValidationError(err)
 	}
-
-	uid, err := s.parseUserID(userID)
-	if err != nil {
-		return err
+	if req.Qty <= 0 {
+		return carterrors.ErrQtyMustBeGreaterThanZero
+	}
+	if req.Qty > constants.CartMaxItemQty {
+		return carterrors.ErrQtyExceedsLimit
 	}
 
 	pid, err := s.parseProductID(req.ProductID)
@@ -152,20 +270,25 @@ func (s *service) AddItem(ctx context.Context, userID string, req AddItemRequest
 		return err
 	}
 
-	cartID, err := s.getOrCreateCart(ctx, uid)
+	cartID, err := s.getOrCreateCart(ctx, owner)
 	if err != nil {
 		return err
 	}
 
-	return s.repo.AddItem(ctx, dbgen.AddCartItemParams{
-		CartID:     cartID,
-		ProductID:  pid,
-		Quantity:   req.Qty,
-		PriceAtAdd: req.Price,
+	// Qty dijumlah dengan item yang sudah ada; batas stok & max qty dicek di query
+	_, err = s.repo.AddItem(ctx, dbgen.AddCartItemParams{
+		CartID:    cartID,
+		ProductID: pid,
+		Quantity:  req.Qty,
+		MaxQty:    constants.CartMaxItemQty,
 	})
+	if err == sql.ErrNoRows {
+		return carterrors.ErrQtyExceedsLimit
+	}
+	return err
 }
 
-func (s *service) UpdateQty(ctx context.Context, userID, productID string, req UpdateQtyRequest) error {
+func (s *service) UpdateQty(ctx context.Context, owner Owner, productID string, req UpdateQtyRequest) error {
 	if err := s.validate.Struct(req); err != nil {
 		return carterrors.MapValidationError(err)
 	}
@@ -173,10 +296,8 @@ func (s *service) UpdateQty(ctx context.Context, userID, productID string, req U
 	if req.Qty <= 0 {
 		return carterrors.ErrInvalidQty
 	}
-
-	uid, err := s.parseUserID(userID)
-	if err != nil {
-		return err
+	if req.Qty > constants.CartMaxItemQty {
+		return carterrors.ErrQtyExceedsLimit
 	}
 
 	pid, err := s.parseProductID(productID)
@@ -184,7 +305,7 @@ func (s *service) UpdateQty(ctx context.Context, userID, productID string, req U
 		return err
 	}
 
-	cartID, err := s.getCartOnly(ctx, uid)
+	cartID, err := s.getCartOnly(ctx, owner)
 	if err != nil {
 		return err
 	}
@@ -193,109 +314,160 @@ func (s *service) UpdateQty(ctx context.Context, userID, productID string, req U
 		CartID:    cartID,
 		ProductID: pid,
 		Quantity:  req.Qty,
+		MaxQty:    constants.CartMaxItemQty,
 	})
-
 	if err == sql.ErrNoRows {
-		return carterrors.ErrCartItemNotFound
+		return s.noRowsError(ctx, cartID, pid)
 	}
 
 	return err
 }
 
-func (s *service) Increment(ctx context.Context, userID, productID string) error {
-	uid, err := s.parseUserID(userID)
+func (s *service) Increment(ctx context.Context, owner Owner, productID string) error {
+	_, err := s.adjust(ctx, owner, productID, 1)
+	return err
+}
+
+func (s *service) Decrement(ctx context.Context, owner Owner, productID string) error {
+	item, err := s.adjust(ctx, owner, productID, -1)
 	if err != nil {
 		return err
 	}
 
+	// Qty habis -> item dihapus dari cart
+	if item.Quantity <= 0 {
+		return s.repo.DeleteItem(ctx, item.CartID, item.ProductID)
+	}
+	return nil
+}
+
+// adjust ubah qty relatif (atomic di database, aman untuk request paralel)
+func (s *service) adjust(ctx context.Context, owner Owner, productID string, delta int32) (dbgen.CartItem, error) {
 	pid, err := s.parseProductID(productID)
 	if err != nil {
-		return err
+		return dbgen.CartItem{}, err
 	}
 
-	cartID, err := s.getCartOnly(ctx, uid)
+	cartID, err := s.getCartOnly(ctx, owner)
 	if err != nil {
-		return err
+		return dbgen.CartItem{}, err
 	}
 
-	// komentar: increment = qty + 1 via UpdateQty
-	_, err = s.repo.UpdateQty(ctx, dbgen.UpdateCartItemQtyParams{
+	item, err := s.repo.AdjustQty(ctx, dbgen.AdjustCartItemQtyParams{
 		CartID:    cartID,
 		ProductID: pid,
-		Quantity:  1,
+		Delta:     delta,
+		MaxQty:    constants.CartMaxItemQty,
 	})
-
 	if err == sql.ErrNoRows {
-		return carterrors.ErrCartItemNotFound
+		return dbgen.CartItem{}, s.noRowsError(ctx, cartID, pid)
 	}
-
-	return err
+	if err != nil {
+		return dbgen.CartItem{}, err
+	}
+	return item, nil
 }
 
-func (s *service) Decrement(ctx context.Context, userID, productID string) error {
-	uid, err := s.parseUserID(userID)
-	if err != nil {
+// noRowsError bedakan item tidak ada vs update ditolak karena batas stok / max qty
+func (s *service) noRowsError(ctx context.Context, cartID, productID uuid.UUID) error {
+	_, err := s.repo.GetItem(ctx, cartID, productID)
+	switch {
+	case err == sql.ErrNoRows:
+		return carterrors.ErrCartItemNotFound
+	case err != nil:
 		return err
+	default:
+		return carterrors.ErrQtyExceedsLimit
 	}
+}
 
+func (s *service) DeleteItem(ctx context.Context, owner Owner, productID string) error {
 	pid, err := s.parseProductID(productID)
 	if err != nil {
 		return err
 	}
 
-	cartID, err := s.getCartOnly(ctx, uid)
+	cartID, err := s.getCartOnly(ctx, owner)
 	if err != nil {
 		return err
 	}
 
-	// komentar:
-	// decrement TIDAK boleh bikin qty <= 0
-	item, err := s.repo.UpdateQty(ctx, dbgen.UpdateCartItemQtyParams{
-		CartID:    cartID,
-		ProductID: pid,
-		Quantity:  -1,
-	})
+	return s.repo.DeleteItem(ctx, cartID, pid)
+}
 
-	if err == sql.ErrNoRows {
-		return carterrors.ErrCartItemNotFound
+func (s *service) Delete(ctx context.Context, owner Owner) error {
+	cartID, err := s.getCartOnly(ctx, owner)
+	if err != nil {
+		return err
 	}
 
-	if item.Quantity <= 0 {
-		return s.repo.DeleteItem(ctx, cartID, pid)
-	}
+	return s.repo.Delete(ctx, cartID)
+}
 
-	return nil
+// ========================
+// guest cart
+// ========================
+
+// NewGuest membuat identitas guest baru. Cart-nya baru dibuat saat item pertama ditambahkan.
+func (s *service) NewGuest() GuestSession {
+	guestID := uuid.New()
+	return GuestSession{
+		Owner:     GuestOwner(guestID),
+		Token:     SignGuestToken(guestID),
+		ExpiresAt: time.Now().Add(s.guestTTL),
+	}
 }
 
-func (s *service) DeleteItem(ctx context.Context, userID, productID string) error {
+// MergeGuest pindahkan isi cart guest ke cart user (dipanggil setelah login / register).
+// Qty produk yang sama dijumlah dan dibatasi stok & max qty; cart guest dihapus setelahnya.
+func (s *service) MergeGuest(ctx context.Context, userID string, guestToken string) error {
 	uid, err := s.parseUserID(userID)
 	if err != nil {
 		return err
 	}
-
-	pid, err := s.parseProductID(productID)
+	guestID, err := ParseGuestToken(guestToken)
 	if err != nil {
 		return err
 	}
 
-	cartID, err := s.getCartOnly(ctx, uid)
+	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
-		return err
+		return carterrors.ErrCartMergeFailed
 	}
+	defer tx.Rollback()
 
-	return s.repo.DeleteItem(ctx, cartID, pid)
-}
+	qtx := s.repo.WithTx(tx)
 
-func (s *service) Delete(ctx context.Context, userID string) error {
-	uid, err := s.parseUserID(userID)
+	// 1. Kunci cart guest; tidak ada / kedaluwarsa / sudah di-merge = tidak ada yang dipindah
+	guestCart, err := qtx.LockGuestCart(ctx, guestID)
 	if err != nil {
-		return err
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		return carterrors.ErrCartMergeFailed
 	}
 
-	cartID, err := s.getCartOnly(ctx, uid)
+	// 2. Cart user (dibuat jika belum ada)
+	userCart, err := qtx.CreateCart(ctx, uid)
 	if err != nil {
-		return err
+		return carterrors.ErrCartMergeFailed
 	}
 
-	return s.repo.Delete(ctx, cartID)
+	// 3. Gabungkan item lalu hapus cart guest
+	if _, err := qtx.MergeItems(ctx, userCart.ID, guestCart.ID, constants.CartMaxItemQty); err != nil {
+		return carterrors.ErrCartMergeFailed
+	}
+	if err := qtx.Delete(ctx, guestCart.ID); err != nil {
+		return carterrors.ErrCartMergeFailed
+	}
+
+	if err := tx.Commit(); err != nil {
+		return carterrors.ErrCartMergeFailed
+	}
+	return nil
+}
+
+// PurgeExpiredGuests hapus cart guest yang expires_at-nya sudah lewat (per batch)
+func (s *service) PurgeExpiredGuests(ctx context.Context, before time.Time, limit int32) (int64, error) {
+	return s.repo.DeleteExpiredGuests(ctx, before, limit)
 }
@@ -8,9 +8,11 @@ import (
 	carterrors "go-sqlc-starter/internal/api/v1/cart/errors"
 	mock "go-sqlc-starter/internal/api/v1/mock/cart"
 	"go-sqlc-starter/internal/dbgen"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -21,7 +23,7 @@ func TestCart_Create(t *testing.T) {
 	defer ctrl.Finish()
 
 	repo := mock.NewMockRepository(ctrl)
-	svc := cart.NewService(repo)
+	svc := cart.NewService(nil, repo, time.Hour)
 	ctx := context.Background()
 
 	t.Run("success_already_exists", func(t *testing.T) {
@@ -32,7 +34,7 @@ func TestCart_Create(t *testing.T) {
 			GetByUserID(ctx, userID).
 			Return(dbgen.Cart{ID: cartID}, nil)
 
-		err := svc.Create(ctx, userID.String())
+		err := svc.Create(ctx, cart.UserOwner(userID.String()))
 		assert.NoError(t, err)
 	})
 
@@ -48,12 +50,12 @@ func TestCart_Create(t *testing.T) {
 			CreateCart(ctx, userID).
 			Return(dbgen.Cart{ID: cartID}, nil)
 
-		err := svc.Create(ctx, userID.String())
+		err := svc.Create(ctx, cart.UserOwner(userID.String()))
 		assert.NoError(t, err)
 	})
 
 	t.Run("error_invalid_user_id", func(t *testing.T) {
-		err := svc.Create(ctx, "invalid-uuid")
+		err := svc.Create(ctx, cart.UserOwner("invalid-uuid"))
 		assert.Error(t, err)
 	})
 
@@ -68,7 +70,7 @@ func TestCart_Create(t *testing.T) {
 			CreateCart(ctx, userID).
 			Return(dbgen.Cart{}, errors.New("db error"))
 
-		err := svc.Create(ctx, userID.String())
+		err := svc.Create(ctx, cart.UserOwner(userID.String()))
 		assert.Error(t, err)
 	})
 }
@@ -78,7 +80,7 @@ func TestCart_Count(t *testing.T) {
 	defer ctrl.Finish()
 
 	repo := mock.NewMockRepository(ctrl)
-	svc := cart.NewService(repo)
+	svc := cart.NewService(nil, repo, time.Hour)
 	ctx := context.Background()
 
 	t.Run("success", func(t *testing.T) {
@@ -88,7 +90,7 @@ func TestCart_Count(t *testing.T) {
 		repo.EXPECT().GetByUserID(ctx, userID).Return(dbgen.Cart{ID: cartID}, nil)
 		repo.EXPECT().Count(ctx, cartID).Return(int64(3), nil)
 
-		count, err := svc.Count(ctx, userID.String())
+		count, err := svc.Count(ctx, cart.UserOwner(userID.String()))
 		assert.NoError(t, err)
 		assert.Equal(t, int64(3), count)
 	})
@@ -100,7 +102,7 @@ func TestCart_Count(t *testing.T) {
 			GetByUserID(ctx, userID).
 			Return(dbgen.Cart{}, sql.ErrNoRows)
 
-		_, err := svc.Count(ctx, userID.String())
+		_, err := svc.Count(ctx, cart.UserOwner(userID.String()))
 		assert.Error(t, err)
 	})
 }
@@ -110,7 +112,7 @@ func TestCart_Detail(t *testing.T) {
 	defer ctrl.Finish()
 
 	repo := mock.NewMockRepository(ctrl)
-	svc := cart.NewService(repo)
+	svc := cart.NewService(nil, repo, time.Hour)
 	ctx := context.Background()
 
 	t.Run("success", func(t *testing.T) {
@@ -129,21 +131,88 @@ func TestCart_Detail(t *testing.T) {
 			},
 		}
 
-		repo.EXPECT().GetDetail(ctx, userID).Return(rows, nil)
+		cartID := uuid.New()
+		repo.EXPECT().GetByUserID(ctx, userID).Return(dbgen.Cart{ID: cartID}, nil)
+		repo.EXPECT().GetDetail(ctx, cartID).Return(rows, nil)
 
-		res, err := svc.Detail(ctx, userID.String())
+		res, err := svc.Detail(ctx, cart.UserOwner(userID.String()))
 		assert.NoError(t, err)
 		assert.Len(t, res.Items, 1)
 	})
 
+	t.Run("success_enriched_with_warnings", func(t *testing.T) {
+		userID := uuid.New()
+		cartID := uuid.New()
+		now := time.Now()
+
+		rows := []dbgen.GetCartDetailRow{
+			// Normal: harga & stok masih sesuai
+			{ID: uuid.New(), ProductID: uuid.New(), Quantity: 2, PriceAtAdd: 10000, CreatedAt: now,
+				ProductName: "Kaos", ProductSlug: "kaos", CurrentPrice: "10000.00", Stock: 5, ProductExists: true, IsActive: true},
+			// Harga naik & stok kurang dari qty
+			{ID: uuid.New(), ProductID: uuid.New(), Quantity: 3, PriceAtAdd: 20000, CreatedAt: now,
+				ProductName: "Topi", CurrentPrice: "25000.00", Stock: 1, ProductExists: true, IsActive: true},
+			// Stok habis
+			{ID: uuid.New(), ProductID: uuid.New(), Quantity: 1, PriceAtAdd: 5000, CreatedAt: now,
+				CurrentPrice: "5000.00", Stock: 0, ProductExists: true, IsActive: true},
+			// Produk dihapus: tidak masuk subtotal
+			{ID: uuid.New(), ProductID: uuid.New(), Quantity: 4, PriceAtAdd: 7000, CreatedAt: now,
+				CurrentPrice: "0", ProductExists: false},
+		}
+
+		repo.EXPECT().GetByUserID(ctx, userID).Return(dbgen.Cart{ID: cartID}, nil)
+		repo.EXPECT().GetDetail(ctx, cartID).Return(rows, nil)
+
+		res, err := svc.Detail(ctx, cart.UserOwner(userID.String()))
+		assert.NoError(t, err)
+		assert.Len(t, res.Items, 4)
+		assert.Equal(t, int32(10), res.ItemCount)
+		assert.Equal(t, 20000.0+75000.0+5000.0, res.Subtotal)
+		assert.True(t, res.HasWarnings)
+
+		codes := func(item cart.CartItemDetailResponse) []string {
+			var out []string
+			for _, w := range item.Warnings {
+				out = append(out, w.Code)
+			}
+			return out
+		}
+		assert.Empty(t, res.Items[0].Warnings)
+		assert.Equal(t, "Kaos", res.Items[0].Name)
+		assert.Equal(t, 20000.0, res.Items[0].LineTotal)
+		assert.Equal(t, []string{"INSUFFICIENT_STOCK", "PRICE_CHANGED"}, codes(res.Items[1]))
+		assert.Equal(t, []string{"OUT_OF_STOCK"}, codes(res.Items[2]))
+		assert.Equal(t, []string{"PRODUCT_REMOVED"}, codes(res.Items[3]))
+		assert.False(t, res.Items[3].IsActive)
+		assert.Zero(t, res.Items[3].LineTotal)
+	})
+
+	t.Run("inactive_product", func(t *testing.T) {
+		userID := uuid.New()
+		cartID := uuid.New()
+
+		repo.EXPECT().GetByUserID(ctx, userID).Return(dbgen.Cart{ID: cartID}, nil)
+		repo.EXPECT().GetDetail(ctx, cartID).Return([]dbgen.GetCartDetailRow{
+			{ID: uuid.New(), ProductID: uuid.New(), Quantity: 1, PriceAtAdd: 1000, CurrentPrice: "2000.00", Stock: 3, ProductExists: true, IsActive: false},
+		}, nil)
+
+		res, err := svc.Detail(ctx, cart.UserOwner(userID.String()))
+		assert.NoError(t, err)
+		assert.Len(t, res.Items[0].Warnings, 1)
+		assert.Equal(t, "PRODUCT_INACTIVE", res.Items[0].Warnings[0].Code)
+		assert.Zero(t, res.Subtotal)
+	})
+
 	t.Run("error_repo_fail", func(t *testing.T) {
 		userID := uuid.New()
+		cartID := uuid.New()
 
+		repo.EXPECT().GetByUserID(ctx, userID).Return(dbgen.Cart{ID: cartID}, nil)
 		repo.EXPECT().
-			GetDetail(ctx, userID).
+			GetDetail(ctx, cartID).
 			Return(nil, errors.New("db error"))
 
-		_, err := svc.Detail(ctx, userID.String())
+		_, err := svc.Detail(ctx, cart.UserOwner(userID.String()))
 		assert.Error(t, err)
 	})
 }
@@ -153,7 +222,7 @@ func TestCart_AddItem(t *testing.T) {
 	defer ctrl.Finish()
 
 	repo := mock.NewMockRepository(ctrl)
-	svc := cart.NewService(repo)
+	svc := cart.NewService(nil, repo, time.Hour)
 	ctx := context.Background()
 
 	t.Run("success_add_item", func(t *testing.T) {
@@ -171,22 +240,20 @@ func TestCart_AddItem(t *testing.T) {
 
 		repo.EXPECT().
 			AddItem(ctx, gomock.Any()).
-			Return(nil)
+			Return(dbgen.CartItem{}, nil)
 
-		err := svc.AddItem(ctx, userID.String(), cart.AddItemRequest{
+		err := svc.AddItem(ctx, cart.UserOwner(userID.String()), cart.AddItemRequest{
 			ProductID: prodID.String(),
 			Qty:       2,
-			Price:     10000,
 		})
 
 		assert.NoError(t, err)
 	})
 
 	t.Run("error_invalid_product_id", func(t *testing.T) {
-		err := svc.AddItem(ctx, uuid.New().String(), cart.AddItemRequest{
+		err := svc.AddItem(ctx, cart.UserOwner(uuid.New().String()), cart.AddItemRequest{
 			ProductID: "invalid",
 			Qty:       1,
-			Price:     1000,
 		})
 		assert.Error(t, err)
 	})
@@ -197,18 +264,43 @@ func TestCart_Increment_Decrement(t *testing.T) {
 	defer ctrl.Finish()
 
 	repo := mock.NewMockRepository(ctrl)
-	svc := cart.NewService(repo)
+	svc := cart.NewService(nil, repo, time.Hour)
 	ctx := context.Background()
 
 	userID := uuid.New()
 	cartID := uuid.New()
 	prodID := uuid.New()
 
+	adjust := func(delta int32) dbgen.AdjustCartItemQtyParams {
+		return dbgen.AdjustCartItemQtyParams{CartID: cartID, ProductID: prodID, Delta: delta, MaxQty: 99}
+	}
+
 	t.Run("increment_success", func(t *testing.T) {
 		repo.EXPECT().GetByUserID(ctx, userID).Return(dbgen.Cart{ID: cartID}, nil)
-		repo.EXPECT().UpdateQty(ctx, gomock.Any()).Return(dbgen.CartItem{}, nil)
+		repo.EXPECT().
+			AdjustQty(ctx, adjust(1)).
+			Return(dbgen.CartItem{CartID: cartID, ProductID: prodID, Quantity: 3}, nil)
+
+		err := svc.Increment(ctx, cart.UserOwner(userID.String()), prodID.String())
+		assert.NoError(t, err)
+	})
+
+	t.Run("increment_over_stock_or_limit", func(t *testing.T) {
+		repo.EXPECT().GetByUserID(ctx, userID).Return(dbgen.Cart{ID: cartID}, nil)
+		repo.EXPECT().AdjustQty(ctx, adjust(1)).Return(dbgen.CartItem{}, sql.ErrNoRows)
+		repo.EXPECT().GetItem(ctx, cartID, prodID).Return(dbgen.CartItem{Quantity: 5}, nil)
+
+		err := svc.Increment(ctx, cart.UserOwner(userID.String()), prodID.String())
+		assert.Equal(t, carterrors.ErrQtyExceedsLimit, err)
+	})
+
+	t.Run("decrement_keeps_item", func(t *testing.T) {
+		repo.EXPECT().GetByUserID(ctx, userID).Return(dbgen.Cart{ID: cartID}, nil)
+		repo.EXPECT().
+			AdjustQty(ctx, adjust(-1)).
+			Return(dbgen.CartItem{CartID: cartID, ProductID: prodID, Quantity: 1}, nil)
 
-		err := svc.Increment(ctx, userID.String(), prodID.String())
+		err := svc.Decrement(ctx, cart.UserOwner(userID.String()), prodID.String())
 		assert.NoError(t, err)
 	})
 
@@ -216,14 +308,14 @@ func TestCart_Increment_Decrement(t *testing.T) {
 		repo.EXPECT().GetByUserID(ctx, userID).Return(dbgen.Cart{ID: cartID}, nil)
 
 		repo.EXPECT().
-			UpdateQty(ctx, gomock.Any()).
-			Return(dbgen.CartItem{Quantity: 0}, nil)
+			AdjustQty(ctx, adjust(-1)).
+			Return(dbgen.CartItem{CartID: cartID, ProductID: prodID, Quantity: 0}, nil)
 
 		repo.EXPECT().
 			DeleteItem(ctx, cartID, prodID).
 			Return(nil)
 
-		err := svc.Decrement(ctx, userID.String(), prodID.String())
+		err := svc.Decrement(ctx, cart.UserOwner(userID.String()), prodID.String())
 		assert.NoError(t, err)
 	})
 
@@ -233,12 +325,74 @@ func TestCart_Increment_Decrement(t *testing.T) {
 			Return(dbgen.Cart{ID: cartID}, nil)
 
 		repo.EXPECT().
-			UpdateQty(ctx, gomock.Any()).
+			AdjustQty(ctx, adjust(1)).
+			Return(dbgen.CartItem{}, sql.ErrNoRows)
+		repo.EXPECT().
+			GetItem(ctx, cartID, prodID).
 			Return(dbgen.CartItem{}, sql.ErrNoRows)
 
-		err := svc.Increment(ctx, userID.String(), prodID.String())
+		err := svc.Increment(ctx, cart.UserOwner(userID.String()), prodID.String())
 		assert.Equal(t, carterrors.ErrCartItemNotFound, err)
 	})
+
+	t.Run("decrement_db_error_does_not_delete", func(t *testing.T) {
+		repo.EXPECT().GetByUserID(ctx, userID).Return(dbgen.Cart{ID: cartID}, nil)
+		repo.EXPECT().AdjustQty(ctx, adjust(-1)).Return(dbgen.CartItem{}, errors.New("db error"))
+
+		err := svc.Decrement(ctx, cart.UserOwner(userID.String()), prodID.String())
+		assert.Error(t, err)
+	})
+}
+
+func TestCart_QtyBounds(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockRepository(ctrl)
+	svc := cart.NewService(nil, repo, time.Hour)
+	ctx := context.Background()
+
+	userID := uuid.New()
+	cartID := uuid.New()
+	prodID := uuid.New()
+
+	t.Run("add_item_over_max_rejected_before_query", func(t *testing.T) {
+		err := svc.AddItem(ctx, cart.UserOwner(userID.String()), cart.AddItemRequest{
+			ProductID: prodID.String(),
+			Qty:       100,
+		})
+		assert.Equal(t, carterrors.ErrQtyExceedsLimit, err)
+	})
+
+	t.Run("add_item_over_stock", func(t *testing.T) {
+		repo.EXPECT().GetByUserID(ctx, userID).Return(dbgen.Cart{ID: cartID}, nil)
+		repo.EXPECT().AddItem(ctx, gomock.Any()).Return(dbgen.CartItem{}, sql.ErrNoRows)
+
+		err := svc.AddItem(ctx, cart.UserOwner(userID.String()), cart.AddItemRequest{
+			ProductID: prodID.String(),
+			Qty:       5,
+		})
+		assert.Equal(t, carterrors.ErrQtyExceedsLimit, err)
+	})
+
+	t.Run("update_qty_passes_cap", func(t *testing.T) {
+		repo.EXPECT().GetByUserID(ctx, userID).Return(dbgen.Cart{ID: cartID}, nil)
+		repo.EXPECT().
+			UpdateQty(ctx, dbgen.UpdateCartItemQtyParams{CartID: cartID, ProductID: prodID, Quantity: 4, MaxQty: 99}).
+			Return(dbgen.CartItem{Quantity: 4}, nil)
+
+		err := svc.UpdateQty(ctx, cart.UserOwner(userID.String()), prodID.String(), cart.UpdateQtyRequest{Qty: 4})
+		assert.NoError(t, err)
+	})
+
+	t.Run("update_qty_over_stock", func(t *testing.T) {
+		repo.EXPECT().GetByUserID(ctx, userID).Return(dbgen.Cart{ID: cartID}, nil)
+		repo.EXPECT().UpdateQty(ctx, gomock.Any()).Return(dbgen.CartItem{}, sql.ErrNoRows)
+		repo.EXPECT().GetItem(ctx, cartID, prodID).Return(dbgen.CartItem{Quantity: 1}, nil)
+
+		err := svc.UpdateQty(ctx, cart.UserOwner(userID.String()), prodID.String(), cart.UpdateQtyRequest{Qty: 50})
+		assert.Equal(t, carterrors.ErrQtyExceedsLimit, err)
+	})
 }
 
 func TestCart_Delete(t *testing.T) {
@@ -246,7 +400,7 @@ func TestCart_Delete(t *testing.T) {
 	defer ctrl.Finish()
 
 	repo := mock.NewMockRepository(ctrl)
-	svc := cart.NewService(repo)
+	svc := cart.NewService(nil, repo, time.Hour)
 	ctx := context.Background()
 
 	t.Run("delete_item_success", func(t *testing.T) {
@@ -257,7 +411,7 @@ func TestCart_Delete(t *testing.T) {
 		repo.EXPECT().GetByUserID(ctx, userID).Return(dbgen.Cart{ID: cartID}, nil)
 		repo.EXPECT().DeleteItem(ctx, cartID, prodID).Return(nil)
 
-		err := svc.DeleteItem(ctx, userID.String(), prodID.String())
+		err := svc.DeleteItem(ctx, cart.UserOwner(userID.String()), prodID.String())
 		assert.NoError(t, err)
 	})
 
@@ -268,7 +422,165 @@ func TestCart_Delete(t *testing.T) {
 		repo.EXPECT().GetByUserID(ctx, userID).Return(dbgen.Cart{ID: cartID}, nil)
 		repo.EXPECT().Delete(ctx, cartID).Return(nil)
 
-		err := svc.Delete(ctx, userID.String())
+		err := svc.Delete(ctx, cart.UserOwner(userID.String()))
+		assert.NoError(t, err)
+	})
+}
+
+func TestCart_GuestToken(t *testing.T) {
+	t.Setenv("CART_TOKEN_SECRET", "cart-test-secret")
+	guestID := uuid.New()
+
+	t.Run("roundtrip", func(t *testing.T) {
+		id, err := cart.ParseGuestToken(cart.SignGuestToken(guestID))
+		assert.NoError(t, err)
+		assert.Equal(t, guestID, id)
+	})
+
+	t.Run("forged_guest_id", func(t *testing.T) {
+		token := cart.SignGuestToken(guestID)
+		_, sig, _ := strings.Cut(token, ".")
+
+		_, err := cart.ParseGuestToken(uuid.NewString() + "." + sig)
+		assert.Equal(t, carterrors.ErrInvalidCartToken, err)
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		_, err := cart.ParseGuestToken("not-a-token")
+		assert.Equal(t, carterrors.ErrInvalidCartToken, err)
+	})
+}
+
+func TestCart_Guest(t *testing.T) {
+	t.Setenv("CART_TOKEN_SECRET", "cart-test-secret")
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock.NewMockRepository(ctrl)
+	svc := cart.NewService(nil, repo, time.Hour)
+	ctx := context.Background()
+
+	t.Run("add_item_upserts_guest_cart_with_expiry", func(t *testing.T) {
+		guest := cart.GuestOwner(uuid.New())
+		cartID := uuid.New()
+		prodID := uuid.New()
+
+		repo.EXPECT().
+			CreateGuestCart(ctx, guest.GuestID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uuid.UUID, expiresAt time.Time) (dbgen.Cart, error) {
+				assert.WithinDuration(t, time.Now().Add(time.Hour), expiresAt, time.Minute)
+				return dbgen.Cart{ID: cartID}, nil
+			})
+		repo.EXPECT().
+			AddItem(ctx, dbgen.AddCartItemParams{CartID: cartID, ProductID: prodID, Quantity: 1, MaxQty: 99}).
+			Return(dbgen.CartItem{}, nil)
+
+		err := svc.AddItem(ctx, guest, cart.AddItemRequest{ProductID: prodID.String(), Qty: 1})
 		assert.NoError(t, err)
 	})
+
+	t.Run("detail_without_cart_is_empty", func(t *testing.T) {
+		res, err := svc.Detail(ctx, cart.Owner{})
+		assert.NoError(t, err)
+		assert.Empty(t, res.Items)
+	})
+
+	t.Run("count_expired_guest_cart", func(t *testing.T) {
+		guest := cart.GuestOwner(uuid.New())
+
+		repo.EXPECT().GetByGuestID(ctx, guest.GuestID).Return(dbgen.Cart{}, sql.ErrNoRows)
+
+		_, err := svc.Count(ctx, guest)
+		assert.Equal(t, carterrors.ErrCartNotFound, err)
+	})
+
+	t.Run("new_guest_token_is_valid", func(t *testing.T) {
+		session := svc.NewGuest()
+
+		id, err := cart.ParseGuestToken(session.Token)
+		assert.NoError(t, err)
+		assert.Equal(t, session.Owner.GuestID, id)
+		assert.True(t, session.Owner.IsGuest())
+	})
+
+	t.Run("purge_expired", func(t *testing.T) {
+		before := time.Now()
+		repo.EXPECT().DeleteExpiredGuests(ctx, before, int32(50)).Return(int64(4), nil)
+
+		n, err := svc.PurgeExpiredGuests(ctx, before, 50)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(4), n)
+	})
+}
+
+func TestCart_MergeGuest(t *testing.T) {
+	t.Setenv("CART_TOKEN_SECRET", "cart-test-secret")
+	ctx := context.Background()
+	userID := uuid.New()
+	guestID := uuid.New()
+	token := cart.SignGuestToken(guestID)
+
+	setup := func(t *testing.T) (cart.Service, *mock.MockRepository, sqlmock.Sqlmock) {
+		ctrl := gomock.NewController(t)
+		repo := mock.NewMockRepository(ctrl)
+		db, dbMock, err := sqlmock.New()
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { db.Close() })
+		return cart.NewService(db, repo, time.Hour), repo, dbMock
+	}
+
+	t.Run("success_items_moved_and_guest_cart_deleted", func(t *testing.T) {
+		svc, repo, dbMock := setup(t)
+		guestCartID, userCartID := uuid.New(), uuid.New()
+
+		dbMock.ExpectBegin()
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().LockGuestCart(ctx, guestID).Return(dbgen.Cart{ID: guestCartID}, nil)
+		repo.EXPECT().CreateCart(ctx, userID).Return(dbgen.Cart{ID: userCartID}, nil)
+		repo.EXPECT().MergeItems(ctx, userCartID, guestCartID, int32(99)).Return(int64(2), nil)
+		repo.EXPECT().Delete(ctx, guestCartID).Return(nil)
+		dbMock.ExpectCommit()
+
+		err := svc.MergeGuest(ctx, userID.String(), token)
+		assert.NoError(t, err)
+		assert.NoError(t, dbMock.ExpectationsWereMet())
+	})
+
+	t.Run("no_guest_cart_is_noop", func(t *testing.T) {
+		svc, repo, dbMock := setup(t)
+
+		dbMock.ExpectBegin()
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().LockGuestCart(ctx, guestID).Return(dbgen.Cart{}, sql.ErrNoRows)
+		dbMock.ExpectRollback()
+
+		err := svc.MergeGuest(ctx, userID.String(), token)
+		assert.NoError(t, err)
+		assert.NoError(t, dbMock.ExpectationsWereMet())
+	})
+
+	t.Run("merge_failure_rolls_back", func(t *testing.T) {
+		svc, repo, dbMock := setup(t)
+		guestCartID, userCartID := uuid.New(), uuid.New()
+
+		dbMock.ExpectBegin()
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().LockGuestCart(ctx, guestID).Return(dbgen.Cart{ID: guestCartID}, nil)
+		repo.EXPECT().CreateCart(ctx, userID).Return(dbgen.Cart{ID: userCartID}, nil)
+		repo.EXPECT().MergeItems(ctx, userCartID, guestCartID, int32(99)).Return(int64(0), errors.New("db error"))
+		dbMock.ExpectRollback()
+
+		err := svc.MergeGuest(ctx, userID.String(), token)
+		assert.Equal(t, carterrors.ErrCartMergeFailed, err)
+		assert.NoError(t, dbMock.ExpectationsWereMet())
+	})
+
+	t.Run("invalid_token", func(t *testing.T) {
+		svc, _, _ := setup(t)
+
+		err := svc.MergeGuest(ctx, userID.String(), "forged.token")
+		assert.Equal(t, carterrors.ErrInvalidCartToken, err)
+	})
 }
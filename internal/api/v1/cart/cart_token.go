@@ -0,0 +1,57 @@
+package cart
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	carterrors "go-sqlc-starter/internal/api/v1/cart/errors"
+	"go-sqlc-starter/internal/pkg/signing"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Owner pemilik cart: user login (UserID) atau guest (GuestID dari cart token).
+// Zero value = belum punya identitas (guest tanpa token).
+type Owner struct {
+	UserID  string
+	GuestID uuid.UUID
+}
+
+func UserOwner(userID string) Owner {
+	return Owner{UserID: userID}
+}
+
+func GuestOwner(guestID uuid.UUID) Owner {
+	return Owner{GuestID: guestID}
+}
+
+func (o Owner) IsGuest() bool {
+	return o.UserID == "" && o.GuestID != uuid.Nil
+}
+
+func signGuestID(payload string) string {
+	mac := hmac.New(sha256.New, signing.Key(signing.CartTokenSecretEnv))
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// SignGuestToken membuat token "<guest_id>.<signature>" agar guest_id tidak bisa ditebak / dipalsukan
+func SignGuestToken(guestID uuid.UUID) string {
+	payload := guestID.String()
+	return payload + "." + signGuestID(payload)
+}
+
+// ParseGuestToken memvalidasi signature lalu mengembalikan guest_id
+func ParseGuestToken(token string) (uuid.UUID, error) {
+	payload, sig, ok := strings.Cut(strings.TrimSpace(token), ".")
+	if !ok || !hmac.Equal([]byte(sig), []byte(signGuestID(payload))) {
+		return uuid.Nil, carterrors.ErrInvalidCartToken
+	}
+
+	id, err := uuid.Parse(payload)
+	if err != nil {
+		return uuid.Nil, carterrors.ErrInvalidCartToken
+	}
+	return id, nil
+}
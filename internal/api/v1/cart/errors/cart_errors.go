@@ -44,6 +44,12 @@ var (
 		http.StatusBadRequest,
 	)
 
+	ErrQtyExceedsLimit = apperror.New(
+		apperror.CodeConflict,
+		"Requested quantity exceeds available stock or per-item limit",
+		http.StatusConflict,
+	)
+
 	// ========================
 	// Business Rule Errors
 	// ========================
@@ -59,4 +65,20 @@ var (
 		"Cart is empty",
 		http.StatusBadRequest,
 	)
+
+	// ========================
+	// Guest Cart Errors
+	// ========================
+
+	ErrInvalidCartToken = apperror.New(
+		apperror.CodeInvalidInput,
+		"Invalid cart token",
+		http.StatusBadRequest,
+	)
+
+	ErrCartMergeFailed = apperror.New(
+		apperror.CodeInternalError,
+		"Failed to merge guest cart",
+		http.StatusInternalServerError,
+	)
 )
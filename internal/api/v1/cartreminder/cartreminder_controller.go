@@ -0,0 +1,68 @@
+package cartreminder
+
+import (
+	"go-sqlc-starter/internal/pkg/apperror"
+	"go-sqlc-starter/internal/pkg/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Controller struct {
+	service Service
+}
+
+func NewController(svc Service) *Controller {
+	return &Controller{service: svc}
+}
+
+// ==================== PUBLIC ENDPOINTS ====================
+
+// Unsubscribe GET /cart-reminders/unsubscribe?token=... (link dari email, tanpa login)
+func (ctrl *Controller) Unsubscribe(c *gin.Context) {
+	if err := ctrl.service.Unsubscribe(c.Request.Context(), c.Query("token")); err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{"message": "You will no longer receive cart reminders"}, nil)
+}
+
+// ==================== ADMIN ENDPOINTS ====================
+
+// Report GET /admin/carts/abandoned
+func (ctrl *Controller) Report(c *gin.Context) {
+	page, limit := parsePage(c)
+
+	res, total, err := ctrl.service.Report(c.Request.Context(), page, limit)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, paginationMeta(total, page, limit))
+}
+
+func parsePage(c *gin.Context) (int, int) {
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if err != nil || limit < 1 || limit > 100 {
+		limit = 20
+	}
+	return page, limit
+}
+
+func paginationMeta(total int64, page, limit int) *response.PaginationMeta {
+	return &response.PaginationMeta{
+		Total:      total,
+		TotalPages: int((total + int64(limit) - 1) / int64(limit)),
+		Page:       page,
+		PageSize:   limit,
+	}
+}
@@ -0,0 +1,81 @@
+package cartreminder_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go-sqlc-starter/internal/api/v1/cartreminder"
+	cartremindererrors "go-sqlc-starter/internal/api/v1/cartreminder/errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== FAKE SERVICE (Mock Manual) ====================
+
+type fakeCartReminderService struct {
+	unsubscribeFn func(ctx context.Context, token string) error
+	reportFn      func(ctx context.Context, page, limit int) (cartreminder.AbandonedCartReportResponse, int64, error)
+}
+
+func (f *fakeCartReminderService) SendReminders(ctx context.Context, now time.Time, limit int32) (int, error) {
+	return 0, nil
+}
+func (f *fakeCartReminderService) Unsubscribe(ctx context.Context, token string) error {
+	return f.unsubscribeFn(ctx, token)
+}
+func (f *fakeCartReminderService) Report(ctx context.Context, page, limit int) (cartreminder.AbandonedCartReportResponse, int64, error) {
+	return f.reportFn(ctx, page, limit)
+}
+
+func TestCartReminderController_Unsubscribe(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	svc := &fakeCartReminderService{
+		unsubscribeFn: func(ctx context.Context, token string) error {
+			if token == "valid" {
+				return nil
+			}
+			return cartremindererrors.ErrInvalidUnsubscribeToken
+		},
+	}
+
+	r := gin.New()
+	r.GET("/cart-reminders/unsubscribe", cartreminder.NewController(svc).Unsubscribe)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/cart-reminders/unsubscribe?token=valid", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/cart-reminders/unsubscribe?token=forged", nil))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestCartReminderController_Report(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	svc := &fakeCartReminderService{
+		reportFn: func(ctx context.Context, page, limit int) (cartreminder.AbandonedCartReportResponse, int64, error) {
+			assert.Equal(t, 2, page)
+			assert.Equal(t, 10, limit)
+			return cartreminder.AbandonedCartReportResponse{
+				Summary: cartreminder.AbandonedCartSummary{CartCount: 12, TotalValue: 990000},
+				Carts:   []cartreminder.AbandonedCartResponse{},
+			}, 12, nil
+		},
+	}
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/admin/carts/abandoned?page=2&limit=10", nil)
+
+	cartreminder.NewController(svc).Report(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"totalValue":990000`)
+	assert.Contains(t, w.Body.String(), `"totalPages":2`)
+}
@@ -0,0 +1,29 @@
+package cartreminder
+
+import "time"
+
+// ==================== RESPONSE STRUCTS ====================
+
+type AbandonedCartSummary struct {
+	CartCount     int64   `json:"cartCount"`
+	ItemCount     int64   `json:"itemCount"`
+	TotalValue    float64 `json:"totalValue"`
+	RemindedCount int64   `json:"remindedCount"`
+}
+
+type AbandonedCartResponse struct {
+	CartID         string    `json:"cartId"`
+	UserID         string    `json:"userId"`
+	Email          string    `json:"email"`
+	ItemCount      int32     `json:"itemCount"`
+	CartValue      float64   `json:"cartValue"`
+	LastActivityAt time.Time `json:"lastActivityAt"`
+	RemindersSent  int32     `json:"remindersSent"`
+}
+
+type AbandonedCartReportResponse struct {
+	// IdleAfter batas idle yang dipakai laporan (mis. "24h0m0s")
+	IdleAfter string                  `json:"idleAfter"`
+	Summary   AbandonedCartSummary    `json:"summary"`
+	Carts     []AbandonedCartResponse `json:"carts"`
+}
@@ -0,0 +1,71 @@
+package cartreminder
+
+import (
+	"context"
+	"database/sql"
+	"go-sqlc-starter/internal/dbgen"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=cartreminder_repo.go -destination=../mock/cartreminder/cartreminder_repo_mock.go -package=mock
+type Repository interface {
+	WithTx(tx dbgen.DBTX) Repository
+
+	ListDue(ctx context.Context, idleBefore time.Time, maxReminders, limit int32) ([]dbgen.ListDueCartRemindersRow, error)
+	CreateReminder(ctx context.Context, arg dbgen.CreateCartReminderParams) (int64, error)
+	CreateNotification(ctx context.Context, arg dbgen.CreateNotificationParams) error
+	OptOut(ctx context.Context, userID uuid.UUID) error
+
+	// Laporan admin
+	Summary(ctx context.Context, idleBefore time.Time) (dbgen.GetAbandonedCartSummaryRow, error)
+	ListAbandoned(ctx context.Context, idleBefore time.Time, limit, offset int32) ([]dbgen.ListAbandonedCartsRow, error)
+}
+
+type repository struct {
+	q *dbgen.Queries
+}
+
+func NewRepository(q *dbgen.Queries) Repository {
+	return &repository{q: q}
+}
+
+func (r *repository) WithTx(tx dbgen.DBTX) Repository {
+	if sqlTx, ok := tx.(*sql.Tx); ok {
+		return &repository{q: r.q.WithTx(sqlTx)}
+	}
+	return r
+}
+
+func (r *repository) ListDue(ctx context.Context, idleBefore time.Time, maxReminders, limit int32) ([]dbgen.ListDueCartRemindersRow, error) {
+	return r.q.ListDueCartReminders(ctx, dbgen.ListDueCartRemindersParams{
+		IdleBefore:   idleBefore,
+		MaxReminders: maxReminders,
+		BatchSize:    limit,
+	})
+}
+
+func (r *repository) CreateReminder(ctx context.Context, arg dbgen.CreateCartReminderParams) (int64, error) {
+	return r.q.CreateCartReminder(ctx, arg)
+}
+
+func (r *repository) CreateNotification(ctx context.Context, arg dbgen.CreateNotificationParams) error {
+	return r.q.CreateNotification(ctx, arg)
+}
+
+func (r *repository) OptOut(ctx context.Context, userID uuid.UUID) error {
+	return r.q.OptOutCartReminders(ctx, userID)
+}
+
+func (r *repository) Summary(ctx context.Context, idleBefore time.Time) (dbgen.GetAbandonedCartSummaryRow, error) {
+	return r.q.GetAbandonedCartSummary(ctx, idleBefore)
+}
+
+func (r *repository) ListAbandoned(ctx context.Context, idleBefore time.Time, limit, offset int32) ([]dbgen.ListAbandonedCartsRow, error) {
+	return r.q.ListAbandonedCarts(ctx, dbgen.ListAbandonedCartsParams{
+		IdleBefore: idleBefore,
+		Limit:      limit,
+		Offset:     offset,
+	})
+}
@@ -0,0 +1,182 @@
+package cartreminder
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	cartremindererrors "go-sqlc-starter/internal/api/v1/cartreminder/errors"
+	"go-sqlc-starter/internal/dbgen"
+	"go-sqlc-starter/internal/pkg/constants"
+	"go-sqlc-starter/internal/pkg/notifier"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UnsubscribePath link unsubscribe di email pengingat (lihat routes)
+const UnsubscribePath = "/api/v1/cart-reminders/unsubscribe"
+
+type Config struct {
+	IdleAfter    time.Duration // cart dianggap terbengkalai setelah tidak disentuh selama ini
+	MaxReminders int32         // maksimal pengingat per aktivitas cart
+}
+
+//go:generate mockgen -source=cartreminder_service.go -destination=../mock/cartreminder/cartreminder_service_mock.go -package=mock
+type Service interface {
+	// Job: antre email pengingat ke outbox notifikasi, dikirim oleh job delivery
+	SendReminders(ctx context.Context, now time.Time, limit int32) (int, error)
+
+	// Public (link di email)
+	Unsubscribe(ctx context.Context, token string) error
+
+	// Admin
+	Report(ctx context.Context, page, limit int) (AbandonedCartReportResponse, int64, error)
+}
+
+type service struct {
+	db   *sql.DB
+	repo Repository
+	cfg  Config
+}
+
+func NewService(db *sql.DB, r Repository, cfg Config) Service {
+	if cfg.IdleAfter <= 0 {
+		cfg.IdleAfter = constants.CartReminderDefaultIdle
+	}
+	if cfg.MaxReminders <= 0 {
+		cfg.MaxReminders = constants.CartReminderDefaultMax
+	}
+	return &service{
+		db:   db,
+		repo: r,
+		cfg:  cfg,
+	}
+}
+
+// ==================== JOB ====================
+
+func (s *service) SendReminders(ctx context.Context, now time.Time, limit int32) (int, error) {
+	due, err := s.repo.ListDue(ctx, now.Add(-s.cfg.IdleAfter), s.cfg.MaxReminders, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	queued := 0
+	for _, cart := range due {
+		ok, err := s.queueReminder(ctx, cart)
+		if err != nil {
+			return queued, err
+		}
+		if ok {
+			queued++
+		}
+	}
+	return queued, nil
+}
+
+// queueReminder catat riwayat + antre email dalam satu transaksi.
+// false = pengingat ini sudah dicatat run lain (duplikat dilewati).
+func (s *service) queueReminder(ctx context.Context, cart dbgen.ListDueCartRemindersRow) (bool, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	qtx := s.repo.WithTx(tx)
+
+	// 1. Riwayat (unik per cart + aktivitas + nomor pengingat)
+	n, err := qtx.CreateReminder(ctx, dbgen.CreateCartReminderParams{
+		CartID:         cart.CartID,
+		UserID:         cart.UserID,
+		ReminderNumber: cart.RemindersSent + 1,
+		CartActivityAt: cart.LastActivityAt,
+		ItemCount:      cart.ItemCount,
+		CartValue:      cart.CartValue,
+	})
+	if err != nil {
+		return false, err
+	}
+	if n == 0 {
+		return false, nil
+	}
+
+	// 2. Email ke outbox
+	value, _ := strconv.ParseFloat(cart.CartValue, 64)
+	if err := qtx.CreateNotification(ctx, dbgen.CreateNotificationParams{
+		UserID:    uuid.NullUUID{UUID: cart.UserID, Valid: true},
+		Channel:   notifier.ChannelEmail,
+		Type:      constants.NotificationCartReminder,
+		Recipient: cart.Email,
+		Subject:   "You left something in your cart",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nYou still have %d item(s) worth %.0f waiting in your cart.\n\nComplete your order: /cart\n\nStop these reminders: %s?token=%s",
+			cart.FirstName, cart.ItemCount, value, UnsubscribePath, SignUnsubscribeToken(cart.UserID),
+		),
+	}); err != nil {
+		return false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ==================== PUBLIC ====================
+
+func (s *service) Unsubscribe(ctx context.Context, token string) error {
+	userID, err := ParseUnsubscribeToken(token)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.OptOut(ctx, userID); err != nil {
+		return cartremindererrors.ErrCartReminderFailed
+	}
+	return nil
+}
+
+// ==================== ADMIN ====================
+
+// Report ringkasan + daftar cart terbengkalai (nilai = qty x harga saat ditambahkan)
+func (s *service) Report(ctx context.Context, page, limit int) (AbandonedCartReportResponse, int64, error) {
+	idleBefore := time.Now().Add(-s.cfg.IdleAfter)
+
+	summary, err := s.repo.Summary(ctx, idleBefore)
+	if err != nil {
+		return AbandonedCartReportResponse{}, 0, cartremindererrors.ErrCartReminderFailed
+	}
+
+	rows, err := s.repo.ListAbandoned(ctx, idleBefore, int32(limit), int32((page-1)*limit))
+	if err != nil {
+		return AbandonedCartReportResponse{}, 0, cartremindererrors.ErrCartReminderFailed
+	}
+
+	carts := make([]AbandonedCartResponse, 0, len(rows))
+	for _, r := range rows {
+		value, _ := strconv.ParseFloat(r.CartValue, 64)
+		carts = append(carts, AbandonedCartResponse{
+			CartID:         r.CartID.String(),
+			UserID:         r.UserID.String(),
+			Email:          r.Email,
+			ItemCount:      r.ItemCount,
+			CartValue:      value,
+			LastActivityAt: r.LastActivityAt,
+			RemindersSent:  r.RemindersSent,
+		})
+	}
+
+	total, _ := strconv.ParseFloat(summary.TotalValue, 64)
+	return AbandonedCartReportResponse{
+		IdleAfter: s.cfg.IdleAfter.String(),
+		Summary: AbandonedCartSummary{
+			CartCount:     summary.CartCount,
+			ItemCount:     summary.ItemCount,
+			TotalValue:    total,
+			RemindedCount: summary.RemindedCount,
+		},
+		Carts: carts,
+	}, summary.CartCount, nil
+}
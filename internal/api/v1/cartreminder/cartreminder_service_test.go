@@ -0,0 +1,148 @@
+package cartreminder_test
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"go-sqlc-starter/internal/api/v1/cartreminder"
+	cartremindererrors "go-sqlc-starter/internal/api/v1/cartreminder/errors"
+	cartreminderMock "go-sqlc-starter/internal/api/v1/mock/cartreminder"
+	"go-sqlc-starter/internal/dbgen"
+	"go-sqlc-starter/internal/pkg/constants"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type serviceDeps struct {
+	db      *sql.DB
+	sqlMock sqlmock.Sqlmock
+	service cartreminder.Service
+	repo    *cartreminderMock.MockRepository
+}
+
+func setupServiceTest(t *testing.T) *serviceDeps {
+	t.Helper()
+	t.Setenv("CART_REMINDER_SECRET", "reminder-test-secret")
+
+	ctrl := gomock.NewController(t)
+	db, sqlMock, _ := sqlmock.New()
+	repo := cartreminderMock.NewMockRepository(ctrl)
+	repo.EXPECT().WithTx(gomock.Any()).Return(repo).AnyTimes()
+
+	return &serviceDeps{
+		db:      db,
+		sqlMock: sqlMock,
+		service: cartreminder.NewService(db, repo, cartreminder.Config{IdleAfter: 6 * time.Hour, MaxReminders: 3}),
+		repo:    repo,
+	}
+}
+
+func TestCartReminderService_SendReminders(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	userID := uuid.New()
+	due := dbgen.ListDueCartRemindersRow{
+		CartID: uuid.New(), UserID: userID, Email: "a@mail.com", FirstName: "Budi",
+		LastActivityAt: now.Add(-8 * time.Hour), ItemCount: 2, CartValue: "150000.00", RemindersSent: 1,
+	}
+
+	t.Run("success - next reminder recorded and email queued", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		defer deps.db.Close()
+
+		deps.repo.EXPECT().ListDue(ctx, now.Add(-6*time.Hour), int32(3), int32(50)).Return([]dbgen.ListDueCartRemindersRow{due}, nil)
+		deps.sqlMock.ExpectBegin()
+		deps.repo.EXPECT().CreateReminder(gomock.Any(), dbgen.CreateCartReminderParams{
+			CartID: due.CartID, UserID: userID, ReminderNumber: 2,
+			CartActivityAt: due.LastActivityAt, ItemCount: 2, CartValue: "150000.00",
+		}).Return(int64(1), nil)
+		deps.repo.EXPECT().
+			CreateNotification(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, arg dbgen.CreateNotificationParams) error {
+				assert.Equal(t, constants.NotificationCartReminder, arg.Type)
+				assert.Equal(t, "a@mail.com", arg.Recipient)
+				assert.Contains(t, arg.Body, cartreminder.UnsubscribePath+"?token="+cartreminder.SignUnsubscribeToken(userID))
+				return nil
+			})
+		deps.sqlMock.ExpectCommit()
+
+		queued, err := deps.service.SendReminders(ctx, now, 50)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, queued)
+		assert.NoError(t, deps.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("duplicate - already recorded by another run", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		defer deps.db.Close()
+
+		deps.repo.EXPECT().ListDue(ctx, gomock.Any(), int32(3), int32(50)).Return([]dbgen.ListDueCartRemindersRow{due}, nil)
+		deps.sqlMock.ExpectBegin()
+		deps.repo.EXPECT().CreateReminder(gomock.Any(), gomock.Any()).Return(int64(0), nil)
+		deps.sqlMock.ExpectRollback()
+
+		queued, err := deps.service.SendReminders(ctx, now, 50)
+
+		assert.NoError(t, err)
+		assert.Zero(t, queued)
+		assert.NoError(t, deps.sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestCartReminderService_Unsubscribe(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+
+	t.Run("valid token - opted out", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		defer deps.db.Close()
+
+		deps.repo.EXPECT().OptOut(ctx, userID).Return(nil)
+
+		err := deps.service.Unsubscribe(ctx, cartreminder.SignUnsubscribeToken(userID))
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("tampered token", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		defer deps.db.Close()
+
+		// Signature milik user lain
+		_, sig, _ := strings.Cut(cartreminder.SignUnsubscribeToken(userID), ".")
+
+		err := deps.service.Unsubscribe(ctx, uuid.New().String()+"."+sig)
+
+		assert.Equal(t, cartremindererrors.ErrInvalidUnsubscribeToken, err)
+	})
+}
+
+func TestCartReminderService_Report(t *testing.T) {
+	ctx := context.Background()
+	deps := setupServiceTest(t)
+	defer deps.db.Close()
+
+	deps.repo.EXPECT().Summary(ctx, gomock.Any()).Return(dbgen.GetAbandonedCartSummaryRow{
+		CartCount: 4, ItemCount: 9, TotalValue: "1250000.00", RemindedCount: 3,
+	}, nil)
+	deps.repo.EXPECT().ListAbandoned(ctx, gomock.Any(), int32(2), int32(2)).Return([]dbgen.ListAbandonedCartsRow{
+		{CartID: uuid.New(), UserID: uuid.New(), Email: "a@mail.com", ItemCount: 3, CartValue: "500000.00", RemindersSent: 1},
+	}, nil)
+
+	res, total, err := deps.service.Report(ctx, 2, 2)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), total)
+	assert.Equal(t, 1250000.0, res.Summary.TotalValue)
+	assert.Equal(t, int64(3), res.Summary.RemindedCount)
+	assert.Equal(t, "6h0m0s", res.IdleAfter)
+	assert.Len(t, res.Carts, 1)
+	assert.Equal(t, 500000.0, res.Carts[0].CartValue)
+}
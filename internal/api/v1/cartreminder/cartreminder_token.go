@@ -0,0 +1,41 @@
+package cartreminder
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	cartremindererrors "go-sqlc-starter/internal/api/v1/cartreminder/errors"
+	"go-sqlc-starter/internal/pkg/signing"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Prefix payload agar signature tidak bisa dipakai ulang sebagai token lain (mis. cart token)
+const tokenScope = "cart-reminder:"
+
+func signUserID(payload string) string {
+	mac := hmac.New(sha256.New, signing.Key(signing.CartReminderSecretEnv))
+	mac.Write([]byte(tokenScope + payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// SignUnsubscribeToken token "<user_id>.<signature>" untuk link unsubscribe (tanpa login)
+func SignUnsubscribeToken(userID uuid.UUID) string {
+	payload := userID.String()
+	return payload + "." + signUserID(payload)
+}
+
+// ParseUnsubscribeToken memvalidasi signature lalu mengembalikan user_id
+func ParseUnsubscribeToken(token string) (uuid.UUID, error) {
+	payload, sig, ok := strings.Cut(strings.TrimSpace(token), ".")
+	if !ok || !hmac.Equal([]byte(sig), []byte(signUserID(payload))) {
+		return uuid.Nil, cartremindererrors.ErrInvalidUnsubscribeToken
+	}
+
+	id, err := uuid.Parse(payload)
+	if err != nil {
+		return uuid.Nil, cartremindererrors.ErrInvalidUnsubscribeToken
+	}
+	return id, nil
+}
@@ -0,0 +1,20 @@
+package cartremindererrors
+
+import (
+	"go-sqlc-starter/internal/pkg/apperror"
+	"net/http"
+)
+
+var (
+	ErrInvalidUnsubscribeToken = apperror.New(
+		apperror.CodeInvalidInput,
+		"Invalid unsubscribe token",
+		http.StatusBadRequest,
+	)
+
+	ErrCartReminderFailed = apperror.New(
+		apperror.CodeInternalError,
+		"Failed to process cart reminders",
+		http.StatusInternalServerError,
+	)
+)
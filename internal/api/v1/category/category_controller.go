@@ -1,6 +1,10 @@
 package category
 
 import (
+	"errors"
+	categoryerrors "go-sqlc-starter/internal/api/v1/category/errors"
+	"go-sqlc-starter/internal/pkg/apperror"
+	"go-sqlc-starter/internal/pkg/httpx"
 	"go-sqlc-starter/internal/pkg/response"
 	"go-sqlc-starter/internal/pkg/utils"
 	"log"
@@ -110,6 +114,40 @@ func (ctrl *Controller) GetByID(c *gin.Context) {
 	response.Success(c, http.StatusOK, res, nil)
 }
 
+// GET BY SLUG (Public)
+// Slug lama hasil rename di-redirect 301 ke slug terbaru
+func (ctrl *Controller) GetBySlug(c *gin.Context) {
+	slug := c.Param("slug")
+
+	res, err := ctrl.service.GetBySlug(c.Request.Context(), slug)
+	if err != nil {
+		if errors.Is(err, categoryerrors.ErrCategoryNotFound) {
+			if newSlug, rerr := ctrl.service.ResolveSlug(c.Request.Context(), slug); rerr == nil {
+				httpx.RedirectSlug(c, slug, newSlug)
+				return
+			}
+		}
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// GET TREE (Public)
+// Kategori nested parent -> children
+func (ctrl *Controller) GetTree(c *gin.Context) {
+	res, err := ctrl.service.GetTree(c.Request.Context())
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, nil)
+}
+
 // 3. CREATE BRAND
 func (ctrl *Controller) Create(c *gin.Context) {
 	ctx := c.Request.Context()
@@ -131,9 +169,12 @@ func (ctrl *Controller) Create(c *gin.Context) {
 	description := c.PostForm("description")
 
 	req := CreateCategoryRequest{
-		Name:        name,
-		Slug:        utils.GenerateSlug(name),
-		Description: description,
+		Name:            name,
+		Slug:            utils.GenerateSlug(name),
+		Description:     description,
+		ParentID:        c.PostForm("parent_id"),
+		MetaTitle:       c.PostForm("meta_title"),
+		MetaDescription: c.PostForm("meta_description"),
 	}
 	// 3. Validate required fields
 	if req.Name == "" {
@@ -227,9 +268,21 @@ func (ctrl *Controller) Update(c *gin.Context) {
 	// 2. Parse form fields (sesuaikan dengan struct UpdateCategoryRequest Anda)
 	// Karena multipart/form-data, kita ambil via PostForm, bukan BindJSON
 	req := UpdateCategoryRequest{
-		Name:        name,
-		Slug:        utils.GenerateSlug(name),
-		Description: description,
+		Name:            name,
+		Slug:            utils.GenerateSlug(name),
+		Description:     description,
+		MetaTitle:       c.PostForm("meta_title"),
+		MetaDescription: c.PostForm("meta_description"),
+	}
+
+	if isActiveStr := c.PostForm("is_active"); isActiveStr != "" {
+		isActive := isActiveStr == "true"
+		req.IsActive = &isActive
+	}
+
+	// parent_id dikirim kosong = pindahkan ke root
+	if parentID, ok := c.GetPostForm("parent_id"); ok {
+		req.ParentID = &parentID
 	}
 
 	// 3. Get uploaded file (optional)
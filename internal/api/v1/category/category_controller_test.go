@@ -28,6 +28,10 @@ type fakeCategoryService struct {
 	UpdateFn     func(ctx context.Context, id string, req category.UpdateCategoryRequest, file multipart.File, filename string) (category.CategoryAdminResponse, error)
 	DeleteFn     func(ctx context.Context, id string) error
 	RestoreFn    func(ctx context.Context, id string) (category.CategoryAdminResponse, error)
+
+	GetBySlugFn   func(ctx context.Context, slug string) (category.CategoryPublicResponse, error)
+	ResolveSlugFn func(ctx context.Context, oldSlug string) (string, error)
+	GetTreeFn     func(ctx context.Context) ([]category.CategoryTreeResponse, error)
 }
 
 func (f *fakeCategoryService) Create(ctx context.Context, req category.CreateCategoryRequest, file multipart.File, filename string) (category.CategoryAdminResponse, error) {
@@ -51,6 +55,15 @@ func (f *fakeCategoryService) Delete(ctx context.Context, id string) error {
 func (f *fakeCategoryService) Restore(ctx context.Context, id string) (category.CategoryAdminResponse, error) {
 	return f.RestoreFn(ctx, id)
 }
+func (f *fakeCategoryService) GetBySlug(ctx context.Context, slug string) (category.CategoryPublicResponse, error) {
+	return f.GetBySlugFn(ctx, slug)
+}
+func (f *fakeCategoryService) ResolveSlug(ctx context.Context, oldSlug string) (string, error) {
+	return f.ResolveSlugFn(ctx, oldSlug)
+}
+func (f *fakeCategoryService) GetTree(ctx context.Context) ([]category.CategoryTreeResponse, error) {
+	return f.GetTreeFn(ctx)
+}
 
 // ==================== HELPERS ====================
 
@@ -425,3 +438,46 @@ func TestDeleteCategory(t *testing.T) {
 	})
 
 }
+
+func TestCategoryController_GetTree(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		svc := &fakeCategoryService{
+			GetTreeFn: func(ctx context.Context) ([]category.CategoryTreeResponse, error) {
+				return []category.CategoryTreeResponse{
+					{ID: uuid.NewString(), Name: "Elektronik", Children: []category.CategoryTreeResponse{
+						{ID: uuid.NewString(), Name: "Smartphone", Children: []category.CategoryTreeResponse{}},
+					}},
+				}, nil
+			},
+		}
+
+		r := setupTestRouter()
+		r.GET("/categories/tree", category.NewController(svc).GetTree)
+
+		req := httptest.NewRequest(http.MethodGet, "/categories/tree", nil)
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "Smartphone")
+	})
+
+	t.Run("service error", func(t *testing.T) {
+		svc := &fakeCategoryService{
+			GetTreeFn: func(ctx context.Context) ([]category.CategoryTreeResponse, error) {
+				return nil, errors.New("db error")
+			},
+		}
+
+		r := setupTestRouter()
+		r.GET("/categories/tree", category.NewController(svc).GetTree)
+
+		req := httptest.NewRequest(http.MethodGet, "/categories/tree", nil)
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
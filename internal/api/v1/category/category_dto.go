@@ -9,14 +9,22 @@ type CreateCategoryRequest struct {
 	Slug        string `json:"slug"`
 	Description string `json:"description" validate:"max=500"`
 	ImageUrl    string `json:"imageUrl" validate:"omitempty,url"`
+	ParentID    string `json:"parentId" validate:"omitempty,uuid"`
+
+	MetaTitle       string `json:"metaTitle" validate:"max=255"`
+	MetaDescription string `json:"metaDescription" validate:"max=500"`
 }
 
 type UpdateCategoryRequest struct {
-	Name        string `json:"name" binding:"required" validate:"required,min=2,max=100"`
-	Slug        string `json:"slug"`
-	Description string `json:"description" validate:"max=500"`
-	ImageUrl    string `json:"imageUrl" validate:"omitempty,url"`
-	IsActive    *bool  `json:"isActive" binding:"required" validate:"required"`
+	Name        string  `json:"name" binding:"required" validate:"required,min=2,max=100"`
+	Slug        string  `json:"slug"`
+	Description string  `json:"description" validate:"max=500"`
+	ImageUrl    string  `json:"imageUrl" validate:"omitempty,url"`
+	IsActive    *bool   `json:"isActive"` // nil = tidak diubah
+	ParentID    *string `json:"parentId"` // nil = tidak diubah, "" = jadikan root
+
+	MetaTitle       string `json:"metaTitle" validate:"max=255"`
+	MetaDescription string `json:"metaDescription" validate:"max=500"`
 }
 
 type ListCategoryRequest struct {
@@ -34,6 +42,10 @@ type CategoryPublicResponse struct {
 	Slug        string `json:"slug"`
 	Description string `json:"description,omitempty"`
 	ImageUrl    string `json:"imageUrl,omitempty"`
+	ParentID    string `json:"parentId,omitempty"`
+
+	MetaTitle       string `json:"metaTitle,omitempty"`
+	MetaDescription string `json:"metaDescription,omitempty"`
 }
 
 type CategoryAdminResponse struct {
@@ -42,8 +54,21 @@ type CategoryAdminResponse struct {
 	Slug        string     `json:"slug"`
 	Description string     `json:"description"`
 	ImageUrl    string     `json:"imageUrl"`
+	ParentID    string     `json:"parentId,omitempty"`
 	IsActive    bool       `json:"isActive"`
 	CreatedAt   time.Time  `json:"createdAt"`
 	UpdatedAt   time.Time  `json:"updatedAt"`
 	DeletedAt   *time.Time `json:"deletedAt,omitempty"`
+
+	MetaTitle       string `json:"metaTitle"`
+	MetaDescription string `json:"metaDescription"`
+}
+
+// CategoryTreeResponse node kategori beserta sub-kategorinya
+type CategoryTreeResponse struct {
+	ID       string                 `json:"id"`
+	Name     string                 `json:"name"`
+	Slug     string                 `json:"slug"`
+	ImageUrl string                 `json:"imageUrl,omitempty"`
+	Children []CategoryTreeResponse `json:"children"`
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"go-sqlc-starter/internal/dbgen"
+	"go-sqlc-starter/internal/pkg/constants"
 
 	"github.com/google/uuid"
 )
@@ -18,6 +19,18 @@ type Repository interface {
 	Update(ctx context.Context, arg dbgen.UpdateCategoryParams) (dbgen.Category, error)
 	Delete(ctx context.Context, id uuid.UUID) error
 	Restore(ctx context.Context, id uuid.UUID) (dbgen.Category, error)
+
+	// Slug & riwayat slug (301 redirect setelah rename)
+	GetBySlug(ctx context.Context, slug string) (dbgen.Category, error)
+	SlugExists(ctx context.Context, slug string, excludeID uuid.UUID) (bool, error)
+	GetSlugRedirect(ctx context.Context, oldSlug string) (string, error)
+	CreateSlugRedirect(ctx context.Context, oldSlug string, categoryID uuid.UUID) error
+	DeleteSlugRedirect(ctx context.Context, slug string) error
+
+	// Hierarki kategori
+	ListTree(ctx context.Context) ([]dbgen.ListCategoryTreeRow, error)
+	IsDescendant(ctx context.Context, categoryID, candidateID uuid.UUID) (bool, error)
+	GetBreadcrumbs(ctx context.Context, id uuid.UUID) ([]dbgen.GetCategoryBreadcrumbsRow, error)
 }
 
 type repository struct {
@@ -56,6 +69,51 @@ func (r *repository) Restore(ctx context.Context, id uuid.UUID) (dbgen.Category,
 	return r.queries.RestoreCategory(ctx, id)
 }
 
+func (r *repository) GetBySlug(ctx context.Context, slug string) (dbgen.Category, error) {
+	return r.queries.GetCategoryBySlug(ctx, slug)
+}
+
+func (r *repository) SlugExists(ctx context.Context, slug string, excludeID uuid.UUID) (bool, error) {
+	return r.queries.CategorySlugExists(ctx, dbgen.CategorySlugExistsParams{
+		Slug:      slug,
+		ExcludeID: excludeID,
+	})
+}
+
+func (r *repository) GetSlugRedirect(ctx context.Context, oldSlug string) (string, error) {
+	return r.queries.GetCategorySlugRedirect(ctx, oldSlug)
+}
+
+func (r *repository) CreateSlugRedirect(ctx context.Context, oldSlug string, categoryID uuid.UUID) error {
+	return r.queries.UpsertSlugRedirect(ctx, dbgen.UpsertSlugRedirectParams{
+		EntityType: constants.SlugEntityCategory,
+		OldSlug:    oldSlug,
+		EntityID:   categoryID,
+	})
+}
+
+func (r *repository) DeleteSlugRedirect(ctx context.Context, slug string) error {
+	return r.queries.DeleteSlugRedirect(ctx, dbgen.DeleteSlugRedirectParams{
+		EntityType: constants.SlugEntityCategory,
+		OldSlug:    slug,
+	})
+}
+
+func (r *repository) ListTree(ctx context.Context) ([]dbgen.ListCategoryTreeRow, error) {
+	return r.queries.ListCategoryTree(ctx)
+}
+
+func (r *repository) IsDescendant(ctx context.Context, categoryID, candidateID uuid.UUID) (bool, error) {
+	return r.queries.IsCategoryDescendant(ctx, dbgen.IsCategoryDescendantParams{
+		CategoryID:  categoryID,
+		CandidateID: candidateID,
+	})
+}
+
+func (r *repository) GetBreadcrumbs(ctx context.Context, id uuid.UUID) ([]dbgen.GetCategoryBreadcrumbsRow, error) {
+	return r.queries.GetCategoryBreadcrumbs(ctx, id)
+}
+
 func (r *repository) WithTx(tx dbgen.DBTX) Repository {
 	if sqlTx, ok := tx.(*sql.Tx); ok {
 		return &repository{
@@ -9,6 +9,7 @@ import (
 	"go-sqlc-starter/internal/dbgen"
 	"go-sqlc-starter/internal/pkg/apperror"
 	"go-sqlc-starter/internal/pkg/constants"
+	"go-sqlc-starter/internal/pkg/utils"
 	"mime/multipart"
 	"strings"
 
@@ -27,6 +28,9 @@ type Service interface {
 	ListPublic(ctx context.Context, page, limit int) ([]CategoryPublicResponse, int64, error)
 	ListAdmin(ctx context.Context, req ListCategoryRequest) ([]CategoryAdminResponse, int64, error)
 	GetByID(ctx context.Context, id string) (CategoryAdminResponse, error)
+	GetBySlug(ctx context.Context, slug string) (CategoryPublicResponse, error)
+	GetTree(ctx context.Context) ([]CategoryTreeResponse, error)
+	ResolveSlug(ctx context.Context, oldSlug string) (string, error)
 	Update(ctx context.Context, id string, req UpdateCategoryRequest, file multipart.File, filename string) (CategoryAdminResponse, error)
 	Delete(ctx context.Context, id string) error
 	Restore(ctx context.Context, id string) (CategoryAdminResponse, error)
@@ -66,6 +70,7 @@ func (s *service) ListPublic(ctx context.Context, page, limit int) ([]CategoryPu
 			Name:     row.Name,
 			Slug:     row.Slug,
 			ImageUrl: row.ImageUrl.String,
+			ParentID: nullUUIDString(row.ParentID),
 		})
 	}
 	return res, total, nil
@@ -132,10 +137,97 @@ func (s *service) GetByID(ctx context.Context, idStr string) (CategoryAdminRespo
 	return mapToResponse(category), err
 }
 
+func (s *service) GetBySlug(ctx context.Context, slug string) (CategoryPublicResponse, error) {
+	category, err := s.repo.GetBySlug(ctx, slug)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return CategoryPublicResponse{}, categoryerrors.ErrCategoryNotFound
+		}
+		return CategoryPublicResponse{}, categoryerrors.ErrCategoryFailed
+	}
+
+	return CategoryPublicResponse{
+		ID:              category.ID.String(),
+		Name:            category.Name,
+		Slug:            category.Slug,
+		Description:     category.Description.String,
+		ImageUrl:        category.ImageUrl.String,
+		ParentID:        nullUUIDString(category.ParentID),
+		MetaTitle:       fallback(category.MetaTitle.String, category.Name),
+		MetaDescription: fallback(category.MetaDescription.String, category.Description.String),
+	}, nil
+}
+
+// GetTree mengembalikan kategori aktif dalam bentuk nested (root -> children).
+// Kategori yang parent-nya nonaktif/terhapus ikut tersembunyi.
+func (s *service) GetTree(ctx context.Context) ([]CategoryTreeResponse, error) {
+	rows, err := s.repo.ListTree(ctx)
+	if err != nil {
+		return nil, categoryerrors.ErrCategoryFailed
+	}
+
+	// 1. Kelompokkan berdasarkan parent (uuid.Nil = root)
+	children := make(map[uuid.UUID][]dbgen.ListCategoryTreeRow)
+	for _, row := range rows {
+		parent := uuid.Nil
+		if row.ParentID.Valid {
+			parent = row.ParentID.UUID
+		}
+		children[parent] = append(children[parent], row)
+	}
+
+	// 2. Rangkai rekursif dari root
+	var build func(parent uuid.UUID) []CategoryTreeResponse
+	build = func(parent uuid.UUID) []CategoryTreeResponse {
+		nodes := make([]CategoryTreeResponse, 0, len(children[parent]))
+		for _, row := range children[parent] {
+			nodes = append(nodes, CategoryTreeResponse{
+				ID:       row.ID.String(),
+				Name:     row.Name,
+				Slug:     row.Slug,
+				ImageUrl: row.ImageUrl.String,
+				Children: build(row.ID),
+			})
+		}
+		return nodes
+	}
+
+	return build(uuid.Nil), nil
+}
+
+// ResolveSlug mencari slug terbaru dari slug lama (untuk 301 redirect)
+func (s *service) ResolveSlug(ctx context.Context, oldSlug string) (string, error) {
+	slug, err := s.repo.GetSlugRedirect(ctx, oldSlug)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", categoryerrors.ErrCategoryNotFound
+		}
+		return "", categoryerrors.ErrCategoryFailed
+	}
+	return slug, nil
+}
+
 func (s *service) Create(ctx context.Context, req CreateCategoryRequest, file multipart.File, filename string) (CategoryAdminResponse, error) {
 	if err := s.validate.Struct(req); err != nil {
 		return CategoryAdminResponse{}, apperror.MapValidationError(err)
 	}
+
+	// Parent (opsional) harus ada
+	var parentID uuid.NullUUID
+	if req.ParentID != "" {
+		parent, err := s.resolveParent(ctx, uuid.Nil, req.ParentID)
+		if err != nil {
+			return CategoryAdminResponse{}, err
+		}
+		parentID = parent
+	}
+
+	// Slug unik (retry dengan suffix jika bentrok)
+	slug, err := s.uniqueSlug(ctx, req.Slug, req.Name, uuid.Nil)
+	if err != nil {
+		return CategoryAdminResponse{}, categoryerrors.ErrCategoryFailed
+	}
+
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return CategoryAdminResponse{}, err
@@ -144,10 +236,13 @@ func (s *service) Create(ctx context.Context, req CreateCategoryRequest, file mu
 
 	qtx := s.repo.WithTx(tx)
 	category, err := qtx.Create(ctx, dbgen.CreateCategoryParams{
-		Name:        req.Name,
-		Slug:        req.Slug,
-		Description: dbgen.NewNullString(req.Description),
-		ImageUrl:    sql.NullString{},
+		Name:            req.Name,
+		Slug:            slug,
+		Description:     dbgen.NewNullString(req.Description),
+		ImageUrl:        sql.NullString{},
+		MetaTitle:       dbgen.NewNullString(req.MetaTitle),
+		MetaDescription: dbgen.NewNullString(req.MetaDescription),
+		ParentID:        parentID,
 	})
 	if err != nil {
 		return CategoryAdminResponse{}, categoryerrors.ErrCategoryFailed
@@ -167,12 +262,15 @@ func (s *service) Create(ctx context.Context, req CreateCategoryRequest, file mu
 
 		// 5. Update category dengan image URL yang didapat
 		_, err = qtx.Update(ctx, dbgen.UpdateCategoryParams{
-			ID:          category.ID,
-			Name:        category.Name,
-			Slug:        category.Slug,
-			Description: category.Description,
-			ImageUrl:    dbgen.NewNullString(imageURL),
-			IsActive:    category.IsActive,
+			ID:              category.ID,
+			Name:            category.Name,
+			Slug:            category.Slug,
+			Description:     category.Description,
+			ImageUrl:        dbgen.NewNullString(imageURL),
+			IsActive:        category.IsActive,
+			MetaTitle:       category.MetaTitle,
+			MetaDescription: category.MetaDescription,
+			ParentID:        category.ParentID,
 		})
 		if err != nil {
 			// Update gagal, hapus image yang sudah terlanjur diupload
@@ -215,6 +313,15 @@ func (s *service) Update(
 		return CategoryAdminResponse{}, categoryerrors.ErrCategoryNotFound
 	}
 
+	// 1b. Pindah parent: tidak boleh ke dirinya sendiri / turunannya
+	parentID := category.ParentID
+	if req.ParentID != nil {
+		parentID, err = s.resolveParent(ctx, category.ID, *req.ParentID)
+		if err != nil {
+			return CategoryAdminResponse{}, err
+		}
+	}
+
 	var newImageURL sql.NullString
 
 	// 2. Kalau upload image baru
@@ -252,19 +359,57 @@ func (s *service) Update(
 		newImageURL = category.ImageUrl
 	}
 
-	// 3. Update DB
-	_, err = s.repo.Update(ctx, dbgen.UpdateCategoryParams{
-		ID:          category.ID,
-		Name:        req.Name,
-		Slug:        req.Slug,
-		Description: dbgen.NewNullString(req.Description),
-		ImageUrl:    newImageURL,
-		IsActive:    category.IsActive,
+	// 3. Slug hanya berubah jika nama berubah
+	slug := category.Slug
+	if req.Name != category.Name {
+		slug, err = s.uniqueSlug(ctx, req.Slug, req.Name, category.ID)
+		if err != nil {
+			return CategoryAdminResponse{}, categoryerrors.ErrCategoryFailed
+		}
+	}
+
+	isActive := category.IsActive
+	if req.IsActive != nil {
+		isActive = sql.NullBool{Bool: *req.IsActive, Valid: true}
+	}
+
+	// 4. Update DB + riwayat slug dalam satu transaksi
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return CategoryAdminResponse{}, categoryerrors.ErrCategoryFailed
+	}
+	defer tx.Rollback()
+
+	qtx := s.repo.WithTx(tx)
+	_, err = qtx.Update(ctx, dbgen.UpdateCategoryParams{
+		ID:              category.ID,
+		Name:            req.Name,
+		Slug:            slug,
+		Description:     dbgen.NewNullString(req.Description),
+		ImageUrl:        newImageURL,
+		IsActive:        isActive,
+		MetaTitle:       dbgen.NewNullString(req.MetaTitle),
+		MetaDescription: dbgen.NewNullString(req.MetaDescription),
+		ParentID:        parentID,
 	})
 	if err != nil {
 		return CategoryAdminResponse{}, err
 	}
 
+	// 5. Slug lama disimpan agar URL lama 301 ke slug baru
+	if slug != category.Slug {
+		if err := qtx.DeleteSlugRedirect(ctx, slug); err != nil {
+			return CategoryAdminResponse{}, categoryerrors.ErrCategoryFailed
+		}
+		if err := qtx.CreateSlugRedirect(ctx, category.Slug, category.ID); err != nil {
+			return CategoryAdminResponse{}, categoryerrors.ErrCategoryFailed
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return CategoryAdminResponse{}, categoryerrors.ErrCategoryFailed
+	}
+
 	return s.GetByID(ctx, category.ID.String())
 }
 
@@ -307,12 +452,77 @@ func (s *service) Restore(ctx context.Context, idStr string) (CategoryAdminRespo
 
 func mapToResponse(category dbgen.Category) CategoryAdminResponse {
 	return CategoryAdminResponse{
-		ID:        category.ID.String(),
-		Name:      category.Name,
-		ImageUrl:  category.ImageUrl.String,
-		Slug:      category.Slug,
-		CreatedAt: category.CreatedAt,
+		ID:              category.ID.String(),
+		Name:            category.Name,
+		ImageUrl:        category.ImageUrl.String,
+		Slug:            category.Slug,
+		ParentID:        nullUUIDString(category.ParentID),
+		CreatedAt:       category.CreatedAt,
+		MetaTitle:       category.MetaTitle.String,
+		MetaDescription: category.MetaDescription.String,
+	}
+}
+
+// uniqueSlug memakai slug dari request (jika ada) atau nama sebagai dasar,
+// excludeID agar slug milik kategori itu sendiri tidak dianggap bentrok
+func (s *service) uniqueSlug(ctx context.Context, slug, name string, excludeID uuid.UUID) (string, error) {
+	text := slug
+	if text == "" {
+		text = name
+	}
+	return utils.UniqueSlug(ctx, text, func(ctx context.Context, candidate string) (bool, error) {
+		return s.repo.SlugExists(ctx, candidate, excludeID)
+	})
+}
+
+// resolveParent memvalidasi parent baru. raw "" = root.
+// categoryID uuid.Nil untuk kategori baru (belum punya turunan).
+func (s *service) resolveParent(ctx context.Context, categoryID uuid.UUID, raw string) (uuid.NullUUID, error) {
+	if raw == "" {
+		return uuid.NullUUID{}, nil
+	}
+
+	parentID, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.NullUUID{}, categoryerrors.ErrInvalidParent
+	}
+	if parentID == categoryID {
+		return uuid.NullUUID{}, categoryerrors.ErrCategoryCycle
 	}
+
+	if _, err := s.repo.GetByID(ctx, parentID); err != nil {
+		if err == sql.ErrNoRows {
+			return uuid.NullUUID{}, categoryerrors.ErrParentNotFound
+		}
+		return uuid.NullUUID{}, categoryerrors.ErrCategoryFailed
+	}
+
+	// Cegah siklus: parent baru tidak boleh turunan kategori ini
+	if categoryID != uuid.Nil {
+		isDescendant, err := s.repo.IsDescendant(ctx, categoryID, parentID)
+		if err != nil {
+			return uuid.NullUUID{}, categoryerrors.ErrCategoryFailed
+		}
+		if isDescendant {
+			return uuid.NullUUID{}, categoryerrors.ErrCategoryCycle
+		}
+	}
+
+	return uuid.NullUUID{UUID: parentID, Valid: true}, nil
+}
+
+func nullUUIDString(id uuid.NullUUID) string {
+	if !id.Valid {
+		return ""
+	}
+	return id.UUID.String()
+}
+
+func fallback(value, def string) string {
+	if value != "" {
+		return value
+	}
+	return def
 }
 
 func (s *service) mapAdminRowsToResponse(rows []dbgen.ListCategoriesAdminRow) []CategoryAdminResponse {
@@ -324,10 +534,14 @@ func (s *service) mapAdminRowsToResponse(rows []dbgen.ListCategoriesAdminRow) []
 			Slug:        row.Slug,
 			Description: row.Description.String,
 			ImageUrl:    row.ImageUrl.String,
+			ParentID:    nullUUIDString(row.ParentID),
 			IsActive:    row.IsActive.Bool,
 			CreatedAt:   row.CreatedAt,
 			UpdatedAt:   row.UpdatedAt,
 			DeletedAt:   nil, // Bisa diisi row.DeletedAt jika tipenya cocok
+
+			MetaTitle:       row.MetaTitle.String,
+			MetaDescription: row.MetaDescription.String,
 		})
 	}
 	return res
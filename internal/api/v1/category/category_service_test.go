@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"go-sqlc-starter/internal/api/v1/category"
+	categoryerrors "go-sqlc-starter/internal/api/v1/category/errors"
 	"go-sqlc-starter/internal/dbgen"
 	"go-sqlc-starter/internal/pkg/constants"
 
@@ -200,6 +201,7 @@ func TestCategoryService_Create(t *testing.T) {
 		filename := "logo.png"
 		imgURL := "https://cloudinary.com/apple.png"
 
+		deps.repo.EXPECT().SlugExists(ctx, "apple", uuid.Nil).Return(false, nil)
 		expectTx(t, deps.sqlMock, true)
 		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
 
@@ -224,6 +226,7 @@ func TestCategoryService_Create(t *testing.T) {
 
 	t.Run("negative - upload image failed (rollback)", func(t *testing.T) {
 		fakeFile := &mockFile{}
+		deps.repo.EXPECT().SlugExists(ctx, "apple", uuid.Nil).Return(false, nil)
 		expectTx(t, deps.sqlMock, false) // Expect Rollback
 		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
 
@@ -239,6 +242,7 @@ func TestCategoryService_Create(t *testing.T) {
 	})
 
 	t.Run("negative - database create failed", func(t *testing.T) {
+		deps.repo.EXPECT().SlugExists(ctx, "apple", uuid.Nil).Return(false, nil)
 		expectTx(t, deps.sqlMock, false)
 		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
 
@@ -263,13 +267,26 @@ func TestCategoryService_Update(t *testing.T) {
 			Return(dbgen.Category{
 				ID:       id,
 				Name:     "Apple",
+				Slug:     "apple",
 				ImageUrl: sql.NullString{},
 				IsActive: dbgen.NewNullBool(true),
 			}, nil)
 
+		// Nama berubah -> slug baru + slug lama disimpan sebagai redirect
+		deps.repo.EXPECT().SlugExists(ctx, "updated-apple", id).Return(false, nil)
+
+		expectTx(t, deps.sqlMock, true)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+
 		deps.repo.EXPECT().
 			Update(ctx, gomock.Any()).
-			Return(dbgen.Category{ID: id}, nil)
+			DoAndReturn(func(_ context.Context, arg dbgen.UpdateCategoryParams) (dbgen.Category, error) {
+				assert.Equal(t, "updated-apple", arg.Slug)
+				return dbgen.Category{ID: id}, nil
+			})
+
+		deps.repo.EXPECT().DeleteSlugRedirect(ctx, "updated-apple").Return(nil)
+		deps.repo.EXPECT().CreateSlugRedirect(ctx, "apple", id).Return(nil)
 
 		deps.repo.EXPECT().
 			GetByID(ctx, id).
@@ -290,7 +307,9 @@ func TestCategoryService_Update(t *testing.T) {
 		deps.repo.EXPECT().
 			GetByID(ctx, id).
 			Return(dbgen.Category{
-				ID: id,
+				ID:   id,
+				Name: req.Name,
+				Slug: "updated-apple",
 				ImageUrl: dbgen.NewNullString(
 					"https://res.cloudinary.com/demo/image/upload/v1769161193/go-gadget/categorys/category-old.png",
 				),
@@ -305,6 +324,10 @@ func TestCategoryService_Update(t *testing.T) {
 			UploadImage(ctx, fakeFile, gomock.Any(), constants.CloudinaryCategoryFolder).
 			Return(imgURL, nil)
 
+		// Nama tidak berubah -> tanpa cek slug & redirect
+		expectTx(t, deps.sqlMock, true)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+
 		deps.repo.EXPECT().
 			Update(ctx, gomock.Any()).
 			Return(dbgen.Category{ID: id}, nil)
@@ -337,6 +360,152 @@ func TestCategoryService_Update(t *testing.T) {
 	})
 }
 
+func TestCategoryService_GetBySlug(t *testing.T) {
+	deps := setupServiceTest(t)
+	defer deps.db.Close()
+
+	ctx := context.Background()
+	id := uuid.New()
+
+	t.Run("success - meta fallback to name and description", func(t *testing.T) {
+		deps.repo.EXPECT().
+			GetBySlug(ctx, "apple").
+			Return(dbgen.Category{
+				ID:          id,
+				Name:        "Apple",
+				Slug:        "apple",
+				Description: dbgen.NewNullString("Premium Tech"),
+			}, nil)
+
+		res, err := deps.service.GetBySlug(ctx, "apple")
+		assert.NoError(t, err)
+		assert.Equal(t, "Apple", res.MetaTitle)
+		assert.Equal(t, "Premium Tech", res.MetaDescription)
+	})
+
+	t.Run("fail - not found", func(t *testing.T) {
+		deps.repo.EXPECT().
+			GetBySlug(ctx, "unknown").
+			Return(dbgen.Category{}, sql.ErrNoRows)
+
+		_, err := deps.service.GetBySlug(ctx, "unknown")
+		assert.ErrorIs(t, err, categoryerrors.ErrCategoryNotFound)
+	})
+}
+
+func TestCategoryService_ResolveSlug(t *testing.T) {
+	deps := setupServiceTest(t)
+	defer deps.db.Close()
+
+	ctx := context.Background()
+
+	t.Run("success - old slug resolved", func(t *testing.T) {
+		deps.repo.EXPECT().GetSlugRedirect(ctx, "apple").Return("apple-inc", nil)
+
+		slug, err := deps.service.ResolveSlug(ctx, "apple")
+		assert.NoError(t, err)
+		assert.Equal(t, "apple-inc", slug)
+	})
+
+	t.Run("fail - no redirect", func(t *testing.T) {
+		deps.repo.EXPECT().GetSlugRedirect(ctx, "unknown").Return("", sql.ErrNoRows)
+
+		_, err := deps.service.ResolveSlug(ctx, "unknown")
+		assert.ErrorIs(t, err, categoryerrors.ErrCategoryNotFound)
+	})
+}
+
+func TestCategoryService_GetTree(t *testing.T) {
+	deps := setupServiceTest(t)
+	defer deps.db.Close()
+
+	ctx := context.Background()
+	rootID := uuid.New()
+	childID := uuid.New()
+
+	t.Run("success - nested children", func(t *testing.T) {
+		deps.repo.EXPECT().ListTree(ctx).Return([]dbgen.ListCategoryTreeRow{
+			{ID: rootID, Name: "Elektronik", Slug: "elektronik"},
+			{ID: childID, ParentID: uuid.NullUUID{UUID: rootID, Valid: true}, Name: "Smartphone", Slug: "smartphone"},
+			{ID: uuid.New(), ParentID: uuid.NullUUID{UUID: childID, Valid: true}, Name: "Android", Slug: "android"},
+		}, nil)
+
+		res, err := deps.service.GetTree(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, res, 1)
+		assert.Equal(t, "smartphone", res[0].Children[0].Slug)
+		assert.Equal(t, "android", res[0].Children[0].Children[0].Slug)
+	})
+
+	t.Run("fail - db error", func(t *testing.T) {
+		deps.repo.EXPECT().ListTree(ctx).Return(nil, errors.New("db error"))
+
+		_, err := deps.service.GetTree(ctx)
+		assert.ErrorIs(t, err, categoryerrors.ErrCategoryFailed)
+	})
+}
+
+func TestCategoryService_Update_Parent(t *testing.T) {
+	deps := setupServiceTest(t)
+	defer deps.db.Close()
+
+	ctx := context.Background()
+	id := uuid.New()
+	parentID := uuid.New()
+	existing := dbgen.Category{ID: id, Name: "Smartphone", Slug: "smartphone"}
+
+	t.Run("fail - parent is itself", func(t *testing.T) {
+		self := id.String()
+		deps.repo.EXPECT().GetByID(ctx, id).Return(existing, nil)
+
+		_, err := deps.service.Update(ctx, id.String(), category.UpdateCategoryRequest{Name: "Smartphone", ParentID: &self}, nil, "")
+		assert.ErrorIs(t, err, categoryerrors.ErrCategoryCycle)
+	})
+
+	t.Run("fail - parent is descendant", func(t *testing.T) {
+		parent := parentID.String()
+		deps.repo.EXPECT().GetByID(ctx, id).Return(existing, nil)
+		deps.repo.EXPECT().GetByID(ctx, parentID).Return(dbgen.Category{ID: parentID}, nil)
+		deps.repo.EXPECT().IsDescendant(ctx, id, parentID).Return(true, nil)
+
+		_, err := deps.service.Update(ctx, id.String(), category.UpdateCategoryRequest{Name: "Smartphone", ParentID: &parent}, nil, "")
+		assert.ErrorIs(t, err, categoryerrors.ErrCategoryCycle)
+	})
+
+	t.Run("fail - parent not found", func(t *testing.T) {
+		parent := parentID.String()
+		deps.repo.EXPECT().GetByID(ctx, id).Return(existing, nil)
+		deps.repo.EXPECT().GetByID(ctx, parentID).Return(dbgen.Category{}, sql.ErrNoRows)
+
+		_, err := deps.service.Update(ctx, id.String(), category.UpdateCategoryRequest{Name: "Smartphone", ParentID: &parent}, nil, "")
+		assert.ErrorIs(t, err, categoryerrors.ErrParentNotFound)
+	})
+
+	t.Run("success - move under new parent", func(t *testing.T) {
+		parent := parentID.String()
+		deps.repo.EXPECT().GetByID(ctx, id).Return(existing, nil)
+		deps.repo.EXPECT().GetByID(ctx, parentID).Return(dbgen.Category{ID: parentID}, nil)
+		deps.repo.EXPECT().IsDescendant(ctx, id, parentID).Return(false, nil)
+
+		expectTx(t, deps.sqlMock, true)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		deps.repo.EXPECT().
+			Update(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, arg dbgen.UpdateCategoryParams) (dbgen.Category, error) {
+				assert.Equal(t, uuid.NullUUID{UUID: parentID, Valid: true}, arg.ParentID)
+				return dbgen.Category{ID: id}, nil
+			})
+
+		deps.repo.EXPECT().GetByID(ctx, id).Return(dbgen.Category{
+			ID: id, Name: "Smartphone", ParentID: uuid.NullUUID{UUID: parentID, Valid: true},
+		}, nil)
+
+		res, err := deps.service.Update(ctx, id.String(), category.UpdateCategoryRequest{Name: "Smartphone", ParentID: &parent}, nil, "")
+		assert.NoError(t, err)
+		assert.Equal(t, parentID.String(), res.ParentID)
+	})
+}
+
 func TestCategoryService_Delete(t *testing.T) {
 	deps := setupServiceTest(t)
 	defer deps.db.Close()
@@ -42,4 +42,22 @@ var (
 		"Invalid category image URL",
 		http.StatusBadRequest,
 	)
+
+	ErrInvalidParent = apperror.New(
+		apperror.CodeInvalidInput,
+		"Invalid parent category",
+		http.StatusBadRequest,
+	)
+
+	ErrParentNotFound = apperror.New(
+		apperror.CodeNotFound,
+		"parent category not found",
+		http.StatusNotFound,
+	)
+
+	ErrCategoryCycle = apperror.New(
+		apperror.CodeConflict,
+		"Category cannot be moved under itself or its descendants",
+		http.StatusConflict,
+	)
 )
@@ -0,0 +1,50 @@
+package inventoryerrors
+
+import (
+	"go-sqlc-starter/internal/pkg/apperror"
+	"net/http"
+)
+
+var (
+	ErrInvalidProductID = apperror.New(
+		apperror.CodeInvalidInput,
+		"Invalid product ID",
+		http.StatusBadRequest,
+	)
+
+	ErrInvalidDelta = apperror.New(
+		apperror.CodeInvalidInput,
+		"Stock delta must not be zero",
+		http.StatusBadRequest,
+	)
+
+	ErrInvalidReason = apperror.New(
+		apperror.CodeInvalidInput,
+		"Invalid stock movement reason",
+		http.StatusBadRequest,
+	)
+
+	ErrInvalidThreshold = apperror.New(
+		apperror.CodeInvalidInput,
+		"Low stock threshold must be zero or greater",
+		http.StatusBadRequest,
+	)
+
+	ErrProductNotFound = apperror.New(
+		apperror.CodeNotFound,
+		"Product not found",
+		http.StatusNotFound,
+	)
+
+	ErrInsufficientStock = apperror.New(
+		apperror.CodeConflict,
+		"Insufficient stock",
+		http.StatusConflict,
+	)
+
+	ErrInventoryFailed = apperror.New(
+		apperror.CodeInternalError,
+		"Failed to process inventory",
+		http.StatusInternalServerError,
+	)
+)
@@ -0,0 +1,115 @@
+package inventory
+
+import (
+	"go-sqlc-starter/internal/pkg/apperror"
+	"go-sqlc-starter/internal/pkg/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Controller struct {
+	service Service
+}
+
+func NewController(svc Service) *Controller {
+	return &Controller{service: svc}
+}
+
+// Adjust perubahan stok relatif dengan alasan
+// POST /admin/inventory/products/:id/adjustments
+func (ctrl *Controller) Adjust(c *gin.Context) {
+	var req AdjustStockRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		appErr := apperror.Wrap(err, apperror.CodeInvalidInput, "Invalid request body", http.StatusBadRequest)
+		httpErr := apperror.ToHTTP(appErr)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, err.Error())
+		return
+	}
+
+	// Actor dari JWT (opsional, untuk audit ledger)
+	actorID, _ := c.Get("user_id")
+	actor, _ := actorID.(string)
+
+	res, err := ctrl.service.Adjust(c.Request.Context(), c.Param("id"), actor, req)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusCreated, res, nil)
+}
+
+// ListMovements riwayat ledger stok satu produk
+// GET /admin/inventory/products/:id/movements?page=1&limit=20
+func (ctrl *Controller) ListMovements(c *gin.Context) {
+	page, limit := parsePage(c)
+
+	data, total, err := ctrl.service.ListMovements(c.Request.Context(), c.Param("id"), page, limit)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, data, paginationMeta(total, page, limit))
+}
+
+// ListLowStock laporan produk dengan stok <= threshold
+// GET /admin/inventory/low-stock?page=1&limit=20
+func (ctrl *Controller) ListLowStock(c *gin.Context) {
+	page, limit := parsePage(c)
+
+	data, total, err := ctrl.service.ListLowStock(c.Request.Context(), page, limit)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, data, paginationMeta(total, page, limit))
+}
+
+// SetThreshold ubah batas low-stock per produk
+// PUT /admin/inventory/products/:id/threshold
+func (ctrl *Controller) SetThreshold(c *gin.Context) {
+	var req SetThresholdRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		appErr := apperror.Wrap(err, apperror.CodeInvalidInput, "Invalid request body", http.StatusBadRequest)
+		httpErr := apperror.ToHTTP(appErr)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, err.Error())
+		return
+	}
+
+	res, err := ctrl.service.SetThreshold(c.Request.Context(), c.Param("id"), req)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+func parsePage(c *gin.Context) (int, int) {
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if err != nil || limit < 1 || limit > 100 {
+		limit = 20
+	}
+	return page, limit
+}
+
+func paginationMeta(total int64, page, limit int) *response.PaginationMeta {
+	return &response.PaginationMeta{
+		Total:      total,
+		TotalPages: int((total + int64(limit) - 1) / int64(limit)),
+		Page:       page,
+		PageSize:   limit,
+	}
+}
@@ -0,0 +1,152 @@
+package inventory_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"go-sqlc-starter/internal/api/v1/inventory"
+	inventoryerrors "go-sqlc-starter/internal/api/v1/inventory/errors"
+	"go-sqlc-starter/internal/dbgen"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== FAKE SERVICE (Mock Manual) ====================
+
+type fakeInventoryService struct {
+	recordFunc        func(ctx context.Context, tx dbgen.DBTX, m inventory.Movement) (dbgen.StockMovement, error)
+	adjustFunc        func(ctx context.Context, productID, actorID string, req inventory.AdjustStockRequest) (inventory.StockMovementResponse, error)
+	listMovementsFunc func(ctx context.Context, productID string, page, limit int) ([]inventory.StockMovementResponse, int64, error)
+	listLowStockFunc  func(ctx context.Context, page, limit int) ([]inventory.LowStockResponse, int64, error)
+	setThresholdFunc  func(ctx context.Context, productID string, req inventory.SetThresholdRequest) (inventory.ProductStockResponse, error)
+}
+
+func (f *fakeInventoryService) Record(ctx context.Context, tx dbgen.DBTX, m inventory.Movement) (dbgen.StockMovement, error) {
+	return f.recordFunc(ctx, tx, m)
+}
+func (f *fakeInventoryService) Adjust(ctx context.Context, p, a string, req inventory.AdjustStockRequest) (inventory.StockMovementResponse, error) {
+	return f.adjustFunc(ctx, p, a, req)
+}
+func (f *fakeInventoryService) ListMovements(ctx context.Context, p string, page, limit int) ([]inventory.StockMovementResponse, int64, error) {
+	return f.listMovementsFunc(ctx, p, page, limit)
+}
+func (f *fakeInventoryService) ListLowStock(ctx context.Context, page, limit int) ([]inventory.LowStockResponse, int64, error) {
+	return f.listLowStockFunc(ctx, page, limit)
+}
+func (f *fakeInventoryService) SetThreshold(ctx context.Context, p string, req inventory.SetThresholdRequest) (inventory.ProductStockResponse, error) {
+	return f.setThresholdFunc(ctx, p, req)
+}
+
+// ==================== REUSABLE HELPERS ====================
+
+type inventoryTestDeps struct {
+	svc  *fakeInventoryService
+	ctrl *inventory.Controller
+	w    *httptest.ResponseRecorder
+	ctx  *gin.Context
+}
+
+func setupInventoryControllerTest() *inventoryTestDeps {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	svc := &fakeInventoryService{}
+
+	return &inventoryTestDeps{
+		svc:  svc,
+		ctrl: inventory.NewController(svc),
+		w:    w,
+		ctx:  ctx,
+	}
+}
+
+func (d *inventoryTestDeps) performRequest(method, path string, body interface{}) {
+	var jsonBody []byte
+	if body != nil {
+		jsonBody, _ = json.Marshal(body)
+	}
+	d.ctx.Request = httptest.NewRequest(method, path, bytes.NewBuffer(jsonBody))
+	d.ctx.Request.Header.Set("Content-Type", "application/json")
+}
+
+// ==================== ADJUST ====================
+
+func TestInventoryController_Adjust(t *testing.T) {
+	productID := uuid.New().String()
+	actorID := uuid.New().String()
+
+	t.Run("positive - actor taken from token", func(t *testing.T) {
+		d := setupInventoryControllerTest()
+		d.ctx.Set("user_id", actorID)
+		d.ctx.Params = gin.Params{{Key: "id", Value: productID}}
+
+		d.svc.adjustFunc = func(ctx context.Context, p, a string, req inventory.AdjustStockRequest) (inventory.StockMovementResponse, error) {
+			assert.Equal(t, productID, p)
+			assert.Equal(t, actorID, a)
+			assert.Equal(t, int32(-3), req.Delta)
+			return inventory.StockMovementResponse{ProductID: p, Delta: req.Delta, StockAfter: 7}, nil
+		}
+
+		d.performRequest(http.MethodPost, "/admin/inventory/products/"+productID+"/adjustments", map[string]interface{}{
+			"delta":  -3,
+			"reason": "ADJUSTMENT",
+			"note":   "damaged",
+		})
+		d.ctrl.Adjust(d.ctx)
+
+		assert.Equal(t, http.StatusCreated, d.w.Code)
+	})
+
+	t.Run("negative - insufficient stock", func(t *testing.T) {
+		d := setupInventoryControllerTest()
+		d.ctx.Params = gin.Params{{Key: "id", Value: productID}}
+
+		d.svc.adjustFunc = func(ctx context.Context, p, a string, req inventory.AdjustStockRequest) (inventory.StockMovementResponse, error) {
+			return inventory.StockMovementResponse{}, inventoryerrors.ErrInsufficientStock
+		}
+
+		d.performRequest(http.MethodPost, "/admin/inventory/products/"+productID+"/adjustments", map[string]interface{}{
+			"delta":  -100,
+			"reason": "ADJUSTMENT",
+		})
+		d.ctrl.Adjust(d.ctx)
+
+		assert.Equal(t, http.StatusConflict, d.w.Code)
+	})
+
+	t.Run("negative - invalid body", func(t *testing.T) {
+		d := setupInventoryControllerTest()
+		d.ctx.Params = gin.Params{{Key: "id", Value: productID}}
+
+		d.ctx.Request = httptest.NewRequest(http.MethodPost, "/admin/inventory/products/"+productID+"/adjustments", bytes.NewBufferString("{"))
+		d.ctx.Request.Header.Set("Content-Type", "application/json")
+		d.ctrl.Adjust(d.ctx)
+
+		assert.Equal(t, http.StatusBadRequest, d.w.Code)
+	})
+}
+
+// ==================== LOW STOCK ====================
+
+func TestInventoryController_ListLowStock(t *testing.T) {
+	t.Run("positive - paginated report", func(t *testing.T) {
+		d := setupInventoryControllerTest()
+
+		d.svc.listLowStockFunc = func(ctx context.Context, page, limit int) ([]inventory.LowStockResponse, int64, error) {
+			assert.Equal(t, 2, page)
+			assert.Equal(t, 10, limit)
+			return []inventory.LowStockResponse{{Name: "iPhone 15", Stock: 1, LowStockThreshold: 5}}, 11, nil
+		}
+
+		d.performRequest(http.MethodGet, "/admin/inventory/low-stock?page=2&limit=10", nil)
+		d.ctrl.ListLowStock(d.ctx)
+
+		assert.Equal(t, http.StatusOK, d.w.Code)
+		assert.Contains(t, d.w.Body.String(), `"totalPages":2`)
+	})
+}
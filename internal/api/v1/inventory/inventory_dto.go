@@ -0,0 +1,44 @@
+package inventory
+
+import "time"
+
+// ==================== REQUEST STRUCTS ====================
+
+// AdjustStockRequest perubahan stok relatif oleh admin (delta boleh negatif)
+type AdjustStockRequest struct {
+	Delta  int32  `json:"delta"`
+	Reason string `json:"reason"` // RESTOCK, ADJUSTMENT, RETURN
+	Note   string `json:"note" validate:"max=255"`
+}
+
+type SetThresholdRequest struct {
+	Threshold *int32 `json:"threshold"`
+}
+
+// ==================== RESPONSE STRUCTS ====================
+
+type StockMovementResponse struct {
+	ID         string    `json:"id"`
+	ProductID  string    `json:"productId"`
+	Delta      int32     `json:"delta"`
+	StockAfter int32     `json:"stockAfter"`
+	Reason     string    `json:"reason"`
+	OrderID    *string   `json:"orderId"`
+	ActorID    *string   `json:"actorId"`
+	Note       string    `json:"note"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+type ProductStockResponse struct {
+	ProductID         string `json:"productId"`
+	Stock             int32  `json:"stock"`
+	LowStockThreshold int32  `json:"lowStockThreshold"`
+}
+
+type LowStockResponse struct {
+	ProductID         string `json:"productId"`
+	Name              string `json:"name"`
+	SKU               string `json:"sku"`
+	Stock             int32  `json:"stock"`
+	LowStockThreshold int32  `json:"lowStockThreshold"`
+}
@@ -0,0 +1,78 @@
+package inventory
+
+import (
+	"context"
+	"database/sql"
+	"go-sqlc-starter/internal/dbgen"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=inventory_repo.go -destination=../mock/inventory/inventory_repo_mock.go -package=mock
+type Repository interface {
+	WithTx(tx dbgen.DBTX) Repository
+	AdjustStock(ctx context.Context, productID uuid.UUID, delta int32) (int32, error)
+	GetStock(ctx context.Context, productID uuid.UUID) (dbgen.GetProductStockRow, error)
+	CreateMovement(ctx context.Context, arg dbgen.CreateStockMovementParams) (dbgen.StockMovement, error)
+	ListMovements(ctx context.Context, productID uuid.UUID, limit, offset int32) ([]dbgen.ListStockMovementsByProductRow, error)
+	ListLowStock(ctx context.Context, limit, offset int32) ([]dbgen.ListLowStockProductsRow, error)
+	UpdateThreshold(ctx context.Context, productID uuid.UUID, threshold int32) (dbgen.UpdateProductLowStockThresholdRow, error)
+}
+
+type repository struct {
+	queries *dbgen.Queries
+}
+
+func NewRepository(q *dbgen.Queries) Repository {
+	return &repository{queries: q}
+}
+
+func (r *repository) WithTx(tx dbgen.DBTX) Repository {
+	if sqlTx, ok := tx.(*sql.Tx); ok {
+		return &repository{
+			queries: r.queries.WithTx(sqlTx),
+		}
+	}
+	return r
+}
+
+func (r *repository) AdjustStock(ctx context.Context, productID uuid.UUID, delta int32) (int32, error) {
+	row, err := r.queries.AdjustProductStock(ctx, dbgen.AdjustProductStockParams{
+		Delta:     delta,
+		ProductID: productID,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return row.Stock, nil
+}
+
+func (r *repository) GetStock(ctx context.Context, productID uuid.UUID) (dbgen.GetProductStockRow, error) {
+	return r.queries.GetProductStock(ctx, productID)
+}
+
+func (r *repository) CreateMovement(ctx context.Context, arg dbgen.CreateStockMovementParams) (dbgen.StockMovement, error) {
+	return r.queries.CreateStockMovement(ctx, arg)
+}
+
+func (r *repository) ListMovements(ctx context.Context, productID uuid.UUID, limit, offset int32) ([]dbgen.ListStockMovementsByProductRow, error) {
+	return r.queries.ListStockMovementsByProduct(ctx, dbgen.ListStockMovementsByProductParams{
+		ProductID: productID,
+		Limit:     limit,
+		Offset:    offset,
+	})
+}
+
+func (r *repository) ListLowStock(ctx context.Context, limit, offset int32) ([]dbgen.ListLowStockProductsRow, error) {
+	return r.queries.ListLowStockProducts(ctx, dbgen.ListLowStockProductsParams{
+		Limit:  limit,
+		Offset: offset,
+	})
+}
+
+func (r *repository) UpdateThreshold(ctx context.Context, productID uuid.UUID, threshold int32) (dbgen.UpdateProductLowStockThresholdRow, error) {
+	return r.queries.UpdateProductLowStockThreshold(ctx, dbgen.UpdateProductLowStockThresholdParams{
+		ID:                productID,
+		LowStockThreshold: threshold,
+	})
+}
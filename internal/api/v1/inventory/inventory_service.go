@@ -0,0 +1,257 @@
+package inventory
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	inventoryerrors "go-sqlc-starter/internal/api/v1/inventory/errors"
+	"go-sqlc-starter/internal/dbgen"
+	"go-sqlc-starter/internal/pkg/apperror"
+	"go-sqlc-starter/internal/pkg/constants"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+// Movement satu perubahan stok yang dicatat ke ledger
+type Movement struct {
+	ProductID uuid.UUID
+	Delta     int32
+	Reason    string
+	OrderID   uuid.NullUUID
+	ActorID   uuid.NullUUID
+	Note      string
+}
+
+// Reason yang boleh dipakai admin; PURCHASE / CANCEL hanya dari alur order
+var manualReasons = map[string]bool{
+	constants.StockReasonRestock:    true,
+	constants.StockReasonAdjustment: true,
+	constants.StockReasonReturn:     true,
+}
+
+// StockWatcher dipanggil setiap stok berubah, di transaksi yang sama (mis. notifikasi stok kembali)
+type StockWatcher interface {
+	OnStockChanged(ctx context.Context, tx dbgen.DBTX, productID uuid.UUID, stockBefore, stockAfter int32) error
+}
+
+//go:generate mockgen -source=inventory_service.go -destination=../mock/inventory/inventory_service_mock.go -package=mock
+type Service interface {
+	// Record mengubah products.stock dan mencatat ledger di dalam transaksi milik caller
+	Record(ctx context.Context, tx dbgen.DBTX, m Movement) (dbgen.StockMovement, error)
+
+	// Admin
+	Adjust(ctx context.Context, productID, actorID string, req AdjustStockRequest) (StockMovementResponse, error)
+	ListMovements(ctx context.Context, productID string, page, limit int) ([]StockMovementResponse, int64, error)
+	ListLowStock(ctx context.Context, page, limit int) ([]LowStockResponse, int64, error)
+	SetThreshold(ctx context.Context, productID string, req SetThresholdRequest) (ProductStockResponse, error)
+}
+
+type service struct {
+	db       *sql.DB
+	repo     Repository
+	watcher  StockWatcher
+	validate *validator.Validate
+}
+
+// NewService w boleh nil (tanpa hook perubahan stok)
+func NewService(db *sql.DB, r Repository, w StockWatcher) Service {
+	return &service{
+		db:       db,
+		repo:     r,
+		watcher:  w,
+		validate: validator.New(),
+	}
+}
+
+func (s *service) Record(ctx context.Context, tx dbgen.DBTX, m Movement) (dbgen.StockMovement, error) {
+	if m.Delta == 0 {
+		return dbgen.StockMovement{}, inventoryerrors.ErrInvalidDelta
+	}
+
+	qtx := s.repo
+	if tx != nil {
+		qtx = s.repo.WithTx(tx)
+	}
+
+	// 1. Update stok relatif (atomik, tidak boleh minus)
+	stockAfter, err := qtx.AdjustStock(ctx, m.ProductID, m.Delta)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return dbgen.StockMovement{}, inventoryerrors.ErrInventoryFailed
+		}
+		// 0 row: bedakan produk tidak ada vs stok kurang
+		if _, gerr := qtx.GetStock(ctx, m.ProductID); gerr != nil {
+			if errors.Is(gerr, sql.ErrNoRows) {
+				return dbgen.StockMovement{}, inventoryerrors.ErrProductNotFound
+			}
+			return dbgen.StockMovement{}, inventoryerrors.ErrInventoryFailed
+		}
+		return dbgen.StockMovement{}, inventoryerrors.ErrInsufficientStock
+	}
+
+	// 2. Catat ledger
+	mv, err := qtx.CreateMovement(ctx, dbgen.CreateStockMovementParams{
+		ProductID:  m.ProductID,
+		Delta:      m.Delta,
+		StockAfter: stockAfter,
+		Reason:     m.Reason,
+		OrderID:    m.OrderID,
+		ActorID:    m.ActorID,
+		Note:       dbgen.ToText(m.Note),
+	})
+	if err != nil {
+		return dbgen.StockMovement{}, inventoryerrors.ErrInventoryFailed
+	}
+
+	// 3. Hook perubahan stok (ikut rollback bersama transaksi caller)
+	if s.watcher != nil {
+		if err := s.watcher.OnStockChanged(ctx, tx, m.ProductID, stockAfter-m.Delta, stockAfter); err != nil {
+			return dbgen.StockMovement{}, inventoryerrors.ErrInventoryFailed
+		}
+	}
+
+	return mv, nil
+}
+
+func (s *service) Adjust(ctx context.Context, productID, actorID string, req AdjustStockRequest) (StockMovementResponse, error) {
+	pid, err := uuid.Parse(productID)
+	if err != nil {
+		return StockMovementResponse{}, inventoryerrors.ErrInvalidProductID
+	}
+	if err := s.validate.Struct(req); err != nil {
+		return StockMovementResponse{}, apperror.MapValidationError(err)
+	}
+	if req.Delta == 0 {
+		return StockMovementResponse{}, inventoryerrors.ErrInvalidDelta
+	}
+	if !manualReasons[req.Reason] {
+		return StockMovementResponse{}, inventoryerrors.ErrInvalidReason
+	}
+
+	var actor uuid.NullUUID
+	if aid, err := uuid.Parse(actorID); err == nil {
+		actor = uuid.NullUUID{UUID: aid, Valid: true}
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return StockMovementResponse{}, inventoryerrors.ErrInventoryFailed
+	}
+	defer tx.Rollback()
+
+	mv, err := s.Record(ctx, tx, Movement{
+		ProductID: pid,
+		Delta:     req.Delta,
+		Reason:    req.Reason,
+		ActorID:   actor,
+		Note:      req.Note,
+	})
+	if err != nil {
+		return StockMovementResponse{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return StockMovementResponse{}, inventoryerrors.ErrInventoryFailed
+	}
+
+	return mapMovement(mv), nil
+}
+
+func (s *service) ListMovements(ctx context.Context, productID string, page, limit int) ([]StockMovementResponse, int64, error) {
+	pid, err := uuid.Parse(productID)
+	if err != nil {
+		return nil, 0, inventoryerrors.ErrInvalidProductID
+	}
+
+	rows, err := s.repo.ListMovements(ctx, pid, int32(limit), int32((page-1)*limit))
+	if err != nil {
+		return nil, 0, inventoryerrors.ErrInventoryFailed
+	}
+
+	var total int64
+	res := make([]StockMovementResponse, 0, len(rows))
+	for _, r := range rows {
+		total = r.TotalCount
+		res = append(res, mapMovement(dbgen.StockMovement{
+			ID:         r.ID,
+			ProductID:  r.ProductID,
+			Delta:      r.Delta,
+			StockAfter: r.StockAfter,
+			Reason:     r.Reason,
+			OrderID:    r.OrderID,
+			ActorID:    r.ActorID,
+			Note:       r.Note,
+			CreatedAt:  r.CreatedAt,
+		}))
+	}
+
+	return res, total, nil
+}
+
+func (s *service) ListLowStock(ctx context.Context, page, limit int) ([]LowStockResponse, int64, error) {
+	rows, err := s.repo.ListLowStock(ctx, int32(limit), int32((page-1)*limit))
+	if err != nil {
+		return nil, 0, inventoryerrors.ErrInventoryFailed
+	}
+
+	var total int64
+	res := make([]LowStockResponse, 0, len(rows))
+	for _, r := range rows {
+		total = r.TotalCount
+		res = append(res, LowStockResponse{
+			ProductID:         r.ID.String(),
+			Name:              r.Name,
+			SKU:               r.Sku.String,
+			Stock:             r.Stock,
+			LowStockThreshold: r.LowStockThreshold,
+		})
+	}
+
+	return res, total, nil
+}
+
+func (s *service) SetThreshold(ctx context.Context, productID string, req SetThresholdRequest) (ProductStockResponse, error) {
+	pid, err := uuid.Parse(productID)
+	if err != nil {
+		return ProductStockResponse{}, inventoryerrors.ErrInvalidProductID
+	}
+	if req.Threshold == nil || *req.Threshold < 0 {
+		return ProductStockResponse{}, inventoryerrors.ErrInvalidThreshold
+	}
+
+	row, err := s.repo.UpdateThreshold(ctx, pid, *req.Threshold)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ProductStockResponse{}, inventoryerrors.ErrProductNotFound
+		}
+		return ProductStockResponse{}, inventoryerrors.ErrInventoryFailed
+	}
+
+	return ProductStockResponse{
+		ProductID:         row.ID.String(),
+		Stock:             row.Stock,
+		LowStockThreshold: row.LowStockThreshold,
+	}, nil
+}
+
+func mapMovement(m dbgen.StockMovement) StockMovementResponse {
+	res := StockMovementResponse{
+		ID:         m.ID.String(),
+		ProductID:  m.ProductID.String(),
+		Delta:      m.Delta,
+		StockAfter: m.StockAfter,
+		Reason:     m.Reason,
+		Note:       m.Note.String,
+		CreatedAt:  m.CreatedAt,
+	}
+	if m.OrderID.Valid {
+		id := m.OrderID.UUID.String()
+		res.OrderID = &id
+	}
+	if m.ActorID.Valid {
+		id := m.ActorID.UUID.String()
+		res.ActorID = &id
+	}
+	return res
+}
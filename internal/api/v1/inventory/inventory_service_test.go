@@ -0,0 +1,258 @@
+package inventory_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"go-sqlc-starter/internal/api/v1/inventory"
+	inventoryerrors "go-sqlc-starter/internal/api/v1/inventory/errors"
+	inventoryMock "go-sqlc-starter/internal/api/v1/mock/inventory"
+	"go-sqlc-starter/internal/dbgen"
+	"go-sqlc-starter/internal/pkg/constants"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type serviceDeps struct {
+	db      *sql.DB
+	sqlMock sqlmock.Sqlmock
+	service inventory.Service
+	repo    *inventoryMock.MockRepository
+}
+
+func setupServiceTest(t *testing.T) *serviceDeps {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	db, sqlMock, _ := sqlmock.New()
+	repo := inventoryMock.NewMockRepository(ctrl)
+
+	return &serviceDeps{
+		db:      db,
+		sqlMock: sqlMock,
+		service: inventory.NewService(db, repo, nil),
+		repo:    repo,
+	}
+}
+
+func expectTx(t *testing.T, mock sqlmock.Sqlmock, commit bool) {
+	t.Helper()
+
+	mock.ExpectBegin()
+	if commit {
+		mock.ExpectCommit()
+	} else {
+		mock.ExpectRollback()
+	}
+}
+
+func TestInventoryService_Record(t *testing.T) {
+	deps := setupServiceTest(t)
+	defer deps.db.Close()
+
+	ctx := context.Background()
+	productID := uuid.New()
+	orderID := uuid.NullUUID{UUID: uuid.New(), Valid: true}
+
+	t.Run("success - stock updated and ledger written", func(t *testing.T) {
+		deps.repo.EXPECT().AdjustStock(ctx, productID, int32(-2)).Return(int32(8), nil)
+		deps.repo.EXPECT().
+			CreateMovement(ctx, dbgen.CreateStockMovementParams{
+				ProductID:  productID,
+				Delta:      -2,
+				StockAfter: 8,
+				Reason:     constants.StockReasonPurchase,
+				OrderID:    orderID,
+			}).
+			Return(dbgen.StockMovement{ProductID: productID, Delta: -2, StockAfter: 8}, nil)
+
+		mv, err := deps.service.Record(ctx, nil, inventory.Movement{
+			ProductID: productID,
+			Delta:     -2,
+			Reason:    constants.StockReasonPurchase,
+			OrderID:   orderID,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int32(8), mv.StockAfter)
+	})
+
+	t.Run("fail - zero delta", func(t *testing.T) {
+		_, err := deps.service.Record(ctx, nil, inventory.Movement{ProductID: productID})
+		assert.ErrorIs(t, err, inventoryerrors.ErrInvalidDelta)
+	})
+
+	t.Run("fail - insufficient stock", func(t *testing.T) {
+		deps.repo.EXPECT().AdjustStock(ctx, productID, int32(-20)).Return(int32(0), sql.ErrNoRows)
+		deps.repo.EXPECT().GetStock(ctx, productID).Return(dbgen.GetProductStockRow{ID: productID, Stock: 8}, nil)
+
+		_, err := deps.service.Record(ctx, nil, inventory.Movement{ProductID: productID, Delta: -20, Reason: constants.StockReasonPurchase})
+		assert.ErrorIs(t, err, inventoryerrors.ErrInsufficientStock)
+	})
+
+	t.Run("fail - product not found", func(t *testing.T) {
+		deps.repo.EXPECT().AdjustStock(ctx, productID, int32(5)).Return(int32(0), sql.ErrNoRows)
+		deps.repo.EXPECT().GetStock(ctx, productID).Return(dbgen.GetProductStockRow{}, sql.ErrNoRows)
+
+		_, err := deps.service.Record(ctx, nil, inventory.Movement{ProductID: productID, Delta: 5, Reason: constants.StockReasonRestock})
+		assert.ErrorIs(t, err, inventoryerrors.ErrProductNotFound)
+	})
+}
+
+func TestInventoryService_RecordStockWatcher(t *testing.T) {
+	ctx := context.Background()
+	productID := uuid.New()
+
+	setup := func(t *testing.T) (inventory.Service, *inventoryMock.MockRepository, *inventoryMock.MockStockWatcher) {
+		ctrl := gomock.NewController(t)
+		repo := inventoryMock.NewMockRepository(ctrl)
+		watcher := inventoryMock.NewMockStockWatcher(ctrl)
+		return inventory.NewService(nil, repo, watcher), repo, watcher
+	}
+
+	t.Run("restock from zero - watcher gets before/after", func(t *testing.T) {
+		svc, repo, watcher := setup(t)
+
+		repo.EXPECT().AdjustStock(ctx, productID, int32(5)).Return(int32(5), nil)
+		repo.EXPECT().CreateMovement(ctx, gomock.Any()).Return(dbgen.StockMovement{StockAfter: 5}, nil)
+		watcher.EXPECT().OnStockChanged(ctx, nil, productID, int32(0), int32(5)).Return(nil)
+
+		_, err := svc.Record(ctx, nil, inventory.Movement{ProductID: productID, Delta: 5, Reason: constants.StockReasonRestock})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("watcher failure - movement rejected", func(t *testing.T) {
+		svc, repo, watcher := setup(t)
+
+		repo.EXPECT().AdjustStock(ctx, productID, int32(5)).Return(int32(5), nil)
+		repo.EXPECT().CreateMovement(ctx, gomock.Any()).Return(dbgen.StockMovement{StockAfter: 5}, nil)
+		watcher.EXPECT().OnStockChanged(ctx, nil, productID, int32(0), int32(5)).Return(errors.New("outbox failed"))
+
+		_, err := svc.Record(ctx, nil, inventory.Movement{ProductID: productID, Delta: 5, Reason: constants.StockReasonRestock})
+
+		assert.ErrorIs(t, err, inventoryerrors.ErrInventoryFailed)
+	})
+}
+
+func TestInventoryService_Adjust(t *testing.T) {
+	deps := setupServiceTest(t)
+	defer deps.db.Close()
+
+	ctx := context.Background()
+	productID := uuid.New()
+	actorID := uuid.New()
+
+	t.Run("success - manual restock with actor", func(t *testing.T) {
+		expectTx(t, deps.sqlMock, true)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		deps.repo.EXPECT().AdjustStock(ctx, productID, int32(10)).Return(int32(15), nil)
+		deps.repo.EXPECT().
+			CreateMovement(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, arg dbgen.CreateStockMovementParams) (dbgen.StockMovement, error) {
+				assert.Equal(t, constants.StockReasonRestock, arg.Reason)
+				assert.Equal(t, uuid.NullUUID{UUID: actorID, Valid: true}, arg.ActorID)
+				assert.Equal(t, "supplier PO-1", arg.Note.String)
+				return dbgen.StockMovement{ProductID: productID, Delta: 10, StockAfter: 15, Reason: arg.Reason, ActorID: arg.ActorID}, nil
+			})
+
+		res, err := deps.service.Adjust(ctx, productID.String(), actorID.String(), inventory.AdjustStockRequest{
+			Delta:  10,
+			Reason: constants.StockReasonRestock,
+			Note:   "supplier PO-1",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int32(15), res.StockAfter)
+		assert.Equal(t, actorID.String(), *res.ActorID)
+		assert.NoError(t, deps.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - order-only reason rejected", func(t *testing.T) {
+		_, err := deps.service.Adjust(ctx, productID.String(), actorID.String(), inventory.AdjustStockRequest{
+			Delta:  -1,
+			Reason: constants.StockReasonPurchase,
+		})
+		assert.ErrorIs(t, err, inventoryerrors.ErrInvalidReason)
+	})
+
+	t.Run("fail - insufficient stock rolls back", func(t *testing.T) {
+		expectTx(t, deps.sqlMock, false)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		deps.repo.EXPECT().AdjustStock(ctx, productID, int32(-99)).Return(int32(0), sql.ErrNoRows)
+		deps.repo.EXPECT().GetStock(ctx, productID).Return(dbgen.GetProductStockRow{ID: productID, Stock: 3}, nil)
+
+		_, err := deps.service.Adjust(ctx, productID.String(), "", inventory.AdjustStockRequest{
+			Delta:  -99,
+			Reason: constants.StockReasonAdjustment,
+		})
+
+		assert.ErrorIs(t, err, inventoryerrors.ErrInsufficientStock)
+		assert.NoError(t, deps.sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestInventoryService_ListLowStock(t *testing.T) {
+	deps := setupServiceTest(t)
+	defer deps.db.Close()
+
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		deps.repo.EXPECT().ListLowStock(ctx, int32(20), int32(20)).Return([]dbgen.ListLowStockProductsRow{
+			{ID: uuid.New(), Name: "iPhone 15", Sku: sql.NullString{String: "IP15", Valid: true}, Stock: 1, LowStockThreshold: 5, TotalCount: 21},
+		}, nil)
+
+		res, total, err := deps.service.ListLowStock(ctx, 2, 20)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(21), total)
+		assert.Equal(t, "IP15", res[0].SKU)
+	})
+
+	t.Run("fail - repo error", func(t *testing.T) {
+		deps.repo.EXPECT().ListLowStock(ctx, int32(20), int32(0)).Return(nil, errors.New("db error"))
+
+		_, _, err := deps.service.ListLowStock(ctx, 1, 20)
+		assert.ErrorIs(t, err, inventoryerrors.ErrInventoryFailed)
+	})
+}
+
+func TestInventoryService_SetThreshold(t *testing.T) {
+	deps := setupServiceTest(t)
+	defer deps.db.Close()
+
+	ctx := context.Background()
+	productID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		threshold := int32(3)
+		deps.repo.EXPECT().UpdateThreshold(ctx, productID, threshold).
+			Return(dbgen.UpdateProductLowStockThresholdRow{ID: productID, Stock: 10, LowStockThreshold: 3}, nil)
+
+		res, err := deps.service.SetThreshold(ctx, productID.String(), inventory.SetThresholdRequest{Threshold: &threshold})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int32(3), res.LowStockThreshold)
+	})
+
+	t.Run("fail - negative threshold", func(t *testing.T) {
+		threshold := int32(-1)
+		_, err := deps.service.SetThreshold(ctx, productID.String(), inventory.SetThresholdRequest{Threshold: &threshold})
+		assert.ErrorIs(t, err, inventoryerrors.ErrInvalidThreshold)
+	})
+
+	t.Run("fail - product not found", func(t *testing.T) {
+		threshold := int32(1)
+		deps.repo.EXPECT().UpdateThreshold(ctx, productID, threshold).
+			Return(dbgen.UpdateProductLowStockThresholdRow{}, sql.ErrNoRows)
+
+		_, err := deps.service.SetThreshold(ctx, productID.String(), inventory.SetThresholdRequest{Threshold: &threshold})
+		assert.ErrorIs(t, err, inventoryerrors.ErrProductNotFound)
+	})
+}
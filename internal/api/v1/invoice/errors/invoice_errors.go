@@ -0,0 +1,32 @@
+package invoiceerrors
+
+import (
+	"go-sqlc-starter/internal/pkg/apperror"
+	"net/http"
+)
+
+var (
+	ErrInvalidOrderID = apperror.New(
+		apperror.CodeInvalidInput,
+		"Invalid order ID",
+		http.StatusBadRequest,
+	)
+
+	ErrOrderNotFound = apperror.New(
+		apperror.CodeNotFound,
+		"Order not found",
+		http.StatusNotFound,
+	)
+
+	ErrInvoiceNotAvailable = apperror.New(
+		apperror.CodeInvalidState,
+		"Invoice is available after the order is paid",
+		http.StatusBadRequest,
+	)
+
+	ErrInvoiceFailed = apperror.New(
+		apperror.CodeInternalError,
+		"Failed to generate invoice",
+		http.StatusInternalServerError,
+	)
+)
@@ -0,0 +1,41 @@
+package invoice
+
+import (
+	"fmt"
+	"go-sqlc-starter/internal/pkg/apperror"
+	"go-sqlc-starter/internal/pkg/response"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Controller struct {
+	service Service
+}
+
+func NewController(svc Service) *Controller {
+	return &Controller{service: svc}
+}
+
+// Download unduh invoice PDF (pemilik order atau admin)
+// GET /orders/:id/invoice.pdf
+func (ctrl *Controller) Download(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		response.Error(c, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated", nil)
+		return
+	}
+
+	role, _ := c.Get("role")
+	isAdmin := role == "ADMIN" || role == "SUPERADMIN"
+
+	file, err := ctrl.service.Generate(c.Request.Context(), userID.(string), isAdmin, c.Param("id"))
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, file.Filename))
+	c.Data(http.StatusOK, "application/pdf", file.Content)
+}
@@ -0,0 +1,92 @@
+package invoice_test
+
+import (
+	"context"
+	"go-sqlc-starter/internal/api/v1/invoice"
+	invoiceerrors "go-sqlc-starter/internal/api/v1/invoice/errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== FAKE SERVICE (Mock Manual) ====================
+
+type fakeInvoiceService struct {
+	generateFunc func(ctx context.Context, userID string, isAdmin bool, orderID string) (invoice.File, error)
+}
+
+func (f *fakeInvoiceService) Generate(ctx context.Context, userID string, isAdmin bool, orderID string) (invoice.File, error) {
+	return f.generateFunc(ctx, userID, isAdmin, orderID)
+}
+
+func TestInvoiceController_Download(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	userID, orderID := uuid.New().String(), uuid.New().String()
+
+	perform := func(svc *fakeInvoiceService, userID, role string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		if userID != "" {
+			c.Set("user_id", userID)
+			c.Set("role", role)
+		}
+		c.Params = gin.Params{{Key: "id", Value: orderID}}
+		c.Request = httptest.NewRequest(http.MethodGet, "/orders/"+orderID+"/invoice.pdf", nil)
+
+		invoice.NewController(svc).Download(c)
+		return w
+	}
+
+	t.Run("positive - pdf attachment", func(t *testing.T) {
+		svc := &fakeInvoiceService{
+			generateFunc: func(ctx context.Context, u string, isAdmin bool, o string) (invoice.File, error) {
+				assert.Equal(t, userID, u)
+				assert.False(t, isAdmin)
+				assert.Equal(t, orderID, o)
+				return invoice.File{Filename: "INV-2026-000001.pdf", Content: []byte("%PDF-1.4")}, nil
+			},
+		}
+
+		w := perform(svc, userID, "CUSTOMER")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/pdf", w.Header().Get("Content-Type"))
+		assert.Equal(t, `attachment; filename="INV-2026-000001.pdf"`, w.Header().Get("Content-Disposition"))
+		assert.Equal(t, "%PDF-1.4", w.Body.String())
+	})
+
+	t.Run("positive - admin role bypasses ownership", func(t *testing.T) {
+		svc := &fakeInvoiceService{
+			generateFunc: func(ctx context.Context, u string, isAdmin bool, o string) (invoice.File, error) {
+				assert.True(t, isAdmin)
+				return invoice.File{Filename: "INV.pdf"}, nil
+			},
+		}
+
+		w := perform(svc, userID, "SUPERADMIN")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("negative - not paid yet", func(t *testing.T) {
+		svc := &fakeInvoiceService{
+			generateFunc: func(ctx context.Context, u string, isAdmin bool, o string) (invoice.File, error) {
+				return invoice.File{}, invoiceerrors.ErrInvoiceNotAvailable
+			},
+		}
+
+		w := perform(svc, userID, "CUSTOMER")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("negative - unauthenticated", func(t *testing.T) {
+		w := perform(&fakeInvoiceService{}, "", "")
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
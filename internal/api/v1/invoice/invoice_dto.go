@@ -0,0 +1,48 @@
+package invoice
+
+import (
+	"go-sqlc-starter/internal/api/v1/address"
+	"time"
+)
+
+// File hasil render invoice siap dikirim ke client
+type File struct {
+	Filename string
+	Content  []byte
+}
+
+// Invoice data order yang dirender ke PDF
+type Invoice struct {
+	Number           string
+	IssuedAt         time.Time
+	OrderNumber      string
+	PlacedAt         time.Time
+	Status           string
+	PaymentMethod    string
+	PaymentStatus    string
+	PaidAt           *time.Time
+	BillTo           Address
+	Items            []Line
+	Subtotal         float64
+	Discount         float64
+	VoucherCode      string
+	Shipping         float64
+	ShippingCourier  string
+	ShippingService  string
+	Tax              float64
+	PricesIncludeTax bool
+	Total            float64
+	Refunded         float64
+}
+
+type Line struct {
+	Name      string
+	UnitPrice float64
+	Quantity  int32
+	TaxRate   float64 // persen, snapshot dari order_items
+	TaxAmount float64
+	Total     float64
+}
+
+// Address isi orders.address_snapshot yang ditulis saat checkout
+type Address = address.Snapshot
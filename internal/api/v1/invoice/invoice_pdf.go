@@ -0,0 +1,222 @@
+package invoice
+
+import (
+	"fmt"
+	"go-sqlc-starter/internal/pkg/pdf"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Layout A4 portrait, semua ukuran dalam point
+const (
+	marginX     = 40.0
+	rowHeight   = 16.0
+	bottomLimit = pdf.PageHeight - 60
+
+	colNo      = marginX
+	colItem    = marginX + 25
+	colQty     = 280.0 // rata kanan
+	colPrice   = 360.0 // rata kanan
+	colTaxRate = 400.0 // rata kanan
+	colTax     = 480.0 // rata kanan
+	colTotal   = pdf.PageWidth - marginX
+)
+
+const dateLayout = "02 Jan 2006 15:04"
+
+type summaryRow struct {
+	label string
+	value string
+	bold  bool
+}
+
+// Render susun invoice menjadi file PDF
+func Render(inv Invoice) []byte {
+	doc := pdf.New()
+	right := pdf.PageWidth - marginX
+
+	// 1. Header
+	doc.Text(marginX, 60, 20, true, "INVOICE")
+	doc.TextRight(right, 60, 11, true, inv.Number)
+	doc.Line(marginX, 72, right, 72)
+
+	// 2. Info invoice & order (kiri), alamat tagihan (kanan)
+	y := 92.0
+	info := [][2]string{
+		{"Invoice Date", inv.IssuedAt.Format(dateLayout)},
+		{"Order No", inv.OrderNumber},
+		{"Order Date", inv.PlacedAt.Format(dateLayout)},
+		{"Order Status", inv.Status},
+	}
+	for i, row := range info {
+		doc.Text(marginX, y+float64(i)*14, 9, true, row[0])
+		doc.Text(marginX+80, y+float64(i)*14, 9, false, row[1])
+	}
+
+	doc.Text(320, y, 9, true, "Bill To")
+	for i, line := range addressLines(inv.BillTo) {
+		doc.Text(320, y+float64(i+1)*14, 9, false, pdf.Truncate(line, 9, false, right-320))
+	}
+
+	// 3. Pembayaran
+	y += 5 * 14
+	paidAt := "-"
+	if inv.PaidAt != nil {
+		paidAt = inv.PaidAt.Format(dateLayout)
+	}
+	payment := [][2]string{
+		{"Payment Method", orDash(inv.PaymentMethod)},
+		{"Payment Status", inv.PaymentStatus},
+		{"Paid At", paidAt},
+	}
+	for i, row := range payment {
+		doc.Text(marginX, y+float64(i)*14, 9, true, row[0])
+		doc.Text(marginX+80, y+float64(i)*14, 9, false, row[1])
+	}
+
+	// 4. Tabel item (pindah halaman jika penuh, header tabel diulang)
+	y += 3*14 + 20
+	y = tableHeader(doc, y)
+	for i, item := range inv.Items {
+		if y > bottomLimit {
+			doc.AddPage()
+			y = tableHeader(doc, 60)
+		}
+		doc.Text(colNo, y, 9, false, fmt.Sprintf("%d", i+1))
+		doc.Text(colItem, y, 9, false, pdf.Truncate(item.Name, 9, false, colQty-40-colItem))
+		doc.TextRight(colQty, y, 9, false, fmt.Sprintf("%d", item.Quantity))
+		doc.TextRight(colPrice, y, 9, false, formatRupiah(item.UnitPrice))
+		doc.TextRight(colTaxRate, y, 9, false, formatRate(item.TaxRate))
+		doc.TextRight(colTax, y, 9, false, formatRupiah(item.TaxAmount))
+		doc.TextRight(colTotal, y, 9, false, formatRupiah(item.Total))
+		y += rowHeight
+	}
+	doc.Line(marginX, y-10, right, y-10)
+
+	// 5. Ringkasan harga
+	discountLabel := "Discount"
+	if inv.VoucherCode != "" {
+		discountLabel += " (" + inv.VoucherCode + ")"
+	}
+	shippingLabel := "Shipping"
+	if courier := strings.TrimSpace(inv.ShippingCourier + " " + inv.ShippingService); courier != "" {
+		shippingLabel += " (" + courier + ")"
+	}
+
+	summary := []summaryRow{
+		{"Subtotal", formatRupiah(inv.Subtotal), false},
+		{discountLabel, "-" + formatRupiah(inv.Discount), false},
+		{shippingLabel, formatRupiah(inv.Shipping), false},
+	}
+	// Mode eksklusif: pajak menambah total; inklusif: hanya informasi porsi pajak
+	if inv.PricesIncludeTax {
+		summary = append(summary,
+			summaryRow{"Total", formatRupiah(inv.Total), true},
+			summaryRow{"Incl. Tax (PPN)", formatRupiah(inv.Tax), false},
+		)
+	} else {
+		summary = append(summary,
+			summaryRow{"Tax (PPN)", formatRupiah(inv.Tax), false},
+			summaryRow{"Total", formatRupiah(inv.Total), true},
+		)
+	}
+	if inv.Refunded > 0 {
+		summary = append(summary, summaryRow{"Refunded", "-" + formatRupiah(inv.Refunded), false})
+	}
+
+	y += 6
+	if y+float64(len(summary))*rowHeight > bottomLimit {
+		doc.AddPage()
+		y = 60
+	}
+	for _, row := range summary {
+		doc.TextRight(colTax, y, 9, row.bold, row.label)
+		doc.TextRight(colTotal, y, 9, row.bold, row.value)
+		y += rowHeight
+	}
+
+	doc.Text(marginX, pdf.PageHeight-40, 8, false, "This invoice is generated electronically and is valid without signature.")
+
+	return doc.Bytes()
+}
+
+func tableHeader(doc *pdf.Document, y float64) float64 {
+	doc.Text(colNo, y, 9, true, "No")
+	doc.Text(colItem, y, 9, true, "Item")
+	doc.TextRight(colQty, y, 9, true, "Qty")
+	doc.TextRight(colPrice, y, 9, true, "Unit Price")
+	doc.TextRight(colTaxRate, y, 9, true, "Tax %")
+	doc.TextRight(colTax, y, 9, true, "Tax")
+	doc.TextRight(colTotal, y, 9, true, "Total")
+	doc.Line(marginX, y+6, colTotal, y+6)
+	return y + rowHeight + 4
+}
+
+func addressLines(a Address) []string {
+	var lines []string
+	if a.RecipientName != "" {
+		lines = append(lines, a.RecipientName)
+	}
+	if a.RecipientPhone != "" {
+		lines = append(lines, a.RecipientPhone)
+	}
+	if a.Street != "" {
+		lines = append(lines, a.Street)
+	}
+	if area := joinNonEmpty(a.Subdistrict, a.District); area != "" {
+		lines = append(lines, area)
+	}
+	if region := joinNonEmpty(a.City, a.Province, a.PostalCode); region != "" {
+		lines = append(lines, region)
+	}
+	if len(lines) == 0 {
+		lines = append(lines, "-")
+	}
+	return lines
+}
+
+func joinNonEmpty(parts ...string) string {
+	var out []string
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			out = append(out, p)
+		}
+	}
+	return strings.Join(out, ", ")
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// formatRupiah 1500000.5 -> "Rp 1.500.000,50" (desimal hanya jika ada sen)
+// formatRate tarif pajak dalam persen, desimal memakai koma
+func formatRate(v float64) string {
+	return strings.ReplaceAll(strconv.FormatFloat(v, 'f', -1, 64), ".", ",") + "%"
+}
+
+func formatRupiah(v float64) string {
+	cents := int64(math.Round(math.Abs(v) * 100))
+	whole := fmt.Sprintf("%d", cents/100)
+
+	var b strings.Builder
+	for i, c := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			b.WriteByte('.')
+		}
+		b.WriteRune(c)
+	}
+	if frac := cents % 100; frac != 0 {
+		fmt.Fprintf(&b, ",%02d", frac)
+	}
+
+	sign := ""
+	if v < 0 {
+		sign = "-"
+	}
+	return sign + "Rp " + b.String()
+}
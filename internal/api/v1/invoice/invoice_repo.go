@@ -0,0 +1,30 @@
+package invoice
+
+import (
+	"context"
+	"go-sqlc-starter/internal/dbgen"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=invoice_repo.go -destination=../mock/invoice/invoice_repo_mock.go -package=mock
+type Repository interface {
+	GetOrder(ctx context.Context, orderID uuid.UUID) (dbgen.Order, error)
+	GetItems(ctx context.Context, orderID uuid.UUID) ([]dbgen.OrderItem, error)
+}
+
+type repository struct {
+	queries *dbgen.Queries
+}
+
+func NewRepository(q *dbgen.Queries) Repository {
+	return &repository{queries: q}
+}
+
+func (r *repository) GetOrder(ctx context.Context, orderID uuid.UUID) (dbgen.Order, error) {
+	return r.queries.GetOrderByID(ctx, orderID)
+}
+
+func (r *repository) GetItems(ctx context.Context, orderID uuid.UUID) ([]dbgen.OrderItem, error) {
+	return r.queries.GetOrderItems(ctx, orderID)
+}
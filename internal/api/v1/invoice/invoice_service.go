@@ -0,0 +1,117 @@
+package invoice
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	invoiceerrors "go-sqlc-starter/internal/api/v1/invoice/errors"
+	"go-sqlc-starter/internal/dbgen"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=invoice_service.go -destination=../mock/invoice/invoice_service_mock.go -package=mock
+type Service interface {
+	// Generate render invoice PDF. Selain admin hanya boleh untuk order miliknya.
+	Generate(ctx context.Context, userID string, isAdmin bool, orderID string) (File, error)
+}
+
+type service struct {
+	repo Repository
+}
+
+func NewService(r Repository) Service {
+	return &service{
+		repo: r,
+	}
+}
+
+func (s *service) Generate(ctx context.Context, userID string, isAdmin bool, orderID string) (File, error) {
+	oid, err := uuid.Parse(orderID)
+	if err != nil {
+		return File{}, invoiceerrors.ErrInvalidOrderID
+	}
+
+	// 1. Order milik user lain dianggap tidak ada (tidak bocorkan keberadaannya)
+	o, err := s.repo.GetOrder(ctx, oid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return File{}, invoiceerrors.ErrOrderNotFound
+		}
+		return File{}, invoiceerrors.ErrInvoiceFailed
+	}
+	if !isAdmin && o.UserID.String() != userID {
+		return File{}, invoiceerrors.ErrOrderNotFound
+	}
+
+	// 2. Nomor invoice dialokasikan DB saat order menjadi PAID
+	if !o.InvoiceNumber.Valid {
+		return File{}, invoiceerrors.ErrInvoiceNotAvailable
+	}
+
+	items, err := s.repo.GetItems(ctx, oid)
+	if err != nil {
+		return File{}, invoiceerrors.ErrInvoiceFailed
+	}
+
+	inv := buildInvoice(o, items)
+	return File{
+		Filename: strings.ReplaceAll(inv.Number, "/", "-") + ".pdf",
+		Content:  Render(inv),
+	}, nil
+}
+
+func buildInvoice(o dbgen.Order, items []dbgen.OrderItem) Invoice {
+	inv := Invoice{
+		Number:           o.InvoiceNumber.String,
+		IssuedAt:         o.InvoicedAt.Time,
+		OrderNumber:      o.OrderNumber,
+		PlacedAt:         o.PlacedAt,
+		Status:           o.Status,
+		PaymentMethod:    o.PaymentMethod.String,
+		PaymentStatus:    o.PaymentStatus,
+		BillTo:           snapshotAddress(o),
+		Subtotal:         parseMoney(o.SubtotalPrice),
+		Discount:         parseMoney(o.DiscountPrice),
+		VoucherCode:      o.VoucherCode.String,
+		Shipping:         parseMoney(o.ShippingPrice),
+		ShippingCourier:  o.ShippingCourier.String,
+		ShippingService:  o.ShippingService.String,
+		Tax:              parseMoney(o.TaxPrice),
+		PricesIncludeTax: o.PricesIncludeTax,
+		Total:            parseMoney(o.TotalPrice),
+		Refunded:         parseMoney(o.RefundedAmount),
+	}
+	if o.PaidAt.Valid {
+		inv.PaidAt = &o.PaidAt.Time
+	}
+
+	inv.Items = make([]Line, 0, len(items))
+	for _, item := range items {
+		inv.Items = append(inv.Items, Line{
+			Name:      item.NameSnapshot,
+			UnitPrice: parseMoney(item.UnitPrice),
+			Quantity:  item.Quantity,
+			TaxRate:   parseMoney(item.TaxRate),
+			TaxAmount: parseMoney(item.TaxAmount),
+			Total:     parseMoney(item.TotalPrice),
+		})
+	}
+	return inv
+}
+
+// snapshotAddress hanya membaca snapshot; alamat aktif user tidak dipakai
+// karena bisa sudah diedit / dihapus setelah order dibuat
+func snapshotAddress(o dbgen.Order) Address {
+	var addr Address
+	_ = json.Unmarshal(o.AddressSnapshot, &addr)
+	return addr
+}
+
+func parseMoney(v string) float64 {
+	f, _ := strconv.ParseFloat(v, 64)
+	return f
+}
@@ -0,0 +1,157 @@
+package invoice_test
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"go-sqlc-starter/internal/api/v1/invoice"
+	invoiceerrors "go-sqlc-starter/internal/api/v1/invoice/errors"
+	invoiceMock "go-sqlc-starter/internal/api/v1/mock/invoice"
+	"go-sqlc-starter/internal/dbgen"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type serviceDeps struct {
+	service invoice.Service
+	repo    *invoiceMock.MockRepository
+}
+
+func setupServiceTest(t *testing.T) *serviceDeps {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	repo := invoiceMock.NewMockRepository(ctrl)
+
+	return &serviceDeps{
+		service: invoice.NewService(repo),
+		repo:    repo,
+	}
+}
+
+func paidOrder(userID uuid.UUID, snapshot string) dbgen.Order {
+	return dbgen.Order{
+		ID:              uuid.New(),
+		OrderNumber:     "ORD-1700000000ABCD",
+		UserID:          userID,
+		Status:          "PAID",
+		PaymentMethod:   sql.NullString{String: "BANK_TRANSFER", Valid: true},
+		PaymentStatus:   "PAID",
+		AddressSnapshot: json.RawMessage(snapshot),
+		SubtotalPrice:   "300000.00",
+		DiscountPrice:   "30000.00",
+		ShippingPrice:   "15000.00",
+		TaxPrice:        "29700.00",
+		TotalPrice:      "314700.00",
+		RefundedAmount:  "0.00",
+		VoucherCode:     sql.NullString{String: "HEMAT10", Valid: true},
+		PlacedAt:        time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC),
+		PaidAt:          sql.NullTime{Time: time.Date(2026, 10, 1, 9, 5, 0, 0, time.UTC), Valid: true},
+		InvoiceNumber:   sql.NullString{String: "INV/2026/000042", Valid: true},
+		InvoicedAt:      sql.NullTime{Time: time.Date(2026, 10, 1, 9, 5, 0, 0, time.UTC), Valid: true},
+	}
+}
+
+func TestInvoiceService_Generate(t *testing.T) {
+	ctx := context.Background()
+	ownerID := uuid.New()
+	items := []dbgen.OrderItem{
+		{NameSnapshot: "Kaos Polos (Hitam)", UnitPrice: "150000.00", Quantity: 2, TotalPrice: "300000.00", TaxRate: "11.00", TaxAmount: "29700.00"},
+	}
+
+	t.Run("success - owner downloads invoice", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		o := paidOrder(ownerID, `{"recipient_name":"Budi","street":"Jl. Merdeka 1","city":"Bandung"}`)
+
+		deps.repo.EXPECT().GetOrder(ctx, o.ID).Return(o, nil)
+		deps.repo.EXPECT().GetItems(ctx, o.ID).Return(items, nil)
+
+		file, err := deps.service.Generate(ctx, ownerID.String(), false, o.ID.String())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "INV-2026-000042.pdf", file.Filename)
+		assert.True(t, bytes.HasPrefix(file.Content, []byte("%PDF-")))
+		assert.Contains(t, string(file.Content), "(INV/2026/000042)")
+		assert.Contains(t, string(file.Content), "(Kaos Polos \\(Hitam\\))")
+		assert.Contains(t, string(file.Content), "(Tax %)")
+		assert.Contains(t, string(file.Content), "(11%)")
+		assert.Contains(t, string(file.Content), "(Tax \\(PPN\\))")
+		assert.Contains(t, string(file.Content), "(Rp 29.700)")
+		assert.Contains(t, string(file.Content), "(Rp 314.700)")
+		assert.Contains(t, string(file.Content), "(Budi)")
+	})
+
+	t.Run("success - admin reads other user's invoice from snapshot", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		o := paidOrder(ownerID, `{"address_id":"`+uuid.New().String()+`","recipient_name":"Siti","recipient_phone":"0812","street":"Jl. Sudirman 5","city":"Jakarta","postal_code":"10220"}`)
+
+		deps.repo.EXPECT().GetOrder(ctx, o.ID).Return(o, nil)
+		deps.repo.EXPECT().GetItems(ctx, o.ID).Return(items, nil)
+
+		file, err := deps.service.Generate(ctx, uuid.New().String(), true, o.ID.String())
+
+		assert.NoError(t, err)
+		assert.Contains(t, string(file.Content), "(Siti)")
+		assert.Contains(t, string(file.Content), "(Jakarta, 10220)")
+	})
+
+	t.Run("error - other customer's order is not found", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		o := paidOrder(ownerID, `{}`)
+
+		deps.repo.EXPECT().GetOrder(ctx, o.ID).Return(o, nil)
+
+		_, err := deps.service.Generate(ctx, uuid.New().String(), false, o.ID.String())
+
+		assert.Equal(t, invoiceerrors.ErrOrderNotFound, err)
+	})
+
+	t.Run("error - order not paid yet", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		o := paidOrder(ownerID, `{}`)
+		o.Status, o.PaymentStatus = "PENDING", "UNPAID"
+		o.InvoiceNumber = sql.NullString{}
+
+		deps.repo.EXPECT().GetOrder(ctx, o.ID).Return(o, nil)
+
+		_, err := deps.service.Generate(ctx, ownerID.String(), false, o.ID.String())
+
+		assert.Equal(t, invoiceerrors.ErrInvoiceNotAvailable, err)
+	})
+
+	t.Run("error - order missing", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		oid := uuid.New()
+
+		deps.repo.EXPECT().GetOrder(ctx, oid).Return(dbgen.Order{}, sql.ErrNoRows)
+
+		_, err := deps.service.Generate(ctx, ownerID.String(), true, oid.String())
+
+		assert.Equal(t, invoiceerrors.ErrOrderNotFound, err)
+	})
+
+	t.Run("error - invalid order id", func(t *testing.T) {
+		deps := setupServiceTest(t)
+
+		_, err := deps.service.Generate(ctx, ownerID.String(), false, "not-a-uuid")
+
+		assert.Equal(t, invoiceerrors.ErrInvalidOrderID, err)
+	})
+}
+
+func TestRender_PaginatesLongOrders(t *testing.T) {
+	inv := invoice.Invoice{Number: "INV/2026/000001"}
+	for i := 0; i < 80; i++ {
+		inv.Items = append(inv.Items, invoice.Line{Name: "Item", UnitPrice: 1000, Quantity: 1, Total: 1000})
+	}
+
+	content := string(invoice.Render(inv))
+
+	assert.Contains(t, content, "/Count 3")
+}
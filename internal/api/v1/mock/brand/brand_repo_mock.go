@@ -52,6 +52,20 @@ func (mr *MockRepositoryMockRecorder) Create(ctx, arg interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), ctx, arg)
 }
 
+// CreateSlugRedirect mocks base method.
+func (m *MockRepository) CreateSlugRedirect(ctx context.Context, oldSlug string, brandID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSlugRedirect", ctx, oldSlug, brandID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSlugRedirect indicates an expected call of CreateSlugRedirect.
+func (mr *MockRepositoryMockRecorder) CreateSlugRedirect(ctx, oldSlug, brandID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSlugRedirect", reflect.TypeOf((*MockRepository)(nil).CreateSlugRedirect), ctx, oldSlug, brandID)
+}
+
 // Delete mocks base method.
 func (m *MockRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -66,6 +80,20 @@ func (mr *MockRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), ctx, id)
 }
 
+// DeleteSlugRedirect mocks base method.
+func (m *MockRepository) DeleteSlugRedirect(ctx context.Context, slug string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSlugRedirect", ctx, slug)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSlugRedirect indicates an expected call of DeleteSlugRedirect.
+func (mr *MockRepositoryMockRecorder) DeleteSlugRedirect(ctx, slug interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSlugRedirect", reflect.TypeOf((*MockRepository)(nil).DeleteSlugRedirect), ctx, slug)
+}
+
 // GetByID mocks base method.
 func (m *MockRepository) GetByID(ctx context.Context, id uuid.UUID) (dbgen.Brand, error) {
 	m.ctrl.T.Helper()
@@ -81,6 +109,36 @@ func (mr *MockRepositoryMockRecorder) GetByID(ctx, id interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockRepository)(nil).GetByID), ctx, id)
 }
 
+// GetBySlug mocks base method.
+func (m *MockRepository) GetBySlug(ctx context.Context, slug string) (dbgen.Brand, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBySlug", ctx, slug)
+	ret0, _ := ret[0].(dbgen.Brand)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBySlug indicates an expected call of GetBySlug.
+func (mr *MockRepositoryMockRecorder) GetBySlug(ctx, slug interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBySlug", reflect.TypeOf((*MockRepository)(nil).GetBySlug), ctx, slug)
+}
+
+// GetSlugRedirect mocks base method.
+func (m *MockRepository) GetSlugRedirect(ctx context.Context, oldSlug string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSlugRedirect", ctx, oldSlug)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSlugRedirect indicates an expected call of GetSlugRedirect.
+func (mr *MockRepositoryMockRecorder) GetSlugRedirect(ctx, oldSlug interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSlugRedirect", reflect.TypeOf((*MockRepository)(nil).GetSlugRedirect), ctx, oldSlug)
+}
+
 // ListAdmin mocks base method.
 func (m *MockRepository) ListAdmin(ctx context.Context, arg dbgen.ListBrandsAdminParams) ([]dbgen.ListBrandsAdminRow, error) {
 	m.ctrl.T.Helper()
@@ -126,6 +184,21 @@ func (mr *MockRepositoryMockRecorder) Restore(ctx, id interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockRepository)(nil).Restore), ctx, id)
 }
 
+// SlugExists mocks base method.
+func (m *MockRepository) SlugExists(ctx context.Context, slug string, excludeID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SlugExists", ctx, slug, excludeID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SlugExists indicates an expected call of SlugExists.
+func (mr *MockRepositoryMockRecorder) SlugExists(ctx, slug, excludeID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlugExists", reflect.TypeOf((*MockRepository)(nil).SlugExists), ctx, slug, excludeID)
+}
+
 // Update mocks base method.
 func (m *MockRepository) Update(ctx context.Context, arg dbgen.UpdateBrandParams) (dbgen.Brand, error) {
 	m.ctrl.T.Helper()
@@ -6,8 +6,10 @@ package mock
 
 import (
 	context "context"
+	cart "go-sqlc-starter/internal/api/v1/cart"
 	dbgen "go-sqlc-starter/internal/dbgen"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
@@ -37,11 +39,12 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 }
 
 // AddItem mocks base method.
-func (m *MockRepository) AddItem(ctx context.Context, arg dbgen.AddCartItemParams) error {
+func (m *MockRepository) AddItem(ctx context.Context, arg dbgen.AddCartItemParams) (dbgen.CartItem, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AddItem", ctx, arg)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(dbgen.CartItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // AddItem indicates an expected call of AddItem.
@@ -50,6 +53,21 @@ func (mr *MockRepositoryMockRecorder) AddItem(ctx, arg interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddItem", reflect.TypeOf((*MockRepository)(nil).AddItem), ctx, arg)
 }
 
+// AdjustQty mocks base method.
+func (m *MockRepository) AdjustQty(ctx context.Context, arg dbgen.AdjustCartItemQtyParams) (dbgen.CartItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdjustQty", ctx, arg)
+	ret0, _ := ret[0].(dbgen.CartItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdjustQty indicates an expected call of AdjustQty.
+func (mr *MockRepositoryMockRecorder) AdjustQty(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdjustQty", reflect.TypeOf((*MockRepository)(nil).AdjustQty), ctx, arg)
+}
+
 // Count mocks base method.
 func (m *MockRepository) Count(ctx context.Context, cartID uuid.UUID) (int64, error) {
 	m.ctrl.T.Helper()
@@ -80,6 +98,21 @@ func (mr *MockRepositoryMockRecorder) CreateCart(ctx, userID interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCart", reflect.TypeOf((*MockRepository)(nil).CreateCart), ctx, userID)
 }
 
+// CreateGuestCart mocks base method.
+func (m *MockRepository) CreateGuestCart(ctx context.Context, guestID uuid.UUID, expiresAt time.Time) (dbgen.Cart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGuestCart", ctx, guestID, expiresAt)
+	ret0, _ := ret[0].(dbgen.Cart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGuestCart indicates an expected call of CreateGuestCart.
+func (mr *MockRepositoryMockRecorder) CreateGuestCart(ctx, guestID, expiresAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGuestCart", reflect.TypeOf((*MockRepository)(nil).CreateGuestCart), ctx, guestID, expiresAt)
+}
+
 // Delete mocks base method.
 func (m *MockRepository) Delete(ctx context.Context, cartID uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -94,6 +127,21 @@ func (mr *MockRepositoryMockRecorder) Delete(ctx, cartID interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), ctx, cartID)
 }
 
+// DeleteExpiredGuests mocks base method.
+func (m *MockRepository) DeleteExpiredGuests(ctx context.Context, before time.Time, limit int32) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredGuests", ctx, before, limit)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpiredGuests indicates an expected call of DeleteExpiredGuests.
+func (mr *MockRepositoryMockRecorder) DeleteExpiredGuests(ctx, before, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredGuests", reflect.TypeOf((*MockRepository)(nil).DeleteExpiredGuests), ctx, before, limit)
+}
+
 // DeleteItem mocks base method.
 func (m *MockRepository) DeleteItem(ctx context.Context, cartID, productID uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -108,6 +156,21 @@ func (mr *MockRepositoryMockRecorder) DeleteItem(ctx, cartID, productID interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteItem", reflect.TypeOf((*MockRepository)(nil).DeleteItem), ctx, cartID, productID)
 }
 
+// GetByGuestID mocks base method.
+func (m *MockRepository) GetByGuestID(ctx context.Context, guestID uuid.UUID) (dbgen.Cart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByGuestID", ctx, guestID)
+	ret0, _ := ret[0].(dbgen.Cart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByGuestID indicates an expected call of GetByGuestID.
+func (mr *MockRepositoryMockRecorder) GetByGuestID(ctx, guestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByGuestID", reflect.TypeOf((*MockRepository)(nil).GetByGuestID), ctx, guestID)
+}
+
 // GetByUserID mocks base method.
 func (m *MockRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (dbgen.Cart, error) {
 	m.ctrl.T.Helper()
@@ -124,18 +187,63 @@ func (mr *MockRepositoryMockRecorder) GetByUserID(ctx, userID interface{}) *gomo
 }
 
 // GetDetail mocks base method.
-func (m *MockRepository) GetDetail(ctx context.Context, userID uuid.UUID) ([]dbgen.GetCartDetailRow, error) {
+func (m *MockRepository) GetDetail(ctx context.Context, cartID uuid.UUID) ([]dbgen.GetCartDetailRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDetail", ctx, userID)
+	ret := m.ctrl.Call(m, "GetDetail", ctx, cartID)
 	ret0, _ := ret[0].([]dbgen.GetCartDetailRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetDetail indicates an expected call of GetDetail.
-func (mr *MockRepositoryMockRecorder) GetDetail(ctx, userID interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) GetDetail(ctx, cartID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDetail", reflect.TypeOf((*MockRepository)(nil).GetDetail), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDetail", reflect.TypeOf((*MockRepository)(nil).GetDetail), ctx, cartID)
+}
+
+// GetItem mocks base method.
+func (m *MockRepository) GetItem(ctx context.Context, cartID, productID uuid.UUID) (dbgen.CartItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItem", ctx, cartID, productID)
+	ret0, _ := ret[0].(dbgen.CartItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItem indicates an expected call of GetItem.
+func (mr *MockRepositoryMockRecorder) GetItem(ctx, cartID, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItem", reflect.TypeOf((*MockRepository)(nil).GetItem), ctx, cartID, productID)
+}
+
+// LockGuestCart mocks base method.
+func (m *MockRepository) LockGuestCart(ctx context.Context, guestID uuid.UUID) (dbgen.Cart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockGuestCart", ctx, guestID)
+	ret0, _ := ret[0].(dbgen.Cart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockGuestCart indicates an expected call of LockGuestCart.
+func (mr *MockRepositoryMockRecorder) LockGuestCart(ctx, guestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockGuestCart", reflect.TypeOf((*MockRepository)(nil).LockGuestCart), ctx, guestID)
+}
+
+// MergeItems mocks base method.
+func (m *MockRepository) MergeItems(ctx context.Context, targetCartID, sourceCartID uuid.UUID, maxQty int32) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeItems", ctx, targetCartID, sourceCartID, maxQty)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeItems indicates an expected call of MergeItems.
+func (mr *MockRepositoryMockRecorder) MergeItems(ctx, targetCartID, sourceCartID, maxQty interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeItems", reflect.TypeOf((*MockRepository)(nil).MergeItems), ctx, targetCartID, sourceCartID, maxQty)
 }
 
 // UpdateQty mocks base method.
@@ -152,3 +260,17 @@ func (mr *MockRepositoryMockRecorder) UpdateQty(ctx, arg interface{}) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateQty", reflect.TypeOf((*MockRepository)(nil).UpdateQty), ctx, arg)
 }
+
+// WithTx mocks base method.
+func (m *MockRepository) WithTx(tx dbgen.DBTX) cart.Repository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", tx)
+	ret0, _ := ret[0].(cart.Repository)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockRepositoryMockRecorder) WithTx(tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockRepository)(nil).WithTx), tx)
+}
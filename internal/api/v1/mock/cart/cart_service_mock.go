@@ -8,6 +8,7 @@ import (
 	context "context"
 	cart "go-sqlc-starter/internal/api/v1/cart"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -36,129 +37,172 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 }
 
 // AddItem mocks base method.
-func (m *MockService) AddItem(ctx context.Context, userID string, req cart.AddItemRequest) error {
+func (m *MockService) AddItem(ctx context.Context, owner cart.Owner, req cart.AddItemRequest) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddItem", ctx, userID, req)
+	ret := m.ctrl.Call(m, "AddItem", ctx, owner, req)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // AddItem indicates an expected call of AddItem.
-func (mr *MockServiceMockRecorder) AddItem(ctx, userID, req interface{}) *gomock.Call {
+func (mr *MockServiceMockRecorder) AddItem(ctx, owner, req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddItem", reflect.TypeOf((*MockService)(nil).AddItem), ctx, userID, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddItem", reflect.TypeOf((*MockService)(nil).AddItem), ctx, owner, req)
 }
 
 // Count mocks base method.
-func (m *MockService) Count(ctx context.Context, userID string) (int64, error) {
+func (m *MockService) Count(ctx context.Context, owner cart.Owner) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Count", ctx, userID)
+	ret := m.ctrl.Call(m, "Count", ctx, owner)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Count indicates an expected call of Count.
-func (mr *MockServiceMockRecorder) Count(ctx, userID interface{}) *gomock.Call {
+func (mr *MockServiceMockRecorder) Count(ctx, owner interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockService)(nil).Count), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockService)(nil).Count), ctx, owner)
 }
 
 // Create mocks base method.
-func (m *MockService) Create(ctx context.Context, userID string) error {
+func (m *MockService) Create(ctx context.Context, owner cart.Owner) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, userID)
+	ret := m.ctrl.Call(m, "Create", ctx, owner)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockServiceMockRecorder) Create(ctx, userID interface{}) *gomock.Call {
+func (mr *MockServiceMockRecorder) Create(ctx, owner interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockService)(nil).Create), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockService)(nil).Create), ctx, owner)
 }
 
 // Decrement mocks base method.
-func (m *MockService) Decrement(ctx context.Context, userID, productID string) error {
+func (m *MockService) Decrement(ctx context.Context, owner cart.Owner, productID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Decrement", ctx, userID, productID)
+	ret := m.ctrl.Call(m, "Decrement", ctx, owner, productID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Decrement indicates an expected call of Decrement.
-func (mr *MockServiceMockRecorder) Decrement(ctx, userID, productID interface{}) *gomock.Call {
+func (mr *MockServiceMockRecorder) Decrement(ctx, owner, productID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrement", reflect.TypeOf((*MockService)(nil).Decrement), ctx, userID, productID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrement", reflect.TypeOf((*MockService)(nil).Decrement), ctx, owner, productID)
 }
 
 // Delete mocks base method.
-func (m *MockService) Delete(ctx context.Context, userID string) error {
+func (m *MockService) Delete(ctx context.Context, owner cart.Owner) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, userID)
+	ret := m.ctrl.Call(m, "Delete", ctx, owner)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockServiceMockRecorder) Delete(ctx, userID interface{}) *gomock.Call {
+func (mr *MockServiceMockRecorder) Delete(ctx, owner interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockService)(nil).Delete), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockService)(nil).Delete), ctx, owner)
 }
 
 // DeleteItem mocks base method.
-func (m *MockService) DeleteItem(ctx context.Context, userID, productID string) error {
+func (m *MockService) DeleteItem(ctx context.Context, owner cart.Owner, productID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteItem", ctx, userID, productID)
+	ret := m.ctrl.Call(m, "DeleteItem", ctx, owner, productID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteItem indicates an expected call of DeleteItem.
-func (mr *MockServiceMockRecorder) DeleteItem(ctx, userID, productID interface{}) *gomock.Call {
+func (mr *MockServiceMockRecorder) DeleteItem(ctx, owner, productID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteItem", reflect.TypeOf((*MockService)(nil).DeleteItem), ctx, userID, productID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteItem", reflect.TypeOf((*MockService)(nil).DeleteItem), ctx, owner, productID)
 }
 
 // Detail mocks base method.
-func (m *MockService) Detail(ctx context.Context, userID string) (cart.CartDetailResponse, error) {
+func (m *MockService) Detail(ctx context.Context, owner cart.Owner) (cart.CartDetailResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Detail", ctx, userID)
+	ret := m.ctrl.Call(m, "Detail", ctx, owner)
 	ret0, _ := ret[0].(cart.CartDetailResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Detail indicates an expected call of Detail.
-func (mr *MockServiceMockRecorder) Detail(ctx, userID interface{}) *gomock.Call {
+func (mr *MockServiceMockRecorder) Detail(ctx, owner interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Detail", reflect.TypeOf((*MockService)(nil).Detail), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Detail", reflect.TypeOf((*MockService)(nil).Detail), ctx, owner)
 }
 
 // Increment mocks base method.
-func (m *MockService) Increment(ctx context.Context, userID, productID string) error {
+func (m *MockService) Increment(ctx context.Context, owner cart.Owner, productID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Increment", ctx, userID, productID)
+	ret := m.ctrl.Call(m, "Increment", ctx, owner, productID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Increment indicates an expected call of Increment.
-func (mr *MockServiceMockRecorder) Increment(ctx, userID, productID interface{}) *gomock.Call {
+func (mr *MockServiceMockRecorder) Increment(ctx, owner, productID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Increment", reflect.TypeOf((*MockService)(nil).Increment), ctx, userID, productID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Increment", reflect.TypeOf((*MockService)(nil).Increment), ctx, owner, productID)
+}
+
+// MergeGuest mocks base method.
+func (m *MockService) MergeGuest(ctx context.Context, userID, guestToken string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeGuest", ctx, userID, guestToken)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MergeGuest indicates an expected call of MergeGuest.
+func (mr *MockServiceMockRecorder) MergeGuest(ctx, userID, guestToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeGuest", reflect.TypeOf((*MockService)(nil).MergeGuest), ctx, userID, guestToken)
+}
+
+// NewGuest mocks base method.
+func (m *MockService) NewGuest() cart.GuestSession {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGuest")
+	ret0, _ := ret[0].(cart.GuestSession)
+	return ret0
+}
+
+// NewGuest indicates an expected call of NewGuest.
+func (mr *MockServiceMockRecorder) NewGuest() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGuest", reflect.TypeOf((*MockService)(nil).NewGuest))
+}
+
+// PurgeExpiredGuests mocks base method.
+func (m *MockService) PurgeExpiredGuests(ctx context.Context, before time.Time, limit int32) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeExpiredGuests", ctx, before, limit)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeExpiredGuests indicates an expected call of PurgeExpiredGuests.
+func (mr *MockServiceMockRecorder) PurgeExpiredGuests(ctx, before, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeExpiredGuests", reflect.TypeOf((*MockService)(nil).PurgeExpiredGuests), ctx, before, limit)
 }
 
 // UpdateQty mocks base method.
-func (m *MockService) UpdateQty(ctx context.Context, userID, productID string, req cart.UpdateQtyRequest) error {
+func (m *MockService) UpdateQty(ctx context.Context, owner cart.Owner, productID string, req cart.UpdateQtyRequest) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateQty", ctx, userID, productID, req)
+	ret := m.ctrl.Call(m, "UpdateQty", ctx, owner, productID, req)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateQty indicates an expected call of UpdateQty.
-func (mr *MockServiceMockRecorder) UpdateQty(ctx, userID, productID, req interface{}) *gomock.Call {
+func (mr *MockServiceMockRecorder) UpdateQty(ctx, owner, productID, req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateQty", reflect.TypeOf((*MockService)(nil).UpdateQty), ctx, userID, productID, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateQty", reflect.TypeOf((*MockService)(nil).UpdateQty), ctx, owner, productID, req)
 }
@@ -52,6 +52,20 @@ func (mr *MockRepositoryMockRecorder) Create(ctx, arg interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), ctx, arg)
 }
 
+// CreateSlugRedirect mocks base method.
+func (m *MockRepository) CreateSlugRedirect(ctx context.Context, oldSlug string, categoryID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSlugRedirect", ctx, oldSlug, categoryID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSlugRedirect indicates an expected call of CreateSlugRedirect.
+func (mr *MockRepositoryMockRecorder) CreateSlugRedirect(ctx, oldSlug, categoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSlugRedirect", reflect.TypeOf((*MockRepository)(nil).CreateSlugRedirect), ctx, oldSlug, categoryID)
+}
+
 // Delete mocks base method.
 func (m *MockRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -66,6 +80,35 @@ func (mr *MockRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), ctx, id)
 }
 
+// DeleteSlugRedirect mocks base method.
+func (m *MockRepository) DeleteSlugRedirect(ctx context.Context, slug string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSlugRedirect", ctx, slug)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSlugRedirect indicates an expected call of DeleteSlugRedirect.
+func (mr *MockRepositoryMockRecorder) DeleteSlugRedirect(ctx, slug interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSlugRedirect", reflect.TypeOf((*MockRepository)(nil).DeleteSlugRedirect), ctx, slug)
+}
+
+// GetBreadcrumbs mocks base method.
+func (m *MockRepository) GetBreadcrumbs(ctx context.Context, id uuid.UUID) ([]dbgen.GetCategoryBreadcrumbsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBreadcrumbs", ctx, id)
+	ret0, _ := ret[0].([]dbgen.GetCategoryBreadcrumbsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBreadcrumbs indicates an expected call of GetBreadcrumbs.
+func (mr *MockRepositoryMockRecorder) GetBreadcrumbs(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBreadcrumbs", reflect.TypeOf((*MockRepository)(nil).GetBreadcrumbs), ctx, id)
+}
+
 // GetByID mocks base method.
 func (m *MockRepository) GetByID(ctx context.Context, id uuid.UUID) (dbgen.Category, error) {
 	m.ctrl.T.Helper()
@@ -81,6 +124,51 @@ func (mr *MockRepositoryMockRecorder) GetByID(ctx, id interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockRepository)(nil).GetByID), ctx, id)
 }
 
+// GetBySlug mocks base method.
+func (m *MockRepository) GetBySlug(ctx context.Context, slug string) (dbgen.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBySlug", ctx, slug)
+	ret0, _ := ret[0].(dbgen.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBySlug indicates an expected call of GetBySlug.
+func (mr *MockRepositoryMockRecorder) GetBySlug(ctx, slug interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBySlug", reflect.TypeOf((*MockRepository)(nil).GetBySlug), ctx, slug)
+}
+
+// GetSlugRedirect mocks base method.
+func (m *MockRepository) GetSlugRedirect(ctx context.Context, oldSlug string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSlugRedirect", ctx, oldSlug)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSlugRedirect indicates an expected call of GetSlugRedirect.
+func (mr *MockRepositoryMockRecorder) GetSlugRedirect(ctx, oldSlug interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSlugRedirect", reflect.TypeOf((*MockRepository)(nil).GetSlugRedirect), ctx, oldSlug)
+}
+
+// IsDescendant mocks base method.
+func (m *MockRepository) IsDescendant(ctx context.Context, categoryID, candidateID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsDescendant", ctx, categoryID, candidateID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsDescendant indicates an expected call of IsDescendant.
+func (mr *MockRepositoryMockRecorder) IsDescendant(ctx, categoryID, candidateID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsDescendant", reflect.TypeOf((*MockRepository)(nil).IsDescendant), ctx, categoryID, candidateID)
+}
+
 // ListAdmin mocks base method.
 func (m *MockRepository) ListAdmin(ctx context.Context, arg dbgen.ListCategoriesAdminParams) ([]dbgen.ListCategoriesAdminRow, error) {
 	m.ctrl.T.Helper()
@@ -111,6 +199,21 @@ func (mr *MockRepositoryMockRecorder) ListPublic(ctx, limit, offset interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPublic", reflect.TypeOf((*MockRepository)(nil).ListPublic), ctx, limit, offset)
 }
 
+// ListTree mocks base method.
+func (m *MockRepository) ListTree(ctx context.Context) ([]dbgen.ListCategoryTreeRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTree", ctx)
+	ret0, _ := ret[0].([]dbgen.ListCategoryTreeRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTree indicates an expected call of ListTree.
+func (mr *MockRepositoryMockRecorder) ListTree(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTree", reflect.TypeOf((*MockRepository)(nil).ListTree), ctx)
+}
+
 // Restore mocks base method.
 func (m *MockRepository) Restore(ctx context.Context, id uuid.UUID) (dbgen.Category, error) {
 	m.ctrl.T.Helper()
@@ -126,6 +229,21 @@ func (mr *MockRepositoryMockRecorder) Restore(ctx, id interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockRepository)(nil).Restore), ctx, id)
 }
 
+// SlugExists mocks base method.
+func (m *MockRepository) SlugExists(ctx context.Context, slug string, excludeID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SlugExists", ctx, slug, excludeID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SlugExists indicates an expected call of SlugExists.
+func (mr *MockRepositoryMockRecorder) SlugExists(ctx, slug, excludeID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlugExists", reflect.TypeOf((*MockRepository)(nil).SlugExists), ctx, slug, excludeID)
+}
+
 // Update mocks base method.
 func (m *MockRepository) Update(ctx context.Context, arg dbgen.UpdateCategoryParams) (dbgen.Category, error) {
 	m.ctrl.T.Helper()
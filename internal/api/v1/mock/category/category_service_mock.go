@@ -132,6 +132,36 @@ func (mr *MockServiceMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockService)(nil).GetByID), ctx, id)
 }
 
+// GetBySlug mocks base method.
+func (m *MockService) GetBySlug(ctx context.Context, slug string) (category.CategoryPublicResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBySlug", ctx, slug)
+	ret0, _ := ret[0].(category.CategoryPublicResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBySlug indicates an expected call of GetBySlug.
+func (mr *MockServiceMockRecorder) GetBySlug(ctx, slug interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBySlug", reflect.TypeOf((*MockService)(nil).GetBySlug), ctx, slug)
+}
+
+// GetTree mocks base method.
+func (m *MockService) GetTree(ctx context.Context) ([]category.CategoryTreeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTree", ctx)
+	ret0, _ := ret[0].([]category.CategoryTreeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTree indicates an expected call of GetTree.
+func (mr *MockServiceMockRecorder) GetTree(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTree", reflect.TypeOf((*MockService)(nil).GetTree), ctx)
+}
+
 // ListAdmin mocks base method.
 func (m *MockService) ListAdmin(ctx context.Context, req category.ListCategoryRequest) ([]category.CategoryAdminResponse, int64, error) {
 	m.ctrl.T.Helper()
@@ -164,6 +194,21 @@ func (mr *MockServiceMockRecorder) ListPublic(ctx, page, limit interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPublic", reflect.TypeOf((*MockService)(nil).ListPublic), ctx, page, limit)
 }
 
+// ResolveSlug mocks base method.
+func (m *MockService) ResolveSlug(ctx context.Context, oldSlug string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveSlug", ctx, oldSlug)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveSlug indicates an expected call of ResolveSlug.
+func (mr *MockServiceMockRecorder) ResolveSlug(ctx, oldSlug interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveSlug", reflect.TypeOf((*MockService)(nil).ResolveSlug), ctx, oldSlug)
+}
+
 // Restore mocks base method.
 func (m *MockService) Restore(ctx context.Context, id string) (category.CategoryAdminResponse, error) {
 	m.ctrl.T.Helper()
@@ -9,6 +9,7 @@ import (
 	order "go-sqlc-starter/internal/api/v1/order"
 	dbgen "go-sqlc-starter/internal/dbgen"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
@@ -37,6 +38,51 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
+// Cancel mocks base method.
+func (m *MockRepository) Cancel(ctx context.Context, id uuid.UUID) (dbgen.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", ctx, id)
+	ret0, _ := ret[0].(dbgen.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockRepositoryMockRecorder) Cancel(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockRepository)(nil).Cancel), ctx, id)
+}
+
+// Complete mocks base method.
+func (m *MockRepository) Complete(ctx context.Context, id uuid.UUID) (dbgen.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", ctx, id)
+	ret0, _ := ret[0].(dbgen.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockRepositoryMockRecorder) Complete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockRepository)(nil).Complete), ctx, id)
+}
+
+// CompleteDelivered mocks base method.
+func (m *MockRepository) CompleteDelivered(ctx context.Context, cutoff time.Time, limit int32) ([]dbgen.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteDelivered", ctx, cutoff, limit)
+	ret0, _ := ret[0].([]dbgen.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteDelivered indicates an expected call of CompleteDelivered.
+func (mr *MockRepositoryMockRecorder) CompleteDelivered(ctx, cutoff, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteDelivered", reflect.TypeOf((*MockRepository)(nil).CompleteDelivered), ctx, cutoff, limit)
+}
+
 // CreateOrder mocks base method.
 func (m *MockRepository) CreateOrder(ctx context.Context, arg dbgen.CreateOrderParams) (dbgen.Order, error) {
 	m.ctrl.T.Helper()
@@ -66,6 +112,21 @@ func (mr *MockRepositoryMockRecorder) CreateOrderItem(ctx, arg interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrderItem", reflect.TypeOf((*MockRepository)(nil).CreateOrderItem), ctx, arg)
 }
 
+// Expire mocks base method.
+func (m *MockRepository) Expire(ctx context.Context, id uuid.UUID, reason string) (dbgen.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Expire", ctx, id, reason)
+	ret0, _ := ret[0].(dbgen.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Expire indicates an expected call of Expire.
+func (mr *MockRepositoryMockRecorder) Expire(ctx, id, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Expire", reflect.TypeOf((*MockRepository)(nil).Expire), ctx, id, reason)
+}
+
 // GetByID mocks base method.
 func (m *MockRepository) GetByID(ctx context.Context, id uuid.UUID) (dbgen.Order, error) {
 	m.ctrl.T.Helper()
@@ -126,6 +187,66 @@ func (mr *MockRepositoryMockRecorder) ListAdmin(ctx, arg interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAdmin", reflect.TypeOf((*MockRepository)(nil).ListAdmin), ctx, arg)
 }
 
+// ListAdminKeyset mocks base method.
+func (m *MockRepository) ListAdminKeyset(ctx context.Context, arg dbgen.ListOrdersAdminKeysetParams) ([]dbgen.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAdminKeyset", ctx, arg)
+	ret0, _ := ret[0].([]dbgen.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAdminKeyset indicates an expected call of ListAdminKeyset.
+func (mr *MockRepositoryMockRecorder) ListAdminKeyset(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAdminKeyset", reflect.TypeOf((*MockRepository)(nil).ListAdminKeyset), ctx, arg)
+}
+
+// ListExpiredPendingIDs mocks base method.
+func (m *MockRepository) ListExpiredPendingIDs(ctx context.Context, cutoff time.Time, limit int32) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExpiredPendingIDs", ctx, cutoff, limit)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExpiredPendingIDs indicates an expected call of ListExpiredPendingIDs.
+func (mr *MockRepositoryMockRecorder) ListExpiredPendingIDs(ctx, cutoff, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExpiredPendingIDs", reflect.TypeOf((*MockRepository)(nil).ListExpiredPendingIDs), ctx, cutoff, limit)
+}
+
+// ListKeyset mocks base method.
+func (m *MockRepository) ListKeyset(ctx context.Context, arg dbgen.ListOrdersKeysetParams) ([]dbgen.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListKeyset", ctx, arg)
+	ret0, _ := ret[0].([]dbgen.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListKeyset indicates an expected call of ListKeyset.
+func (mr *MockRepositoryMockRecorder) ListKeyset(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKeyset", reflect.TypeOf((*MockRepository)(nil).ListKeyset), ctx, arg)
+}
+
+// SetReceiptNo mocks base method.
+func (m *MockRepository) SetReceiptNo(ctx context.Context, id uuid.UUID, receiptNo string) (dbgen.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReceiptNo", ctx, id, receiptNo)
+	ret0, _ := ret[0].(dbgen.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetReceiptNo indicates an expected call of SetReceiptNo.
+func (mr *MockRepositoryMockRecorder) SetReceiptNo(ctx, id, receiptNo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReceiptNo", reflect.TypeOf((*MockRepository)(nil).SetReceiptNo), ctx, id, receiptNo)
+}
+
 // UpdateStatus mocks base method.
 func (m *MockRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status string) (dbgen.Order, error) {
 	m.ctrl.T.Helper()
@@ -7,9 +7,12 @@ package mock
 import (
 	context "context"
 	order "go-sqlc-starter/internal/api/v1/order"
+	cursor "go-sqlc-starter/internal/pkg/cursor"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
 )
 
 // MockService is a mock of Service interface.
@@ -35,18 +38,47 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// AutoComplete mocks base method.
+func (m *MockService) AutoComplete(ctx context.Context, cutoff time.Time, limit int32) ([]order.OrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AutoComplete", ctx, cutoff, limit)
+	ret0, _ := ret[0].([]order.OrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AutoComplete indicates an expected call of AutoComplete.
+func (mr *MockServiceMockRecorder) AutoComplete(ctx, cutoff, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutoComplete", reflect.TypeOf((*MockService)(nil).AutoComplete), ctx, cutoff, limit)
+}
+
 // Cancel mocks base method.
-func (m *MockService) Cancel(ctx context.Context, orderID string) error {
+func (m *MockService) Cancel(ctx context.Context, userID, orderID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Cancel", ctx, orderID)
+	ret := m.ctrl.Call(m, "Cancel", ctx, userID, orderID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Cancel indicates an expected call of Cancel.
-func (mr *MockServiceMockRecorder) Cancel(ctx, orderID interface{}) *gomock.Call {
+func (mr *MockServiceMockRecorder) Cancel(ctx, userID, orderID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockService)(nil).Cancel), ctx, orderID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockService)(nil).Cancel), ctx, userID, orderID)
+}
+
+// CancelAdmin mocks base method.
+func (m *MockService) CancelAdmin(ctx context.Context, adminID, orderID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelAdmin", ctx, adminID, orderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelAdmin indicates an expected call of CancelAdmin.
+func (mr *MockServiceMockRecorder) CancelAdmin(ctx, adminID, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelAdmin", reflect.TypeOf((*MockService)(nil).CancelAdmin), ctx, adminID, orderID)
 }
 
 // Checkout mocks base method.
@@ -65,24 +97,54 @@ func (mr *MockServiceMockRecorder) Checkout(ctx, req interface{}) *gomock.Call {
 }
 
 // Detail mocks base method.
-func (m *MockService) Detail(ctx context.Context, orderID string) (order.OrderResponse, error) {
+func (m *MockService) Detail(ctx context.Context, userID, orderID string) (order.OrderResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Detail", ctx, orderID)
+	ret := m.ctrl.Call(m, "Detail", ctx, userID, orderID)
 	ret0, _ := ret[0].(order.OrderResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Detail indicates an expected call of Detail.
-func (mr *MockServiceMockRecorder) Detail(ctx, orderID interface{}) *gomock.Call {
+func (mr *MockServiceMockRecorder) Detail(ctx, userID, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Detail", reflect.TypeOf((*MockService)(nil).Detail), ctx, userID, orderID)
+}
+
+// DetailAdmin mocks base method.
+func (m *MockService) DetailAdmin(ctx context.Context, orderID string) (order.OrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetailAdmin", ctx, orderID)
+	ret0, _ := ret[0].(order.OrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetailAdmin indicates an expected call of DetailAdmin.
+func (mr *MockServiceMockRecorder) DetailAdmin(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetailAdmin", reflect.TypeOf((*MockService)(nil).DetailAdmin), ctx, orderID)
+}
+
+// ExpireUnpaid mocks base method.
+func (m *MockService) ExpireUnpaid(ctx context.Context, cutoff time.Time, limit int32) ([]order.OrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireUnpaid", ctx, cutoff, limit)
+	ret0, _ := ret[0].([]order.OrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireUnpaid indicates an expected call of ExpireUnpaid.
+func (mr *MockServiceMockRecorder) ExpireUnpaid(ctx, cutoff, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Detail", reflect.TypeOf((*MockService)(nil).Detail), ctx, orderID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireUnpaid", reflect.TypeOf((*MockService)(nil).ExpireUnpaid), ctx, cutoff, limit)
 }
 
 // List mocks base method.
-func (m *MockService) List(ctx context.Context, userID string, page, limit int) ([]order.OrderResponse, int64, error) {
+func (m *MockService) List(ctx context.Context, userID, status string, page, limit int) ([]order.OrderResponse, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", ctx, userID, page, limit)
+	ret := m.ctrl.Call(m, "List", ctx, userID, status, page, limit)
 	ret0, _ := ret[0].([]order.OrderResponse)
 	ret1, _ := ret[1].(int64)
 	ret2, _ := ret[2].(error)
@@ -90,9 +152,9 @@ func (m *MockService) List(ctx context.Context, userID string, page, limit int)
 }
 
 // List indicates an expected call of List.
-func (mr *MockServiceMockRecorder) List(ctx, userID, page, limit interface{}) *gomock.Call {
+func (mr *MockServiceMockRecorder) List(ctx, userID, status, page, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockService)(nil).List), ctx, userID, page, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockService)(nil).List), ctx, userID, status, page, limit)
 }
 
 // ListAdmin mocks base method.
@@ -111,17 +173,64 @@ func (mr *MockServiceMockRecorder) ListAdmin(ctx, status, search, page, limit in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAdmin", reflect.TypeOf((*MockService)(nil).ListAdmin), ctx, status, search, page, limit)
 }
 
-// UpdateStatus mocks base method.
-func (m *MockService) UpdateStatus(ctx context.Context, orderID, status string) (order.OrderResponse, error) {
+// ListAdminByCursor mocks base method.
+func (m *MockService) ListAdminByCursor(ctx context.Context, status, search, token string, limit int) ([]order.OrderResponse, cursor.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAdminByCursor", ctx, status, search, token, limit)
+	ret0, _ := ret[0].([]order.OrderResponse)
+	ret1, _ := ret[1].(cursor.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListAdminByCursor indicates an expected call of ListAdminByCursor.
+func (mr *MockServiceMockRecorder) ListAdminByCursor(ctx, status, search, token, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAdminByCursor", reflect.TypeOf((*MockService)(nil).ListAdminByCursor), ctx, status, search, token, limit)
+}
+
+// ListByCursor mocks base method.
+func (m *MockService) ListByCursor(ctx context.Context, userID, status, token string, limit int) ([]order.OrderResponse, cursor.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByCursor", ctx, userID, status, token, limit)
+	ret0, _ := ret[0].([]order.OrderResponse)
+	ret1, _ := ret[1].(cursor.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListByCursor indicates an expected call of ListByCursor.
+func (mr *MockServiceMockRecorder) ListByCursor(ctx, userID, status, token, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByCursor", reflect.TypeOf((*MockService)(nil).ListByCursor), ctx, userID, status, token, limit)
+}
+
+// UpdateStatusByAdmin mocks base method.
+func (m *MockService) UpdateStatusByAdmin(ctx context.Context, orderID, nextStatus string, receiptNo *string) (order.OrderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatusByAdmin", ctx, orderID, nextStatus, receiptNo)
+	ret0, _ := ret[0].(order.OrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStatusByAdmin indicates an expected call of UpdateStatusByAdmin.
+func (mr *MockServiceMockRecorder) UpdateStatusByAdmin(ctx, orderID, nextStatus, receiptNo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatusByAdmin", reflect.TypeOf((*MockService)(nil).UpdateStatusByAdmin), ctx, orderID, nextStatus, receiptNo)
+}
+
+// UpdateStatusByCustomer mocks base method.
+func (m *MockService) UpdateStatusByCustomer(ctx context.Context, orderID string, userID uuid.UUID, nextStatus string) (order.OrderResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateStatus", ctx, orderID, status)
+	ret := m.ctrl.Call(m, "UpdateStatusByCustomer", ctx, orderID, userID, nextStatus)
 	ret0, _ := ret[0].(order.OrderResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateStatus indicates an expected call of UpdateStatus.
-func (mr *MockServiceMockRecorder) UpdateStatus(ctx, orderID, status interface{}) *gomock.Call {
+// UpdateStatusByCustomer indicates an expected call of UpdateStatusByCustomer.
+func (mr *MockServiceMockRecorder) UpdateStatusByCustomer(ctx, orderID, userID, nextStatus interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockService)(nil).UpdateStatus), ctx, orderID, status)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatusByCustomer", reflect.TypeOf((*MockService)(nil).UpdateStatusByCustomer), ctx, orderID, userID, nextStatus)
 }
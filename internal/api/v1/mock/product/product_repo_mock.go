@@ -52,6 +52,35 @@ func (mr *MockRepositoryMockRecorder) Create(ctx, arg interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), ctx, arg)
 }
 
+// CreatePrice mocks base method.
+func (m *MockRepository) CreatePrice(ctx context.Context, arg dbgen.CreateProductPriceParams) (dbgen.ProductPrice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePrice", ctx, arg)
+	ret0, _ := ret[0].(dbgen.ProductPrice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePrice indicates an expected call of CreatePrice.
+func (mr *MockRepositoryMockRecorder) CreatePrice(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePrice", reflect.TypeOf((*MockRepository)(nil).CreatePrice), ctx, arg)
+}
+
+// CreateSlugRedirect mocks base method.
+func (m *MockRepository) CreateSlugRedirect(ctx context.Context, oldSlug string, productID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSlugRedirect", ctx, oldSlug, productID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSlugRedirect indicates an expected call of CreateSlugRedirect.
+func (mr *MockRepositoryMockRecorder) CreateSlugRedirect(ctx, oldSlug, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSlugRedirect", reflect.TypeOf((*MockRepository)(nil).CreateSlugRedirect), ctx, oldSlug, productID)
+}
+
 // Delete mocks base method.
 func (m *MockRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -66,6 +95,49 @@ func (mr *MockRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), ctx, id)
 }
 
+// DeletePrice mocks base method.
+func (m *MockRepository) DeletePrice(ctx context.Context, id, productID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePrice", ctx, id, productID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePrice indicates an expected call of DeletePrice.
+func (mr *MockRepositoryMockRecorder) DeletePrice(ctx, id, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePrice", reflect.TypeOf((*MockRepository)(nil).DeletePrice), ctx, id, productID)
+}
+
+// DeleteSlugRedirect mocks base method.
+func (m *MockRepository) DeleteSlugRedirect(ctx context.Context, slug string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSlugRedirect", ctx, slug)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSlugRedirect indicates an expected call of DeleteSlugRedirect.
+func (mr *MockRepositoryMockRecorder) DeleteSlugRedirect(ctx, slug interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSlugRedirect", reflect.TypeOf((*MockRepository)(nil).DeleteSlugRedirect), ctx, slug)
+}
+
+// GetBrandBySlug mocks base method.
+func (m *MockRepository) GetBrandBySlug(ctx context.Context, slug string) (dbgen.Brand, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBrandBySlug", ctx, slug)
+	ret0, _ := ret[0].(dbgen.Brand)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBrandBySlug indicates an expected call of GetBrandBySlug.
+func (mr *MockRepositoryMockRecorder) GetBrandBySlug(ctx, slug interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBrandBySlug", reflect.TypeOf((*MockRepository)(nil).GetBrandBySlug), ctx, slug)
+}
+
 // GetByID mocks base method.
 func (m *MockRepository) GetByID(ctx context.Context, id uuid.UUID) (dbgen.GetProductByIDRow, error) {
 	m.ctrl.T.Helper()
@@ -96,6 +168,21 @@ func (mr *MockRepositoryMockRecorder) GetBySlug(ctx, slug interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBySlug", reflect.TypeOf((*MockRepository)(nil).GetBySlug), ctx, slug)
 }
 
+// GetSlugRedirect mocks base method.
+func (m *MockRepository) GetSlugRedirect(ctx context.Context, oldSlug string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSlugRedirect", ctx, oldSlug)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSlugRedirect indicates an expected call of GetSlugRedirect.
+func (mr *MockRepositoryMockRecorder) GetSlugRedirect(ctx, oldSlug interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSlugRedirect", reflect.TypeOf((*MockRepository)(nil).GetSlugRedirect), ctx, oldSlug)
+}
+
 // ListAdmin mocks base method.
 func (m *MockRepository) ListAdmin(ctx context.Context, arg dbgen.ListProductsAdminParams) ([]dbgen.ListProductsAdminRow, error) {
 	m.ctrl.T.Helper()
@@ -111,6 +198,66 @@ func (mr *MockRepositoryMockRecorder) ListAdmin(ctx, arg interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAdmin", reflect.TypeOf((*MockRepository)(nil).ListAdmin), ctx, arg)
 }
 
+// ListAdminKeyset mocks base method.
+func (m *MockRepository) ListAdminKeyset(ctx context.Context, arg dbgen.ListProductsAdminKeysetParams) ([]dbgen.ListProductsAdminKeysetRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAdminKeyset", ctx, arg)
+	ret0, _ := ret[0].([]dbgen.ListProductsAdminKeysetRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAdminKeyset indicates an expected call of ListAdminKeyset.
+func (mr *MockRepositoryMockRecorder) ListAdminKeyset(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAdminKeyset", reflect.TypeOf((*MockRepository)(nil).ListAdminKeyset), ctx, arg)
+}
+
+// ListExistingSKUs mocks base method.
+func (m *MockRepository) ListExistingSKUs(ctx context.Context, skus []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExistingSKUs", ctx, skus)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExistingSKUs indicates an expected call of ListExistingSKUs.
+func (mr *MockRepositoryMockRecorder) ListExistingSKUs(ctx, skus interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExistingSKUs", reflect.TypeOf((*MockRepository)(nil).ListExistingSKUs), ctx, skus)
+}
+
+// ListForExport mocks base method.
+func (m *MockRepository) ListForExport(ctx context.Context, arg dbgen.ExportProductsParams) ([]dbgen.ExportProductsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListForExport", ctx, arg)
+	ret0, _ := ret[0].([]dbgen.ExportProductsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListForExport indicates an expected call of ListForExport.
+func (mr *MockRepositoryMockRecorder) ListForExport(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListForExport", reflect.TypeOf((*MockRepository)(nil).ListForExport), ctx, arg)
+}
+
+// ListPrices mocks base method.
+func (m *MockRepository) ListPrices(ctx context.Context, productID uuid.UUID) ([]dbgen.ProductPrice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPrices", ctx, productID)
+	ret0, _ := ret[0].([]dbgen.ProductPrice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPrices indicates an expected call of ListPrices.
+func (mr *MockRepositoryMockRecorder) ListPrices(ctx, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPrices", reflect.TypeOf((*MockRepository)(nil).ListPrices), ctx, productID)
+}
+
 // ListPublic mocks base method.
 func (m *MockRepository) ListPublic(ctx context.Context, arg dbgen.ListProductsPublicParams) ([]dbgen.ListProductsPublicRow, error) {
 	m.ctrl.T.Helper()
@@ -126,6 +273,36 @@ func (mr *MockRepositoryMockRecorder) ListPublic(ctx, arg interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPublic", reflect.TypeOf((*MockRepository)(nil).ListPublic), ctx, arg)
 }
 
+// ListPublicKeyset mocks base method.
+func (m *MockRepository) ListPublicKeyset(ctx context.Context, arg dbgen.ListProductsPublicKeysetParams) ([]dbgen.ListProductsPublicKeysetRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPublicKeyset", ctx, arg)
+	ret0, _ := ret[0].([]dbgen.ListProductsPublicKeysetRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPublicKeyset indicates an expected call of ListPublicKeyset.
+func (mr *MockRepositoryMockRecorder) ListPublicKeyset(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPublicKeyset", reflect.TypeOf((*MockRepository)(nil).ListPublicKeyset), ctx, arg)
+}
+
+// LockStock mocks base method.
+func (m *MockRepository) LockStock(ctx context.Context, id uuid.UUID) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockStock", ctx, id)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockStock indicates an expected call of LockStock.
+func (mr *MockRepositoryMockRecorder) LockStock(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockStock", reflect.TypeOf((*MockRepository)(nil).LockStock), ctx, id)
+}
+
 // Restore mocks base method.
 func (m *MockRepository) Restore(ctx context.Context, id uuid.UUID) (dbgen.Product, error) {
 	m.ctrl.T.Helper()
@@ -141,6 +318,36 @@ func (mr *MockRepositoryMockRecorder) Restore(ctx, id interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockRepository)(nil).Restore), ctx, id)
 }
 
+// SlugExists mocks base method.
+func (m *MockRepository) SlugExists(ctx context.Context, slug string, excludeID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SlugExists", ctx, slug, excludeID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SlugExists indicates an expected call of SlugExists.
+func (mr *MockRepositoryMockRecorder) SlugExists(ctx, slug, excludeID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlugExists", reflect.TypeOf((*MockRepository)(nil).SlugExists), ctx, slug, excludeID)
+}
+
+// Suggest mocks base method.
+func (m *MockRepository) Suggest(ctx context.Context, arg dbgen.SuggestProductsParams) ([]dbgen.SuggestProductsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suggest", ctx, arg)
+	ret0, _ := ret[0].([]dbgen.SuggestProductsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suggest indicates an expected call of Suggest.
+func (mr *MockRepositoryMockRecorder) Suggest(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suggest", reflect.TypeOf((*MockRepository)(nil).Suggest), ctx, arg)
+}
+
 // Update mocks base method.
 func (m *MockRepository) Update(ctx context.Context, arg dbgen.UpdateProductParams) (dbgen.Product, error) {
 	m.ctrl.T.Helper()
@@ -156,6 +363,21 @@ func (mr *MockRepositoryMockRecorder) Update(ctx, arg interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRepository)(nil).Update), ctx, arg)
 }
 
+// UpsertBatch mocks base method.
+func (m *MockRepository) UpsertBatch(ctx context.Context, arg dbgen.UpsertProductsBatchParams) ([]dbgen.UpsertProductsBatchRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertBatch", ctx, arg)
+	ret0, _ := ret[0].([]dbgen.UpsertProductsBatchRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertBatch indicates an expected call of UpsertBatch.
+func (mr *MockRepositoryMockRecorder) UpsertBatch(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertBatch", reflect.TypeOf((*MockRepository)(nil).UpsertBatch), ctx, arg)
+}
+
 // WithTx mocks base method.
 func (m *MockRepository) WithTx(tx dbgen.DBTX) product.Repository {
 	m.ctrl.T.Helper()
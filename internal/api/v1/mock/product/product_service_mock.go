@@ -8,6 +8,8 @@ import (
 	context "context"
 	product "go-sqlc-starter/internal/api/v1/product"
 	dbgen "go-sqlc-starter/internal/dbgen"
+	cursor "go-sqlc-starter/internal/pkg/cursor"
+	io "io"
 	multipart "mime/multipart"
 	reflect "reflect"
 
@@ -38,36 +40,6 @@ func (m *MockReviewRepository) EXPECT() *MockReviewRepositoryMockRecorder {
 	return m.recorder
 }
 
-// CountByProductID mocks base method.
-func (m *MockReviewRepository) CountByProductID(ctx context.Context, productID uuid.UUID) (int64, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CountByProductID", ctx, productID)
-	ret0, _ := ret[0].(int64)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// CountByProductID indicates an expected call of CountByProductID.
-func (mr *MockReviewRepositoryMockRecorder) CountByProductID(ctx, productID interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByProductID", reflect.TypeOf((*MockReviewRepository)(nil).CountByProductID), ctx, productID)
-}
-
-// GetAverageRating mocks base method.
-func (m *MockReviewRepository) GetAverageRating(ctx context.Context, productID uuid.UUID) (float64, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAverageRating", ctx, productID)
-	ret0, _ := ret[0].(float64)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetAverageRating indicates an expected call of GetAverageRating.
-func (mr *MockReviewRepositoryMockRecorder) GetAverageRating(ctx, productID interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAverageRating", reflect.TypeOf((*MockReviewRepository)(nil).GetAverageRating), ctx, productID)
-}
-
 // GetByProductID mocks base method.
 func (m *MockReviewRepository) GetByProductID(ctx context.Context, productID uuid.UUID, limit, offset int32) ([]dbgen.GetReviewsByProductIDRow, error) {
 	m.ctrl.T.Helper()
@@ -135,6 +107,43 @@ func (mr *MockCloudinaryServiceMockRecorder) UploadImage(ctx, file, filename, fo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadImage", reflect.TypeOf((*MockCloudinaryService)(nil).UploadImage), ctx, file, filename, folderName)
 }
 
+// MockPriceWatcher is a mock of PriceWatcher interface.
+type MockPriceWatcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockPriceWatcherMockRecorder
+}
+
+// MockPriceWatcherMockRecorder is the mock recorder for MockPriceWatcher.
+type MockPriceWatcherMockRecorder struct {
+	mock *MockPriceWatcher
+}
+
+// NewMockPriceWatcher creates a new mock instance.
+func NewMockPriceWatcher(ctrl *gomock.Controller) *MockPriceWatcher {
+	mock := &MockPriceWatcher{ctrl: ctrl}
+	mock.recorder = &MockPriceWatcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPriceWatcher) EXPECT() *MockPriceWatcherMockRecorder {
+	return m.recorder
+}
+
+// OnPriceChanged mocks base method.
+func (m *MockPriceWatcher) OnPriceChanged(ctx context.Context, tx dbgen.DBTX, productID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OnPriceChanged", ctx, tx, productID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OnPriceChanged indicates an expected call of OnPriceChanged.
+func (mr *MockPriceWatcherMockRecorder) OnPriceChanged(ctx, tx, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnPriceChanged", reflect.TypeOf((*MockPriceWatcher)(nil).OnPriceChanged), ctx, tx, productID)
+}
+
 // MockService is a mock of Service interface.
 type MockService struct {
 	ctrl     *gomock.Controller
@@ -173,6 +182,21 @@ func (mr *MockServiceMockRecorder) Create(ctx, req, file, filename interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockService)(nil).Create), ctx, req, file, filename)
 }
 
+// CreatePrice mocks base method.
+func (m *MockService) CreatePrice(ctx context.Context, productID string, req product.CreateProductPriceRequest) (product.ProductPriceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePrice", ctx, productID, req)
+	ret0, _ := ret[0].(product.ProductPriceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePrice indicates an expected call of CreatePrice.
+func (mr *MockServiceMockRecorder) CreatePrice(ctx, productID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePrice", reflect.TypeOf((*MockService)(nil).CreatePrice), ctx, productID, req)
+}
+
 // Delete mocks base method.
 func (m *MockService) Delete(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -187,6 +211,34 @@ func (mr *MockServiceMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockService)(nil).Delete), ctx, id)
 }
 
+// DeletePrice mocks base method.
+func (m *MockService) DeletePrice(ctx context.Context, productID, priceID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePrice", ctx, productID, priceID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePrice indicates an expected call of DeletePrice.
+func (mr *MockServiceMockRecorder) DeletePrice(ctx, productID, priceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePrice", reflect.TypeOf((*MockService)(nil).DeletePrice), ctx, productID, priceID)
+}
+
+// Export mocks base method.
+func (m *MockService) Export(ctx context.Context, req product.ListProductAdminRequest, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, req, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockServiceMockRecorder) Export(ctx, req, w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockService)(nil).Export), ctx, req, w)
+}
+
 // GetByID mocks base method.
 func (m *MockService) GetByID(ctx context.Context, id string) (product.ProductAdminResponse, error) {
 	m.ctrl.T.Helper()
@@ -217,6 +269,21 @@ func (mr *MockServiceMockRecorder) GetBySlug(ctx, slug interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBySlug", reflect.TypeOf((*MockService)(nil).GetBySlug), ctx, slug)
 }
 
+// Import mocks base method.
+func (m *MockService) Import(ctx context.Context, rows [][]string, req product.ImportProductsRequest) (product.ImportProductsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx, rows, req)
+	ret0, _ := ret[0].(product.ImportProductsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockServiceMockRecorder) Import(ctx, rows, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockService)(nil).Import), ctx, rows, req)
+}
+
 // ListAdmin mocks base method.
 func (m *MockService) ListAdmin(ctx context.Context, req product.ListProductAdminRequest) ([]product.ProductAdminResponse, int64, error) {
 	m.ctrl.T.Helper()
@@ -233,6 +300,37 @@ func (mr *MockServiceMockRecorder) ListAdmin(ctx, req interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAdmin", reflect.TypeOf((*MockService)(nil).ListAdmin), ctx, req)
 }
 
+// ListAdminByCursor mocks base method.
+func (m *MockService) ListAdminByCursor(ctx context.Context, req product.ListProductAdminRequest) ([]product.ProductAdminResponse, cursor.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAdminByCursor", ctx, req)
+	ret0, _ := ret[0].([]product.ProductAdminResponse)
+	ret1, _ := ret[1].(cursor.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListAdminByCursor indicates an expected call of ListAdminByCursor.
+func (mr *MockServiceMockRecorder) ListAdminByCursor(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAdminByCursor", reflect.TypeOf((*MockService)(nil).ListAdminByCursor), ctx, req)
+}
+
+// ListPrices mocks base method.
+func (m *MockService) ListPrices(ctx context.Context, productID string) ([]product.ProductPriceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPrices", ctx, productID)
+	ret0, _ := ret[0].([]product.ProductPriceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPrices indicates an expected call of ListPrices.
+func (mr *MockServiceMockRecorder) ListPrices(ctx, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPrices", reflect.TypeOf((*MockService)(nil).ListPrices), ctx, productID)
+}
+
 // ListPublic mocks base method.
 func (m *MockService) ListPublic(ctx context.Context, req product.ListPublicRequest) ([]product.ProductPublicResponse, int64, error) {
 	m.ctrl.T.Helper()
@@ -249,6 +347,37 @@ func (mr *MockServiceMockRecorder) ListPublic(ctx, req interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPublic", reflect.TypeOf((*MockService)(nil).ListPublic), ctx, req)
 }
 
+// ListPublicByCursor mocks base method.
+func (m *MockService) ListPublicByCursor(ctx context.Context, req product.ListPublicRequest) ([]product.ProductPublicResponse, cursor.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPublicByCursor", ctx, req)
+	ret0, _ := ret[0].([]product.ProductPublicResponse)
+	ret1, _ := ret[1].(cursor.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListPublicByCursor indicates an expected call of ListPublicByCursor.
+func (mr *MockServiceMockRecorder) ListPublicByCursor(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPublicByCursor", reflect.TypeOf((*MockService)(nil).ListPublicByCursor), ctx, req)
+}
+
+// ResolveSlug mocks base method.
+func (m *MockService) ResolveSlug(ctx context.Context, oldSlug string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveSlug", ctx, oldSlug)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveSlug indicates an expected call of ResolveSlug.
+func (mr *MockServiceMockRecorder) ResolveSlug(ctx, oldSlug interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveSlug", reflect.TypeOf((*MockService)(nil).ResolveSlug), ctx, oldSlug)
+}
+
 // Restore mocks base method.
 func (m *MockService) Restore(ctx context.Context, id string) (product.ProductAdminResponse, error) {
 	m.ctrl.T.Helper()
@@ -264,6 +393,21 @@ func (mr *MockServiceMockRecorder) Restore(ctx, id interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockService)(nil).Restore), ctx, id)
 }
 
+// Suggest mocks base method.
+func (m *MockService) Suggest(ctx context.Context, query string, limit int) ([]product.ProductSuggestResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suggest", ctx, query, limit)
+	ret0, _ := ret[0].([]product.ProductSuggestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suggest indicates an expected call of Suggest.
+func (mr *MockServiceMockRecorder) Suggest(ctx, query, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suggest", reflect.TypeOf((*MockService)(nil).Suggest), ctx, query, limit)
+}
+
 // Update mocks base method.
 func (m *MockService) Update(ctx context.Context, idStr string, req product.UpdateProductRequest, file multipart.File, filename string) (product.ProductAdminResponse, error) {
 	m.ctrl.T.Helper()
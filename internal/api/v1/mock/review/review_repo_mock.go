@@ -67,19 +67,34 @@ func (mr *MockRepositoryMockRecorder) CheckUserPurchased(ctx, userID, productID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckUserPurchased", reflect.TypeOf((*MockRepository)(nil).CheckUserPurchased), ctx, userID, productID)
 }
 
+// CountAdmin mocks base method.
+func (m *MockRepository) CountAdmin(ctx context.Context, arg dbgen.CountReviewsAdminParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAdmin", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAdmin indicates an expected call of CountAdmin.
+func (mr *MockRepositoryMockRecorder) CountAdmin(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAdmin", reflect.TypeOf((*MockRepository)(nil).CountAdmin), ctx, arg)
+}
+
 // CountByProductID mocks base method.
-func (m *MockRepository) CountByProductID(ctx context.Context, productID uuid.UUID) (int64, error) {
+func (m *MockRepository) CountByProductID(ctx context.Context, arg dbgen.CountReviewsByProductIDParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CountByProductID", ctx, productID)
+	ret := m.ctrl.Call(m, "CountByProductID", ctx, arg)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CountByProductID indicates an expected call of CountByProductID.
-func (mr *MockRepositoryMockRecorder) CountByProductID(ctx, productID interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) CountByProductID(ctx, arg interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByProductID", reflect.TypeOf((*MockRepository)(nil).CountByProductID), ctx, productID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByProductID", reflect.TypeOf((*MockRepository)(nil).CountByProductID), ctx, arg)
 }
 
 // CountByUserID mocks base method.
@@ -112,6 +127,50 @@ func (mr *MockRepositoryMockRecorder) Create(ctx, arg interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), ctx, arg)
 }
 
+// CreatePhoto mocks base method.
+func (m *MockRepository) CreatePhoto(ctx context.Context, arg dbgen.CreateReviewPhotoParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePhoto", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePhoto indicates an expected call of CreatePhoto.
+func (mr *MockRepositoryMockRecorder) CreatePhoto(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePhoto", reflect.TypeOf((*MockRepository)(nil).CreatePhoto), ctx, arg)
+}
+
+// CreateReply mocks base method.
+func (m *MockRepository) CreateReply(ctx context.Context, arg dbgen.CreateReviewReplyParams) (dbgen.ReviewReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReply", ctx, arg)
+	ret0, _ := ret[0].(dbgen.ReviewReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReply indicates an expected call of CreateReply.
+func (mr *MockRepositoryMockRecorder) CreateReply(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReply", reflect.TypeOf((*MockRepository)(nil).CreateReply), ctx, arg)
+}
+
+// CreateReport mocks base method.
+func (m *MockRepository) CreateReport(ctx context.Context, arg dbgen.CreateReviewReportParams) (dbgen.ReviewReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReport", ctx, arg)
+	ret0, _ := ret[0].(dbgen.ReviewReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReport indicates an expected call of CreateReport.
+func (mr *MockRepositoryMockRecorder) CreateReport(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReport", reflect.TypeOf((*MockRepository)(nil).CreateReport), ctx, arg)
+}
+
 // Delete mocks base method.
 func (m *MockRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -126,6 +185,51 @@ func (mr *MockRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), ctx, id)
 }
 
+// DeletePhotos mocks base method.
+func (m *MockRepository) DeletePhotos(ctx context.Context, reviewID uuid.UUID) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePhotos", ctx, reviewID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePhotos indicates an expected call of DeletePhotos.
+func (mr *MockRepositoryMockRecorder) DeletePhotos(ctx, reviewID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePhotos", reflect.TypeOf((*MockRepository)(nil).DeletePhotos), ctx, reviewID)
+}
+
+// DeleteReply mocks base method.
+func (m *MockRepository) DeleteReply(ctx context.Context, reviewID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReply", ctx, reviewID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteReply indicates an expected call of DeleteReply.
+func (mr *MockRepositoryMockRecorder) DeleteReply(ctx, reviewID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReply", reflect.TypeOf((*MockRepository)(nil).DeleteReply), ctx, reviewID)
+}
+
+// DeleteVote mocks base method.
+func (m *MockRepository) DeleteVote(ctx context.Context, reviewID, userID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVote", ctx, reviewID, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVote indicates an expected call of DeleteVote.
+func (mr *MockRepositoryMockRecorder) DeleteVote(ctx, reviewID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVote", reflect.TypeOf((*MockRepository)(nil).DeleteVote), ctx, reviewID, userID)
+}
+
 // GetAverageRating mocks base method.
 func (m *MockRepository) GetAverageRating(ctx context.Context, productID uuid.UUID) (float64, error) {
 	m.ctrl.T.Helper()
@@ -171,6 +275,21 @@ func (mr *MockRepositoryMockRecorder) GetByProductID(ctx, productID, limit, offs
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByProductID", reflect.TypeOf((*MockRepository)(nil).GetByProductID), ctx, productID, limit, offset)
 }
 
+// GetByProductIDKeyset mocks base method.
+func (m *MockRepository) GetByProductIDKeyset(ctx context.Context, arg dbgen.GetReviewsByProductIDKeysetParams) ([]dbgen.GetReviewsByProductIDKeysetRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByProductIDKeyset", ctx, arg)
+	ret0, _ := ret[0].([]dbgen.GetReviewsByProductIDKeysetRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByProductIDKeyset indicates an expected call of GetByProductIDKeyset.
+func (mr *MockRepositoryMockRecorder) GetByProductIDKeyset(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByProductIDKeyset", reflect.TypeOf((*MockRepository)(nil).GetByProductIDKeyset), ctx, arg)
+}
+
 // GetByUserID mocks base method.
 func (m *MockRepository) GetByUserID(ctx context.Context, userID uuid.UUID, limit, offset int32) ([]dbgen.GetReviewsByUserIDRow, error) {
 	m.ctrl.T.Helper()
@@ -201,6 +320,168 @@ func (mr *MockRepositoryMockRecorder) GetCompletedOrder(ctx, userID, productID i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompletedOrder", reflect.TypeOf((*MockRepository)(nil).GetCompletedOrder), ctx, userID, productID)
 }
 
+// GetReply mocks base method.
+func (m *MockRepository) GetReply(ctx context.Context, reviewID uuid.UUID) (dbgen.ReviewReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReply", ctx, reviewID)
+	ret0, _ := ret[0].(dbgen.ReviewReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReply indicates an expected call of GetReply.
+func (mr *MockRepositoryMockRecorder) GetReply(ctx, reviewID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReply", reflect.TypeOf((*MockRepository)(nil).GetReply), ctx, reviewID)
+}
+
+// IncrementReportCount mocks base method.
+func (m *MockRepository) IncrementReportCount(ctx context.Context, reviewID uuid.UUID) (dbgen.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementReportCount", ctx, reviewID)
+	ret0, _ := ret[0].(dbgen.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementReportCount indicates an expected call of IncrementReportCount.
+func (mr *MockRepositoryMockRecorder) IncrementReportCount(ctx, reviewID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementReportCount", reflect.TypeOf((*MockRepository)(nil).IncrementReportCount), ctx, reviewID)
+}
+
+// ListAdmin mocks base method.
+func (m *MockRepository) ListAdmin(ctx context.Context, arg dbgen.ListReviewsAdminParams) ([]dbgen.ListReviewsAdminRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAdmin", ctx, arg)
+	ret0, _ := ret[0].([]dbgen.ListReviewsAdminRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAdmin indicates an expected call of ListAdmin.
+func (mr *MockRepositoryMockRecorder) ListAdmin(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAdmin", reflect.TypeOf((*MockRepository)(nil).ListAdmin), ctx, arg)
+}
+
+// ListByProduct mocks base method.
+func (m *MockRepository) ListByProduct(ctx context.Context, arg dbgen.GetReviewsByProductIDParams) ([]dbgen.GetReviewsByProductIDRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByProduct", ctx, arg)
+	ret0, _ := ret[0].([]dbgen.GetReviewsByProductIDRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByProduct indicates an expected call of ListByProduct.
+func (mr *MockRepositoryMockRecorder) ListByProduct(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByProduct", reflect.TypeOf((*MockRepository)(nil).ListByProduct), ctx, arg)
+}
+
+// ListReports mocks base method.
+func (m *MockRepository) ListReports(ctx context.Context, reviewID uuid.UUID) ([]dbgen.ListReviewReportsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReports", ctx, reviewID)
+	ret0, _ := ret[0].([]dbgen.ListReviewReportsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReports indicates an expected call of ListReports.
+func (mr *MockRepositoryMockRecorder) ListReports(ctx, reviewID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReports", reflect.TypeOf((*MockRepository)(nil).ListReports), ctx, reviewID)
+}
+
+// LockProductRating mocks base method.
+func (m *MockRepository) LockProductRating(ctx context.Context, productID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockProductRating", ctx, productID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockProductRating indicates an expected call of LockProductRating.
+func (mr *MockRepositoryMockRecorder) LockProductRating(ctx, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockProductRating", reflect.TypeOf((*MockRepository)(nil).LockProductRating), ctx, productID)
+}
+
+// RecomputeAllProductRatings mocks base method.
+func (m *MockRepository) RecomputeAllProductRatings(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecomputeAllProductRatings", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecomputeAllProductRatings indicates an expected call of RecomputeAllProductRatings.
+func (mr *MockRepositoryMockRecorder) RecomputeAllProductRatings(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecomputeAllProductRatings", reflect.TypeOf((*MockRepository)(nil).RecomputeAllProductRatings), ctx)
+}
+
+// RecomputeProductRating mocks base method.
+func (m *MockRepository) RecomputeProductRating(ctx context.Context, productID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecomputeProductRating", ctx, productID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecomputeProductRating indicates an expected call of RecomputeProductRating.
+func (mr *MockRepositoryMockRecorder) RecomputeProductRating(ctx, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecomputeProductRating", reflect.TypeOf((*MockRepository)(nil).RecomputeProductRating), ctx, productID)
+}
+
+// RecomputeVotes mocks base method.
+func (m *MockRepository) RecomputeVotes(ctx context.Context, reviewID uuid.UUID) (dbgen.RecomputeReviewVotesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecomputeVotes", ctx, reviewID)
+	ret0, _ := ret[0].(dbgen.RecomputeReviewVotesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecomputeVotes indicates an expected call of RecomputeVotes.
+func (mr *MockRepositoryMockRecorder) RecomputeVotes(ctx, reviewID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecomputeVotes", reflect.TypeOf((*MockRepository)(nil).RecomputeVotes), ctx, reviewID)
+}
+
+// ResolveReports mocks base method.
+func (m *MockRepository) ResolveReports(ctx context.Context, reviewID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveReports", ctx, reviewID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResolveReports indicates an expected call of ResolveReports.
+func (mr *MockRepositoryMockRecorder) ResolveReports(ctx, reviewID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveReports", reflect.TypeOf((*MockRepository)(nil).ResolveReports), ctx, reviewID)
+}
+
+// SetStatus mocks base method.
+func (m *MockRepository) SetStatus(ctx context.Context, arg dbgen.SetReviewStatusParams) (dbgen.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetStatus", ctx, arg)
+	ret0, _ := ret[0].(dbgen.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetStatus indicates an expected call of SetStatus.
+func (mr *MockRepositoryMockRecorder) SetStatus(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStatus", reflect.TypeOf((*MockRepository)(nil).SetStatus), ctx, arg)
+}
+
 // Update mocks base method.
 func (m *MockRepository) Update(ctx context.Context, arg dbgen.UpdateReviewParams) (dbgen.Review, error) {
 	m.ctrl.T.Helper()
@@ -216,6 +497,35 @@ func (mr *MockRepositoryMockRecorder) Update(ctx, arg interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRepository)(nil).Update), ctx, arg)
 }
 
+// UpdateReply mocks base method.
+func (m *MockRepository) UpdateReply(ctx context.Context, arg dbgen.UpdateReviewReplyParams) (dbgen.ReviewReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReply", ctx, arg)
+	ret0, _ := ret[0].(dbgen.ReviewReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateReply indicates an expected call of UpdateReply.
+func (mr *MockRepositoryMockRecorder) UpdateReply(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReply", reflect.TypeOf((*MockRepository)(nil).UpdateReply), ctx, arg)
+}
+
+// UpsertVote mocks base method.
+func (m *MockRepository) UpsertVote(ctx context.Context, arg dbgen.UpsertReviewVoteParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertVote", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertVote indicates an expected call of UpsertVote.
+func (mr *MockRepositoryMockRecorder) UpsertVote(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertVote", reflect.TypeOf((*MockRepository)(nil).UpsertVote), ctx, arg)
+}
+
 // WithTx mocks base method.
 func (m *MockRepository) WithTx(tx dbgen.DBTX) review.Repository {
 	m.ctrl.T.Helper()
@@ -7,11 +7,65 @@ package mock
 import (
 	context "context"
 	review "go-sqlc-starter/internal/api/v1/review"
+	cursor "go-sqlc-starter/internal/pkg/cursor"
+	multipart "mime/multipart"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
 )
 
+// MockCloudinaryService is a mock of CloudinaryService interface.
+type MockCloudinaryService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCloudinaryServiceMockRecorder
+}
+
+// MockCloudinaryServiceMockRecorder is the mock recorder for MockCloudinaryService.
+type MockCloudinaryServiceMockRecorder struct {
+	mock *MockCloudinaryService
+}
+
+// NewMockCloudinaryService creates a new mock instance.
+func NewMockCloudinaryService(ctrl *gomock.Controller) *MockCloudinaryService {
+	mock := &MockCloudinaryService{ctrl: ctrl}
+	mock.recorder = &MockCloudinaryServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCloudinaryService) EXPECT() *MockCloudinaryServiceMockRecorder {
+	return m.recorder
+}
+
+// DeleteImage mocks base method.
+func (m *MockCloudinaryService) DeleteImage(ctx context.Context, publicID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteImage", ctx, publicID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteImage indicates an expected call of DeleteImage.
+func (mr *MockCloudinaryServiceMockRecorder) DeleteImage(ctx, publicID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImage", reflect.TypeOf((*MockCloudinaryService)(nil).DeleteImage), ctx, publicID)
+}
+
+// UploadImage mocks base method.
+func (m *MockCloudinaryService) UploadImage(ctx context.Context, file multipart.File, filename, folderName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadImage", ctx, file, filename, folderName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadImage indicates an expected call of UploadImage.
+func (mr *MockCloudinaryServiceMockRecorder) UploadImage(ctx, file, filename, folderName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadImage", reflect.TypeOf((*MockCloudinaryService)(nil).UploadImage), ctx, file, filename, folderName)
+}
+
 // MockService is a mock of Service interface.
 type MockService struct {
 	ctrl     *gomock.Controller
@@ -35,6 +89,21 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// Approve mocks base method.
+func (m *MockService) Approve(ctx context.Context, adminID, id string, req review.ModerateReviewRequest) (review.AdminReviewResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Approve", ctx, adminID, id, req)
+	ret0, _ := ret[0].(review.AdminReviewResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Approve indicates an expected call of Approve.
+func (mr *MockServiceMockRecorder) Approve(ctx, adminID, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Approve", reflect.TypeOf((*MockService)(nil).Approve), ctx, adminID, id, req)
+}
+
 // CheckEligibility mocks base method.
 func (m *MockService) CheckEligibility(ctx context.Context, userID, productSlug string) (review.ReviewEligibilityResponse, error) {
 	m.ctrl.T.Helper()
@@ -51,18 +120,33 @@ func (mr *MockServiceMockRecorder) CheckEligibility(ctx, userID, productSlug int
 }
 
 // Create mocks base method.
-func (m *MockService) Create(ctx context.Context, userID, productSlug string, req review.CreateReviewRequest) (review.ReviewResponse, error) {
+func (m *MockService) Create(ctx context.Context, userID, productSlug string, req review.CreateReviewRequest, photos []review.Photo) (review.ReviewResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, userID, productSlug, req)
+	ret := m.ctrl.Call(m, "Create", ctx, userID, productSlug, req, photos)
 	ret0, _ := ret[0].(review.ReviewResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockServiceMockRecorder) Create(ctx, userID, productSlug, req interface{}) *gomock.Call {
+func (mr *MockServiceMockRecorder) Create(ctx, userID, productSlug, req, photos interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockService)(nil).Create), ctx, userID, productSlug, req, photos)
+}
+
+// CreateReply mocks base method.
+func (m *MockService) CreateReply(ctx context.Context, adminID, id string, req review.ReviewReplyRequest) (review.ReviewReplyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReply", ctx, adminID, id, req)
+	ret0, _ := ret[0].(review.ReviewReplyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReply indicates an expected call of CreateReply.
+func (mr *MockServiceMockRecorder) CreateReply(ctx, adminID, id, req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockService)(nil).Create), ctx, userID, productSlug, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReply", reflect.TypeOf((*MockService)(nil).CreateReply), ctx, adminID, id, req)
 }
 
 // Delete mocks base method.
@@ -79,19 +163,79 @@ func (mr *MockServiceMockRecorder) Delete(ctx, reviewID, userID interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockService)(nil).Delete), ctx, reviewID, userID)
 }
 
+// DeleteReply mocks base method.
+func (m *MockService) DeleteReply(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReply", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReply indicates an expected call of DeleteReply.
+func (mr *MockServiceMockRecorder) DeleteReply(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReply", reflect.TypeOf((*MockService)(nil).DeleteReply), ctx, id)
+}
+
+// DeleteVote mocks base method.
+func (m *MockService) DeleteVote(ctx context.Context, userID, id string) (review.ReviewVoteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVote", ctx, userID, id)
+	ret0, _ := ret[0].(review.ReviewVoteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVote indicates an expected call of DeleteVote.
+func (mr *MockServiceMockRecorder) DeleteVote(ctx, userID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVote", reflect.TypeOf((*MockService)(nil).DeleteVote), ctx, userID, id)
+}
+
+// GetAdmin mocks base method.
+func (m *MockService) GetAdmin(ctx context.Context, id string) (review.AdminReviewResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAdmin", ctx, id)
+	ret0, _ := ret[0].(review.AdminReviewResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAdmin indicates an expected call of GetAdmin.
+func (mr *MockServiceMockRecorder) GetAdmin(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAdmin", reflect.TypeOf((*MockService)(nil).GetAdmin), ctx, id)
+}
+
 // GetByProductSlug mocks base method.
-func (m *MockService) GetByProductSlug(ctx context.Context, productSlug string, page, limit int) (review.ReviewListResponse, error) {
+func (m *MockService) GetByProductSlug(ctx context.Context, productSlug string, filter review.ReviewListFilter, page, limit int) (review.ReviewListResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByProductSlug", ctx, productSlug, page, limit)
+	ret := m.ctrl.Call(m, "GetByProductSlug", ctx, productSlug, filter, page, limit)
 	ret0, _ := ret[0].(review.ReviewListResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByProductSlug indicates an expected call of GetByProductSlug.
-func (mr *MockServiceMockRecorder) GetByProductSlug(ctx, productSlug, page, limit interface{}) *gomock.Call {
+func (mr *MockServiceMockRecorder) GetByProductSlug(ctx, productSlug, filter, page, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByProductSlug", reflect.TypeOf((*MockService)(nil).GetByProductSlug), ctx, productSlug, filter, page, limit)
+}
+
+// GetByProductSlugByCursor mocks base method.
+func (m *MockService) GetByProductSlugByCursor(ctx context.Context, productSlug string, filter review.ReviewListFilter, token string, limit int) (review.ReviewListResponse, cursor.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByProductSlugByCursor", ctx, productSlug, filter, token, limit)
+	ret0, _ := ret[0].(review.ReviewListResponse)
+	ret1, _ := ret[1].(cursor.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetByProductSlugByCursor indicates an expected call of GetByProductSlugByCursor.
+func (mr *MockServiceMockRecorder) GetByProductSlugByCursor(ctx, productSlug, filter, token, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByProductSlug", reflect.TypeOf((*MockService)(nil).GetByProductSlug), ctx, productSlug, page, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByProductSlugByCursor", reflect.TypeOf((*MockService)(nil).GetByProductSlugByCursor), ctx, productSlug, filter, token, limit)
 }
 
 // GetByUserID mocks base method.
@@ -109,6 +253,81 @@ func (mr *MockServiceMockRecorder) GetByUserID(ctx, userID, page, limit interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockService)(nil).GetByUserID), ctx, userID, page, limit)
 }
 
+// Hide mocks base method.
+func (m *MockService) Hide(ctx context.Context, adminID, id string, req review.ModerateReviewRequest) (review.AdminReviewResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Hide", ctx, adminID, id, req)
+	ret0, _ := ret[0].(review.AdminReviewResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Hide indicates an expected call of Hide.
+func (mr *MockServiceMockRecorder) Hide(ctx, adminID, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Hide", reflect.TypeOf((*MockService)(nil).Hide), ctx, adminID, id, req)
+}
+
+// ListAdmin mocks base method.
+func (m *MockService) ListAdmin(ctx context.Context, filter review.AdminReviewFilter, page, limit int) ([]review.AdminReviewResponse, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAdmin", ctx, filter, page, limit)
+	ret0, _ := ret[0].([]review.AdminReviewResponse)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListAdmin indicates an expected call of ListAdmin.
+func (mr *MockServiceMockRecorder) ListAdmin(ctx, filter, page, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAdmin", reflect.TypeOf((*MockService)(nil).ListAdmin), ctx, filter, page, limit)
+}
+
+// RecomputeAllRatings mocks base method.
+func (m *MockService) RecomputeAllRatings(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecomputeAllRatings", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecomputeAllRatings indicates an expected call of RecomputeAllRatings.
+func (mr *MockServiceMockRecorder) RecomputeAllRatings(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecomputeAllRatings", reflect.TypeOf((*MockService)(nil).RecomputeAllRatings), ctx)
+}
+
+// Reject mocks base method.
+func (m *MockService) Reject(ctx context.Context, adminID, id string, req review.ModerateReviewRequest) (review.AdminReviewResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reject", ctx, adminID, id, req)
+	ret0, _ := ret[0].(review.AdminReviewResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reject indicates an expected call of Reject.
+func (mr *MockServiceMockRecorder) Reject(ctx, adminID, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reject", reflect.TypeOf((*MockService)(nil).Reject), ctx, adminID, id, req)
+}
+
+// Report mocks base method.
+func (m *MockService) Report(ctx context.Context, userID, id string, req review.ReportReviewRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Report", ctx, userID, id, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Report indicates an expected call of Report.
+func (mr *MockServiceMockRecorder) Report(ctx, userID, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Report", reflect.TypeOf((*MockService)(nil).Report), ctx, userID, id, req)
+}
+
 // Update mocks base method.
 func (m *MockService) Update(ctx context.Context, reviewID, userID string, req review.UpdateReviewRequest) (review.ReviewResponse, error) {
 	m.ctrl.T.Helper()
@@ -123,3 +342,33 @@ func (mr *MockServiceMockRecorder) Update(ctx, reviewID, userID, req interface{}
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockService)(nil).Update), ctx, reviewID, userID, req)
 }
+
+// UpdateReply mocks base method.
+func (m *MockService) UpdateReply(ctx context.Context, adminID, id string, req review.ReviewReplyRequest) (review.ReviewReplyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReply", ctx, adminID, id, req)
+	ret0, _ := ret[0].(review.ReviewReplyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateReply indicates an expected call of UpdateReply.
+func (mr *MockServiceMockRecorder) UpdateReply(ctx, adminID, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReply", reflect.TypeOf((*MockService)(nil).UpdateReply), ctx, adminID, id, req)
+}
+
+// Vote mocks base method.
+func (m *MockService) Vote(ctx context.Context, userID, id string, req review.VoteReviewRequest) (review.ReviewVoteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Vote", ctx, userID, id, req)
+	ret0, _ := ret[0].(review.ReviewVoteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Vote indicates an expected call of Vote.
+func (mr *MockServiceMockRecorder) Vote(ctx, userID, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Vote", reflect.TypeOf((*MockService)(nil).Vote), ctx, userID, id, req)
+}
@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: shipment_service.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	shipment "go-sqlc-starter/internal/api/v1/shipment"
+	dbgen "go-sqlc-starter/internal/dbgen"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockService) Create(ctx context.Context, tx dbgen.DBTX, order dbgen.Order, receiptNo string) (dbgen.Shipment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, tx, order, receiptNo)
+	ret0, _ := ret[0].(dbgen.Shipment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockServiceMockRecorder) Create(ctx, tx, order, receiptNo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockService)(nil).Create), ctx, tx, order, receiptNo)
+}
+
+// Tracking mocks base method.
+func (m *MockService) Tracking(ctx context.Context, userID, orderID string) (shipment.TrackingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Tracking", ctx, userID, orderID)
+	ret0, _ := ret[0].(shipment.TrackingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Tracking indicates an expected call of Tracking.
+func (mr *MockServiceMockRecorder) Tracking(ctx, userID, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tracking", reflect.TypeOf((*MockService)(nil).Tracking), ctx, userID, orderID)
+}
+
+// Webhook mocks base method.
+func (m *MockService) Webhook(ctx context.Context, payload []byte, signature string) (shipment.WebhookResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Webhook", ctx, payload, signature)
+	ret0, _ := ret[0].(shipment.WebhookResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Webhook indicates an expected call of Webhook.
+func (mr *MockServiceMockRecorder) Webhook(ctx, payload, signature interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Webhook", reflect.TypeOf((*MockService)(nil).Webhook), ctx, payload, signature)
+}
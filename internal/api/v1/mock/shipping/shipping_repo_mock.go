@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: shipping_repo.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	shipping "go-sqlc-starter/internal/api/v1/shipping"
+	dbgen "go-sqlc-starter/internal/dbgen"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListProductWeights mocks base method.
+func (m *MockRepository) ListProductWeights(ctx context.Context, productIDs []uuid.UUID) ([]dbgen.ListProductWeightsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProductWeights", ctx, productIDs)
+	ret0, _ := ret[0].([]dbgen.ListProductWeightsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProductWeights indicates an expected call of ListProductWeights.
+func (mr *MockRepositoryMockRecorder) ListProductWeights(ctx, productIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProductWeights", reflect.TypeOf((*MockRepository)(nil).ListProductWeights), ctx, productIDs)
+}
+
+// ListRatesForDestination mocks base method.
+func (m *MockRepository) ListRatesForDestination(ctx context.Context, province, city string, weightGrams int32) ([]dbgen.ShippingRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRatesForDestination", ctx, province, city, weightGrams)
+	ret0, _ := ret[0].([]dbgen.ShippingRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRatesForDestination indicates an expected call of ListRatesForDestination.
+func (mr *MockRepositoryMockRecorder) ListRatesForDestination(ctx, province, city, weightGrams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRatesForDestination", reflect.TypeOf((*MockRepository)(nil).ListRatesForDestination), ctx, province, city, weightGrams)
+}
+
+// WithTx mocks base method.
+func (m *MockRepository) WithTx(tx dbgen.DBTX) shipping.Repository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", tx)
+	ret0, _ := ret[0].(shipping.Repository)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockRepositoryMockRecorder) WithTx(tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockRepository)(nil).WithTx), tx)
+}
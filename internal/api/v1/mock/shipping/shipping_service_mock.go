@@ -0,0 +1,67 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: shipping_service.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	cart "go-sqlc-starter/internal/api/v1/cart"
+	shipping "go-sqlc-starter/internal/api/v1/shipping"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// Quote mocks base method.
+func (m *MockService) Quote(ctx context.Context, userID string, req shipping.QuoteRequest) (shipping.QuoteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quote", ctx, userID, req)
+	ret0, _ := ret[0].(shipping.QuoteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Quote indicates an expected call of Quote.
+func (mr *MockServiceMockRecorder) Quote(ctx, userID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quote", reflect.TypeOf((*MockService)(nil).Quote), ctx, userID, req)
+}
+
+// Select mocks base method.
+func (m *MockService) Select(ctx context.Context, userID, addressID string, items []cart.CartItemDetailResponse, courier, service string) (shipping.Rate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Select", ctx, userID, addressID, items, courier, service)
+	ret0, _ := ret[0].(shipping.Rate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Select indicates an expected call of Select.
+func (mr *MockServiceMockRecorder) Select(ctx, userID, addressID, items, courier, service interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Select", reflect.TypeOf((*MockService)(nil).Select), ctx, userID, addressID, items, courier, service)
+}
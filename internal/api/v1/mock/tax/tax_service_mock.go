@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: tax_service.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	tax "go-sqlc-starter/internal/api/v1/tax"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// Calculate mocks base method.
+func (m *MockService) Calculate(ctx context.Context, lines []tax.Line, discount float64) (tax.Breakdown, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Calculate", ctx, lines, discount)
+	ret0, _ := ret[0].(tax.Breakdown)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Calculate indicates an expected call of Calculate.
+func (mr *MockServiceMockRecorder) Calculate(ctx, lines, discount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Calculate", reflect.TypeOf((*MockService)(nil).Calculate), ctx, lines, discount)
+}
+
+// ListClasses mocks base method.
+func (m *MockService) ListClasses(ctx context.Context) ([]tax.TaxClassResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClasses", ctx)
+	ret0, _ := ret[0].([]tax.TaxClassResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClasses indicates an expected call of ListClasses.
+func (mr *MockServiceMockRecorder) ListClasses(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClasses", reflect.TypeOf((*MockService)(nil).ListClasses), ctx)
+}
+
+// UpsertClass mocks base method.
+func (m *MockService) UpsertClass(ctx context.Context, code string, req tax.UpsertTaxClassRequest) (tax.TaxClassResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertClass", ctx, code, req)
+	ret0, _ := ret[0].(tax.TaxClassResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertClass indicates an expected call of UpsertClass.
+func (mr *MockServiceMockRecorder) UpsertClass(ctx, code, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertClass", reflect.TypeOf((*MockService)(nil).UpsertClass), ctx, code, req)
+}
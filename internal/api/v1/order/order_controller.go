@@ -2,6 +2,7 @@ package order
 
 import (
 	"go-sqlc-starter/internal/pkg/apperror"
+	"go-sqlc-starter/internal/pkg/httpx"
 	"go-sqlc-starter/internal/pkg/response"
 	"net/http"
 	"strconv"
@@ -61,7 +62,8 @@ func (ctrl *Controller) Checkout(c *gin.Context) {
 }
 
 // List retrieves all orders for the authenticated user
-// GET /orders?page=1&limit=10
+// GET /orders?page=1&limit=10&status=PAID
+// GET /orders?pagination=cursor&limit=10 atau /orders?cursor=<token> (keyset)
 func (ctrl *Controller) List(c *gin.Context) {
 	userID, exists := c.Get("user_id")
 	if !exists {
@@ -75,6 +77,7 @@ func (ctrl *Controller) List(c *gin.Context) {
 		return
 	}
 
+	status := c.Query("status")
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
 
@@ -85,9 +88,29 @@ func (ctrl *Controller) List(c *gin.Context) {
 		limit = 10
 	}
 
+	if pg := httpx.ParseCursor(c); pg.Enabled {
+		orders, cur, err := ctrl.service.ListByCursor(c.Request.Context(), userID.(string), status, pg.Cursor, limit)
+		if err != nil {
+			httpErr := apperror.ToHTTP(err)
+			response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+			return
+		}
+		response.Success(c, http.StatusOK, gin.H{
+			"orders": orders,
+			"pagination": gin.H{
+				"limit":      limit,
+				"nextCursor": cur.Next,
+				"prevCursor": cur.Prev,
+				"status":     status,
+			},
+		}, nil)
+		return
+	}
+
 	orders, total, err := ctrl.service.List(
 		c.Request.Context(),
 		userID.(string),
+		status,
 		page,
 		limit,
 	)
@@ -100,16 +123,23 @@ func (ctrl *Controller) List(c *gin.Context) {
 	response.Success(c, http.StatusOK, gin.H{
 		"orders": orders,
 		"pagination": gin.H{
-			"page":  page,
-			"limit": limit,
-			"total": total,
+			"page":   page,
+			"limit":  limit,
+			"total":  total,
+			"status": status,
 		},
 	}, nil)
 }
 
-// Detail retrieves a single order by ID
+// Detail retrieves a single order owned by the authenticated user
 // GET /orders/:id
 func (ctrl *Controller) Detail(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		response.Error(c, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated", nil)
+		return
+	}
+
 	orderID := c.Param("id")
 	if orderID == "" {
 		httpErr := apperror.ToHTTP(ErrInvalidOrderID)
@@ -117,7 +147,7 @@ func (ctrl *Controller) Detail(c *gin.Context) {
 		return
 	}
 
-	res, err := ctrl.service.Detail(c.Request.Context(), orderID)
+	res, err := ctrl.service.Detail(c.Request.Context(), userID.(string), orderID)
 	if err != nil {
 		httpErr := apperror.ToHTTP(err)
 		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
@@ -127,9 +157,15 @@ func (ctrl *Controller) Detail(c *gin.Context) {
 	response.Success(c, http.StatusOK, res, nil)
 }
 
-// Cancel cancels an order (only for PENDING status)
+// Cancel cancels an order owned by the authenticated user (only for PENDING status)
 // PATCH /orders/:id/cancel
 func (ctrl *Controller) Cancel(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		response.Error(c, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated", nil)
+		return
+	}
+
 	orderID := c.Param("id")
 	if orderID == "" {
 		httpErr := apperror.ToHTTP(ErrInvalidOrderID)
@@ -137,7 +173,7 @@ func (ctrl *Controller) Cancel(c *gin.Context) {
 		return
 	}
 
-	if err := ctrl.service.Cancel(c.Request.Context(), orderID); err != nil {
+	if err := ctrl.service.Cancel(c.Request.Context(), userID.(string), orderID); err != nil {
 		httpErr := apperror.ToHTTP(err)
 		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
 		return
@@ -166,6 +202,26 @@ func (ctrl *Controller) ListAdmin(c *gin.Context) {
 		limit = 20
 	}
 
+	if pg := httpx.ParseCursor(c); pg.Enabled {
+		orders, cur, err := ctrl.service.ListAdminByCursor(c.Request.Context(), status, search, pg.Cursor, limit)
+		if err != nil {
+			httpErr := apperror.ToHTTP(err)
+			response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+			return
+		}
+		response.Success(c, http.StatusOK, gin.H{
+			"orders": orders,
+			"pagination": gin.H{
+				"limit":      limit,
+				"nextCursor": cur.Next,
+				"prevCursor": cur.Prev,
+				"status":     status,
+				"search":     search,
+			},
+		}, nil)
+		return
+	}
+
 	orders, total, err := ctrl.service.ListAdmin(
 		c.Request.Context(),
 		status,
@@ -191,6 +247,35 @@ func (ctrl *Controller) ListAdmin(c *gin.Context) {
 	}, nil)
 }
 
+// DetailAdmin retrieves any order by ID (admin only)
+// GET /orders/admin/:id
+func (ctrl *Controller) DetailAdmin(c *gin.Context) {
+	res, err := ctrl.service.DetailAdmin(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// CancelAdmin cancels any PENDING order (admin only)
+// PATCH /orders/admin/:id/cancel
+func (ctrl *Controller) CancelAdmin(c *gin.Context) {
+	adminID := c.GetString("user_id")
+
+	if err := ctrl.service.CancelAdmin(c.Request.Context(), adminID, c.Param("id")); err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{
+		"message": "Order cancelled successfully",
+	}, nil)
+}
+
 // UpdateStatus updates order status (admin only)
 // PATCH /admin/orders/:id/status
 func (c *Controller) UpdateStatusByAdmin(ctx *gin.Context) {
@@ -221,7 +306,8 @@ func (c *Controller) UpdateStatusByCustomer(ctx *gin.Context) {
 	// Langsung paksa status ke COMPLETED karena ini endpoint khusus customer
 	res, err := c.service.UpdateStatusByCustomer(ctx.Request.Context(), id, userID, "COMPLETED")
 	if err != nil {
-		ctx.JSON(400, gin.H{"error": err.Error()})
+		httpErr := apperror.ToHTTP(err)
+		response.Error(ctx, httpErr.Status, httpErr.Code, httpErr.Message, nil)
 		return
 	}
 
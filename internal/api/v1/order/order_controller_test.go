@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"go-sqlc-starter/internal/api/v1/order"
+	"go-sqlc-starter/internal/pkg/cursor"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -19,10 +20,16 @@ import (
 
 type fakeOrderService struct {
 	checkoutFunc  func(ctx context.Context, req order.CheckoutRequest) (order.OrderResponse, error)
-	listFunc      func(ctx context.Context, userID string, page, limit int) ([]order.OrderResponse, int64, error)
-	detailFunc    func(ctx context.Context, orderID string) (order.OrderResponse, error)
-	cancelFunc    func(ctx context.Context, orderID string) error
+	listFunc      func(ctx context.Context, userID string, status string, page, limit int) ([]order.OrderResponse, int64, error)
+	detailFunc    func(ctx context.Context, userID string, orderID string) (order.OrderResponse, error)
+	cancelFunc    func(ctx context.Context, userID string, orderID string) error
 	listAdminFunc func(ctx context.Context, status string, search string, page, limit int) ([]order.OrderResponse, int64, error)
+
+	detailAdminFunc func(ctx context.Context, orderID string) (order.OrderResponse, error)
+	cancelAdminFunc func(ctx context.Context, adminID string, orderID string) error
+
+	listByCursorFunc      func(ctx context.Context, userID string, status string, token string, limit int) ([]order.OrderResponse, cursor.Page, error)
+	listAdminByCursorFunc func(ctx context.Context, status string, search string, token string, limit int) ([]order.OrderResponse, cursor.Page, error)
 	// Perbaikan: Gunakan uuid.UUID dan *string di dalam definisi func field
 	updateStatusCustomerFunc func(ctx context.Context, orderID string, userID uuid.UUID, status string) (order.OrderResponse, error)
 	updateStatusAdminFunc    func(ctx context.Context, orderID string, status string, receiptNo *string) (order.OrderResponse, error)
@@ -35,23 +42,37 @@ func (f *fakeOrderService) Checkout(ctx context.Context, req order.CheckoutReque
 	return order.OrderResponse{}, nil
 }
 
-func (f *fakeOrderService) List(ctx context.Context, userID string, page, limit int) ([]order.OrderResponse, int64, error) {
+func (f *fakeOrderService) List(ctx context.Context, userID string, status string, page, limit int) ([]order.OrderResponse, int64, error) {
 	if f.listFunc != nil {
-		return f.listFunc(ctx, userID, page, limit)
+		return f.listFunc(ctx, userID, status, page, limit)
 	}
 	return []order.OrderResponse{}, 0, nil
 }
 
-func (f *fakeOrderService) Detail(ctx context.Context, orderID string) (order.OrderResponse, error) {
+func (f *fakeOrderService) Detail(ctx context.Context, userID string, orderID string) (order.OrderResponse, error) {
 	if f.detailFunc != nil {
-		return f.detailFunc(ctx, orderID)
+		return f.detailFunc(ctx, userID, orderID)
 	}
 	return order.OrderResponse{}, nil
 }
 
-func (f *fakeOrderService) Cancel(ctx context.Context, orderID string) error {
+func (f *fakeOrderService) Cancel(ctx context.Context, userID string, orderID string) error {
 	if f.cancelFunc != nil {
-		return f.cancelFunc(ctx, orderID)
+		return f.cancelFunc(ctx, userID, orderID)
+	}
+	return nil
+}
+
+func (f *fakeOrderService) DetailAdmin(ctx context.Context, orderID string) (order.OrderResponse, error) {
+	if f.detailAdminFunc != nil {
+		return f.detailAdminFunc(ctx, orderID)
+	}
+	return order.OrderResponse{}, nil
+}
+
+func (f *fakeOrderService) CancelAdmin(ctx context.Context, adminID string, orderID string) error {
+	if f.cancelAdminFunc != nil {
+		return f.cancelAdminFunc(ctx, adminID, orderID)
 	}
 	return nil
 }
@@ -63,6 +84,20 @@ func (f *fakeOrderService) ListAdmin(ctx context.Context, status string, search
 	return []order.OrderResponse{}, 0, nil
 }
 
+func (f *fakeOrderService) ListByCursor(ctx context.Context, userID string, status string, token string, limit int) ([]order.OrderResponse, cursor.Page, error) {
+	if f.listByCursorFunc != nil {
+		return f.listByCursorFunc(ctx, userID, status, token, limit)
+	}
+	return []order.OrderResponse{}, cursor.Page{}, nil
+}
+
+func (f *fakeOrderService) ListAdminByCursor(ctx context.Context, status string, search string, token string, limit int) ([]order.OrderResponse, cursor.Page, error) {
+	if f.listAdminByCursorFunc != nil {
+		return f.listAdminByCursorFunc(ctx, status, search, token, limit)
+	}
+	return []order.OrderResponse{}, cursor.Page{}, nil
+}
+
 func (f *fakeOrderService) UpdateStatusByCustomer(ctx context.Context, orderID string, userID uuid.UUID, status string) (order.OrderResponse, error) {
 	if f.updateStatusCustomerFunc != nil {
 		return f.updateStatusCustomerFunc(ctx, orderID, userID, status)
@@ -77,6 +112,14 @@ func (f *fakeOrderService) UpdateStatusByAdmin(ctx context.Context, orderID stri
 	return order.OrderResponse{}, nil
 }
 
+func (f *fakeOrderService) ExpireUnpaid(ctx context.Context, cutoff time.Time, limit int32) ([]order.OrderResponse, error) {
+	return nil, nil
+}
+
+func (f *fakeOrderService) AutoComplete(ctx context.Context, cutoff time.Time, limit int32) ([]order.OrderResponse, error) {
+	return nil, nil
+}
+
 // ==================== HELPER FUNCTIONS ====================
 
 func setupTestRouter() *gin.Engine {
@@ -209,7 +252,7 @@ func TestOrderController_List(t *testing.T) {
 		userID := uuid.New().String()
 
 		svc := &fakeOrderService{
-			listFunc: func(ctx context.Context, uid string, page, limit int) ([]order.OrderResponse, int64, error) {
+			listFunc: func(ctx context.Context, uid string, status string, page, limit int) ([]order.OrderResponse, int64, error) {
 				assert.Equal(t, userID, uid)
 				assert.Equal(t, 1, page)
 				assert.Equal(t, 10, limit)
@@ -252,8 +295,8 @@ func TestOrderController_List(t *testing.T) {
 		userID := uuid.New().String()
 
 		svc := &fakeOrderService{
-			listFunc: func(ctx context.Context, uid string, page, limit int) ([]order.OrderResponse, int64, error) {
-				// Note: status filter logic should be in controller layer
+			listFunc: func(ctx context.Context, uid string, status string, page, limit int) ([]order.OrderResponse, int64, error) {
+				assert.Equal(t, "PAID", status)
 				orders := []order.OrderResponse{
 					{OrderNumber: "ORD-003", Status: "PAID", TotalPrice: 150000.00},
 				}
@@ -276,7 +319,7 @@ func TestOrderController_List(t *testing.T) {
 
 	t.Run("empty_orders", func(t *testing.T) {
 		svc := &fakeOrderService{
-			listFunc: func(ctx context.Context, uid string, page, limit int) ([]order.OrderResponse, int64, error) {
+			listFunc: func(ctx context.Context, uid string, status string, page, limit int) ([]order.OrderResponse, int64, error) {
 				return []order.OrderResponse{}, 0, nil
 			},
 		}
@@ -295,7 +338,7 @@ func TestOrderController_List(t *testing.T) {
 
 	t.Run("service_error", func(t *testing.T) {
 		svc := &fakeOrderService{
-			listFunc: func(ctx context.Context, uid string, page, limit int) ([]order.OrderResponse, int64, error) {
+			listFunc: func(ctx context.Context, uid string, status string, page, limit int) ([]order.OrderResponse, int64, error) {
 				return nil, 0, errors.New("database error")
 			},
 		}
@@ -311,6 +354,51 @@ func TestOrderController_List(t *testing.T) {
 
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
 	})
+
+	t.Run("cursor_mode", func(t *testing.T) {
+		userID := uuid.New().String()
+
+		svc := &fakeOrderService{
+			listByCursorFunc: func(ctx context.Context, uid string, status string, token string, limit int) ([]order.OrderResponse, cursor.Page, error) {
+				assert.Equal(t, userID, uid)
+				assert.Equal(t, "", token)
+				assert.Equal(t, 5, limit)
+				return []order.OrderResponse{{OrderNumber: "ORD-001"}}, cursor.Page{Next: "next-tok"}, nil
+			},
+		}
+
+		ctrl := newTestController(svc)
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+
+		c.Request = httptest.NewRequest(http.MethodGet, "/orders?pagination=cursor&limit=5", nil)
+		c.Set("user_id", userID)
+
+		ctrl.List(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "ORD-001")
+		assert.Contains(t, w.Body.String(), `"nextCursor":"next-tok"`)
+	})
+
+	t.Run("cursor_mode_invalid_cursor", func(t *testing.T) {
+		svc := &fakeOrderService{
+			listByCursorFunc: func(ctx context.Context, uid string, status string, token string, limit int) ([]order.OrderResponse, cursor.Page, error) {
+				return nil, cursor.Page{}, cursor.ErrInvalidCursor
+			},
+		}
+
+		ctrl := newTestController(svc)
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+
+		c.Request = httptest.NewRequest(http.MethodGet, "/orders?cursor=broken", nil)
+		c.Set("user_id", uuid.New().String())
+
+		ctrl.List(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
 }
 
 // ==================== DETAIL ORDER TESTS ====================
@@ -320,7 +408,7 @@ func TestOrderController_Detail(t *testing.T) {
 		orderID := uuid.New().String()
 
 		svc := &fakeOrderService{
-			detailFunc: func(ctx context.Context, id string) (order.OrderResponse, error) {
+			detailFunc: func(ctx context.Context, uid string, id string) (order.OrderResponse, error) {
 				assert.Equal(t, orderID, id)
 
 				return order.OrderResponse{
@@ -347,6 +435,7 @@ func TestOrderController_Detail(t *testing.T) {
 		c, _ := gin.CreateTestContext(w)
 
 		c.Request = httptest.NewRequest(http.MethodGet, "/orders/"+orderID, nil)
+		c.Set("user_id", uuid.New().String())
 		c.Params = gin.Params{{Key: "id", Value: orderID}}
 
 		ctrl.Detail(c)
@@ -360,7 +449,7 @@ func TestOrderController_Detail(t *testing.T) {
 		orderID := uuid.New().String()
 
 		svc := &fakeOrderService{
-			detailFunc: func(ctx context.Context, id string) (order.OrderResponse, error) {
+			detailFunc: func(ctx context.Context, uid string, id string) (order.OrderResponse, error) {
 				return order.OrderResponse{}, errors.New("order not found")
 			},
 		}
@@ -370,6 +459,7 @@ func TestOrderController_Detail(t *testing.T) {
 		c, _ := gin.CreateTestContext(w)
 
 		c.Request = httptest.NewRequest(http.MethodGet, "/orders/"+orderID, nil)
+		c.Set("user_id", uuid.New().String())
 		c.Params = gin.Params{{Key: "id", Value: orderID}}
 
 		ctrl.Detail(c)
@@ -385,7 +475,7 @@ func TestOrderController_Cancel(t *testing.T) {
 		orderID := uuid.New().String()
 
 		svc := &fakeOrderService{
-			cancelFunc: func(ctx context.Context, id string) error {
+			cancelFunc: func(ctx context.Context, uid string, id string) error {
 				assert.Equal(t, orderID, id)
 				return nil
 			},
@@ -396,6 +486,7 @@ func TestOrderController_Cancel(t *testing.T) {
 		c, _ := gin.CreateTestContext(w)
 
 		c.Request = httptest.NewRequest(http.MethodPatch, "/orders/"+orderID+"/cancel", nil)
+		c.Set("user_id", uuid.New().String())
 		c.Params = gin.Params{{Key: "id", Value: orderID}}
 
 		ctrl.Cancel(c)
@@ -407,7 +498,7 @@ func TestOrderController_Cancel(t *testing.T) {
 		orderID := uuid.New().String()
 
 		svc := &fakeOrderService{
-			cancelFunc: func(ctx context.Context, id string) error {
+			cancelFunc: func(ctx context.Context, uid string, id string) error {
 				return errors.New("order already cancelled")
 			},
 		}
@@ -417,6 +508,7 @@ func TestOrderController_Cancel(t *testing.T) {
 		c, _ := gin.CreateTestContext(w)
 
 		c.Request = httptest.NewRequest(http.MethodPatch, "/orders/"+orderID+"/cancel", nil)
+		c.Set("user_id", uuid.New().String())
 		c.Params = gin.Params{{Key: "id", Value: orderID}}
 
 		ctrl.Cancel(c)
@@ -428,7 +520,7 @@ func TestOrderController_Cancel(t *testing.T) {
 		orderID := uuid.New().String()
 
 		svc := &fakeOrderService{
-			cancelFunc: func(ctx context.Context, id string) error {
+			cancelFunc: func(ctx context.Context, uid string, id string) error {
 				return errors.New("order not found")
 			},
 		}
@@ -438,6 +530,7 @@ func TestOrderController_Cancel(t *testing.T) {
 		c, _ := gin.CreateTestContext(w)
 
 		c.Request = httptest.NewRequest(http.MethodPatch, "/orders/"+orderID+"/cancel", nil)
+		c.Set("user_id", uuid.New().String())
 		c.Params = gin.Params{{Key: "id", Value: orderID}}
 
 		ctrl.Cancel(c)
@@ -5,9 +5,12 @@ import "time"
 // ==================== REQUEST STRUCTS ====================
 
 type CheckoutRequest struct {
-	UserID    string `json:"-"`
-	AddressID string `json:"addressId" binding:"required"`
-	Note      string `json:"note"`
+	UserID      string `json:"-"`
+	AddressID   string `json:"addressId" binding:"required"`
+	Note        string `json:"note"`
+	VoucherCode string `json:"voucherCode"` // opsional
+	Courier     string `json:"courier" binding:"required"`
+	Service     string `json:"service" binding:"required"`
 }
 
 type ListOrderRequest struct {
@@ -44,21 +47,34 @@ type CheckoutResponse struct {
 }
 
 type OrderResponse struct {
-	ID          string              `json:"id"`
-	OrderNumber string              `json:"orderNumber"`
-	Status      string              `json:"status"`
-	ReceiptNo   *string             `json:"receiptNo,omitempty"` // Tambahkan di sini
-	TotalPrice  float64             `json:"totalPrice"`
-	PlacedAt    time.Time           `json:"placedAt"`
-	Items       []OrderItemResponse `json:"items,omitempty"`
+	ID               string              `json:"id"`
+	OrderNumber      string              `json:"orderNumber"`
+	Status           string              `json:"status"`
+	PaymentStatus    string              `json:"paymentStatus"`
+	ReceiptNo        *string             `json:"receiptNo,omitempty"` // Tambahkan di sini
+	SubtotalPrice    float64             `json:"subtotalPrice"`
+	DiscountPrice    float64             `json:"discountPrice"`
+	VoucherCode      *string             `json:"voucherCode,omitempty"`
+	ShippingPrice    float64             `json:"shippingPrice"`
+	ShippingCourier  string              `json:"shippingCourier,omitempty"`
+	ShippingService  string              `json:"shippingService,omitempty"`
+	TaxPrice         float64             `json:"taxPrice"`
+	PricesIncludeTax bool                `json:"pricesIncludeTax"` // true = taxPrice sudah termasuk di harga
+	TotalPrice       float64             `json:"totalPrice"`
+	RefundedAmount   float64             `json:"refundedAmount"` // akumulasi refund dari retur
+	PlacedAt         time.Time           `json:"placedAt"`
+	Items            []OrderItemResponse `json:"items,omitempty"`
 }
 
 type OrderItemResponse struct {
+	ID           string  `json:"id"` // dipakai saat mengajukan retur
 	ProductID    string  `json:"productId"`
 	NameSnapshot string  `json:"name"`
 	UnitPrice    float64 `json:"unitPrice"`
 	Quantity     int32   `json:"quantity"`
 	Subtotal     float64 `json:"subtotal"` // unit_price * quantity
+	TaxRate      float64 `json:"taxRate"`  // persen
+	TaxAmount    float64 `json:"taxAmount"`
 }
 
 type OrderDetailResponse struct {
@@ -30,6 +30,12 @@ var (
 		http.StatusBadRequest,
 	)
 
+	ErrCartItemsUnavailable = apperror.New(
+		apperror.CodeInvalidState,
+		"Some items in your cart are no longer available, please review your cart",
+		http.StatusConflict,
+	)
+
 	ErrCannotCancel = apperror.New(
 		apperror.CodeInvalidState,
 		"Order cannot be cancelled",
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"go-sqlc-starter/internal/dbgen"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -16,8 +17,16 @@ type Repository interface {
 	GetByID(ctx context.Context, id uuid.UUID) (dbgen.Order, error)
 	GetItems(ctx context.Context, orderID uuid.UUID) ([]dbgen.OrderItem, error)
 	UpdateStatus(ctx context.Context, id uuid.UUID, status string) (dbgen.Order, error)
+	SetReceiptNo(ctx context.Context, id uuid.UUID, receiptNo string) (dbgen.Order, error)
+	ListExpiredPendingIDs(ctx context.Context, cutoff time.Time, limit int32) ([]uuid.UUID, error)
+	Cancel(ctx context.Context, id uuid.UUID) (dbgen.Order, error)
+	Complete(ctx context.Context, id uuid.UUID) (dbgen.Order, error)
+	Expire(ctx context.Context, id uuid.UUID, reason string) (dbgen.Order, error)
+	CompleteDelivered(ctx context.Context, cutoff time.Time, limit int32) ([]dbgen.Order, error)
 	List(ctx context.Context, arg dbgen.ListOrdersParams) ([]dbgen.ListOrdersRow, error)
 	ListAdmin(ctx context.Context, arg dbgen.ListOrdersAdminParams) ([]dbgen.ListOrdersAdminRow, error)
+	ListKeyset(ctx context.Context, arg dbgen.ListOrdersKeysetParams) ([]dbgen.Order, error)
+	ListAdminKeyset(ctx context.Context, arg dbgen.ListOrdersAdminKeysetParams) ([]dbgen.Order, error)
 }
 
 type repository struct {
@@ -65,6 +74,42 @@ func (r *repository) UpdateStatus(ctx context.Context, id uuid.UUID, status stri
 	})
 }
 
+func (r *repository) SetReceiptNo(ctx context.Context, id uuid.UUID, receiptNo string) (dbgen.Order, error) {
+	return r.queries.SetOrderReceiptNo(ctx, dbgen.SetOrderReceiptNoParams{
+		ID:        id,
+		ReceiptNo: sql.NullString{String: receiptNo, Valid: true},
+	})
+}
+
+func (r *repository) ListExpiredPendingIDs(ctx context.Context, cutoff time.Time, limit int32) ([]uuid.UUID, error) {
+	return r.queries.ListExpiredPendingOrderIDs(ctx, dbgen.ListExpiredPendingOrderIDsParams{
+		Cutoff:    cutoff,
+		BatchSize: limit,
+	})
+}
+
+func (r *repository) Cancel(ctx context.Context, id uuid.UUID) (dbgen.Order, error) {
+	return r.queries.CancelOrder(ctx, id)
+}
+
+func (r *repository) Complete(ctx context.Context, id uuid.UUID) (dbgen.Order, error) {
+	return r.queries.CompleteOrder(ctx, id)
+}
+
+func (r *repository) Expire(ctx context.Context, id uuid.UUID, reason string) (dbgen.Order, error) {
+	return r.queries.ExpireOrder(ctx, dbgen.ExpireOrderParams{
+		ID:           id,
+		CancelReason: sql.NullString{String: reason, Valid: true},
+	})
+}
+
+func (r *repository) CompleteDelivered(ctx context.Context, cutoff time.Time, limit int32) ([]dbgen.Order, error) {
+	return r.queries.CompleteDeliveredOrders(ctx, dbgen.CompleteDeliveredOrdersParams{
+		Cutoff:    cutoff,
+		BatchSize: limit,
+	})
+}
+
 func (r *repository) List(ctx context.Context, arg dbgen.ListOrdersParams) ([]dbgen.ListOrdersRow, error) {
 	return r.queries.ListOrders(ctx, arg)
 }
@@ -72,3 +117,11 @@ func (r *repository) List(ctx context.Context, arg dbgen.ListOrdersParams) ([]db
 func (r *repository) ListAdmin(ctx context.Context, arg dbgen.ListOrdersAdminParams) ([]dbgen.ListOrdersAdminRow, error) {
 	return r.queries.ListOrdersAdmin(ctx, arg)
 }
+
+func (r *repository) ListKeyset(ctx context.Context, arg dbgen.ListOrdersKeysetParams) ([]dbgen.Order, error) {
+	return r.queries.ListOrdersKeyset(ctx, arg)
+}
+
+func (r *repository) ListAdminKeyset(ctx context.Context, arg dbgen.ListOrdersAdminKeysetParams) ([]dbgen.Order, error) {
+	return r.queries.ListOrdersAdminKeyset(ctx, arg)
+}
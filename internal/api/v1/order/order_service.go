@@ -4,10 +4,19 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"go-sqlc-starter/internal/api/v1/auth"
+	"go-sqlc-starter/internal/api/v1/address"
 	"go-sqlc-starter/internal/api/v1/cart"
+	"go-sqlc-starter/internal/api/v1/inventory"
+	"go-sqlc-starter/internal/api/v1/shipment"
+	"go-sqlc-starter/internal/api/v1/shipping"
+	shippingerrors "go-sqlc-starter/internal/api/v1/shipping/errors"
+	"go-sqlc-starter/internal/api/v1/tax"
+	"go-sqlc-starter/internal/api/v1/voucher"
 	"go-sqlc-starter/internal/dbgen"
+	"go-sqlc-starter/internal/pkg/constants"
+	"go-sqlc-starter/internal/pkg/cursor"
 	"strconv"
 	"strings"
 	"time"
@@ -19,35 +28,56 @@ import (
 type Service interface {
 	// Customer Actions
 	Checkout(ctx context.Context, req CheckoutRequest) (OrderResponse, error)
-	List(ctx context.Context, userID string, page, limit int) ([]OrderResponse, int64, error)
-	Detail(ctx context.Context, orderID string) (OrderResponse, error)
-	Cancel(ctx context.Context, orderID string) error
+	// Semua aksi customer di-scope ke userID; order milik user lain = ErrOrderNotFound
+	List(ctx context.Context, userID string, status string, page, limit int) ([]OrderResponse, int64, error)
+	ListByCursor(ctx context.Context, userID string, status string, token string, limit int) ([]OrderResponse, cursor.Page, error)
+	Detail(ctx context.Context, userID string, orderID string) (OrderResponse, error)
+	Cancel(ctx context.Context, userID string, orderID string) error
 	UpdateStatusByCustomer(ctx context.Context, orderID string, userID uuid.UUID, nextStatus string) (OrderResponse, error)
 
-	// Shared/Admin Actions
+	// Shared/Admin Actions (tanpa scope pemilik)
 	ListAdmin(ctx context.Context, status string, search string, page, limit int) ([]OrderResponse, int64, error)
+	ListAdminByCursor(ctx context.Context, status string, search string, token string, limit int) ([]OrderResponse, cursor.Page, error)
+	DetailAdmin(ctx context.Context, orderID string) (OrderResponse, error)
+	CancelAdmin(ctx context.Context, adminID string, orderID string) error
 	UpdateStatusByAdmin(ctx context.Context, orderID string, nextStatus string, receiptNo *string) (OrderResponse, error)
+
+	// System Actions (scheduler)
+	ExpireUnpaid(ctx context.Context, cutoff time.Time, limit int32) ([]OrderResponse, error)
+	AutoComplete(ctx context.Context, cutoff time.Time, limit int32) ([]OrderResponse, error)
 }
 
 type service struct {
-	repo    Repository
-	cartSvc cart.Service
-	db      *sql.DB        // Dibutuhkan untuk s.db.BeginTx()
-	queries *dbgen.Queries // Untuk query standar non-transaksi
+	repo         Repository
+	cartSvc      cart.Service
+	inventorySvc inventory.Service
+	voucherSvc   voucher.Service
+	shippingSvc  shipping.Service
+	shipmentSvc  shipment.Service
+	taxSvc       tax.Service
+	addressRepo  address.Repository
+	db           *sql.DB        // Dibutuhkan untuk s.db.BeginTx()
+	queries      *dbgen.Queries // Untuk query standar non-transaksi
 }
 
-func NewService(db *sql.DB, r Repository, c cart.Service) Service {
+func NewService(db *sql.DB, r Repository, c cart.Service, inv inventory.Service, v voucher.Service, ship shipping.Service, sh shipment.Service, t tax.Service, a address.Repository) Service {
 	return &service{
-		db:      db,
-		repo:    r,
-		cartSvc: c,
+		db:           db,
+		repo:         r,
+		cartSvc:      c,
+		inventorySvc: inv,
+		voucherSvc:   v,
+		shippingSvc:  ship,
+		shipmentSvc:  sh,
+		taxSvc:       t,
+		addressRepo:  a,
 	}
 }
 
 // CUSTOMER: Checkout
 func (s *service) Checkout(ctx context.Context, req CheckoutRequest) (OrderResponse, error) {
 	// 1. Ambil detail cart (Lakukan di luar transaksi untuk performa)
-	cartData, err := s.cartSvc.Detail(ctx, req.UserID)
+	cartData, err := s.cartSvc.Detail(ctx, cart.UserOwner(req.UserID))
 	if err != nil {
 		return OrderResponse{}, err
 	}
@@ -55,6 +85,35 @@ func (s *service) Checkout(ctx context.Context, req CheckoutRequest) (OrderRespo
 		return OrderResponse{}, ErrCartEmpty
 	}
 
+	// 1a. Item yang tidak bisa dibeli (hapus / nonaktif / stok kurang) harus dibereskan dulu di cart
+	for _, item := range cartData.Items {
+		if cart.HasBlockingWarning(item) {
+			return OrderResponse{}, ErrCartItemsUnavailable
+		}
+	}
+
+	// 1b. Ongkir dihitung ulang di server dari alamat & berat keranjang
+	rate, err := s.shippingSvc.Select(ctx, req.UserID, req.AddressID, cartData.Items, req.Courier, req.Service)
+	if err != nil {
+		return OrderResponse{}, err
+	}
+
+	uid, _ := uuid.Parse(req.UserID)
+
+	// 1c. Alamat disalin utuh ke order; invoice tidak boleh ikut berubah saat alamat diedit / dihapus
+	aid, _ := uuid.Parse(req.AddressID)
+	addr, err := s.addressRepo.GetByID(ctx, aid, uid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return OrderResponse{}, shippingerrors.ErrAddressNotFound
+		}
+		return OrderResponse{}, ErrOrderFailed
+	}
+	addressSnapshot, err := json.Marshal(address.NewSnapshot(addr))
+	if err != nil {
+		return OrderResponse{}, ErrOrderFailed
+	}
+
 	// 2. Mulai Transaksi Database
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
@@ -70,54 +129,100 @@ func (s *service) Checkout(ctx context.Context, req CheckoutRequest) (OrderRespo
 
 	// --- LOGIKA BISNIS ---
 
-	// Hitung total harga
-	var total float64
+	// Hitung subtotal dari harga efektif saat checkout (jadwal harga / sale aktif), bukan price_at_add
+	var subtotal float64
 	for _, item := range cartData.Items {
-		total += float64(item.Price) * float64(item.Qty)
+		subtotal += item.CurrentPrice * float64(item.Qty)
+	}
+
+	// Voucher (opsional): row voucher terkunci sampai transaksi ini selesai
+	var applied voucher.Application
+	if req.VoucherCode != "" {
+		applied, err = s.voucherSvc.Apply(ctx, tx, uid, req.VoucherCode, voucher.CartLines(cartData.Items))
+		if err != nil {
+			return OrderResponse{}, err
+		}
+	}
+
+	// Pajak per baris dihitung dari harga setelah diskon; mode inklusif tidak menambah total
+	taxed, err := s.taxSvc.Calculate(ctx, tax.CartLines(cartData.Items), applied.Discount)
+	if err != nil {
+		return OrderResponse{}, err
+	}
+	total := subtotal - applied.Discount + rate.Price
+	if !taxed.PricesIncludeTax {
+		total += taxed.Total
 	}
 
-	uid, _ := uuid.Parse(req.UserID)
 	orderNumber := fmt.Sprintf("ORD-%d%s", time.Now().Unix(), strings.ToUpper(uuid.New().String()[:4]))
 
 	// 4. Simpan ke Database (Master Order)
 	o, err := qtx.CreateOrder(ctx, dbgen.CreateOrderParams{
-		OrderNumber:     orderNumber,
-		UserID:          uid,
-		Status:          "PENDING",
-		AddressSnapshot: json.RawMessage(`{"address_id":"` + req.AddressID + `"}`),
-		TotalPrice:      fmt.Sprintf("%.2f", total),
-		Note:            dbgen.ToText(req.Note),
+		OrderNumber:      orderNumber,
+		UserID:           uid,
+		Status:           "PENDING",
+		AddressSnapshot:  json.RawMessage(addressSnapshot),
+		SubtotalPrice:    fmt.Sprintf("%.2f", subtotal),
+		DiscountPrice:    fmt.Sprintf("%.2f", applied.Discount),
+		ShippingPrice:    fmt.Sprintf("%.2f", rate.Price),
+		TotalPrice:       fmt.Sprintf("%.2f", total),
+		Note:             dbgen.ToText(req.Note),
+		VoucherCode:      dbgen.ToText(applied.Code),
+		ShippingCourier:  dbgen.ToText(rate.Courier),
+		ShippingService:  dbgen.ToText(rate.Service),
+		TaxPrice:         fmt.Sprintf("%.2f", taxed.Total),
+		PricesIncludeTax: taxed.PricesIncludeTax,
 	})
 	if err != nil {
 		return OrderResponse{}, ErrOrderFailed
 	}
 
 	// 5. Simpan Order Items secara loop
-	for _, item := range cartData.Items {
+	for i, item := range cartData.Items {
 		pID, _ := uuid.Parse(item.ProductID)
 		err := qtx.CreateOrderItem(ctx, dbgen.CreateOrderItemParams{
 			OrderID:      o.ID,
 			ProductID:    pID,
-			NameSnapshot: "Product Name Placeholder",
-			UnitPrice:    fmt.Sprintf("%.2f", float64(item.Price)),
+			NameSnapshot: item.Name,
+			UnitPrice:    fmt.Sprintf("%.2f", item.CurrentPrice),
 			Quantity:     item.Qty,
-			TotalPrice:   fmt.Sprintf("%.2f", float64(item.Price)*float64(item.Qty)),
+			TotalPrice:   fmt.Sprintf("%.2f", item.CurrentPrice*float64(item.Qty)),
+			TaxRate:      fmt.Sprintf("%.2f", taxed.Lines[i].Rate),
+			TaxAmount:    fmt.Sprintf("%.2f", taxed.Lines[i].Amount),
 		})
 		if err != nil {
 			// Mengembalikan error di sini akan memicu defer tx.Rollback()
 			return OrderResponse{}, ErrOrderFailed
 		}
+
+		// Kurangi stok (gagal jika stok tidak cukup -> seluruh order di-rollback)
+		if _, err := s.inventorySvc.Record(ctx, tx, inventory.Movement{
+			ProductID: pID,
+			Delta:     -item.Qty,
+			Reason:    constants.StockReasonPurchase,
+			OrderID:   uuid.NullUUID{UUID: o.ID, Valid: true},
+			ActorID:   uuid.NullUUID{UUID: uid, Valid: true},
+		}); err != nil {
+			return OrderResponse{}, err
+		}
 	}
 
-	// 6. Kosongkan Cart
+	// 6. Catat pemakaian voucher di transaksi yang sama (kuota tidak bisa terlewati)
+	if applied.VoucherID != uuid.Nil {
+		if err := s.voucherSvc.Redeem(ctx, tx, uid, o.ID, applied); err != nil {
+			return OrderResponse{}, err
+		}
+	}
+
+	// 7. Kosongkan Cart
 	// Jika cart service menggunakan database yang sama, gunakan qtx
 	// Jika cart service adalah service terpisah (microservice), pastikan s.cartSvc.Delete mendukung context
-	err = s.cartSvc.Delete(ctx, req.UserID)
+	err = s.cartSvc.Delete(ctx, cart.UserOwner(req.UserID))
 	if err != nil {
 		return OrderResponse{}, fmt.Errorf("failed to clear cart: %w", err)
 	}
 
-	// 7. COMMIT: Simpan semua perubahan secara permanen
+	// 8. COMMIT: Simpan semua perubahan secara permanen
 	if err := tx.Commit(); err != nil {
 		return OrderResponse{}, ErrOrderFailed
 	}
@@ -125,11 +230,12 @@ func (s *service) Checkout(ctx context.Context, req CheckoutRequest) (OrderRespo
 	return s.mapOrderToResponse(o, nil), nil
 }
 
-// CUSTOMER & ADMIN: List
-func (s *service) List(ctx context.Context, userID string, page, limit int) ([]OrderResponse, int64, error) {
+// CUSTOMER: List (status kosong = semua status)
+func (s *service) List(ctx context.Context, userID string, status string, page, limit int) ([]OrderResponse, int64, error) {
 	uid, _ := uuid.Parse(userID)
 	rows, err := s.repo.List(ctx, dbgen.ListOrdersParams{
 		UserID: uid,
+		Status: dbgen.ToText(strings.ToUpper(status)),
 		Limit:  int32(limit),
 		Offset: int32((page - 1) * limit),
 	})
@@ -187,70 +293,236 @@ func (s *service) ListAdmin(ctx context.Context, status string, search string, p
 	return res, total, nil
 }
 
-// CUSTOMER & ADMIN: Detail
-func (s *service) Detail(ctx context.Context, orderID string) (OrderResponse, error) {
-	oid, err := uuid.Parse(orderID)
+// orderCursorSort: riwayat order selalu terbaru dulu (placed_at DESC, id DESC)
+const orderCursorSort = "placed_at:desc"
+
+func (s *service) ListByCursor(ctx context.Context, userID string, status string, token string, limit int) ([]OrderResponse, cursor.Page, error) {
+	uid, _ := uuid.Parse(userID)
+	if limit < 1 {
+		limit = 10
+	}
+
+	cur, err := cursor.Parse(token, orderCursorSort, 2)
 	if err != nil {
-		return OrderResponse{}, ErrInvalidOrderID
+		return nil, cursor.Page{}, err
 	}
 
-	o, err := s.repo.GetByID(ctx, oid)
+	params := dbgen.ListOrdersKeysetParams{
+		Limit:   int32(limit + 1),
+		UserID:  uid,
+		Status:  dbgen.ToText(strings.ToUpper(status)),
+		SortDir: "desc",
+	}
+	if cur != nil {
+		if params.CursorPlacedAt, params.CursorID, err = parseOrderCursor(cur.Values); err != nil {
+			return nil, cursor.Page{}, err
+		}
+		// Halaman sebelumnya: ambil arah sebaliknya lalu dibalik di Window
+		if cur.Backward {
+			params.SortDir = "asc"
+		}
+	}
+
+	rows, err := s.repo.ListKeyset(ctx, params)
 	if err != nil {
-		return OrderResponse{}, ErrOrderNotFound
+		return nil, cursor.Page{}, err
+	}
+
+	rows, page := cursor.Window(rows, limit, cur, orderCursorSort, orderCursorKey)
+	res := make([]OrderResponse, 0, len(rows))
+	for _, r := range rows {
+		res = append(res, s.mapOrderToResponse(r, nil))
+	}
+	return res, page, nil
+}
+
+func (s *service) ListAdminByCursor(ctx context.Context, status string, search string, token string, limit int) ([]OrderResponse, cursor.Page, error) {
+	if limit < 1 {
+		limit = 10
+	}
+
+	cur, err := cursor.Parse(token, orderCursorSort, 2)
+	if err != nil {
+		return nil, cursor.Page{}, err
+	}
+
+	params := dbgen.ListOrdersAdminKeysetParams{
+		Limit:   int32(limit + 1),
+		Status:  dbgen.ToText(status),
+		Search:  dbgen.ToText(search),
+		SortDir: "desc",
+	}
+	if cur != nil {
+		if params.CursorPlacedAt, params.CursorID, err = parseOrderCursor(cur.Values); err != nil {
+			return nil, cursor.Page{}, err
+		}
+		if cur.Backward {
+			params.SortDir = "asc"
+		}
+	}
+
+	rows, err := s.repo.ListAdminKeyset(ctx, params)
+	if err != nil {
+		return nil, cursor.Page{}, err
+	}
+
+	rows, page := cursor.Window(rows, limit, cur, orderCursorSort, orderCursorKey)
+	res := make([]OrderResponse, 0, len(rows))
+	for _, r := range rows {
+		res = append(res, s.mapOrderToResponse(r, nil))
+	}
+	return res, page, nil
+}
+
+func orderCursorKey(o dbgen.Order) []string {
+	return []string{o.PlacedAt.Format(time.RFC3339Nano), o.ID.String()}
+}
+
+func parseOrderCursor(values []string) (sql.NullTime, uuid.NullUUID, error) {
+	placedAt, err := time.Parse(time.RFC3339Nano, values[0])
+	if err != nil {
+		return sql.NullTime{}, uuid.NullUUID{}, cursor.ErrInvalidCursor
+	}
+	id, err := uuid.Parse(values[1])
+	if err != nil {
+		return sql.NullTime{}, uuid.NullUUID{}, cursor.ErrInvalidCursor
+	}
+	return sql.NullTime{Time: placedAt, Valid: true}, uuid.NullUUID{UUID: id, Valid: true}, nil
+}
+
+// CUSTOMER: Detail (hanya order miliknya)
+func (s *service) Detail(ctx context.Context, userID string, orderID string) (OrderResponse, error) {
+	o, err := s.getOwned(ctx, userID, orderID)
+	if err != nil {
+		return OrderResponse{}, err
+	}
+	return s.detail(ctx, o)
+}
+
+// ADMIN: Detail order siapa pun
+func (s *service) DetailAdmin(ctx context.Context, orderID string) (OrderResponse, error) {
+	o, err := s.getOrder(ctx, orderID)
+	if err != nil {
+		return OrderResponse{}, err
 	}
+	return s.detail(ctx, o)
+}
 
-	// Disarankan menangani error GetItems juga
-	items, err := s.repo.GetItems(ctx, oid)
+func (s *service) detail(ctx context.Context, o dbgen.Order) (OrderResponse, error) {
+	items, err := s.repo.GetItems(ctx, o.ID)
 	if err != nil {
-		// Tergantung kebutuhan bisnis, bisa return error atau biarkan items kosong
 		return OrderResponse{}, err
 	}
 
 	return s.mapOrderToResponse(o, items), nil
 }
 
-// CUSTOMER: Cancel
-// CUSTOMER: Cancel
-func (s *service) Cancel(ctx context.Context, orderID string) error {
-	oid, err := uuid.Parse(orderID)
+// CUSTOMER: Cancel (hanya order miliknya)
+func (s *service) Cancel(ctx context.Context, userID string, orderID string) error {
+	o, err := s.getOwned(ctx, userID, orderID)
 	if err != nil {
-		return ErrInvalidOrderID // Pastikan error ini ada di order_errors.go
+		return err
 	}
+	return s.cancel(ctx, o, uuid.NullUUID{UUID: o.UserID, Valid: true})
+}
 
-	// 1. Ambil data order (Bisa di luar transaksi untuk cek awal)
-	o, err := s.repo.GetByID(ctx, oid)
+// ADMIN: Cancel order siapa pun, admin tercatat sebagai aktor di ledger stok
+func (s *service) CancelAdmin(ctx context.Context, adminID string, orderID string) error {
+	o, err := s.getOrder(ctx, orderID)
 	if err != nil {
 		return err
 	}
+	actor := uuid.NullUUID{}
+	if aid, err := uuid.Parse(adminID); err == nil {
+		actor = uuid.NullUUID{UUID: aid, Valid: true}
+	}
+	return s.cancel(ctx, o, actor)
+}
 
-	// 2. Validasi status
+func (s *service) cancel(ctx context.Context, o dbgen.Order, actor uuid.NullUUID) error {
+	// 1. Validasi status
 	if o.Status != "PENDING" {
 		return ErrCannotCancel
 	}
 
-	// 3. Mulai Transaksi
+	// 2. Mulai Transaksi
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
 	defer tx.Rollback()
 
-	// 4. Gunakan WithTx
+	// 3. Gunakan WithTx
 	qtx := s.repo.WithTx(tx)
 
-	// 5. Update Status melalui qtx
-	_, err = qtx.UpdateStatus(ctx, oid, "CANCELLED")
-	if err != nil {
+	// 4. Guard status di query: cancel ganda, order yang keburu expire / dibayar ditolak
+	if _, err := qtx.Cancel(ctx, o.ID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrCannotCancel
+		}
 		return err
 	}
 
-	// Jika ke depannya ada logika kembalikan stok:
-	// err = s.productSvc.RestoreStock(ctx, o.Items)
-	// if err != nil { return err }
+	// 5. Kembalikan stok & kuota voucher (hanya jika baris benar-benar berubah)
+	if err := s.releaseOrder(ctx, tx, qtx, o.ID, actor); err != nil {
+		return err
+	}
 
 	return tx.Commit()
 }
 
+// getOrder ambil order berdasarkan ID tanpa cek pemilik
+func (s *service) getOrder(ctx context.Context, orderID string) (dbgen.Order, error) {
+	oid, err := uuid.Parse(orderID)
+	if err != nil {
+		return dbgen.Order{}, ErrInvalidOrderID
+	}
+
+	o, err := s.repo.GetByID(ctx, oid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dbgen.Order{}, ErrOrderNotFound
+		}
+		return dbgen.Order{}, err
+	}
+	return o, nil
+}
+
+// getOwned sama dengan getOrder, tetapi order milik user lain dianggap tidak ada
+// (tidak membocorkan keberadaan order lewat 403)
+func (s *service) getOwned(ctx context.Context, userID string, orderID string) (dbgen.Order, error) {
+	o, err := s.getOrder(ctx, orderID)
+	if err != nil {
+		return dbgen.Order{}, err
+	}
+	if o.UserID.String() != userID {
+		return dbgen.Order{}, ErrOrderNotFound
+	}
+	return o, nil
+}
+
+// releaseOrder kembalikan stok setiap item ke ledger dan kuota voucher (jika ada).
+// actor kosong = dibatalkan oleh sistem.
+func (s *service) releaseOrder(ctx context.Context, tx *sql.Tx, qtx Repository, oid uuid.UUID, actor uuid.NullUUID) error {
+	items, err := qtx.GetItems(ctx, oid)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		if _, err := s.inventorySvc.Record(ctx, tx, inventory.Movement{
+			ProductID: item.ProductID,
+			Delta:     item.Quantity,
+			Reason:    constants.StockReasonCancel,
+			OrderID:   uuid.NullUUID{UUID: oid, Valid: true},
+			ActorID:   actor,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return s.voucherSvc.Release(ctx, tx, oid)
+}
+
 // // CUSTOMER: Update (DELIVERED -> COMPLETED)
 // func (s *service) UpdateStatus(ctx context.Context, orderID string, status string) (OrderResponse, error) {
 // 	oid, err := uuid.Parse(orderID)
@@ -319,7 +591,7 @@ func (s *service) UpdateStatusByAdmin(ctx context.Context, orderID string, nextS
 		if order.Status != "PROCESSING" {
 			return OrderResponse{}, ErrInvalidStatusTransition
 		}
-		if receiptNo == nil || *receiptNo == "" {
+		if receiptNo == nil || strings.TrimSpace(*receiptNo) == "" {
 			return OrderResponse{}, ErrReceiptRequired
 		}
 	default:
@@ -333,6 +605,17 @@ func (s *service) UpdateStatusByAdmin(ctx context.Context, orderID string, nextS
 		return OrderResponse{}, ErrOrderFailed
 	}
 
+	// Simpan resi di order & buat record shipment untuk tracking
+	if nextStatus == "SHIPPED" {
+		receipt := strings.TrimSpace(*receiptNo)
+		if o, err = qtx.SetReceiptNo(ctx, oid, receipt); err != nil {
+			return OrderResponse{}, ErrOrderFailed
+		}
+		if _, err := s.shipmentSvc.Create(ctx, tx, o, receipt); err != nil {
+			return OrderResponse{}, err
+		}
+	}
+
 	if err := tx.Commit(); err != nil {
 		return OrderResponse{}, ErrOrderFailed
 	}
@@ -344,7 +627,7 @@ func (s *service) UpdateStatusByAdmin(ctx context.Context, orderID string, nextS
 func (s *service) UpdateStatusByCustomer(ctx context.Context, orderID string, userID uuid.UUID, nextStatus string) (OrderResponse, error) {
 	oid, err := uuid.Parse(orderID)
 	if err != nil {
-		return OrderResponse{}, fmt.Errorf("invalid order id")
+		return OrderResponse{}, ErrInvalidOrderID
 	}
 
 	tx, err := s.db.BeginTx(ctx, nil)
@@ -356,15 +639,26 @@ func (s *service) UpdateStatusByCustomer(ctx context.Context, orderID string, us
 	qtx := s.repo.WithTx(tx)
 	order, err := qtx.GetByID(ctx, oid)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return OrderResponse{}, ErrOrderNotFound
+		}
 		return OrderResponse{}, err
 	}
 
 	if order.UserID != userID {
-		return OrderResponse{}, auth.ErrUnauthorized
+		return OrderResponse{}, ErrOrderNotFound
 	}
 
-	o, err := qtx.UpdateStatus(ctx, oid, nextStatus)
+	// Customer hanya boleh DELIVERED -> COMPLETED, guard status ada di query
+	if nextStatus != "COMPLETED" {
+		return OrderResponse{}, ErrInvalidStatusTransition
+	}
+
+	o, err := qtx.Complete(ctx, oid)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return OrderResponse{}, ErrInvalidStatusTransition
+		}
 		return OrderResponse{}, err
 	}
 
@@ -375,24 +669,119 @@ func (s *service) UpdateStatusByCustomer(ctx context.Context, orderID string, us
 	return s.mapOrderToResponse(o, nil), nil
 }
 
+// SYSTEM: batalkan order PENDING yang belum dibayar sebelum cutoff.
+// Tiap order diproses di transaksi sendiri agar satu kegagalan tidak membatalkan batch.
+func (s *service) ExpireUnpaid(ctx context.Context, cutoff time.Time, limit int32) ([]OrderResponse, error) {
+	ids, err := s.repo.ListExpiredPendingIDs(ctx, cutoff, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		expired []OrderResponse
+		errs    []error
+	)
+	for _, id := range ids {
+		o, ok, err := s.expireOne(ctx, id)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("expire order %s: %w", id, err))
+			continue
+		}
+		if ok {
+			expired = append(expired, s.mapOrderToResponse(o, nil))
+		}
+	}
+
+	return expired, errors.Join(errs...)
+}
+
+func (s *service) expireOne(ctx context.Context, oid uuid.UUID) (dbgen.Order, bool, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return dbgen.Order{}, false, err
+	}
+	defer tx.Rollback()
+
+	qtx := s.repo.WithTx(tx)
+
+	// Guard status di query: order yang keburu dibayar dilewati
+	o, err := qtx.Expire(ctx, oid, constants.OrderCancelReasonExpired)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dbgen.Order{}, false, nil
+		}
+		return dbgen.Order{}, false, err
+	}
+
+	if err := s.releaseOrder(ctx, tx, qtx, oid, uuid.NullUUID{}); err != nil {
+		return dbgen.Order{}, false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return dbgen.Order{}, false, err
+	}
+	return o, true, nil
+}
+
+// SYSTEM: selesaikan order DELIVERED yang diterima sebelum cutoff
+func (s *service) AutoComplete(ctx context.Context, cutoff time.Time, limit int32) ([]OrderResponse, error) {
+	orders, err := s.repo.CompleteDelivered(ctx, cutoff, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]OrderResponse, 0, len(orders))
+	for _, o := range orders {
+		res = append(res, s.mapOrderToResponse(o, nil))
+	}
+	return res, nil
+}
+
 // Helper Mapper
 func (s *service) mapOrderToResponse(o dbgen.Order, items []dbgen.OrderItem) OrderResponse {
+	subtotal, _ := strconv.ParseFloat(o.SubtotalPrice, 64)
+	discount, _ := strconv.ParseFloat(o.DiscountPrice, 64)
+	shippingPrice, _ := strconv.ParseFloat(o.ShippingPrice, 64)
 	total, _ := strconv.ParseFloat(o.TotalPrice, 64)
+	refunded, _ := strconv.ParseFloat(o.RefundedAmount, 64)
+	taxPrice, _ := strconv.ParseFloat(o.TaxPrice, 64)
 	res := OrderResponse{
-		ID:          o.ID.String(),
-		OrderNumber: o.OrderNumber,
-		Status:      o.Status,
-		TotalPrice:  total,
-		PlacedAt:    o.PlacedAt,
+		ID:               o.ID.String(),
+		OrderNumber:      o.OrderNumber,
+		Status:           o.Status,
+		PaymentStatus:    o.PaymentStatus,
+		SubtotalPrice:    subtotal,
+		DiscountPrice:    discount,
+		ShippingPrice:    shippingPrice,
+		ShippingCourier:  o.ShippingCourier.String,
+		ShippingService:  o.ShippingService.String,
+		TaxPrice:         taxPrice,
+		PricesIncludeTax: o.PricesIncludeTax,
+		TotalPrice:       total,
+		RefundedAmount:   refunded,
+		PlacedAt:         o.PlacedAt,
+	}
+	if o.VoucherCode.Valid {
+		code := o.VoucherCode.String
+		res.VoucherCode = &code
+	}
+	if o.ReceiptNo.Valid {
+		receipt := o.ReceiptNo.String
+		res.ReceiptNo = &receipt
 	}
 
 	for _, item := range items {
 		uPrice, _ := strconv.ParseFloat(item.UnitPrice, 64)
+		taxRate, _ := strconv.ParseFloat(item.TaxRate, 64)
+		taxAmount, _ := strconv.ParseFloat(item.TaxAmount, 64)
 		res.Items = append(res.Items, OrderItemResponse{
+			ID:           item.ID.String(),
 			ProductID:    item.ProductID.String(),
 			NameSnapshot: item.NameSnapshot,
 			UnitPrice:    uPrice,
 			Quantity:     item.Quantity,
+			TaxRate:      taxRate,
+			TaxAmount:    taxAmount,
 		})
 	}
 	return res
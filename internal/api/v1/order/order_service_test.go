@@ -3,13 +3,29 @@ package order_test
 import (
 	"context"
 	"database/sql"
-	"go-sqlc-starter/internal/api/v1/auth"
+	"encoding/json"
 	"go-sqlc-starter/internal/api/v1/cart"
+	"go-sqlc-starter/internal/api/v1/inventory"
+	inventoryerrors "go-sqlc-starter/internal/api/v1/inventory/errors"
+	addressMock "go-sqlc-starter/internal/api/v1/mock/address"
 	cartMock "go-sqlc-starter/internal/api/v1/mock/cart"
+	inventoryMock "go-sqlc-starter/internal/api/v1/mock/inventory"
 	orderMock "go-sqlc-starter/internal/api/v1/mock/order"
+	shipmentMock "go-sqlc-starter/internal/api/v1/mock/shipment"
+	shippingMock "go-sqlc-starter/internal/api/v1/mock/shipping"
+	taxMock "go-sqlc-starter/internal/api/v1/mock/tax"
+	voucherMock "go-sqlc-starter/internal/api/v1/mock/voucher"
 	"go-sqlc-starter/internal/api/v1/order"
+	"go-sqlc-starter/internal/api/v1/shipping"
+	shippingerrors "go-sqlc-starter/internal/api/v1/shipping/errors"
+	"go-sqlc-starter/internal/api/v1/tax"
+	taxerrors "go-sqlc-starter/internal/api/v1/tax/errors"
+	"go-sqlc-starter/internal/api/v1/voucher"
+	vouchererrors "go-sqlc-starter/internal/api/v1/voucher/errors"
 	"go-sqlc-starter/internal/dbgen"
+	"go-sqlc-starter/internal/pkg/constants"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/golang/mock/gomock"
@@ -29,15 +45,22 @@ func TestOrderService_Checkout(t *testing.T) {
 
 	orderRepo := orderMock.NewMockRepository(ctrl)
 	cartSvc := cartMock.NewMockService(ctrl)
+	inventorySvc := inventoryMock.NewMockService(ctrl)
+	voucherSvc := voucherMock.NewMockService(ctrl)
+	shippingSvc := shippingMock.NewMockService(ctrl)
+	shipmentSvc := shipmentMock.NewMockService(ctrl)
+	taxSvc := taxMock.NewMockService(ctrl)
+	addressRepo := addressMock.NewMockRepository(ctrl)
 
 	// Sekarang menyertakan DB untuk keperluan transaksi
-	svc := order.NewService(db, orderRepo, cartSvc)
+	svc := order.NewService(db, orderRepo, cartSvc, inventorySvc, voucherSvc, shippingSvc, shipmentSvc, taxSvc, addressRepo)
 	ctx := context.Background()
 
 	t.Run("success_checkout", func(t *testing.T) {
 		userID := uuid.New()
 		productID := uuid.New()
 		orderID := uuid.New()
+		addressID := uuid.New()
 
 		// --- SQL Mock Expectations ---
 		mock.ExpectBegin()
@@ -48,43 +71,219 @@ func TestOrderService_Checkout(t *testing.T) {
 		orderRepo.EXPECT().WithTx(gomock.Any()).Return(orderRepo).AnyTimes()
 
 		cartSvc.EXPECT().
-			Detail(gomock.Any(), userID.String()).
+			Detail(gomock.Any(), cart.UserOwner(userID.String())).
 			Return(cart.CartDetailResponse{
 				Items: []cart.CartItemDetailResponse{
 					{
 						ProductID: productID.String(),
 						Qty:       2,
-						Price:     5000,
+						// price_at_add lama; yang ditagih harga sale aktif saat checkout
+						Price:        6000,
+						CurrentPrice: 5000,
 					},
 				},
 			}, nil)
 
+		// Ongkir dihitung ulang dari alamat & kurir terpilih
+		shippingSvc.EXPECT().
+			Select(gomock.Any(), userID.String(), addressID.String(), gomock.Any(), "JNE", "REG").
+			Return(shipping.Rate{Courier: "JNE", Service: "REG", Price: 9000}, nil)
+
+		// Alamat disalin utuh ke snapshot order
+		addressRepo.EXPECT().
+			GetByID(gomock.Any(), addressID, userID).
+			Return(dbgen.Address{
+				ID:             addressID,
+				RecipientName:  "Budi",
+				RecipientPhone: "08123",
+				Street:         "Jl. Merdeka 1",
+				City:           sql.NullString{String: "Bandung", Valid: true},
+				PostalCode:     sql.NullString{String: "40111", Valid: true},
+			}, nil)
+
+		// PPN 11% eksklusif ditambahkan ke total
+		taxSvc.EXPECT().
+			Calculate(gomock.Any(), []tax.Line{{ProductID: productID, Amount: 10000}}, 0.0).
+			Return(tax.Breakdown{
+				Lines: []tax.LineTax{{ProductID: productID, TaxClass: "STANDARD", Rate: 11, Amount: 1100}},
+				Total: 1100,
+			}, nil)
+
 		orderRepo.EXPECT().
 			CreateOrder(gomock.Any(), gomock.Any()).
-			Return(dbgen.Order{
-				ID:          orderID,
-				OrderNumber: "ORD-123",
-				UserID:      userID,
-				Status:      "PENDING",
-				TotalPrice:  "10000.00",
-			}, nil)
+			DoAndReturn(func(_ context.Context, arg dbgen.CreateOrderParams) (dbgen.Order, error) {
+				assert.Equal(t, "10000.00", arg.SubtotalPrice)
+				assert.Equal(t, "9000.00", arg.ShippingPrice)
+				assert.Equal(t, "1100.00", arg.TaxPrice)
+				assert.False(t, arg.PricesIncludeTax)
+				assert.Equal(t, "20100.00", arg.TotalPrice)
+				assert.Equal(t, "JNE", arg.ShippingCourier.String)
+				assert.Equal(t, "REG", arg.ShippingService.String)
+
+				var snapshot map[string]string
+				assert.NoError(t, json.Unmarshal(arg.AddressSnapshot, &snapshot))
+				assert.Equal(t, addressID.String(), snapshot["address_id"])
+				assert.Equal(t, "Budi", snapshot["recipient_name"])
+				assert.Equal(t, "08123", snapshot["recipient_phone"])
+				assert.Equal(t, "Jl. Merdeka 1", snapshot["street"])
+				assert.Equal(t, "Bandung", snapshot["city"])
+				assert.Equal(t, "40111", snapshot["postal_code"])
+				return dbgen.Order{
+					ID:          orderID,
+					OrderNumber: "ORD-123",
+					UserID:      userID,
+					Status:      "PENDING",
+					TotalPrice:  arg.TotalPrice,
+				}, nil
+			})
 
 		orderRepo.EXPECT().
 			CreateOrderItem(gomock.Any(), gomock.Any()).
-			Return(nil)
+			DoAndReturn(func(_ context.Context, arg dbgen.CreateOrderItemParams) error {
+				assert.Equal(t, "5000.00", arg.UnitPrice)
+				assert.Equal(t, "10000.00", arg.TotalPrice)
+				assert.Equal(t, "11.00", arg.TaxRate)
+				assert.Equal(t, "1100.00", arg.TaxAmount)
+				return nil
+			})
+
+		// Stok berkurang sesuai qty, tercatat ke order
+		inventorySvc.EXPECT().
+			Record(gomock.Any(), gomock.Any(), inventory.Movement{
+				ProductID: productID,
+				Delta:     -2,
+				Reason:    constants.StockReasonPurchase,
+				OrderID:   uuid.NullUUID{UUID: orderID, Valid: true},
+				ActorID:   uuid.NullUUID{UUID: userID, Valid: true},
+			}).
+			Return(dbgen.StockMovement{}, nil)
 
 		cartSvc.EXPECT().
-			Delete(gomock.Any(), userID.String()).
+			Delete(gomock.Any(), cart.UserOwner(userID.String())).
 			Return(nil)
 
 		// Execute
 		res, err := svc.Checkout(ctx, order.CheckoutRequest{
 			UserID:    userID.String(),
-			AddressID: "addr-1",
+			AddressID: addressID.String(),
+			Courier:   "JNE",
+			Service:   "REG",
 		})
 
 		assert.NoError(t, err)
 		assert.Equal(t, "ORD-123", res.OrderNumber)
+		assert.Equal(t, 20100.0, res.TotalPrice)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success_checkout_with_voucher", func(t *testing.T) {
+		userID := uuid.New()
+		productID := uuid.New()
+		orderID := uuid.New()
+		voucherID := uuid.New()
+
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		orderRepo.EXPECT().WithTx(gomock.Any()).Return(orderRepo).AnyTimes()
+		cartSvc.EXPECT().
+			Detail(gomock.Any(), cart.UserOwner(userID.String())).
+			Return(cart.CartDetailResponse{
+				Items: []cart.CartItemDetailResponse{{ProductID: productID.String(), Qty: 2, CurrentPrice: 5000}},
+			}, nil)
+
+		shippingSvc.EXPECT().
+			Select(gomock.Any(), userID.String(), "addr-1", gomock.Any(), "", "").
+			Return(shipping.Rate{}, nil)
+		addressRepo.EXPECT().GetByID(gomock.Any(), gomock.Any(), userID).Return(dbgen.Address{}, nil)
+
+		applied := voucher.Application{
+			VoucherID:        voucherID,
+			Code:             "HEMAT10",
+			Subtotal:         10000,
+			EligibleSubtotal: 10000,
+			Discount:         1000,
+		}
+		voucherSvc.EXPECT().
+			Apply(gomock.Any(), gomock.Any(), userID, "hemat10", gomock.Any()).
+			Return(applied, nil)
+
+		// Mode harga termasuk pajak: pajak dihitung dari harga setelah diskon, total tidak bertambah
+		taxSvc.EXPECT().
+			Calculate(gomock.Any(), gomock.Any(), 1000.0).
+			Return(tax.Breakdown{
+				PricesIncludeTax: true,
+				Lines:            []tax.LineTax{{ProductID: productID, Rate: 11, Amount: 891.89}},
+				Total:            891.89,
+			}, nil)
+
+		// Diskon tersimpan di order
+		orderRepo.EXPECT().
+			CreateOrder(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, arg dbgen.CreateOrderParams) (dbgen.Order, error) {
+				assert.Equal(t, "10000.00", arg.SubtotalPrice)
+				assert.Equal(t, "1000.00", arg.DiscountPrice)
+				assert.Equal(t, "891.89", arg.TaxPrice)
+				assert.True(t, arg.PricesIncludeTax)
+				assert.Equal(t, "9000.00", arg.TotalPrice)
+				assert.Equal(t, "HEMAT10", arg.VoucherCode.String)
+				return dbgen.Order{
+					ID:            orderID,
+					UserID:        userID,
+					Status:        "PENDING",
+					SubtotalPrice: arg.SubtotalPrice,
+					DiscountPrice: arg.DiscountPrice,
+					TotalPrice:    arg.TotalPrice,
+					VoucherCode:   arg.VoucherCode,
+				}, nil
+			})
+		orderRepo.EXPECT().CreateOrderItem(gomock.Any(), gomock.Any()).Return(nil)
+		inventorySvc.EXPECT().
+			Record(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(dbgen.StockMovement{}, nil)
+
+		// Pemakaian voucher dicatat di transaksi yang sama
+		voucherSvc.EXPECT().
+			Redeem(gomock.Any(), gomock.Any(), userID, orderID, applied).
+			Return(nil)
+		cartSvc.EXPECT().Delete(gomock.Any(), cart.UserOwner(userID.String())).Return(nil)
+
+		res, err := svc.Checkout(ctx, order.CheckoutRequest{
+			UserID:      userID.String(),
+			AddressID:   "addr-1",
+			VoucherCode: "hemat10",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1000.0, res.DiscountPrice)
+		assert.Equal(t, 9000.0, res.TotalPrice)
+		assert.Equal(t, "HEMAT10", *res.VoucherCode)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error_voucher_usage_limit_should_rollback", func(t *testing.T) {
+		userID := uuid.New()
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		orderRepo.EXPECT().WithTx(gomock.Any()).Return(orderRepo).AnyTimes()
+		cartSvc.EXPECT().
+			Detail(gomock.Any(), cart.UserOwner(userID.String())).
+			Return(cart.CartDetailResponse{
+				Items: []cart.CartItemDetailResponse{{ProductID: uuid.New().String(), Qty: 1, CurrentPrice: 1000}},
+			}, nil)
+		shippingSvc.EXPECT().
+			Select(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(shipping.Rate{}, nil)
+		addressRepo.EXPECT().GetByID(gomock.Any(), gomock.Any(), userID).Return(dbgen.Address{}, nil)
+		voucherSvc.EXPECT().
+			Apply(gomock.Any(), gomock.Any(), userID, "HABIS", gomock.Any()).
+			Return(voucher.Application{}, vouchererrors.ErrUsageLimitReached)
+
+		_, err := svc.Checkout(ctx, order.CheckoutRequest{UserID: userID.String(), VoucherCode: "HABIS"})
+
+		assert.ErrorIs(t, err, vouchererrors.ErrUsageLimitReached)
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 
@@ -98,10 +297,18 @@ func TestOrderService_Checkout(t *testing.T) {
 		orderRepo.EXPECT().WithTx(gomock.Any()).Return(orderRepo).AnyTimes()
 
 		cartSvc.EXPECT().
-			Detail(gomock.Any(), userID.String()).
+			Detail(gomock.Any(), cart.UserOwner(userID.String())).
 			Return(cart.CartDetailResponse{
-				Items: []cart.CartItemDetailResponse{{ProductID: uuid.New().String(), Qty: 1, Price: 1000}},
+				Items: []cart.CartItemDetailResponse{{ProductID: uuid.New().String(), Qty: 1, CurrentPrice: 1000}},
 			}, nil)
+		shippingSvc.EXPECT().
+			Select(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(shipping.Rate{}, nil)
+		addressRepo.EXPECT().GetByID(gomock.Any(), gomock.Any(), userID).Return(dbgen.Address{}, nil)
+
+		taxSvc.EXPECT().
+			Calculate(gomock.Any(), gomock.Any(), 0.0).
+			Return(tax.Breakdown{Lines: []tax.LineTax{{}}}, nil)
 
 		// Simulate error in DB
 		orderRepo.EXPECT().
@@ -116,12 +323,131 @@ func TestOrderService_Checkout(t *testing.T) {
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 
+	t.Run("error_insufficient_stock_should_rollback", func(t *testing.T) {
+		userID := uuid.New()
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		orderRepo.EXPECT().WithTx(gomock.Any()).Return(orderRepo).AnyTimes()
+		cartSvc.EXPECT().
+			Detail(gomock.Any(), cart.UserOwner(userID.String())).
+			Return(cart.CartDetailResponse{
+				Items: []cart.CartItemDetailResponse{{ProductID: uuid.New().String(), Qty: 5, CurrentPrice: 1000}},
+			}, nil)
+		shippingSvc.EXPECT().
+			Select(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(shipping.Rate{}, nil)
+		addressRepo.EXPECT().GetByID(gomock.Any(), gomock.Any(), userID).Return(dbgen.Address{}, nil)
+		taxSvc.EXPECT().
+			Calculate(gomock.Any(), gomock.Any(), 0.0).
+			Return(tax.Breakdown{Lines: []tax.LineTax{{}}}, nil)
+		orderRepo.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Return(dbgen.Order{ID: uuid.New()}, nil)
+		orderRepo.EXPECT().CreateOrderItem(gomock.Any(), gomock.Any()).Return(nil)
+		inventorySvc.EXPECT().
+			Record(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(dbgen.StockMovement{}, inventoryerrors.ErrInsufficientStock)
+
+		_, err := svc.Checkout(ctx, order.CheckoutRequest{UserID: userID.String()})
+
+		assert.ErrorIs(t, err, inventoryerrors.ErrInsufficientStock)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error_tax_rate_missing_should_rollback", func(t *testing.T) {
+		userID := uuid.New()
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		orderRepo.EXPECT().WithTx(gomock.Any()).Return(orderRepo).AnyTimes()
+		cartSvc.EXPECT().
+			Detail(gomock.Any(), cart.UserOwner(userID.String())).
+			Return(cart.CartDetailResponse{
+				Items: []cart.CartItemDetailResponse{{ProductID: uuid.New().String(), Qty: 1, CurrentPrice: 1000}},
+			}, nil)
+		shippingSvc.EXPECT().
+			Select(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(shipping.Rate{}, nil)
+		addressRepo.EXPECT().GetByID(gomock.Any(), gomock.Any(), userID).Return(dbgen.Address{}, nil)
+		taxSvc.EXPECT().
+			Calculate(gomock.Any(), gomock.Any(), 0.0).
+			Return(tax.Breakdown{}, taxerrors.ErrTaxRateNotFound)
+
+		_, err := svc.Checkout(ctx, order.CheckoutRequest{UserID: userID.String()})
+
+		assert.ErrorIs(t, err, taxerrors.ErrTaxRateNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error_shipping_rate_not_available", func(t *testing.T) {
+		userID := uuid.New()
+
+		// Ongkir divalidasi sebelum transaksi dimulai
+		cartSvc.EXPECT().
+			Detail(gomock.Any(), cart.UserOwner(userID.String())).
+			Return(cart.CartDetailResponse{
+				Items: []cart.CartItemDetailResponse{{ProductID: uuid.New().String(), Qty: 1, CurrentPrice: 1000}},
+			}, nil)
+		shippingSvc.EXPECT().
+			Select(gomock.Any(), userID.String(), "addr-1", gomock.Any(), "JNE", "YES").
+			Return(shipping.Rate{}, shippingerrors.ErrRateNotAvailable)
+
+		_, err := svc.Checkout(ctx, order.CheckoutRequest{
+			UserID:    userID.String(),
+			AddressID: "addr-1",
+			Courier:   "JNE",
+			Service:   "YES",
+		})
+
+		assert.ErrorIs(t, err, shippingerrors.ErrRateNotAvailable)
+	})
+
+	t.Run("error_address_not_found", func(t *testing.T) {
+		userID := uuid.New()
+
+		cartSvc.EXPECT().
+			Detail(gomock.Any(), cart.UserOwner(userID.String())).
+			Return(cart.CartDetailResponse{
+				Items: []cart.CartItemDetailResponse{{ProductID: uuid.New().String(), Qty: 1, CurrentPrice: 1000}},
+			}, nil)
+		shippingSvc.EXPECT().
+			Select(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(shipping.Rate{Price: 9000}, nil)
+		addressRepo.EXPECT().
+			GetByID(gomock.Any(), gomock.Any(), userID).
+			Return(dbgen.Address{}, sql.ErrNoRows)
+
+		_, err := svc.Checkout(ctx, order.CheckoutRequest{UserID: userID.String(), AddressID: uuid.New().String()})
+
+		assert.ErrorIs(t, err, shippingerrors.ErrAddressNotFound)
+	})
+
+	t.Run("error_cart_has_unavailable_items", func(t *testing.T) {
+		userID := uuid.New()
+
+		// Ditolak sebelum ongkir & transaksi; harga berubah saja tidak memblokir
+		cartSvc.EXPECT().
+			Detail(gomock.Any(), cart.UserOwner(userID.String())).
+			Return(cart.CartDetailResponse{
+				Items: []cart.CartItemDetailResponse{
+					{ProductID: uuid.New().String(), Qty: 1, Warnings: []cart.CartItemWarning{{Code: constants.CartWarningPriceChanged}}},
+					{ProductID: uuid.New().String(), Qty: 1, Warnings: []cart.CartItemWarning{{Code: constants.CartWarningProductInactive}}},
+				},
+				HasWarnings: true,
+			}, nil)
+
+		_, err := svc.Checkout(ctx, order.CheckoutRequest{UserID: userID.String()})
+
+		assert.ErrorIs(t, err, order.ErrCartItemsUnavailable)
+	})
+
 	t.Run("error_cart_empty", func(t *testing.T) {
 		userID := uuid.New()
 
 		// Tidak ada mock.ExpectBegin karena fungsi return sebelum transaksi mulai
 		cartSvc.EXPECT().
-			Detail(gomock.Any(), userID.String()).
+			Detail(gomock.Any(), cart.UserOwner(userID.String())).
 			Return(cart.CartDetailResponse{Items: []cart.CartItemDetailResponse{}}, nil)
 
 		_, err := svc.Checkout(ctx, order.CheckoutRequest{UserID: userID.String()})
@@ -139,7 +465,12 @@ func TestOrderService_List(t *testing.T) {
 
 	orderRepo := orderMock.NewMockRepository(ctrl)
 	cartSvc := cartMock.NewMockService(ctrl)
-	svc := order.NewService(db, orderRepo, cartSvc)
+	inventorySvc := inventoryMock.NewMockService(ctrl)
+	voucherSvc := voucherMock.NewMockService(ctrl)
+	shippingSvc := shippingMock.NewMockService(ctrl)
+	shipmentSvc := shipmentMock.NewMockService(ctrl)
+	taxSvc := taxMock.NewMockService(ctrl)
+	svc := order.NewService(db, orderRepo, cartSvc, inventorySvc, voucherSvc, shippingSvc, shipmentSvc, taxSvc, nil)
 	ctx := context.Background()
 
 	t.Run("success_list_orders", func(t *testing.T) {
@@ -153,10 +484,10 @@ func TestOrderService_List(t *testing.T) {
 		}
 
 		orderRepo.EXPECT().
-			List(gomock.Any(), gomock.Any()).
+			List(gomock.Any(), dbgen.ListOrdersParams{UserID: userID, Limit: 10, Offset: 0}).
 			Return(mockRows, nil)
 
-		res, total, err := svc.List(ctx, userID.String(), 1, 10)
+		res, total, err := svc.List(ctx, userID.String(), "", 1, 10)
 
 		assert.NoError(t, err)
 		assert.Equal(t, int64(2), total)
@@ -167,9 +498,27 @@ func TestOrderService_List(t *testing.T) {
 		userID := uuid.New()
 		orderRepo.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, assert.AnError)
 
-		_, _, err := svc.List(ctx, userID.String(), 1, 10)
+		_, _, err := svc.List(ctx, userID.String(), "", 1, 10)
 		assert.Error(t, err)
 	})
+
+	t.Run("success_filter_by_status", func(t *testing.T) {
+		userID := uuid.New()
+		orderRepo.EXPECT().
+			List(gomock.Any(), dbgen.ListOrdersParams{
+				UserID: userID,
+				Status: sql.NullString{String: "PAID", Valid: true},
+				Limit:  10,
+				Offset: 10,
+			}).
+			Return([]dbgen.ListOrdersRow{{ID: uuid.New(), UserID: userID, Status: "PAID", TotalCount: 11}}, nil)
+
+		res, total, err := svc.List(ctx, userID.String(), "paid", 2, 10)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(11), total)
+		assert.Equal(t, "PAID", res[0].Status)
+	})
 }
 
 func TestOrderService_ListAdmin(t *testing.T) {
@@ -181,7 +530,12 @@ func TestOrderService_ListAdmin(t *testing.T) {
 
 	orderRepo := orderMock.NewMockRepository(ctrl)
 	cartSvc := cartMock.NewMockService(ctrl)
-	svc := order.NewService(db, orderRepo, cartSvc)
+	inventorySvc := inventoryMock.NewMockService(ctrl)
+	voucherSvc := voucherMock.NewMockService(ctrl)
+	shippingSvc := shippingMock.NewMockService(ctrl)
+	shipmentSvc := shipmentMock.NewMockService(ctrl)
+	taxSvc := taxMock.NewMockService(ctrl)
+	svc := order.NewService(db, orderRepo, cartSvc, inventorySvc, voucherSvc, shippingSvc, shipmentSvc, taxSvc, nil)
 	ctx := context.Background()
 
 	t.Run("success_list_all_orders", func(t *testing.T) {
@@ -207,15 +561,21 @@ func TestOrderService_Detail(t *testing.T) {
 
 	orderRepo := orderMock.NewMockRepository(ctrl)
 	cartSvc := cartMock.NewMockService(ctrl)
-	svc := order.NewService(db, orderRepo, cartSvc)
+	inventorySvc := inventoryMock.NewMockService(ctrl)
+	voucherSvc := voucherMock.NewMockService(ctrl)
+	shippingSvc := shippingMock.NewMockService(ctrl)
+	shipmentSvc := shipmentMock.NewMockService(ctrl)
+	taxSvc := taxMock.NewMockService(ctrl)
+	svc := order.NewService(db, orderRepo, cartSvc, inventorySvc, voucherSvc, shippingSvc, shipmentSvc, taxSvc, nil)
 	ctx := context.Background()
 
 	t.Run("success_get_detail", func(t *testing.T) {
 		orderID := uuid.New()
-		orderRepo.EXPECT().GetByID(gomock.Any(), orderID).Return(dbgen.Order{ID: orderID, OrderNumber: "ORD-123"}, nil)
+		userID := uuid.New()
+		orderRepo.EXPECT().GetByID(gomock.Any(), orderID).Return(dbgen.Order{ID: orderID, UserID: userID, OrderNumber: "ORD-123"}, nil)
 		orderRepo.EXPECT().GetItems(gomock.Any(), orderID).Return([]dbgen.OrderItem{}, nil)
 
-		res, err := svc.Detail(ctx, orderID.String())
+		res, err := svc.Detail(ctx, userID.String(), orderID.String())
 		assert.NoError(t, err)
 		assert.Equal(t, "ORD-123", res.OrderNumber)
 	})
@@ -224,8 +584,31 @@ func TestOrderService_Detail(t *testing.T) {
 		orderID := uuid.New()
 		orderRepo.EXPECT().GetByID(gomock.Any(), orderID).Return(dbgen.Order{}, sql.ErrNoRows)
 
-		_, err := svc.Detail(ctx, orderID.String())
-		assert.Error(t, err)
+		_, err := svc.Detail(ctx, uuid.New().String(), orderID.String())
+		assert.ErrorIs(t, err, order.ErrOrderNotFound)
+	})
+
+	t.Run("error_other_users_order_is_not_found", func(t *testing.T) {
+		orderID := uuid.New()
+		orderRepo.EXPECT().GetByID(gomock.Any(), orderID).Return(dbgen.Order{ID: orderID, UserID: uuid.New()}, nil)
+
+		_, err := svc.Detail(ctx, uuid.New().String(), orderID.String())
+		assert.ErrorIs(t, err, order.ErrOrderNotFound)
+	})
+
+	t.Run("error_invalid_order_id", func(t *testing.T) {
+		_, err := svc.Detail(ctx, uuid.New().String(), "not-a-uuid")
+		assert.ErrorIs(t, err, order.ErrInvalidOrderID)
+	})
+
+	t.Run("admin_reads_any_order", func(t *testing.T) {
+		orderID := uuid.New()
+		orderRepo.EXPECT().GetByID(gomock.Any(), orderID).Return(dbgen.Order{ID: orderID, UserID: uuid.New(), OrderNumber: "ORD-456"}, nil)
+		orderRepo.EXPECT().GetItems(gomock.Any(), orderID).Return([]dbgen.OrderItem{}, nil)
+
+		res, err := svc.DetailAdmin(ctx, orderID.String())
+		assert.NoError(t, err)
+		assert.Equal(t, "ORD-456", res.OrderNumber)
 	})
 }
 
@@ -238,32 +621,58 @@ func TestOrderService_Cancel(t *testing.T) {
 
 	orderRepo := orderMock.NewMockRepository(ctrl)
 	cartSvc := cartMock.NewMockService(ctrl)
-	svc := order.NewService(db, orderRepo, cartSvc)
+	inventorySvc := inventoryMock.NewMockService(ctrl)
+	voucherSvc := voucherMock.NewMockService(ctrl)
+	shippingSvc := shippingMock.NewMockService(ctrl)
+	shipmentSvc := shipmentMock.NewMockService(ctrl)
+	taxSvc := taxMock.NewMockService(ctrl)
+	svc := order.NewService(db, orderRepo, cartSvc, inventorySvc, voucherSvc, shippingSvc, shipmentSvc, taxSvc, nil)
 	ctx := context.Background()
 
 	t.Run("success_cancel_order", func(t *testing.T) {
 		orderID := uuid.New()
+		userID := uuid.New()
+		productID := uuid.New()
 
 		// 1. Mock GetByID (DILUAR/SEBELUM transaksi)
 		orderRepo.EXPECT().
 			GetByID(gomock.Any(), orderID).
 			Return(dbgen.Order{
-				ID: orderID, Status: "PENDING",
+				ID: orderID, UserID: userID, Status: "PENDING",
 			}, nil)
 
 		// 2. Setup Transaction Mock (Setelah GetByID)
 		mock.ExpectBegin()
 
-		// 3. Mock WithTx dan UpdateStatus (DIDALAM transaksi)
+		// 3. Mock WithTx dan Cancel ber-guard (DIDALAM transaksi)
 		orderRepo.EXPECT().WithTx(gomock.Any()).Return(orderRepo).AnyTimes()
 		orderRepo.EXPECT().
-			UpdateStatus(gomock.Any(), orderID, "CANCELLED").
-			Return(dbgen.Order{}, nil)
+			Cancel(gomock.Any(), orderID).
+			Return(dbgen.Order{ID: orderID, Status: "CANCELLED"}, nil)
+
+		// 4. Stok item dikembalikan
+		orderRepo.EXPECT().
+			GetItems(gomock.Any(), orderID).
+			Return([]dbgen.OrderItem{{ProductID: productID, Quantity: 3}}, nil)
+		inventorySvc.EXPECT().
+			Record(gomock.Any(), gomock.Any(), inventory.Movement{
+				ProductID: productID,
+				Delta:     3,
+				Reason:    constants.StockReasonCancel,
+				OrderID:   uuid.NullUUID{UUID: orderID, Valid: true},
+				ActorID:   uuid.NullUUID{UUID: userID, Valid: true},
+			}).
+			Return(dbgen.StockMovement{}, nil)
+
+		// 5. Kuota voucher dikembalikan (no-op jika tanpa voucher)
+		voucherSvc.EXPECT().
+			Release(gomock.Any(), gomock.Any(), orderID).
+			Return(nil)
 
 		mock.ExpectCommit()
 
 		// Execute
-		err := svc.Cancel(ctx, orderID.String())
+		err := svc.Cancel(ctx, userID.String(), orderID.String())
 
 		// Assert
 		assert.NoError(t, err)
@@ -273,13 +682,119 @@ func TestOrderService_Cancel(t *testing.T) {
 	t.Run("error_order_not_pending", func(t *testing.T) {
 		orderID := uuid.New()
 		// Tidak ada BeginTx karena divalidasi sebelum transaksi (opsional, tergantung logic service Anda)
+		userID := uuid.New()
+		orderRepo.EXPECT().GetByID(gomock.Any(), orderID).Return(dbgen.Order{
+			ID: orderID, UserID: userID, Status: "COMPLETED",
+		}, nil)
+
+		err := svc.Cancel(ctx, userID.String(), orderID.String())
+		assert.ErrorIs(t, err, order.ErrCannotCancel)
+	})
+
+	t.Run("error_already_cancelled_or_paid_does_not_release_twice", func(t *testing.T) {
+		orderID := uuid.New()
+		userID := uuid.New()
+
+		// Snapshot masih PENDING, tetapi di DB order sudah dibatalkan/expire/dibayar
 		orderRepo.EXPECT().GetByID(gomock.Any(), orderID).Return(dbgen.Order{
-			ID: orderID, Status: "COMPLETED",
+			ID: orderID, UserID: userID, Status: "PENDING",
 		}, nil)
+		mock.ExpectBegin()
+		orderRepo.EXPECT().Cancel(gomock.Any(), orderID).Return(dbgen.Order{}, sql.ErrNoRows)
+		// GetItems / inventory.Record / voucher.Release tidak boleh terpanggil
+		mock.ExpectRollback()
+
+		err := svc.Cancel(ctx, userID.String(), orderID.String())
 
-		err := svc.Cancel(ctx, orderID.String())
 		assert.ErrorIs(t, err, order.ErrCannotCancel)
+		assert.NoError(t, mock.ExpectationsWereMet())
 	})
+
+	t.Run("error_other_users_order_is_not_found", func(t *testing.T) {
+		orderID := uuid.New()
+		// Tidak ada transaksi: order milik user lain ditolak sebelum BeginTx
+		orderRepo.EXPECT().GetByID(gomock.Any(), orderID).Return(dbgen.Order{
+			ID: orderID, UserID: uuid.New(), Status: "PENDING",
+		}, nil)
+
+		err := svc.Cancel(ctx, uuid.New().String(), orderID.String())
+		assert.ErrorIs(t, err, order.ErrOrderNotFound)
+	})
+
+	t.Run("admin_cancels_any_order_as_actor", func(t *testing.T) {
+		orderID := uuid.New()
+		adminID := uuid.New()
+		productID := uuid.New()
+
+		orderRepo.EXPECT().GetByID(gomock.Any(), orderID).Return(dbgen.Order{
+			ID: orderID, UserID: uuid.New(), Status: "PENDING",
+		}, nil)
+		mock.ExpectBegin()
+		orderRepo.EXPECT().Cancel(gomock.Any(), orderID).Return(dbgen.Order{ID: orderID, Status: "CANCELLED"}, nil)
+		orderRepo.EXPECT().
+			GetItems(gomock.Any(), orderID).
+			Return([]dbgen.OrderItem{{ProductID: productID, Quantity: 1}}, nil)
+		inventorySvc.EXPECT().
+			Record(gomock.Any(), gomock.Any(), inventory.Movement{
+				ProductID: productID,
+				Delta:     1,
+				Reason:    constants.StockReasonCancel,
+				OrderID:   uuid.NullUUID{UUID: orderID, Valid: true},
+				ActorID:   uuid.NullUUID{UUID: adminID, Valid: true},
+			}).
+			Return(dbgen.StockMovement{}, nil)
+		voucherSvc.EXPECT().Release(gomock.Any(), gomock.Any(), orderID).Return(nil)
+		mock.ExpectCommit()
+
+		err := svc.CancelAdmin(ctx, adminID.String(), orderID.String())
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+// Produk di order sudah di-soft-delete: cancel tetap mengembalikan stok lewat inventory asli
+// (query AdjustProductStock hanya memblokir delta negatif untuk produk terhapus)
+func TestOrderService_CancelSoftDeletedProduct(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+
+	orderRepo := orderMock.NewMockRepository(ctrl)
+	voucherSvc := voucherMock.NewMockService(ctrl)
+	inventorySvc := inventory.NewService(db, inventory.NewRepository(dbgen.New(db)), nil)
+	svc := order.NewService(db, orderRepo, cartMock.NewMockService(ctrl), inventorySvc, voucherSvc,
+		shippingMock.NewMockService(ctrl), shipmentMock.NewMockService(ctrl), taxMock.NewMockService(ctrl), nil)
+	ctx := context.Background()
+
+	orderID := uuid.New()
+	userID := uuid.New()
+	productID := uuid.New()
+
+	orderRepo.EXPECT().GetByID(gomock.Any(), orderID).Return(dbgen.Order{
+		ID: orderID, UserID: userID, Status: "PENDING",
+	}, nil)
+	orderRepo.EXPECT().WithTx(gomock.Any()).Return(orderRepo)
+	orderRepo.EXPECT().Cancel(gomock.Any(), orderID).Return(dbgen.Order{ID: orderID, Status: "CANCELLED"}, nil)
+	orderRepo.EXPECT().GetItems(gomock.Any(), orderID).
+		Return([]dbgen.OrderItem{{ProductID: productID, Quantity: 2}}, nil)
+	voucherSvc.EXPECT().Release(gomock.Any(), gomock.Any(), orderID).Return(nil)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`UPDATE products .*\(deleted_at IS NULL OR \$1::int > 0\)`).
+		WithArgs(int32(2), productID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "stock"}).AddRow(productID, 2))
+	mock.ExpectQuery(`INSERT INTO stock_movements`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "product_id", "delta", "stock_after", "reason", "order_id", "actor_id", "note", "created_at"}).
+			AddRow(uuid.New(), productID, 2, 2, constants.StockReasonCancel, orderID, userID, nil, time.Now()))
+	mock.ExpectCommit()
+
+	err := svc.Cancel(ctx, userID.String(), orderID.String())
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
 func TestOrderService_UpdateStatusByCustomer(t *testing.T) {
@@ -291,7 +806,12 @@ func TestOrderService_UpdateStatusByCustomer(t *testing.T) {
 
 	orderRepo := orderMock.NewMockRepository(ctrl)
 	cartSvc := cartMock.NewMockService(ctrl)
-	svc := order.NewService(db, orderRepo, cartSvc)
+	inventorySvc := inventoryMock.NewMockService(ctrl)
+	voucherSvc := voucherMock.NewMockService(ctrl)
+	shippingSvc := shippingMock.NewMockService(ctrl)
+	shipmentSvc := shipmentMock.NewMockService(ctrl)
+	taxSvc := taxMock.NewMockService(ctrl)
+	svc := order.NewService(db, orderRepo, cartSvc, inventorySvc, voucherSvc, shippingSvc, shipmentSvc, taxSvc, nil)
 	ctx := context.Background()
 
 	t.Run("customer_success_complete", func(t *testing.T) {
@@ -302,12 +822,12 @@ func TestOrderService_UpdateStatusByCustomer(t *testing.T) {
 		mock.ExpectBegin()
 		orderRepo.EXPECT().WithTx(gomock.Any()).Return(orderRepo)
 
-		// 1. Mock GetByID: Pastikan UserID sama dan status SHIPPED/DELIVERED
+		// 1. Mock GetByID: Pastikan UserID sama dan status DELIVERED
 		orderRepo.EXPECT().GetByID(ctx, orderID).Return(dbgen.Order{
-			ID: orderID, UserID: userID, Status: "SHIPPED",
+			ID: orderID, UserID: userID, Status: "DELIVERED",
 		}, nil)
 
-		orderRepo.EXPECT().UpdateStatus(ctx, orderID, statusTarget).Return(dbgen.Order{
+		orderRepo.EXPECT().Complete(ctx, orderID).Return(dbgen.Order{
 			ID: orderID, Status: statusTarget,
 		}, nil)
 
@@ -331,12 +851,48 @@ func TestOrderService_UpdateStatusByCustomer(t *testing.T) {
 			ID: orderID, UserID: realOwnerID, Status: "SHIPPED",
 		}, nil)
 
+		mock.ExpectRollback()
+
 		// User yang login (wrongUserID) tidak sama dengan pemilik order (realOwnerID)
 		_, err := svc.UpdateStatusByCustomer(ctx, orderID.String(), wrongUserID, "COMPLETED")
 
 		assert.Error(t, err)
-		assert.Equal(t, auth.ErrUnauthorized, err) // Sesuai pesan error di service Anda
+		assert.Equal(t, order.ErrOrderNotFound, err) // Order user lain tidak dibocorkan
+	})
+
+	t.Run("customer_cannot_complete_undelivered_order", func(t *testing.T) {
+		orderID := uuid.New()
+		userID := uuid.New()
+
+		mock.ExpectBegin()
+		orderRepo.EXPECT().WithTx(gomock.Any()).Return(orderRepo)
+		orderRepo.EXPECT().GetByID(ctx, orderID).Return(dbgen.Order{
+			ID: orderID, UserID: userID, Status: "PENDING",
+		}, nil)
+		// Guard di query: order belum DELIVERED tidak ter-update
+		orderRepo.EXPECT().Complete(ctx, orderID).Return(dbgen.Order{}, sql.ErrNoRows)
 		mock.ExpectRollback()
+
+		_, err := svc.UpdateStatusByCustomer(ctx, orderID.String(), userID, "COMPLETED")
+
+		assert.ErrorIs(t, err, order.ErrInvalidStatusTransition)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("customer_cannot_set_other_status", func(t *testing.T) {
+		orderID := uuid.New()
+		userID := uuid.New()
+
+		mock.ExpectBegin()
+		orderRepo.EXPECT().WithTx(gomock.Any()).Return(orderRepo)
+		orderRepo.EXPECT().GetByID(ctx, orderID).Return(dbgen.Order{
+			ID: orderID, UserID: userID, Status: "DELIVERED",
+		}, nil)
+		mock.ExpectRollback()
+
+		_, err := svc.UpdateStatusByCustomer(ctx, orderID.String(), userID, "CANCELLED")
+
+		assert.ErrorIs(t, err, order.ErrInvalidStatusTransition)
 	})
 }
 
@@ -349,7 +905,12 @@ func TestOrderService_UpdateStatusByAdmin(t *testing.T) {
 
 	orderRepo := orderMock.NewMockRepository(ctrl)
 	cartSvc := cartMock.NewMockService(ctrl)
-	svc := order.NewService(db, orderRepo, cartSvc)
+	inventorySvc := inventoryMock.NewMockService(ctrl)
+	voucherSvc := voucherMock.NewMockService(ctrl)
+	shippingSvc := shippingMock.NewMockService(ctrl)
+	shipmentSvc := shipmentMock.NewMockService(ctrl)
+	taxSvc := taxMock.NewMockService(ctrl)
+	svc := order.NewService(db, orderRepo, cartSvc, inventorySvc, voucherSvc, shippingSvc, shipmentSvc, taxSvc, nil)
 	ctx := context.Background()
 
 	t.Run("admin_success_processing", func(t *testing.T) {
@@ -377,6 +938,41 @@ func TestOrderService_UpdateStatusByAdmin(t *testing.T) {
 		assert.Equal(t, statusTarget, res.Status)
 	})
 
+	t.Run("admin_success_shipped_persists_receipt", func(t *testing.T) {
+		orderID := uuid.New()
+		statusTarget := "SHIPPED"
+		receipt := " JNE123 "
+
+		mock.ExpectBegin()
+		orderRepo.EXPECT().WithTx(gomock.Any()).Return(orderRepo)
+
+		orderRepo.EXPECT().GetByID(ctx, orderID).Return(dbgen.Order{
+			ID: orderID, Status: "PROCESSING",
+		}, nil)
+		orderRepo.EXPECT().UpdateStatus(ctx, orderID, statusTarget).Return(dbgen.Order{
+			ID: orderID, Status: statusTarget,
+		}, nil)
+
+		// Resi disimpan (sudah di-trim) lalu shipment dibuat dalam tx yang sama
+		shipped := dbgen.Order{
+			ID:        orderID,
+			Status:    statusTarget,
+			ReceiptNo: sql.NullString{String: "JNE123", Valid: true},
+		}
+		orderRepo.EXPECT().SetReceiptNo(ctx, orderID, "JNE123").Return(shipped, nil)
+		shipmentSvc.EXPECT().Create(ctx, gomock.Any(), shipped, "JNE123").Return(dbgen.Shipment{}, nil)
+
+		mock.ExpectCommit()
+
+		res, err := svc.UpdateStatusByAdmin(ctx, orderID.String(), statusTarget, &receipt)
+
+		assert.NoError(t, err)
+		assert.Equal(t, statusTarget, res.Status)
+		if assert.NotNil(t, res.ReceiptNo) {
+			assert.Equal(t, "JNE123", *res.ReceiptNo)
+		}
+	})
+
 	t.Run("admin_failed_shipped_no_receipt", func(t *testing.T) {
 		orderID := uuid.New()
 		statusTarget := "SHIPPED"
@@ -397,3 +993,118 @@ func TestOrderService_UpdateStatusByAdmin(t *testing.T) {
 		mock.ExpectRollback()
 	})
 }
+
+func TestOrderService_ExpireUnpaid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+
+	orderRepo := orderMock.NewMockRepository(ctrl)
+	cartSvc := cartMock.NewMockService(ctrl)
+	inventorySvc := inventoryMock.NewMockService(ctrl)
+	voucherSvc := voucherMock.NewMockService(ctrl)
+	shippingSvc := shippingMock.NewMockService(ctrl)
+	shipmentSvc := shipmentMock.NewMockService(ctrl)
+	taxSvc := taxMock.NewMockService(ctrl)
+	svc := order.NewService(db, orderRepo, cartSvc, inventorySvc, voucherSvc, shippingSvc, shipmentSvc, taxSvc, nil)
+	ctx := context.Background()
+	cutoff := time.Now().Add(-24 * time.Hour)
+
+	orderRepo.EXPECT().WithTx(gomock.Any()).Return(orderRepo).AnyTimes()
+
+	t.Run("success_expire_and_release_stock", func(t *testing.T) {
+		expiredID, paidID := uuid.New(), uuid.New()
+		productID := uuid.New()
+
+		orderRepo.EXPECT().ListExpiredPendingIDs(ctx, cutoff, int32(50)).Return([]uuid.UUID{expiredID, paidID}, nil)
+
+		// 1. Order pertama dibatalkan, stok & voucher dikembalikan oleh sistem
+		mock.ExpectBegin()
+		orderRepo.EXPECT().Expire(ctx, expiredID, constants.OrderCancelReasonExpired).
+			Return(dbgen.Order{ID: expiredID, Status: "CANCELLED"}, nil)
+		orderRepo.EXPECT().GetItems(ctx, expiredID).
+			Return([]dbgen.OrderItem{{ProductID: productID, Quantity: 2}}, nil)
+		inventorySvc.EXPECT().
+			Record(ctx, gomock.Any(), inventory.Movement{
+				ProductID: productID,
+				Delta:     2,
+				Reason:    constants.StockReasonCancel,
+				OrderID:   uuid.NullUUID{UUID: expiredID, Valid: true},
+			}).
+			Return(dbgen.StockMovement{}, nil)
+		voucherSvc.EXPECT().Release(ctx, gomock.Any(), expiredID).Return(nil)
+		mock.ExpectCommit()
+
+		// 2. Order kedua keburu dibayar: guard query tidak mengembalikan row
+		mock.ExpectBegin()
+		orderRepo.EXPECT().Expire(ctx, paidID, constants.OrderCancelReasonExpired).
+			Return(dbgen.Order{}, sql.ErrNoRows)
+		mock.ExpectRollback()
+
+		res, err := svc.ExpireUnpaid(ctx, cutoff, 50)
+
+		assert.NoError(t, err)
+		assert.Len(t, res, 1)
+		assert.Equal(t, expiredID.String(), res[0].ID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error_one_order_does_not_stop_batch", func(t *testing.T) {
+		failedID, okID := uuid.New(), uuid.New()
+
+		orderRepo.EXPECT().ListExpiredPendingIDs(ctx, cutoff, int32(50)).Return([]uuid.UUID{failedID, okID}, nil)
+
+		mock.ExpectBegin()
+		orderRepo.EXPECT().Expire(ctx, failedID, constants.OrderCancelReasonExpired).
+			Return(dbgen.Order{}, sql.ErrConnDone)
+		mock.ExpectRollback()
+
+		mock.ExpectBegin()
+		orderRepo.EXPECT().Expire(ctx, okID, constants.OrderCancelReasonExpired).
+			Return(dbgen.Order{ID: okID, Status: "CANCELLED"}, nil)
+		orderRepo.EXPECT().GetItems(ctx, okID).Return(nil, nil)
+		voucherSvc.EXPECT().Release(ctx, gomock.Any(), okID).Return(nil)
+		mock.ExpectCommit()
+
+		res, err := svc.ExpireUnpaid(ctx, cutoff, 50)
+
+		assert.ErrorIs(t, err, sql.ErrConnDone)
+		assert.Len(t, res, 1)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestOrderService_AutoComplete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db, _, _ := sqlmock.New()
+	defer db.Close()
+
+	orderRepo := orderMock.NewMockRepository(ctrl)
+	svc := order.NewService(db, orderRepo, nil, nil, nil, nil, nil, nil, nil)
+	ctx := context.Background()
+	cutoff := time.Now().Add(-7 * 24 * time.Hour)
+
+	t.Run("success_completed_orders_returned", func(t *testing.T) {
+		orderID := uuid.New()
+		orderRepo.EXPECT().CompleteDelivered(ctx, cutoff, int32(100)).
+			Return([]dbgen.Order{{ID: orderID, Status: "COMPLETED"}}, nil)
+
+		res, err := svc.AutoComplete(ctx, cutoff, 100)
+
+		assert.NoError(t, err)
+		assert.Len(t, res, 1)
+		assert.Equal(t, "COMPLETED", res[0].Status)
+	})
+
+	t.Run("error_repository", func(t *testing.T) {
+		orderRepo.EXPECT().CompleteDelivered(ctx, cutoff, int32(100)).Return(nil, sql.ErrConnDone)
+
+		_, err := svc.AutoComplete(ctx, cutoff, 100)
+
+		assert.Error(t, err)
+	})
+}
@@ -18,6 +18,18 @@ var (
 		http.StatusBadRequest,
 	)
 
+	ErrInvalidBrandID = apperror.New(
+		apperror.CodeInvalidInput,
+		"Invalid brand ID",
+		http.StatusBadRequest,
+	)
+
+	ErrCursorSortUnsupported = apperror.New(
+		apperror.CodeInvalidInput,
+		"Cursor pagination is not supported for this sort",
+		http.StatusBadRequest,
+	)
+
 	ErrProductNotFound = apperror.New(
 		apperror.CodeNotFound,
 		"Product not found",
@@ -36,9 +48,81 @@ var (
 		http.StatusInternalServerError,
 	)
 
+	ErrInvalidWeight = apperror.New(
+		apperror.CodeInvalidInput,
+		"Product weight must be zero or greater",
+		http.StatusBadRequest,
+	)
+
+	ErrInvalidTaxClass = apperror.New(
+		apperror.CodeInvalidInput,
+		"Unknown tax class",
+		http.StatusBadRequest,
+	)
+
+	ErrInvalidPublishSchedule = apperror.New(
+		apperror.CodeInvalidInput,
+		"Invalid publish schedule, use RFC3339 and unpublish after publish",
+		http.StatusBadRequest,
+	)
+
+	ErrInvalidPriceSchedule = apperror.New(
+		apperror.CodeInvalidInput,
+		"Invalid price schedule",
+		http.StatusBadRequest,
+	)
+
+	ErrPriceScheduleNotFound = apperror.New(
+		apperror.CodeNotFound,
+		"Price schedule not found",
+		http.StatusNotFound,
+	)
+
 	ErrImageUploadFailed = apperror.New(
 		apperror.CodeInternalError,
 		"Failed to upload image",
 		http.StatusInternalServerError,
 	)
+
+	ErrImportUnsupportedFormat = apperror.New(
+		apperror.CodeInvalidInput,
+		"Unsupported import file, use .csv or .xlsx",
+		http.StatusBadRequest,
+	)
+
+	ErrImportInvalidFile = apperror.New(
+		apperror.CodeInvalidInput,
+		"Import file cannot be read",
+		http.StatusBadRequest,
+	)
+
+	ErrImportEmpty = apperror.New(
+		apperror.CodeInvalidInput,
+		"Import file has no data rows",
+		http.StatusBadRequest,
+	)
+
+	ErrImportInvalidHeader = apperror.New(
+		apperror.CodeInvalidInput,
+		"Import header must contain sku, name, price, stock, category_slug",
+		http.StatusBadRequest,
+	)
+
+	ErrImportTooManyRows = apperror.New(
+		apperror.CodeInvalidInput,
+		"Import file exceeds the maximum number of rows",
+		http.StatusBadRequest,
+	)
+
+	ErrImportValidation = apperror.New(
+		apperror.CodeInvalidInput,
+		"Import has invalid rows, nothing was saved",
+		http.StatusUnprocessableEntity,
+	)
+
+	ErrImportFailed = apperror.New(
+		apperror.CodeInternalError,
+		"Failed to import products",
+		http.StatusInternalServerError,
+	)
 )
@@ -1,13 +1,20 @@
 package product
 
 import (
+	"errors"
 	"fmt"
+	producterrors "go-sqlc-starter/internal/api/v1/product/errors"
 	"go-sqlc-starter/internal/pkg/apperror"
+	"go-sqlc-starter/internal/pkg/constants"
+	"go-sqlc-starter/internal/pkg/cursor"
 	"go-sqlc-starter/internal/pkg/httpx"
 	"go-sqlc-starter/internal/pkg/response"
+	"go-sqlc-starter/internal/pkg/spreadsheet"
+	"log"
 	"mime/multipart"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
@@ -26,15 +33,27 @@ func (ctrl *Controller) GetPublicList(c *gin.Context) {
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
 	minPrice, _ := strconv.ParseFloat(c.DefaultQuery("min_price", "0"), 64)
 	maxPrice, _ := strconv.ParseFloat(c.DefaultQuery("max_price", "0"), 64)
+	minRating, _ := strconv.ParseFloat(c.DefaultQuery("min_rating", "0"), 64)
+
+	// Default sort: relevance jika ada keyword pencarian
+	// (mode cursor tidak mendukung relevance, tetap newest)
+	search := c.Query("search")
+	pg := httpx.ParseCursor(c)
+	defaultSort := "newest"
+	if search != "" && !pg.Enabled {
+		defaultSort = "relevance"
+	}
 
 	req := ListPublicRequest{
 		Page:       page,
 		Limit:      limit,
-		Search:     c.Query("search"),
+		Search:     search,
 		CategoryID: c.Query("category_id"),
 		MinPrice:   minPrice,
 		MaxPrice:   maxPrice,
-		SortBy:     c.DefaultQuery("sort_by", "newest"),
+		MinRating:  minRating,
+		SortBy:     c.DefaultQuery("sort_by", defaultSort),
+		Cursor:     pg.Cursor,
 	}
 
 	// Support route: /products/category/:categoryId
@@ -42,6 +61,17 @@ func (ctrl *Controller) GetPublicList(c *gin.Context) {
 		req.CategoryID = c.Param("categoryId")
 	}
 
+	if pg.Enabled {
+		data, cur, err := ctrl.service.ListPublicByCursor(c.Request.Context(), req)
+		if err != nil {
+			httpErr := apperror.ToHTTP(err)
+			response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+			return
+		}
+		response.Success(c, http.StatusOK, data, ctrl.makeCursorPagination(limit, cur))
+		return
+	}
+
 	data, total, err := ctrl.service.ListPublic(c.Request.Context(), req)
 	if err != nil {
 		response.Error(
@@ -62,6 +92,20 @@ func (ctrl *Controller) GetPublicList(c *gin.Context) {
 	)
 }
 
+// GET SUGGEST (Autocomplete search box)
+func (ctrl *Controller) Suggest(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "5"))
+
+	data, err := ctrl.service.Suggest(c.Request.Context(), c.Query("q"), limit)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, data, nil)
+}
+
 // 2. GET ADMIN LIST (Dashboard)
 func (ctrl *Controller) GetAdminList(c *gin.Context) {
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
@@ -78,6 +122,18 @@ func (ctrl *Controller) GetAdminList(c *gin.Context) {
 		SortDir:  sort.SortDir,
 	}
 
+	if pg := httpx.ParseCursor(c); pg.Enabled {
+		req.Cursor = pg.Cursor
+		data, cur, err := ctrl.service.ListAdminByCursor(c.Request.Context(), req)
+		if err != nil {
+			httpErr := apperror.ToHTTP(err)
+			response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+			return
+		}
+		response.Success(c, http.StatusOK, data, ctrl.makeCursorPagination(limit, cur))
+		return
+	}
+
 	data, total, err := ctrl.service.ListAdmin(c.Request.Context(), req)
 	if err != nil {
 		response.Error(
@@ -116,9 +172,17 @@ func (ctrl *Controller) Create(c *gin.Context) {
 	// 2. Parse form fields
 	req := CreateProductRequest{
 		CategoryID:  c.PostForm("category_id"),
+		BrandID:     c.PostForm("brand_id"),
 		Name:        c.PostForm("name"),
 		Description: c.PostForm("description"),
 		SKU:         c.PostForm("sku"),
+		TaxClass:    c.PostForm("tax_class"),
+
+		MetaTitle:       c.PostForm("meta_title"),
+		MetaDescription: c.PostForm("meta_description"),
+
+		PublishedAt:   c.PostForm("published_at"),
+		UnpublishedAt: c.PostForm("unpublished_at"),
 	}
 
 	// Parse numeric fields
@@ -142,6 +206,16 @@ func (ctrl *Controller) Create(c *gin.Context) {
 		req.Stock = stock
 	}
 
+	if weightStr := c.PostForm("weight_grams"); weightStr != "" {
+		var weight int32
+		_, err := fmt.Sscanf(weightStr, "%d", &weight)
+		if err != nil {
+			response.Error(c, http.StatusBadRequest, "INVALID_WEIGHT", "Invalid weight format", nil)
+			return
+		}
+		req.WeightGrams = weight
+	}
+
 	// 3. Validate required fields
 	if req.CategoryID == "" || req.Name == "" || req.Price == 0 || req.Stock == 0 {
 		response.Error(
@@ -196,16 +270,21 @@ func (ctrl *Controller) GetByID(c *gin.Context) {
 	response.Success(c, http.StatusOK, res, nil)
 }
 
+// GET DETAIL BY SLUG (Customers)
+// Slug lama hasil rename di-redirect 301 ke slug terbaru
 func (ctrl *Controller) GetBySlug(c *gin.Context) {
-	res, err := ctrl.service.GetBySlug(c.Request.Context(), c.Param("slug"))
+	slug := c.Param("slug")
+
+	res, err := ctrl.service.GetBySlug(c.Request.Context(), slug)
 	if err != nil {
-		response.Error(
-			c,
-			http.StatusNotFound,
-			"NOT_FOUND",
-			"Produk tidak ditemukan",
-			nil,
-		)
+		if errors.Is(err, producterrors.ErrProductNotFound) {
+			if newSlug, rerr := ctrl.service.ResolveSlug(c.Request.Context(), slug); rerr == nil {
+				httpx.RedirectSlug(c, slug, newSlug)
+				return
+			}
+		}
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
 		return
 	}
 
@@ -232,9 +311,14 @@ func (ctrl *Controller) Update(c *gin.Context) {
 	// 2. Parse form fields (all optional for update)
 	req := UpdateProductRequest{
 		CategoryID:  c.PostForm("category_id"),
+		BrandID:     c.PostForm("brand_id"),
 		Name:        c.PostForm("name"),
 		Description: c.PostForm("description"),
 		SKU:         c.PostForm("sku"),
+		TaxClass:    c.PostForm("tax_class"),
+
+		MetaTitle:       c.PostForm("meta_title"),
+		MetaDescription: c.PostForm("meta_description"),
 	}
 
 	// Parse numeric fields
@@ -250,7 +334,15 @@ func (ctrl *Controller) Update(c *gin.Context) {
 		var stock int32
 		_, err := fmt.Sscanf(stockStr, "%d", &stock)
 		if err == nil {
-			req.Stock = stock
+			req.Stock = &stock
+		}
+	}
+
+	if weightStr := c.PostForm("weight_grams"); weightStr != "" {
+		var weight int32
+		_, err := fmt.Sscanf(weightStr, "%d", &weight)
+		if err == nil {
+			req.WeightGrams = &weight
 		}
 	}
 
@@ -259,6 +351,14 @@ func (ctrl *Controller) Update(c *gin.Context) {
 		req.IsActive = &isActive
 	}
 
+	// Jadwal tayang: field dikirim kosong = hapus jadwal
+	if publishedAt, ok := c.GetPostForm("published_at"); ok {
+		req.PublishedAt = &publishedAt
+	}
+	if unpublishedAt, ok := c.GetPostForm("unpublished_at"); ok {
+		req.UnpublishedAt = &unpublishedAt
+	}
+
 	// 3. Get uploaded file (optional)
 	var file multipart.File
 	var filename string
@@ -317,6 +417,131 @@ func (ctrl *Controller) Restore(c *gin.Context) {
 	response.Success(c, http.StatusOK, res, nil)
 }
 
+// 7a. PRICE SCHEDULES
+// GET /admin/products/:id/prices
+func (ctrl *Controller) ListPrices(c *gin.Context) {
+	res, err := ctrl.service.ListPrices(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// POST /admin/products/:id/prices
+func (ctrl *Controller) CreatePrice(c *gin.Context) {
+	var req CreateProductPriceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		appErr := apperror.Wrap(err, apperror.CodeInvalidInput, "Invalid request body", http.StatusBadRequest)
+		httpErr := apperror.ToHTTP(appErr)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, err.Error())
+		return
+	}
+
+	res, err := ctrl.service.CreatePrice(c.Request.Context(), c.Param("id"), req)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusCreated, res, nil)
+}
+
+// DELETE /admin/products/:id/prices/:priceId
+func (ctrl *Controller) DeletePrice(c *gin.Context) {
+	if err := ctrl.service.DeletePrice(c.Request.Context(), c.Param("id"), c.Param("priceId")); err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, nil, nil)
+}
+
+// 8. BULK IMPORT (CSV / XLSX)
+// POST /admin/products/import?dry_run=true&batch_size=500 (form-data: file)
+func (ctrl *Controller) Import(c *gin.Context) {
+	var req ImportProductsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "INVALID_QUERY", "Invalid dry_run / batch_size", err.Error())
+		return
+	}
+
+	// 1. Ambil file
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "FILE_ERROR", "File is required", nil)
+		return
+	}
+	if fileHeader.Size > constants.ProductImportMaxFileSize {
+		response.Error(c, http.StatusBadRequest, "FILE_TOO_LARGE", "Import file exceeds 10 MB", nil)
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "FILE_ERROR", "Failed to open uploaded file", err.Error())
+		return
+	}
+	defer file.Close()
+
+	// 2. Parse CSV / XLSX
+	rows, err := spreadsheet.Read(file, fileHeader.Size, fileHeader.Filename)
+	if err != nil {
+		appErr := producterrors.ErrImportInvalidFile
+		if errors.Is(err, spreadsheet.ErrUnsupportedFormat) {
+			appErr = producterrors.ErrImportUnsupportedFormat
+		}
+		httpErr := apperror.ToHTTP(appErr)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	// 3. Import (laporan error per baris dikirim sebagai details)
+	res, err := ctrl.service.Import(c.Request.Context(), rows, req)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		if errors.Is(err, producterrors.ErrImportValidation) {
+			response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, res)
+			return
+		}
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// 9. EXPORT CSV (filter sama dengan list admin)
+// GET /admin/products/export?search=&category_id=
+func (ctrl *Controller) Export(c *gin.Context) {
+	req := ListProductAdminRequest{
+		Search:   c.Query("search"),
+		Category: c.Query("category_id"),
+	}
+
+	filename := fmt.Sprintf("products-%s.csv", time.Now().Format("20060102-150405"))
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+
+	if err := ctrl.service.Export(c.Request.Context(), req, c.Writer); err != nil {
+		// Stream sudah terkirim sebagian, tidak bisa ganti status
+		if c.Writer.Written() {
+			log.Printf("product export aborted: %v", err)
+			c.Abort()
+			return
+		}
+		c.Header("Content-Disposition", "")
+		c.Header("Content-Type", "application/json; charset=utf-8")
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+}
+
 // Helper: Pagination Meta
 func (ctrl *Controller) makePagination(page, limit int, total int64) *response.PaginationMeta {
 	totalPages := 0
@@ -331,3 +556,11 @@ func (ctrl *Controller) makePagination(page, limit int, total int64) *response.P
 		PageSize:   limit,
 	}
 }
+
+func (ctrl *Controller) makeCursorPagination(limit int, page cursor.Page) *response.PaginationMeta {
+	return &response.PaginationMeta{
+		PageSize:   limit,
+		NextCursor: page.Next,
+		PrevCursor: page.Prev,
+	}
+}
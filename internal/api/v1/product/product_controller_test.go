@@ -12,6 +12,7 @@ import (
 
 	"go-sqlc-starter/internal/api/v1/product"
 	producterrors "go-sqlc-starter/internal/api/v1/product/errors"
+	"go-sqlc-starter/internal/pkg/cursor"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -31,6 +32,18 @@ type fakeProductService struct {
 	GetBySlugFn  func(ctx context.Context, slug string) (product.ProductDetailResponse, error)
 	DeleteFn     func(ctx context.Context, id string) error
 	RestoreFn    func(ctx context.Context, id string) (product.ProductAdminResponse, error)
+	SuggestFn    func(ctx context.Context, query string, limit int) ([]product.ProductSuggestResponse, error)
+
+	ListPublicByCursorFn func(ctx context.Context, req product.ListPublicRequest) ([]product.ProductPublicResponse, cursor.Page, error)
+	ListAdminByCursorFn  func(ctx context.Context, req product.ListProductAdminRequest) ([]product.ProductAdminResponse, cursor.Page, error)
+	ResolveSlugFn        func(ctx context.Context, oldSlug string) (string, error)
+
+	ImportFn func(ctx context.Context, rows [][]string, req product.ImportProductsRequest) (product.ImportProductsResponse, error)
+	ExportFn func(ctx context.Context, req product.ListProductAdminRequest, w io.Writer) error
+
+	ListPricesFn  func(ctx context.Context, productID string) ([]product.ProductPriceResponse, error)
+	CreatePriceFn func(ctx context.Context, productID string, req product.CreateProductPriceRequest) (product.ProductPriceResponse, error)
+	DeletePriceFn func(ctx context.Context, productID, priceID string) error
 }
 
 func (f *fakeProductService) Create(ctx context.Context, req product.CreateProductRequest, file multipart.File, filename string) (product.ProductAdminResponse, error) {
@@ -61,6 +74,20 @@ func (f *fakeProductService) ListAdmin(ctx context.Context, req product.ListProd
 	return f.ListAdminFn(ctx, req)
 }
 
+func (f *fakeProductService) ListPublicByCursor(ctx context.Context, req product.ListPublicRequest) ([]product.ProductPublicResponse, cursor.Page, error) {
+	if f.ListPublicByCursorFn == nil {
+		return nil, cursor.Page{}, nil
+	}
+	return f.ListPublicByCursorFn(ctx, req)
+}
+
+func (f *fakeProductService) ListAdminByCursor(ctx context.Context, req product.ListProductAdminRequest) ([]product.ProductAdminResponse, cursor.Page, error) {
+	if f.ListAdminByCursorFn == nil {
+		return nil, cursor.Page{}, nil
+	}
+	return f.ListAdminByCursorFn(ctx, req)
+}
+
 func (f *fakeProductService) GetByID(ctx context.Context, id string) (product.ProductAdminResponse, error) {
 	if f.GetByIDFn == nil {
 		return product.ProductAdminResponse{}, nil
@@ -75,6 +102,48 @@ func (f *fakeProductService) GetBySlug(ctx context.Context, slug string) (produc
 	return f.GetBySlugFn(ctx, slug)
 }
 
+func (f *fakeProductService) ResolveSlug(ctx context.Context, oldSlug string) (string, error) {
+	if f.ResolveSlugFn == nil {
+		return "", producterrors.ErrProductNotFound
+	}
+	return f.ResolveSlugFn(ctx, oldSlug)
+}
+
+func (f *fakeProductService) Import(ctx context.Context, rows [][]string, req product.ImportProductsRequest) (product.ImportProductsResponse, error) {
+	if f.ImportFn == nil {
+		return product.ImportProductsResponse{}, nil
+	}
+	return f.ImportFn(ctx, rows, req)
+}
+
+func (f *fakeProductService) Export(ctx context.Context, req product.ListProductAdminRequest, w io.Writer) error {
+	if f.ExportFn == nil {
+		return nil
+	}
+	return f.ExportFn(ctx, req, w)
+}
+
+func (f *fakeProductService) ListPrices(ctx context.Context, productID string) ([]product.ProductPriceResponse, error) {
+	if f.ListPricesFn == nil {
+		return []product.ProductPriceResponse{}, nil
+	}
+	return f.ListPricesFn(ctx, productID)
+}
+
+func (f *fakeProductService) CreatePrice(ctx context.Context, productID string, req product.CreateProductPriceRequest) (product.ProductPriceResponse, error) {
+	if f.CreatePriceFn == nil {
+		return product.ProductPriceResponse{}, nil
+	}
+	return f.CreatePriceFn(ctx, productID, req)
+}
+
+func (f *fakeProductService) DeletePrice(ctx context.Context, productID, priceID string) error {
+	if f.DeletePriceFn == nil {
+		return nil
+	}
+	return f.DeletePriceFn(ctx, productID, priceID)
+}
+
 func (f *fakeProductService) Delete(ctx context.Context, id string) error {
 	if f.DeleteFn == nil {
 		return nil
@@ -92,6 +161,13 @@ func (f *fakeProductService) Restore(
 	return f.RestoreFn(ctx, id)
 }
 
+func (f *fakeProductService) Suggest(ctx context.Context, query string, limit int) ([]product.ProductSuggestResponse, error) {
+	if f.SuggestFn == nil {
+		return []product.ProductSuggestResponse{}, nil
+	}
+	return f.SuggestFn(ctx, query, limit)
+}
+
 //
 // ==================== HELPERS ====================
 //
@@ -245,6 +321,138 @@ func TestListAdminProducts(t *testing.T) {
 	})
 }
 
+//
+// ==================== LIST PUBLIC ====================
+//
+
+func TestListPublicProducts(t *testing.T) {
+	t.Run("search_defaults_to_relevance", func(t *testing.T) {
+		svc := &fakeProductService{
+			ListPublicFn: func(ctx context.Context, req product.ListPublicRequest) ([]product.ProductPublicResponse, int64, error) {
+				assert.Equal(t, "iphone", req.Search)
+				assert.Equal(t, "relevance", req.SortBy)
+				return []product.ProductPublicResponse{}, 0, nil
+			},
+		}
+
+		r := setupTestRouter()
+		r.GET("/products", newTestController(svc).GetPublicList)
+
+		req := httptest.NewRequest(http.MethodGet, "/products?search=iphone", nil)
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("no_search_defaults_to_newest", func(t *testing.T) {
+		svc := &fakeProductService{
+			ListPublicFn: func(ctx context.Context, req product.ListPublicRequest) ([]product.ProductPublicResponse, int64, error) {
+				assert.Equal(t, "newest", req.SortBy)
+				return []product.ProductPublicResponse{}, 0, nil
+			},
+		}
+
+		r := setupTestRouter()
+		r.GET("/products", newTestController(svc).GetPublicList)
+
+		req := httptest.NewRequest(http.MethodGet, "/products", nil)
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("cursor_mode_returns_cursor_meta", func(t *testing.T) {
+		svc := &fakeProductService{
+			ListPublicByCursorFn: func(ctx context.Context, req product.ListPublicRequest) ([]product.ProductPublicResponse, cursor.Page, error) {
+				assert.Equal(t, "tok", req.Cursor)
+				assert.Equal(t, "newest", req.SortBy)
+				return []product.ProductPublicResponse{}, cursor.Page{Next: "next-tok", Prev: "prev-tok"}, nil
+			},
+		}
+
+		r := setupTestRouter()
+		r.GET("/products", newTestController(svc).GetPublicList)
+
+		req := httptest.NewRequest(http.MethodGet, "/products?search=iphone&cursor=tok", nil)
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"nextCursor":"next-tok"`)
+		assert.Contains(t, w.Body.String(), `"prevCursor":"prev-tok"`)
+	})
+
+	t.Run("cursor_mode_invalid_cursor", func(t *testing.T) {
+		svc := &fakeProductService{
+			ListPublicByCursorFn: func(ctx context.Context, req product.ListPublicRequest) ([]product.ProductPublicResponse, cursor.Page, error) {
+				return nil, cursor.Page{}, cursor.ErrInvalidCursor
+			},
+		}
+
+		r := setupTestRouter()
+		r.GET("/products", newTestController(svc).GetPublicList)
+
+		req := httptest.NewRequest(http.MethodGet, "/products?cursor=broken", nil)
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+//
+// ==================== SUGGEST ====================
+//
+
+func TestSuggestProducts(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		svc := &fakeProductService{
+			SuggestFn: func(ctx context.Context, query string, limit int) ([]product.ProductSuggestResponse, error) {
+				assert.Equal(t, "iph", query)
+				assert.Equal(t, 8, limit)
+				return []product.ProductSuggestResponse{
+					{ID: uuid.NewString(), Name: "iPhone 15", Slug: "iphone-15"},
+				}, nil
+			},
+		}
+
+		r := setupTestRouter()
+		r.GET("/products/suggest", newTestController(svc).Suggest)
+
+		req := httptest.NewRequest(http.MethodGet, "/products/suggest?q=iph&limit=8", nil)
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "iphone-15")
+	})
+
+	t.Run("service_error", func(t *testing.T) {
+		svc := &fakeProductService{
+			SuggestFn: func(ctx context.Context, query string, limit int) ([]product.ProductSuggestResponse, error) {
+				return nil, producterrors.ErrProductFailed
+			},
+		}
+
+		r := setupTestRouter()
+		r.GET("/products/suggest", newTestController(svc).Suggest)
+
+		req := httptest.NewRequest(http.MethodGet, "/products/suggest?q=iph", nil)
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
 //
 // ==================== GET BY ID (ADMIN) ====================
 //
@@ -331,6 +539,29 @@ func TestGetProductBySlug(t *testing.T) {
 
 		assert.Equal(t, http.StatusNotFound, w.Code)
 	})
+
+	t.Run("redirect_old_slug", func(t *testing.T) {
+		svc := &fakeProductService{
+			GetBySlugFn: func(ctx context.Context, slug string) (product.ProductDetailResponse, error) {
+				return product.ProductDetailResponse{}, producterrors.ErrProductNotFound
+			},
+			ResolveSlugFn: func(ctx context.Context, oldSlug string) (string, error) {
+				assert.Equal(t, "iphone-15", oldSlug)
+				return "iphone-15-pro", nil
+			},
+		}
+
+		r := setupTestRouter()
+		r.GET("/products/slug/:slug", newTestController(svc).GetBySlug)
+
+		req := httptest.NewRequest(http.MethodGet, "/products/slug/iphone-15?ref=home", nil)
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusMovedPermanently, w.Code)
+		assert.Equal(t, "/products/slug/iphone-15-pro?ref=home", w.Header().Get("Location"))
+	})
 }
 
 //
@@ -377,3 +608,186 @@ func TestDeleteProduct(t *testing.T) {
 		assert.Equal(t, http.StatusNotFound, w.Code)
 	})
 }
+
+//
+// ==================== PRICE SCHEDULES ====================
+//
+
+func TestCreateProductPrice(t *testing.T) {
+	id := uuid.NewString()
+
+	t.Run("success", func(t *testing.T) {
+		svc := &fakeProductService{
+			CreatePriceFn: func(ctx context.Context, pid string, req product.CreateProductPriceRequest) (product.ProductPriceResponse, error) {
+				assert.Equal(t, id, pid)
+				assert.Equal(t, 12000000.0, req.Price)
+				assert.Equal(t, 15000000.0, *req.CompareAtPrice)
+				assert.NotNil(t, req.EndsAt)
+				return product.ProductPriceResponse{ProductID: pid, Price: req.Price}, nil
+			},
+		}
+
+		r := setupTestRouter()
+		r.POST("/admin/products/:id/prices", newTestController(svc).CreatePrice)
+
+		body := `{"price":12000000,"compareAtPrice":15000000,"startsAt":"2026-11-11T00:00:00Z","endsAt":"2026-11-12T00:00:00Z"}`
+		req := httptest.NewRequest(http.MethodPost, "/admin/products/"+id+"/prices", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("invalid_schedule", func(t *testing.T) {
+		svc := &fakeProductService{
+			CreatePriceFn: func(ctx context.Context, pid string, req product.CreateProductPriceRequest) (product.ProductPriceResponse, error) {
+				return product.ProductPriceResponse{}, producterrors.ErrInvalidPriceSchedule
+			},
+		}
+
+		r := setupTestRouter()
+		r.POST("/admin/products/:id/prices", newTestController(svc).CreatePrice)
+
+		req := httptest.NewRequest(http.MethodPost, "/admin/products/"+id+"/prices", bytes.NewBufferString(`{"price":0}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestDeleteProductPrice(t *testing.T) {
+	svc := &fakeProductService{
+		DeletePriceFn: func(ctx context.Context, pid, priceID string) error {
+			return producterrors.ErrPriceScheduleNotFound
+		},
+	}
+
+	r := setupTestRouter()
+	r.DELETE("/admin/products/:id/prices/:priceId", newTestController(svc).DeletePrice)
+
+	req := httptest.NewRequest(http.MethodDelete, "/admin/products/"+uuid.NewString()+"/prices/"+uuid.NewString(), nil)
+	w := httptest.NewRecorder()
+
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+//
+// ==================== IMPORT / EXPORT ====================
+//
+
+func TestImportProducts(t *testing.T) {
+	csvContent := []byte("sku,name,price,stock,category_slug\nIP15,iPhone 15,15000000,10,smartphone\n")
+
+	t.Run("success_dry_run", func(t *testing.T) {
+		svc := &fakeProductService{
+			ImportFn: func(ctx context.Context, rows [][]string, req product.ImportProductsRequest) (product.ImportProductsResponse, error) {
+				assert.True(t, req.DryRun)
+				assert.Equal(t, 100, req.BatchSize)
+				assert.Len(t, rows, 2)
+				assert.Equal(t, "IP15", rows[1][0])
+				return product.ImportProductsResponse{DryRun: true, TotalRows: 1, Created: 1}, nil
+			},
+		}
+
+		r := setupTestRouter()
+		r.POST("/admin/products/import", newTestController(svc).Import)
+
+		body, ct, _ := createMultipartForm(nil, "file", "products.csv", csvContent)
+		req := httptest.NewRequest(http.MethodPost, "/admin/products/import?dry_run=true&batch_size=100", body)
+		req.Header.Set("Content-Type", ct)
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("unsupported_format", func(t *testing.T) {
+		r := setupTestRouter()
+		r.POST("/admin/products/import", newTestController(&fakeProductService{}).Import)
+
+		body, ct, _ := createMultipartForm(nil, "file", "products.txt", csvContent)
+		req := httptest.NewRequest(http.MethodPost, "/admin/products/import", body)
+		req.Header.Set("Content-Type", ct)
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("row_validation_error", func(t *testing.T) {
+		svc := &fakeProductService{
+			ImportFn: func(ctx context.Context, rows [][]string, req product.ImportProductsRequest) (product.ImportProductsResponse, error) {
+				return product.ImportProductsResponse{
+					TotalRows: 1,
+					Failed:    1,
+					Errors:    []product.ImportRowError{{Row: 2, SKU: "IP15", Field: "price", Message: "invalid"}},
+				}, producterrors.ErrImportValidation
+			},
+		}
+
+		r := setupTestRouter()
+		r.POST("/admin/products/import", newTestController(svc).Import)
+
+		body, ct, _ := createMultipartForm(nil, "file", "products.csv", csvContent)
+		req := httptest.NewRequest(http.MethodPost, "/admin/products/import", body)
+		req.Header.Set("Content-Type", ct)
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assert.Contains(t, w.Body.String(), "price")
+	})
+}
+
+func TestExportProducts(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		svc := &fakeProductService{
+			ExportFn: func(ctx context.Context, req product.ListProductAdminRequest, w io.Writer) error {
+				assert.Equal(t, "iphone", req.Search)
+				_, err := io.WriteString(w, "sku,name\nIP15,iPhone 15\n")
+				return err
+			},
+		}
+
+		r := setupTestRouter()
+		r.GET("/admin/products/export", newTestController(svc).Export)
+
+		req := httptest.NewRequest(http.MethodGet, "/admin/products/export?search=iphone", nil)
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Header().Get("Content-Type"), "text/csv")
+		assert.Contains(t, w.Body.String(), "IP15")
+	})
+
+	t.Run("error_before_stream", func(t *testing.T) {
+		svc := &fakeProductService{
+			ExportFn: func(ctx context.Context, req product.ListProductAdminRequest, w io.Writer) error {
+				return producterrors.ErrInvalidCategoryID
+			},
+		}
+
+		r := setupTestRouter()
+		r.GET("/admin/products/export", newTestController(svc).Export)
+
+		req := httptest.NewRequest(http.MethodGet, "/admin/products/export?category_id=bad", nil)
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Empty(t, w.Header().Get("Content-Disposition"))
+	})
+}
@@ -12,7 +12,9 @@ type ListPublicRequest struct {
 	CategoryID string
 	MinPrice   float64
 	MaxPrice   float64
-	SortBy     string
+	MinRating  float64 // 0 = tanpa filter
+	SortBy     string  // newest | oldest | price_high | price_low | relevance | rating
+	Cursor     string  // token keyset; relevance tidak mendukung cursor
 }
 
 type ListProductAdminRequest struct {
@@ -22,28 +24,57 @@ type ListProductAdminRequest struct {
 	Category string
 	SortBy   string
 	SortDir  string // asc | desc
+	Cursor   string
 }
 
 // CreateProductRequest digunakan untuk input Admin saat membuat produk baru
 type CreateProductRequest struct {
 	CategoryID  string  `json:"categoryId" binding:"required"`
+	BrandID     string  `json:"brandId"`
 	Name        string  `json:"name" binding:"required"`
 	Description string  `json:"description"`
 	Price       float64 `json:"price" binding:"required"`
 	Stock       int32   `json:"stock" binding:"required"`
 	SKU         string  `json:"sku"`
 	ImageUrl    string  `json:"imagedUrl"`
+	WeightGrams int32   `json:"weightGrams"` // untuk ongkir
+	TaxClass    string  `json:"taxClass"`    // kosong = STANDARD
+
+	MetaTitle       string `json:"metaTitle"`
+	MetaDescription string `json:"metaDescription"`
+
+	// Jadwal tayang (RFC3339), kosong = langsung tayang / tanpa batas
+	PublishedAt   string `json:"publishedAt"`
+	UnpublishedAt string `json:"unpublishedAt"`
 }
 
 type UpdateProductRequest struct {
 	CategoryID  string  `json:"categoryId"`
+	BrandID     string  `json:"brandId"`
 	Name        string  `json:"name"`
 	Description string  `json:"description"`
 	Price       float64 `json:"price"`
-	Stock       int32   `json:"stock"`
+	Stock       *int32  `json:"stock"` // nil = tidak diubah; selisih dicatat ke ledger stok
 	SKU         string  `json:"sku"`
 	ImageUrl    string  `json:"imagedUrl"`
 	IsActive    *bool   `json:"isActive"` // Gunakan pointer agar bisa membedakan false (bool) dan nil (tidak dikirim)
+	WeightGrams *int32  `json:"weightGrams"`
+	TaxClass    string  `json:"taxClass"` // kosong = tidak diubah
+
+	MetaTitle       string `json:"metaTitle"`
+	MetaDescription string `json:"metaDescription"`
+
+	// nil = tidak diubah, "" = hapus jadwal
+	PublishedAt   *string `json:"publishedAt"`
+	UnpublishedAt *string `json:"unpublishedAt"`
+}
+
+// CreateProductPriceRequest jadwal harga; EndsAt kosong = berlaku tanpa batas
+type CreateProductPriceRequest struct {
+	Price          float64    `json:"price"`
+	CompareAtPrice *float64   `json:"compareAtPrice"`
+	StartsAt       time.Time  `json:"startsAt"`
+	EndsAt         *time.Time `json:"endsAt"`
 }
 
 // ==================== RESPONSE STRUCTS ====================
@@ -54,8 +85,21 @@ type ProductPublicResponse struct {
 	CategoryName string  `json:"categoryName"`
 	Name         string  `json:"name"`
 	Slug         string  `json:"slug"`
-	Price        float64 `json:"price"`
+	Price        float64 `json:"price"` // harga efektif (sudah termasuk jadwal harga aktif)
 	ImageURL     string  `json:"imagedUrl,omitempty"`
+	RatingAvg    float64 `json:"ratingAvg"`
+	RatingCount  int32   `json:"ratingCount"`
+
+	// Harga coret; nil jika tidak sedang diskon
+	OriginalPrice *float64 `json:"originalPrice"`
+}
+
+// ProductSuggestResponse untuk autocomplete search box
+type ProductSuggestResponse struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Slug     string `json:"slug"`
+	ImageURL string `json:"imagedUrl,omitempty"`
 }
 
 // ProductDetailResponse untuk detail produk dengan reviews
@@ -65,6 +109,7 @@ type ProductDetailResponse struct {
 	Slug           string            `json:"slug"`
 	Description    string            `json:"description"`
 	Price          float64           `json:"price"`
+	OriginalPrice  *float64          `json:"originalPrice"`
 	Stock          int32             `json:"stock"`
 	CategoryID     string            `json:"categoryId,omitempty"`
 	CategoryName   string            `json:"categoryName,omitempty"`
@@ -74,21 +119,44 @@ type ProductDetailResponse struct {
 	SKU            string            `json:"sku,omitempty"`
 	Specifications map[string]string `json:"specifications,omitempty"`
 
+	// Jalur kategori dari root sampai kategori produk
+	Breadcrumbs []Breadcrumb `json:"breadcrumbs"`
+
+	// SEO (fallback ke nama/deskripsi jika kosong)
+	MetaTitle       string `json:"metaTitle"`
+	MetaDescription string `json:"metaDescription"`
+
 	// Review fields
-	Reviews       []ReviewSummary `json:"reviews"`
-	AverageRating float64         `json:"averagedRating"`
-	RatingCount   int64           `json:"ratignCount"`
+	Reviews         []ReviewSummary `json:"reviews"`
+	AverageRating   float64         `json:"averagedRating"`
+	RatingCount     int64           `json:"ratignCount"`
+	RatingHistogram map[int]int32   `json:"ratingHistogram"` // bintang (1-5) -> jumlah review
 
 	CreatedAt time.Time `json:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt"`
 }
 
+// Breadcrumb satu level kategori pada product detail
+type Breadcrumb struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
 // ReviewSummary for product detail (5 reviews terbaru)
 type ReviewSummary struct {
-	ID        string    `json:"id"`
-	UserName  string    `json:"userName"`
-	Rating    int32     `json:"rating"`
-	Comment   string    `json:"comment"`
+	ID        string              `json:"id"`
+	UserName  string              `json:"userName"`
+	Rating    int32               `json:"rating"`
+	Comment   string              `json:"comment"`
+	Photos    []string            `json:"photos"`
+	Reply     *ReviewReplySummary `json:"reply,omitempty"`
+	CreatedAt time.Time           `json:"createdAt"`
+}
+
+// ReviewReplySummary balasan merchant pada review
+type ReviewReplySummary struct {
+	Body      string    `json:"body"`
 	CreatedAt time.Time `json:"createdAt"`
 }
 
@@ -103,6 +171,48 @@ type ProductAdminResponse struct {
 	SKU          string    `json:"sku"`
 	ImageURL     string    `json:"imagedUrl,omitempty"`
 	IsActive     bool      `json:"isActive"`
+	WeightGrams  int32     `json:"weightGrams"`
+	TaxClass     string    `json:"taxClass"`
 	CreatedAt    time.Time `json:"createdAt"`
 	UpdatedAt    time.Time `json:"updatedAt"`
+
+	MetaTitle       string `json:"metaTitle,omitempty"`
+	MetaDescription string `json:"metaDescription,omitempty"`
+
+	PublishedAt   *time.Time `json:"publishedAt"`
+	UnpublishedAt *time.Time `json:"unpublishedAt"`
+}
+
+type ProductPriceResponse struct {
+	ID             string     `json:"id"`
+	ProductID      string     `json:"productId"`
+	Price          float64    `json:"price"`
+	CompareAtPrice *float64   `json:"compareAtPrice"`
+	StartsAt       time.Time  `json:"startsAt"`
+	EndsAt         *time.Time `json:"endsAt"`
+	CreatedAt      time.Time  `json:"createdAt"`
+}
+
+// --- BULK IMPORT / EXPORT ---
+
+type ImportProductsRequest struct {
+	DryRun    bool `form:"dry_run"`
+	BatchSize int  `form:"batch_size"`
+}
+
+// ImportRowError error per baris (Row mengikuti nomor baris di file, header = 1)
+type ImportRowError struct {
+	Row     int    `json:"row"`
+	SKU     string `json:"sku,omitempty"`
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+type ImportProductsResponse struct {
+	DryRun    bool             `json:"dryRun"`
+	TotalRows int              `json:"totalRows"`
+	Created   int              `json:"created"`
+	Updated   int              `json:"updated"`
+	Failed    int              `json:"failed"`
+	Errors    []ImportRowError `json:"errors"`
 }
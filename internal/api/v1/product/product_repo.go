@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"go-sqlc-starter/internal/dbgen"
+	"go-sqlc-starter/internal/pkg/constants"
 
 	"github.com/google/uuid"
 )
@@ -15,13 +16,34 @@ type Repository interface {
 	// Pisahkan List menjadi Public dan Admin sesuai query.sql terbaru
 	ListPublic(ctx context.Context, arg dbgen.ListProductsPublicParams) ([]dbgen.ListProductsPublicRow, error)
 	ListAdmin(ctx context.Context, arg dbgen.ListProductsAdminParams) ([]dbgen.ListProductsAdminRow, error)
+	ListPublicKeyset(ctx context.Context, arg dbgen.ListProductsPublicKeysetParams) ([]dbgen.ListProductsPublicKeysetRow, error)
+	ListAdminKeyset(ctx context.Context, arg dbgen.ListProductsAdminKeysetParams) ([]dbgen.ListProductsAdminKeysetRow, error)
 
 	GetByID(ctx context.Context, id uuid.UUID) (dbgen.GetProductByIDRow, error)
 	Update(ctx context.Context, arg dbgen.UpdateProductParams) (dbgen.Product, error)
+	LockStock(ctx context.Context, id uuid.UUID) (int32, error)
 	Delete(ctx context.Context, id uuid.UUID) error
 	Restore(ctx context.Context, id uuid.UUID) (dbgen.Product, error)
 
 	GetBySlug(ctx context.Context, slug string) (dbgen.GetProductBySlugRow, error)
+	Suggest(ctx context.Context, arg dbgen.SuggestProductsParams) ([]dbgen.SuggestProductsRow, error)
+
+	// Slug & riwayat slug (301 redirect setelah rename)
+	SlugExists(ctx context.Context, slug string, excludeID uuid.UUID) (bool, error)
+	GetSlugRedirect(ctx context.Context, oldSlug string) (string, error)
+	CreateSlugRedirect(ctx context.Context, oldSlug string, productID uuid.UUID) error
+	DeleteSlugRedirect(ctx context.Context, slug string) error
+
+	// Bulk import / export
+	GetBrandBySlug(ctx context.Context, slug string) (dbgen.Brand, error)
+	ListExistingSKUs(ctx context.Context, skus []string) ([]string, error)
+	UpsertBatch(ctx context.Context, arg dbgen.UpsertProductsBatchParams) ([]dbgen.UpsertProductsBatchRow, error)
+	ListForExport(ctx context.Context, arg dbgen.ExportProductsParams) ([]dbgen.ExportProductsRow, error)
+
+	// Jadwal harga
+	CreatePrice(ctx context.Context, arg dbgen.CreateProductPriceParams) (dbgen.ProductPrice, error)
+	ListPrices(ctx context.Context, productID uuid.UUID) ([]dbgen.ProductPrice, error)
+	DeletePrice(ctx context.Context, id, productID uuid.UUID) error
 }
 
 type repository struct {
@@ -46,6 +68,15 @@ func (r *repository) ListAdmin(ctx context.Context, arg dbgen.ListProductsAdminP
 	return r.queries.ListProductsAdmin(ctx, arg)
 }
 
+// Keyset pagination (cursor) untuk katalog besar, tanpa OFFSET & count(*)
+func (r *repository) ListPublicKeyset(ctx context.Context, arg dbgen.ListProductsPublicKeysetParams) ([]dbgen.ListProductsPublicKeysetRow, error) {
+	return r.queries.ListProductsPublicKeyset(ctx, arg)
+}
+
+func (r *repository) ListAdminKeyset(ctx context.Context, arg dbgen.ListProductsAdminKeysetParams) ([]dbgen.ListProductsAdminKeysetRow, error) {
+	return r.queries.ListProductsAdminKeyset(ctx, arg)
+}
+
 func (r *repository) GetByID(ctx context.Context, id uuid.UUID) (dbgen.GetProductByIDRow, error) {
 	return r.queries.GetProductByID(ctx, id)
 }
@@ -54,10 +85,63 @@ func (r *repository) GetBySlug(ctx context.Context, slug string) (dbgen.GetProdu
 	return r.queries.GetProductBySlug(ctx, slug)
 }
 
+// Autocomplete: prefix match tsvector + trigram fallback
+func (r *repository) Suggest(ctx context.Context, arg dbgen.SuggestProductsParams) ([]dbgen.SuggestProductsRow, error) {
+	return r.queries.SuggestProducts(ctx, arg)
+}
+
+func (r *repository) SlugExists(ctx context.Context, slug string, excludeID uuid.UUID) (bool, error) {
+	return r.queries.ProductSlugExists(ctx, dbgen.ProductSlugExistsParams{
+		Slug:      slug,
+		ExcludeID: excludeID,
+	})
+}
+
+func (r *repository) GetSlugRedirect(ctx context.Context, oldSlug string) (string, error) {
+	return r.queries.GetProductSlugRedirect(ctx, oldSlug)
+}
+
+func (r *repository) CreateSlugRedirect(ctx context.Context, oldSlug string, productID uuid.UUID) error {
+	return r.queries.UpsertSlugRedirect(ctx, dbgen.UpsertSlugRedirectParams{
+		EntityType: constants.SlugEntityProduct,
+		OldSlug:    oldSlug,
+		EntityID:   productID,
+	})
+}
+
+func (r *repository) DeleteSlugRedirect(ctx context.Context, slug string) error {
+	return r.queries.DeleteSlugRedirect(ctx, dbgen.DeleteSlugRedirectParams{
+		EntityType: constants.SlugEntityProduct,
+		OldSlug:    slug,
+	})
+}
+
+func (r *repository) GetBrandBySlug(ctx context.Context, slug string) (dbgen.Brand, error) {
+	return r.queries.GetBrandBySlug(ctx, slug)
+}
+
+func (r *repository) ListExistingSKUs(ctx context.Context, skus []string) ([]string, error) {
+	return r.queries.ListProductSKUsExisting(ctx, skus)
+}
+
+// Upsert multi-row (unnest) berdasarkan SKU, Inserted=false berarti update
+func (r *repository) UpsertBatch(ctx context.Context, arg dbgen.UpsertProductsBatchParams) ([]dbgen.UpsertProductsBatchRow, error) {
+	return r.queries.UpsertProductsBatch(ctx, arg)
+}
+
+func (r *repository) ListForExport(ctx context.Context, arg dbgen.ExportProductsParams) ([]dbgen.ExportProductsRow, error) {
+	return r.queries.ExportProducts(ctx, arg)
+}
+
 func (r *repository) Update(ctx context.Context, arg dbgen.UpdateProductParams) (dbgen.Product, error) {
 	return r.queries.UpdateProduct(ctx, arg)
 }
 
+// LockStock SELECT ... FOR UPDATE, hanya berarti jika dipanggil lewat WithTx
+func (r *repository) LockStock(ctx context.Context, id uuid.UUID) (int32, error) {
+	return r.queries.LockProductStock(ctx, id)
+}
+
 func (r *repository) Delete(ctx context.Context, id uuid.UUID) error {
 	return r.queries.SoftDeleteProduct(ctx, id)
 }
@@ -79,3 +163,19 @@ func (r *repository) WithTx(tx dbgen.DBTX) Repository {
 	// Anda bisa mengembalikan repository standar atau menangani error-nya
 	return r
 }
+
+func (r *repository) CreatePrice(ctx context.Context, arg dbgen.CreateProductPriceParams) (dbgen.ProductPrice, error) {
+	return r.queries.CreateProductPrice(ctx, arg)
+}
+
+func (r *repository) ListPrices(ctx context.Context, productID uuid.UUID) ([]dbgen.ProductPrice, error) {
+	return r.queries.ListProductPrices(ctx, productID)
+}
+
+func (r *repository) DeletePrice(ctx context.Context, id, productID uuid.UUID) error {
+	_, err := r.queries.DeleteProductPrice(ctx, dbgen.DeleteProductPriceParams{
+		ID:        id,
+		ProductID: productID,
+	})
+	return err
+}
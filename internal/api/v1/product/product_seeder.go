@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"go-sqlc-starter/internal/dbgen"
+	"go-sqlc-starter/internal/pkg/constants"
 	"log"
 
 	"github.com/google/uuid"
@@ -18,8 +19,8 @@ func SeedProducts(repo Repository, categoryID string, name string, price float64
 		Name:       name,
 		Slug:       fmt.Sprintf("%s-%s", name, uuid.New().String()[:4]),
 		Price:      fmt.Sprintf("%.2f", price), // Konversi float ke string untuk DECIMAL
-		Stock:      10,
 		Sku:        dbgen.NewNullString("SKU-" + name),
+		TaxClass:   constants.TaxClassStandard,
 	})
 
 	if err != nil {
@@ -3,23 +3,32 @@ package product
 import (
 	"context"
 	"database/sql"
+	"encoding/csv"
+	"errors"
 	"fmt"
 	"go-sqlc-starter/internal/api/v1/category"
+	"go-sqlc-starter/internal/api/v1/inventory"
 	producterrors "go-sqlc-starter/internal/api/v1/product/errors"
 	"go-sqlc-starter/internal/dbgen"
 	"go-sqlc-starter/internal/pkg/constants"
+	"go-sqlc-starter/internal/pkg/cursor"
+	"go-sqlc-starter/internal/pkg/utils"
+	"io"
 	"mime/multipart"
+	"os"
 	"strconv"
 	"strings"
 	"time"
+	"unicode"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
+// ReviewRepository: agregat rating dibaca dari kolom products (dijaga review service),
+// di sini hanya perlu review terbaru untuk ringkasan
 type ReviewRepository interface {
 	GetByProductID(ctx context.Context, productID uuid.UUID, limit, offset int32) ([]dbgen.GetReviewsByProductIDRow, error)
-	CountByProductID(ctx context.Context, productID uuid.UUID) (int64, error)
-	GetAverageRating(ctx context.Context, productID uuid.UUID) (float64, error)
 }
 
 // ReviewRow represents review data from repository
@@ -36,10 +45,17 @@ type CloudinaryService interface {
 	DeleteImage(ctx context.Context, publicID string) error
 }
 
+// PriceWatcher dipanggil setelah harga produk berubah (mis. notifikasi harga turun)
+type PriceWatcher interface {
+	OnPriceChanged(ctx context.Context, tx dbgen.DBTX, productID uuid.UUID) error
+}
+
 //go:generate mockgen -source=product_service.go -destination=../mock/product/product_service_mock.go -package=mock
 type Service interface {
 	ListPublic(ctx context.Context, req ListPublicRequest) ([]ProductPublicResponse, int64, error)
 	ListAdmin(ctx context.Context, req ListProductAdminRequest) ([]ProductAdminResponse, int64, error)
+	ListPublicByCursor(ctx context.Context, req ListPublicRequest) ([]ProductPublicResponse, cursor.Page, error)
+	ListAdminByCursor(ctx context.Context, req ListProductAdminRequest) ([]ProductAdminResponse, cursor.Page, error)
 	Create(ctx context.Context, req CreateProductRequest, file multipart.File, filename string) (ProductAdminResponse, error)
 	Update(ctx context.Context, idStr string, req UpdateProductRequest, file multipart.File, filename string) (ProductAdminResponse, error)
 	Delete(ctx context.Context, id string) error
@@ -47,6 +63,16 @@ type Service interface {
 
 	GetByID(ctx context.Context, id string) (ProductAdminResponse, error)
 	GetBySlug(ctx context.Context, slug string) (ProductDetailResponse, error)
+	ResolveSlug(ctx context.Context, oldSlug string) (string, error)
+	Suggest(ctx context.Context, query string, limit int) ([]ProductSuggestResponse, error)
+
+	Import(ctx context.Context, rows [][]string, req ImportProductsRequest) (ImportProductsResponse, error)
+	Export(ctx context.Context, req ListProductAdminRequest, w io.Writer) error
+
+	// Jadwal harga (admin)
+	ListPrices(ctx context.Context, productID string) ([]ProductPriceResponse, error)
+	CreatePrice(ctx context.Context, productID string, req CreateProductPriceRequest) (ProductPriceResponse, error)
+	DeletePrice(ctx context.Context, productID, priceID string) error
 }
 
 type service struct {
@@ -55,15 +81,20 @@ type service struct {
 	categoryRepo   category.Repository
 	reviewRepo     ReviewRepository
 	cloudinaryRepo CloudinaryService
+	inventorySvc   inventory.Service
+	priceWatcher   PriceWatcher
 }
 
-func NewService(db *sql.DB, repo Repository, categoryRepo category.Repository, reviewRepo ReviewRepository, cloudinaryRepo CloudinaryService) Service {
+// NewService priceWatcher boleh nil (tanpa hook perubahan harga)
+func NewService(db *sql.DB, repo Repository, categoryRepo category.Repository, reviewRepo ReviewRepository, cloudinaryRepo CloudinaryService, inventorySvc inventory.Service, priceWatcher PriceWatcher) Service {
 	return &service{
 		db:             db,
 		repo:           repo,
 		categoryRepo:   categoryRepo,
 		reviewRepo:     reviewRepo,
 		cloudinaryRepo: cloudinaryRepo,
+		inventorySvc:   inventorySvc,
+		priceWatcher:   priceWatcher,
 	}
 }
 
@@ -74,13 +105,17 @@ func (s *service) ListPublic(ctx context.Context, req ListPublicRequest) ([]Prod
 		req.MaxPrice = 999999999
 	}
 
+	search := strings.TrimSpace(req.Search)
+
 	params := dbgen.ListProductsPublicParams{
-		Limit:    int32(req.Limit),
-		Offset:   int32(offset),
-		Search:   dbgen.NewNullString(req.Search),
-		MinPrice: fmt.Sprintf("%.2f", req.MinPrice),
-		MaxPrice: fmt.Sprintf("%.2f", req.MaxPrice),
-		SortBy:   req.SortBy,
+		Limit:       int32(req.Limit),
+		Offset:      int32(offset),
+		Search:      dbgen.NewNullString(search),
+		SearchQuery: dbgen.NewNullString(buildPrefixQuery(search)),
+		MinPrice:    fmt.Sprintf("%.2f", req.MinPrice),
+		MaxPrice:    fmt.Sprintf("%.2f", req.MaxPrice),
+		MinRating:   clampRating(req.MinRating),
+		SortBy:      req.SortBy,
 	}
 
 	if req.CategoryID != "" {
@@ -98,6 +133,205 @@ func (s *service) ListPublic(ctx context.Context, req ListPublicRequest) ([]Prod
 	return s.mapToPublicResponse(rows)
 }
 
+// flipPublicSort membalik arah sort untuk mengambil halaman sebelumnya
+var flipPublicSort = map[string]string{
+	"newest":     "oldest",
+	"oldest":     "newest",
+	"price_high": "price_low",
+	"price_low":  "price_high",
+}
+
+func (s *service) ListPublicByCursor(ctx context.Context, req ListPublicRequest) ([]ProductPublicResponse, cursor.Page, error) {
+	// 1. Normalisasi sort & limit
+	sortBy := req.SortBy
+	if sortBy == "" {
+		sortBy = "newest"
+	}
+	if _, ok := flipPublicSort[sortBy]; !ok {
+		return nil, cursor.Page{}, producterrors.ErrCursorSortUnsupported
+	}
+	limit := req.Limit
+	if limit < 1 {
+		limit = 10
+	}
+	if req.MaxPrice == 0 {
+		req.MaxPrice = 999999999
+	}
+
+	// 2. Decode cursor (kosong = halaman pertama)
+	cur, err := cursor.Parse(req.Cursor, sortBy, 2)
+	if err != nil {
+		return nil, cursor.Page{}, err
+	}
+
+	search := strings.TrimSpace(req.Search)
+	params := dbgen.ListProductsPublicKeysetParams{
+		Limit:       int32(limit + 1),
+		Search:      dbgen.NewNullString(search),
+		SearchQuery: dbgen.NewNullString(buildPrefixQuery(search)),
+		MinPrice:    fmt.Sprintf("%.2f", req.MinPrice),
+		MaxPrice:    fmt.Sprintf("%.2f", req.MaxPrice),
+		MinRating:   clampRating(req.MinRating),
+		SortBy:      sortBy,
+	}
+	if req.CategoryID != "" {
+		if uid, err := uuid.Parse(req.CategoryID); err == nil {
+			params.CategoryID = uuid.NullUUID{UUID: uid, Valid: true}
+		}
+	}
+
+	// 3. Isi posisi cursor; halaman sebelumnya = sort dibalik dari cursor
+	if cur != nil {
+		if cur.Backward {
+			params.SortBy = flipPublicSort[sortBy]
+		}
+		if err := applyPublicCursor(&params, sortBy, cur.Values); err != nil {
+			return nil, cursor.Page{}, err
+		}
+	}
+
+	rows, err := s.repo.ListPublicKeyset(ctx, params)
+	if err != nil {
+		return nil, cursor.Page{}, producterrors.ErrProductFailed
+	}
+
+	// 4. Potong baris ekstra & buat token next/prev
+	rows, page := cursor.Window(rows, limit, cur, sortBy, func(r dbgen.ListProductsPublicKeysetRow) []string {
+		if sortBy == "price_high" || sortBy == "price_low" {
+			return []string{r.EffectivePrice, r.ID.String()}
+		}
+		return []string{r.CreatedAt.Format(time.RFC3339Nano), r.ID.String()}
+	})
+
+	res := make([]ProductPublicResponse, 0, len(rows))
+	for _, row := range rows {
+		price, original := displayPrices(row.Price, row.EffectivePrice, row.CompareAtPrice)
+		res = append(res, ProductPublicResponse{
+			ID:            row.ID.String(),
+			CategoryName:  row.CategoryName,
+			Name:          row.Name,
+			Slug:          row.Slug,
+			Price:         price,
+			OriginalPrice: original,
+			RatingAvg:     row.RatingAvg,
+			RatingCount:   row.RatingCount,
+		})
+	}
+	return res, page, nil
+}
+
+func (s *service) ListAdminByCursor(ctx context.Context, req ListProductAdminRequest) ([]ProductAdminResponse, cursor.Page, error) {
+	// 1. Normalisasi sort & limit (sama seperti ListAdmin)
+	limit := req.Limit
+	if limit < 1 {
+		limit = 10
+	}
+	sortCol := req.SortBy
+	switch sortCol {
+	case "name", "stock", "price", "created_at":
+	default:
+		sortCol = "created_at"
+	}
+	sortDir := strings.ToLower(req.SortDir)
+	if sortDir != "asc" {
+		sortDir = "desc"
+	}
+	sortKey := sortCol + ":" + sortDir
+
+	// 2. Decode cursor
+	cur, err := cursor.Parse(req.Cursor, sortKey, 2)
+	if err != nil {
+		return nil, cursor.Page{}, err
+	}
+
+	params := dbgen.ListProductsAdminKeysetParams{
+		Limit:   int32(limit + 1),
+		Search:  dbgen.NewNullString(req.Search),
+		SortCol: sortCol,
+		SortDir: sortDir,
+	}
+	if req.Category != "" {
+		if uid, err := uuid.Parse(req.Category); err == nil {
+			params.CategoryID = uuid.NullUUID{UUID: uid, Valid: true}
+		}
+	}
+
+	// 3. Isi posisi cursor
+	if cur != nil {
+		if cur.Backward {
+			params.SortDir = flipDir(sortDir)
+		}
+		if err := applyAdminCursor(&params, sortCol, cur.Values); err != nil {
+			return nil, cursor.Page{}, err
+		}
+	}
+
+	rows, err := s.repo.ListAdminKeyset(ctx, params)
+	if err != nil {
+		return nil, cursor.Page{}, producterrors.ErrProductFailed
+	}
+
+	// 4. Potong baris ekstra & buat token next/prev
+	rows, page := cursor.Window(rows, limit, cur, sortKey, func(r dbgen.ListProductsAdminKeysetRow) []string {
+		switch sortCol {
+		case "name":
+			return []string{r.Name, r.ID.String()}
+		case "stock":
+			return []string{strconv.Itoa(int(r.Stock)), r.ID.String()}
+		case "price":
+			return []string{r.Price, r.ID.String()}
+		}
+		return []string{r.CreatedAt.Format(time.RFC3339Nano), r.ID.String()}
+	})
+
+	res := make([]ProductAdminResponse, 0, len(rows))
+	for _, row := range rows {
+		priceFloat, _ := strconv.ParseFloat(row.Price, 64)
+		res = append(res, ProductAdminResponse{
+			ID:           row.ID.String(),
+			CategoryName: row.CategoryName,
+			Name:         row.Name,
+			Slug:         row.Slug,
+			Price:        priceFloat,
+			Stock:        row.Stock,
+			SKU:          row.Sku.String,
+			IsActive:     row.IsActive.Bool,
+			CreatedAt:    row.CreatedAt,
+		})
+	}
+	return res, page, nil
+}
+
+func (s *service) Suggest(ctx context.Context, query string, limit int) ([]ProductSuggestResponse, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return []ProductSuggestResponse{}, nil
+	}
+	if limit < 1 || limit > 10 {
+		limit = 5
+	}
+
+	rows, err := s.repo.Suggest(ctx, dbgen.SuggestProductsParams{
+		Limit:       int32(limit),
+		SearchQuery: buildPrefixQuery(query),
+		Search:      query,
+	})
+	if err != nil {
+		return nil, producterrors.ErrProductFailed
+	}
+
+	res := make([]ProductSuggestResponse, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, ProductSuggestResponse{
+			ID:       row.ID.String(),
+			Name:     row.Name,
+			Slug:     row.Slug,
+			ImageURL: row.ImageUrl.String,
+		})
+	}
+	return res, nil
+}
+
 func (s *service) GetBySlug(ctx context.Context, slug string) (ProductDetailResponse, error) {
 	// 1. Get product by slug
 	product, err := s.repo.GetBySlug(ctx, slug)
@@ -114,20 +348,35 @@ func (s *service) GetBySlug(ctx context.Context, slug string) (ProductDetailResp
 		reviews = nil // atau bisa nil
 	}
 
-	// 3. Get average rating
-	avgRating, err := s.reviewRepo.GetAverageRating(ctx, product.ID)
-	if err != nil && err != sql.ErrNoRows {
-		avgRating = 0
+	// 3. Breadcrumbs kategori (tidak fatal jika gagal)
+	crumbs, err := s.categoryRepo.GetBreadcrumbs(ctx, product.CategoryID)
+	if err != nil {
+		crumbs = nil
 	}
 
-	// 4. Get total reviews count
-	ratingCount, err := s.reviewRepo.CountByProductID(ctx, product.ID)
-	if err != nil && err != sql.ErrNoRows {
-		ratingCount = 0
+	// 4. Map to response (rating dari agregat di baris produk)
+	res := s.mapToDetailResponse(product, reviews)
+	res.Breadcrumbs = make([]Breadcrumb, 0, len(crumbs))
+	for _, c := range crumbs {
+		res.Breadcrumbs = append(res.Breadcrumbs, Breadcrumb{
+			ID:   c.ID.String(),
+			Name: c.Name,
+			Slug: c.Slug,
+		})
 	}
+	return res, nil
+}
 
-	// 5. Map to response
-	return s.mapToDetailResponse(product, reviews, avgRating, ratingCount), nil
+// ResolveSlug mencari slug terbaru dari slug lama (untuk 301 redirect)
+func (s *service) ResolveSlug(ctx context.Context, oldSlug string) (string, error) {
+	slug, err := s.repo.GetSlugRedirect(ctx, oldSlug)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", producterrors.ErrProductNotFound
+		}
+		return "", producterrors.ErrProductFailed
+	}
+	return slug, nil
 }
 
 func (s *service) ListAdmin(
@@ -194,9 +443,39 @@ func (s *service) Create(ctx context.Context, req CreateProductRequest, file mul
 		return ProductAdminResponse{}, producterrors.ErrCategoryNotFound
 	}
 
-	// 2. Generate slug
-	slug := strings.ToLower(strings.ReplaceAll(req.Name, " ", "-")) + "-" + uuid.New().String()[:5]
+	brandID, err := parseBrandID(req.BrandID)
+	if err != nil {
+		return ProductAdminResponse{}, err
+	}
+
+	// 2. Generate slug (unik, retry dengan suffix jika bentrok)
+	slug, err := utils.UniqueSlug(ctx, req.Name, func(ctx context.Context, slug string) (bool, error) {
+		return s.repo.SlugExists(ctx, slug, uuid.Nil)
+	})
+	if err != nil {
+		return ProductAdminResponse{}, producterrors.ErrProductFailed
+	}
 	priceStr := fmt.Sprintf("%.2f", req.Price)
+	if req.WeightGrams < 0 {
+		return ProductAdminResponse{}, producterrors.ErrInvalidWeight
+	}
+	taxClass := strings.ToUpper(strings.TrimSpace(req.TaxClass))
+	if taxClass == "" {
+		taxClass = constants.TaxClassStandard
+	}
+
+	// 2a. Jadwal tayang (opsional)
+	publishedAt, err := parseScheduleTime(req.PublishedAt)
+	if err != nil {
+		return ProductAdminResponse{}, err
+	}
+	unpublishedAt, err := parseScheduleTime(req.UnpublishedAt)
+	if err != nil {
+		return ProductAdminResponse{}, err
+	}
+	if err := validatePublishWindow(publishedAt, unpublishedAt); err != nil {
+		return ProductAdminResponse{}, err
+	}
 
 	// 3. Start transaction
 	tx, err := s.db.BeginTx(ctx, nil)
@@ -213,14 +492,36 @@ func (s *service) Create(ctx context.Context, req CreateProductRequest, file mul
 		Slug:        slug,
 		Description: dbgen.NewNullString(req.Description),
 		Price:       priceStr,
-		Stock:       req.Stock,
 		Sku:         dbgen.NewNullString(req.SKU),
 		ImageUrl:    sql.NullString{}, // Empty first
+		BrandID:     brandID,
+
+		MetaTitle:       dbgen.NewNullString(req.MetaTitle),
+		MetaDescription: dbgen.NewNullString(req.MetaDescription),
+		PublishedAt:     publishedAt,
+		UnpublishedAt:   unpublishedAt,
+		WeightGrams:     req.WeightGrams,
+		TaxClass:        taxClass,
 	})
 	if err != nil {
+		if isTaxClassViolation(err) {
+			return ProductAdminResponse{}, producterrors.ErrInvalidTaxClass
+		}
 		return ProductAdminResponse{}, producterrors.ErrProductFailed
 	}
 
+	// 4a. Stok awal dicatat lewat ledger
+	if req.Stock > 0 {
+		if _, err := s.inventorySvc.Record(ctx, tx, inventory.Movement{
+			ProductID: product.ID,
+			Delta:     req.Stock,
+			Reason:    constants.StockReasonInitial,
+			Note:      "initial stock",
+		}); err != nil {
+			return ProductAdminResponse{}, err
+		}
+	}
+
 	// 5. Upload image to Cloudinary (if provided)
 	var imageURL string
 	if file != nil && filename != "" {
@@ -239,10 +540,18 @@ func (s *service) Create(ctx context.Context, req CreateProductRequest, file mul
 			Name:        product.Name,
 			Description: product.Description,
 			Price:       product.Price,
-			Stock:       product.Stock,
 			Sku:         product.Sku,
 			ImageUrl:    dbgen.NewNullString(imageURL),
 			IsActive:    product.IsActive,
+			BrandID:     product.BrandID,
+
+			Slug:            product.Slug,
+			MetaTitle:       product.MetaTitle,
+			MetaDescription: product.MetaDescription,
+			PublishedAt:     product.PublishedAt,
+			UnpublishedAt:   product.UnpublishedAt,
+			WeightGrams:     product.WeightGrams,
+			TaxClass:        product.TaxClass,
 		})
 		if err != nil {
 			// Update failed, should delete uploaded image
@@ -277,15 +586,21 @@ func (s *service) GetByID(ctx context.Context, idStr string) (ProductAdminRespon
 
 	priceFloat, _ := strconv.ParseFloat(p.Price, 64)
 	return ProductAdminResponse{
-		ID:           p.ID.String(),
-		CategoryName: p.CategoryName,
-		Name:         p.Name,
-		Slug:         p.Slug,
-		Price:        priceFloat,
-		Stock:        p.Stock,
-		SKU:          p.Sku.String,
-		IsActive:     p.IsActive.Bool,
-		CreatedAt:    p.CreatedAt,
+		ID:              p.ID.String(),
+		CategoryName:    p.CategoryName,
+		Name:            p.Name,
+		Slug:            p.Slug,
+		Price:           priceFloat,
+		Stock:           p.Stock,
+		SKU:             p.Sku.String,
+		IsActive:        p.IsActive.Bool,
+		WeightGrams:     p.WeightGrams,
+		TaxClass:        p.TaxClass,
+		CreatedAt:       p.CreatedAt,
+		MetaTitle:       p.MetaTitle.String,
+		MetaDescription: p.MetaDescription.String,
+		PublishedAt:     nullTimePtr(p.PublishedAt),
+		UnpublishedAt:   nullTimePtr(p.UnpublishedAt),
 	}, nil
 }
 
@@ -309,11 +624,19 @@ func (s *service) Update(ctx context.Context, idStr string, req UpdateProductReq
 		Name:        existingProduct.Name,
 		Description: existingProduct.Description,
 		Price:       existingProduct.Price,
-		Stock:       existingProduct.Stock,
 		Sku:         existingProduct.Sku,
 		ImageUrl:    existingProduct.ImageUrl,
 		CategoryID:  existingProduct.CategoryID,
 		IsActive:    existingProduct.IsActive,
+		BrandID:     existingProduct.BrandID,
+
+		Slug:            existingProduct.Slug,
+		MetaTitle:       existingProduct.MetaTitle,
+		MetaDescription: existingProduct.MetaDescription,
+		PublishedAt:     existingProduct.PublishedAt,
+		UnpublishedAt:   existingProduct.UnpublishedAt,
+		WeightGrams:     existingProduct.WeightGrams,
+		TaxClass:        existingProduct.TaxClass,
 	}
 
 	// 4. Update fields if provided
@@ -326,12 +649,16 @@ func (s *service) Update(ctx context.Context, idStr string, req UpdateProductReq
 			params.CategoryID = catID
 		}
 	}
+	if req.BrandID != "" {
+		brandID, err := parseBrandID(req.BrandID)
+		if err != nil {
+			return ProductAdminResponse{}, err
+		}
+		params.BrandID = brandID
+	}
 	if req.Price > 0 {
 		params.Price = fmt.Sprintf("%.2f", req.Price)
 	}
-	if req.Stock != 0 {
-		params.Stock = req.Stock
-	}
 	if req.SKU != "" {
 		params.Sku = dbgen.NewNullString(req.SKU)
 	}
@@ -341,6 +668,45 @@ func (s *service) Update(ctx context.Context, idStr string, req UpdateProductReq
 	if req.IsActive != nil {
 		params.IsActive = sql.NullBool{Bool: *req.IsActive, Valid: true}
 	}
+	if req.WeightGrams != nil {
+		if *req.WeightGrams < 0 {
+			return ProductAdminResponse{}, producterrors.ErrInvalidWeight
+		}
+		params.WeightGrams = *req.WeightGrams
+	}
+	if taxClass := strings.ToUpper(strings.TrimSpace(req.TaxClass)); taxClass != "" {
+		params.TaxClass = taxClass
+	}
+	if req.MetaTitle != "" {
+		params.MetaTitle = dbgen.NewNullString(req.MetaTitle)
+	}
+	if req.MetaDescription != "" {
+		params.MetaDescription = dbgen.NewNullString(req.MetaDescription)
+	}
+	if req.PublishedAt != nil {
+		if params.PublishedAt, err = parseScheduleTime(*req.PublishedAt); err != nil {
+			return ProductAdminResponse{}, err
+		}
+	}
+	if req.UnpublishedAt != nil {
+		if params.UnpublishedAt, err = parseScheduleTime(*req.UnpublishedAt); err != nil {
+			return ProductAdminResponse{}, err
+		}
+	}
+	if err := validatePublishWindow(params.PublishedAt, params.UnpublishedAt); err != nil {
+		return ProductAdminResponse{}, err
+	}
+
+	// 4a. Rename -> slug baru, slug lama disimpan untuk redirect
+	if req.Name != "" && req.Name != existingProduct.Name {
+		slug, err := utils.UniqueSlug(ctx, req.Name, func(ctx context.Context, slug string) (bool, error) {
+			return s.repo.SlugExists(ctx, slug, id)
+		})
+		if err != nil {
+			return ProductAdminResponse{}, producterrors.ErrProductFailed
+		}
+		params.Slug = slug
+	}
 
 	// 5. Start transaction
 	tx, err := s.db.BeginTx(ctx, nil)
@@ -370,12 +736,44 @@ func (s *service) Update(ctx context.Context, idStr string, req UpdateProductReq
 
 	// 7. Update product in DB
 	_, err = qtx.Update(ctx, params)
+	if err == nil && params.Slug != existingProduct.Slug {
+		err = s.recordSlugChange(ctx, qtx, id, existingProduct.Slug, params.Slug)
+	}
+	if isTaxClassViolation(err) {
+		err = producterrors.ErrInvalidTaxClass
+	} else if err != nil {
+		err = producterrors.ErrProductFailed
+	}
+
+	// 7a. Stok absolut dari form -> selisih dicatat sebagai ADJUSTMENT (0 juga valid).
+	// Selisih dihitung dari stok yang dikunci di transaksi ini, bukan hasil baca di awal
+	// (checkout / restock bisa mengubah stok di antaranya)
+	if err == nil && req.Stock != nil {
+		var current int32
+		if current, err = qtx.LockStock(ctx, id); err != nil {
+			err = producterrors.ErrProductFailed
+		} else if *req.Stock != current {
+			_, err = s.inventorySvc.Record(ctx, tx, inventory.Movement{
+				ProductID: id,
+				Delta:     *req.Stock - current,
+				Reason:    constants.StockReasonAdjustment,
+				Note:      "product update",
+			})
+		}
+	}
+
+	// 7b. Harga dasar berubah -> hook harga turun, di transaksi yang sama
+	if err == nil && s.priceWatcher != nil && params.Price != existingProduct.Price {
+		if err = s.priceWatcher.OnPriceChanged(ctx, tx, id); err != nil {
+			err = producterrors.ErrProductFailed
+		}
+	}
 	if err != nil {
 		// Update failed, delete new uploaded image if exists
 		if newImageURL != "" {
 			_ = s.cloudinaryRepo.DeleteImage(ctx, fmt.Sprintf("%s-%s", id.String(), filename))
 		}
-		return ProductAdminResponse{}, producterrors.ErrProductFailed
+		return ProductAdminResponse{}, err
 	}
 
 	// 8. Commit transaction
@@ -447,13 +845,16 @@ func (s *service) mapToPublicResponse(rows []dbgen.ListProductsPublicRow) ([]Pro
 		if total == 0 {
 			total = row.TotalCount
 		}
-		priceFloat, _ := strconv.ParseFloat(row.Price, 64)
+		price, original := displayPrices(row.Price, row.EffectivePrice, row.CompareAtPrice)
 		res = append(res, ProductPublicResponse{
-			ID:           row.ID.String(),
-			CategoryName: row.CategoryName,
-			Name:         row.Name,
-			Slug:         row.Slug,
-			Price:        priceFloat,
+			ID:            row.ID.String(),
+			CategoryName:  row.CategoryName,
+			Name:          row.Name,
+			Slug:          row.Slug,
+			Price:         price,
+			OriginalPrice: original,
+			RatingAvg:     row.RatingAvg,
+			RatingCount:   row.RatingCount,
 		})
 	}
 	return res, total, nil
@@ -485,38 +886,187 @@ func (s *service) mapToAdminResponse(rows []dbgen.ListProductsAdminRow) ([]Produ
 func (s *service) mapToDetailResponse(
 	product dbgen.GetProductBySlugRow,
 	reviews []dbgen.GetReviewsByProductIDRow,
-	avgRating float64,
-	ratingCount int64,
 ) ProductDetailResponse {
-	price, _ := strconv.ParseFloat(product.Price, 64)
+	price, original := displayPrices(product.Price, product.EffectivePrice, product.CompareAtPrice)
 
 	var reviewSummaries []ReviewSummary
 	for _, r := range reviews {
-		reviewSummaries = append(reviewSummaries, ReviewSummary{
+		summary := ReviewSummary{
 			ID:        r.ID.String(),
 			UserName:  r.UserName,
 			Rating:    r.Rating,
 			Comment:   r.Comment,
+			Photos:    r.PhotoUrls,
 			CreatedAt: r.CreatedAt,
-		})
+		}
+		if summary.Photos == nil {
+			summary.Photos = []string{}
+		}
+		if r.ReplyBody.Valid {
+			summary.Reply = &ReviewReplySummary{Body: r.ReplyBody.String, CreatedAt: r.ReplyCreatedAt.Time}
+		}
+		reviewSummaries = append(reviewSummaries, summary)
+	}
+
+	var brandID string
+	if product.BrandID.Valid {
+		brandID = product.BrandID.UUID.String()
 	}
 
 	return ProductDetailResponse{
-		ID:            product.ID.String(),
-		Name:          product.Name,
-		Slug:          product.Slug,
-		Description:   product.Description.String, // Handle sql.NullString
-		Price:         price,
-		Stock:         product.Stock,
-		ImageURL:      product.ImageUrl.String, // Handle sql.NullString
-		SKU:           product.Sku.String,
-		CategoryID:    product.CategoryID.String(),
-		Reviews:       reviewSummaries,
-		AverageRating: avgRating,
-		RatingCount:   ratingCount,
-		CreatedAt:     product.CreatedAt,
-		UpdatedAt:     product.UpdatedAt,
+		ID:              product.ID.String(),
+		Name:            product.Name,
+		Slug:            product.Slug,
+		Description:     product.Description.String, // Handle sql.NullString
+		Price:           price,
+		OriginalPrice:   original,
+		Stock:           product.Stock,
+		ImageURL:        product.ImageUrl.String, // Handle sql.NullString
+		SKU:             product.Sku.String,
+		CategoryID:      product.CategoryID.String(),
+		CategoryName:    product.CategoryName,
+		BrandID:         brandID,
+		BrandName:       product.BrandName.String,
+		MetaTitle:       metaTitle(product.MetaTitle.String, product.Name),
+		MetaDescription: metaDescription(product.MetaDescription.String, product.Description.String),
+		Reviews:         reviewSummaries,
+		AverageRating:   product.RatingAvg,
+		RatingCount:     int64(product.RatingCount),
+		RatingHistogram: map[int]int32{
+			1: product.Rating1Count,
+			2: product.Rating2Count,
+			3: product.Rating3Count,
+			4: product.Rating4Count,
+			5: product.Rating5Count,
+		},
+		CreatedAt: product.CreatedAt,
+		UpdatedAt: product.UpdatedAt,
+	}
+}
+
+// buildPrefixQuery mengubah input user menjadi tsquery prefix (type-ahead),
+// contoh: "iphone 15 pro" -> "iphone:* & 15:* & pro:*".
+// Karakter non huruf/angka dibuang agar tidak merusak sintaks to_tsquery.
+func buildPrefixQuery(search string) string {
+	terms := strings.FieldsFunc(strings.ToLower(search), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for i, t := range terms {
+		terms[i] = t + ":*"
+	}
+	return strings.Join(terms, " & ")
+}
+
+// clampRating batasi filter min_rating ke rentang 0-5
+func clampRating(r float64) float64 {
+	if r < 0 {
+		return 0
+	}
+	if r > 5 {
+		return 5
+	}
+	return r
+}
+
+func applyPublicCursor(params *dbgen.ListProductsPublicKeysetParams, sortBy string, values []string) error {
+	id, err := uuid.Parse(values[1])
+	if err != nil {
+		return cursor.ErrInvalidCursor
+	}
+	params.CursorID = uuid.NullUUID{UUID: id, Valid: true}
+
+	if sortBy == "price_high" || sortBy == "price_low" {
+		if _, err := strconv.ParseFloat(values[0], 64); err != nil {
+			return cursor.ErrInvalidCursor
+		}
+		params.CursorPrice = dbgen.NewNullString(values[0])
+		return nil
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, values[0])
+	if err != nil {
+		return cursor.ErrInvalidCursor
+	}
+	params.CursorCreatedAt = sql.NullTime{Time: t, Valid: true}
+	return nil
+}
+
+func applyAdminCursor(params *dbgen.ListProductsAdminKeysetParams, sortCol string, values []string) error {
+	id, err := uuid.Parse(values[1])
+	if err != nil {
+		return cursor.ErrInvalidCursor
+	}
+	params.CursorID = uuid.NullUUID{UUID: id, Valid: true}
+
+	switch sortCol {
+	case "name":
+		params.CursorName = sql.NullString{String: values[0], Valid: true}
+	case "stock":
+		stock, err := strconv.Atoi(values[0])
+		if err != nil {
+			return cursor.ErrInvalidCursor
+		}
+		params.CursorStock = sql.NullInt32{Int32: int32(stock), Valid: true}
+	case "price":
+		if _, err := strconv.ParseFloat(values[0], 64); err != nil {
+			return cursor.ErrInvalidCursor
+		}
+		params.CursorPrice = dbgen.NewNullString(values[0])
+	default:
+		t, err := time.Parse(time.RFC3339Nano, values[0])
+		if err != nil {
+			return cursor.ErrInvalidCursor
+		}
+		params.CursorCreatedAt = sql.NullTime{Time: t, Valid: true}
+	}
+	return nil
+}
+
+func flipDir(dir string) string {
+	if dir == "asc" {
+		return "desc"
+	}
+	return "asc"
+}
+
+// recordSlugChange menyimpan slug lama sebagai redirect ke produk ini.
+// Redirect milik produk ini dengan slug baru dihapus (kasus rename balik ke nama lama).
+func (s *service) recordSlugChange(ctx context.Context, qtx Repository, id uuid.UUID, oldSlug, newSlug string) error {
+	if err := qtx.DeleteSlugRedirect(ctx, newSlug); err != nil {
+		return err
+	}
+	return qtx.CreateSlugRedirect(ctx, oldSlug, id)
+}
+
+// metaTitle & metaDescription: fallback SEO jika admin tidak mengisi meta
+func metaTitle(meta, name string) string {
+	if meta != "" {
+		return meta
 	}
+	return name
+}
+
+func metaDescription(meta, description string) string {
+	if meta != "" {
+		return meta
+	}
+	// Batas umum meta description ~160 karakter
+	runes := []rune(strings.TrimSpace(description))
+	if len(runes) > 160 {
+		return strings.TrimSpace(string(runes[:157])) + "..."
+	}
+	return string(runes)
+}
+
+func parseBrandID(raw string) (uuid.NullUUID, error) {
+	if raw == "" {
+		return uuid.NullUUID{}, nil
+	}
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.NullUUID{}, producterrors.ErrInvalidBrandID
+	}
+	return uuid.NullUUID{UUID: id, Valid: true}, nil
 }
 
 // Helper function to calculate average rating (if needed in other methods)
@@ -532,3 +1082,588 @@ func calculateAverageRating(reviews []ReviewRow) float64 {
 
 	return float64(sum) / float64(len(reviews))
 }
+
+// ==================== BULK IMPORT / EXPORT ====================
+
+// importColumns urutan kolom file import (dan hasil export)
+var importColumns = []string{"sku", "name", "description", "price", "stock", "category_slug", "brand_slug", "is_active"}
+
+var requiredImportColumns = []string{"sku", "name", "price", "stock", "category_slug"}
+
+// importItem satu baris import yang sudah valid
+type importItem struct {
+	SKU         string
+	Name        string
+	Slug        string
+	Description string
+	Price       string
+	Stock       int32
+	CategoryID  uuid.UUID
+	BrandID     string
+	IsActive    bool
+}
+
+// Import memproses baris CSV/XLSX (baris pertama = header).
+// Semua baris divalidasi dulu; jika ada error tidak ada yang disimpan.
+// Dry-run hanya mengembalikan laporan tanpa menulis ke database.
+func (s *service) Import(ctx context.Context, rows [][]string, req ImportProductsRequest) (ImportProductsResponse, error) {
+	if len(rows) < 2 {
+		return ImportProductsResponse{}, producterrors.ErrImportEmpty
+	}
+
+	// 1. Header -> index kolom
+	header := make(map[string]int)
+	for i, col := range rows[0] {
+		header[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(col, "\ufeff")))] = i
+	}
+	for _, col := range requiredImportColumns {
+		if _, ok := header[col]; !ok {
+			return ImportProductsResponse{}, producterrors.ErrImportInvalidHeader
+		}
+	}
+
+	data := rows[1:]
+	if len(data) > constants.ProductImportMaxRows {
+		return ImportProductsResponse{}, producterrors.ErrImportTooManyRows
+	}
+
+	res := ImportProductsResponse{
+		DryRun: req.DryRun,
+		Errors: make([]ImportRowError, 0),
+	}
+
+	// 2. Validasi per baris (lookup kategori/brand di-cache per slug)
+	categories := make(map[string]uuid.UUID)
+	brands := make(map[string]uuid.UUID)
+	seen := make(map[string]int)
+	items := make([]importItem, 0, len(data))
+
+	for i, row := range data {
+		rowNum := i + 2
+		get := func(col string) string {
+			idx, ok := header[col]
+			if !ok || idx >= len(row) {
+				return ""
+			}
+			return strings.TrimSpace(row[idx])
+		}
+
+		// baris kosong dilewati
+		if strings.TrimSpace(strings.Join(row, "")) == "" {
+			continue
+		}
+		res.TotalRows++
+
+		item, rowErrs, err := s.parseImportRow(ctx, get, categories, brands)
+		if err != nil {
+			return ImportProductsResponse{}, producterrors.ErrImportFailed
+		}
+
+		if item.SKU != "" {
+			if first, dup := seen[item.SKU]; dup {
+				rowErrs = append(rowErrs, ImportRowError{
+					Field:   "sku",
+					Message: fmt.Sprintf("duplicate sku, already used on row %d", first),
+				})
+			} else {
+				seen[item.SKU] = rowNum
+			}
+		}
+
+		if len(rowErrs) > 0 {
+			res.Failed++
+			for _, e := range rowErrs {
+				e.Row = rowNum
+				e.SKU = item.SKU
+				res.Errors = append(res.Errors, e)
+			}
+			continue
+		}
+		items = append(items, item)
+	}
+
+	if res.TotalRows == 0 {
+		return ImportProductsResponse{}, producterrors.ErrImportEmpty
+	}
+
+	if len(res.Errors) > 0 {
+		if req.DryRun {
+			return res, nil
+		}
+		return res, producterrors.ErrImportValidation
+	}
+
+	// 3. Dry-run: hitung create vs update dari SKU yang sudah ada
+	if req.DryRun {
+		skus := make([]string, 0, len(items))
+		for _, item := range items {
+			skus = append(skus, item.SKU)
+		}
+		existing, err := s.repo.ListExistingSKUs(ctx, skus)
+		if err != nil {
+			return ImportProductsResponse{}, producterrors.ErrImportFailed
+		}
+		res.Updated = len(existing)
+		res.Created = len(items) - res.Updated
+		return res, nil
+	}
+
+	// 4. Upsert per batch dalam satu transaksi
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return ImportProductsResponse{}, producterrors.ErrImportFailed
+	}
+	defer tx.Rollback()
+
+	qtx := s.repo.WithTx(tx)
+	if err := assignImportSlugs(ctx, qtx, items); err != nil {
+		return ImportProductsResponse{}, producterrors.ErrImportFailed
+	}
+
+	batchSize := importBatchSize(req.BatchSize)
+	for start := 0; start < len(items); start += batchSize {
+		end := start + batchSize
+		if end > len(items) {
+			end = len(items)
+		}
+
+		batch := items[start:end]
+		upserted, err := qtx.UpsertBatch(ctx, toUpsertParams(batch))
+		if err != nil {
+			return ImportProductsResponse{}, producterrors.ErrImportFailed
+		}
+
+		targetStock := make(map[string]int32, len(batch))
+		for _, item := range batch {
+			targetStock[item.SKU] = item.Stock
+		}
+
+		for _, row := range upserted {
+			reason := constants.StockReasonAdjustment
+			if row.Inserted {
+				reason = constants.StockReasonInitial
+				res.Created++
+			} else {
+				res.Updated++
+			}
+
+			// Kolom stock di file = stok target; selisihnya masuk ledger
+			if delta := targetStock[row.Sku] - row.Stock; delta != 0 {
+				if _, err := s.inventorySvc.Record(ctx, tx, inventory.Movement{
+					ProductID: row.ID,
+					Delta:     delta,
+					Reason:    reason,
+					Note:      "bulk import",
+				}); err != nil {
+					return ImportProductsResponse{}, producterrors.ErrImportFailed
+				}
+			}
+
+			// Harga produk lama turun -> hook harga turun, sama seperti Update
+			if row.PriceDecreased && s.priceWatcher != nil {
+				if err := s.priceWatcher.OnPriceChanged(ctx, tx, row.ID); err != nil {
+					return ImportProductsResponse{}, producterrors.ErrImportFailed
+				}
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return ImportProductsResponse{}, producterrors.ErrImportFailed
+	}
+
+	return res, nil
+}
+
+// parseImportRow memvalidasi satu baris. err hanya untuk kegagalan database,
+// kesalahan data dikembalikan sebagai ImportRowError.
+func (s *service) parseImportRow(
+	ctx context.Context,
+	get func(col string) string,
+	categories map[string]uuid.UUID,
+	brands map[string]uuid.UUID,
+) (importItem, []ImportRowError, error) {
+	var errs []ImportRowError
+	fail := func(field, msg string) {
+		errs = append(errs, ImportRowError{Field: field, Message: msg})
+	}
+
+	item := importItem{
+		SKU:         get("sku"),
+		Name:        get("name"),
+		Description: get("description"),
+		IsActive:    true,
+	}
+
+	if item.SKU == "" {
+		fail("sku", "sku is required")
+	} else if len(item.SKU) > 100 {
+		fail("sku", "sku must be at most 100 characters")
+	}
+
+	if item.Name == "" {
+		fail("name", "name is required")
+	} else if len(item.Name) > 200 {
+		fail("name", "name must be at most 200 characters")
+	}
+
+	price, err := strconv.ParseFloat(get("price"), 64)
+	if err != nil || price <= 0 {
+		fail("price", "price must be a number greater than 0")
+	} else {
+		item.Price = fmt.Sprintf("%.2f", price)
+	}
+
+	stock, err := strconv.Atoi(get("stock"))
+	if err != nil || stock < 0 {
+		fail("stock", "stock must be a non-negative integer")
+	} else {
+		item.Stock = int32(stock)
+	}
+
+	if raw := get("is_active"); raw != "" {
+		active, ok := parseImportBool(raw)
+		if !ok {
+			fail("is_active", "is_active must be true or false")
+		}
+		item.IsActive = active
+	}
+
+	// Kategori wajib, resolve by slug
+	if slug := get("category_slug"); slug == "" {
+		fail("category_slug", "category_slug is required")
+	} else if id, ok := categories[slug]; ok {
+		item.CategoryID = id
+	} else {
+		cat, err := s.categoryRepo.GetBySlug(ctx, slug)
+		switch {
+		case err == sql.ErrNoRows:
+			fail("category_slug", "category not found")
+		case err != nil:
+			return importItem{}, nil, err
+		default:
+			categories[slug] = cat.ID
+			item.CategoryID = cat.ID
+		}
+	}
+
+	// Brand opsional
+	if slug := get("brand_slug"); slug != "" {
+		if id, ok := brands[slug]; ok {
+			item.BrandID = id.String()
+		} else {
+			brand, err := s.repo.GetBrandBySlug(ctx, slug)
+			switch {
+			case err == sql.ErrNoRows:
+				fail("brand_slug", "brand not found")
+			case err != nil:
+				return importItem{}, nil, err
+			default:
+				brands[slug] = brand.ID
+				item.BrandID = brand.ID.String()
+			}
+		}
+	}
+
+	return item, errs, nil
+}
+
+// assignImportSlugs slug unik untuk SKU baru (cek database + slug lain di file yang sama).
+// SKU yang sudah ada tidak mengubah slug (ON CONFLICT tidak menyentuh kolom slug).
+func assignImportSlugs(ctx context.Context, qtx Repository, items []importItem) error {
+	skus := make([]string, 0, len(items))
+	for _, item := range items {
+		skus = append(skus, item.SKU)
+	}
+	existing, err := qtx.ListExistingSKUs(ctx, skus)
+	if err != nil {
+		return err
+	}
+	isExisting := make(map[string]bool, len(existing))
+	for _, sku := range existing {
+		isExisting[sku] = true
+	}
+
+	taken := make(map[string]bool)
+	for i := range items {
+		if isExisting[items[i].SKU] {
+			items[i].Slug = utils.GenerateSlug(items[i].Name + " " + items[i].SKU)
+			continue
+		}
+
+		slug, err := utils.UniqueSlug(ctx, items[i].Name, func(ctx context.Context, slug string) (bool, error) {
+			if taken[slug] {
+				return true, nil
+			}
+			return qtx.SlugExists(ctx, slug, uuid.Nil)
+		})
+		if err != nil {
+			return err
+		}
+		taken[slug] = true
+		items[i].Slug = slug
+	}
+	return nil
+}
+
+func toUpsertParams(items []importItem) dbgen.UpsertProductsBatchParams {
+	arg := dbgen.UpsertProductsBatchParams{}
+	for _, item := range items {
+		arg.Skus = append(arg.Skus, item.SKU)
+		arg.Names = append(arg.Names, item.Name)
+		arg.Slugs = append(arg.Slugs, item.Slug)
+		arg.Descriptions = append(arg.Descriptions, item.Description)
+		arg.Prices = append(arg.Prices, item.Price)
+		arg.CategoryIds = append(arg.CategoryIds, item.CategoryID)
+		arg.BrandIds = append(arg.BrandIds, item.BrandID)
+		arg.IsActives = append(arg.IsActives, item.IsActive)
+	}
+	return arg
+}
+
+// importBatchSize: request > env PRODUCT_IMPORT_BATCH_SIZE > default
+func importBatchSize(requested int) int {
+	size := requested
+	if size <= 0 {
+		size, _ = strconv.Atoi(os.Getenv("PRODUCT_IMPORT_BATCH_SIZE"))
+	}
+	if size <= 0 {
+		size = constants.ProductImportDefaultBatchSize
+	}
+	if size > constants.ProductImportMaxBatchSize {
+		size = constants.ProductImportMaxBatchSize
+	}
+	return size
+}
+
+func parseImportBool(raw string) (bool, bool) {
+	switch strings.ToLower(raw) {
+	case "true", "1", "yes", "y":
+		return true, true
+	case "false", "0", "no", "n":
+		return false, true
+	}
+	return false, false
+}
+
+// Export menulis produk (filter sama dengan list admin) sebagai CSV ke w,
+// diambil per halaman agar tidak memuat semua produk ke memori.
+func (s *service) Export(ctx context.Context, req ListProductAdminRequest, w io.Writer) error {
+	params := dbgen.ExportProductsParams{
+		Limit:  constants.ProductExportPageSize,
+		Search: dbgen.NewNullString(req.Search),
+	}
+	if req.Category != "" {
+		catID, err := uuid.Parse(req.Category)
+		if err != nil {
+			return producterrors.ErrInvalidCategoryID
+		}
+		params.CategoryID = uuid.NullUUID{UUID: catID, Valid: true}
+	}
+
+	// Halaman pertama diambil sebelum menulis apa pun,
+	// agar error awal masih bisa dikembalikan sebagai JSON
+	rows, err := s.repo.ListForExport(ctx, params)
+	if err != nil {
+		return producterrors.ErrProductFailed
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(importColumns); err != nil {
+		return err
+	}
+
+	for {
+		for _, row := range rows {
+			record := []string{
+				row.Sku.String,
+				row.Name,
+				row.Description.String,
+				row.Price,
+				strconv.Itoa(int(row.Stock)),
+				row.CategorySlug,
+				row.BrandSlug.String,
+				strconv.FormatBool(row.IsActive.Bool),
+			}
+			if err := cw.Write(record); err != nil {
+				return err
+			}
+		}
+
+		cw.Flush()
+		if err := cw.Error(); err != nil {
+			return err
+		}
+		if f, ok := w.(interface{ Flush() }); ok {
+			f.Flush()
+		}
+
+		if len(rows) < constants.ProductExportPageSize {
+			return nil
+		}
+
+		params.Offset += constants.ProductExportPageSize
+		rows, err = s.repo.ListForExport(ctx, params)
+		if err != nil {
+			return producterrors.ErrProductFailed
+		}
+	}
+}
+
+// --- JADWAL TAYANG & HARGA ---
+
+// parseScheduleTime RFC3339; string kosong = NULL
+func parseScheduleTime(raw string) (sql.NullTime, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return sql.NullTime{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return sql.NullTime{}, producterrors.ErrInvalidPublishSchedule
+	}
+	return sql.NullTime{Time: t.UTC(), Valid: true}, nil
+}
+
+func validatePublishWindow(publishedAt, unpublishedAt sql.NullTime) error {
+	if publishedAt.Valid && unpublishedAt.Valid && !unpublishedAt.Time.After(publishedAt.Time) {
+		return producterrors.ErrInvalidPublishSchedule
+	}
+	return nil
+}
+
+// displayPrices harga efektif + harga coret.
+// Harga coret = compare_at jadwal aktif, atau harga dasar jika harga jadwal lebih murah.
+func displayPrices(base, effective string, compareAt sql.NullString) (float64, *float64) {
+	basePrice, _ := strconv.ParseFloat(base, 64)
+	price, err := strconv.ParseFloat(effective, 64)
+	if err != nil || effective == "" {
+		price = basePrice
+	}
+
+	original := basePrice
+	if compareAt.Valid {
+		original, _ = strconv.ParseFloat(compareAt.String, 64)
+	}
+	if original <= price {
+		return price, nil
+	}
+	return price, &original
+}
+
+func nullTimePtr(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+func (s *service) ListPrices(ctx context.Context, productID string) ([]ProductPriceResponse, error) {
+	pid, err := uuid.Parse(productID)
+	if err != nil {
+		return nil, producterrors.ErrInvalidProductID
+	}
+
+	rows, err := s.repo.ListPrices(ctx, pid)
+	if err != nil {
+		return nil, producterrors.ErrProductFailed
+	}
+
+	res := make([]ProductPriceResponse, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, mapPriceResponse(row))
+	}
+	return res, nil
+}
+
+func (s *service) CreatePrice(ctx context.Context, productID string, req CreateProductPriceRequest) (ProductPriceResponse, error) {
+	pid, err := uuid.Parse(productID)
+	if err != nil {
+		return ProductPriceResponse{}, producterrors.ErrInvalidProductID
+	}
+
+	// 1. Validasi jadwal
+	if req.Price <= 0 || req.StartsAt.IsZero() {
+		return ProductPriceResponse{}, producterrors.ErrInvalidPriceSchedule
+	}
+	if req.EndsAt != nil && !req.EndsAt.After(req.StartsAt) {
+		return ProductPriceResponse{}, producterrors.ErrInvalidPriceSchedule
+	}
+	if req.CompareAtPrice != nil && *req.CompareAtPrice <= req.Price {
+		return ProductPriceResponse{}, producterrors.ErrInvalidPriceSchedule
+	}
+
+	// 2. Produk harus ada
+	if _, err := s.repo.GetByID(ctx, pid); err != nil {
+		if err == sql.ErrNoRows {
+			return ProductPriceResponse{}, producterrors.ErrProductNotFound
+		}
+		return ProductPriceResponse{}, producterrors.ErrProductFailed
+	}
+
+	arg := dbgen.CreateProductPriceParams{
+		ProductID: pid,
+		Price:     fmt.Sprintf("%.2f", req.Price),
+		StartsAt:  req.StartsAt.UTC(),
+	}
+	if req.CompareAtPrice != nil {
+		arg.CompareAtPrice = dbgen.NewNullString(fmt.Sprintf("%.2f", *req.CompareAtPrice))
+	}
+	if req.EndsAt != nil {
+		arg.EndsAt = sql.NullTime{Time: req.EndsAt.UTC(), Valid: true}
+	}
+
+	row, err := s.repo.CreatePrice(ctx, arg)
+	if err != nil {
+		return ProductPriceResponse{}, producterrors.ErrProductFailed
+	}
+
+	// 3. Jadwal yang langsung berlaku -> hook harga turun; gagal tidak membatalkan jadwal,
+	// job delivery menyapu ulang langganan harga turun
+	if s.priceWatcher != nil && !row.StartsAt.After(time.Now()) {
+		_ = s.priceWatcher.OnPriceChanged(ctx, nil, pid)
+	}
+	return mapPriceResponse(row), nil
+}
+
+func (s *service) DeletePrice(ctx context.Context, productID, priceID string) error {
+	pid, err := uuid.Parse(productID)
+	if err != nil {
+		return producterrors.ErrInvalidProductID
+	}
+	id, err := uuid.Parse(priceID)
+	if err != nil {
+		return producterrors.ErrPriceScheduleNotFound
+	}
+
+	if err := s.repo.DeletePrice(ctx, id, pid); err != nil {
+		if err == sql.ErrNoRows {
+			return producterrors.ErrPriceScheduleNotFound
+		}
+		return producterrors.ErrProductFailed
+	}
+	return nil
+}
+
+func mapPriceResponse(p dbgen.ProductPrice) ProductPriceResponse {
+	price, _ := strconv.ParseFloat(p.Price, 64)
+	res := ProductPriceResponse{
+		ID:        p.ID.String(),
+		ProductID: p.ProductID.String(),
+		Price:     price,
+		StartsAt:  p.StartsAt,
+		EndsAt:    nullTimePtr(p.EndsAt),
+		CreatedAt: p.CreatedAt,
+	}
+	if p.CompareAtPrice.Valid {
+		compareAt, _ := strconv.ParseFloat(p.CompareAtPrice.String, 64)
+		res.CompareAtPrice = &compareAt
+	}
+	return res
+}
+
+// isTaxClassViolation kode tax_class tidak ada di tabel tax_classes
+func isTaxClassViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23503" && pqErr.Constraint == "fk_products_tax_class"
+}
@@ -1,6 +1,7 @@
 package product_test
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
 	"errors"
@@ -8,12 +9,16 @@ import (
 	"testing"
 	"time"
 
+	"go-sqlc-starter/internal/api/v1/inventory"
 	"go-sqlc-starter/internal/api/v1/product"
+	producterrors "go-sqlc-starter/internal/api/v1/product/errors"
 	"go-sqlc-starter/internal/dbgen"
 	"go-sqlc-starter/internal/pkg/constants"
+	"go-sqlc-starter/internal/pkg/cursor"
 
 	categoryMock "go-sqlc-starter/internal/api/v1/mock/category"
 	cloudinaryMock "go-sqlc-starter/internal/api/v1/mock/cloudinary"
+	inventoryMock "go-sqlc-starter/internal/api/v1/mock/inventory"
 	productMock "go-sqlc-starter/internal/api/v1/mock/product"
 	reviewMock "go-sqlc-starter/internal/api/v1/mock/review"
 
@@ -35,10 +40,12 @@ type serviceDeps struct {
 	catRepo    *categoryMock.MockRepository
 	reviewRepo *reviewMock.MockRepository
 	cloudinary *cloudinaryMock.MockService
+	inventory  *inventoryMock.MockService
 }
 
 func setupServiceTest(t *testing.T) *serviceDeps {
 	t.Helper()
+	t.Setenv("CURSOR_SECRET", "cursor-test-secret")
 
 	ctrl := gomock.NewController(t)
 	db, sqlMock, _ := sqlmock.New()
@@ -47,8 +54,9 @@ func setupServiceTest(t *testing.T) *serviceDeps {
 	catRepo := categoryMock.NewMockRepository(ctrl)
 	reviewRepo := reviewMock.NewMockRepository(ctrl)
 	cloudinary := cloudinaryMock.NewMockService(ctrl)
+	inventorySvc := inventoryMock.NewMockService(ctrl)
 
-	svc := product.NewService(db, repo, catRepo, reviewRepo, cloudinary)
+	svc := product.NewService(db, repo, catRepo, reviewRepo, cloudinary, inventorySvc, nil)
 
 	return &serviceDeps{
 		db:         db,
@@ -58,6 +66,7 @@ func setupServiceTest(t *testing.T) *serviceDeps {
 		catRepo:    catRepo,
 		reviewRepo: reviewRepo,
 		cloudinary: cloudinary,
+		inventory:  inventorySvc,
 	}
 }
 
@@ -102,12 +111,23 @@ func TestProductService_Create(t *testing.T) {
 		// PERBAIKAN: Gunakan interface matcher untuk file yang tidak nil
 		// Jika Anda ingin benar-benar mensimulasikan file, Anda butuh dummy struct yang mengimplementasikan multipart.File
 
+		deps.repo.EXPECT().SlugExists(gomock.Any(), "iphone-15", uuid.Nil).Return(false, nil)
 		expectTx(t, deps.sqlMock, true)
 
 		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
 		deps.catRepo.EXPECT().GetByID(gomock.Any(), catID).Return(dbgen.Category{ID: catID}, nil)
 		deps.repo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(dbgen.Product{ID: productID}, nil)
 
+		// Stok awal masuk ledger
+		deps.inventory.EXPECT().
+			Record(gomock.Any(), gomock.Any(), inventory.Movement{
+				ProductID: productID,
+				Delta:     10,
+				Reason:    constants.StockReasonInitial,
+				Note:      "initial stock",
+			}).
+			Return(dbgen.StockMovement{}, nil)
+
 		// UploadImage akan dipanggil karena kita akan passing 'not nil' value di pemanggilan service
 		deps.cloudinary.EXPECT().
 			UploadImage(gomock.Any(), gomock.Any(), gomock.Any(), constants.CloudinaryProductFolder).
@@ -137,6 +157,10 @@ func TestProductService_Create(t *testing.T) {
 		// Gunakan pointer kosong atau implementasi dummy agar tidak nil saat dipanggil
 		fakeFile := &mockFile{}
 
+		// Slug "iphone-15" sudah dipakai -> fallback ke "iphone-15-2"
+		deps.repo.EXPECT().SlugExists(gomock.Any(), "iphone-15", uuid.Nil).Return(true, nil)
+		deps.repo.EXPECT().SlugExists(gomock.Any(), "iphone-15-2", uuid.Nil).Return(false, nil)
+
 		expectTx(t, deps.sqlMock, false)
 
 		deps.repo.EXPECT().
@@ -151,6 +175,10 @@ func TestProductService_Create(t *testing.T) {
 			Create(gomock.Any(), gomock.Any()).
 			Return(dbgen.Product{ID: productID}, nil)
 
+		deps.inventory.EXPECT().
+			Record(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(dbgen.StockMovement{}, nil)
+
 		// PERBAIKAN DI SINI:
 		// Gunakan gomock.Any() untuk argumen kedua (file)
 		deps.cloudinary.EXPECT().
@@ -178,6 +206,7 @@ func TestProductService_Update(t *testing.T) {
 	existing := dbgen.GetProductByIDRow{
 		ID:   id,
 		Name: "Old Name",
+		Slug: "old-name",
 		ImageUrl: sql.NullString{
 			String: "https://old.jpg",
 			Valid:  true,
@@ -205,8 +234,18 @@ func TestProductService_Update(t *testing.T) {
 			UploadImage(ctx, gomock.Any(), gomock.Any(), constants.CloudinaryProductFolder).
 			Return("https://new.jpg", nil)
 
-		// 4. Mock Update di DB
-		deps.repo.EXPECT().Update(ctx, gomock.Any()).Return(dbgen.Product{}, nil)
+		// 4. Mock Update di DB (nama berubah -> slug baru)
+		deps.repo.EXPECT().SlugExists(ctx, "new-name", id).Return(false, nil)
+		deps.repo.EXPECT().
+			Update(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, arg dbgen.UpdateProductParams) (dbgen.Product, error) {
+				assert.Equal(t, "new-name", arg.Slug)
+				return dbgen.Product{}, nil
+			})
+
+		// Slug lama disimpan sebagai redirect
+		deps.repo.EXPECT().DeleteSlugRedirect(ctx, "new-name").Return(nil)
+		deps.repo.EXPECT().CreateSlugRedirect(ctx, "old-name", id).Return(nil)
 
 		// 5. Mock Hapus gambar lama
 		deps.cloudinary.EXPECT().DeleteImage(ctx, existing.ImageUrl.String).Return(nil)
@@ -225,6 +264,71 @@ func TestProductService_Update(t *testing.T) {
 		assert.Equal(t, req.Name, res.Name)
 	})
 
+	t.Run("positive - stock set to zero is recorded as adjustment", func(t *testing.T) {
+		stock := int32(0)
+		current := dbgen.GetProductByIDRow{ID: id, Name: "Old Name", Slug: "old-name", Stock: 7}
+
+		expectTx(t, deps.sqlMock, true)
+		deps.repo.EXPECT().GetByID(ctx, id).Return(current, nil)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo).AnyTimes()
+		deps.repo.EXPECT().Update(ctx, gomock.Any()).Return(dbgen.Product{}, nil)
+		// Stok berubah (restock) setelah dibaca di awal; selisih dari stok yang terkunci
+		deps.repo.EXPECT().LockStock(ctx, id).Return(int32(9), nil)
+		deps.inventory.EXPECT().
+			Record(ctx, gomock.Any(), inventory.Movement{
+				ProductID: id,
+				Delta:     -9,
+				Reason:    constants.StockReasonAdjustment,
+				Note:      "product update",
+			}).
+			Return(dbgen.StockMovement{StockAfter: 0}, nil)
+		deps.repo.EXPECT().GetByID(ctx, id).Return(dbgen.GetProductByIDRow{ID: id, Name: "Old Name"}, nil)
+
+		res, err := deps.service.Update(ctx, id.String(), product.UpdateProductRequest{Stock: &stock}, nil, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, int32(0), res.Stock)
+	})
+
+	t.Run("positive - locked stock already matches, no ledger entry", func(t *testing.T) {
+		stock := int32(5)
+		current := dbgen.GetProductByIDRow{ID: id, Name: "Old Name", Slug: "old-name", Stock: 7}
+
+		expectTx(t, deps.sqlMock, true)
+		deps.repo.EXPECT().GetByID(ctx, id).Return(current, nil)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo).AnyTimes()
+		deps.repo.EXPECT().Update(ctx, gomock.Any()).Return(dbgen.Product{}, nil)
+		deps.repo.EXPECT().LockStock(ctx, id).Return(int32(5), nil)
+		deps.repo.EXPECT().GetByID(ctx, id).Return(dbgen.GetProductByIDRow{ID: id, Name: "Old Name", Stock: 5}, nil)
+
+		res, err := deps.service.Update(ctx, id.String(), product.UpdateProductRequest{Stock: &stock}, nil, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, int32(5), res.Stock)
+	})
+
+	t.Run("negative - unpublish before publish", func(t *testing.T) {
+		publishedAt := "2026-12-01T00:00:00Z"
+		unpublishedAt := "2026-11-01T00:00:00Z"
+		deps.repo.EXPECT().GetByID(ctx, id).Return(dbgen.GetProductByIDRow{ID: id, Name: "Old Name", Slug: "old-name"}, nil)
+
+		_, err := deps.service.Update(ctx, id.String(), product.UpdateProductRequest{
+			PublishedAt:   &publishedAt,
+			UnpublishedAt: &unpublishedAt,
+		}, nil, "")
+
+		assert.ErrorIs(t, err, producterrors.ErrInvalidPublishSchedule)
+	})
+
+	t.Run("negative - weight below zero", func(t *testing.T) {
+		weight := int32(-1)
+		deps.repo.EXPECT().GetByID(ctx, id).Return(dbgen.GetProductByIDRow{ID: id, Name: "Old Name", Slug: "old-name"}, nil)
+
+		_, err := deps.service.Update(ctx, id.String(), product.UpdateProductRequest{WeightGrams: &weight}, nil, "")
+
+		assert.ErrorIs(t, err, producterrors.ErrInvalidWeight)
+	})
+
 	t.Run("negative - product not found", func(t *testing.T) {
 		deps.repo.EXPECT().
 			GetByID(ctx, id).
@@ -333,6 +437,105 @@ func TestProductService_ListPublic(t *testing.T) {
 		assert.Equal(t, int64(1), total)
 		assert.Len(t, res, 1)
 	})
+
+	t.Run("positive - scheduled sale price with strike-through", func(t *testing.T) {
+		rows := []dbgen.ListProductsPublicRow{
+			{ID: uuid.New(), Name: "On Sale", Price: "100.00", EffectivePrice: "80.00", TotalCount: 3},
+			{ID: uuid.New(), Name: "Compare At", Price: "100.00", EffectivePrice: "90.00", CompareAtPrice: sql.NullString{String: "120.00", Valid: true}, TotalCount: 3},
+			{ID: uuid.New(), Name: "Regular", Price: "100.00", EffectivePrice: "100.00", TotalCount: 3},
+		}
+		deps.repo.EXPECT().ListPublic(ctx, gomock.Any()).Return(rows, nil)
+
+		res, _, err := deps.service.ListPublic(ctx, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 80.0, res[0].Price)
+		assert.Equal(t, 100.0, *res[0].OriginalPrice)
+		assert.Equal(t, 90.0, res[1].Price)
+		assert.Equal(t, 120.0, *res[1].OriginalPrice)
+		assert.Equal(t, 100.0, res[2].Price)
+		assert.Nil(t, res[2].OriginalPrice)
+	})
+
+	t.Run("positive - search builds prefix tsquery", func(t *testing.T) {
+		searchReq := product.ListPublicRequest{
+			Page:   1,
+			Limit:  10,
+			Search: "  iPhone 15-Pro ",
+			SortBy: "relevance",
+		}
+
+		deps.repo.EXPECT().
+			ListPublic(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, p dbgen.ListProductsPublicParams) ([]dbgen.ListProductsPublicRow, error) {
+				assert.Equal(t, sql.NullString{String: "iPhone 15-Pro", Valid: true}, p.Search)
+				assert.Equal(t, sql.NullString{String: "iphone:* & 15:* & pro:*", Valid: true}, p.SearchQuery)
+				assert.Equal(t, "relevance", p.SortBy)
+				return []dbgen.ListProductsPublicRow{}, nil
+			})
+
+		_, _, err := deps.service.ListPublic(ctx, searchReq)
+		assert.NoError(t, err)
+	})
+
+	t.Run("positive - empty search leaves filters null", func(t *testing.T) {
+		deps.repo.EXPECT().
+			ListPublic(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, p dbgen.ListProductsPublicParams) ([]dbgen.ListProductsPublicRow, error) {
+				assert.False(t, p.Search.Valid)
+				assert.False(t, p.SearchQuery.Valid)
+				return []dbgen.ListProductsPublicRow{}, nil
+			})
+
+		_, _, err := deps.service.ListPublic(ctx, req)
+		assert.NoError(t, err)
+	})
+}
+
+//
+// ======================= SUGGEST =======================
+//
+
+func TestProductService_Suggest(t *testing.T) {
+	deps := setupServiceTest(t)
+	defer deps.db.Close()
+
+	ctx := context.Background()
+
+	t.Run("positive - returns suggestions", func(t *testing.T) {
+		deps.repo.EXPECT().
+			Suggest(ctx, dbgen.SuggestProductsParams{
+				Limit:       5,
+				SearchQuery: "iph:*",
+				Search:      "iph",
+			}).
+			Return([]dbgen.SuggestProductsRow{
+				{ID: uuid.New(), Name: "iPhone 15", Slug: "iphone-15"},
+			}, nil)
+
+		res, err := deps.service.Suggest(ctx, "iph", 0)
+
+		assert.NoError(t, err)
+		assert.Len(t, res, 1)
+		assert.Equal(t, "iphone-15", res[0].Slug)
+	})
+
+	t.Run("positive - blank query skips repository", func(t *testing.T) {
+		res, err := deps.service.Suggest(ctx, "   ", 5)
+
+		assert.NoError(t, err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("negative - repository error", func(t *testing.T) {
+		deps.repo.EXPECT().
+			Suggest(ctx, gomock.Any()).
+			Return(nil, errors.New("db error"))
+
+		_, err := deps.service.Suggest(ctx, "iph", 5)
+
+		assert.Error(t, err)
+	})
 }
 
 //
@@ -412,18 +615,131 @@ func TestProductService_GetBySlug(t *testing.T) {
 	slug := "iphone-15-abcde"
 
 	t.Run("success", func(t *testing.T) {
+		// Agregat rating sudah didenormalisasi di kolom produk
 		deps.repo.EXPECT().GetBySlug(ctx, slug).Return(dbgen.GetProductBySlugRow{
-			ID: id, Name: "iPhone 15", Slug: slug, Price: "1500.00",
+			ID: id, Name: "iPhone 15", Slug: slug, Price: "1500.00", EffectivePrice: "1500.00",
+			RatingAvg: 4.5, RatingCount: 10, Rating5Count: 6, Rating4Count: 3, Rating3Count: 1,
 		}, nil)
 
 		deps.reviewRepo.EXPECT().GetByProductID(ctx, id, int32(5), int32(0)).Return(nil, nil)
-		deps.reviewRepo.EXPECT().GetAverageRating(ctx, id).Return(4.5, nil)
-		deps.reviewRepo.EXPECT().CountByProductID(ctx, id).Return(int64(10), nil)
+		deps.catRepo.EXPECT().GetBreadcrumbs(ctx, gomock.Any()).Return([]dbgen.GetCategoryBreadcrumbsRow{
+			{ID: uuid.New(), Name: "Elektronik", Slug: "elektronik"},
+			{ID: uuid.New(), Name: "Smartphone", Slug: "smartphone"},
+		}, nil)
 
 		res, err := deps.service.GetBySlug(ctx, slug)
 		assert.NoError(t, err)
 		assert.Equal(t, slug, res.Slug)
+		assert.Len(t, res.Breadcrumbs, 2)
+		assert.Equal(t, "elektronik", res.Breadcrumbs[0].Slug)
 		assert.Equal(t, 4.5, res.AverageRating)
+		assert.Equal(t, int64(10), res.RatingCount)
+		assert.Equal(t, map[int]int32{1: 0, 2: 0, 3: 1, 4: 3, 5: 6}, res.RatingHistogram)
+		assert.Equal(t, "iPhone 15", res.MetaTitle)
+	})
+
+	t.Run("success - review summary dengan foto & balasan merchant", func(t *testing.T) {
+		deps.repo.EXPECT().GetBySlug(ctx, slug).Return(dbgen.GetProductBySlugRow{
+			ID: id, Name: "iPhone 15", Slug: slug, Price: "1500.00", EffectivePrice: "1500.00",
+		}, nil)
+
+		deps.reviewRepo.EXPECT().GetByProductID(ctx, id, int32(5), int32(0)).Return([]dbgen.GetReviewsByProductIDRow{
+			{ID: uuid.New(), Rating: 5, PhotoUrls: []string{"https://cdn/1.jpg"}, ReplyBody: sql.NullString{String: "Terima kasih!", Valid: true}},
+			{ID: uuid.New(), Rating: 4},
+		}, nil)
+		deps.catRepo.EXPECT().GetBreadcrumbs(ctx, gomock.Any()).Return(nil, nil)
+
+		res, err := deps.service.GetBySlug(ctx, slug)
+		assert.NoError(t, err)
+		assert.Len(t, res.Reviews, 2)
+		assert.Equal(t, []string{"https://cdn/1.jpg"}, res.Reviews[0].Photos)
+		assert.Equal(t, "Terima kasih!", res.Reviews[0].Reply.Body)
+		assert.Empty(t, res.Reviews[1].Photos)
+		assert.Nil(t, res.Reviews[1].Reply)
+	})
+}
+
+func TestProductService_CreatePrice(t *testing.T) {
+	deps := setupServiceTest(t)
+	defer deps.db.Close()
+
+	ctx := context.Background()
+	id := uuid.New()
+	startsAt := time.Date(2026, 11, 11, 0, 0, 0, 0, time.UTC)
+	endsAt := startsAt.Add(24 * time.Hour)
+	compareAt := 15000000.0
+
+	t.Run("success", func(t *testing.T) {
+		deps.repo.EXPECT().GetByID(ctx, id).Return(dbgen.GetProductByIDRow{ID: id}, nil)
+		deps.repo.EXPECT().
+			CreatePrice(ctx, dbgen.CreateProductPriceParams{
+				ProductID:      id,
+				Price:          "12000000.00",
+				CompareAtPrice: sql.NullString{String: "15000000.00", Valid: true},
+				StartsAt:       startsAt,
+				EndsAt:         sql.NullTime{Time: endsAt, Valid: true},
+			}).
+			Return(dbgen.ProductPrice{ID: uuid.New(), ProductID: id, Price: "12000000.00", CompareAtPrice: sql.NullString{String: "15000000.00", Valid: true}, StartsAt: startsAt}, nil)
+
+		res, err := deps.service.CreatePrice(ctx, id.String(), product.CreateProductPriceRequest{
+			Price:          12000000,
+			CompareAtPrice: &compareAt,
+			StartsAt:       startsAt,
+			EndsAt:         &endsAt,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 12000000.0, res.Price)
+		assert.Equal(t, compareAt, *res.CompareAtPrice)
+	})
+
+	t.Run("fail - window ends before start", func(t *testing.T) {
+		before := startsAt.Add(-time.Hour)
+		_, err := deps.service.CreatePrice(ctx, id.String(), product.CreateProductPriceRequest{
+			Price:    12000000,
+			StartsAt: startsAt,
+			EndsAt:   &before,
+		})
+		assert.ErrorIs(t, err, producterrors.ErrInvalidPriceSchedule)
+	})
+
+	t.Run("fail - compare-at not above price", func(t *testing.T) {
+		low := 1000.0
+		_, err := deps.service.CreatePrice(ctx, id.String(), product.CreateProductPriceRequest{
+			Price:          12000000,
+			CompareAtPrice: &low,
+			StartsAt:       startsAt,
+		})
+		assert.ErrorIs(t, err, producterrors.ErrInvalidPriceSchedule)
+	})
+
+	t.Run("fail - product not found", func(t *testing.T) {
+		deps.repo.EXPECT().GetByID(ctx, id).Return(dbgen.GetProductByIDRow{}, sql.ErrNoRows)
+
+		_, err := deps.service.CreatePrice(ctx, id.String(), product.CreateProductPriceRequest{Price: 100, StartsAt: startsAt})
+		assert.ErrorIs(t, err, producterrors.ErrProductNotFound)
+	})
+}
+
+func TestProductService_ResolveSlug(t *testing.T) {
+	deps := setupServiceTest(t)
+	defer deps.db.Close()
+
+	ctx := context.Background()
+
+	t.Run("success - old slug resolved", func(t *testing.T) {
+		deps.repo.EXPECT().GetSlugRedirect(ctx, "iphone-15").Return("iphone-15-pro", nil)
+
+		slug, err := deps.service.ResolveSlug(ctx, "iphone-15")
+		assert.NoError(t, err)
+		assert.Equal(t, "iphone-15-pro", slug)
+	})
+
+	t.Run("fail - no redirect", func(t *testing.T) {
+		deps.repo.EXPECT().GetSlugRedirect(ctx, "unknown").Return("", sql.ErrNoRows)
+
+		_, err := deps.service.ResolveSlug(ctx, "unknown")
+		assert.ErrorIs(t, err, producterrors.ErrProductNotFound)
 	})
 }
 
@@ -491,3 +807,318 @@ func TestProductService_Restore(t *testing.T) {
 		assert.Equal(t, id.String(), res.ID)
 	})
 }
+
+//
+// ======================= LIST PUBLIC BY CURSOR =======================
+//
+
+func TestProductService_ListPublicByCursor(t *testing.T) {
+	deps := setupServiceTest(t)
+	defer deps.db.Close()
+
+	ctx := context.Background()
+	now := time.Now().UTC()
+	rows := []dbgen.ListProductsPublicKeysetRow{
+		{ID: uuid.New(), Name: "P1", Price: "100.00", CreatedAt: now},
+		{ID: uuid.New(), Name: "P2", Price: "90.00", CreatedAt: now.Add(-time.Minute)},
+		{ID: uuid.New(), Name: "P3", Price: "80.00", CreatedAt: now.Add(-2 * time.Minute)},
+	}
+
+	t.Run("positive - first page has next cursor only", func(t *testing.T) {
+		deps.repo.EXPECT().
+			ListPublicKeyset(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, p dbgen.ListProductsPublicKeysetParams) ([]dbgen.ListProductsPublicKeysetRow, error) {
+				// limit + 1 untuk deteksi halaman berikut
+				assert.Equal(t, int32(3), p.Limit)
+				assert.Equal(t, "newest", p.SortBy)
+				assert.False(t, p.CursorID.Valid)
+				return rows, nil
+			})
+
+		res, page, err := deps.service.ListPublicByCursor(ctx, product.ListPublicRequest{Limit: 2})
+
+		assert.NoError(t, err)
+		assert.Len(t, res, 2)
+		assert.NotEmpty(t, page.Next)
+		assert.Empty(t, page.Prev)
+	})
+
+	t.Run("positive - next cursor continues after last row", func(t *testing.T) {
+		deps.repo.EXPECT().ListPublicKeyset(ctx, gomock.Any()).Return(rows, nil)
+		_, first, err := deps.service.ListPublicByCursor(ctx, product.ListPublicRequest{Limit: 2})
+		assert.NoError(t, err)
+
+		deps.repo.EXPECT().
+			ListPublicKeyset(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, p dbgen.ListProductsPublicKeysetParams) ([]dbgen.ListProductsPublicKeysetRow, error) {
+				assert.Equal(t, rows[1].ID, p.CursorID.UUID)
+				assert.True(t, p.CursorCreatedAt.Time.Equal(rows[1].CreatedAt))
+				return rows[2:], nil
+			})
+
+		res, page, err := deps.service.ListPublicByCursor(ctx, product.ListPublicRequest{Limit: 2, Cursor: first.Next})
+
+		assert.NoError(t, err)
+		assert.Len(t, res, 1)
+		assert.Empty(t, page.Next)
+		assert.NotEmpty(t, page.Prev)
+	})
+
+	t.Run("positive - prev cursor flips sort and restores order", func(t *testing.T) {
+		deps.repo.EXPECT().ListPublicKeyset(ctx, gomock.Any()).Return(rows, nil)
+		_, first, _ := deps.service.ListPublicByCursor(ctx, product.ListPublicRequest{Limit: 2})
+		deps.repo.EXPECT().ListPublicKeyset(ctx, gomock.Any()).Return(rows[2:], nil)
+		_, second, _ := deps.service.ListPublicByCursor(ctx, product.ListPublicRequest{Limit: 2, Cursor: first.Next})
+
+		deps.repo.EXPECT().
+			ListPublicKeyset(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, p dbgen.ListProductsPublicKeysetParams) ([]dbgen.ListProductsPublicKeysetRow, error) {
+				assert.Equal(t, "oldest", p.SortBy)
+				assert.Equal(t, rows[2].ID, p.CursorID.UUID)
+				// Urutan terbalik dari query (oldest dulu)
+				return []dbgen.ListProductsPublicKeysetRow{rows[1], rows[0]}, nil
+			})
+
+		res, page, err := deps.service.ListPublicByCursor(ctx, product.ListPublicRequest{Limit: 2, Cursor: second.Prev})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "P1", res[0].Name)
+		assert.Equal(t, "P2", res[1].Name)
+		assert.NotEmpty(t, page.Next)
+		assert.Empty(t, page.Prev)
+	})
+
+	t.Run("negative - cursor from another sort", func(t *testing.T) {
+		deps.repo.EXPECT().ListPublicKeyset(ctx, gomock.Any()).Return(rows, nil)
+		_, first, _ := deps.service.ListPublicByCursor(ctx, product.ListPublicRequest{Limit: 2})
+
+		_, _, err := deps.service.ListPublicByCursor(ctx, product.ListPublicRequest{Limit: 2, SortBy: "price_low", Cursor: first.Next})
+		assert.ErrorIs(t, err, cursor.ErrInvalidCursor)
+	})
+
+	t.Run("negative - tampered cursor", func(t *testing.T) {
+		_, _, err := deps.service.ListPublicByCursor(ctx, product.ListPublicRequest{Limit: 2, Cursor: "eyJzIjoibmV3ZXN0In0.bad"})
+		assert.ErrorIs(t, err, cursor.ErrInvalidCursor)
+	})
+
+	t.Run("negative - relevance not supported", func(t *testing.T) {
+		_, _, err := deps.service.ListPublicByCursor(ctx, product.ListPublicRequest{Limit: 2, SortBy: "relevance"})
+		assert.ErrorIs(t, err, producterrors.ErrCursorSortUnsupported)
+	})
+}
+
+//
+// ======================= IMPORT / EXPORT =======================
+//
+
+func TestProductService_Import(t *testing.T) {
+	deps := setupServiceTest(t)
+	defer deps.db.Close()
+
+	ctx := context.Background()
+	catID := uuid.New()
+	brandID := uuid.New()
+	header := []string{"sku", "name", "price", "stock", "category_slug", "brand_slug"}
+
+	t.Run("fail - missing required header", func(t *testing.T) {
+		_, err := deps.service.Import(ctx, [][]string{{"sku", "name"}, {"A", "B"}}, product.ImportProductsRequest{})
+		assert.ErrorIs(t, err, producterrors.ErrImportInvalidHeader)
+	})
+
+	t.Run("dry run - reports row errors without writing", func(t *testing.T) {
+		rows := [][]string{
+			header,
+			{"IP15", "iPhone 15", "15000000", "10", "smartphone", "apple"},
+			{"IP15", "iPhone 15 Dup", "abc", "-1", "unknown", ""},
+		}
+
+		deps.catRepo.EXPECT().GetBySlug(ctx, "smartphone").Return(dbgen.Category{ID: catID}, nil)
+		deps.repo.EXPECT().GetBrandBySlug(ctx, "apple").Return(dbgen.Brand{ID: brandID}, nil)
+		deps.catRepo.EXPECT().GetBySlug(ctx, "unknown").Return(dbgen.Category{}, sql.ErrNoRows)
+
+		res, err := deps.service.Import(ctx, rows, product.ImportProductsRequest{DryRun: true})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, res.TotalRows)
+		assert.Equal(t, 1, res.Failed)
+
+		fields := []string{}
+		for _, e := range res.Errors {
+			assert.Equal(t, 3, e.Row)
+			fields = append(fields, e.Field)
+		}
+		assert.ElementsMatch(t, []string{"price", "stock", "category_slug", "sku"}, fields)
+	})
+
+	t.Run("dry run - counts create vs update", func(t *testing.T) {
+		rows := [][]string{
+			header,
+			{"IP15", "iPhone 15", "15000000", "10", "smartphone", ""},
+			{"IP16", "iPhone 16", "17000000", "5", "smartphone", ""},
+		}
+
+		deps.catRepo.EXPECT().GetBySlug(ctx, "smartphone").Return(dbgen.Category{ID: catID}, nil)
+		deps.repo.EXPECT().ListExistingSKUs(ctx, []string{"IP15", "IP16"}).Return([]string{"IP15"}, nil)
+
+		res, err := deps.service.Import(ctx, rows, product.ImportProductsRequest{DryRun: true})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, res.Created)
+		assert.Equal(t, 1, res.Updated)
+	})
+
+	t.Run("fail - invalid rows are not saved", func(t *testing.T) {
+		rows := [][]string{
+			header,
+			{"", "iPhone 15", "15000000", "10", "smartphone", ""},
+		}
+
+		deps.catRepo.EXPECT().GetBySlug(ctx, "smartphone").Return(dbgen.Category{ID: catID}, nil)
+
+		res, err := deps.service.Import(ctx, rows, product.ImportProductsRequest{})
+		assert.ErrorIs(t, err, producterrors.ErrImportValidation)
+		assert.Equal(t, "sku", res.Errors[0].Field)
+	})
+
+	t.Run("success - upsert in batches inside transaction", func(t *testing.T) {
+		rows := [][]string{
+			header,
+			{"IP15", "iPhone 15", "15000000", "10", "smartphone", ""},
+			{"IP16", "iPhone 16", "17000000", "5", "smartphone", ""},
+			{"IP17", "iPhone 17", "19000000", "0", "smartphone", ""},
+		}
+		ip15, ip16 := uuid.New(), uuid.New()
+
+		deps.catRepo.EXPECT().GetBySlug(ctx, "smartphone").Return(dbgen.Category{ID: catID}, nil)
+
+		expectTx(t, deps.sqlMock, true)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+
+		// Slug unik hanya untuk SKU baru; slug yang bentrok diberi suffix
+		deps.repo.EXPECT().ListExistingSKUs(ctx, []string{"IP15", "IP16", "IP17"}).Return([]string{"IP15"}, nil)
+		deps.repo.EXPECT().SlugExists(ctx, "iphone-16", uuid.Nil).Return(false, nil)
+		deps.repo.EXPECT().SlugExists(ctx, "iphone-17", uuid.Nil).Return(true, nil)
+		deps.repo.EXPECT().SlugExists(ctx, "iphone-17-2", uuid.Nil).Return(false, nil)
+
+		// batch_size=2 -> 2 batch
+		deps.repo.EXPECT().
+			UpsertBatch(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, arg dbgen.UpsertProductsBatchParams) ([]dbgen.UpsertProductsBatchRow, error) {
+				assert.Equal(t, []string{"IP15", "IP16"}, arg.Skus)
+				assert.Equal(t, "iphone-16", arg.Slugs[1])
+				assert.Equal(t, "15000000.00", arg.Prices[0])
+				return []dbgen.UpsertProductsBatchRow{
+					{ID: ip15, Sku: "IP15", Stock: 4, Inserted: false},
+					{ID: ip16, Sku: "IP16", Stock: 0, Inserted: true},
+				}, nil
+			})
+		deps.repo.EXPECT().
+			UpsertBatch(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, arg dbgen.UpsertProductsBatchParams) ([]dbgen.UpsertProductsBatchRow, error) {
+				assert.Equal(t, []string{"iphone-17-2"}, arg.Slugs)
+				return []dbgen.UpsertProductsBatchRow{{Sku: "IP17", Inserted: true}}, nil
+			})
+
+		// Selisih ke stok target masuk ledger; IP17 (0 -> 0) tidak dicatat
+		deps.inventory.EXPECT().
+			Record(ctx, gomock.Any(), inventory.Movement{ProductID: ip15, Delta: 6, Reason: constants.StockReasonAdjustment, Note: "bulk import"}).
+			Return(dbgen.StockMovement{}, nil)
+		deps.inventory.EXPECT().
+			Record(ctx, gomock.Any(), inventory.Movement{ProductID: ip16, Delta: 5, Reason: constants.StockReasonInitial, Note: "bulk import"}).
+			Return(dbgen.StockMovement{}, nil)
+
+		res, err := deps.service.Import(ctx, rows, product.ImportProductsRequest{BatchSize: 2})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, res.Created)
+		assert.Equal(t, 1, res.Updated)
+	})
+
+	t.Run("fail - batch error rolls back", func(t *testing.T) {
+		rows := [][]string{
+			header,
+			{"IP15", "iPhone 15", "15000000", "10", "smartphone", ""},
+		}
+
+		deps.catRepo.EXPECT().GetBySlug(ctx, "smartphone").Return(dbgen.Category{ID: catID}, nil)
+
+		expectTx(t, deps.sqlMock, false)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		deps.repo.EXPECT().ListExistingSKUs(ctx, []string{"IP15"}).Return([]string{"IP15"}, nil)
+		deps.repo.EXPECT().UpsertBatch(ctx, gomock.Any()).Return(nil, errors.New("db error"))
+
+		_, err := deps.service.Import(ctx, rows, product.ImportProductsRequest{})
+		assert.ErrorIs(t, err, producterrors.ErrImportFailed)
+	})
+
+	t.Run("success - price drop notifies watcher", func(t *testing.T) {
+		rows := [][]string{
+			header,
+			{"IP15", "iPhone 15", "14000000", "4", "smartphone", ""},
+			{"IP16", "iPhone 16", "18000000", "0", "smartphone", ""},
+		}
+		ip15, ip16 := uuid.New(), uuid.New()
+		watcher := &priceWatcherStub{}
+		svc := product.NewService(deps.db, deps.repo, deps.catRepo, deps.reviewRepo, deps.cloudinary, deps.inventory, watcher)
+
+		deps.catRepo.EXPECT().GetBySlug(ctx, "smartphone").Return(dbgen.Category{ID: catID}, nil)
+
+		expectTx(t, deps.sqlMock, true)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		deps.repo.EXPECT().ListExistingSKUs(ctx, []string{"IP15", "IP16"}).Return([]string{"IP15", "IP16"}, nil)
+		deps.repo.EXPECT().
+			UpsertBatch(ctx, gomock.Any()).
+			Return([]dbgen.UpsertProductsBatchRow{
+				{ID: ip15, Sku: "IP15", Stock: 4, PriceDecreased: true},
+				{ID: ip16, Sku: "IP16", Stock: 0},
+			}, nil)
+
+		res, err := svc.Import(ctx, rows, product.ImportProductsRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, res.Updated)
+		assert.Equal(t, []uuid.UUID{ip15}, watcher.changed)
+	})
+}
+
+type priceWatcherStub struct {
+	changed []uuid.UUID
+}
+
+func (w *priceWatcherStub) OnPriceChanged(_ context.Context, _ dbgen.DBTX, productID uuid.UUID) error {
+	w.changed = append(w.changed, productID)
+	return nil
+}
+
+func TestProductService_Export(t *testing.T) {
+	deps := setupServiceTest(t)
+	defer deps.db.Close()
+
+	ctx := context.Background()
+
+	t.Run("success - writes csv with import columns", func(t *testing.T) {
+		deps.repo.EXPECT().
+			ListForExport(ctx, gomock.Any()).
+			Return([]dbgen.ExportProductsRow{{
+				Sku:          dbgen.NewNullString("IP15"),
+				Name:         "iPhone 15",
+				Price:        "15000000.00",
+				Stock:        10,
+				CategorySlug: "smartphone",
+				BrandSlug:    dbgen.NewNullString("apple"),
+				IsActive:     dbgen.NewNullBool(true),
+			}}, nil)
+
+		var buf bytes.Buffer
+		err := deps.service.Export(ctx, product.ListProductAdminRequest{}, &buf)
+		assert.NoError(t, err)
+		assert.Equal(t,
+			"sku,name,description,price,stock,category_slug,brand_slug,is_active\n"+
+				"IP15,iPhone 15,,15000000.00,10,smartphone,apple,true\n",
+			buf.String(),
+		)
+	})
+
+	t.Run("fail - invalid category id", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := deps.service.Export(ctx, product.ListProductAdminRequest{Category: "bad"}, &buf)
+		assert.ErrorIs(t, err, producterrors.ErrInvalidCategoryID)
+		assert.Empty(t, buf.String())
+	})
+}
@@ -91,4 +91,76 @@ var (
 		"Invalid review input",
 		http.StatusBadRequest,
 	)
+
+	ErrInvalidReviewStatus = apperror.New(
+		apperror.CodeInvalidState,
+		"Review cannot be moved to this status",
+		http.StatusConflict,
+	)
+
+	ErrInvalidStatusFilter = apperror.New(
+		apperror.CodeInvalidInput,
+		"Invalid review status filter",
+		http.StatusBadRequest,
+	)
+
+	ErrTooManyPhotos = apperror.New(
+		apperror.CodeInvalidInput,
+		"Too many photos",
+		http.StatusBadRequest,
+	)
+
+	ErrImageUploadFailed = apperror.New(
+		apperror.CodeInternalError,
+		"Failed to upload review photo",
+		http.StatusInternalServerError,
+	)
+
+	ErrReplyAlreadyExists = apperror.New(
+		apperror.CodeConflict,
+		"This review already has a reply",
+		http.StatusConflict,
+	)
+
+	ErrReplyNotFound = apperror.New(
+		apperror.CodeNotFound,
+		"Reply not found",
+		http.StatusNotFound,
+	)
+
+	ErrInvalidReviewSort = apperror.New(
+		apperror.CodeInvalidInput,
+		"Invalid review sort",
+		http.StatusBadRequest,
+	)
+
+	ErrCursorSortUnsupported = apperror.New(
+		apperror.CodeInvalidInput,
+		"Cursor pagination is not supported for this sort",
+		http.StatusBadRequest,
+	)
+
+	ErrCannotVoteOwnReview = apperror.New(
+		apperror.CodeForbidden,
+		"You cannot vote on your own review",
+		http.StatusForbidden,
+	)
+
+	ErrVoteNotFound = apperror.New(
+		apperror.CodeNotFound,
+		"Vote not found",
+		http.StatusNotFound,
+	)
+
+	ErrCannotReportOwnReview = apperror.New(
+		apperror.CodeForbidden,
+		"You cannot report your own review",
+		http.StatusForbidden,
+	)
+
+	ErrAlreadyReported = apperror.New(
+		apperror.CodeConflict,
+		"You have already reported this review",
+		http.StatusConflict,
+	)
 )
@@ -1,7 +1,9 @@
 package review
 
 import (
+	"context"
 	"go-sqlc-starter/internal/pkg/apperror"
+	"go-sqlc-starter/internal/pkg/httpx"
 	"go-sqlc-starter/internal/pkg/response"
 	"net/http"
 	"strconv"
@@ -21,18 +23,33 @@ func (ctrl *Controller) Create(c *gin.Context) {
 	userID, _ := c.Get("user_id")
 	productSlug := c.Param("slug")
 
+	// Body JSON (tanpa foto) atau multipart form-data (rating, comment, photos)
 	var req CreateReviewRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
+	if err := c.ShouldBind(&req); err != nil {
 		appErr := apperror.Wrap(err, apperror.CodeInvalidInput, "Invalid request body", http.StatusBadRequest)
 		httpErr := apperror.ToHTTP(appErr)
 		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, err.Error())
 		return
 	}
 
+	var photos []Photo
+	if c.Request.MultipartForm != nil {
+		for _, fh := range c.Request.MultipartForm.File["photos"] {
+			f, err := fh.Open()
+			if err != nil {
+				response.Error(c, http.StatusBadRequest, "FILE_ERROR", "Failed to open uploaded file", err.Error())
+				return
+			}
+			defer f.Close()
+
+			photos = append(photos, Photo{File: f, Filename: fh.Filename})
+		}
+	}
+
 	// Parsing userID ke string dilakukan langsung, validasi eksistensi ada di service/middleware
 	uid, _ := userID.(string)
 
-	res, err := ctrl.service.Create(c.Request.Context(), uid, productSlug, req)
+	res, err := ctrl.service.Create(c.Request.Context(), uid, productSlug, req, photos)
 	if err != nil {
 		httpErr := apperror.ToHTTP(err)
 		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
@@ -42,12 +59,34 @@ func (ctrl *Controller) Create(c *gin.Context) {
 	response.Success(c, http.StatusCreated, res, nil)
 }
 
+// GetReviewsByProductSlug GET /products/slug/:slug/reviews?sort_by=helpful&rating=5&with_photos=true
 func (ctrl *Controller) GetReviewsByProductSlug(c *gin.Context) {
 	productSlug := c.Param("slug")
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	withPhotos, _ := strconv.ParseBool(c.DefaultQuery("with_photos", "false"))
+	filter := ReviewListFilter{
+		Sort:       c.Query("sort_by"),
+		Rating:     c.Query("rating"),
+		WithPhotos: withPhotos,
+	}
 
-	res, err := ctrl.service.GetByProductSlug(c.Request.Context(), productSlug, page, limit)
+	if pg := httpx.ParseCursor(c); pg.Enabled {
+		res, cur, err := ctrl.service.GetByProductSlugByCursor(c.Request.Context(), productSlug, filter, pg.Cursor, limit)
+		if err != nil {
+			httpErr := apperror.ToHTTP(err)
+			response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+			return
+		}
+		response.Success(c, http.StatusOK, res, &response.PaginationMeta{
+			PageSize:   res.Limit,
+			NextCursor: cur.Next,
+			PrevCursor: cur.Prev,
+		})
+		return
+	}
+
+	res, err := ctrl.service.GetByProductSlug(c.Request.Context(), productSlug, filter, page, limit)
 	if err != nil {
 		httpErr := apperror.ToHTTP(err)
 		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
@@ -147,3 +186,221 @@ func (ctrl *Controller) DeleteReview(c *gin.Context) {
 		"message": "Review deleted successfully",
 	}, nil)
 }
+
+// Vote PUT /reviews/:id/vote
+func (ctrl *Controller) Vote(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		response.Error(c, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated", nil)
+		return
+	}
+
+	var req VoteReviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		appErr := apperror.Wrap(err, apperror.CodeInvalidInput, "Invalid request body", http.StatusBadRequest)
+		httpErr := apperror.ToHTTP(appErr)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, err.Error())
+		return
+	}
+
+	res, err := ctrl.service.Vote(c.Request.Context(), userID.(string), c.Param("id"), req)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// DeleteVote DELETE /reviews/:id/vote
+func (ctrl *Controller) DeleteVote(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		response.Error(c, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated", nil)
+		return
+	}
+
+	res, err := ctrl.service.DeleteVote(c.Request.Context(), userID.(string), c.Param("id"))
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// Report POST /reviews/:id/report
+func (ctrl *Controller) Report(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		response.Error(c, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated", nil)
+		return
+	}
+
+	var req ReportReviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		appErr := apperror.Wrap(err, apperror.CodeInvalidInput, "Invalid request body", http.StatusBadRequest)
+		httpErr := apperror.ToHTTP(appErr)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, err.Error())
+		return
+	}
+
+	if err := ctrl.service.Report(c.Request.Context(), userID.(string), c.Param("id"), req); err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusCreated, gin.H{"message": "Review reported successfully"}, nil)
+}
+
+// ==================== ADMIN ENDPOINTS ====================
+
+// ListAdmin GET /admin/reviews?status=PENDING&productId=&rating=&reported=true&page=1&limit=20
+func (ctrl *Controller) ListAdmin(c *gin.Context) {
+	page, limit := parsePage(c)
+	reported, _ := strconv.ParseBool(c.DefaultQuery("reported", "false"))
+	filter := AdminReviewFilter{
+		Status:    c.Query("status"),
+		ProductID: c.Query("productId"),
+		Rating:    c.Query("rating"),
+		Reported:  reported,
+	}
+
+	data, total, err := ctrl.service.ListAdmin(c.Request.Context(), filter, page, limit)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, data, paginationMeta(total, page, limit))
+}
+
+// GetAdmin GET /admin/reviews/:id
+func (ctrl *Controller) GetAdmin(c *gin.Context) {
+	res, err := ctrl.service.GetAdmin(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// Approve PATCH /admin/reviews/:id/approve
+func (ctrl *Controller) Approve(c *gin.Context) {
+	ctrl.moderate(c, ctrl.service.Approve)
+}
+
+// Reject PATCH /admin/reviews/:id/reject
+func (ctrl *Controller) Reject(c *gin.Context) {
+	ctrl.moderate(c, ctrl.service.Reject)
+}
+
+// Hide PATCH /admin/reviews/:id/hide
+func (ctrl *Controller) Hide(c *gin.Context) {
+	ctrl.moderate(c, ctrl.service.Hide)
+}
+
+// CreateReply POST /admin/reviews/:id/reply
+func (ctrl *Controller) CreateReply(c *gin.Context) {
+	ctrl.reply(c, http.StatusCreated, ctrl.service.CreateReply)
+}
+
+// UpdateReply PUT /admin/reviews/:id/reply
+func (ctrl *Controller) UpdateReply(c *gin.Context) {
+	ctrl.reply(c, http.StatusOK, ctrl.service.UpdateReply)
+}
+
+// DeleteReply DELETE /admin/reviews/:id/reply
+func (ctrl *Controller) DeleteReply(c *gin.Context) {
+	if err := ctrl.service.DeleteReply(c.Request.Context(), c.Param("id")); err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{"message": "Reply deleted successfully"}, nil)
+}
+
+type replyFunc func(ctx context.Context, adminID, id string, req ReviewReplyRequest) (ReviewReplyResponse, error)
+
+func (ctrl *Controller) reply(c *gin.Context, status int, fn replyFunc) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		response.Error(c, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated", nil)
+		return
+	}
+
+	var req ReviewReplyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		appErr := apperror.Wrap(err, apperror.CodeInvalidInput, "Invalid request body", http.StatusBadRequest)
+		httpErr := apperror.ToHTTP(appErr)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, err.Error())
+		return
+	}
+
+	res, err := fn(c.Request.Context(), userID.(string), c.Param("id"), req)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, status, res, nil)
+}
+
+type moderateFunc func(ctx context.Context, adminID, id string, req ModerateReviewRequest) (AdminReviewResponse, error)
+
+func (ctrl *Controller) moderate(c *gin.Context, fn moderateFunc) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		response.Error(c, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated", nil)
+		return
+	}
+
+	var req ModerateReviewRequest
+	// Body opsional (hanya berisi catatan)
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			appErr := apperror.Wrap(err, apperror.CodeInvalidInput, "Invalid request body", http.StatusBadRequest)
+			httpErr := apperror.ToHTTP(appErr)
+			response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, err.Error())
+			return
+		}
+	}
+
+	res, err := fn(c.Request.Context(), userID.(string), c.Param("id"), req)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+func parsePage(c *gin.Context) (int, int) {
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if err != nil || limit < 1 || limit > 100 {
+		limit = 20
+	}
+	return page, limit
+}
+
+func paginationMeta(total int64, page, limit int) *response.PaginationMeta {
+	return &response.PaginationMeta{
+		Total:      total,
+		TotalPages: int((total + int64(limit) - 1) / int64(limit)),
+		Page:       page,
+		PageSize:   limit,
+	}
+}
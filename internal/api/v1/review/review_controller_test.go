@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"go-sqlc-starter/internal/api/v1/review"
 	reviewerrors "go-sqlc-starter/internal/api/v1/review/errors"
+	"go-sqlc-starter/internal/pkg/cursor"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -18,19 +19,34 @@ import (
 // ==================== FAKE SERVICE (Mock Manual) ====================
 
 type fakeReviewService struct {
-	createFunc           func(ctx context.Context, userID, productSlug string, req review.CreateReviewRequest) (review.ReviewResponse, error)
-	getByProductSlugFunc func(ctx context.Context, productSlug string, page, limit int) (review.ReviewListResponse, error)
+	createFunc           func(ctx context.Context, userID, productSlug string, req review.CreateReviewRequest, photos []review.Photo) (review.ReviewResponse, error)
+	getByProductSlugFunc func(ctx context.Context, productSlug string, filter review.ReviewListFilter, page, limit int) (review.ReviewListResponse, error)
+	getByCursorFunc      func(ctx context.Context, productSlug string, filter review.ReviewListFilter, token string, limit int) (review.ReviewListResponse, cursor.Page, error)
 	getByUserIDFunc      func(ctx context.Context, userID string, page, limit int) (review.UserReviewListResponse, error)
 	checkEligibilityFunc func(ctx context.Context, userID, productSlug string) (review.ReviewEligibilityResponse, error)
 	updateFunc           func(ctx context.Context, reviewID, userID string, req review.UpdateReviewRequest) (review.ReviewResponse, error)
 	deleteFunc           func(ctx context.Context, reviewID, userID string) error
+	voteFunc             func(ctx context.Context, userID, id string, req review.VoteReviewRequest) (review.ReviewVoteResponse, error)
+	deleteVoteFunc       func(ctx context.Context, userID, id string) (review.ReviewVoteResponse, error)
+	reportFunc           func(ctx context.Context, userID, id string, req review.ReportReviewRequest) error
+	listAdminFunc        func(ctx context.Context, filter review.AdminReviewFilter, page, limit int) ([]review.AdminReviewResponse, int64, error)
+	getAdminFunc         func(ctx context.Context, id string) (review.AdminReviewResponse, error)
+	approveFunc          func(ctx context.Context, adminID, id string, req review.ModerateReviewRequest) (review.AdminReviewResponse, error)
+	rejectFunc           func(ctx context.Context, adminID, id string, req review.ModerateReviewRequest) (review.AdminReviewResponse, error)
+	hideFunc             func(ctx context.Context, adminID, id string, req review.ModerateReviewRequest) (review.AdminReviewResponse, error)
+	createReplyFunc      func(ctx context.Context, adminID, id string, req review.ReviewReplyRequest) (review.ReviewReplyResponse, error)
+	updateReplyFunc      func(ctx context.Context, adminID, id string, req review.ReviewReplyRequest) (review.ReviewReplyResponse, error)
+	deleteReplyFunc      func(ctx context.Context, id string) error
 }
 
-func (f *fakeReviewService) Create(ctx context.Context, u, s string, r review.CreateReviewRequest) (review.ReviewResponse, error) {
-	return f.createFunc(ctx, u, s, r)
+func (f *fakeReviewService) Create(ctx context.Context, u, s string, r review.CreateReviewRequest, photos []review.Photo) (review.ReviewResponse, error) {
+	return f.createFunc(ctx, u, s, r, photos)
 }
-func (f *fakeReviewService) GetByProductSlug(ctx context.Context, s string, p, l int) (review.ReviewListResponse, error) {
-	return f.getByProductSlugFunc(ctx, s, p, l)
+func (f *fakeReviewService) GetByProductSlug(ctx context.Context, s string, fl review.ReviewListFilter, p, l int) (review.ReviewListResponse, error) {
+	return f.getByProductSlugFunc(ctx, s, fl, p, l)
+}
+func (f *fakeReviewService) GetByProductSlugByCursor(ctx context.Context, s string, fl review.ReviewListFilter, tok string, l int) (review.ReviewListResponse, cursor.Page, error) {
+	return f.getByCursorFunc(ctx, s, fl, tok, l)
 }
 func (f *fakeReviewService) GetByUserID(ctx context.Context, u string, p, l int) (review.UserReviewListResponse, error) {
 	return f.getByUserIDFunc(ctx, u, p, l)
@@ -44,6 +60,42 @@ func (f *fakeReviewService) Update(ctx context.Context, r, u string, req review.
 func (f *fakeReviewService) Delete(ctx context.Context, r, u string) error {
 	return f.deleteFunc(ctx, r, u)
 }
+func (f *fakeReviewService) Vote(ctx context.Context, u, id string, req review.VoteReviewRequest) (review.ReviewVoteResponse, error) {
+	return f.voteFunc(ctx, u, id, req)
+}
+func (f *fakeReviewService) DeleteVote(ctx context.Context, u, id string) (review.ReviewVoteResponse, error) {
+	return f.deleteVoteFunc(ctx, u, id)
+}
+func (f *fakeReviewService) Report(ctx context.Context, u, id string, req review.ReportReviewRequest) error {
+	return f.reportFunc(ctx, u, id, req)
+}
+func (f *fakeReviewService) ListAdmin(ctx context.Context, fl review.AdminReviewFilter, p, l int) ([]review.AdminReviewResponse, int64, error) {
+	return f.listAdminFunc(ctx, fl, p, l)
+}
+func (f *fakeReviewService) GetAdmin(ctx context.Context, id string) (review.AdminReviewResponse, error) {
+	return f.getAdminFunc(ctx, id)
+}
+func (f *fakeReviewService) Approve(ctx context.Context, a, id string, req review.ModerateReviewRequest) (review.AdminReviewResponse, error) {
+	return f.approveFunc(ctx, a, id, req)
+}
+func (f *fakeReviewService) Reject(ctx context.Context, a, id string, req review.ModerateReviewRequest) (review.AdminReviewResponse, error) {
+	return f.rejectFunc(ctx, a, id, req)
+}
+func (f *fakeReviewService) Hide(ctx context.Context, a, id string, req review.ModerateReviewRequest) (review.AdminReviewResponse, error) {
+	return f.hideFunc(ctx, a, id, req)
+}
+func (f *fakeReviewService) CreateReply(ctx context.Context, a, id string, req review.ReviewReplyRequest) (review.ReviewReplyResponse, error) {
+	return f.createReplyFunc(ctx, a, id, req)
+}
+func (f *fakeReviewService) UpdateReply(ctx context.Context, a, id string, req review.ReviewReplyRequest) (review.ReviewReplyResponse, error) {
+	return f.updateReplyFunc(ctx, a, id, req)
+}
+func (f *fakeReviewService) DeleteReply(ctx context.Context, id string) error {
+	return f.deleteReplyFunc(ctx, id)
+}
+func (f *fakeReviewService) RecomputeAllRatings(ctx context.Context) (int64, error) {
+	return 0, nil
+}
 
 // ==================== REUSABLE HELPERS ====================
 
@@ -91,7 +143,7 @@ func TestReviewController_Create(t *testing.T) {
 		d.ctx.Params = gin.Params{{Key: "slug", Value: slug}}
 		d.performRequest(http.MethodPost, "/", req)
 
-		d.svc.createFunc = func(ctx context.Context, uid, s string, r review.CreateReviewRequest) (review.ReviewResponse, error) {
+		d.svc.createFunc = func(ctx context.Context, uid, s string, r review.CreateReviewRequest, photos []review.Photo) (review.ReviewResponse, error) {
 			return review.ReviewResponse{Comment: r.Comment, Rating: r.Rating}, nil
 		}
 
@@ -106,7 +158,7 @@ func TestReviewController_Create(t *testing.T) {
 		d.ctx.Params = gin.Params{{Key: "slug", Value: "iphone"}}
 		d.performRequest(http.MethodPost, "/", review.CreateReviewRequest{Rating: 5})
 
-		d.svc.createFunc = func(ctx context.Context, uid, s string, r review.CreateReviewRequest) (review.ReviewResponse, error) {
+		d.svc.createFunc = func(ctx context.Context, uid, s string, r review.CreateReviewRequest, photos []review.Photo) (review.ReviewResponse, error) {
 			return review.ReviewResponse{}, reviewerrors.ErrUnauthenticated // Assuming error exists in package
 		}
 
@@ -123,13 +175,55 @@ func TestReviewController_GetReviewsByProductSlug(t *testing.T) {
 		d.ctx.Params = gin.Params{{Key: "slug", Value: "iphone"}}
 		d.performRequest(http.MethodGet, "/?page=1&limit=10", nil)
 
-		d.svc.getByProductSlugFunc = func(ctx context.Context, slug string, page, limit int) (review.ReviewListResponse, error) {
+		d.svc.getByProductSlugFunc = func(ctx context.Context, slug string, fl review.ReviewListFilter, page, limit int) (review.ReviewListResponse, error) {
 			return review.ReviewListResponse{Total: 1, Page: 1}, nil
 		}
 
 		d.ctrl.GetReviewsByProductSlug(d.ctx)
 		assert.Equal(t, http.StatusOK, d.w.Code)
 	})
+
+	t.Run("positive - sort & filter diteruskan ke service", func(t *testing.T) {
+		d := setupReviewControllerTest()
+		d.ctx.Params = gin.Params{{Key: "slug", Value: "iphone"}}
+		d.performRequest(http.MethodGet, "/?sort_by=helpful&rating=5&with_photos=true", nil)
+
+		d.svc.getByProductSlugFunc = func(ctx context.Context, slug string, fl review.ReviewListFilter, page, limit int) (review.ReviewListResponse, error) {
+			assert.Equal(t, review.ReviewListFilter{Sort: "helpful", Rating: "5", WithPhotos: true}, fl)
+			return review.ReviewListResponse{}, nil
+		}
+
+		d.ctrl.GetReviewsByProductSlug(d.ctx)
+		assert.Equal(t, http.StatusOK, d.w.Code)
+	})
+
+	t.Run("negative - sort tidak dikenal", func(t *testing.T) {
+		d := setupReviewControllerTest()
+		d.ctx.Params = gin.Params{{Key: "slug", Value: "iphone"}}
+		d.performRequest(http.MethodGet, "/?sort_by=random", nil)
+
+		d.svc.getByProductSlugFunc = func(ctx context.Context, slug string, fl review.ReviewListFilter, page, limit int) (review.ReviewListResponse, error) {
+			return review.ReviewListResponse{}, reviewerrors.ErrInvalidReviewSort
+		}
+
+		d.ctrl.GetReviewsByProductSlug(d.ctx)
+		assert.Equal(t, http.StatusBadRequest, d.w.Code)
+	})
+
+	t.Run("positive - cursor mode", func(t *testing.T) {
+		d := setupReviewControllerTest()
+		d.ctx.Params = gin.Params{{Key: "slug", Value: "iphone"}}
+		d.performRequest(http.MethodGet, "/?cursor=tok&limit=5", nil)
+
+		d.svc.getByCursorFunc = func(ctx context.Context, slug string, fl review.ReviewListFilter, token string, limit int) (review.ReviewListResponse, cursor.Page, error) {
+			assert.Equal(t, "tok", token)
+			return review.ReviewListResponse{Total: 1, Limit: limit}, cursor.Page{Prev: "prev-tok"}, nil
+		}
+
+		d.ctrl.GetReviewsByProductSlug(d.ctx)
+		assert.Equal(t, http.StatusOK, d.w.Code)
+		assert.Contains(t, d.w.Body.String(), `"prevCursor":"prev-tok"`)
+	})
 }
 
 // ==================== GET BY USER ID ====================
@@ -227,3 +321,234 @@ func TestReviewController_DeleteReview(t *testing.T) {
 		assert.Equal(t, http.StatusOK, d.w.Code)
 	})
 }
+
+// ==================== VOTE & REPORT ====================
+
+func TestReviewController_Vote(t *testing.T) {
+	t.Run("positive - vote tidak membantu", func(t *testing.T) {
+		d := setupReviewControllerTest()
+		userID := uuid.New().String()
+		reviewID := uuid.New().String()
+
+		d.ctx.Set("user_id", userID)
+		d.ctx.Params = gin.Params{{Key: "id", Value: reviewID}}
+		d.performRequest(http.MethodPut, "/", map[string]interface{}{"helpful": false})
+
+		d.svc.voteFunc = func(ctx context.Context, u, id string, req review.VoteReviewRequest) (review.ReviewVoteResponse, error) {
+			assert.Equal(t, userID, u)
+			assert.Equal(t, reviewID, id)
+			assert.False(t, *req.Helpful)
+			return review.ReviewVoteResponse{ReviewID: id, Helpful: req.Helpful, NotHelpfulCount: 1}, nil
+		}
+
+		d.ctrl.Vote(d.ctx)
+		assert.Equal(t, http.StatusOK, d.w.Code)
+		assert.Contains(t, d.w.Body.String(), `"notHelpfulCount":1`)
+	})
+
+	t.Run("negative - vote review sendiri", func(t *testing.T) {
+		d := setupReviewControllerTest()
+		d.ctx.Set("user_id", uuid.New().String())
+		d.ctx.Params = gin.Params{{Key: "id", Value: uuid.New().String()}}
+		d.performRequest(http.MethodPut, "/", map[string]interface{}{"helpful": true})
+
+		d.svc.voteFunc = func(ctx context.Context, u, id string, req review.VoteReviewRequest) (review.ReviewVoteResponse, error) {
+			return review.ReviewVoteResponse{}, reviewerrors.ErrCannotVoteOwnReview
+		}
+
+		d.ctrl.Vote(d.ctx)
+		assert.Equal(t, http.StatusForbidden, d.w.Code)
+	})
+
+	t.Run("negative - hapus vote yang tidak ada", func(t *testing.T) {
+		d := setupReviewControllerTest()
+		d.ctx.Set("user_id", uuid.New().String())
+		d.ctx.Params = gin.Params{{Key: "id", Value: uuid.New().String()}}
+		d.performRequest(http.MethodDelete, "/", nil)
+
+		d.svc.deleteVoteFunc = func(ctx context.Context, u, id string) (review.ReviewVoteResponse, error) {
+			return review.ReviewVoteResponse{}, reviewerrors.ErrVoteNotFound
+		}
+
+		d.ctrl.DeleteVote(d.ctx)
+		assert.Equal(t, http.StatusNotFound, d.w.Code)
+	})
+
+	t.Run("negative - unauthenticated", func(t *testing.T) {
+		d := setupReviewControllerTest()
+		d.performRequest(http.MethodPut, "/", map[string]interface{}{"helpful": true})
+
+		d.ctrl.Vote(d.ctx)
+		assert.Equal(t, http.StatusUnauthorized, d.w.Code)
+	})
+}
+
+func TestReviewController_Report(t *testing.T) {
+	t.Run("positive - laporan tersimpan", func(t *testing.T) {
+		d := setupReviewControllerTest()
+		d.ctx.Set("user_id", uuid.New().String())
+		d.ctx.Params = gin.Params{{Key: "id", Value: uuid.New().String()}}
+		d.performRequest(http.MethodPost, "/", review.ReportReviewRequest{Reason: "SPAM", Note: "link promosi"})
+
+		d.svc.reportFunc = func(ctx context.Context, u, id string, req review.ReportReviewRequest) error {
+			assert.Equal(t, "SPAM", req.Reason)
+			return nil
+		}
+
+		d.ctrl.Report(d.ctx)
+		assert.Equal(t, http.StatusCreated, d.w.Code)
+	})
+
+	t.Run("negative - sudah pernah melapor", func(t *testing.T) {
+		d := setupReviewControllerTest()
+		d.ctx.Set("user_id", uuid.New().String())
+		d.ctx.Params = gin.Params{{Key: "id", Value: uuid.New().String()}}
+		d.performRequest(http.MethodPost, "/", review.ReportReviewRequest{Reason: "ABUSIVE"})
+
+		d.svc.reportFunc = func(ctx context.Context, u, id string, req review.ReportReviewRequest) error {
+			return reviewerrors.ErrAlreadyReported
+		}
+
+		d.ctrl.Report(d.ctx)
+		assert.Equal(t, http.StatusConflict, d.w.Code)
+	})
+}
+
+// ==================== ADMIN MODERATION ====================
+
+func TestReviewController_ListAdmin(t *testing.T) {
+	t.Run("positive - filter diteruskan ke service", func(t *testing.T) {
+		d := setupReviewControllerTest()
+		d.performRequest(http.MethodGet, "/?status=pending&rating=1&reported=true&page=2&limit=5", nil)
+
+		d.svc.listAdminFunc = func(ctx context.Context, fl review.AdminReviewFilter, p, l int) ([]review.AdminReviewResponse, int64, error) {
+			assert.Equal(t, "pending", fl.Status)
+			assert.Equal(t, "1", fl.Rating)
+			assert.True(t, fl.Reported)
+			assert.Equal(t, 2, p)
+			assert.Equal(t, 5, l)
+			return []review.AdminReviewResponse{{ProductName: "iPhone"}}, 6, nil
+		}
+
+		d.ctrl.ListAdmin(d.ctx)
+		assert.Equal(t, http.StatusOK, d.w.Code)
+		assert.Contains(t, d.w.Body.String(), `"totalPages":2`)
+	})
+
+	t.Run("negative - invalid status filter", func(t *testing.T) {
+		d := setupReviewControllerTest()
+		d.performRequest(http.MethodGet, "/?status=DELETED", nil)
+
+		d.svc.listAdminFunc = func(ctx context.Context, fl review.AdminReviewFilter, p, l int) ([]review.AdminReviewResponse, int64, error) {
+			return nil, 0, reviewerrors.ErrInvalidStatusFilter
+		}
+
+		d.ctrl.ListAdmin(d.ctx)
+		assert.Equal(t, http.StatusBadRequest, d.w.Code)
+	})
+}
+
+func TestReviewController_Moderate(t *testing.T) {
+	t.Run("positive - approve tanpa body", func(t *testing.T) {
+		d := setupReviewControllerTest()
+		adminID := uuid.New().String()
+		reviewID := uuid.New().String()
+
+		d.ctx.Set("user_id", adminID)
+		d.ctx.Params = gin.Params{{Key: "id", Value: reviewID}}
+		d.performRequest(http.MethodPatch, "/", nil)
+
+		d.svc.approveFunc = func(ctx context.Context, a, id string, req review.ModerateReviewRequest) (review.AdminReviewResponse, error) {
+			assert.Equal(t, adminID, a)
+			assert.Equal(t, reviewID, id)
+			return review.AdminReviewResponse{ReviewResponse: review.ReviewResponse{ID: id, Status: "APPROVED"}}, nil
+		}
+
+		d.ctrl.Approve(d.ctx)
+		assert.Equal(t, http.StatusOK, d.w.Code)
+		assert.Contains(t, d.w.Body.String(), "APPROVED")
+	})
+
+	t.Run("positive - reject dengan catatan", func(t *testing.T) {
+		d := setupReviewControllerTest()
+		d.ctx.Set("user_id", uuid.New().String())
+		d.ctx.Params = gin.Params{{Key: "id", Value: uuid.New().String()}}
+		d.performRequest(http.MethodPatch, "/", review.ModerateReviewRequest{Note: "spam"})
+
+		d.svc.rejectFunc = func(ctx context.Context, a, id string, req review.ModerateReviewRequest) (review.AdminReviewResponse, error) {
+			assert.Equal(t, "spam", req.Note)
+			return review.AdminReviewResponse{}, nil
+		}
+
+		d.ctrl.Reject(d.ctx)
+		assert.Equal(t, http.StatusOK, d.w.Code)
+	})
+
+	t.Run("negative - transisi status tidak valid", func(t *testing.T) {
+		d := setupReviewControllerTest()
+		d.ctx.Set("user_id", uuid.New().String())
+		d.ctx.Params = gin.Params{{Key: "id", Value: uuid.New().String()}}
+		d.performRequest(http.MethodPatch, "/", nil)
+
+		d.svc.hideFunc = func(ctx context.Context, a, id string, req review.ModerateReviewRequest) (review.AdminReviewResponse, error) {
+			return review.AdminReviewResponse{}, reviewerrors.ErrInvalidReviewStatus
+		}
+
+		d.ctrl.Hide(d.ctx)
+		assert.Equal(t, http.StatusConflict, d.w.Code)
+	})
+
+	t.Run("negative - unauthenticated", func(t *testing.T) {
+		d := setupReviewControllerTest()
+		d.performRequest(http.MethodPatch, "/", nil)
+
+		d.ctrl.Approve(d.ctx)
+		assert.Equal(t, http.StatusUnauthorized, d.w.Code)
+	})
+}
+
+// ==================== MERCHANT REPLY ====================
+
+func TestReviewController_Reply(t *testing.T) {
+	t.Run("positive - create reply", func(t *testing.T) {
+		d := setupReviewControllerTest()
+		d.ctx.Set("user_id", uuid.New().String())
+		d.ctx.Params = gin.Params{{Key: "id", Value: uuid.New().String()}}
+		d.performRequest(http.MethodPost, "/", review.ReviewReplyRequest{Body: "Terima kasih!"})
+
+		d.svc.createReplyFunc = func(ctx context.Context, a, id string, req review.ReviewReplyRequest) (review.ReviewReplyResponse, error) {
+			return review.ReviewReplyResponse{Body: req.Body}, nil
+		}
+
+		d.ctrl.CreateReply(d.ctx)
+		assert.Equal(t, http.StatusCreated, d.w.Code)
+		assert.Contains(t, d.w.Body.String(), "Terima kasih!")
+	})
+
+	t.Run("negative - reply sudah ada", func(t *testing.T) {
+		d := setupReviewControllerTest()
+		d.ctx.Set("user_id", uuid.New().String())
+		d.ctx.Params = gin.Params{{Key: "id", Value: uuid.New().String()}}
+		d.performRequest(http.MethodPost, "/", review.ReviewReplyRequest{Body: "Halo"})
+
+		d.svc.createReplyFunc = func(ctx context.Context, a, id string, req review.ReviewReplyRequest) (review.ReviewReplyResponse, error) {
+			return review.ReviewReplyResponse{}, reviewerrors.ErrReplyAlreadyExists
+		}
+
+		d.ctrl.CreateReply(d.ctx)
+		assert.Equal(t, http.StatusConflict, d.w.Code)
+	})
+
+	t.Run("negative - delete reply not found", func(t *testing.T) {
+		d := setupReviewControllerTest()
+		d.ctx.Params = gin.Params{{Key: "id", Value: uuid.New().String()}}
+		d.performRequest(http.MethodDelete, "/", nil)
+
+		d.svc.deleteReplyFunc = func(ctx context.Context, id string) error {
+			return reviewerrors.ErrReplyNotFound
+		}
+
+		d.ctrl.DeleteReply(d.ctx)
+		assert.Equal(t, http.StatusNotFound, d.w.Code)
+	})
+}
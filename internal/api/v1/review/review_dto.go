@@ -1,12 +1,22 @@
 package review
 
-import "time"
+import (
+	"mime/multipart"
+	"time"
+)
 
 // ==================== REQUEST STRUCTS ====================
 
+// CreateReviewRequest bisa dikirim sebagai JSON atau multipart (dengan foto)
 type CreateReviewRequest struct {
-	Rating  int32  `json:"rating" validate:"required,min=1,max=5"`
-	Comment string `json:"comment" validate:"required,min=10,max=1000"`
+	Rating  int32  `json:"rating" form:"rating" validate:"required,min=1,max=5"`
+	Comment string `json:"comment" form:"comment" validate:"required,min=10,max=1000"`
+}
+
+// Photo file foto review dari form-data (key: photos)
+type Photo struct {
+	File     multipart.File
+	Filename string
 }
 
 type UpdateReviewRequest struct {
@@ -14,6 +24,42 @@ type UpdateReviewRequest struct {
 	Comment string `json:"comment" validate:"required,min=10,max=1000"`
 }
 
+// ModerateReviewRequest catatan admin saat approve / reject / hide (opsional)
+type ModerateReviewRequest struct {
+	Note string `json:"note" validate:"max=500"`
+}
+
+// ReviewReplyRequest balasan publik merchant
+type ReviewReplyRequest struct {
+	Body string `json:"body" validate:"required,min=1,max=1000"`
+}
+
+// VoteReviewRequest vote membantu / tidak membantu (pointer supaya false tetap lolos required)
+type VoteReviewRequest struct {
+	Helpful *bool `json:"helpful" validate:"required"`
+}
+
+// ReportReviewRequest laporan review bermasalah dari user
+type ReportReviewRequest struct {
+	Reason string `json:"reason" validate:"required,oneof=SPAM ABUSIVE OFFENSIVE IRRELEVANT OTHER"`
+	Note   string `json:"note" validate:"max=500"`
+}
+
+// AdminReviewFilter filter antrian moderasi; string kosong = tanpa filter
+type AdminReviewFilter struct {
+	Status    string
+	ProductID string
+	Rating    string
+	Reported  bool // hanya review dengan laporan terbuka
+}
+
+// ReviewListFilter sort & filter listing review publik; string kosong = default
+type ReviewListFilter struct {
+	Sort       string // newest | helpful | rating_high | rating_low
+	Rating     string
+	WithPhotos bool
+}
+
 type GetReviewsRequest struct {
 	Page  int `form:"page"`
 	Limit int `form:"limit"`
@@ -22,15 +68,57 @@ type GetReviewsRequest struct {
 // ==================== RESPONSE STRUCTS ====================
 
 type ReviewResponse struct {
-	ID                 string    `json:"id"`
-	UserID             string    `json:"userId"`
-	UserName           string    `json:"userdName"`
-	ProductID          string    `json:"productId"`
-	Rating             int32     `json:"rating"`
-	Comment            string    `json:"comment"`
-	IsVerifiedPurchase bool      `json:"isVerifiedPurchase"`
-	CreatedAt          time.Time `json:"createdAt"`
-	UpdatedAt          time.Time `json:"updatedAt"`
+	ID                 string               `json:"id"`
+	UserID             string               `json:"userId"`
+	UserName           string               `json:"userdName"`
+	ProductID          string               `json:"productId"`
+	Rating             int32                `json:"rating"`
+	Comment            string               `json:"comment"`
+	IsVerifiedPurchase bool                 `json:"isVerifiedPurchase"`
+	Status             string               `json:"status"`
+	Photos             []string             `json:"photos"`
+	Reply              *ReviewReplyResponse `json:"reply,omitempty"`
+	HelpfulCount       int32                `json:"helpfulCount"`
+	NotHelpfulCount    int32                `json:"notHelpfulCount"`
+	CreatedAt          time.Time            `json:"createdAt"`
+	UpdatedAt          time.Time            `json:"updatedAt"`
+}
+
+type ReviewReplyResponse struct {
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// AdminReviewResponse review lengkap dengan info moderasi untuk admin
+type AdminReviewResponse struct {
+	ReviewResponse
+	UserEmail      string                 `json:"userEmail"`
+	ProductName    string                 `json:"productName"`
+	ProductSlug    string                 `json:"productSlug"`
+	ModerationNote string                 `json:"moderationNote,omitempty"`
+	ModeratedBy    string                 `json:"moderatedBy,omitempty"`
+	ModeratedAt    *time.Time             `json:"moderatedAt,omitempty"`
+	ReportCount    int32                  `json:"reportCount"`
+	Reports        []ReviewReportResponse `json:"reports,omitempty"` // hanya di detail
+}
+
+// ReviewReportResponse laporan terbuka untuk admin
+type ReviewReportResponse struct {
+	ID        string    `json:"id"`
+	UserID    string    `json:"userId"`
+	UserEmail string    `json:"userEmail"`
+	Reason    string    `json:"reason"`
+	Note      string    `json:"note,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// ReviewVoteResponse counter terbaru setelah vote; Helpful nil = vote dihapus
+type ReviewVoteResponse struct {
+	ReviewID        string `json:"reviewId"`
+	Helpful         *bool  `json:"helpful"`
+	HelpfulCount    int32  `json:"helpfulCount"`
+	NotHelpfulCount int32  `json:"notHelpfulCount"`
 }
 
 type ReviewSummaryResponse struct {
@@ -48,6 +136,7 @@ type UserReviewResponse struct {
 	ProductSlug string    `json:"productdSlug"`
 	Rating      int32     `json:"rating"`
 	Comment     string    `json:"comment"`
+	Status      string    `json:"status"`
 	CreatedAt   time.Time `json:"createdAt"`
 	UpdatedAt   time.Time `json:"updatedAt"`
 }
@@ -16,8 +16,10 @@ type Repository interface {
 	Create(ctx context.Context, arg dbgen.CreateReviewParams) (dbgen.Review, error)
 	GetByID(ctx context.Context, id uuid.UUID) (dbgen.GetReviewByIDRow, error)
 	GetByProductID(ctx context.Context, productID uuid.UUID, limit, offset int32) ([]dbgen.GetReviewsByProductIDRow, error)
+	ListByProduct(ctx context.Context, arg dbgen.GetReviewsByProductIDParams) ([]dbgen.GetReviewsByProductIDRow, error)
+	GetByProductIDKeyset(ctx context.Context, arg dbgen.GetReviewsByProductIDKeysetParams) ([]dbgen.GetReviewsByProductIDKeysetRow, error)
 	GetByUserID(ctx context.Context, userID uuid.UUID, limit, offset int32) ([]dbgen.GetReviewsByUserIDRow, error)
-	CountByProductID(ctx context.Context, productID uuid.UUID) (int64, error)
+	CountByProductID(ctx context.Context, arg dbgen.CountReviewsByProductIDParams) (int64, error)
 	CountByUserID(ctx context.Context, userID uuid.UUID) (int64, error)
 	GetAverageRating(ctx context.Context, productID uuid.UUID) (float64, error)
 	CheckExists(ctx context.Context, userID, productID uuid.UUID) (bool, error)
@@ -25,6 +27,31 @@ type Repository interface {
 	GetCompletedOrder(ctx context.Context, userID, productID uuid.UUID) (uuid.UUID, error)
 	Update(ctx context.Context, arg dbgen.UpdateReviewParams) (dbgen.Review, error)
 	Delete(ctx context.Context, id uuid.UUID) error
+	ListAdmin(ctx context.Context, arg dbgen.ListReviewsAdminParams) ([]dbgen.ListReviewsAdminRow, error)
+	CountAdmin(ctx context.Context, arg dbgen.CountReviewsAdminParams) (int64, error)
+	SetStatus(ctx context.Context, arg dbgen.SetReviewStatusParams) (dbgen.Review, error)
+
+	// Foto & balasan merchant
+	CreatePhoto(ctx context.Context, arg dbgen.CreateReviewPhotoParams) error
+	DeletePhotos(ctx context.Context, reviewID uuid.UUID) ([]string, error)
+	GetReply(ctx context.Context, reviewID uuid.UUID) (dbgen.ReviewReply, error)
+	CreateReply(ctx context.Context, arg dbgen.CreateReviewReplyParams) (dbgen.ReviewReply, error)
+	UpdateReply(ctx context.Context, arg dbgen.UpdateReviewReplyParams) (dbgen.ReviewReply, error)
+	DeleteReply(ctx context.Context, reviewID uuid.UUID) (int64, error)
+
+	// Agregat rating di tabel products
+	LockProductRating(ctx context.Context, productID uuid.UUID) error
+	RecomputeProductRating(ctx context.Context, productID uuid.UUID) error
+	RecomputeAllProductRatings(ctx context.Context) (int64, error)
+
+	// Vote membantu & laporan user
+	UpsertVote(ctx context.Context, arg dbgen.UpsertReviewVoteParams) error
+	DeleteVote(ctx context.Context, reviewID, userID uuid.UUID) (int64, error)
+	RecomputeVotes(ctx context.Context, reviewID uuid.UUID) (dbgen.RecomputeReviewVotesRow, error)
+	CreateReport(ctx context.Context, arg dbgen.CreateReviewReportParams) (dbgen.ReviewReport, error)
+	IncrementReportCount(ctx context.Context, reviewID uuid.UUID) (dbgen.Review, error)
+	ListReports(ctx context.Context, reviewID uuid.UUID) ([]dbgen.ListReviewReportsRow, error)
+	ResolveReports(ctx context.Context, reviewID uuid.UUID) error
 }
 
 type repository struct {
@@ -55,11 +82,20 @@ func (r *repository) GetByID(ctx context.Context, id uuid.UUID) (dbgen.GetReview
 func (r *repository) GetByProductID(ctx context.Context, productID uuid.UUID, limit, offset int32) ([]dbgen.GetReviewsByProductIDRow, error) {
 	return r.queries.GetReviewsByProductID(ctx, dbgen.GetReviewsByProductIDParams{
 		ProductID: productID,
+		SortBy:    "newest",
 		Limit:     limit,
 		Offset:    offset,
 	})
 }
 
+func (r *repository) ListByProduct(ctx context.Context, arg dbgen.GetReviewsByProductIDParams) ([]dbgen.GetReviewsByProductIDRow, error) {
+	return r.queries.GetReviewsByProductID(ctx, arg)
+}
+
+func (r *repository) GetByProductIDKeyset(ctx context.Context, arg dbgen.GetReviewsByProductIDKeysetParams) ([]dbgen.GetReviewsByProductIDKeysetRow, error) {
+	return r.queries.GetReviewsByProductIDKeyset(ctx, arg)
+}
+
 func (r *repository) GetByUserID(ctx context.Context, userID uuid.UUID, limit, offset int32) ([]dbgen.GetReviewsByUserIDRow, error) {
 	return r.queries.GetReviewsByUserID(ctx, dbgen.GetReviewsByUserIDParams{
 		UserID: userID,
@@ -68,8 +104,8 @@ func (r *repository) GetByUserID(ctx context.Context, userID uuid.UUID, limit, o
 	})
 }
 
-func (r *repository) CountByProductID(ctx context.Context, productID uuid.UUID) (int64, error) {
-	return r.queries.CountReviewsByProductID(ctx, productID)
+func (r *repository) CountByProductID(ctx context.Context, arg dbgen.CountReviewsByProductIDParams) (int64, error) {
+	return r.queries.CountReviewsByProductID(ctx, arg)
 }
 
 func (r *repository) CountByUserID(ctx context.Context, userID uuid.UUID) (int64, error) {
@@ -133,3 +169,82 @@ func (r *repository) Update(ctx context.Context, arg dbgen.UpdateReviewParams) (
 func (r *repository) Delete(ctx context.Context, id uuid.UUID) error {
 	return r.queries.DeleteReview(ctx, id)
 }
+
+func (r *repository) ListAdmin(ctx context.Context, arg dbgen.ListReviewsAdminParams) ([]dbgen.ListReviewsAdminRow, error) {
+	return r.queries.ListReviewsAdmin(ctx, arg)
+}
+
+func (r *repository) CountAdmin(ctx context.Context, arg dbgen.CountReviewsAdminParams) (int64, error) {
+	return r.queries.CountReviewsAdmin(ctx, arg)
+}
+
+func (r *repository) SetStatus(ctx context.Context, arg dbgen.SetReviewStatusParams) (dbgen.Review, error) {
+	return r.queries.SetReviewStatus(ctx, arg)
+}
+
+func (r *repository) CreatePhoto(ctx context.Context, arg dbgen.CreateReviewPhotoParams) error {
+	return r.queries.CreateReviewPhoto(ctx, arg)
+}
+
+func (r *repository) DeletePhotos(ctx context.Context, reviewID uuid.UUID) ([]string, error) {
+	return r.queries.DeleteReviewPhotos(ctx, reviewID)
+}
+
+func (r *repository) GetReply(ctx context.Context, reviewID uuid.UUID) (dbgen.ReviewReply, error) {
+	return r.queries.GetReviewReply(ctx, reviewID)
+}
+
+func (r *repository) CreateReply(ctx context.Context, arg dbgen.CreateReviewReplyParams) (dbgen.ReviewReply, error) {
+	return r.queries.CreateReviewReply(ctx, arg)
+}
+
+func (r *repository) UpdateReply(ctx context.Context, arg dbgen.UpdateReviewReplyParams) (dbgen.ReviewReply, error) {
+	return r.queries.UpdateReviewReply(ctx, arg)
+}
+
+func (r *repository) DeleteReply(ctx context.Context, reviewID uuid.UUID) (int64, error) {
+	return r.queries.DeleteReviewReply(ctx, reviewID)
+}
+
+func (r *repository) LockProductRating(ctx context.Context, productID uuid.UUID) error {
+	return r.queries.LockProductForRating(ctx, productID)
+}
+
+func (r *repository) RecomputeProductRating(ctx context.Context, productID uuid.UUID) error {
+	return r.queries.RecomputeProductRating(ctx, productID)
+}
+
+func (r *repository) RecomputeAllProductRatings(ctx context.Context) (int64, error) {
+	return r.queries.RecomputeAllProductRatings(ctx)
+}
+
+func (r *repository) UpsertVote(ctx context.Context, arg dbgen.UpsertReviewVoteParams) error {
+	return r.queries.UpsertReviewVote(ctx, arg)
+}
+
+func (r *repository) DeleteVote(ctx context.Context, reviewID, userID uuid.UUID) (int64, error) {
+	return r.queries.DeleteReviewVote(ctx, dbgen.DeleteReviewVoteParams{
+		ReviewID: reviewID,
+		UserID:   userID,
+	})
+}
+
+func (r *repository) RecomputeVotes(ctx context.Context, reviewID uuid.UUID) (dbgen.RecomputeReviewVotesRow, error) {
+	return r.queries.RecomputeReviewVotes(ctx, reviewID)
+}
+
+func (r *repository) CreateReport(ctx context.Context, arg dbgen.CreateReviewReportParams) (dbgen.ReviewReport, error) {
+	return r.queries.CreateReviewReport(ctx, arg)
+}
+
+func (r *repository) IncrementReportCount(ctx context.Context, reviewID uuid.UUID) (dbgen.Review, error) {
+	return r.queries.IncrementReviewReportCount(ctx, reviewID)
+}
+
+func (r *repository) ListReports(ctx context.Context, reviewID uuid.UUID) ([]dbgen.ListReviewReportsRow, error) {
+	return r.queries.ListReviewReports(ctx, reviewID)
+}
+
+func (r *repository) ResolveReports(ctx context.Context, reviewID uuid.UUID) error {
+	return r.queries.ResolveReviewReports(ctx, reviewID)
+}
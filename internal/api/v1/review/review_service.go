@@ -3,13 +3,23 @@ package review
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"fmt"
 	"go-sqlc-starter/internal/api/v1/product"
 	reviewerrors "go-sqlc-starter/internal/api/v1/review/errors"
 	"go-sqlc-starter/internal/dbgen"
 	"go-sqlc-starter/internal/pkg/apperror"
+	"go-sqlc-starter/internal/pkg/constants"
+	"go-sqlc-starter/internal/pkg/cursor"
+	"go-sqlc-starter/internal/pkg/moderation"
+	"mime/multipart"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 // Product struct minimal yang dibutuhkan
@@ -19,37 +29,78 @@ type Product struct {
 	Name string
 }
 
+type CloudinaryService interface {
+	UploadImage(ctx context.Context, file multipart.File, filename string, folderName string) (string, error)
+	DeleteImage(ctx context.Context, publicID string) error
+}
+
 //go:generate mockgen -source=review_service.go -destination=../mock/review/review_service_mock.go -package=mock
 type Service interface {
-	Create(ctx context.Context, userID, productSlug string, req CreateReviewRequest) (ReviewResponse, error)
-	GetByProductSlug(ctx context.Context, productSlug string, page, limit int) (ReviewListResponse, error)
+	Create(ctx context.Context, userID, productSlug string, req CreateReviewRequest, photos []Photo) (ReviewResponse, error)
+	GetByProductSlug(ctx context.Context, productSlug string, filter ReviewListFilter, page, limit int) (ReviewListResponse, error)
+	GetByProductSlugByCursor(ctx context.Context, productSlug string, filter ReviewListFilter, token string, limit int) (ReviewListResponse, cursor.Page, error)
 	GetByUserID(ctx context.Context, userID string, page, limit int) (UserReviewListResponse, error)
 	CheckEligibility(ctx context.Context, userID, productSlug string) (ReviewEligibilityResponse, error)
 	Update(ctx context.Context, reviewID, userID string, req UpdateReviewRequest) (ReviewResponse, error)
 	Delete(ctx context.Context, reviewID, userID string) error
+
+	// Vote membantu & laporan dari user
+	Vote(ctx context.Context, userID, id string, req VoteReviewRequest) (ReviewVoteResponse, error)
+	DeleteVote(ctx context.Context, userID, id string) (ReviewVoteResponse, error)
+	Report(ctx context.Context, userID, id string, req ReportReviewRequest) error
+
+	// Admin Actions (moderasi)
+	ListAdmin(ctx context.Context, filter AdminReviewFilter, page, limit int) ([]AdminReviewResponse, int64, error)
+	GetAdmin(ctx context.Context, id string) (AdminReviewResponse, error)
+	Approve(ctx context.Context, adminID, id string, req ModerateReviewRequest) (AdminReviewResponse, error)
+	Reject(ctx context.Context, adminID, id string, req ModerateReviewRequest) (AdminReviewResponse, error)
+	Hide(ctx context.Context, adminID, id string, req ModerateReviewRequest) (AdminReviewResponse, error)
+	CreateReply(ctx context.Context, adminID, id string, req ReviewReplyRequest) (ReviewReplyResponse, error)
+	UpdateReply(ctx context.Context, adminID, id string, req ReviewReplyRequest) (ReviewReplyResponse, error)
+	DeleteReply(ctx context.Context, id string) error
+
+	// RecomputeAllRatings backfill agregat rating semua produk
+	RecomputeAllRatings(ctx context.Context) (int64, error)
 }
 
 type service struct {
-	repo        Repository
-	productRepo product.Repository
-	db          *sql.DB
-	validate    *validator.Validate
+	repo           Repository
+	productRepo    product.Repository
+	cloudinaryRepo CloudinaryService
+	db             *sql.DB
+	validate       *validator.Validate
+	filter         moderation.Filter
+	autoApprove    bool
 }
 
-func NewService(db *sql.DB, r Repository, pr product.Repository) Service {
+// NewService: filter boleh nil (tanpa filter kata); autoApprove=false -> semua review baru masuk antrian moderasi
+func NewService(db *sql.DB, r Repository, pr product.Repository, cloudinaryRepo CloudinaryService, filter moderation.Filter, autoApprove bool) Service {
 	return &service{
-		db:          db,
-		repo:        r,
-		productRepo: pr,
-		validate:    validator.New(),
+		db:             db,
+		repo:           r,
+		productRepo:    pr,
+		cloudinaryRepo: cloudinaryRepo,
+		validate:       validator.New(),
+		filter:         filter,
+		autoApprove:    autoApprove,
 	}
 }
 
+// Transisi status yang diizinkan: status tujuan -> status asal
+var reviewTransitions = map[string][]string{
+	constants.ReviewStatusApproved: {constants.ReviewStatusPending, constants.ReviewStatusRejected, constants.ReviewStatusHidden},
+	constants.ReviewStatusRejected: {constants.ReviewStatusPending},
+	constants.ReviewStatusHidden:   {constants.ReviewStatusApproved},
+}
+
 // Create creates a new review for a product
-func (s *service) Create(ctx context.Context, userID, productSlug string, req CreateReviewRequest) (ReviewResponse, error) {
+func (s *service) Create(ctx context.Context, userID, productSlug string, req CreateReviewRequest, photos []Photo) (ReviewResponse, error) {
 	if err := s.validate.Struct(req); err != nil {
 		return ReviewResponse{}, apperror.MapValidationError(err)
 	}
+	if len(photos) > constants.ReviewMaxPhotos {
+		return ReviewResponse{}, reviewerrors.ErrTooManyPhotos
+	}
 
 	uid, err := uuid.Parse(userID)
 	if err != nil {
@@ -89,34 +140,83 @@ func (s *service) Create(ctx context.Context, userID, productSlug string, req Cr
 		return ReviewResponse{}, reviewerrors.ErrOrderNotCompleted
 	}
 
-	// 5. Start transaction
+	// 5. Upload foto sebelum transaksi (tidak menahan koneksi / lock selama upload).
+	// Public ID hanya dari id review + urutan, nama file dari user tidak dipakai.
+	reviewID := uuid.New()
+	type uploadedPhoto struct {
+		url      string
+		publicID string
+	}
+	var uploaded []uploadedPhoto
+	cleanup := func() {
+		for _, u := range uploaded {
+			_ = s.cloudinaryRepo.DeleteImage(ctx, u.publicID)
+		}
+	}
+	for i, p := range photos {
+		name := fmt.Sprintf("review-%s-%d", reviewID.String(), i+1)
+
+		url, err := s.cloudinaryRepo.UploadImage(ctx, p.File, name, constants.CloudinaryReviewFolder)
+		if err != nil {
+			cleanup()
+			return ReviewResponse{}, reviewerrors.ErrImageUploadFailed
+		}
+		uploaded = append(uploaded, uploadedPhoto{url: url, publicID: constants.CloudinaryReviewFolder + "/" + name})
+	}
+
+	// 6. Start transaction
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
+		cleanup()
 		return ReviewResponse{}, reviewerrors.ErrReviewFailed
 	}
 	defer tx.Rollback()
 
 	qtx := s.repo.WithTx(tx)
 
-	// 6. Create review
+	// 7. Create review (status awal dari filter kata & auto-approve) beserta fotonya
+	status, note := s.initialStatus(req.Comment)
 	review, err := qtx.Create(ctx, CreateReviewParams{
+		ID:                 reviewID,
 		UserID:             uid,
 		ProductID:          product.ID,
 		OrderID:            orderID,
 		Rating:             req.Rating,
 		Comment:            req.Comment,
 		IsVerifiedPurchase: true,
+		Status:             status,
+		ModerationNote:     note,
 	})
 	if err != nil {
+		cleanup()
+		return ReviewResponse{}, reviewerrors.ErrReviewFailed
+	}
+
+	for i, u := range uploaded {
+		if err := qtx.CreatePhoto(ctx, dbgen.CreateReviewPhotoParams{
+			ReviewID:  review.ID,
+			ImageUrl:  u.url,
+			PublicID:  u.publicID,
+			SortOrder: int32(i),
+		}); err != nil {
+			cleanup()
+			return ReviewResponse{}, reviewerrors.ErrReviewFailed
+		}
+	}
+
+	// 8. Update agregat rating produk
+	if err := s.refreshRating(ctx, qtx, product.ID); err != nil {
+		cleanup()
 		return ReviewResponse{}, reviewerrors.ErrReviewFailed
 	}
 
-	// 7. Commit transaction
+	// 9. Commit transaction
 	if err := tx.Commit(); err != nil {
+		cleanup()
 		return ReviewResponse{}, reviewerrors.ErrReviewFailed
 	}
 
-	// 8. Fetch complete review data with user name
+	// 10. Fetch complete review data with user name
 	reviewDetail, err := s.repo.GetByID(ctx, review.ID)
 	if err != nil {
 		return ReviewResponse{}, reviewerrors.ErrReviewFailed
@@ -126,7 +226,7 @@ func (s *service) Create(ctx context.Context, userID, productSlug string, req Cr
 }
 
 // GetByProductSlug retrieves all reviews for a product
-func (s *service) GetByProductSlug(ctx context.Context, productSlug string, page, limit int) (ReviewListResponse, error) {
+func (s *service) GetByProductSlug(ctx context.Context, productSlug string, filter ReviewListFilter, page, limit int) (ReviewListResponse, error) {
 	if page < 1 {
 		page = 1
 	}
@@ -134,6 +234,11 @@ func (s *service) GetByProductSlug(ctx context.Context, productSlug string, page
 		limit = 10
 	}
 
+	sortBy, rating, err := parseListFilter(filter)
+	if err != nil {
+		return ReviewListResponse{}, err
+	}
+
 	// 1. Get product by slug
 	product, err := s.productRepo.GetBySlug(ctx, productSlug)
 	if err != nil {
@@ -144,14 +249,24 @@ func (s *service) GetByProductSlug(ctx context.Context, productSlug string, page
 	}
 
 	// 2. Get reviews
-	offset := int32((page - 1) * limit)
-	reviews, err := s.repo.GetByProductID(ctx, product.ID, int32(limit), offset)
+	reviews, err := s.repo.ListByProduct(ctx, dbgen.GetReviewsByProductIDParams{
+		ProductID:  product.ID,
+		Rating:     rating,
+		WithPhotos: filter.WithPhotos,
+		SortBy:     sortBy,
+		Limit:      int32(limit),
+		Offset:     int32((page - 1) * limit),
+	})
 	if err != nil {
 		return ReviewListResponse{}, reviewerrors.ErrReviewFailed
 	}
 
-	// 3. Get total count
-	total, err := s.repo.CountByProductID(ctx, product.ID)
+	// 3. Get total count (filter sama)
+	total, err := s.repo.CountByProductID(ctx, dbgen.CountReviewsByProductIDParams{
+		ProductID:  product.ID,
+		Rating:     rating,
+		WithPhotos: filter.WithPhotos,
+	})
 	if err != nil {
 		return ReviewListResponse{}, reviewerrors.ErrReviewFailed
 	}
@@ -167,6 +282,11 @@ func (s *service) GetByProductSlug(ctx context.Context, productSlug string, page
 			Rating:             r.Rating,
 			Comment:            r.Comment,
 			IsVerifiedPurchase: r.IsVerifiedPurchase,
+			Status:             r.Status,
+			Photos:             photoURLs(r.PhotoUrls),
+			Reply:              mapReply(r.ReplyBody, r.ReplyCreatedAt, r.ReplyUpdatedAt),
+			HelpfulCount:       r.HelpfulCount,
+			NotHelpfulCount:    r.NotHelpfulCount,
 			CreatedAt:          r.CreatedAt,
 			UpdatedAt:          r.UpdatedAt,
 		})
@@ -180,6 +300,110 @@ func (s *service) GetByProductSlug(ctx context.Context, productSlug string, page
 	}, nil
 }
 
+// reviewCursorSort: review produk selalu terbaru dulu (created_at DESC, id DESC)
+const reviewCursorSort = "created_at:desc"
+
+// GetByProductSlugByCursor sama seperti GetByProductSlug tapi memakai keyset pagination
+// (hanya untuk sort terbaru; filter rating & foto tetap berlaku)
+func (s *service) GetByProductSlugByCursor(ctx context.Context, productSlug string, filter ReviewListFilter, token string, limit int) (ReviewListResponse, cursor.Page, error) {
+	if limit < 1 || limit > 50 {
+		limit = 10
+	}
+
+	sortBy, rating, err := parseListFilter(filter)
+	if err != nil {
+		return ReviewListResponse{}, cursor.Page{}, err
+	}
+	if sortBy != constants.ReviewSortNewest {
+		return ReviewListResponse{}, cursor.Page{}, reviewerrors.ErrCursorSortUnsupported
+	}
+
+	// 1. Decode cursor (kosong = halaman pertama)
+	cur, err := cursor.Parse(token, reviewCursorSort, 2)
+	if err != nil {
+		return ReviewListResponse{}, cursor.Page{}, err
+	}
+
+	// 2. Get product by slug
+	product, err := s.productRepo.GetBySlug(ctx, productSlug)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ReviewListResponse{}, cursor.Page{}, reviewerrors.ErrProductNotFound
+		}
+		return ReviewListResponse{}, cursor.Page{}, reviewerrors.ErrReviewFailed
+	}
+
+	// 3. Get reviews setelah posisi cursor
+	params := dbgen.GetReviewsByProductIDKeysetParams{
+		Limit:      int32(limit + 1),
+		ProductID:  product.ID,
+		Rating:     rating,
+		WithPhotos: filter.WithPhotos,
+		SortDir:    "desc",
+	}
+	if cur != nil {
+		createdAt, err := time.Parse(time.RFC3339Nano, cur.Values[0])
+		if err != nil {
+			return ReviewListResponse{}, cursor.Page{}, cursor.ErrInvalidCursor
+		}
+		id, err := uuid.Parse(cur.Values[1])
+		if err != nil {
+			return ReviewListResponse{}, cursor.Page{}, cursor.ErrInvalidCursor
+		}
+		params.CursorCreatedAt = sql.NullTime{Time: createdAt, Valid: true}
+		params.CursorID = uuid.NullUUID{UUID: id, Valid: true}
+		if cur.Backward {
+			params.SortDir = "asc"
+		}
+	}
+
+	reviews, err := s.repo.GetByProductIDKeyset(ctx, params)
+	if err != nil {
+		return ReviewListResponse{}, cursor.Page{}, reviewerrors.ErrReviewFailed
+	}
+
+	// 4. Get total count
+	total, err := s.repo.CountByProductID(ctx, dbgen.CountReviewsByProductIDParams{
+		ProductID:  product.ID,
+		Rating:     rating,
+		WithPhotos: filter.WithPhotos,
+	})
+	if err != nil {
+		return ReviewListResponse{}, cursor.Page{}, reviewerrors.ErrReviewFailed
+	}
+
+	// 5. Potong baris ekstra & map to response
+	reviews, page := cursor.Window(reviews, limit, cur, reviewCursorSort, func(r dbgen.GetReviewsByProductIDKeysetRow) []string {
+		return []string{r.CreatedAt.Format(time.RFC3339Nano), r.ID.String()}
+	})
+
+	reviewResponses := make([]ReviewResponse, 0, len(reviews))
+	for _, r := range reviews {
+		reviewResponses = append(reviewResponses, ReviewResponse{
+			ID:                 r.ID.String(),
+			UserID:             r.UserID.String(),
+			UserName:           r.UserName,
+			ProductID:          r.ProductID.String(),
+			Rating:             r.Rating,
+			Comment:            r.Comment,
+			IsVerifiedPurchase: r.IsVerifiedPurchase,
+			Status:             r.Status,
+			Photos:             photoURLs(r.PhotoUrls),
+			Reply:              mapReply(r.ReplyBody, r.ReplyCreatedAt, r.ReplyUpdatedAt),
+			HelpfulCount:       r.HelpfulCount,
+			NotHelpfulCount:    r.NotHelpfulCount,
+			CreatedAt:          r.CreatedAt,
+			UpdatedAt:          r.UpdatedAt,
+		})
+	}
+
+	return ReviewListResponse{
+		Reviews: reviewResponses,
+		Total:   total,
+		Limit:   limit,
+	}, page, nil
+}
+
 // GetByUserID retrieves all reviews by a user
 func (s *service) GetByUserID(ctx context.Context, userID string, page, limit int) (UserReviewListResponse, error) {
 	uid, err := uuid.Parse(userID)
@@ -217,6 +441,7 @@ func (s *service) GetByUserID(ctx context.Context, userID string, page, limit in
 			ProductSlug: r.ProductSlug,
 			Rating:      r.Rating,
 			Comment:     r.Comment,
+			Status:      r.Status,
 			CreatedAt:   r.CreatedAt,
 			UpdatedAt:   r.UpdatedAt,
 		})
@@ -333,22 +558,32 @@ func (s *service) Update(ctx context.Context, reviewID, userID string, req Updat
 
 	qtx := s.repo.WithTx(tx)
 
-	// 4. Update review
+	// 4. Update review; status mengikuti status saat ini (lihat editStatus)
+	status, note, reset := s.editStatus(review, req.Comment)
 	_, err = qtx.Update(ctx, UpdateReviewParams{
-		ID:      rid,
-		Rating:  req.Rating,
-		Comment: req.Comment,
+		ID:              rid,
+		Rating:          req.Rating,
+		Comment:         req.Comment,
+		Status:          status,
+		ModerationNote:  note,
+		ResetModeration: reset,
+		FromStatus:      review.Status,
 	})
 	if err != nil {
+		return ReviewResponse{}, s.transitionError(ctx, rid, err)
+	}
+
+	// 5. Update agregat rating produk
+	if err := s.refreshRating(ctx, qtx, review.ProductID); err != nil {
 		return ReviewResponse{}, reviewerrors.ErrReviewFailed
 	}
 
-	// 5. Commit transaction
+	// 6. Commit transaction
 	if err := tx.Commit(); err != nil {
 		return ReviewResponse{}, reviewerrors.ErrReviewFailed
 	}
 
-	// 6. Fetch updated review
+	// 7. Fetch updated review
 	updatedReview, err := s.repo.GetByID(ctx, rid)
 	if err != nil {
 		return ReviewResponse{}, reviewerrors.ErrReviewFailed
@@ -392,20 +627,564 @@ func (s *service) Delete(ctx context.Context, reviewID, userID string) error {
 
 	qtx := s.repo.WithTx(tx)
 
-	// 4. Delete review
+	// 4. Delete review & foto
 	err = qtx.Delete(ctx, rid)
 	if err != nil {
 		return reviewerrors.ErrReviewFailed
 	}
+	publicIDs, err := qtx.DeletePhotos(ctx, rid)
+	if err != nil {
+		return reviewerrors.ErrReviewFailed
+	}
+
+	// 5. Update agregat rating produk
+	if err := s.refreshRating(ctx, qtx, review.ProductID); err != nil {
+		return reviewerrors.ErrReviewFailed
+	}
+
+	// 6. Commit transaction
+	if err := tx.Commit(); err != nil {
+		return reviewerrors.ErrReviewFailed
+	}
+
+	// 7. Hapus file di Cloudinary setelah commit (best effort)
+	for _, publicID := range publicIDs {
+		_ = s.cloudinaryRepo.DeleteImage(ctx, publicID)
+	}
+
+	return nil
+}
+
+// ==================== ADMIN (MODERASI) ====================
+
+// ListAdmin antrian moderasi dengan filter status / produk / rating
+func (s *service) ListAdmin(ctx context.Context, filter AdminReviewFilter, page, limit int) ([]AdminReviewResponse, int64, error) {
+	params := dbgen.CountReviewsAdminParams{Reported: filter.Reported}
+
+	if filter.Status != "" {
+		status := strings.ToUpper(filter.Status)
+		switch status {
+		case constants.ReviewStatusPending, constants.ReviewStatusApproved,
+			constants.ReviewStatusRejected, constants.ReviewStatusHidden:
+		default:
+			return nil, 0, reviewerrors.ErrInvalidStatusFilter
+		}
+		params.Status = dbgen.ToText(status)
+	}
+	if filter.ProductID != "" {
+		pid, err := uuid.Parse(filter.ProductID)
+		if err != nil {
+			return nil, 0, reviewerrors.ErrInvalidReviewInput
+		}
+		params.ProductID = uuid.NullUUID{UUID: pid, Valid: true}
+	}
+	if filter.Rating != "" {
+		rating, err := strconv.Atoi(filter.Rating)
+		if err != nil || rating < 1 || rating > 5 {
+			return nil, 0, reviewerrors.ErrInvalidRating
+		}
+		params.Rating = dbgen.NewNullInt32(int32(rating))
+	}
+
+	rows, err := s.repo.ListAdmin(ctx, dbgen.ListReviewsAdminParams{
+		Status:    params.Status,
+		ProductID: params.ProductID,
+		Rating:    params.Rating,
+		Reported:  params.Reported,
+		Limit:     int32(limit),
+		Offset:    int32((page - 1) * limit),
+	})
+	if err != nil {
+		return nil, 0, reviewerrors.ErrReviewFailed
+	}
+	total, err := s.repo.CountAdmin(ctx, params)
+	if err != nil {
+		return nil, 0, reviewerrors.ErrReviewFailed
+	}
+
+	res := make([]AdminReviewResponse, 0, len(rows))
+	for _, r := range rows {
+		res = append(res, s.mapToAdminReviewResponse(GetReviewByIDRow(r)))
+	}
+	return res, total, nil
+}
+
+func (s *service) GetAdmin(ctx context.Context, id string) (AdminReviewResponse, error) {
+	rid, err := uuid.Parse(id)
+	if err != nil {
+		return AdminReviewResponse{}, reviewerrors.ErrInvalidReviewID
+	}
+
+	r, err := s.repo.GetByID(ctx, rid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return AdminReviewResponse{}, reviewerrors.ErrReviewNotFound
+		}
+		return AdminReviewResponse{}, reviewerrors.ErrReviewFailed
+	}
+
+	res := s.mapToAdminReviewResponse(r)
+	if r.ReportCount > 0 {
+		reports, err := s.repo.ListReports(ctx, rid)
+		if err != nil {
+			return AdminReviewResponse{}, reviewerrors.ErrReviewFailed
+		}
+		res.Reports = make([]ReviewReportResponse, 0, len(reports))
+		for _, rp := range reports {
+			res.Reports = append(res.Reports, ReviewReportResponse{
+				ID:        rp.ID.String(),
+				UserID:    rp.UserID.String(),
+				UserEmail: rp.UserEmail,
+				Reason:    rp.Reason,
+				Note:      rp.Note.String,
+				CreatedAt: rp.CreatedAt,
+			})
+		}
+	}
+	return res, nil
+}
+
+// Approve tayangkan review (dari PENDING, REJECTED, atau HIDDEN)
+func (s *service) Approve(ctx context.Context, adminID, id string, req ModerateReviewRequest) (AdminReviewResponse, error) {
+	return s.moderate(ctx, adminID, id, constants.ReviewStatusApproved, req)
+}
+
+// Reject tolak review yang masih PENDING
+func (s *service) Reject(ctx context.Context, adminID, id string, req ModerateReviewRequest) (AdminReviewResponse, error) {
+	return s.moderate(ctx, adminID, id, constants.ReviewStatusRejected, req)
+}
+
+// Hide turunkan review yang sudah tayang
+func (s *service) Hide(ctx context.Context, adminID, id string, req ModerateReviewRequest) (AdminReviewResponse, error) {
+	return s.moderate(ctx, adminID, id, constants.ReviewStatusHidden, req)
+}
+
+func (s *service) moderate(ctx context.Context, adminID, id, status string, req ModerateReviewRequest) (AdminReviewResponse, error) {
+	if err := s.validate.Struct(req); err != nil {
+		return AdminReviewResponse{}, apperror.MapValidationError(err)
+	}
+	rid, err := uuid.Parse(id)
+	if err != nil {
+		return AdminReviewResponse{}, reviewerrors.ErrInvalidReviewID
+	}
+	aid, err := uuid.Parse(adminID)
+	if err != nil {
+		return AdminReviewResponse{}, reviewerrors.ErrUnauthenticated
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return AdminReviewResponse{}, reviewerrors.ErrReviewFailed
+	}
+	defer tx.Rollback()
+
+	qtx := s.repo.WithTx(tx)
+
+	// 1. Update ber-guard: gagal (no rows) jika status asal tidak sesuai
+	review, err := qtx.SetStatus(ctx, dbgen.SetReviewStatusParams{
+		ID:             rid,
+		Status:         status,
+		ModerationNote: dbgen.ToText(strings.TrimSpace(req.Note)),
+		ModeratedBy:    uuid.NullUUID{UUID: aid, Valid: true},
+		FromStatuses:   reviewTransitions[status],
+	})
+	if err != nil {
+		return AdminReviewResponse{}, s.transitionError(ctx, rid, err)
+	}
+
+	// 2. Laporan user dianggap sudah ditangani oleh keputusan admin
+	if err := qtx.ResolveReports(ctx, rid); err != nil {
+		return AdminReviewResponse{}, reviewerrors.ErrReviewFailed
+	}
+
+	// 3. Status tayang berubah -> agregat rating produk ikut berubah
+	if err := s.refreshRating(ctx, qtx, review.ProductID); err != nil {
+		return AdminReviewResponse{}, reviewerrors.ErrReviewFailed
+	}
+
+	if err := tx.Commit(); err != nil {
+		return AdminReviewResponse{}, reviewerrors.ErrReviewFailed
+	}
+
+	// 4. Fetch detail terbaru
+	return s.GetAdmin(ctx, id)
+}
+
+// ==================== BALASAN MERCHANT ====================
+
+// CreateReply POST /admin/reviews/:id/reply (satu balasan per review)
+func (s *service) CreateReply(ctx context.Context, adminID, id string, req ReviewReplyRequest) (ReviewReplyResponse, error) {
+	rid, aid, err := s.parseReplyInput(adminID, id, req)
+	if err != nil {
+		return ReviewReplyResponse{}, err
+	}
+
+	// 1. Review harus ada
+	if _, err := s.GetAdmin(ctx, id); err != nil {
+		return ReviewReplyResponse{}, err
+	}
+
+	// 2. Simpan balasan; unique review_id menjaga satu balasan per review
+	reply, err := s.repo.CreateReply(ctx, dbgen.CreateReviewReplyParams{
+		ReviewID: rid,
+		AdminID:  uuid.NullUUID{UUID: aid, Valid: true},
+		Body:     strings.TrimSpace(req.Body),
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ReviewReplyResponse{}, reviewerrors.ErrReplyAlreadyExists
+		}
+		return ReviewReplyResponse{}, reviewerrors.ErrReviewFailed
+	}
+
+	return mapReplyToResponse(reply), nil
+}
+
+// UpdateReply PUT /admin/reviews/:id/reply
+func (s *service) UpdateReply(ctx context.Context, adminID, id string, req ReviewReplyRequest) (ReviewReplyResponse, error) {
+	rid, aid, err := s.parseReplyInput(adminID, id, req)
+	if err != nil {
+		return ReviewReplyResponse{}, err
+	}
+
+	reply, err := s.repo.UpdateReply(ctx, dbgen.UpdateReviewReplyParams{
+		ReviewID: rid,
+		Body:     strings.TrimSpace(req.Body),
+		AdminID:  uuid.NullUUID{UUID: aid, Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ReviewReplyResponse{}, reviewerrors.ErrReplyNotFound
+		}
+		return ReviewReplyResponse{}, reviewerrors.ErrReviewFailed
+	}
+
+	return mapReplyToResponse(reply), nil
+}
+
+// DeleteReply DELETE /admin/reviews/:id/reply
+func (s *service) DeleteReply(ctx context.Context, id string) error {
+	rid, err := uuid.Parse(id)
+	if err != nil {
+		return reviewerrors.ErrInvalidReviewID
+	}
+
+	affected, err := s.repo.DeleteReply(ctx, rid)
+	if err != nil {
+		return reviewerrors.ErrReviewFailed
+	}
+	if affected == 0 {
+		return reviewerrors.ErrReplyNotFound
+	}
+	return nil
+}
+
+func (s *service) parseReplyInput(adminID, id string, req ReviewReplyRequest) (uuid.UUID, uuid.UUID, error) {
+	if err := s.validate.Struct(req); err != nil {
+		return uuid.Nil, uuid.Nil, apperror.MapValidationError(err)
+	}
+	if strings.TrimSpace(req.Body) == "" {
+		return uuid.Nil, uuid.Nil, reviewerrors.ErrInvalidReviewInput
+	}
+	rid, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, reviewerrors.ErrInvalidReviewID
+	}
+	aid, err := uuid.Parse(adminID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, reviewerrors.ErrUnauthenticated
+	}
+	return rid, aid, nil
+}
+
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505"
+}
+
+// ==================== VOTE & LAPORAN ====================
+
+// Vote tandai review membantu / tidak membantu (satu vote per user, vote ulang mengganti)
+func (s *service) Vote(ctx context.Context, userID, id string, req VoteReviewRequest) (ReviewVoteResponse, error) {
+	if err := s.validate.Struct(req); err != nil {
+		return ReviewVoteResponse{}, apperror.MapValidationError(err)
+	}
+	rid, uid, err := s.publicReviewTarget(ctx, userID, id, reviewerrors.ErrCannotVoteOwnReview)
+	if err != nil {
+		return ReviewVoteResponse{}, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return ReviewVoteResponse{}, reviewerrors.ErrReviewFailed
+	}
+	defer tx.Rollback()
+
+	qtx := s.repo.WithTx(tx)
+
+	// 1. Simpan / ganti vote
+	if err := qtx.UpsertVote(ctx, dbgen.UpsertReviewVoteParams{
+		ReviewID: rid,
+		UserID:   uid,
+		Helpful:  *req.Helpful,
+	}); err != nil {
+		return ReviewVoteResponse{}, reviewerrors.ErrReviewFailed
+	}
+
+	// 2. Hitung ulang counter di baris review
+	counts, err := qtx.RecomputeVotes(ctx, rid)
+	if err != nil {
+		return ReviewVoteResponse{}, reviewerrors.ErrReviewFailed
+	}
+
+	if err := tx.Commit(); err != nil {
+		return ReviewVoteResponse{}, reviewerrors.ErrReviewFailed
+	}
+
+	return ReviewVoteResponse{
+		ReviewID:        id,
+		Helpful:         req.Helpful,
+		HelpfulCount:    counts.HelpfulCount,
+		NotHelpfulCount: counts.NotHelpfulCount,
+	}, nil
+}
+
+// DeleteVote batalkan vote user
+func (s *service) DeleteVote(ctx context.Context, userID, id string) (ReviewVoteResponse, error) {
+	rid, err := uuid.Parse(id)
+	if err != nil {
+		return ReviewVoteResponse{}, reviewerrors.ErrInvalidReviewID
+	}
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return ReviewVoteResponse{}, reviewerrors.ErrUnauthenticated
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return ReviewVoteResponse{}, reviewerrors.ErrReviewFailed
+	}
+	defer tx.Rollback()
+
+	qtx := s.repo.WithTx(tx)
+
+	n, err := qtx.DeleteVote(ctx, rid, uid)
+	if err != nil {
+		return ReviewVoteResponse{}, reviewerrors.ErrReviewFailed
+	}
+	if n == 0 {
+		return ReviewVoteResponse{}, reviewerrors.ErrVoteNotFound
+	}
+
+	counts, err := qtx.RecomputeVotes(ctx, rid)
+	if err != nil {
+		return ReviewVoteResponse{}, reviewerrors.ErrReviewFailed
+	}
 
-	// 5. Commit transaction
 	if err := tx.Commit(); err != nil {
+		return ReviewVoteResponse{}, reviewerrors.ErrReviewFailed
+	}
+
+	return ReviewVoteResponse{
+		ReviewID:        id,
+		HelpfulCount:    counts.HelpfulCount,
+		NotHelpfulCount: counts.NotHelpfulCount,
+	}, nil
+}
+
+// Report laporkan review bermasalah. Setelah ReviewReportThreshold laporan,
+// review APPROVED otomatis turun ke PENDING supaya ditinjau admin.
+func (s *service) Report(ctx context.Context, userID, id string, req ReportReviewRequest) error {
+	if err := s.validate.Struct(req); err != nil {
+		return apperror.MapValidationError(err)
+	}
+	rid, uid, err := s.publicReviewTarget(ctx, userID, id, reviewerrors.ErrCannotReportOwnReview)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
 		return reviewerrors.ErrReviewFailed
 	}
+	defer tx.Rollback()
+
+	qtx := s.repo.WithTx(tx)
+
+	// 1. Simpan laporan (satu per user per review)
+	if _, err := qtx.CreateReport(ctx, dbgen.CreateReviewReportParams{
+		ReviewID: rid,
+		UserID:   uid,
+		Reason:   req.Reason,
+		Note:     dbgen.ToText(strings.TrimSpace(req.Note)),
+	}); err != nil {
+		if isUniqueViolation(err) {
+			return reviewerrors.ErrAlreadyReported
+		}
+		return reviewerrors.ErrReviewFailed
+	}
+
+	// 2. Naikkan counter laporan terbuka
+	review, err := qtx.IncrementReportCount(ctx, rid)
+	if err != nil {
+		return reviewerrors.ErrReviewFailed
+	}
+
+	// 3. Lewat ambang batas -> masuk antrian moderasi & keluar dari agregat rating
+	if review.Status == constants.ReviewStatusApproved && review.ReportCount >= constants.ReviewReportThreshold {
+		if _, err := qtx.SetStatus(ctx, dbgen.SetReviewStatusParams{
+			ID:             rid,
+			Status:         constants.ReviewStatusPending,
+			ModerationNote: dbgen.ToText(fmt.Sprintf("reported by %d users", review.ReportCount)),
+			FromStatuses:   []string{constants.ReviewStatusApproved},
+		}); err != nil {
+			return reviewerrors.ErrReviewFailed
+		}
+		if err := s.refreshRating(ctx, qtx, review.ProductID); err != nil {
+			return reviewerrors.ErrReviewFailed
+		}
+	}
 
+	if err := tx.Commit(); err != nil {
+		return reviewerrors.ErrReviewFailed
+	}
 	return nil
 }
 
+// publicReviewTarget validasi target vote / laporan: review harus tayang dan bukan milik user sendiri
+func (s *service) publicReviewTarget(ctx context.Context, userID, id string, ownErr error) (uuid.UUID, uuid.UUID, error) {
+	rid, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, reviewerrors.ErrInvalidReviewID
+	}
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, reviewerrors.ErrUnauthenticated
+	}
+
+	r, err := s.repo.GetByID(ctx, rid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return uuid.Nil, uuid.Nil, reviewerrors.ErrReviewNotFound
+		}
+		return uuid.Nil, uuid.Nil, reviewerrors.ErrReviewFailed
+	}
+	// Review yang belum / tidak tayang diperlakukan seperti tidak ada
+	if r.Status != constants.ReviewStatusApproved {
+		return uuid.Nil, uuid.Nil, reviewerrors.ErrReviewNotFound
+	}
+	if r.UserID == uid {
+		return uuid.Nil, uuid.Nil, ownErr
+	}
+	return rid, uid, nil
+}
+
+// parseListFilter normalisasi sort (default terbaru) & filter rating listing publik
+func parseListFilter(filter ReviewListFilter) (string, sql.NullInt32, error) {
+	sortBy := strings.ToLower(filter.Sort)
+	switch sortBy {
+	case "":
+		sortBy = constants.ReviewSortNewest
+	case constants.ReviewSortNewest, constants.ReviewSortHelpful,
+		constants.ReviewSortRatingHigh, constants.ReviewSortRatingLow:
+	default:
+		return "", sql.NullInt32{}, reviewerrors.ErrInvalidReviewSort
+	}
+
+	var rating sql.NullInt32
+	if filter.Rating != "" {
+		r, err := strconv.Atoi(filter.Rating)
+		if err != nil || r < 1 || r > 5 {
+			return "", sql.NullInt32{}, reviewerrors.ErrInvalidRating
+		}
+		rating = dbgen.NewNullInt32(int32(r))
+	}
+	return sortBy, rating, nil
+}
+
+// RecomputeAllRatings hitung ulang agregat rating semua produk (backfill / perbaikan data)
+func (s *service) RecomputeAllRatings(ctx context.Context) (int64, error) {
+	n, err := s.repo.RecomputeAllProductRatings(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("recompute product ratings: %w", err)
+	}
+	return n, nil
+}
+
+// refreshRating hitung ulang agregat rating produk di dalam transaksi pemanggil.
+// Baris produk dikunci dulu supaya review yang masuk bersamaan tidak saling menimpa hasil hitungan.
+func (s *service) refreshRating(ctx context.Context, qtx Repository, productID uuid.UUID) error {
+	if err := qtx.LockProductRating(ctx, productID); err != nil {
+		return err
+	}
+	return qtx.RecomputeProductRating(ctx, productID)
+}
+
+// transitionError bedakan review tidak ada vs status tidak sesuai saat update ber-guard gagal
+func (s *service) transitionError(ctx context.Context, id uuid.UUID, err error) error {
+	if !errors.Is(err, sql.ErrNoRows) {
+		return reviewerrors.ErrReviewFailed
+	}
+	if _, gerr := s.repo.GetByID(ctx, id); errors.Is(gerr, sql.ErrNoRows) {
+		return reviewerrors.ErrReviewNotFound
+	}
+	return reviewerrors.ErrInvalidReviewStatus
+}
+
+// initialStatus status review baru / hasil edit: kena filter kata -> PENDING dengan catatan,
+// selain itu APPROVED jika auto-approve aktif
+func (s *service) initialStatus(comment string) (string, sql.NullString) {
+	if s.filter != nil {
+		if word, blocked := s.filter.Check(comment); blocked {
+			return constants.ReviewStatusPending, dbgen.ToText(fmt.Sprintf("blocked word: %s", word))
+		}
+	}
+	if s.autoApprove {
+		return constants.ReviewStatusApproved, sql.NullString{}
+	}
+	return constants.ReviewStatusPending, sql.NullString{}
+}
+
+// editStatus status review setelah diedit pemilik:
+//   - REJECTED / HIDDEN: keputusan admin tetap berlaku
+//   - PENDING (filter kata / antrian / laporan user): tetap menunggu admin, tidak pernah auto-approve
+//   - APPROVED: dicek ulang seperti review baru; masuk antrian lagi -> data moderasi dikosongkan
+func (s *service) editStatus(r GetReviewByIDRow, comment string) (string, sql.NullString, bool) {
+	status, note := s.initialStatus(comment)
+
+	switch r.Status {
+	case constants.ReviewStatusApproved:
+		if status == constants.ReviewStatusApproved {
+			return status, r.ModerationNote, false
+		}
+		return status, note, true
+	case constants.ReviewStatusPending:
+		// catatan filter kata baru menggantikan catatan lama
+		if note.Valid {
+			return r.Status, note, false
+		}
+	}
+	return r.Status, r.ModerationNote, false
+}
+
+func (s *service) mapToAdminReviewResponse(r GetReviewByIDRow) AdminReviewResponse {
+	res := AdminReviewResponse{
+		ReviewResponse: s.mapToReviewResponse(r),
+		UserEmail:      r.UserEmail,
+		ProductName:    r.ProductName,
+		ProductSlug:    r.ProductSlug,
+		ModerationNote: r.ModerationNote.String,
+		ReportCount:    r.ReportCount,
+	}
+	if r.ModeratedBy.Valid {
+		res.ModeratedBy = r.ModeratedBy.UUID.String()
+	}
+	if r.ModeratedAt.Valid {
+		res.ModeratedAt = &r.ModeratedAt.Time
+	}
+	return res
+}
+
 // Helper function to map review to response
 func (s *service) mapToReviewResponse(r GetReviewByIDRow) ReviewResponse {
 	return ReviewResponse{
@@ -416,11 +1195,44 @@ func (s *service) mapToReviewResponse(r GetReviewByIDRow) ReviewResponse {
 		Rating:             r.Rating,
 		Comment:            r.Comment,
 		IsVerifiedPurchase: r.IsVerifiedPurchase,
+		Status:             r.Status,
+		Photos:             photoURLs(r.PhotoUrls),
+		Reply:              mapReply(r.ReplyBody, r.ReplyCreatedAt, r.ReplyUpdatedAt),
+		HelpfulCount:       r.HelpfulCount,
+		NotHelpfulCount:    r.NotHelpfulCount,
 		CreatedAt:          r.CreatedAt,
 		UpdatedAt:          r.UpdatedAt,
 	}
 }
 
+// photoURLs selalu kembalikan array (bukan null) di JSON
+func photoURLs(urls []string) []string {
+	if urls == nil {
+		return []string{}
+	}
+	return urls
+}
+
+// mapReply kolom hasil LEFT JOIN review_replies; nil jika belum dibalas
+func mapReply(body sql.NullString, createdAt, updatedAt sql.NullTime) *ReviewReplyResponse {
+	if !body.Valid {
+		return nil
+	}
+	return &ReviewReplyResponse{
+		Body:      body.String,
+		CreatedAt: createdAt.Time,
+		UpdatedAt: updatedAt.Time,
+	}
+}
+
+func mapReplyToResponse(r dbgen.ReviewReply) ReviewReplyResponse {
+	return ReviewReplyResponse{
+		Body:      r.Body,
+		CreatedAt: r.CreatedAt,
+		UpdatedAt: r.UpdatedAt,
+	}
+}
+
 // Note: These type aliases should match your actual dbgen types
 type CreateReviewParams = dbgen.CreateReviewParams
 type UpdateReviewParams = dbgen.UpdateReviewParams
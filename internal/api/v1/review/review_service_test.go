@@ -3,11 +3,15 @@ package review_test
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"mime/multipart"
 	"testing"
 
 	"go-sqlc-starter/internal/api/v1/review"
 	"go-sqlc-starter/internal/dbgen"
 	"go-sqlc-starter/internal/pkg/apperror"
+	"go-sqlc-starter/internal/pkg/constants"
+	"go-sqlc-starter/internal/pkg/moderation"
 
 	productMock "go-sqlc-starter/internal/api/v1/mock/product"
 	reviewMock "go-sqlc-starter/internal/api/v1/mock/review"
@@ -16,6 +20,7 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -27,6 +32,7 @@ type reviewDeps struct {
 	service     review.Service
 	repo        *reviewMock.MockRepository
 	productRepo *productMock.MockRepository
+	cloudinary  *reviewMock.MockCloudinaryService
 }
 
 func setupReviewTest(t *testing.T) *reviewDeps {
@@ -36,8 +42,9 @@ func setupReviewTest(t *testing.T) *reviewDeps {
 
 	repo := reviewMock.NewMockRepository(ctrl)
 	productRepo := productMock.NewMockRepository(ctrl)
+	cld := reviewMock.NewMockCloudinaryService(ctrl)
 
-	svc := review.NewService(db, repo, productRepo)
+	svc := review.NewService(db, repo, productRepo, cld, moderation.NewWordListFilter([]string{"spam"}), true)
 
 	return &reviewDeps{
 		db:          db,
@@ -45,9 +52,16 @@ func setupReviewTest(t *testing.T) *reviewDeps {
 		service:     svc,
 		repo:        repo,
 		productRepo: productRepo,
+		cloudinary:  cld,
 	}
 }
 
+// Helper untuk ekspektasi recompute agregat rating produk di dalam transaksi
+func expectRatingRefresh(repo *reviewMock.MockRepository, productID interface{}) {
+	repo.EXPECT().LockProductRating(gomock.Any(), productID).Return(nil)
+	repo.EXPECT().RecomputeProductRating(gomock.Any(), productID).Return(nil)
+}
+
 // Helper untuk ekspektasi transaksi
 func expectTx(sqlMock sqlmock.Sqlmock, shouldCommit bool) {
 	if shouldCommit {
@@ -83,22 +97,114 @@ func TestReviewService_Create(t *testing.T) {
 
 		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
 		deps.repo.EXPECT().Create(ctx, gomock.Any()).Return(dbgen.Review{ID: uuid.New()}, nil)
+		expectRatingRefresh(deps.repo, productID)
 
 		// MapToReviewResponse calls GetByID
 		deps.repo.EXPECT().GetByID(ctx, gomock.Any()).Return(dbgen.GetReviewByIDRow{
 			ID: uuid.New(), UserID: userID, UserName: "John", Comment: req.Comment,
 		}, nil)
 
-		res, err := deps.service.Create(ctx, userID.String(), productSlug, req)
+		res, err := deps.service.Create(ctx, userID.String(), productSlug, req, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, req.Comment, res.Comment)
 	})
 
+	t.Run("positive - blocked word masuk antrian moderasi", func(t *testing.T) {
+		expectTx(deps.sqlMock, true)
+		spamReq := review.CreateReviewRequest{Rating: 1, Comment: "Beli di toko lain saja, SPAM!"}
+
+		deps.productRepo.EXPECT().GetBySlug(ctx, productSlug).Return(dbgen.GetProductBySlugRow{ID: productID}, nil)
+		deps.repo.EXPECT().CheckExists(ctx, userID, productID).Return(false, nil)
+		deps.repo.EXPECT().CheckUserPurchased(ctx, userID, productID).Return(true, nil)
+		deps.repo.EXPECT().GetCompletedOrder(ctx, userID, productID).Return(orderID, nil)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		deps.repo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, arg dbgen.CreateReviewParams) (dbgen.Review, error) {
+			assert.Equal(t, constants.ReviewStatusPending, arg.Status)
+			assert.Equal(t, "blocked word: spam", arg.ModerationNote.String)
+			return dbgen.Review{ID: uuid.New()}, nil
+		})
+		expectRatingRefresh(deps.repo, productID)
+		deps.repo.EXPECT().GetByID(ctx, gomock.Any()).Return(dbgen.GetReviewByIDRow{Status: constants.ReviewStatusPending}, nil)
+
+		res, err := deps.service.Create(ctx, userID.String(), productSlug, spamReq, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, constants.ReviewStatusPending, res.Status)
+	})
+
+	t.Run("positive - create dengan foto", func(t *testing.T) {
+		expectTx(deps.sqlMock, true)
+		var uploadName string
+
+		deps.productRepo.EXPECT().GetBySlug(ctx, productSlug).Return(dbgen.GetProductBySlugRow{ID: productID}, nil)
+		deps.repo.EXPECT().CheckExists(ctx, userID, productID).Return(false, nil)
+		deps.repo.EXPECT().CheckUserPurchased(ctx, userID, productID).Return(true, nil)
+		deps.repo.EXPECT().GetCompletedOrder(ctx, userID, productID).Return(orderID, nil)
+
+		// Upload sebelum transaksi; nama file dari user tidak ikut ke public ID
+		deps.cloudinary.EXPECT().
+			UploadImage(ctx, nil, gomock.Any(), constants.CloudinaryReviewFolder).
+			DoAndReturn(func(_ context.Context, _ multipart.File, name, _ string) (string, error) {
+				uploadName = name
+				return "https://cdn/unboxing.jpg", nil
+			})
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		deps.repo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, arg dbgen.CreateReviewParams) (dbgen.Review, error) {
+			assert.Equal(t, "review-"+arg.ID.String()+"-1", uploadName)
+			return dbgen.Review{ID: arg.ID}, nil
+		})
+		deps.repo.EXPECT().CreatePhoto(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, arg dbgen.CreateReviewPhotoParams) error {
+			assert.Equal(t, "https://cdn/unboxing.jpg", arg.ImageUrl)
+			assert.Equal(t, constants.CloudinaryReviewFolder+"/review-"+arg.ReviewID.String()+"-1", arg.PublicID)
+			assert.Equal(t, int32(0), arg.SortOrder)
+			return nil
+		})
+		expectRatingRefresh(deps.repo, productID)
+		deps.repo.EXPECT().GetByID(ctx, gomock.Any()).Return(dbgen.GetReviewByIDRow{
+			PhotoUrls: []string{"https://cdn/unboxing.jpg"},
+		}, nil)
+
+		res, err := deps.service.Create(ctx, userID.String(), productSlug, req, []review.Photo{{Filename: "../unboxing foto.jpg"}})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"https://cdn/unboxing.jpg"}, res.Photos)
+		assert.NotContains(t, uploadName, "unboxing")
+	})
+
+	t.Run("negative - upload gagal, foto sebelumnya dihapus tanpa membuka transaksi", func(t *testing.T) {
+		var firstName string
+
+		deps.productRepo.EXPECT().GetBySlug(ctx, productSlug).Return(dbgen.GetProductBySlugRow{ID: productID}, nil)
+		deps.repo.EXPECT().CheckExists(ctx, userID, productID).Return(false, nil)
+		deps.repo.EXPECT().CheckUserPurchased(ctx, userID, productID).Return(true, nil)
+		deps.repo.EXPECT().GetCompletedOrder(ctx, userID, productID).Return(orderID, nil)
+		deps.cloudinary.EXPECT().
+			UploadImage(ctx, nil, gomock.Any(), constants.CloudinaryReviewFolder).
+			DoAndReturn(func(_ context.Context, _ multipart.File, name, _ string) (string, error) {
+				firstName = name
+				return "https://cdn/1.jpg", nil
+			})
+		deps.cloudinary.EXPECT().UploadImage(ctx, nil, gomock.Any(), constants.CloudinaryReviewFolder).Return("", errors.New("upload failed"))
+		deps.cloudinary.EXPECT().DeleteImage(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, publicID string) error {
+			assert.Equal(t, constants.CloudinaryReviewFolder+"/"+firstName, publicID)
+			return nil
+		})
+
+		_, err := deps.service.Create(ctx, userID.String(), productSlug, req, []review.Photo{{Filename: "a.jpg"}, {Filename: "b.jpg"}})
+		assert.Equal(t, reviewerrors.ErrImageUploadFailed, err)
+		assert.NoError(t, deps.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("negative - too many photos", func(t *testing.T) {
+		photos := make([]review.Photo, constants.ReviewMaxPhotos+1)
+
+		_, err := deps.service.Create(ctx, userID.String(), productSlug, req, photos)
+		assert.Equal(t, reviewerrors.ErrTooManyPhotos, err)
+	})
+
 	t.Run("negative - already reviewed", func(t *testing.T) {
 		deps.productRepo.EXPECT().GetBySlug(ctx, productSlug).Return(dbgen.GetProductBySlugRow{ID: productID}, nil)
 		deps.repo.EXPECT().CheckExists(ctx, userID, productID).Return(true, nil)
 
-		_, err := deps.service.Create(ctx, userID.String(), productSlug, req)
+		_, err := deps.service.Create(ctx, userID.String(), productSlug, req, nil)
 		assert.Error(t, err)
 		assert.Equal(t, reviewerrors.ErrReviewAlreadyExists, err)
 	})
@@ -110,7 +216,7 @@ func TestReviewService_Create(t *testing.T) {
 		}
 		var appErr *apperror.AppError
 
-		_, err := deps.service.Create(ctx, userID.String(), productSlug, req)
+		_, err := deps.service.Create(ctx, userID.String(), productSlug, req, nil)
 
 		assert.Error(t, err)
 		assert.ErrorAs(t, err, &appErr)
@@ -161,14 +267,26 @@ func TestReviewService_Update(t *testing.T) {
 	ctx := context.Background()
 	reviewID := uuid.New()
 	userID := uuid.New()
+	productID := uuid.New()
 	req := review.UpdateReviewRequest{Rating: 4, Comment: "Update comment"}
 
 	t.Run("positive - success update", func(t *testing.T) {
 		expectTx(deps.sqlMock, true)
+		current := dbgen.GetReviewByIDRow{
+			ID: reviewID, UserID: userID, ProductID: productID,
+			Status: constants.ReviewStatusApproved, ModerationNote: dbgen.ToText("ok"),
+		}
 
-		deps.repo.EXPECT().GetByID(ctx, reviewID).Return(dbgen.GetReviewByIDRow{ID: reviewID, UserID: userID}, nil)
+		deps.repo.EXPECT().GetByID(ctx, reviewID).Return(current, nil)
 		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
-		deps.repo.EXPECT().Update(ctx, gomock.Any()).Return(dbgen.Review{}, nil)
+		deps.repo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, arg dbgen.UpdateReviewParams) (dbgen.Review, error) {
+			// Lolos filter & auto-approve: tetap APPROVED, data moderasi tidak disentuh
+			assert.Equal(t, constants.ReviewStatusApproved, arg.Status)
+			assert.Equal(t, "ok", arg.ModerationNote.String)
+			assert.False(t, arg.ResetModeration)
+			return dbgen.Review{}, nil
+		})
+		expectRatingRefresh(deps.repo, productID)
 		deps.repo.EXPECT().GetByID(ctx, reviewID).Return(dbgen.GetReviewByIDRow{ID: reviewID, Comment: req.Comment}, nil)
 
 		res, err := deps.service.Update(ctx, reviewID.String(), userID.String(), req)
@@ -176,6 +294,67 @@ func TestReviewService_Update(t *testing.T) {
 		assert.Equal(t, req.Comment, res.Comment)
 	})
 
+	t.Run("positive - rejected / hidden tetap, tidak di-approve ulang", func(t *testing.T) {
+		for _, status := range []string{constants.ReviewStatusRejected, constants.ReviewStatusHidden} {
+			expectTx(deps.sqlMock, true)
+			current := dbgen.GetReviewByIDRow{
+				ID: reviewID, UserID: userID, ProductID: productID,
+				Status: status, ModerationNote: dbgen.ToText("off-topic"),
+			}
+
+			deps.repo.EXPECT().GetByID(ctx, reviewID).Return(current, nil)
+			deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+			deps.repo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, arg dbgen.UpdateReviewParams) (dbgen.Review, error) {
+				assert.Equal(t, status, arg.Status)
+				assert.Equal(t, status, arg.FromStatus)
+				assert.Equal(t, "off-topic", arg.ModerationNote.String)
+				assert.False(t, arg.ResetModeration)
+				return dbgen.Review{}, nil
+			})
+			expectRatingRefresh(deps.repo, productID)
+			deps.repo.EXPECT().GetByID(ctx, reviewID).Return(dbgen.GetReviewByIDRow{ID: reviewID, Status: status}, nil)
+
+			res, err := deps.service.Update(ctx, reviewID.String(), userID.String(), req)
+			assert.NoError(t, err)
+			assert.Equal(t, status, res.Status)
+		}
+	})
+
+	t.Run("positive - pending karena laporan tetap pending", func(t *testing.T) {
+		expectTx(deps.sqlMock, true)
+		current := dbgen.GetReviewByIDRow{
+			ID: reviewID, UserID: userID, ProductID: productID,
+			Status: constants.ReviewStatusPending, ReportCount: constants.ReviewReportThreshold,
+		}
+
+		deps.repo.EXPECT().GetByID(ctx, reviewID).Return(current, nil)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		deps.repo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, arg dbgen.UpdateReviewParams) (dbgen.Review, error) {
+			assert.Equal(t, constants.ReviewStatusPending, arg.Status)
+			assert.False(t, arg.ResetModeration)
+			return dbgen.Review{}, nil
+		})
+		expectRatingRefresh(deps.repo, productID)
+		deps.repo.EXPECT().GetByID(ctx, reviewID).Return(dbgen.GetReviewByIDRow{ID: reviewID, Status: constants.ReviewStatusPending}, nil)
+
+		res, err := deps.service.Update(ctx, reviewID.String(), userID.String(), req)
+		assert.NoError(t, err)
+		assert.Equal(t, constants.ReviewStatusPending, res.Status)
+	})
+
+	t.Run("negative - status berubah sebelum update tersimpan", func(t *testing.T) {
+		expectTx(deps.sqlMock, false)
+		current := dbgen.GetReviewByIDRow{ID: reviewID, UserID: userID, ProductID: productID, Status: constants.ReviewStatusApproved}
+
+		deps.repo.EXPECT().GetByID(ctx, reviewID).Return(current, nil)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		deps.repo.EXPECT().Update(ctx, gomock.Any()).Return(dbgen.Review{}, sql.ErrNoRows)
+		deps.repo.EXPECT().GetByID(ctx, reviewID).Return(dbgen.GetReviewByIDRow{ID: reviewID, Status: constants.ReviewStatusPending}, nil)
+
+		_, err := deps.service.Update(ctx, reviewID.String(), userID.String(), req)
+		assert.Equal(t, reviewerrors.ErrInvalidReviewStatus, err)
+	})
+
 	t.Run("negative - unauthorized update", func(t *testing.T) {
 		otherUser := uuid.New()
 		deps.repo.EXPECT().GetByID(ctx, reviewID).Return(dbgen.GetReviewByIDRow{ID: reviewID, UserID: otherUser}, nil)
@@ -241,6 +420,9 @@ func TestReviewService_Delete(t *testing.T) {
 		deps.repo.EXPECT().GetByID(ctx, reviewID).Return(dbgen.GetReviewByIDRow{ID: reviewID, UserID: userID}, nil)
 		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
 		deps.repo.EXPECT().Delete(ctx, reviewID).Return(nil)
+		deps.repo.EXPECT().DeletePhotos(ctx, reviewID).Return([]string{"go-gadget/reviews/review-1"}, nil)
+		expectRatingRefresh(deps.repo, gomock.Any())
+		deps.cloudinary.EXPECT().DeleteImage(ctx, "go-gadget/reviews/review-1").Return(nil)
 
 		err := deps.service.Delete(ctx, reviewID.String(), userID.String())
 		assert.NoError(t, err)
@@ -267,22 +449,434 @@ func TestReviewService_GetByProductSlug(t *testing.T) {
 
 	t.Run("positive - list success", func(t *testing.T) {
 		deps.productRepo.EXPECT().GetBySlug(ctx, slug).Return(dbgen.GetProductBySlugRow{ID: pid}, nil)
-		deps.repo.EXPECT().GetByProductID(ctx, pid, int32(10), int32(0)).Return([]dbgen.GetReviewsByProductIDRow{{ID: uuid.New()}}, nil)
-		deps.repo.EXPECT().CountByProductID(ctx, pid).Return(int64(1), nil)
+		deps.repo.EXPECT().ListByProduct(ctx, dbgen.GetReviewsByProductIDParams{
+			ProductID: pid, SortBy: constants.ReviewSortNewest, Limit: 10, Offset: 0,
+		}).Return([]dbgen.GetReviewsByProductIDRow{{ID: uuid.New(), HelpfulCount: 4, NotHelpfulCount: 1}}, nil)
+		deps.repo.EXPECT().CountByProductID(ctx, dbgen.CountReviewsByProductIDParams{ProductID: pid}).Return(int64(1), nil)
 
-		res, err := deps.service.GetByProductSlug(ctx, slug, 1, 10)
+		res, err := deps.service.GetByProductSlug(ctx, slug, review.ReviewListFilter{}, 1, 10)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(1), res.Total)
+		assert.Equal(t, int32(4), res.Reviews[0].HelpfulCount)
+		assert.Equal(t, int32(1), res.Reviews[0].NotHelpfulCount)
+	})
+
+	t.Run("positive - paling membantu, bintang 5 dengan foto", func(t *testing.T) {
+		deps.productRepo.EXPECT().GetBySlug(ctx, slug).Return(dbgen.GetProductBySlugRow{ID: pid}, nil)
+		deps.repo.EXPECT().ListByProduct(ctx, dbgen.GetReviewsByProductIDParams{
+			ProductID:  pid,
+			Rating:     sql.NullInt32{Int32: 5, Valid: true},
+			WithPhotos: true,
+			SortBy:     constants.ReviewSortHelpful,
+			Limit:      10,
+			Offset:     10,
+		}).Return(nil, nil)
+		// Total ikut filter yang sama
+		deps.repo.EXPECT().CountByProductID(ctx, dbgen.CountReviewsByProductIDParams{
+			ProductID:  pid,
+			Rating:     sql.NullInt32{Int32: 5, Valid: true},
+			WithPhotos: true,
+		}).Return(int64(11), nil)
+
+		res, err := deps.service.GetByProductSlug(ctx, slug, review.ReviewListFilter{Sort: "HELPFUL", Rating: "5", WithPhotos: true}, 2, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(11), res.Total)
 	})
 
 	t.Run("negative - invalid page", func(t *testing.T) {
 		// Service handle page < 1 as 1
 		deps.productRepo.EXPECT().GetBySlug(ctx, slug).Return(dbgen.GetProductBySlugRow{ID: pid}, nil)
-		deps.repo.EXPECT().GetByProductID(ctx, pid, int32(10), int32(0)).Return(nil, nil)
-		deps.repo.EXPECT().CountByProductID(ctx, pid).Return(int64(0), nil)
+		deps.repo.EXPECT().ListByProduct(ctx, gomock.Any()).Return(nil, nil)
+		deps.repo.EXPECT().CountByProductID(ctx, gomock.Any()).Return(int64(0), nil)
 
-		res, err := deps.service.GetByProductSlug(ctx, slug, 0, 10)
+		res, err := deps.service.GetByProductSlug(ctx, slug, review.ReviewListFilter{}, 0, 10)
 		assert.NoError(t, err)
 		assert.Equal(t, 1, res.Page)
 	})
+
+	t.Run("negative - sort & rating tidak valid", func(t *testing.T) {
+		_, err := deps.service.GetByProductSlug(ctx, slug, review.ReviewListFilter{Sort: "random"}, 1, 10)
+		assert.Equal(t, reviewerrors.ErrInvalidReviewSort, err)
+
+		_, err = deps.service.GetByProductSlug(ctx, slug, review.ReviewListFilter{Rating: "6"}, 1, 10)
+		assert.Equal(t, reviewerrors.ErrInvalidRating, err)
+	})
+
+	t.Run("negative - cursor hanya untuk sort terbaru", func(t *testing.T) {
+		_, _, err := deps.service.GetByProductSlugByCursor(ctx, slug, review.ReviewListFilter{Sort: "rating_low"}, "", 10)
+		assert.Equal(t, reviewerrors.ErrCursorSortUnsupported, err)
+	})
+}
+
+// ======================= MODERATION =======================
+
+func TestReviewService_AutoApproveDisabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db, sqlMock, _ := sqlmock.New()
+	defer db.Close()
+
+	repo := reviewMock.NewMockRepository(ctrl)
+	productRepo := productMock.NewMockRepository(ctrl)
+	svc := review.NewService(db, repo, productRepo, nil, nil, false)
+
+	ctx := context.Background()
+	reviewID := uuid.New()
+	userID := uuid.New()
+
+	expectTx(sqlMock, true)
+	repo.EXPECT().GetByID(ctx, reviewID).Return(dbgen.GetReviewByIDRow{ID: reviewID, UserID: userID, Status: constants.ReviewStatusApproved}, nil)
+	repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+	repo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, arg dbgen.UpdateReviewParams) (dbgen.Review, error) {
+		// Review APPROVED yang diedit kembali ke antrian moderasi
+		assert.Equal(t, constants.ReviewStatusPending, arg.Status)
+		assert.Equal(t, constants.ReviewStatusApproved, arg.FromStatus)
+		assert.True(t, arg.ResetModeration)
+		assert.False(t, arg.ModerationNote.Valid)
+		return dbgen.Review{}, nil
+	})
+	expectRatingRefresh(repo, gomock.Any())
+	repo.EXPECT().GetByID(ctx, reviewID).Return(dbgen.GetReviewByIDRow{ID: reviewID}, nil)
+
+	_, err := svc.Update(ctx, reviewID.String(), userID.String(), review.UpdateReviewRequest{Rating: 3, Comment: "Lumayan untuk harganya"})
+	assert.NoError(t, err)
+}
+
+func TestReviewService_Moderate(t *testing.T) {
+	deps := setupReviewTest(t)
+	defer deps.db.Close()
+
+	ctx := context.Background()
+	reviewID := uuid.New()
+	adminID := uuid.New()
+
+	t.Run("positive - approve dari antrian", func(t *testing.T) {
+		expectTx(deps.sqlMock, true)
+		productID := uuid.New()
+
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		deps.repo.EXPECT().SetStatus(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, arg dbgen.SetReviewStatusParams) (dbgen.Review, error) {
+			assert.Equal(t, constants.ReviewStatusApproved, arg.Status)
+			assert.ElementsMatch(t, []string{constants.ReviewStatusPending, constants.ReviewStatusRejected, constants.ReviewStatusHidden}, arg.FromStatuses)
+			assert.Equal(t, adminID, arg.ModeratedBy.UUID)
+			assert.Equal(t, "ok", arg.ModerationNote.String)
+			return dbgen.Review{ID: reviewID, ProductID: productID}, nil
+		})
+		// Laporan terbuka ditutup & review tayang -> agregat rating produk dihitung ulang
+		deps.repo.EXPECT().ResolveReports(ctx, reviewID).Return(nil)
+		expectRatingRefresh(deps.repo, productID)
+		deps.repo.EXPECT().GetByID(ctx, reviewID).Return(dbgen.GetReviewByIDRow{
+			ID:          reviewID,
+			Status:      constants.ReviewStatusApproved,
+			ModeratedBy: uuid.NullUUID{UUID: adminID, Valid: true},
+		}, nil)
+
+		res, err := deps.service.Approve(ctx, adminID.String(), reviewID.String(), review.ModerateReviewRequest{Note: " ok "})
+		assert.NoError(t, err)
+		assert.Equal(t, constants.ReviewStatusApproved, res.Status)
+		assert.Equal(t, adminID.String(), res.ModeratedBy)
+	})
+
+	t.Run("negative - hide review yang belum tayang", func(t *testing.T) {
+		expectTx(deps.sqlMock, false)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		deps.repo.EXPECT().SetStatus(ctx, gomock.Any()).Return(dbgen.Review{}, sql.ErrNoRows)
+		deps.repo.EXPECT().GetByID(ctx, reviewID).Return(dbgen.GetReviewByIDRow{ID: reviewID, Status: constants.ReviewStatusPending}, nil)
+
+		_, err := deps.service.Hide(ctx, adminID.String(), reviewID.String(), review.ModerateReviewRequest{})
+		assert.Equal(t, reviewerrors.ErrInvalidReviewStatus, err)
+	})
+
+	t.Run("negative - reject review yang tidak ada", func(t *testing.T) {
+		expectTx(deps.sqlMock, false)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		deps.repo.EXPECT().SetStatus(ctx, gomock.Any()).Return(dbgen.Review{}, sql.ErrNoRows)
+		deps.repo.EXPECT().GetByID(ctx, reviewID).Return(dbgen.GetReviewByIDRow{}, sql.ErrNoRows)
+
+		_, err := deps.service.Reject(ctx, adminID.String(), reviewID.String(), review.ModerateReviewRequest{})
+		assert.Equal(t, reviewerrors.ErrReviewNotFound, err)
+	})
+
+	t.Run("negative - invalid status filter", func(t *testing.T) {
+		_, _, err := deps.service.ListAdmin(ctx, review.AdminReviewFilter{Status: "DELETED"}, 1, 20)
+		assert.Equal(t, reviewerrors.ErrInvalidStatusFilter, err)
+	})
+
+	t.Run("positive - list dengan filter", func(t *testing.T) {
+		deps.repo.EXPECT().ListAdmin(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, arg dbgen.ListReviewsAdminParams) ([]dbgen.ListReviewsAdminRow, error) {
+			assert.Equal(t, constants.ReviewStatusPending, arg.Status.String)
+			assert.Equal(t, int32(2), arg.Rating.Int32)
+			assert.Equal(t, int32(20), arg.Offset)
+			assert.True(t, arg.Reported)
+			return []dbgen.ListReviewsAdminRow{{ID: reviewID, ProductName: "iPhone", ReportCount: 2}}, nil
+		})
+		deps.repo.EXPECT().CountAdmin(ctx, gomock.Any()).Return(int64(21), nil)
+
+		res, total, err := deps.service.ListAdmin(ctx, review.AdminReviewFilter{Status: "pending", Rating: "2", Reported: true}, 2, 20)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(21), total)
+		assert.Equal(t, "iPhone", res[0].ProductName)
+		assert.Equal(t, int32(2), res[0].ReportCount)
+	})
+
+	t.Run("positive - detail dengan laporan terbuka", func(t *testing.T) {
+		deps.repo.EXPECT().GetByID(ctx, reviewID).Return(dbgen.GetReviewByIDRow{ID: reviewID, ReportCount: 1}, nil)
+		deps.repo.EXPECT().ListReports(ctx, reviewID).Return([]dbgen.ListReviewReportsRow{
+			{ID: uuid.New(), Reason: "SPAM", UserEmail: "a@b.c"},
+		}, nil)
+
+		res, err := deps.service.GetAdmin(ctx, reviewID.String())
+		assert.NoError(t, err)
+		assert.Len(t, res.Reports, 1)
+		assert.Equal(t, "SPAM", res.Reports[0].Reason)
+	})
+}
+
+// ======================= VOTE & REPORT =======================
+
+func TestReviewService_Vote(t *testing.T) {
+	deps := setupReviewTest(t)
+	defer deps.db.Close()
+
+	ctx := context.Background()
+	reviewID := uuid.New()
+	userID := uuid.New()
+	helpful := true
+
+	t.Run("positive - vote membantu", func(t *testing.T) {
+		expectTx(deps.sqlMock, true)
+		deps.repo.EXPECT().GetByID(ctx, reviewID).Return(dbgen.GetReviewByIDRow{
+			ID: reviewID, UserID: uuid.New(), Status: constants.ReviewStatusApproved,
+		}, nil)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		deps.repo.EXPECT().UpsertVote(ctx, dbgen.UpsertReviewVoteParams{ReviewID: reviewID, UserID: userID, Helpful: true}).Return(nil)
+		deps.repo.EXPECT().RecomputeVotes(ctx, reviewID).Return(dbgen.RecomputeReviewVotesRow{HelpfulCount: 3, NotHelpfulCount: 1}, nil)
+
+		res, err := deps.service.Vote(ctx, userID.String(), reviewID.String(), review.VoteReviewRequest{Helpful: &helpful})
+		assert.NoError(t, err)
+		assert.True(t, *res.Helpful)
+		assert.Equal(t, int32(3), res.HelpfulCount)
+		assert.Equal(t, int32(1), res.NotHelpfulCount)
+	})
+
+	t.Run("negative - review sendiri", func(t *testing.T) {
+		deps.repo.EXPECT().GetByID(ctx, reviewID).Return(dbgen.GetReviewByIDRow{
+			ID: reviewID, UserID: userID, Status: constants.ReviewStatusApproved,
+		}, nil)
+
+		_, err := deps.service.Vote(ctx, userID.String(), reviewID.String(), review.VoteReviewRequest{Helpful: &helpful})
+		assert.Equal(t, reviewerrors.ErrCannotVoteOwnReview, err)
+	})
+
+	t.Run("negative - review belum tayang", func(t *testing.T) {
+		deps.repo.EXPECT().GetByID(ctx, reviewID).Return(dbgen.GetReviewByIDRow{
+			ID: reviewID, UserID: uuid.New(), Status: constants.ReviewStatusPending,
+		}, nil)
+
+		_, err := deps.service.Vote(ctx, userID.String(), reviewID.String(), review.VoteReviewRequest{Helpful: &helpful})
+		assert.Equal(t, reviewerrors.ErrReviewNotFound, err)
+	})
+
+	t.Run("negative - helpful wajib diisi", func(t *testing.T) {
+		_, err := deps.service.Vote(ctx, userID.String(), reviewID.String(), review.VoteReviewRequest{})
+		var appErr *apperror.AppError
+		assert.ErrorAs(t, err, &appErr)
+	})
+
+	t.Run("positive - hapus vote", func(t *testing.T) {
+		expectTx(deps.sqlMock, true)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		deps.repo.EXPECT().DeleteVote(ctx, reviewID, userID).Return(int64(1), nil)
+		deps.repo.EXPECT().RecomputeVotes(ctx, reviewID).Return(dbgen.RecomputeReviewVotesRow{HelpfulCount: 2, NotHelpfulCount: 1}, nil)
+
+		res, err := deps.service.DeleteVote(ctx, userID.String(), reviewID.String())
+		assert.NoError(t, err)
+		assert.Nil(t, res.Helpful)
+		assert.Equal(t, int32(2), res.HelpfulCount)
+	})
+
+	t.Run("negative - hapus vote yang tidak ada", func(t *testing.T) {
+		expectTx(deps.sqlMock, false)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		deps.repo.EXPECT().DeleteVote(ctx, reviewID, userID).Return(int64(0), nil)
+
+		_, err := deps.service.DeleteVote(ctx, userID.String(), reviewID.String())
+		assert.Equal(t, reviewerrors.ErrVoteNotFound, err)
+	})
+
+	assert.NoError(t, deps.sqlMock.ExpectationsWereMet())
+}
+
+func TestReviewService_Report(t *testing.T) {
+	deps := setupReviewTest(t)
+	defer deps.db.Close()
+
+	ctx := context.Background()
+	reviewID := uuid.New()
+	productID := uuid.New()
+	userID := uuid.New()
+	approved := dbgen.GetReviewByIDRow{ID: reviewID, UserID: uuid.New(), ProductID: productID, Status: constants.ReviewStatusApproved}
+
+	t.Run("positive - laporan di bawah ambang batas", func(t *testing.T) {
+		expectTx(deps.sqlMock, true)
+		deps.repo.EXPECT().GetByID(ctx, reviewID).Return(approved, nil)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		deps.repo.EXPECT().CreateReport(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, arg dbgen.CreateReviewReportParams) (dbgen.ReviewReport, error) {
+			assert.Equal(t, "SPAM", arg.Reason)
+			assert.Equal(t, "link promosi", arg.Note.String)
+			return dbgen.ReviewReport{}, nil
+		})
+		deps.repo.EXPECT().IncrementReportCount(ctx, reviewID).Return(dbgen.Review{
+			ID: reviewID, ProductID: productID, Status: constants.ReviewStatusApproved, ReportCount: 1,
+		}, nil)
+
+		err := deps.service.Report(ctx, userID.String(), reviewID.String(), review.ReportReviewRequest{Reason: "SPAM", Note: " link promosi "})
+		assert.NoError(t, err)
+	})
+
+	t.Run("positive - ambang batas tercapai, review masuk antrian moderasi", func(t *testing.T) {
+		expectTx(deps.sqlMock, true)
+		deps.repo.EXPECT().GetByID(ctx, reviewID).Return(approved, nil)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		deps.repo.EXPECT().CreateReport(ctx, gomock.Any()).Return(dbgen.ReviewReport{}, nil)
+		deps.repo.EXPECT().IncrementReportCount(ctx, reviewID).Return(dbgen.Review{
+			ID: reviewID, ProductID: productID, Status: constants.ReviewStatusApproved, ReportCount: constants.ReviewReportThreshold,
+		}, nil)
+		deps.repo.EXPECT().SetStatus(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, arg dbgen.SetReviewStatusParams) (dbgen.Review, error) {
+			assert.Equal(t, constants.ReviewStatusPending, arg.Status)
+			assert.Equal(t, []string{constants.ReviewStatusApproved}, arg.FromStatuses)
+			assert.False(t, arg.ModeratedBy.Valid)
+			return dbgen.Review{ID: reviewID, ProductID: productID}, nil
+		})
+		expectRatingRefresh(deps.repo, productID)
+
+		err := deps.service.Report(ctx, userID.String(), reviewID.String(), review.ReportReviewRequest{Reason: "ABUSIVE"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("negative - sudah pernah melapor", func(t *testing.T) {
+		expectTx(deps.sqlMock, false)
+		deps.repo.EXPECT().GetByID(ctx, reviewID).Return(approved, nil)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		deps.repo.EXPECT().CreateReport(ctx, gomock.Any()).Return(dbgen.ReviewReport{}, &pq.Error{Code: "23505"})
+
+		err := deps.service.Report(ctx, userID.String(), reviewID.String(), review.ReportReviewRequest{Reason: "SPAM"})
+		assert.Equal(t, reviewerrors.ErrAlreadyReported, err)
+	})
+
+	t.Run("negative - review sendiri", func(t *testing.T) {
+		own := approved
+		own.UserID = userID
+		deps.repo.EXPECT().GetByID(ctx, reviewID).Return(own, nil)
+
+		err := deps.service.Report(ctx, userID.String(), reviewID.String(), review.ReportReviewRequest{Reason: "SPAM"})
+		assert.Equal(t, reviewerrors.ErrCannotReportOwnReview, err)
+	})
+
+	t.Run("negative - alasan tidak valid", func(t *testing.T) {
+		err := deps.service.Report(ctx, userID.String(), reviewID.String(), review.ReportReviewRequest{Reason: "BORING"})
+		var appErr *apperror.AppError
+		assert.ErrorAs(t, err, &appErr)
+	})
+
+	assert.NoError(t, deps.sqlMock.ExpectationsWereMet())
+}
+
+// ======================= MERCHANT REPLY =======================
+
+func TestReviewService_Reply(t *testing.T) {
+	deps := setupReviewTest(t)
+	defer deps.db.Close()
+
+	ctx := context.Background()
+	reviewID := uuid.New()
+	adminID := uuid.New()
+	req := review.ReviewReplyRequest{Body: " Terima kasih sudah belanja! "}
+
+	t.Run("positive - create reply", func(t *testing.T) {
+		deps.repo.EXPECT().GetByID(ctx, reviewID).Return(dbgen.GetReviewByIDRow{ID: reviewID}, nil)
+		deps.repo.EXPECT().CreateReply(ctx, dbgen.CreateReviewReplyParams{
+			ReviewID: reviewID,
+			AdminID:  uuid.NullUUID{UUID: adminID, Valid: true},
+			Body:     "Terima kasih sudah belanja!",
+		}).Return(dbgen.ReviewReply{ReviewID: reviewID, Body: "Terima kasih sudah belanja!"}, nil)
+
+		res, err := deps.service.CreateReply(ctx, adminID.String(), reviewID.String(), req)
+		assert.NoError(t, err)
+		assert.Equal(t, "Terima kasih sudah belanja!", res.Body)
+	})
+
+	t.Run("negative - reply sudah ada", func(t *testing.T) {
+		deps.repo.EXPECT().GetByID(ctx, reviewID).Return(dbgen.GetReviewByIDRow{ID: reviewID}, nil)
+		deps.repo.EXPECT().CreateReply(ctx, gomock.Any()).Return(dbgen.ReviewReply{}, &pq.Error{Code: "23505"})
+
+		_, err := deps.service.CreateReply(ctx, adminID.String(), reviewID.String(), req)
+		assert.Equal(t, reviewerrors.ErrReplyAlreadyExists, err)
+	})
+
+	t.Run("negative - update reply yang belum ada", func(t *testing.T) {
+		deps.repo.EXPECT().UpdateReply(ctx, gomock.Any()).Return(dbgen.ReviewReply{}, sql.ErrNoRows)
+
+		_, err := deps.service.UpdateReply(ctx, adminID.String(), reviewID.String(), req)
+		assert.Equal(t, reviewerrors.ErrReplyNotFound, err)
+	})
+
+	t.Run("negative - delete reply yang belum ada", func(t *testing.T) {
+		deps.repo.EXPECT().DeleteReply(ctx, reviewID).Return(int64(0), nil)
+
+		err := deps.service.DeleteReply(ctx, reviewID.String())
+		assert.Equal(t, reviewerrors.ErrReplyNotFound, err)
+	})
+
+	t.Run("negative - body kosong", func(t *testing.T) {
+		_, err := deps.service.CreateReply(ctx, adminID.String(), reviewID.String(), review.ReviewReplyRequest{Body: "   "})
+		assert.Equal(t, reviewerrors.ErrInvalidReviewInput, err)
+	})
+}
+
+// ======================= RATING AGGREGATES =======================
+
+func TestReviewService_RecomputeAllRatings(t *testing.T) {
+	deps := setupReviewTest(t)
+	defer deps.db.Close()
+
+	ctx := context.Background()
+
+	t.Run("positive - backfill semua produk", func(t *testing.T) {
+		deps.repo.EXPECT().RecomputeAllProductRatings(ctx).Return(int64(42), nil)
+
+		n, err := deps.service.RecomputeAllRatings(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(42), n)
+	})
+
+	t.Run("negative - query gagal", func(t *testing.T) {
+		deps.repo.EXPECT().RecomputeAllProductRatings(ctx).Return(int64(0), errors.New("db down"))
+
+		_, err := deps.service.RecomputeAllRatings(ctx)
+		assert.ErrorContains(t, err, "db down")
+	})
+}
+
+func TestReviewService_Create_RatingRefreshFails(t *testing.T) {
+	deps := setupReviewTest(t)
+	defer deps.db.Close()
+
+	ctx := context.Background()
+	userID := uuid.New()
+	productID := uuid.New()
+
+	// Gagal update agregat -> seluruh transaksi review di-rollback
+	expectTx(deps.sqlMock, false)
+	deps.productRepo.EXPECT().GetBySlug(ctx, "gadget-xyz").Return(dbgen.GetProductBySlugRow{ID: productID}, nil)
+	deps.repo.EXPECT().CheckExists(ctx, userID, productID).Return(false, nil)
+	deps.repo.EXPECT().CheckUserPurchased(ctx, userID, productID).Return(true, nil)
+	deps.repo.EXPECT().GetCompletedOrder(ctx, userID, productID).Return(uuid.New(), nil)
+	deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+	deps.repo.EXPECT().Create(ctx, gomock.Any()).Return(dbgen.Review{ID: uuid.New()}, nil)
+	deps.repo.EXPECT().LockProductRating(ctx, productID).Return(nil)
+	deps.repo.EXPECT().RecomputeProductRating(ctx, productID).Return(errors.New("deadlock"))
+
+	_, err := deps.service.Create(ctx, userID.String(), "gadget-xyz", review.CreateReviewRequest{Rating: 5, Comment: "Mantap sekali barangnya"}, nil)
+	assert.Equal(t, reviewerrors.ErrReviewFailed, err)
+	assert.NoError(t, deps.sqlMock.ExpectationsWereMet())
 }
@@ -0,0 +1,86 @@
+package rmaerrors
+
+import (
+	"go-sqlc-starter/internal/pkg/apperror"
+	"net/http"
+)
+
+var (
+	ErrInvalidID = apperror.New(
+		apperror.CodeInvalidInput,
+		"Invalid ID",
+		http.StatusBadRequest,
+	)
+
+	ErrReturnNotFound = apperror.New(
+		apperror.CodeNotFound,
+		"Return request not found",
+		http.StatusNotFound,
+	)
+
+	ErrOrderItemNotFound = apperror.New(
+		apperror.CodeNotFound,
+		"Order item not found",
+		http.StatusNotFound,
+	)
+
+	ErrOrderNotReturnable = apperror.New(
+		apperror.CodeInvalidState,
+		"Only delivered or completed orders can be returned",
+		http.StatusBadRequest,
+	)
+
+	ErrOrderNotPaid = apperror.New(
+		apperror.CodeInvalidState,
+		"Only paid orders can be returned",
+		http.StatusBadRequest,
+	)
+
+	ErrQuantityExceeded = apperror.New(
+		apperror.CodeInvalidState,
+		"Return quantity exceeds the remaining returnable quantity",
+		http.StatusBadRequest,
+	)
+
+	ErrPhotoRequired = apperror.New(
+		apperror.CodeInvalidInput,
+		"At least one photo is required as evidence",
+		http.StatusBadRequest,
+	)
+
+	ErrTooManyPhotos = apperror.New(
+		apperror.CodeInvalidInput,
+		"Too many photos",
+		http.StatusBadRequest,
+	)
+
+	ErrNoteRequired = apperror.New(
+		apperror.CodeInvalidInput,
+		"A note is required when rejecting a return",
+		http.StatusBadRequest,
+	)
+
+	ErrInvalidStatusTransition = apperror.New(
+		apperror.CodeInvalidState,
+		"Return request cannot be processed in its current status",
+		http.StatusBadRequest,
+	)
+
+	ErrRefundExceedsAmount = apperror.New(
+		apperror.CodeInvalidState,
+		"Refund amount exceeds the refundable amount",
+		http.StatusBadRequest,
+	)
+
+	ErrImageUploadFailed = apperror.New(
+		apperror.CodeInternalError,
+		"Failed to upload return photo",
+		http.StatusInternalServerError,
+	)
+
+	ErrReturnFailed = apperror.New(
+		apperror.CodeInternalError,
+		"Failed to process return request",
+		http.StatusInternalServerError,
+	)
+)
@@ -0,0 +1,262 @@
+package rma
+
+import (
+	"go-sqlc-starter/internal/pkg/apperror"
+	"go-sqlc-starter/internal/pkg/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Controller struct {
+	service Service
+}
+
+func NewController(svc Service) *Controller {
+	return &Controller{service: svc}
+}
+
+// ==================== CUSTOMER ENDPOINTS ====================
+
+// Create ajukan retur untuk satu item order (multipart: field + photos[])
+// POST /orders/:id/returns
+func (ctrl *Controller) Create(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		response.Error(c, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated", nil)
+		return
+	}
+
+	// 1. Parse multipart form (max 10 MB)
+	if err := c.Request.ParseMultipartForm(10 << 20); err != nil {
+		response.Error(c, http.StatusBadRequest, "INVALID_FORM", "Invalid multipart form", err.Error())
+		return
+	}
+
+	var req CreateReturnRequest
+	if err := c.ShouldBind(&req); err != nil {
+		appErr := apperror.Wrap(err, apperror.CodeInvalidInput, "Invalid request body", http.StatusBadRequest)
+		httpErr := apperror.ToHTTP(appErr)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, err.Error())
+		return
+	}
+
+	// 2. Buka semua foto bukti (form-data key: photos)
+	var photos []Photo
+	for _, fh := range c.Request.MultipartForm.File["photos"] {
+		f, err := fh.Open()
+		if err != nil {
+			response.Error(c, http.StatusBadRequest, "FILE_ERROR", "Failed to open uploaded file", err.Error())
+			return
+		}
+		defer f.Close()
+
+		photos = append(photos, Photo{File: f, Filename: fh.Filename})
+	}
+
+	res, err := ctrl.service.Create(c.Request.Context(), userID.(string), c.Param("id"), req, photos)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusCreated, res, nil)
+}
+
+// ListMine GET /returns?page=1&limit=20
+func (ctrl *Controller) ListMine(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		response.Error(c, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated", nil)
+		return
+	}
+
+	page, limit := parsePage(c)
+	data, total, err := ctrl.service.ListMine(c.Request.Context(), userID.(string), page, limit)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, data, paginationMeta(total, page, limit))
+}
+
+// GetMine GET /returns/:id
+func (ctrl *Controller) GetMine(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		response.Error(c, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated", nil)
+		return
+	}
+
+	res, err := ctrl.service.GetMine(c.Request.Context(), userID.(string), c.Param("id"))
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// Ship input resi pengiriman balik setelah retur disetujui
+// PATCH /returns/:id/ship
+func (ctrl *Controller) Ship(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		response.Error(c, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated", nil)
+		return
+	}
+
+	var req ShipReturnRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		appErr := apperror.Wrap(err, apperror.CodeInvalidInput, "Invalid request body", http.StatusBadRequest)
+		httpErr := apperror.ToHTTP(appErr)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, err.Error())
+		return
+	}
+
+	res, err := ctrl.service.Ship(c.Request.Context(), userID.(string), c.Param("id"), req)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// ==================== ADMIN ENDPOINTS ====================
+
+// ListAdmin GET /admin/returns?status=REQUESTED&page=1&limit=20
+func (ctrl *Controller) ListAdmin(c *gin.Context) {
+	page, limit := parsePage(c)
+
+	data, total, err := ctrl.service.ListAdmin(c.Request.Context(), c.Query("status"), page, limit)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, data, paginationMeta(total, page, limit))
+}
+
+// GetByID GET /admin/returns/:id
+func (ctrl *Controller) GetByID(c *gin.Context) {
+	res, err := ctrl.service.GetByID(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// Approve PATCH /admin/returns/:id/approve
+func (ctrl *Controller) Approve(c *gin.Context) {
+	var req ReviewReturnRequest
+	// Body opsional untuk approve
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			appErr := apperror.Wrap(err, apperror.CodeInvalidInput, "Invalid request body", http.StatusBadRequest)
+			httpErr := apperror.ToHTTP(appErr)
+			response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, err.Error())
+			return
+		}
+	}
+
+	res, err := ctrl.service.Approve(c.Request.Context(), c.Param("id"), req)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// Reject PATCH /admin/returns/:id/reject
+func (ctrl *Controller) Reject(c *gin.Context) {
+	var req ReviewReturnRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		appErr := apperror.Wrap(err, apperror.CodeInvalidInput, "Invalid request body", http.StatusBadRequest)
+		httpErr := apperror.ToHTTP(appErr)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, err.Error())
+		return
+	}
+
+	res, err := ctrl.service.Reject(c.Request.Context(), c.Param("id"), req)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// Receive tandai barang retur sudah diterima gudang (stok dikembalikan)
+// PATCH /admin/returns/:id/receive
+func (ctrl *Controller) Receive(c *gin.Context) {
+	adminID, _ := c.Get("user_id")
+	actor, _ := adminID.(string)
+
+	res, err := ctrl.service.Receive(c.Request.Context(), actor, c.Param("id"))
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// Refund catat refund (penuh / sebagian) untuk retur yang sudah diterima
+// POST /admin/returns/:id/refund
+func (ctrl *Controller) Refund(c *gin.Context) {
+	adminID, _ := c.Get("user_id")
+	actor, _ := adminID.(string)
+
+	var req RefundRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		appErr := apperror.Wrap(err, apperror.CodeInvalidInput, "Invalid request body", http.StatusBadRequest)
+		httpErr := apperror.ToHTTP(appErr)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, err.Error())
+		return
+	}
+
+	res, err := ctrl.service.Refund(c.Request.Context(), actor, c.Param("id"), req)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+func parsePage(c *gin.Context) (int, int) {
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if err != nil || limit < 1 || limit > 100 {
+		limit = 20
+	}
+	return page, limit
+}
+
+func paginationMeta(total int64, page, limit int) *response.PaginationMeta {
+	return &response.PaginationMeta{
+		Total:      total,
+		TotalPages: int((total + int64(limit) - 1) / int64(limit)),
+		Page:       page,
+		PageSize:   limit,
+	}
+}
@@ -0,0 +1,165 @@
+package rma_test
+
+import (
+	"bytes"
+	"context"
+	"go-sqlc-starter/internal/api/v1/rma"
+	rmaerrors "go-sqlc-starter/internal/api/v1/rma/errors"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== FAKE SERVICE (Mock Manual) ====================
+
+type fakeRMAService struct {
+	createFunc func(ctx context.Context, userID, orderID string, req rma.CreateReturnRequest, photos []rma.Photo) (rma.ReturnResponse, error)
+	rejectFunc func(ctx context.Context, id string, req rma.ReviewReturnRequest) (rma.ReturnResponse, error)
+	refundFunc func(ctx context.Context, adminID, id string, req rma.RefundRequest) (rma.ReturnResponse, error)
+}
+
+func (f *fakeRMAService) Create(ctx context.Context, userID, orderID string, req rma.CreateReturnRequest, photos []rma.Photo) (rma.ReturnResponse, error) {
+	return f.createFunc(ctx, userID, orderID, req, photos)
+}
+func (f *fakeRMAService) ListMine(ctx context.Context, userID string, page, limit int) ([]rma.ReturnResponse, int64, error) {
+	return nil, 0, nil
+}
+func (f *fakeRMAService) GetMine(ctx context.Context, userID, id string) (rma.ReturnResponse, error) {
+	return rma.ReturnResponse{}, nil
+}
+func (f *fakeRMAService) Ship(ctx context.Context, userID, id string, req rma.ShipReturnRequest) (rma.ReturnResponse, error) {
+	return rma.ReturnResponse{}, nil
+}
+func (f *fakeRMAService) ListAdmin(ctx context.Context, status string, page, limit int) ([]rma.ReturnResponse, int64, error) {
+	return nil, 0, nil
+}
+func (f *fakeRMAService) GetByID(ctx context.Context, id string) (rma.ReturnResponse, error) {
+	return rma.ReturnResponse{}, nil
+}
+func (f *fakeRMAService) Approve(ctx context.Context, id string, req rma.ReviewReturnRequest) (rma.ReturnResponse, error) {
+	return rma.ReturnResponse{}, nil
+}
+func (f *fakeRMAService) Reject(ctx context.Context, id string, req rma.ReviewReturnRequest) (rma.ReturnResponse, error) {
+	return f.rejectFunc(ctx, id, req)
+}
+func (f *fakeRMAService) Receive(ctx context.Context, adminID, id string) (rma.ReturnResponse, error) {
+	return rma.ReturnResponse{}, nil
+}
+func (f *fakeRMAService) Refund(ctx context.Context, adminID, id string, req rma.RefundRequest) (rma.ReturnResponse, error) {
+	return f.refundFunc(ctx, adminID, id, req)
+}
+
+func TestRMAController_Create(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	userID, orderID, itemID := uuid.New().String(), uuid.New().String(), uuid.New().String()
+
+	perform := func(svc *fakeRMAService, photos int) *httptest.ResponseRecorder {
+		body := &bytes.Buffer{}
+		mw := multipart.NewWriter(body)
+		_ = mw.WriteField("orderItemId", itemID)
+		_ = mw.WriteField("quantity", "1")
+		_ = mw.WriteField("reason", "DAMAGED")
+		for i := 0; i < photos; i++ {
+			part, _ := mw.CreateFormFile("photos", "photo.jpg")
+			_, _ = part.Write([]byte("img"))
+		}
+		_ = mw.Close()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Set("user_id", userID)
+		c.Params = gin.Params{{Key: "id", Value: orderID}}
+		c.Request = httptest.NewRequest(http.MethodPost, "/orders/"+orderID+"/returns", body)
+		c.Request.Header.Set("Content-Type", mw.FormDataContentType())
+
+		rma.NewController(svc).Create(c)
+		return w
+	}
+
+	t.Run("positive - form and photos forwarded", func(t *testing.T) {
+		svc := &fakeRMAService{
+			createFunc: func(ctx context.Context, u, o string, req rma.CreateReturnRequest, photos []rma.Photo) (rma.ReturnResponse, error) {
+				assert.Equal(t, userID, u)
+				assert.Equal(t, orderID, o)
+				assert.Equal(t, itemID, req.OrderItemID)
+				assert.Len(t, photos, 2)
+				return rma.ReturnResponse{RMANumber: "RMA-1", Status: "REQUESTED"}, nil
+			},
+		}
+
+		w := perform(svc, 2)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Contains(t, w.Body.String(), `"status":"REQUESTED"`)
+	})
+
+	t.Run("negative - order not returnable", func(t *testing.T) {
+		svc := &fakeRMAService{
+			createFunc: func(ctx context.Context, u, o string, req rma.CreateReturnRequest, photos []rma.Photo) (rma.ReturnResponse, error) {
+				return rma.ReturnResponse{}, rmaerrors.ErrOrderNotReturnable
+			},
+		}
+
+		w := perform(svc, 1)
+
+		assert.Equal(t, rmaerrors.ErrOrderNotReturnable.HTTPStatus, w.Code)
+	})
+}
+
+func TestRMAController_AdminActions(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	adminID, returnID := uuid.New().String(), uuid.New().String()
+
+	newContext := func(method, body string) (*gin.Context, *httptest.ResponseRecorder) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Set("user_id", adminID)
+		c.Params = gin.Params{{Key: "id", Value: returnID}}
+		c.Request = httptest.NewRequest(method, "/admin/returns/"+returnID, bytes.NewBufferString(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+		return c, w
+	}
+
+	t.Run("positive - refund forwards admin id", func(t *testing.T) {
+		svc := &fakeRMAService{
+			refundFunc: func(ctx context.Context, a, id string, req rma.RefundRequest) (rma.ReturnResponse, error) {
+				assert.Equal(t, adminID, a)
+				assert.Equal(t, returnID, id)
+				assert.Equal(t, 50000.0, req.Amount)
+				return rma.ReturnResponse{Status: "REFUNDED"}, nil
+			},
+		}
+		c, w := newContext(http.MethodPost, `{"amount":50000,"method":"BANK_TRANSFER"}`)
+
+		rma.NewController(svc).Refund(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"status":"REFUNDED"`)
+	})
+
+	t.Run("negative - refund invalid body", func(t *testing.T) {
+		c, w := newContext(http.MethodPost, `{"amount":"abc"}`)
+
+		rma.NewController(&fakeRMAService{}).Refund(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("negative - reject invalid transition", func(t *testing.T) {
+		svc := &fakeRMAService{
+			rejectFunc: func(ctx context.Context, id string, req rma.ReviewReturnRequest) (rma.ReturnResponse, error) {
+				return rma.ReturnResponse{}, rmaerrors.ErrInvalidStatusTransition
+			},
+		}
+		c, w := newContext(http.MethodPatch, `{"note":"foto tidak jelas"}`)
+
+		rma.NewController(svc).Reject(c)
+
+		assert.Equal(t, rmaerrors.ErrInvalidStatusTransition.HTTPStatus, w.Code)
+	})
+}
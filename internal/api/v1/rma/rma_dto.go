@@ -0,0 +1,75 @@
+package rma
+
+import (
+	"mime/multipart"
+	"time"
+)
+
+// ==================== REQUEST STRUCTS ====================
+
+// CreateReturnRequest dikirim sebagai multipart/form-data bersama foto bukti
+type CreateReturnRequest struct {
+	OrderItemID string `form:"orderItemId" validate:"required,uuid"`
+	Quantity    int32  `form:"quantity" validate:"required,min=1"`
+	Reason      string `form:"reason" validate:"required,oneof=DAMAGED WRONG_ITEM NOT_AS_DESCRIBED MISSING_PARTS OTHER"`
+	Description string `form:"description" validate:"max=1000"`
+}
+
+// Photo file foto bukti yang sudah dibuka dari form
+type Photo struct {
+	File     multipart.File
+	Filename string
+}
+
+type ShipReturnRequest struct {
+	Courier   string `json:"courier" validate:"required,max=50"`
+	ReceiptNo string `json:"receiptNo" validate:"required,max=100"`
+}
+
+// ReviewReturnRequest catatan admin saat approve (opsional) / reject (wajib)
+type ReviewReturnRequest struct {
+	Note string `json:"note" validate:"max=500"`
+}
+
+type RefundRequest struct {
+	Amount    float64 `json:"amount" validate:"required,gt=0"`
+	Method    string  `json:"method" validate:"required,oneof=ORIGINAL_PAYMENT BANK_TRANSFER STORE_CREDIT"`
+	Reference string  `json:"reference" validate:"max=100"`
+	Note      string  `json:"note" validate:"max=500"`
+}
+
+// ==================== RESPONSE STRUCTS ====================
+
+type RefundResponse struct {
+	ID        string    `json:"id"`
+	Amount    float64   `json:"amount"`
+	Method    string    `json:"method"`
+	Reference string    `json:"reference,omitempty"`
+	Note      string    `json:"note,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type ReturnResponse struct {
+	ID              string          `json:"id"`
+	RMANumber       string          `json:"rmaNumber"`
+	OrderID         string          `json:"orderId"`
+	OrderItemID     string          `json:"orderItemId"`
+	ProductID       string          `json:"productId"`
+	ProductName     string          `json:"productName"`
+	UnitPrice       float64         `json:"unitPrice"`
+	Quantity        int32           `json:"quantity"`
+	Reason          string          `json:"reason"`
+	Description     string          `json:"description,omitempty"`
+	Status          string          `json:"status"`
+	AdminNote       string          `json:"adminNote,omitempty"`
+	ReturnCourier   string          `json:"returnCourier,omitempty"`
+	ReturnReceiptNo string          `json:"returnReceiptNo,omitempty"`
+	Photos          []string        `json:"photos,omitempty"`
+	Refund          *RefundResponse `json:"refund,omitempty"`
+	ApprovedAt      *time.Time      `json:"approvedAt,omitempty"`
+	RejectedAt      *time.Time      `json:"rejectedAt,omitempty"`
+	ShippedBackAt   *time.Time      `json:"shippedBackAt,omitempty"`
+	ReceivedAt      *time.Time      `json:"receivedAt,omitempty"`
+	RefundedAt      *time.Time      `json:"refundedAt,omitempty"`
+	CreatedAt       time.Time       `json:"createdAt"`
+}
@@ -0,0 +1,143 @@
+package rma
+
+import (
+	"context"
+	"database/sql"
+	"go-sqlc-starter/internal/dbgen"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=rma_repo.go -destination=../mock/rma/rma_repo_mock.go -package=mock
+type Repository interface {
+	WithTx(tx dbgen.DBTX) Repository
+
+	// Pengajuan
+	GetOrderItemForReturn(ctx context.Context, orderID, orderItemID uuid.UUID) (dbgen.GetOrderItemForReturnRow, error)
+	SumActiveQuantity(ctx context.Context, orderItemID uuid.UUID) (int32, error)
+	Create(ctx context.Context, arg dbgen.CreateReturnRequestParams) (dbgen.ReturnRequest, error)
+	CreatePhoto(ctx context.Context, returnID uuid.UUID, imageURL string) error
+	ListPhotos(ctx context.Context, returnID uuid.UUID) ([]dbgen.ReturnPhoto, error)
+
+	// Query
+	GetByID(ctx context.Context, id uuid.UUID) (dbgen.ReturnRequest, error)
+	GetByIDForUpdate(ctx context.Context, id uuid.UUID) (dbgen.ReturnRequest, error)
+	ListByUser(ctx context.Context, arg dbgen.ListReturnsByUserParams) ([]dbgen.ReturnRequest, error)
+	CountByUser(ctx context.Context, userID uuid.UUID) (int64, error)
+	ListAdmin(ctx context.Context, arg dbgen.ListReturnsAdminParams) ([]dbgen.ReturnRequest, error)
+	CountAdmin(ctx context.Context, status sql.NullString) (int64, error)
+
+	// Transisi status
+	Approve(ctx context.Context, id uuid.UUID, note sql.NullString) (dbgen.ReturnRequest, error)
+	Reject(ctx context.Context, id uuid.UUID, note sql.NullString) (dbgen.ReturnRequest, error)
+	Ship(ctx context.Context, arg dbgen.ShipReturnParams) (dbgen.ReturnRequest, error)
+	Receive(ctx context.Context, id uuid.UUID) (dbgen.ReturnRequest, error)
+	MarkRefunded(ctx context.Context, id uuid.UUID) (dbgen.ReturnRequest, error)
+
+	// Refund
+	CreateRefund(ctx context.Context, arg dbgen.CreateRefundParams) (dbgen.Refund, error)
+	GetRefund(ctx context.Context, returnID uuid.UUID) (dbgen.Refund, error)
+	ApplyOrderRefund(ctx context.Context, orderID uuid.UUID, amount string) (dbgen.Order, error)
+}
+
+type repository struct {
+	queries *dbgen.Queries
+}
+
+func NewRepository(q *dbgen.Queries) Repository {
+	return &repository{queries: q}
+}
+
+func (r *repository) WithTx(tx dbgen.DBTX) Repository {
+	if sqlTx, ok := tx.(*sql.Tx); ok {
+		return &repository{
+			queries: r.queries.WithTx(sqlTx),
+		}
+	}
+	return r
+}
+
+func (r *repository) GetOrderItemForReturn(ctx context.Context, orderID, orderItemID uuid.UUID) (dbgen.GetOrderItemForReturnRow, error) {
+	return r.queries.GetOrderItemForReturn(ctx, dbgen.GetOrderItemForReturnParams{
+		OrderID:     orderID,
+		OrderItemID: orderItemID,
+	})
+}
+
+func (r *repository) SumActiveQuantity(ctx context.Context, orderItemID uuid.UUID) (int32, error) {
+	return r.queries.SumActiveReturnQuantity(ctx, orderItemID)
+}
+
+func (r *repository) Create(ctx context.Context, arg dbgen.CreateReturnRequestParams) (dbgen.ReturnRequest, error) {
+	return r.queries.CreateReturnRequest(ctx, arg)
+}
+
+func (r *repository) CreatePhoto(ctx context.Context, returnID uuid.UUID, imageURL string) error {
+	return r.queries.CreateReturnPhoto(ctx, dbgen.CreateReturnPhotoParams{
+		ReturnID: returnID,
+		ImageUrl: imageURL,
+	})
+}
+
+func (r *repository) ListPhotos(ctx context.Context, returnID uuid.UUID) ([]dbgen.ReturnPhoto, error) {
+	return r.queries.ListReturnPhotos(ctx, returnID)
+}
+
+func (r *repository) GetByID(ctx context.Context, id uuid.UUID) (dbgen.ReturnRequest, error) {
+	return r.queries.GetReturnByID(ctx, id)
+}
+
+func (r *repository) GetByIDForUpdate(ctx context.Context, id uuid.UUID) (dbgen.ReturnRequest, error) {
+	return r.queries.GetReturnByIDForUpdate(ctx, id)
+}
+
+func (r *repository) ListByUser(ctx context.Context, arg dbgen.ListReturnsByUserParams) ([]dbgen.ReturnRequest, error) {
+	return r.queries.ListReturnsByUser(ctx, arg)
+}
+
+func (r *repository) CountByUser(ctx context.Context, userID uuid.UUID) (int64, error) {
+	return r.queries.CountReturnsByUser(ctx, userID)
+}
+
+func (r *repository) ListAdmin(ctx context.Context, arg dbgen.ListReturnsAdminParams) ([]dbgen.ReturnRequest, error) {
+	return r.queries.ListReturnsAdmin(ctx, arg)
+}
+
+func (r *repository) CountAdmin(ctx context.Context, status sql.NullString) (int64, error) {
+	return r.queries.CountReturnsAdmin(ctx, status)
+}
+
+func (r *repository) Approve(ctx context.Context, id uuid.UUID, note sql.NullString) (dbgen.ReturnRequest, error) {
+	return r.queries.ApproveReturn(ctx, dbgen.ApproveReturnParams{ID: id, AdminNote: note})
+}
+
+func (r *repository) Reject(ctx context.Context, id uuid.UUID, note sql.NullString) (dbgen.ReturnRequest, error) {
+	return r.queries.RejectReturn(ctx, dbgen.RejectReturnParams{ID: id, AdminNote: note})
+}
+
+func (r *repository) Ship(ctx context.Context, arg dbgen.ShipReturnParams) (dbgen.ReturnRequest, error) {
+	return r.queries.ShipReturn(ctx, arg)
+}
+
+func (r *repository) Receive(ctx context.Context, id uuid.UUID) (dbgen.ReturnRequest, error) {
+	return r.queries.ReceiveReturn(ctx, id)
+}
+
+func (r *repository) MarkRefunded(ctx context.Context, id uuid.UUID) (dbgen.ReturnRequest, error) {
+	return r.queries.MarkReturnRefunded(ctx, id)
+}
+
+func (r *repository) CreateRefund(ctx context.Context, arg dbgen.CreateRefundParams) (dbgen.Refund, error) {
+	return r.queries.CreateRefund(ctx, arg)
+}
+
+func (r *repository) GetRefund(ctx context.Context, returnID uuid.UUID) (dbgen.Refund, error) {
+	return r.queries.GetRefundByReturnID(ctx, returnID)
+}
+
+func (r *repository) ApplyOrderRefund(ctx context.Context, orderID uuid.UUID, amount string) (dbgen.Order, error) {
+	return r.queries.ApplyOrderRefund(ctx, dbgen.ApplyOrderRefundParams{
+		ID:     orderID,
+		Amount: amount,
+	})
+}
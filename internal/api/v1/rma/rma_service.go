@@ -0,0 +1,546 @@
+package rma
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"go-sqlc-starter/internal/api/v1/auth"
+	"go-sqlc-starter/internal/api/v1/inventory"
+	rmaerrors "go-sqlc-starter/internal/api/v1/rma/errors"
+	"go-sqlc-starter/internal/dbgen"
+	"go-sqlc-starter/internal/pkg/apperror"
+	"go-sqlc-starter/internal/pkg/constants"
+	"mime/multipart"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+// Status retur
+const (
+	StatusRequested   = "REQUESTED"
+	StatusApproved    = "APPROVED"
+	StatusRejected    = "REJECTED"
+	StatusShippedBack = "SHIPPED_BACK"
+	StatusReceived    = "RECEIVED"
+	StatusRefunded    = "REFUNDED"
+)
+
+type CloudinaryService interface {
+	UploadImage(ctx context.Context, file multipart.File, filename string, folderName string) (string, error)
+	DeleteImage(ctx context.Context, publicID string) error
+}
+
+//go:generate mockgen -source=rma_service.go -destination=../mock/rma/rma_service_mock.go -package=mock
+type Service interface {
+	// Customer Actions
+	Create(ctx context.Context, userID, orderID string, req CreateReturnRequest, photos []Photo) (ReturnResponse, error)
+	ListMine(ctx context.Context, userID string, page, limit int) ([]ReturnResponse, int64, error)
+	GetMine(ctx context.Context, userID, id string) (ReturnResponse, error)
+	Ship(ctx context.Context, userID, id string, req ShipReturnRequest) (ReturnResponse, error)
+
+	// Admin Actions
+	ListAdmin(ctx context.Context, status string, page, limit int) ([]ReturnResponse, int64, error)
+	GetByID(ctx context.Context, id string) (ReturnResponse, error)
+	Approve(ctx context.Context, id string, req ReviewReturnRequest) (ReturnResponse, error)
+	Reject(ctx context.Context, id string, req ReviewReturnRequest) (ReturnResponse, error)
+	Receive(ctx context.Context, adminID, id string) (ReturnResponse, error)
+	Refund(ctx context.Context, adminID, id string, req RefundRequest) (ReturnResponse, error)
+}
+
+type service struct {
+	db             *sql.DB
+	repo           Repository
+	cloudinaryRepo CloudinaryService
+	inventorySvc   inventory.Service
+	validate       *validator.Validate
+}
+
+func NewService(db *sql.DB, r Repository, cloudinaryRepo CloudinaryService, inv inventory.Service) Service {
+	return &service{
+		db:             db,
+		repo:           r,
+		cloudinaryRepo: cloudinaryRepo,
+		inventorySvc:   inv,
+		validate:       validator.New(),
+	}
+}
+
+// ==================== CUSTOMER ====================
+
+func (s *service) Create(ctx context.Context, userID, orderID string, req CreateReturnRequest, photos []Photo) (ReturnResponse, error) {
+	if err := s.validate.Struct(req); err != nil {
+		return ReturnResponse{}, apperror.MapValidationError(err)
+	}
+	if len(photos) == 0 {
+		return ReturnResponse{}, rmaerrors.ErrPhotoRequired
+	}
+	if len(photos) > constants.ReturnMaxPhotos {
+		return ReturnResponse{}, rmaerrors.ErrTooManyPhotos
+	}
+
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return ReturnResponse{}, auth.ErrUnauthorized
+	}
+	oid, err := uuid.Parse(orderID)
+	if err != nil {
+		return ReturnResponse{}, rmaerrors.ErrInvalidID
+	}
+	itemID, _ := uuid.Parse(req.OrderItemID)
+
+	// Upload foto bukti sebelum transaksi agar item tidak terkunci selama upload.
+	// Public ID hanya dari id retur + urutan, nama file dari user tidak dipakai.
+	// Jika langkah berikutnya gagal, foto yang sudah terupload dihapus lagi.
+	returnID := uuid.New()
+	var (
+		uploaded  []string
+		urls      []string
+		committed bool
+	)
+	defer func() {
+		if committed {
+			return
+		}
+		for _, publicID := range uploaded {
+			_ = s.cloudinaryRepo.DeleteImage(ctx, publicID)
+		}
+	}()
+	for i, p := range photos {
+		name := fmt.Sprintf("return-%s-%d", returnID.String(), i+1)
+
+		url, err := s.cloudinaryRepo.UploadImage(ctx, p.File, name, constants.CloudinaryReturnFolder)
+		if err != nil {
+			return ReturnResponse{}, rmaerrors.ErrImageUploadFailed
+		}
+		uploaded = append(uploaded, constants.CloudinaryReturnFolder+"/"+name)
+		urls = append(urls, url)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return ReturnResponse{}, rmaerrors.ErrReturnFailed
+	}
+	defer tx.Rollback()
+
+	qtx := s.repo.WithTx(tx)
+
+	// 1. Item dikunci; order milik user lain diperlakukan sama dengan tidak ada
+	item, err := qtx.GetOrderItemForReturn(ctx, oid, itemID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ReturnResponse{}, rmaerrors.ErrOrderItemNotFound
+		}
+		return ReturnResponse{}, rmaerrors.ErrReturnFailed
+	}
+	if item.UserID != uid {
+		return ReturnResponse{}, rmaerrors.ErrOrderItemNotFound
+	}
+	if item.OrderStatus != "DELIVERED" && item.OrderStatus != "COMPLETED" {
+		return ReturnResponse{}, rmaerrors.ErrOrderNotReturnable
+	}
+	if item.PaymentStatus != "PAID" {
+		return ReturnResponse{}, rmaerrors.ErrOrderNotPaid
+	}
+
+	// 2. Qty retur tidak boleh melebihi sisa qty item
+	used, err := qtx.SumActiveQuantity(ctx, itemID)
+	if err != nil {
+		return ReturnResponse{}, rmaerrors.ErrReturnFailed
+	}
+	if used+req.Quantity > item.Quantity {
+		return ReturnResponse{}, rmaerrors.ErrQuantityExceeded
+	}
+
+	r, err := qtx.Create(ctx, dbgen.CreateReturnRequestParams{
+		ID:           returnID,
+		RmaNumber:    fmt.Sprintf("RMA-%d%s", time.Now().Unix(), strings.ToUpper(uuid.New().String()[:4])),
+		OrderID:      oid,
+		OrderItemID:  itemID,
+		UserID:       uid,
+		ProductID:    item.ProductID,
+		NameSnapshot: item.NameSnapshot,
+		UnitPrice:    item.UnitPrice,
+		Quantity:     req.Quantity,
+		Reason:       req.Reason,
+		Description:  dbgen.ToText(req.Description),
+	})
+	if err != nil {
+		return ReturnResponse{}, rmaerrors.ErrReturnFailed
+	}
+
+	// 3. Simpan foto bukti yang sudah terupload
+	for _, url := range urls {
+		if err := qtx.CreatePhoto(ctx, r.ID, url); err != nil {
+			return ReturnResponse{}, rmaerrors.ErrReturnFailed
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return ReturnResponse{}, rmaerrors.ErrReturnFailed
+	}
+	committed = true
+
+	res := mapReturnToResponse(r)
+	res.Photos = urls
+	return res, nil
+}
+
+func (s *service) ListMine(ctx context.Context, userID string, page, limit int) ([]ReturnResponse, int64, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, 0, auth.ErrUnauthorized
+	}
+
+	rows, err := s.repo.ListByUser(ctx, dbgen.ListReturnsByUserParams{
+		UserID: uid,
+		Limit:  int32(limit),
+		Offset: int32((page - 1) * limit),
+	})
+	if err != nil {
+		return nil, 0, rmaerrors.ErrReturnFailed
+	}
+	total, err := s.repo.CountByUser(ctx, uid)
+	if err != nil {
+		return nil, 0, rmaerrors.ErrReturnFailed
+	}
+
+	return mapReturns(rows), total, nil
+}
+
+func (s *service) GetMine(ctx context.Context, userID, id string) (ReturnResponse, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return ReturnResponse{}, auth.ErrUnauthorized
+	}
+
+	r, err := s.find(ctx, id)
+	if err != nil {
+		return ReturnResponse{}, err
+	}
+	if r.UserID != uid {
+		return ReturnResponse{}, rmaerrors.ErrReturnNotFound
+	}
+
+	return s.detail(ctx, r)
+}
+
+func (s *service) Ship(ctx context.Context, userID, id string, req ShipReturnRequest) (ReturnResponse, error) {
+	if err := s.validate.Struct(req); err != nil {
+		return ReturnResponse{}, apperror.MapValidationError(err)
+	}
+
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return ReturnResponse{}, auth.ErrUnauthorized
+	}
+
+	r, err := s.find(ctx, id)
+	if err != nil {
+		return ReturnResponse{}, err
+	}
+	if r.UserID != uid {
+		return ReturnResponse{}, rmaerrors.ErrReturnNotFound
+	}
+
+	// Guard status di query: hanya retur APPROVED yang bisa dikirim balik
+	shipped, err := s.repo.Ship(ctx, dbgen.ShipReturnParams{
+		ID:              r.ID,
+		ReturnCourier:   dbgen.ToText(strings.TrimSpace(req.Courier)),
+		ReturnReceiptNo: dbgen.ToText(strings.TrimSpace(req.ReceiptNo)),
+	})
+	if err != nil {
+		return ReturnResponse{}, s.transitionError(ctx, r.ID, err)
+	}
+
+	return s.detail(ctx, shipped)
+}
+
+// ==================== ADMIN ====================
+
+func (s *service) ListAdmin(ctx context.Context, status string, page, limit int) ([]ReturnResponse, int64, error) {
+	statusFilter := dbgen.ToText(strings.ToUpper(status))
+
+	rows, err := s.repo.ListAdmin(ctx, dbgen.ListReturnsAdminParams{
+		Status: statusFilter,
+		Limit:  int32(limit),
+		Offset: int32((page - 1) * limit),
+	})
+	if err != nil {
+		return nil, 0, rmaerrors.ErrReturnFailed
+	}
+	total, err := s.repo.CountAdmin(ctx, statusFilter)
+	if err != nil {
+		return nil, 0, rmaerrors.ErrReturnFailed
+	}
+
+	return mapReturns(rows), total, nil
+}
+
+func (s *service) GetByID(ctx context.Context, id string) (ReturnResponse, error) {
+	r, err := s.find(ctx, id)
+	if err != nil {
+		return ReturnResponse{}, err
+	}
+	return s.detail(ctx, r)
+}
+
+func (s *service) Approve(ctx context.Context, id string, req ReviewReturnRequest) (ReturnResponse, error) {
+	if err := s.validate.Struct(req); err != nil {
+		return ReturnResponse{}, apperror.MapValidationError(err)
+	}
+	rid, err := uuid.Parse(id)
+	if err != nil {
+		return ReturnResponse{}, rmaerrors.ErrInvalidID
+	}
+
+	r, err := s.repo.Approve(ctx, rid, dbgen.ToText(req.Note))
+	if err != nil {
+		return ReturnResponse{}, s.transitionError(ctx, rid, err)
+	}
+	return s.detail(ctx, r)
+}
+
+func (s *service) Reject(ctx context.Context, id string, req ReviewReturnRequest) (ReturnResponse, error) {
+	if err := s.validate.Struct(req); err != nil {
+		return ReturnResponse{}, apperror.MapValidationError(err)
+	}
+	if strings.TrimSpace(req.Note) == "" {
+		return ReturnResponse{}, rmaerrors.ErrNoteRequired
+	}
+	rid, err := uuid.Parse(id)
+	if err != nil {
+		return ReturnResponse{}, rmaerrors.ErrInvalidID
+	}
+
+	r, err := s.repo.Reject(ctx, rid, dbgen.ToText(req.Note))
+	if err != nil {
+		return ReturnResponse{}, s.transitionError(ctx, rid, err)
+	}
+	return s.detail(ctx, r)
+}
+
+func (s *service) Receive(ctx context.Context, adminID, id string) (ReturnResponse, error) {
+	rid, err := uuid.Parse(id)
+	if err != nil {
+		return ReturnResponse{}, rmaerrors.ErrInvalidID
+	}
+	actor, _ := uuid.Parse(adminID)
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return ReturnResponse{}, rmaerrors.ErrReturnFailed
+	}
+	defer tx.Rollback()
+
+	qtx := s.repo.WithTx(tx)
+
+	r, err := qtx.Receive(ctx, rid)
+	if err != nil {
+		return ReturnResponse{}, s.transitionError(ctx, rid, err)
+	}
+
+	// Barang retur kembali ke stok lewat ledger inventory
+	if _, err := s.inventorySvc.Record(ctx, tx, inventory.Movement{
+		ProductID: r.ProductID,
+		Delta:     r.Quantity,
+		Reason:    constants.StockReasonReturn,
+		OrderID:   uuid.NullUUID{UUID: r.OrderID, Valid: true},
+		ActorID:   uuid.NullUUID{UUID: actor, Valid: actor != uuid.Nil},
+		Note:      r.RmaNumber,
+	}); err != nil {
+		return ReturnResponse{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return ReturnResponse{}, rmaerrors.ErrReturnFailed
+	}
+
+	return s.detail(ctx, r)
+}
+
+func (s *service) Refund(ctx context.Context, adminID, id string, req RefundRequest) (ReturnResponse, error) {
+	if err := s.validate.Struct(req); err != nil {
+		return ReturnResponse{}, apperror.MapValidationError(err)
+	}
+	rid, err := uuid.Parse(id)
+	if err != nil {
+		return ReturnResponse{}, rmaerrors.ErrInvalidID
+	}
+	actor, _ := uuid.Parse(adminID)
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return ReturnResponse{}, rmaerrors.ErrReturnFailed
+	}
+	defer tx.Rollback()
+
+	qtx := s.repo.WithTx(tx)
+
+	r, err := qtx.GetByIDForUpdate(ctx, rid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ReturnResponse{}, rmaerrors.ErrReturnNotFound
+		}
+		return ReturnResponse{}, rmaerrors.ErrReturnFailed
+	}
+	if r.Status != StatusReceived {
+		return ReturnResponse{}, rmaerrors.ErrInvalidStatusTransition
+	}
+
+	// 1. Refund maksimal senilai barang yang diretur (boleh sebagian)
+	unitPrice, _ := strconv.ParseFloat(r.UnitPrice, 64)
+	amount := roundMoney(req.Amount)
+	if amount > roundMoney(unitPrice*float64(r.Quantity)) {
+		return ReturnResponse{}, rmaerrors.ErrRefundExceedsAmount
+	}
+	amountStr := fmt.Sprintf("%.2f", amount)
+
+	// 2. Guard di query: order harus sudah dibayar dan akumulasi refund tidak melebihi total order
+	if _, err := qtx.ApplyOrderRefund(ctx, r.OrderID, amountStr); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ReturnResponse{}, rmaerrors.ErrRefundExceedsAmount
+		}
+		return ReturnResponse{}, rmaerrors.ErrReturnFailed
+	}
+
+	refund, err := qtx.CreateRefund(ctx, dbgen.CreateRefundParams{
+		ReturnID:    r.ID,
+		OrderID:     r.OrderID,
+		Amount:      amountStr,
+		Method:      req.Method,
+		Reference:   dbgen.ToText(req.Reference),
+		Note:        dbgen.ToText(req.Note),
+		ProcessedBy: uuid.NullUUID{UUID: actor, Valid: actor != uuid.Nil},
+	})
+	if err != nil {
+		return ReturnResponse{}, rmaerrors.ErrReturnFailed
+	}
+
+	r, err = qtx.MarkRefunded(ctx, r.ID)
+	if err != nil {
+		return ReturnResponse{}, rmaerrors.ErrReturnFailed
+	}
+
+	if err := tx.Commit(); err != nil {
+		return ReturnResponse{}, rmaerrors.ErrReturnFailed
+	}
+
+	res := mapReturnToResponse(r)
+	res.Refund = mapRefund(refund)
+	return res, nil
+}
+
+// ==================== HELPERS ====================
+
+func (s *service) find(ctx context.Context, id string) (dbgen.ReturnRequest, error) {
+	rid, err := uuid.Parse(id)
+	if err != nil {
+		return dbgen.ReturnRequest{}, rmaerrors.ErrInvalidID
+	}
+
+	r, err := s.repo.GetByID(ctx, rid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dbgen.ReturnRequest{}, rmaerrors.ErrReturnNotFound
+		}
+		return dbgen.ReturnRequest{}, rmaerrors.ErrReturnFailed
+	}
+	return r, nil
+}
+
+// transitionError bedakan retur tidak ada vs status tidak sesuai saat update ber-guard gagal
+func (s *service) transitionError(ctx context.Context, id uuid.UUID, err error) error {
+	if !errors.Is(err, sql.ErrNoRows) {
+		return rmaerrors.ErrReturnFailed
+	}
+	if _, gerr := s.repo.GetByID(ctx, id); errors.Is(gerr, sql.ErrNoRows) {
+		return rmaerrors.ErrReturnNotFound
+	}
+	return rmaerrors.ErrInvalidStatusTransition
+}
+
+// detail lengkapi response dengan foto & refund
+func (s *service) detail(ctx context.Context, r dbgen.ReturnRequest) (ReturnResponse, error) {
+	res := mapReturnToResponse(r)
+
+	photos, err := s.repo.ListPhotos(ctx, r.ID)
+	if err != nil {
+		return ReturnResponse{}, rmaerrors.ErrReturnFailed
+	}
+	for _, p := range photos {
+		res.Photos = append(res.Photos, p.ImageUrl)
+	}
+
+	refund, err := s.repo.GetRefund(ctx, r.ID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return ReturnResponse{}, rmaerrors.ErrReturnFailed
+	}
+	if err == nil {
+		res.Refund = mapRefund(refund)
+	}
+
+	return res, nil
+}
+
+func mapReturns(rows []dbgen.ReturnRequest) []ReturnResponse {
+	res := make([]ReturnResponse, 0, len(rows))
+	for _, r := range rows {
+		res = append(res, mapReturnToResponse(r))
+	}
+	return res
+}
+
+func mapReturnToResponse(r dbgen.ReturnRequest) ReturnResponse {
+	unitPrice, _ := strconv.ParseFloat(r.UnitPrice, 64)
+	return ReturnResponse{
+		ID:              r.ID.String(),
+		RMANumber:       r.RmaNumber,
+		OrderID:         r.OrderID.String(),
+		OrderItemID:     r.OrderItemID.String(),
+		ProductID:       r.ProductID.String(),
+		ProductName:     r.NameSnapshot,
+		UnitPrice:       unitPrice,
+		Quantity:        r.Quantity,
+		Reason:          r.Reason,
+		Description:     r.Description.String,
+		Status:          r.Status,
+		AdminNote:       r.AdminNote.String,
+		ReturnCourier:   r.ReturnCourier.String,
+		ReturnReceiptNo: r.ReturnReceiptNo.String,
+		ApprovedAt:      timePtr(r.ApprovedAt),
+		RejectedAt:      timePtr(r.RejectedAt),
+		ShippedBackAt:   timePtr(r.ShippedBackAt),
+		ReceivedAt:      timePtr(r.ReceivedAt),
+		RefundedAt:      timePtr(r.RefundedAt),
+		CreatedAt:       r.CreatedAt,
+	}
+}
+
+func mapRefund(rf dbgen.Refund) *RefundResponse {
+	amount, _ := strconv.ParseFloat(rf.Amount, 64)
+	return &RefundResponse{
+		ID:        rf.ID.String(),
+		Amount:    amount,
+		Method:    rf.Method,
+		Reference: rf.Reference.String,
+		Note:      rf.Note.String,
+		CreatedAt: rf.CreatedAt,
+	}
+}
+
+func timePtr(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	v := t.Time
+	return &v
+}
+
+func roundMoney(v float64) float64 {
+	return float64(int64(v*100+0.5)) / 100
+}
@@ -0,0 +1,366 @@
+package rma_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"mime/multipart"
+	"testing"
+
+	"go-sqlc-starter/internal/api/v1/inventory"
+	inventoryMock "go-sqlc-starter/internal/api/v1/mock/inventory"
+	rmaMock "go-sqlc-starter/internal/api/v1/mock/rma"
+	"go-sqlc-starter/internal/api/v1/rma"
+	rmaerrors "go-sqlc-starter/internal/api/v1/rma/errors"
+	"go-sqlc-starter/internal/dbgen"
+	"go-sqlc-starter/internal/pkg/constants"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type serviceDeps struct {
+	db           *sql.DB
+	sqlMock      sqlmock.Sqlmock
+	service      rma.Service
+	repo         *rmaMock.MockRepository
+	cloudinary   *rmaMock.MockCloudinaryService
+	inventorySvc *inventoryMock.MockService
+}
+
+func setupServiceTest(t *testing.T) *serviceDeps {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	db, sqlMock, _ := sqlmock.New()
+	repo := rmaMock.NewMockRepository(ctrl)
+	cld := rmaMock.NewMockCloudinaryService(ctrl)
+	inv := inventoryMock.NewMockService(ctrl)
+
+	repo.EXPECT().WithTx(gomock.Any()).Return(repo).AnyTimes()
+
+	return &serviceDeps{
+		db:           db,
+		sqlMock:      sqlMock,
+		service:      rma.NewService(db, repo, cld, inv),
+		repo:         repo,
+		cloudinary:   cld,
+		inventorySvc: inv,
+	}
+}
+
+func expectTx(t *testing.T, mock sqlmock.Sqlmock, commit bool) {
+	t.Helper()
+
+	mock.ExpectBegin()
+	if commit {
+		mock.ExpectCommit()
+	} else {
+		mock.ExpectRollback()
+	}
+}
+
+// expectUpload mengharapkan satu foto terupload sebelum transaksi; deleted = foto dihapus lagi karena gagal
+func expectUpload(ctx context.Context, deps *serviceDeps, deleted bool) {
+	var publicID string
+	deps.cloudinary.EXPECT().UploadImage(ctx, nil, gomock.Any(), constants.CloudinaryReturnFolder).
+		DoAndReturn(func(_ context.Context, _ multipart.File, name, folder string) (string, error) {
+			publicID = folder + "/" + name
+			return "https://cdn/" + name + ".jpg", nil
+		})
+	if deleted {
+		deps.cloudinary.EXPECT().DeleteImage(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, id string) error {
+				if id != publicID {
+					return errors.New("unexpected public id " + id)
+				}
+				return nil
+			})
+	}
+}
+
+func TestRMAService_Create(t *testing.T) {
+	ctx := context.Background()
+	userID, orderID, itemID, productID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	req := rma.CreateReturnRequest{OrderItemID: itemID.String(), Quantity: 1, Reason: "DAMAGED"}
+	photos := []rma.Photo{{Filename: "cracked.jpg"}}
+
+	item := dbgen.GetOrderItemForReturnRow{
+		ID:            itemID,
+		OrderID:       orderID,
+		ProductID:     productID,
+		NameSnapshot:  "Phone X",
+		UnitPrice:     "5000000.00",
+		Quantity:      2,
+		UserID:        userID,
+		OrderStatus:   "COMPLETED",
+		PaymentStatus: "PAID",
+	}
+
+	t.Run("success - photo uploaded before tx with server-built public id", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		var uploadedName string
+
+		// Upload terjadi sebelum BeginTx, nama file dari user tidak dipakai
+		deps.cloudinary.EXPECT().UploadImage(ctx, nil, gomock.Any(), constants.CloudinaryReturnFolder).
+			DoAndReturn(func(_ context.Context, _ multipart.File, name, _ string) (string, error) {
+				uploadedName = name
+				return "https://cdn/proof.jpg", nil
+			})
+		expectTx(t, deps.sqlMock, true)
+		deps.repo.EXPECT().GetOrderItemForReturn(ctx, orderID, itemID).Return(item, nil)
+		deps.repo.EXPECT().SumActiveQuantity(ctx, itemID).Return(int32(1), nil)
+		deps.repo.EXPECT().Create(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, arg dbgen.CreateReturnRequestParams) (dbgen.ReturnRequest, error) {
+				assert.Equal(t, productID, arg.ProductID)
+				assert.Equal(t, "5000000.00", arg.UnitPrice)
+				assert.Equal(t, "return-"+arg.ID.String()+"-1", uploadedName)
+				return dbgen.ReturnRequest{ID: arg.ID, Status: "REQUESTED", Quantity: arg.Quantity}, nil
+			})
+		deps.repo.EXPECT().CreatePhoto(ctx, gomock.Any(), "https://cdn/proof.jpg").Return(nil)
+
+		res, err := deps.service.Create(ctx, userID.String(), orderID.String(), req, photos)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "REQUESTED", res.Status)
+		assert.Equal(t, []string{"https://cdn/proof.jpg"}, res.Photos)
+		assert.NotContains(t, uploadedName, "cracked")
+		assert.NoError(t, deps.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("error - quantity exceeds remaining, uploaded photo deleted", func(t *testing.T) {
+		deps := setupServiceTest(t)
+
+		expectUpload(ctx, deps, true)
+		expectTx(t, deps.sqlMock, false)
+		deps.repo.EXPECT().GetOrderItemForReturn(ctx, orderID, itemID).Return(item, nil)
+		deps.repo.EXPECT().SumActiveQuantity(ctx, itemID).Return(int32(2), nil)
+
+		_, err := deps.service.Create(ctx, userID.String(), orderID.String(), req, photos)
+
+		assert.Equal(t, rmaerrors.ErrQuantityExceeded, err)
+	})
+
+	t.Run("error - order not delivered yet", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		pending := item
+		pending.OrderStatus = "PAID"
+
+		expectUpload(ctx, deps, true)
+		expectTx(t, deps.sqlMock, false)
+		deps.repo.EXPECT().GetOrderItemForReturn(ctx, orderID, itemID).Return(pending, nil)
+
+		_, err := deps.service.Create(ctx, userID.String(), orderID.String(), req, photos)
+
+		assert.Equal(t, rmaerrors.ErrOrderNotReturnable, err)
+	})
+
+	t.Run("error - order not paid", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		unpaid := item
+		unpaid.PaymentStatus = "UNPAID"
+
+		expectUpload(ctx, deps, true)
+		expectTx(t, deps.sqlMock, false)
+		deps.repo.EXPECT().GetOrderItemForReturn(ctx, orderID, itemID).Return(unpaid, nil)
+
+		_, err := deps.service.Create(ctx, userID.String(), orderID.String(), req, photos)
+
+		assert.Equal(t, rmaerrors.ErrOrderNotPaid, err)
+	})
+
+	t.Run("error - item of another user", func(t *testing.T) {
+		deps := setupServiceTest(t)
+
+		expectUpload(ctx, deps, true)
+		expectTx(t, deps.sqlMock, false)
+		deps.repo.EXPECT().GetOrderItemForReturn(ctx, orderID, itemID).Return(item, nil)
+
+		_, err := deps.service.Create(ctx, uuid.New().String(), orderID.String(), req, photos)
+
+		assert.Equal(t, rmaerrors.ErrOrderItemNotFound, err)
+	})
+
+	t.Run("error - photo required", func(t *testing.T) {
+		deps := setupServiceTest(t)
+
+		_, err := deps.service.Create(ctx, userID.String(), orderID.String(), req, nil)
+
+		assert.Equal(t, rmaerrors.ErrPhotoRequired, err)
+	})
+
+	t.Run("error - second upload fails, first photo deleted without opening tx", func(t *testing.T) {
+		deps := setupServiceTest(t)
+
+		expectUpload(ctx, deps, true)
+		deps.cloudinary.EXPECT().UploadImage(ctx, nil, gomock.Any(), constants.CloudinaryReturnFolder).Return("", errors.New("upload failed"))
+
+		_, err := deps.service.Create(ctx, userID.String(), orderID.String(), req, []rma.Photo{{Filename: "a.jpg"}, {Filename: "b.jpg"}})
+
+		assert.Equal(t, rmaerrors.ErrImageUploadFailed, err)
+		assert.NoError(t, deps.sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRMAService_ReviewAndShip(t *testing.T) {
+	ctx := context.Background()
+	returnID, userID := uuid.New(), uuid.New()
+
+	t.Run("success - approve", func(t *testing.T) {
+		deps := setupServiceTest(t)
+
+		deps.repo.EXPECT().Approve(ctx, returnID, sql.NullString{}).Return(dbgen.ReturnRequest{ID: returnID, Status: "APPROVED"}, nil)
+		deps.repo.EXPECT().ListPhotos(ctx, returnID).Return(nil, nil)
+		deps.repo.EXPECT().GetRefund(ctx, returnID).Return(dbgen.Refund{}, sql.ErrNoRows)
+
+		res, err := deps.service.Approve(ctx, returnID.String(), rma.ReviewReturnRequest{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "APPROVED", res.Status)
+		assert.Nil(t, res.Refund)
+	})
+
+	t.Run("error - approve already processed return", func(t *testing.T) {
+		deps := setupServiceTest(t)
+
+		deps.repo.EXPECT().Approve(ctx, returnID, sql.NullString{}).Return(dbgen.ReturnRequest{}, sql.ErrNoRows)
+		deps.repo.EXPECT().GetByID(ctx, returnID).Return(dbgen.ReturnRequest{ID: returnID, Status: "REJECTED"}, nil)
+
+		_, err := deps.service.Approve(ctx, returnID.String(), rma.ReviewReturnRequest{})
+
+		assert.Equal(t, rmaerrors.ErrInvalidStatusTransition, err)
+	})
+
+	t.Run("error - reject without note", func(t *testing.T) {
+		deps := setupServiceTest(t)
+
+		_, err := deps.service.Reject(ctx, returnID.String(), rma.ReviewReturnRequest{Note: "  "})
+
+		assert.Equal(t, rmaerrors.ErrNoteRequired, err)
+	})
+
+	t.Run("error - ship return of another user", func(t *testing.T) {
+		deps := setupServiceTest(t)
+
+		deps.repo.EXPECT().GetByID(ctx, returnID).Return(dbgen.ReturnRequest{ID: returnID, UserID: uuid.New()}, nil)
+
+		_, err := deps.service.Ship(ctx, userID.String(), returnID.String(), rma.ShipReturnRequest{Courier: "JNE", ReceiptNo: "R1"})
+
+		assert.Equal(t, rmaerrors.ErrReturnNotFound, err)
+	})
+}
+
+func TestRMAService_Receive(t *testing.T) {
+	ctx := context.Background()
+	deps := setupServiceTest(t)
+	returnID, orderID, productID, adminID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	received := dbgen.ReturnRequest{
+		ID:        returnID,
+		OrderID:   orderID,
+		ProductID: productID,
+		Quantity:  2,
+		RmaNumber: "RMA-1",
+		Status:    "RECEIVED",
+	}
+
+	expectTx(t, deps.sqlMock, true)
+	deps.repo.EXPECT().Receive(ctx, returnID).Return(received, nil)
+	// Stok kembali lewat ledger dengan reason RETURN
+	deps.inventorySvc.EXPECT().Record(ctx, gomock.Any(), inventory.Movement{
+		ProductID: productID,
+		Delta:     2,
+		Reason:    constants.StockReasonReturn,
+		OrderID:   uuid.NullUUID{UUID: orderID, Valid: true},
+		ActorID:   uuid.NullUUID{UUID: adminID, Valid: true},
+		Note:      "RMA-1",
+	}).Return(dbgen.StockMovement{}, nil)
+	deps.repo.EXPECT().ListPhotos(ctx, returnID).Return(nil, nil)
+	deps.repo.EXPECT().GetRefund(ctx, returnID).Return(dbgen.Refund{}, sql.ErrNoRows)
+
+	res, err := deps.service.Receive(ctx, adminID.String(), returnID.String())
+
+	assert.NoError(t, err)
+	assert.Equal(t, "RECEIVED", res.Status)
+	assert.NoError(t, deps.sqlMock.ExpectationsWereMet())
+}
+
+func TestRMAService_Refund(t *testing.T) {
+	ctx := context.Background()
+	returnID, orderID, adminID := uuid.New(), uuid.New(), uuid.New()
+	received := dbgen.ReturnRequest{ID: returnID, OrderID: orderID, UnitPrice: "150000.00", Quantity: 2, Status: "RECEIVED"}
+
+	t.Run("success - partial refund", func(t *testing.T) {
+		deps := setupServiceTest(t)
+
+		expectTx(t, deps.sqlMock, true)
+		deps.repo.EXPECT().GetByIDForUpdate(ctx, returnID).Return(received, nil)
+		deps.repo.EXPECT().ApplyOrderRefund(ctx, orderID, "100000.00").Return(dbgen.Order{PaymentStatus: "PARTIAL_REFUND"}, nil)
+		deps.repo.EXPECT().CreateRefund(ctx, dbgen.CreateRefundParams{
+			ReturnID:    returnID,
+			OrderID:     orderID,
+			Amount:      "100000.00",
+			Method:      "BANK_TRANSFER",
+			Reference:   sql.NullString{String: "TRX-1", Valid: true},
+			ProcessedBy: uuid.NullUUID{UUID: adminID, Valid: true},
+		}).Return(dbgen.Refund{ID: uuid.New(), Amount: "100000.00", Method: "BANK_TRANSFER"}, nil)
+		deps.repo.EXPECT().MarkRefunded(ctx, returnID).Return(dbgen.ReturnRequest{ID: returnID, Status: "REFUNDED"}, nil)
+
+		res, err := deps.service.Refund(ctx, adminID.String(), returnID.String(), rma.RefundRequest{
+			Amount:    100000,
+			Method:    "BANK_TRANSFER",
+			Reference: "TRX-1",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "REFUNDED", res.Status)
+		assert.Equal(t, 100000.0, res.Refund.Amount)
+		assert.NoError(t, deps.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("error - amount above returned items value", func(t *testing.T) {
+		deps := setupServiceTest(t)
+
+		expectTx(t, deps.sqlMock, false)
+		deps.repo.EXPECT().GetByIDForUpdate(ctx, returnID).Return(received, nil)
+
+		_, err := deps.service.Refund(ctx, adminID.String(), returnID.String(), rma.RefundRequest{Amount: 300000.01, Method: "STORE_CREDIT"})
+
+		assert.Equal(t, rmaerrors.ErrRefundExceedsAmount, err)
+	})
+
+	t.Run("error - order total already refunded", func(t *testing.T) {
+		deps := setupServiceTest(t)
+
+		expectTx(t, deps.sqlMock, false)
+		deps.repo.EXPECT().GetByIDForUpdate(ctx, returnID).Return(received, nil)
+		deps.repo.EXPECT().ApplyOrderRefund(ctx, orderID, "300000.00").Return(dbgen.Order{}, sql.ErrNoRows)
+
+		_, err := deps.service.Refund(ctx, adminID.String(), returnID.String(), rma.RefundRequest{Amount: 300000, Method: "ORIGINAL_PAYMENT"})
+
+		assert.Equal(t, rmaerrors.ErrRefundExceedsAmount, err)
+	})
+
+	t.Run("error - return not received yet", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		approved := received
+		approved.Status = "APPROVED"
+
+		expectTx(t, deps.sqlMock, false)
+		deps.repo.EXPECT().GetByIDForUpdate(ctx, returnID).Return(approved, nil)
+
+		_, err := deps.service.Refund(ctx, adminID.String(), returnID.String(), rma.RefundRequest{Amount: 1000, Method: "ORIGINAL_PAYMENT"})
+
+		assert.Equal(t, rmaerrors.ErrInvalidStatusTransition, err)
+	})
+
+	t.Run("error - invalid method", func(t *testing.T) {
+		deps := setupServiceTest(t)
+
+		_, err := deps.service.Refund(ctx, adminID.String(), returnID.String(), rma.RefundRequest{Amount: 1000, Method: "CASH"})
+
+		assert.Error(t, err)
+	})
+}
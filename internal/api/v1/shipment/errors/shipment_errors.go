@@ -0,0 +1,50 @@
+package shipmenterrors
+
+import (
+	"go-sqlc-starter/internal/pkg/apperror"
+	"net/http"
+)
+
+var (
+	ErrInvalidOrderID = apperror.New(
+		apperror.CodeInvalidInput,
+		"Invalid order ID",
+		http.StatusBadRequest,
+	)
+
+	ErrOrderNotFound = apperror.New(
+		apperror.CodeNotFound,
+		"Order not found",
+		http.StatusNotFound,
+	)
+
+	ErrShipmentNotFound = apperror.New(
+		apperror.CodeNotFound,
+		"Shipment not found",
+		http.StatusNotFound,
+	)
+
+	ErrReceiptRequired = apperror.New(
+		apperror.CodeInvalidInput,
+		"Receipt number is required",
+		http.StatusBadRequest,
+	)
+
+	ErrInvalidSignature = apperror.New(
+		apperror.CodeUnauthorized,
+		"Invalid webhook signature",
+		http.StatusUnauthorized,
+	)
+
+	ErrInvalidPayload = apperror.New(
+		apperror.CodeInvalidInput,
+		"Invalid webhook payload",
+		http.StatusBadRequest,
+	)
+
+	ErrShipmentFailed = apperror.New(
+		apperror.CodeInternalError,
+		"Failed to process shipment",
+		http.StatusInternalServerError,
+	)
+)
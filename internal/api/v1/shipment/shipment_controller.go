@@ -0,0 +1,61 @@
+package shipment
+
+import (
+	"go-sqlc-starter/internal/pkg/apperror"
+	"go-sqlc-starter/internal/pkg/response"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Header berisi hex HMAC-SHA256 dari body webhook
+const SignatureHeader = "X-Courier-Signature"
+
+type Controller struct {
+	service Service
+}
+
+func NewController(svc Service) *Controller {
+	return &Controller{service: svc}
+}
+
+// Webhook terima update tracking dari kurir
+// POST /webhooks/courier
+func (ctrl *Controller) Webhook(c *gin.Context) {
+	// Body mentah dibutuhkan untuk verifikasi signature
+	payload, err := c.GetRawData()
+	if err != nil {
+		appErr := apperror.Wrap(err, apperror.CodeInvalidInput, "Invalid request body", http.StatusBadRequest)
+		httpErr := apperror.ToHTTP(appErr)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, err.Error())
+		return
+	}
+
+	res, err := ctrl.service.Webhook(c.Request.Context(), payload, c.GetHeader(SignatureHeader))
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// Tracking timeline pengiriman order milik user
+// GET /orders/:id/tracking
+func (ctrl *Controller) Tracking(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		response.Error(c, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated", nil)
+		return
+	}
+
+	res, err := ctrl.service.Tracking(c.Request.Context(), userID.(string), c.Param("id"))
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, nil)
+}
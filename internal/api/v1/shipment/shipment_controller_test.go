@@ -0,0 +1,126 @@
+package shipment_test
+
+import (
+	"bytes"
+	"context"
+	"go-sqlc-starter/internal/api/v1/shipment"
+	shipmenterrors "go-sqlc-starter/internal/api/v1/shipment/errors"
+	"go-sqlc-starter/internal/dbgen"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== FAKE SERVICE (Mock Manual) ====================
+
+type fakeShipmentService struct {
+	webhookFunc  func(ctx context.Context, payload []byte, signature string) (shipment.WebhookResponse, error)
+	trackingFunc func(ctx context.Context, userID, orderID string) (shipment.TrackingResponse, error)
+}
+
+func (f *fakeShipmentService) Create(ctx context.Context, tx dbgen.DBTX, order dbgen.Order, receiptNo string) (dbgen.Shipment, error) {
+	return dbgen.Shipment{}, nil
+}
+func (f *fakeShipmentService) Webhook(ctx context.Context, payload []byte, signature string) (shipment.WebhookResponse, error) {
+	return f.webhookFunc(ctx, payload, signature)
+}
+func (f *fakeShipmentService) Tracking(ctx context.Context, userID, orderID string) (shipment.TrackingResponse, error) {
+	return f.trackingFunc(ctx, userID, orderID)
+}
+
+func TestShipmentController_Webhook(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	body := `{"courier":"JNE","receiptNo":"JNE123","events":[]}`
+
+	perform := func(svc *fakeShipmentService, signature string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/webhooks/courier", bytes.NewBufferString(body))
+		c.Request.Header.Set(shipment.SignatureHeader, signature)
+
+		shipment.NewController(svc).Webhook(c)
+		return w
+	}
+
+	t.Run("positive - raw body and signature forwarded", func(t *testing.T) {
+		svc := &fakeShipmentService{
+			webhookFunc: func(ctx context.Context, payload []byte, signature string) (shipment.WebhookResponse, error) {
+				assert.Equal(t, body, string(payload))
+				assert.Equal(t, "abc", signature)
+				return shipment.WebhookResponse{EventsAdded: 1, Delivered: true}, nil
+			},
+		}
+
+		w := perform(svc, "abc")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"delivered":true`)
+	})
+
+	t.Run("negative - invalid signature", func(t *testing.T) {
+		svc := &fakeShipmentService{
+			webhookFunc: func(ctx context.Context, payload []byte, signature string) (shipment.WebhookResponse, error) {
+				return shipment.WebhookResponse{}, shipmenterrors.ErrInvalidSignature
+			},
+		}
+
+		w := perform(svc, "bad")
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestShipmentController_Tracking(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	userID, orderID := uuid.New().String(), uuid.New().String()
+
+	perform := func(svc *fakeShipmentService, userID string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		if userID != "" {
+			c.Set("user_id", userID)
+		}
+		c.Params = gin.Params{{Key: "id", Value: orderID}}
+		c.Request = httptest.NewRequest(http.MethodGet, "/orders/"+orderID+"/tracking", nil)
+
+		shipment.NewController(svc).Tracking(c)
+		return w
+	}
+
+	t.Run("positive - timeline returned", func(t *testing.T) {
+		svc := &fakeShipmentService{
+			trackingFunc: func(ctx context.Context, u, o string) (shipment.TrackingResponse, error) {
+				assert.Equal(t, userID, u)
+				assert.Equal(t, orderID, o)
+				return shipment.TrackingResponse{ReceiptNo: "JNE123", Events: []shipment.TrackingEventResponse{{Status: "IN_TRANSIT"}}}, nil
+			},
+		}
+
+		w := perform(svc, userID)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"receiptNo":"JNE123"`)
+	})
+
+	t.Run("negative - not shipped yet", func(t *testing.T) {
+		svc := &fakeShipmentService{
+			trackingFunc: func(ctx context.Context, u, o string) (shipment.TrackingResponse, error) {
+				return shipment.TrackingResponse{}, shipmenterrors.ErrShipmentNotFound
+			},
+		}
+
+		w := perform(svc, userID)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("negative - unauthenticated", func(t *testing.T) {
+		w := perform(&fakeShipmentService{}, "")
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
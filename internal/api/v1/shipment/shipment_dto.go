@@ -0,0 +1,47 @@
+package shipment
+
+import "time"
+
+// ==================== REQUEST STRUCTS ====================
+
+// WebhookRequest payload notifikasi tracking dari kurir
+type WebhookRequest struct {
+	Courier   string                 `json:"courier" validate:"required,max=50"`
+	ReceiptNo string                 `json:"receiptNo" validate:"required,max=100"`
+	Events    []TrackingEventRequest `json:"events" validate:"required,min=1,dive"`
+}
+
+type TrackingEventRequest struct {
+	Status      string    `json:"status" validate:"required,max=30"`
+	Description string    `json:"description"`
+	Location    string    `json:"location" validate:"max=120"`
+	OccurredAt  time.Time `json:"occurredAt" validate:"required"`
+}
+
+// ==================== RESPONSE STRUCTS ====================
+
+type WebhookResponse struct {
+	ShipmentID  string `json:"shipmentId"`
+	OrderID     string `json:"orderId"`
+	EventsAdded int64  `json:"eventsAdded"`
+	Delivered   bool   `json:"delivered"`
+}
+
+type TrackingEventResponse struct {
+	Status      string    `json:"status"`
+	Description string    `json:"description"`
+	Location    string    `json:"location,omitempty"`
+	OccurredAt  time.Time `json:"occurredAt"`
+}
+
+type TrackingResponse struct {
+	OrderID     string                  `json:"orderId"`
+	OrderNumber string                  `json:"orderNumber"`
+	OrderStatus string                  `json:"orderStatus"`
+	Courier     string                  `json:"courier"`
+	Service     string                  `json:"service,omitempty"`
+	ReceiptNo   string                  `json:"receiptNo"`
+	ShippedAt   time.Time               `json:"shippedAt"`
+	DeliveredAt *time.Time              `json:"deliveredAt,omitempty"`
+	Events      []TrackingEventResponse `json:"events"`
+}
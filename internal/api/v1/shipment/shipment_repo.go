@@ -0,0 +1,78 @@
+package shipment
+
+import (
+	"context"
+	"database/sql"
+	"go-sqlc-starter/internal/dbgen"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=shipment_repo.go -destination=../mock/shipment/shipment_repo_mock.go -package=mock
+type Repository interface {
+	WithTx(tx dbgen.DBTX) Repository
+	GetOrder(ctx context.Context, orderID uuid.UUID) (dbgen.Order, error)
+	Create(ctx context.Context, arg dbgen.CreateShipmentParams) (dbgen.Shipment, error)
+	GetByOrderID(ctx context.Context, orderID uuid.UUID) (dbgen.Shipment, error)
+	GetByReceiptForUpdate(ctx context.Context, courier, receiptNo string) (dbgen.Shipment, error)
+	CreateEvent(ctx context.Context, arg dbgen.CreateShipmentTrackingEventParams) (int64, error)
+	ListEvents(ctx context.Context, shipmentID uuid.UUID) ([]dbgen.ShipmentTrackingEvent, error)
+	MarkDelivered(ctx context.Context, shipmentID uuid.UUID, deliveredAt time.Time) (int64, error)
+	MarkOrderDelivered(ctx context.Context, orderID uuid.UUID) (int64, error)
+}
+
+type repository struct {
+	queries *dbgen.Queries
+}
+
+func NewRepository(q *dbgen.Queries) Repository {
+	return &repository{queries: q}
+}
+
+func (r *repository) WithTx(tx dbgen.DBTX) Repository {
+	if sqlTx, ok := tx.(*sql.Tx); ok {
+		return &repository{
+			queries: r.queries.WithTx(sqlTx),
+		}
+	}
+	return r
+}
+
+func (r *repository) GetOrder(ctx context.Context, orderID uuid.UUID) (dbgen.Order, error) {
+	return r.queries.GetOrderByID(ctx, orderID)
+}
+
+func (r *repository) Create(ctx context.Context, arg dbgen.CreateShipmentParams) (dbgen.Shipment, error) {
+	return r.queries.CreateShipment(ctx, arg)
+}
+
+func (r *repository) GetByOrderID(ctx context.Context, orderID uuid.UUID) (dbgen.Shipment, error) {
+	return r.queries.GetShipmentByOrderID(ctx, orderID)
+}
+
+func (r *repository) GetByReceiptForUpdate(ctx context.Context, courier, receiptNo string) (dbgen.Shipment, error) {
+	return r.queries.GetShipmentByReceiptForUpdate(ctx, dbgen.GetShipmentByReceiptForUpdateParams{
+		Courier:   courier,
+		ReceiptNo: receiptNo,
+	})
+}
+
+func (r *repository) CreateEvent(ctx context.Context, arg dbgen.CreateShipmentTrackingEventParams) (int64, error) {
+	return r.queries.CreateShipmentTrackingEvent(ctx, arg)
+}
+
+func (r *repository) ListEvents(ctx context.Context, shipmentID uuid.UUID) ([]dbgen.ShipmentTrackingEvent, error) {
+	return r.queries.ListShipmentTrackingEvents(ctx, shipmentID)
+}
+
+func (r *repository) MarkDelivered(ctx context.Context, shipmentID uuid.UUID, deliveredAt time.Time) (int64, error) {
+	return r.queries.MarkShipmentDelivered(ctx, dbgen.MarkShipmentDeliveredParams{
+		ID:          shipmentID,
+		DeliveredAt: sql.NullTime{Time: deliveredAt, Valid: true},
+	})
+}
+
+func (r *repository) MarkOrderDelivered(ctx context.Context, orderID uuid.UUID) (int64, error) {
+	return r.queries.MarkOrderDelivered(ctx, orderID)
+}
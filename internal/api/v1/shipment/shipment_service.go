@@ -0,0 +1,217 @@
+package shipment
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	shipmenterrors "go-sqlc-starter/internal/api/v1/shipment/errors"
+	"go-sqlc-starter/internal/dbgen"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+// Status tracking dari kurir yang menandakan paket sudah diterima
+const TrackingStatusDelivered = "DELIVERED"
+
+//go:generate mockgen -source=shipment_service.go -destination=../mock/shipment/shipment_service_mock.go -package=mock
+type Service interface {
+	// Create catat pengiriman saat order ditandai SHIPPED (ikut tx milik order)
+	Create(ctx context.Context, tx dbgen.DBTX, order dbgen.Order, receiptNo string) (dbgen.Shipment, error)
+
+	// Webhook proses notifikasi tracking dari kurir (payload mentah + signature HMAC)
+	Webhook(ctx context.Context, payload []byte, signature string) (WebhookResponse, error)
+
+	// Tracking timeline pengiriman untuk pemilik order
+	Tracking(ctx context.Context, userID, orderID string) (TrackingResponse, error)
+}
+
+type service struct {
+	db            *sql.DB
+	repo          Repository
+	webhookSecret []byte
+	validate      *validator.Validate
+}
+
+func NewService(db *sql.DB, r Repository, webhookSecret string) Service {
+	return &service{
+		db:            db,
+		repo:          r,
+		webhookSecret: []byte(webhookSecret),
+		validate:      validator.New(),
+	}
+}
+
+func (s *service) Create(ctx context.Context, tx dbgen.DBTX, order dbgen.Order, receiptNo string) (dbgen.Shipment, error) {
+	receiptNo = strings.TrimSpace(receiptNo)
+	if receiptNo == "" {
+		return dbgen.Shipment{}, shipmenterrors.ErrReceiptRequired
+	}
+
+	qtx := s.repo
+	if tx != nil {
+		qtx = s.repo.WithTx(tx)
+	}
+
+	sh, err := qtx.Create(ctx, dbgen.CreateShipmentParams{
+		OrderID:   order.ID,
+		Courier:   order.ShippingCourier.String,
+		Service:   order.ShippingService.String,
+		ReceiptNo: receiptNo,
+	})
+	if err != nil {
+		return dbgen.Shipment{}, shipmenterrors.ErrShipmentFailed
+	}
+	return sh, nil
+}
+
+func (s *service) Webhook(ctx context.Context, payload []byte, signature string) (WebhookResponse, error) {
+	// 1. Verifikasi signature sebelum menyentuh isi payload
+	if !s.verifySignature(payload, signature) {
+		return WebhookResponse{}, shipmenterrors.ErrInvalidSignature
+	}
+
+	var req WebhookRequest
+	if err := json.Unmarshal(payload, &req); err != nil {
+		return WebhookResponse{}, shipmenterrors.ErrInvalidPayload
+	}
+	if err := s.validate.Struct(req); err != nil {
+		return WebhookResponse{}, shipmenterrors.ErrInvalidPayload
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return WebhookResponse{}, shipmenterrors.ErrShipmentFailed
+	}
+	defer tx.Rollback()
+
+	qtx := s.repo.WithTx(tx)
+
+	// 2. Kunci shipment agar webhook paralel untuk resi yang sama tidak balapan
+	sh, err := qtx.GetByReceiptForUpdate(ctx, strings.TrimSpace(req.Courier), strings.TrimSpace(req.ReceiptNo))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return WebhookResponse{}, shipmenterrors.ErrShipmentNotFound
+		}
+		return WebhookResponse{}, shipmenterrors.ErrShipmentFailed
+	}
+
+	res := WebhookResponse{
+		ShipmentID: sh.ID.String(),
+		OrderID:    sh.OrderID.String(),
+	}
+
+	// 3. Append event, duplikat (webhook dikirim ulang) diabaikan oleh DB
+	for _, ev := range req.Events {
+		status := strings.ToUpper(strings.TrimSpace(ev.Status))
+		location := strings.TrimSpace(ev.Location)
+
+		added, err := qtx.CreateEvent(ctx, dbgen.CreateShipmentTrackingEventParams{
+			ShipmentID:  sh.ID,
+			Status:      status,
+			Description: strings.TrimSpace(ev.Description),
+			Location:    sql.NullString{String: location, Valid: location != ""},
+			OccurredAt:  ev.OccurredAt.UTC(),
+		})
+		if err != nil {
+			return WebhookResponse{}, shipmenterrors.ErrShipmentFailed
+		}
+		res.EventsAdded += added
+
+		// 4. Event DELIVERED otomatis memindahkan order SHIPPED -> DELIVERED
+		if status == TrackingStatusDelivered {
+			if _, err := qtx.MarkDelivered(ctx, sh.ID, ev.OccurredAt.UTC()); err != nil {
+				return WebhookResponse{}, shipmenterrors.ErrShipmentFailed
+			}
+			if _, err := qtx.MarkOrderDelivered(ctx, sh.OrderID); err != nil {
+				return WebhookResponse{}, shipmenterrors.ErrShipmentFailed
+			}
+			res.Delivered = true
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return WebhookResponse{}, shipmenterrors.ErrShipmentFailed
+	}
+
+	return res, nil
+}
+
+func (s *service) Tracking(ctx context.Context, userID, orderID string) (TrackingResponse, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return TrackingResponse{}, shipmenterrors.ErrOrderNotFound
+	}
+	oid, err := uuid.Parse(orderID)
+	if err != nil {
+		return TrackingResponse{}, shipmenterrors.ErrInvalidOrderID
+	}
+
+	order, err := s.repo.GetOrder(ctx, oid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return TrackingResponse{}, shipmenterrors.ErrOrderNotFound
+		}
+		return TrackingResponse{}, shipmenterrors.ErrShipmentFailed
+	}
+	// Order milik user lain diperlakukan sama dengan tidak ada
+	if order.UserID != uid {
+		return TrackingResponse{}, shipmenterrors.ErrOrderNotFound
+	}
+
+	sh, err := s.repo.GetByOrderID(ctx, oid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return TrackingResponse{}, shipmenterrors.ErrShipmentNotFound
+		}
+		return TrackingResponse{}, shipmenterrors.ErrShipmentFailed
+	}
+
+	events, err := s.repo.ListEvents(ctx, sh.ID)
+	if err != nil {
+		return TrackingResponse{}, shipmenterrors.ErrShipmentFailed
+	}
+
+	res := TrackingResponse{
+		OrderID:     order.ID.String(),
+		OrderNumber: order.OrderNumber,
+		OrderStatus: order.Status,
+		Courier:     sh.Courier,
+		Service:     sh.Service,
+		ReceiptNo:   sh.ReceiptNo,
+		ShippedAt:   sh.ShippedAt,
+		Events:      make([]TrackingEventResponse, 0, len(events)),
+	}
+	if sh.DeliveredAt.Valid {
+		deliveredAt := sh.DeliveredAt.Time
+		res.DeliveredAt = &deliveredAt
+	}
+	for _, ev := range events {
+		res.Events = append(res.Events, TrackingEventResponse{
+			Status:      ev.Status,
+			Description: ev.Description,
+			Location:    ev.Location.String,
+			OccurredAt:  ev.OccurredAt,
+		})
+	}
+	return res, nil
+}
+
+// verifySignature cocokkan hex HMAC-SHA256 dari body mentah.
+// Secret kosong = webhook ditolak (fail closed).
+func (s *service) verifySignature(payload []byte, signature string) bool {
+	if len(s.webhookSecret) == 0 || signature == "" {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, s.webhookSecret)
+	mac.Write(payload)
+	expected := hex.EncodeToString(mac.Sum(nil))
+
+	return hmac.Equal([]byte(strings.ToLower(strings.TrimSpace(signature))), []byte(expected))
+}
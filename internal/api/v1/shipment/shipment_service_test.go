@@ -0,0 +1,231 @@
+package shipment_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	shipmentMock "go-sqlc-starter/internal/api/v1/mock/shipment"
+	"go-sqlc-starter/internal/api/v1/shipment"
+	shipmenterrors "go-sqlc-starter/internal/api/v1/shipment/errors"
+	"go-sqlc-starter/internal/dbgen"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+const webhookSecret = "courier-secret"
+
+type serviceDeps struct {
+	db      *sql.DB
+	sqlMock sqlmock.Sqlmock
+	service shipment.Service
+	repo    *shipmentMock.MockRepository
+}
+
+func setupServiceTest(t *testing.T) *serviceDeps {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	db, sqlMock, _ := sqlmock.New()
+	repo := shipmentMock.NewMockRepository(ctrl)
+
+	return &serviceDeps{
+		db:      db,
+		sqlMock: sqlMock,
+		service: shipment.NewService(db, repo, webhookSecret),
+		repo:    repo,
+	}
+}
+
+func expectTx(t *testing.T, mock sqlmock.Sqlmock, commit bool) {
+	t.Helper()
+
+	mock.ExpectBegin()
+	if commit {
+		mock.ExpectCommit()
+	} else {
+		mock.ExpectRollback()
+	}
+}
+
+func sign(payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(webhookSecret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestShipmentService_Create(t *testing.T) {
+	deps := setupServiceTest(t)
+	ctx := context.Background()
+
+	order := dbgen.Order{
+		ID:              uuid.New(),
+		ShippingCourier: sql.NullString{String: "JNE", Valid: true},
+		ShippingService: sql.NullString{String: "REG", Valid: true},
+	}
+
+	t.Run("success - courier and service taken from order", func(t *testing.T) {
+		deps.repo.EXPECT().Create(ctx, dbgen.CreateShipmentParams{
+			OrderID:   order.ID,
+			Courier:   "JNE",
+			Service:   "REG",
+			ReceiptNo: "JNE123",
+		}).Return(dbgen.Shipment{ID: uuid.New(), ReceiptNo: "JNE123"}, nil)
+
+		sh, err := deps.service.Create(ctx, nil, order, " JNE123 ")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "JNE123", sh.ReceiptNo)
+	})
+
+	t.Run("error - blank receipt", func(t *testing.T) {
+		_, err := deps.service.Create(ctx, nil, order, "   ")
+
+		assert.Equal(t, shipmenterrors.ErrReceiptRequired, err)
+	})
+}
+
+func TestShipmentService_Webhook(t *testing.T) {
+	ctx := context.Background()
+	occurredAt := time.Date(2026, 10, 1, 9, 30, 0, 0, time.UTC)
+	sh := dbgen.Shipment{ID: uuid.New(), OrderID: uuid.New(), Courier: "JNE", ReceiptNo: "JNE123"}
+
+	t.Run("success - events appended and order delivered", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		payload := []byte(`{"courier":"JNE","receiptNo":"JNE123","events":[` +
+			`{"status":"in_transit","description":"Sorting center","location":"Jakarta","occurredAt":"2026-10-01T08:00:00Z"},` +
+			`{"status":"DELIVERED","description":"Diterima","occurredAt":"2026-10-01T09:30:00Z"}]}`)
+
+		expectTx(t, deps.sqlMock, true)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		deps.repo.EXPECT().GetByReceiptForUpdate(ctx, "JNE", "JNE123").Return(sh, nil)
+		deps.repo.EXPECT().CreateEvent(ctx, dbgen.CreateShipmentTrackingEventParams{
+			ShipmentID:  sh.ID,
+			Status:      "IN_TRANSIT",
+			Description: "Sorting center",
+			Location:    sql.NullString{String: "Jakarta", Valid: true},
+			OccurredAt:  time.Date(2026, 10, 1, 8, 0, 0, 0, time.UTC),
+		}).Return(int64(1), nil)
+		deps.repo.EXPECT().CreateEvent(ctx, gomock.Any()).Return(int64(1), nil)
+		deps.repo.EXPECT().MarkDelivered(ctx, sh.ID, occurredAt).Return(int64(1), nil)
+		deps.repo.EXPECT().MarkOrderDelivered(ctx, sh.OrderID).Return(int64(1), nil)
+
+		res, err := deps.service.Webhook(ctx, payload, sign(payload))
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), res.EventsAdded)
+		assert.True(t, res.Delivered)
+		assert.NoError(t, deps.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("success - redelivered event is ignored", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		payload := []byte(`{"courier":"jne","receiptNo":"JNE123","events":[{"status":"IN_TRANSIT","occurredAt":"2026-10-01T08:00:00Z"}]}`)
+
+		expectTx(t, deps.sqlMock, true)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		deps.repo.EXPECT().GetByReceiptForUpdate(ctx, "jne", "JNE123").Return(sh, nil)
+		deps.repo.EXPECT().CreateEvent(ctx, gomock.Any()).Return(int64(0), nil)
+
+		res, err := deps.service.Webhook(ctx, payload, sign(payload))
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), res.EventsAdded)
+		assert.False(t, res.Delivered)
+	})
+
+	t.Run("error - invalid signature", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		payload := []byte(`{"courier":"JNE","receiptNo":"JNE123","events":[]}`)
+
+		_, err := deps.service.Webhook(ctx, payload, sign([]byte("tampered")))
+
+		assert.Equal(t, shipmenterrors.ErrInvalidSignature, err)
+	})
+
+	t.Run("error - secret not configured", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		svc := shipment.NewService(nil, shipmentMock.NewMockRepository(ctrl), "")
+		payload := []byte(`{}`)
+
+		_, err := svc.Webhook(ctx, payload, sign(payload))
+
+		assert.Equal(t, shipmenterrors.ErrInvalidSignature, err)
+	})
+
+	t.Run("error - payload without events", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		payload := []byte(`{"courier":"JNE","receiptNo":"JNE123","events":[]}`)
+
+		_, err := deps.service.Webhook(ctx, payload, sign(payload))
+
+		assert.Equal(t, shipmenterrors.ErrInvalidPayload, err)
+	})
+
+	t.Run("error - unknown receipt", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		payload := []byte(`{"courier":"JNE","receiptNo":"NOPE","events":[{"status":"IN_TRANSIT","occurredAt":"2026-10-01T08:00:00Z"}]}`)
+
+		expectTx(t, deps.sqlMock, false)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		deps.repo.EXPECT().GetByReceiptForUpdate(ctx, "JNE", "NOPE").Return(dbgen.Shipment{}, sql.ErrNoRows)
+
+		_, err := deps.service.Webhook(ctx, payload, sign(payload))
+
+		assert.Equal(t, shipmenterrors.ErrShipmentNotFound, err)
+	})
+}
+
+func TestShipmentService_Tracking(t *testing.T) {
+	deps := setupServiceTest(t)
+	ctx := context.Background()
+	userID, orderID := uuid.New(), uuid.New()
+	sh := dbgen.Shipment{ID: uuid.New(), OrderID: orderID, Courier: "JNE", Service: "REG", ReceiptNo: "JNE123"}
+
+	t.Run("success - timeline returned", func(t *testing.T) {
+		deps.repo.EXPECT().GetOrder(ctx, orderID).Return(dbgen.Order{ID: orderID, UserID: userID, Status: "SHIPPED"}, nil)
+		deps.repo.EXPECT().GetByOrderID(ctx, orderID).Return(sh, nil)
+		deps.repo.EXPECT().ListEvents(ctx, sh.ID).Return([]dbgen.ShipmentTrackingEvent{
+			{Status: "IN_TRANSIT", Location: sql.NullString{String: "Jakarta", Valid: true}},
+			{Status: "PICKED_UP"},
+		}, nil)
+
+		res, err := deps.service.Tracking(ctx, userID.String(), orderID.String())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "JNE123", res.ReceiptNo)
+		assert.Nil(t, res.DeliveredAt)
+		assert.Len(t, res.Events, 2)
+		assert.Equal(t, "Jakarta", res.Events[0].Location)
+	})
+
+	t.Run("error - order owned by another user", func(t *testing.T) {
+		deps.repo.EXPECT().GetOrder(ctx, orderID).Return(dbgen.Order{ID: orderID, UserID: uuid.New()}, nil)
+
+		_, err := deps.service.Tracking(ctx, userID.String(), orderID.String())
+
+		assert.Equal(t, shipmenterrors.ErrOrderNotFound, err)
+	})
+
+	t.Run("error - order not shipped yet", func(t *testing.T) {
+		deps.repo.EXPECT().GetOrder(ctx, orderID).Return(dbgen.Order{ID: orderID, UserID: userID, Status: "PAID"}, nil)
+		deps.repo.EXPECT().GetByOrderID(ctx, orderID).Return(dbgen.Shipment{}, sql.ErrNoRows)
+
+		_, err := deps.service.Tracking(ctx, userID.String(), orderID.String())
+
+		assert.Equal(t, shipmenterrors.ErrShipmentNotFound, err)
+	})
+
+	t.Run("error - invalid order id", func(t *testing.T) {
+		_, err := deps.service.Tracking(ctx, userID.String(), "not-a-uuid")
+
+		assert.Equal(t, shipmenterrors.ErrInvalidOrderID, err)
+	})
+}
@@ -0,0 +1,38 @@
+package shippingerrors
+
+import (
+	"go-sqlc-starter/internal/pkg/apperror"
+	"net/http"
+)
+
+var (
+	ErrInvalidAddressID = apperror.New(
+		apperror.CodeInvalidInput,
+		"Invalid address ID",
+		http.StatusBadRequest,
+	)
+
+	ErrAddressNotFound = apperror.New(
+		apperror.CodeNotFound,
+		"Address not found",
+		http.StatusNotFound,
+	)
+
+	ErrCartEmpty = apperror.New(
+		apperror.CodeInvalidState,
+		"Your shopping cart is empty",
+		http.StatusBadRequest,
+	)
+
+	ErrRateNotAvailable = apperror.New(
+		apperror.CodeInvalidState,
+		"Selected shipping service is not available for this address",
+		http.StatusBadRequest,
+	)
+
+	ErrShippingFailed = apperror.New(
+		apperror.CodeInternalError,
+		"Failed to calculate shipping rates",
+		http.StatusInternalServerError,
+	)
+)
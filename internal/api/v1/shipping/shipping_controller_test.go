@@ -0,0 +1,88 @@
+package shipping_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"go-sqlc-starter/internal/api/v1/cart"
+	"go-sqlc-starter/internal/api/v1/shipping"
+	shippingerrors "go-sqlc-starter/internal/api/v1/shipping/errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== FAKE SERVICE (Mock Manual) ====================
+
+type fakeShippingService struct {
+	quoteFunc func(ctx context.Context, userID string, req shipping.QuoteRequest) (shipping.QuoteResponse, error)
+}
+
+func (f *fakeShippingService) Quote(ctx context.Context, userID string, req shipping.QuoteRequest) (shipping.QuoteResponse, error) {
+	return f.quoteFunc(ctx, userID, req)
+}
+func (f *fakeShippingService) Select(ctx context.Context, userID, addressID string, items []cart.CartItemDetailResponse, courier, service string) (shipping.Rate, error) {
+	return shipping.Rate{}, nil
+}
+
+func performQuote(svc *fakeShippingService, userID string, body interface{}) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	if userID != "" {
+		c.Set("user_id", userID)
+	}
+
+	jsonBody, _ := json.Marshal(body)
+	c.Request = httptest.NewRequest(http.MethodPost, "/shipping/quote", bytes.NewBuffer(jsonBody))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	shipping.NewController(svc).Quote(c)
+	return w
+}
+
+func TestShippingController_Quote(t *testing.T) {
+	userID := uuid.New().String()
+	addressID := uuid.New().String()
+
+	t.Run("positive - rates returned", func(t *testing.T) {
+		svc := &fakeShippingService{
+			quoteFunc: func(ctx context.Context, u string, req shipping.QuoteRequest) (shipping.QuoteResponse, error) {
+				assert.Equal(t, userID, u)
+				assert.Equal(t, addressID, req.AddressID)
+				return shipping.QuoteResponse{
+					AddressID:   req.AddressID,
+					WeightGrams: 1500,
+					Rates:       []shipping.RateResponse{{Courier: "JNE", Service: "REG", Price: 18000}},
+				}, nil
+			},
+		}
+
+		w := performQuote(svc, userID, map[string]string{"addressId": addressID})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"courier":"JNE"`)
+	})
+
+	t.Run("negative - unauthenticated", func(t *testing.T) {
+		w := performQuote(&fakeShippingService{}, "", map[string]string{"addressId": addressID})
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("negative - address not found", func(t *testing.T) {
+		svc := &fakeShippingService{
+			quoteFunc: func(ctx context.Context, u string, req shipping.QuoteRequest) (shipping.QuoteResponse, error) {
+				return shipping.QuoteResponse{}, shippingerrors.ErrAddressNotFound
+			},
+		}
+
+		w := performQuote(svc, userID, map[string]string{"addressId": addressID})
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,22 @@
+package shipping
+
+// ==================== REQUEST STRUCTS ====================
+
+type QuoteRequest struct {
+	AddressID string `json:"addressId" validate:"required,uuid"`
+}
+
+// ==================== RESPONSE STRUCTS ====================
+
+type RateResponse struct {
+	Courier string  `json:"courier"`
+	Service string  `json:"service"`
+	Price   float64 `json:"price"`
+	Etd     string  `json:"etd,omitempty"`
+}
+
+type QuoteResponse struct {
+	AddressID   string         `json:"addressId"`
+	WeightGrams int32          `json:"weightGrams"`
+	Rates       []RateResponse `json:"rates"`
+}
@@ -0,0 +1,64 @@
+package shipping
+
+import (
+	"context"
+	"strconv"
+)
+
+// Destination tujuan pengiriman yang diambil dari alamat user
+type Destination struct {
+	Province   string
+	City       string
+	PostalCode string
+}
+
+// Rate satu pilihan kurir/layanan beserta ongkirnya
+type Rate struct {
+	Courier string
+	Service string
+	Price   float64
+	Etd     string
+}
+
+// RateProvider sumber tarif ongkir; bisa diganti dengan API kurir tanpa mengubah checkout
+//
+//go:generate mockgen -source=shipping_provider.go -destination=../mock/shipping/shipping_provider_mock.go -package=mock
+type RateProvider interface {
+	Rates(ctx context.Context, dest Destination, weightGrams int32) ([]Rate, error)
+}
+
+// tableRateProvider tarif dari tabel shipping_rates (zona province/city x tier berat)
+type tableRateProvider struct {
+	repo Repository
+}
+
+func NewTableRateProvider(r Repository) RateProvider {
+	return &tableRateProvider{repo: r}
+}
+
+func (p *tableRateProvider) Rates(ctx context.Context, dest Destination, weightGrams int32) ([]Rate, error) {
+	rows, err := p.repo.ListRatesForDestination(ctx, dest.Province, dest.City, weightGrams)
+	if err != nil {
+		return nil, err
+	}
+
+	// Query sudah mengurutkan tarif paling spesifik lebih dulu; ambil yang pertama per kurir/layanan
+	seen := make(map[string]bool, len(rows))
+	rates := make([]Rate, 0, len(rows))
+	for _, r := range rows {
+		key := r.Courier + "|" + r.Service
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		price, _ := strconv.ParseFloat(r.Price, 64)
+		rates = append(rates, Rate{
+			Courier: r.Courier,
+			Service: r.Service,
+			Price:   price,
+			Etd:     r.Etd.String,
+		})
+	}
+	return rates, nil
+}
@@ -0,0 +1,45 @@
+package shipping
+
+import (
+	"context"
+	"database/sql"
+	"go-sqlc-starter/internal/dbgen"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=shipping_repo.go -destination=../mock/shipping/shipping_repo_mock.go -package=mock
+type Repository interface {
+	WithTx(tx dbgen.DBTX) Repository
+	ListRatesForDestination(ctx context.Context, province, city string, weightGrams int32) ([]dbgen.ShippingRate, error)
+	ListProductWeights(ctx context.Context, productIDs []uuid.UUID) ([]dbgen.ListProductWeightsRow, error)
+}
+
+type repository struct {
+	queries *dbgen.Queries
+}
+
+func NewRepository(q *dbgen.Queries) Repository {
+	return &repository{queries: q}
+}
+
+func (r *repository) WithTx(tx dbgen.DBTX) Repository {
+	if sqlTx, ok := tx.(*sql.Tx); ok {
+		return &repository{
+			queries: r.queries.WithTx(sqlTx),
+		}
+	}
+	return r
+}
+
+func (r *repository) ListRatesForDestination(ctx context.Context, province, city string, weightGrams int32) ([]dbgen.ShippingRate, error) {
+	return r.queries.ListShippingRatesForDestination(ctx, dbgen.ListShippingRatesForDestinationParams{
+		Province:    province,
+		City:        city,
+		WeightGrams: weightGrams,
+	})
+}
+
+func (r *repository) ListProductWeights(ctx context.Context, productIDs []uuid.UUID) ([]dbgen.ListProductWeightsRow, error) {
+	return r.queries.ListProductWeights(ctx, productIDs)
+}
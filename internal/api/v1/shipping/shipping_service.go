@@ -0,0 +1,142 @@
+package shipping
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"go-sqlc-starter/internal/api/v1/address"
+	"go-sqlc-starter/internal/api/v1/cart"
+	shippingerrors "go-sqlc-starter/internal/api/v1/shipping/errors"
+	"go-sqlc-starter/internal/pkg/apperror"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=shipping_service.go -destination=../mock/shipping/shipping_service_mock.go -package=mock
+type Service interface {
+	// Quote semua pilihan ongkir untuk keranjang user ke alamat tertentu
+	Quote(ctx context.Context, userID string, req QuoteRequest) (QuoteResponse, error)
+
+	// Select hitung ulang ongkir kurir/layanan terpilih (dipakai checkout)
+	Select(ctx context.Context, userID, addressID string, items []cart.CartItemDetailResponse, courier, service string) (Rate, error)
+}
+
+type service struct {
+	repo        Repository
+	addressRepo address.Repository
+	cartSvc     cart.Service
+	provider    RateProvider
+	validate    *validator.Validate
+}
+
+func NewService(r Repository, addressRepo address.Repository, c cart.Service, p RateProvider) Service {
+	return &service{
+		repo:        r,
+		addressRepo: addressRepo,
+		cartSvc:     c,
+		provider:    p,
+		validate:    validator.New(),
+	}
+}
+
+func (s *service) Quote(ctx context.Context, userID string, req QuoteRequest) (QuoteResponse, error) {
+	if err := s.validate.Struct(req); err != nil {
+		return QuoteResponse{}, apperror.MapValidationError(err)
+	}
+
+	cartData, err := s.cartSvc.Detail(ctx, cart.UserOwner(userID))
+	if err != nil {
+		return QuoteResponse{}, err
+	}
+	if len(cartData.Items) == 0 {
+		return QuoteResponse{}, shippingerrors.ErrCartEmpty
+	}
+
+	weight, rates, err := s.rates(ctx, userID, req.AddressID, cartData.Items)
+	if err != nil {
+		return QuoteResponse{}, err
+	}
+
+	res := QuoteResponse{
+		AddressID:   req.AddressID,
+		WeightGrams: weight,
+		Rates:       make([]RateResponse, 0, len(rates)),
+	}
+	for _, r := range rates {
+		res.Rates = append(res.Rates, RateResponse{
+			Courier: r.Courier,
+			Service: r.Service,
+			Price:   r.Price,
+			Etd:     r.Etd,
+		})
+	}
+	return res, nil
+}
+
+func (s *service) Select(ctx context.Context, userID, addressID string, items []cart.CartItemDetailResponse, courier, service string) (Rate, error) {
+	_, rates, err := s.rates(ctx, userID, addressID, items)
+	if err != nil {
+		return Rate{}, err
+	}
+
+	for _, r := range rates {
+		if strings.EqualFold(r.Courier, courier) && strings.EqualFold(r.Service, service) {
+			return r, nil
+		}
+	}
+	return Rate{}, shippingerrors.ErrRateNotAvailable
+}
+
+// rates total berat keranjang + tarif dari provider untuk alamat milik user
+func (s *service) rates(ctx context.Context, userID, addressID string, items []cart.CartItemDetailResponse) (int32, []Rate, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return 0, nil, shippingerrors.ErrAddressNotFound
+	}
+	aid, err := uuid.Parse(addressID)
+	if err != nil {
+		return 0, nil, shippingerrors.ErrInvalidAddressID
+	}
+
+	// 1. Alamat harus milik user
+	addr, err := s.addressRepo.GetByID(ctx, aid, uid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil, shippingerrors.ErrAddressNotFound
+		}
+		return 0, nil, shippingerrors.ErrShippingFailed
+	}
+
+	// 2. Total berat = berat produk x qty
+	productIDs := make([]uuid.UUID, 0, len(items))
+	for _, item := range items {
+		pid, _ := uuid.Parse(item.ProductID)
+		productIDs = append(productIDs, pid)
+	}
+	rows, err := s.repo.ListProductWeights(ctx, productIDs)
+	if err != nil {
+		return 0, nil, shippingerrors.ErrShippingFailed
+	}
+	weights := make(map[string]int32, len(rows))
+	for _, r := range rows {
+		weights[r.ID.String()] = r.WeightGrams
+	}
+	var weight int32
+	for _, item := range items {
+		weight += weights[item.ProductID] * item.Qty
+	}
+
+	// 3. Tarif dari provider
+	rates, err := s.provider.Rates(ctx, Destination{
+		Province:   addr.Province.String,
+		City:       addr.City.String,
+		PostalCode: addr.PostalCode.String,
+	}, weight)
+	if err != nil {
+		return 0, nil, shippingerrors.ErrShippingFailed
+	}
+
+	return weight, rates, nil
+}
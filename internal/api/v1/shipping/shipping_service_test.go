@@ -0,0 +1,160 @@
+package shipping_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"go-sqlc-starter/internal/api/v1/cart"
+	addressMock "go-sqlc-starter/internal/api/v1/mock/address"
+	cartMock "go-sqlc-starter/internal/api/v1/mock/cart"
+	shippingMock "go-sqlc-starter/internal/api/v1/mock/shipping"
+	"go-sqlc-starter/internal/api/v1/shipping"
+	shippingerrors "go-sqlc-starter/internal/api/v1/shipping/errors"
+	"go-sqlc-starter/internal/dbgen"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type serviceDeps struct {
+	service     shipping.Service
+	repo        *shippingMock.MockRepository
+	addressRepo *addressMock.MockRepository
+	cartSvc     *cartMock.MockService
+	provider    *shippingMock.MockRateProvider
+}
+
+func setupServiceTest(t *testing.T) *serviceDeps {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	repo := shippingMock.NewMockRepository(ctrl)
+	addressRepo := addressMock.NewMockRepository(ctrl)
+	cartSvc := cartMock.NewMockService(ctrl)
+	provider := shippingMock.NewMockRateProvider(ctrl)
+
+	return &serviceDeps{
+		service:     shipping.NewService(repo, addressRepo, cartSvc, provider),
+		repo:        repo,
+		addressRepo: addressRepo,
+		cartSvc:     cartSvc,
+		provider:    provider,
+	}
+}
+
+func TestShippingService_Quote(t *testing.T) {
+	deps := setupServiceTest(t)
+
+	ctx := context.Background()
+	userID, addressID := uuid.New(), uuid.New()
+	shoe, shirt := uuid.New(), uuid.New()
+	items := []cart.CartItemDetailResponse{
+		{ProductID: shoe.String(), Qty: 2},
+		{ProductID: shirt.String(), Qty: 1},
+	}
+
+	t.Run("success - weight summed from cart and destination from address", func(t *testing.T) {
+		deps.cartSvc.EXPECT().Detail(ctx, cart.UserOwner(userID.String())).Return(cart.CartDetailResponse{Items: items}, nil)
+		deps.addressRepo.EXPECT().GetByID(ctx, addressID, userID).Return(dbgen.Address{
+			Province: sql.NullString{String: "Jawa Barat", Valid: true},
+			City:     sql.NullString{String: "Bandung", Valid: true},
+		}, nil)
+		deps.repo.EXPECT().ListProductWeights(ctx, []uuid.UUID{shoe, shirt}).Return([]dbgen.ListProductWeightsRow{
+			{ID: shoe, WeightGrams: 800},
+			{ID: shirt, WeightGrams: 300},
+		}, nil)
+		deps.provider.EXPECT().
+			Rates(ctx, shipping.Destination{Province: "Jawa Barat", City: "Bandung"}, int32(1900)).
+			Return([]shipping.Rate{{Courier: "JNE", Service: "REG", Price: 18000, Etd: "2-3"}}, nil)
+
+		res, err := deps.service.Quote(ctx, userID.String(), shipping.QuoteRequest{AddressID: addressID.String()})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int32(1900), res.WeightGrams)
+		assert.Len(t, res.Rates, 1)
+		assert.Equal(t, 18000.0, res.Rates[0].Price)
+	})
+
+	t.Run("error - address belongs to another user", func(t *testing.T) {
+		deps.cartSvc.EXPECT().Detail(ctx, cart.UserOwner(userID.String())).Return(cart.CartDetailResponse{Items: items}, nil)
+		deps.addressRepo.EXPECT().GetByID(ctx, addressID, userID).Return(dbgen.Address{}, sql.ErrNoRows)
+
+		_, err := deps.service.Quote(ctx, userID.String(), shipping.QuoteRequest{AddressID: addressID.String()})
+
+		assert.ErrorIs(t, err, shippingerrors.ErrAddressNotFound)
+	})
+
+	t.Run("error - empty cart", func(t *testing.T) {
+		deps.cartSvc.EXPECT().Detail(ctx, cart.UserOwner(userID.String())).Return(cart.CartDetailResponse{}, nil)
+
+		_, err := deps.service.Quote(ctx, userID.String(), shipping.QuoteRequest{AddressID: addressID.String()})
+
+		assert.ErrorIs(t, err, shippingerrors.ErrCartEmpty)
+	})
+}
+
+func TestShippingService_Select(t *testing.T) {
+	deps := setupServiceTest(t)
+
+	ctx := context.Background()
+	userID, addressID, productID := uuid.New(), uuid.New(), uuid.New()
+	items := []cart.CartItemDetailResponse{{ProductID: productID.String(), Qty: 1}}
+
+	expectRates := func() {
+		deps.addressRepo.EXPECT().GetByID(ctx, addressID, userID).Return(dbgen.Address{}, nil)
+		deps.repo.EXPECT().ListProductWeights(ctx, gomock.Any()).Return([]dbgen.ListProductWeightsRow{{ID: productID, WeightGrams: 500}}, nil)
+		deps.provider.EXPECT().Rates(ctx, gomock.Any(), int32(500)).Return([]shipping.Rate{
+			{Courier: "JNE", Service: "REG", Price: 10000},
+			{Courier: "JNE", Service: "YES", Price: 20000},
+		}, nil)
+	}
+
+	t.Run("success - courier and service matched case-insensitively", func(t *testing.T) {
+		expectRates()
+
+		rate, err := deps.service.Select(ctx, userID.String(), addressID.String(), items, "jne", "yes")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 20000.0, rate.Price)
+	})
+
+	t.Run("error - service not offered for destination", func(t *testing.T) {
+		expectRates()
+
+		_, err := deps.service.Select(ctx, userID.String(), addressID.String(), items, "SICEPAT", "BEST")
+
+		assert.ErrorIs(t, err, shippingerrors.ErrRateNotAvailable)
+	})
+
+	t.Run("error - invalid address id", func(t *testing.T) {
+		_, err := deps.service.Select(ctx, userID.String(), "not-a-uuid", items, "JNE", "REG")
+
+		assert.ErrorIs(t, err, shippingerrors.ErrInvalidAddressID)
+	})
+}
+
+func TestTableRateProvider_Rates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := shippingMock.NewMockRepository(ctrl)
+	provider := shipping.NewTableRateProvider(repo)
+	ctx := context.Background()
+
+	t.Run("most specific zone wins per courier service", func(t *testing.T) {
+		// Urutan dari query: city > province > default
+		repo.EXPECT().ListRatesForDestination(ctx, "Jawa Barat", "Bandung", int32(1200)).Return([]dbgen.ShippingRate{
+			{Courier: "JNE", Service: "REG", Price: "12000.00", City: sql.NullString{String: "Bandung", Valid: true}},
+			{Courier: "JNE", Service: "REG", Price: "15000.00"},
+			{Courier: "JNE", Service: "YES", Price: "25000.00", Etd: sql.NullString{String: "1", Valid: true}},
+		}, nil)
+
+		rates, err := provider.Rates(ctx, shipping.Destination{Province: "Jawa Barat", City: "Bandung"}, 1200)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []shipping.Rate{
+			{Courier: "JNE", Service: "REG", Price: 12000},
+			{Courier: "JNE", Service: "YES", Price: 25000, Etd: "1"},
+		}, rates)
+	})
+}
@@ -0,0 +1,32 @@
+package subscriptionerrors
+
+import (
+	"go-sqlc-starter/internal/pkg/apperror"
+	"net/http"
+)
+
+var (
+	ErrInvalidSubscriptionID = apperror.New(
+		apperror.CodeInvalidInput,
+		"Invalid subscription id",
+		http.StatusBadRequest,
+	)
+
+	ErrSubscriptionNotFound = apperror.New(
+		apperror.CodeNotFound,
+		"Subscription not found",
+		http.StatusNotFound,
+	)
+
+	ErrAlreadyInStock = apperror.New(
+		apperror.CodeInvalidState,
+		"Product is already in stock",
+		http.StatusBadRequest,
+	)
+
+	ErrSubscriptionFailed = apperror.New(
+		apperror.CodeInternalError,
+		"Failed to process subscription",
+		http.StatusInternalServerError,
+	)
+)
@@ -0,0 +1,117 @@
+package subscription_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go-sqlc-starter/internal/api/v1/subscription"
+	subscriptionerrors "go-sqlc-starter/internal/api/v1/subscription/errors"
+	"go-sqlc-starter/internal/dbgen"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== FAKE SERVICE (Mock Manual) ====================
+
+type fakeSubscriptionService struct {
+	subscribeFn   func(ctx context.Context, userID string, req subscription.SubscribeRequest) (subscription.SubscriptionResponse, error)
+	unsubscribeFn func(ctx context.Context, userID, subscriptionID string) error
+}
+
+func (f *fakeSubscriptionService) Subscribe(ctx context.Context, userID string, req subscription.SubscribeRequest) (subscription.SubscriptionResponse, error) {
+	return f.subscribeFn(ctx, userID, req)
+}
+func (f *fakeSubscriptionService) Unsubscribe(ctx context.Context, userID, subscriptionID string) error {
+	return f.unsubscribeFn(ctx, userID, subscriptionID)
+}
+func (f *fakeSubscriptionService) ListMine(ctx context.Context, userID string) ([]subscription.SubscriptionResponse, error) {
+	return nil, nil
+}
+func (f *fakeSubscriptionService) OnStockChanged(ctx context.Context, tx dbgen.DBTX, productID uuid.UUID, stockBefore, stockAfter int32) error {
+	return nil
+}
+func (f *fakeSubscriptionService) OnPriceChanged(ctx context.Context, tx dbgen.DBTX, productID uuid.UUID) error {
+	return nil
+}
+func (f *fakeSubscriptionService) EnqueueDuePriceDrops(ctx context.Context) (int, error) {
+	return 0, nil
+}
+func (f *fakeSubscriptionService) DeliverPending(ctx context.Context, limit int32) (int, int, error) {
+	return 0, 0, nil
+}
+
+func TestSubscriptionController_Subscribe(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	perform := func(svc *fakeSubscriptionService, userID, body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		if userID != "" {
+			c.Set("user_id", userID)
+		}
+		c.Request = httptest.NewRequest(http.MethodPost, "/subscriptions", bytes.NewBufferString(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		subscription.NewController(svc).Subscribe(c)
+		return w
+	}
+
+	t.Run("positive - created", func(t *testing.T) {
+		svc := &fakeSubscriptionService{
+			subscribeFn: func(ctx context.Context, userID string, req subscription.SubscribeRequest) (subscription.SubscriptionResponse, error) {
+				assert.Equal(t, "user-1", userID)
+				assert.Equal(t, "BACK_IN_STOCK", req.Type)
+				return subscription.SubscriptionResponse{ID: "s-1", Type: req.Type}, nil
+			},
+		}
+
+		w := perform(svc, "user-1", `{"productId":"p-1","type":"BACK_IN_STOCK"}`)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Contains(t, w.Body.String(), `"id":"s-1"`)
+	})
+
+	t.Run("negative - already in stock", func(t *testing.T) {
+		svc := &fakeSubscriptionService{
+			subscribeFn: func(ctx context.Context, userID string, req subscription.SubscribeRequest) (subscription.SubscriptionResponse, error) {
+				return subscription.SubscriptionResponse{}, subscriptionerrors.ErrAlreadyInStock
+			},
+		}
+
+		w := perform(svc, "user-1", `{"productId":"p-1","type":"BACK_IN_STOCK"}`)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("negative - not authenticated", func(t *testing.T) {
+		w := perform(&fakeSubscriptionService{}, "", `{}`)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestSubscriptionController_Unsubscribe(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	svc := &fakeSubscriptionService{
+		unsubscribeFn: func(ctx context.Context, userID, subscriptionID string) error {
+			assert.Equal(t, "s-1", subscriptionID)
+			return subscriptionerrors.ErrSubscriptionNotFound
+		},
+	}
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Set("user_id", "user-1")
+	c.Params = gin.Params{{Key: "id", Value: "s-1"}}
+	c.Request = httptest.NewRequest(http.MethodDelete, "/subscriptions/s-1", nil)
+
+	subscription.NewController(svc).Unsubscribe(c)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
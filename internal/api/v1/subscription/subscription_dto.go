@@ -0,0 +1,23 @@
+package subscription
+
+import "time"
+
+// ==================== REQUEST STRUCTS ====================
+
+type SubscribeRequest struct {
+	ProductID string `json:"productId" validate:"required,uuid"`
+	Type      string `json:"type" validate:"required,oneof=BACK_IN_STOCK PRICE_DROP"`
+}
+
+// ==================== RESPONSE STRUCTS ====================
+
+type SubscriptionResponse struct {
+	ID               string     `json:"id"`
+	ProductID        string     `json:"productId"`
+	ProductName      string     `json:"productName,omitempty"`
+	ProductSlug      string     `json:"productSlug,omitempty"`
+	Type             string     `json:"type"`
+	PriceAtSubscribe float64    `json:"priceAtSubscribe"`
+	NotifiedAt       *time.Time `json:"notifiedAt,omitempty"`
+	CreatedAt        time.Time  `json:"createdAt"`
+}
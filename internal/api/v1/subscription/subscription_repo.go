@@ -0,0 +1,91 @@
+package subscription
+
+import (
+	"context"
+	"database/sql"
+	"go-sqlc-starter/internal/dbgen"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=subscription_repo.go -destination=../mock/subscription/subscription_repo_mock.go -package=mock
+type Repository interface {
+	WithTx(tx dbgen.DBTX) Repository
+
+	GetProduct(ctx context.Context, productID uuid.UUID) (dbgen.GetSubscribableProductRow, error)
+	Upsert(ctx context.Context, arg dbgen.UpsertProductSubscriptionParams) (dbgen.ProductSubscription, error)
+	Delete(ctx context.Context, id, userID uuid.UUID) (int64, error)
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]dbgen.ListProductSubscriptionsByUserRow, error)
+
+	// Hook stok / harga
+	ClaimBackInStock(ctx context.Context, productID uuid.UUID) ([]dbgen.ClaimBackInStockSubscriptionsRow, error)
+	ClaimPriceDrop(ctx context.Context, productID uuid.NullUUID) ([]dbgen.ClaimPriceDropSubscriptionsRow, error)
+
+	// Outbox notifikasi
+	CreateNotification(ctx context.Context, arg dbgen.CreateNotificationParams) error
+	ListPendingNotifications(ctx context.Context, limit int32) ([]dbgen.Notification, error)
+	MarkSent(ctx context.Context, id uuid.UUID) error
+	MarkFailed(ctx context.Context, id uuid.UUID, reason string, maxAttempts int32) error
+}
+
+type repository struct {
+	q *dbgen.Queries
+}
+
+func NewRepository(q *dbgen.Queries) Repository {
+	return &repository{q: q}
+}
+
+func (r *repository) WithTx(tx dbgen.DBTX) Repository {
+	if sqlTx, ok := tx.(*sql.Tx); ok {
+		return &repository{q: r.q.WithTx(sqlTx)}
+	}
+	return r
+}
+
+func (r *repository) GetProduct(ctx context.Context, productID uuid.UUID) (dbgen.GetSubscribableProductRow, error) {
+	return r.q.GetSubscribableProduct(ctx, productID)
+}
+
+func (r *repository) Upsert(ctx context.Context, arg dbgen.UpsertProductSubscriptionParams) (dbgen.ProductSubscription, error) {
+	return r.q.UpsertProductSubscription(ctx, arg)
+}
+
+func (r *repository) Delete(ctx context.Context, id, userID uuid.UUID) (int64, error) {
+	return r.q.DeleteProductSubscription(ctx, dbgen.DeleteProductSubscriptionParams{
+		ID:     id,
+		UserID: userID,
+	})
+}
+
+func (r *repository) ListByUser(ctx context.Context, userID uuid.UUID) ([]dbgen.ListProductSubscriptionsByUserRow, error) {
+	return r.q.ListProductSubscriptionsByUser(ctx, userID)
+}
+
+func (r *repository) ClaimBackInStock(ctx context.Context, productID uuid.UUID) ([]dbgen.ClaimBackInStockSubscriptionsRow, error) {
+	return r.q.ClaimBackInStockSubscriptions(ctx, productID)
+}
+
+func (r *repository) ClaimPriceDrop(ctx context.Context, productID uuid.NullUUID) ([]dbgen.ClaimPriceDropSubscriptionsRow, error) {
+	return r.q.ClaimPriceDropSubscriptions(ctx, productID)
+}
+
+func (r *repository) CreateNotification(ctx context.Context, arg dbgen.CreateNotificationParams) error {
+	return r.q.CreateNotification(ctx, arg)
+}
+
+func (r *repository) ListPendingNotifications(ctx context.Context, limit int32) ([]dbgen.Notification, error) {
+	return r.q.ListPendingNotifications(ctx, limit)
+}
+
+func (r *repository) MarkSent(ctx context.Context, id uuid.UUID) error {
+	return r.q.MarkNotificationSent(ctx, id)
+}
+
+func (r *repository) MarkFailed(ctx context.Context, id uuid.UUID, reason string, maxAttempts int32) error {
+	return r.q.MarkNotificationFailed(ctx, dbgen.MarkNotificationFailedParams{
+		ID:          id,
+		LastError:   sql.NullString{String: reason, Valid: reason != ""},
+		MaxAttempts: maxAttempts,
+	})
+}
@@ -0,0 +1,262 @@
+package subscription
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	autherrors "go-sqlc-starter/internal/api/v1/auth/errors"
+	producterrors "go-sqlc-starter/internal/api/v1/product/errors"
+	subscriptionerrors "go-sqlc-starter/internal/api/v1/subscription/errors"
+	"go-sqlc-starter/internal/dbgen"
+	"go-sqlc-starter/internal/pkg/apperror"
+	"go-sqlc-starter/internal/pkg/constants"
+	"go-sqlc-starter/internal/pkg/notifier"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=subscription_service.go -destination=../mock/subscription/subscription_service_mock.go -package=mock
+type Service interface {
+	// Customer
+	Subscribe(ctx context.Context, userID string, req SubscribeRequest) (SubscriptionResponse, error)
+	Unsubscribe(ctx context.Context, userID, subscriptionID string) error
+	ListMine(ctx context.Context, userID string) ([]SubscriptionResponse, error)
+
+	// Hook dari inventory / product: antre notifikasi di transaksi milik caller
+	OnStockChanged(ctx context.Context, tx dbgen.DBTX, productID uuid.UUID, stockBefore, stockAfter int32) error
+	OnPriceChanged(ctx context.Context, tx dbgen.DBTX, productID uuid.UUID) error
+
+	// Job
+	EnqueueDuePriceDrops(ctx context.Context) (int, error)
+	DeliverPending(ctx context.Context, limit int32) (sent int, failed int, err error)
+}
+
+type service struct {
+	repo     Repository
+	notifier notifier.Notifier
+	validate *validator.Validate
+}
+
+func NewService(r Repository, n notifier.Notifier) Service {
+	return &service{
+		repo:     r,
+		notifier: n,
+		validate: validator.New(),
+	}
+}
+
+// ==================== CUSTOMER ====================
+
+func (s *service) Subscribe(ctx context.Context, userID string, req SubscribeRequest) (SubscriptionResponse, error) {
+	if err := s.validate.Struct(req); err != nil {
+		return SubscriptionResponse{}, apperror.MapValidationError(err)
+	}
+
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return SubscriptionResponse{}, autherrors.ErrInvalidUserID
+	}
+	pid, err := uuid.Parse(req.ProductID)
+	if err != nil {
+		return SubscriptionResponse{}, producterrors.ErrInvalidProductID
+	}
+
+	// 1. Produk harus ada; harga aktif jadi acuan PRICE_DROP
+	p, err := s.repo.GetProduct(ctx, pid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return SubscriptionResponse{}, producterrors.ErrProductNotFound
+		}
+		return SubscriptionResponse{}, subscriptionerrors.ErrSubscriptionFailed
+	}
+
+	// 2. Stok masih ada -> tidak ada yang perlu ditunggu
+	if req.Type == constants.SubscriptionBackInStock && p.Stock > 0 {
+		return SubscriptionResponse{}, subscriptionerrors.ErrAlreadyInStock
+	}
+
+	sub, err := s.repo.Upsert(ctx, dbgen.UpsertProductSubscriptionParams{
+		UserID:           uid,
+		ProductID:        pid,
+		Type:             req.Type,
+		PriceAtSubscribe: p.CurrentPrice,
+	})
+	if err != nil {
+		return SubscriptionResponse{}, subscriptionerrors.ErrSubscriptionFailed
+	}
+
+	price, _ := strconv.ParseFloat(sub.PriceAtSubscribe, 64)
+	return SubscriptionResponse{
+		ID:               sub.ID.String(),
+		ProductID:        sub.ProductID.String(),
+		ProductName:      p.Name,
+		Type:             sub.Type,
+		PriceAtSubscribe: price,
+		CreatedAt:        sub.CreatedAt,
+	}, nil
+}
+
+func (s *service) Unsubscribe(ctx context.Context, userID, subscriptionID string) error {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return autherrors.ErrInvalidUserID
+	}
+	id, err := uuid.Parse(subscriptionID)
+	if err != nil {
+		return subscriptionerrors.ErrInvalidSubscriptionID
+	}
+
+	// Hanya milik sendiri; milik user lain diperlakukan tidak ada
+	n, err := s.repo.Delete(ctx, id, uid)
+	if err != nil {
+		return subscriptionerrors.ErrSubscriptionFailed
+	}
+	if n == 0 {
+		return subscriptionerrors.ErrSubscriptionNotFound
+	}
+	return nil
+}
+
+func (s *service) ListMine(ctx context.Context, userID string) ([]SubscriptionResponse, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, autherrors.ErrInvalidUserID
+	}
+
+	rows, err := s.repo.ListByUser(ctx, uid)
+	if err != nil {
+		return nil, subscriptionerrors.ErrSubscriptionFailed
+	}
+
+	res := make([]SubscriptionResponse, 0, len(rows))
+	for _, r := range rows {
+		price, _ := strconv.ParseFloat(r.PriceAtSubscribe, 64)
+		item := SubscriptionResponse{
+			ID:               r.ID.String(),
+			ProductID:        r.ProductID.String(),
+			ProductName:      r.ProductName,
+			ProductSlug:      r.ProductSlug,
+			Type:             r.Type,
+			PriceAtSubscribe: price,
+			CreatedAt:        r.CreatedAt,
+		}
+		if r.NotifiedAt.Valid {
+			item.NotifiedAt = &r.NotifiedAt.Time
+		}
+		res = append(res, item)
+	}
+	return res, nil
+}
+
+// ==================== HOOKS ====================
+
+// OnStockChanged antre notifikasi BACK_IN_STOCK saat stok kembali dari 0
+func (s *service) OnStockChanged(ctx context.Context, tx dbgen.DBTX, productID uuid.UUID, stockBefore, stockAfter int32) error {
+	if stockBefore > 0 || stockAfter <= 0 {
+		return nil
+	}
+
+	qtx := s.repo
+	if tx != nil {
+		qtx = s.repo.WithTx(tx)
+	}
+
+	subs, err := qtx.ClaimBackInStock(ctx, productID)
+	if err != nil {
+		return err
+	}
+	for _, sub := range subs {
+		if err := qtx.CreateNotification(ctx, dbgen.CreateNotificationParams{
+			UserID:    uuid.NullUUID{UUID: sub.UserID, Valid: true},
+			Channel:   notifier.ChannelEmail,
+			Type:      constants.SubscriptionBackInStock,
+			Recipient: sub.Email,
+			Subject:   fmt.Sprintf("%s is back in stock", sub.ProductName),
+			Body: fmt.Sprintf(
+				"Good news! %s is available again.\n\nGet it before it runs out: /products/slug/%s",
+				sub.ProductName, sub.ProductSlug,
+			),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OnPriceChanged antre notifikasi PRICE_DROP jika harga aktif di bawah harga saat subscribe
+func (s *service) OnPriceChanged(ctx context.Context, tx dbgen.DBTX, productID uuid.UUID) error {
+	qtx := s.repo
+	if tx != nil {
+		qtx = s.repo.WithTx(tx)
+	}
+
+	_, err := s.enqueuePriceDrops(ctx, qtx, uuid.NullUUID{UUID: productID, Valid: true})
+	return err
+}
+
+// EnqueueDuePriceDrops sapu semua produk: jadwal harga (product_prices) yang baru mulai
+// tidak lewat hook mana pun
+func (s *service) EnqueueDuePriceDrops(ctx context.Context) (int, error) {
+	return s.enqueuePriceDrops(ctx, s.repo, uuid.NullUUID{})
+}
+
+func (s *service) enqueuePriceDrops(ctx context.Context, qtx Repository, productID uuid.NullUUID) (int, error) {
+	subs, err := qtx.ClaimPriceDrop(ctx, productID)
+	if err != nil {
+		return 0, err
+	}
+	for _, sub := range subs {
+		oldPrice, _ := strconv.ParseFloat(sub.PriceAtSubscribe, 64)
+		newPrice, _ := strconv.ParseFloat(sub.CurrentPrice, 64)
+
+		if err := qtx.CreateNotification(ctx, dbgen.CreateNotificationParams{
+			UserID:    uuid.NullUUID{UUID: sub.UserID, Valid: true},
+			Channel:   notifier.ChannelEmail,
+			Type:      constants.SubscriptionPriceDrop,
+			Recipient: sub.Email,
+			Subject:   fmt.Sprintf("Price drop: %s", sub.ProductName),
+			Body: fmt.Sprintf(
+				"%s is now %.0f (was %.0f).\n\nSee the product: /products/slug/%s",
+				sub.ProductName, newPrice, oldPrice, sub.ProductSlug,
+			),
+		}); err != nil {
+			return 0, err
+		}
+	}
+	return len(subs), nil
+}
+
+// ==================== DELIVERY ====================
+
+// DeliverPending kirim outbox PENDING lewat notifier; gagal kirim dicoba lagi di run berikutnya
+func (s *service) DeliverPending(ctx context.Context, limit int32) (int, int, error) {
+	rows, err := s.repo.ListPendingNotifications(ctx, limit)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	sent, failed := 0, 0
+	for _, n := range rows {
+		sendErr := s.notifier.Send(ctx, notifier.Message{
+			Channel:   n.Channel,
+			Recipient: n.Recipient,
+			Subject:   n.Subject,
+			Body:      n.Body,
+		})
+		if sendErr != nil {
+			failed++
+			if err := s.repo.MarkFailed(ctx, n.ID, sendErr.Error(), constants.NotificationMaxAttempts); err != nil {
+				return sent, failed, err
+			}
+			continue
+		}
+
+		sent++
+		if err := s.repo.MarkSent(ctx, n.ID); err != nil {
+			return sent, failed, err
+		}
+	}
+	return sent, failed, nil
+}
@@ -0,0 +1,227 @@
+package subscription_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	subscriptionMock "go-sqlc-starter/internal/api/v1/mock/subscription"
+	producterrors "go-sqlc-starter/internal/api/v1/product/errors"
+	"go-sqlc-starter/internal/api/v1/subscription"
+	subscriptionerrors "go-sqlc-starter/internal/api/v1/subscription/errors"
+	"go-sqlc-starter/internal/dbgen"
+	"go-sqlc-starter/internal/pkg/constants"
+	"go-sqlc-starter/internal/pkg/notifier"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type serviceDeps struct {
+	service subscription.Service
+	repo    *subscriptionMock.MockRepository
+	mailer  *notifier.FakeMailer
+}
+
+func setupServiceTest(t *testing.T) *serviceDeps {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	repo := subscriptionMock.NewMockRepository(ctrl)
+	mailer := notifier.NewFakeMailer()
+
+	return &serviceDeps{
+		service: subscription.NewService(repo, notifier.NewEmailNotifier(mailer)),
+		repo:    repo,
+		mailer:  mailer,
+	}
+}
+
+func TestSubscriptionService_Subscribe(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	productID := uuid.New()
+
+	t.Run("price drop - current price recorded", func(t *testing.T) {
+		deps := setupServiceTest(t)
+
+		deps.repo.EXPECT().GetProduct(ctx, productID).Return(dbgen.GetSubscribableProductRow{
+			ID: productID, Name: "Kaos", Stock: 3, CurrentPrice: "80000.00",
+		}, nil)
+		deps.repo.EXPECT().Upsert(ctx, dbgen.UpsertProductSubscriptionParams{
+			UserID: userID, ProductID: productID, Type: constants.SubscriptionPriceDrop, PriceAtSubscribe: "80000.00",
+		}).Return(dbgen.ProductSubscription{
+			ID: uuid.New(), ProductID: productID, Type: constants.SubscriptionPriceDrop, PriceAtSubscribe: "80000.00",
+		}, nil)
+
+		res, err := deps.service.Subscribe(ctx, userID.String(), subscription.SubscribeRequest{
+			ProductID: productID.String(), Type: constants.SubscriptionPriceDrop,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 80000.0, res.PriceAtSubscribe)
+		assert.Equal(t, "Kaos", res.ProductName)
+	})
+
+	t.Run("back in stock - product still in stock", func(t *testing.T) {
+		deps := setupServiceTest(t)
+
+		deps.repo.EXPECT().GetProduct(ctx, productID).Return(dbgen.GetSubscribableProductRow{Stock: 2}, nil)
+
+		_, err := deps.service.Subscribe(ctx, userID.String(), subscription.SubscribeRequest{
+			ProductID: productID.String(), Type: constants.SubscriptionBackInStock,
+		})
+
+		assert.Equal(t, subscriptionerrors.ErrAlreadyInStock, err)
+	})
+
+	t.Run("product not found", func(t *testing.T) {
+		deps := setupServiceTest(t)
+
+		deps.repo.EXPECT().GetProduct(ctx, productID).Return(dbgen.GetSubscribableProductRow{}, sql.ErrNoRows)
+
+		_, err := deps.service.Subscribe(ctx, userID.String(), subscription.SubscribeRequest{
+			ProductID: productID.String(), Type: constants.SubscriptionBackInStock,
+		})
+
+		assert.Equal(t, producterrors.ErrProductNotFound, err)
+	})
+
+	t.Run("invalid type", func(t *testing.T) {
+		deps := setupServiceTest(t)
+
+		_, err := deps.service.Subscribe(ctx, userID.String(), subscription.SubscribeRequest{
+			ProductID: productID.String(), Type: "RESTOCK",
+		})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestSubscriptionService_Unsubscribe(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	subID := uuid.New()
+
+	deps := setupServiceTest(t)
+
+	// Milik user lain -> 0 row
+	deps.repo.EXPECT().Delete(ctx, subID, userID).Return(int64(0), nil)
+
+	err := deps.service.Unsubscribe(ctx, userID.String(), subID.String())
+
+	assert.Equal(t, subscriptionerrors.ErrSubscriptionNotFound, err)
+}
+
+func TestSubscriptionService_OnStockChanged(t *testing.T) {
+	ctx := context.Background()
+	productID := uuid.New()
+
+	t.Run("zero to positive - notification queued", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		userID := uuid.New()
+
+		deps.repo.EXPECT().ClaimBackInStock(ctx, productID).Return([]dbgen.ClaimBackInStockSubscriptionsRow{
+			{ID: uuid.New(), UserID: userID, Email: "a@mail.com", ProductName: "Kaos", ProductSlug: "kaos"},
+		}, nil)
+		deps.repo.EXPECT().
+			CreateNotification(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, arg dbgen.CreateNotificationParams) error {
+				assert.Equal(t, "a@mail.com", arg.Recipient)
+				assert.Equal(t, constants.SubscriptionBackInStock, arg.Type)
+				assert.Equal(t, notifier.ChannelEmail, arg.Channel)
+				assert.Equal(t, userID, arg.UserID.UUID)
+				assert.Contains(t, arg.Body, "/products/slug/kaos")
+				return nil
+			})
+
+		err := deps.service.OnStockChanged(ctx, nil, productID, 0, 4)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("still in stock - nothing to do", func(t *testing.T) {
+		deps := setupServiceTest(t)
+
+		err := deps.service.OnStockChanged(ctx, nil, productID, 2, 6)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("sold out - nothing to do", func(t *testing.T) {
+		deps := setupServiceTest(t)
+
+		err := deps.service.OnStockChanged(ctx, nil, productID, 1, 0)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestSubscriptionService_OnPriceChanged(t *testing.T) {
+	ctx := context.Background()
+	productID := uuid.New()
+	deps := setupServiceTest(t)
+
+	deps.repo.EXPECT().
+		ClaimPriceDrop(ctx, uuid.NullUUID{UUID: productID, Valid: true}).
+		Return([]dbgen.ClaimPriceDropSubscriptionsRow{
+			{UserID: uuid.New(), Email: "a@mail.com", ProductName: "Kaos", PriceAtSubscribe: "100000.00", CurrentPrice: "75000.00"},
+		}, nil)
+	deps.repo.EXPECT().
+		CreateNotification(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, arg dbgen.CreateNotificationParams) error {
+			assert.Equal(t, constants.SubscriptionPriceDrop, arg.Type)
+			assert.Contains(t, arg.Body, "75000")
+			assert.Contains(t, arg.Body, "100000")
+			return nil
+		})
+
+	err := deps.service.OnPriceChanged(ctx, nil, productID)
+
+	assert.NoError(t, err)
+}
+
+func TestSubscriptionService_DeliverPending(t *testing.T) {
+	ctx := context.Background()
+
+	pending := []dbgen.Notification{
+		{ID: uuid.New(), Channel: notifier.ChannelEmail, Recipient: "a@mail.com", Subject: "Kaos is back in stock", Body: "..."},
+		{ID: uuid.New(), Channel: notifier.ChannelEmail, Recipient: "b@mail.com", Subject: "Price drop: Kaos", Body: "..."},
+	}
+
+	t.Run("success - sent through mailer", func(t *testing.T) {
+		deps := setupServiceTest(t)
+
+		deps.repo.EXPECT().ListPendingNotifications(ctx, int32(10)).Return(pending, nil)
+		deps.repo.EXPECT().MarkSent(ctx, pending[0].ID).Return(nil)
+		deps.repo.EXPECT().MarkSent(ctx, pending[1].ID).Return(nil)
+
+		sent, failed, err := deps.service.DeliverPending(ctx, 10)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, sent)
+		assert.Zero(t, failed)
+
+		outbox := deps.mailer.Outbox()
+		assert.Len(t, outbox, 2)
+		assert.Equal(t, "a@mail.com", outbox[0].To)
+		assert.Equal(t, "Price drop: Kaos", outbox[1].Subject)
+	})
+
+	t.Run("mailer failure - marked for retry", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		deps.mailer.Err = errors.New("smtp down")
+
+		deps.repo.EXPECT().ListPendingNotifications(ctx, int32(10)).Return(pending[:1], nil)
+		deps.repo.EXPECT().MarkFailed(ctx, pending[0].ID, "smtp down", int32(constants.NotificationMaxAttempts)).Return(nil)
+
+		sent, failed, err := deps.service.DeliverPending(ctx, 10)
+
+		assert.NoError(t, err)
+		assert.Zero(t, sent)
+		assert.Equal(t, 1, failed)
+		assert.Empty(t, deps.mailer.Outbox())
+	})
+}
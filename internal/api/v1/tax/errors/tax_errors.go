@@ -0,0 +1,26 @@
+package taxerrors
+
+import (
+	"go-sqlc-starter/internal/pkg/apperror"
+	"net/http"
+)
+
+var (
+	ErrInvalidTaxClass = apperror.New(
+		apperror.CodeInvalidInput,
+		"Invalid tax class code",
+		http.StatusBadRequest,
+	)
+
+	ErrTaxRateNotFound = apperror.New(
+		apperror.CodeNotFound,
+		"Tax rate not found for product",
+		http.StatusNotFound,
+	)
+
+	ErrTaxFailed = apperror.New(
+		apperror.CodeInternalError,
+		"Failed to calculate tax",
+		http.StatusInternalServerError,
+	)
+)
@@ -0,0 +1,96 @@
+package tax_test
+
+import (
+	"bytes"
+	"context"
+	"go-sqlc-starter/internal/api/v1/tax"
+	taxerrors "go-sqlc-starter/internal/api/v1/tax/errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== FAKE SERVICE (Mock Manual) ====================
+
+type fakeTaxService struct {
+	listFunc   func(ctx context.Context) ([]tax.TaxClassResponse, error)
+	upsertFunc func(ctx context.Context, code string, req tax.UpsertTaxClassRequest) (tax.TaxClassResponse, error)
+}
+
+func (f *fakeTaxService) Calculate(ctx context.Context, lines []tax.Line, discount float64) (tax.Breakdown, error) {
+	return tax.Breakdown{}, nil
+}
+func (f *fakeTaxService) ListClasses(ctx context.Context) ([]tax.TaxClassResponse, error) {
+	return f.listFunc(ctx)
+}
+func (f *fakeTaxService) UpsertClass(ctx context.Context, code string, req tax.UpsertTaxClassRequest) (tax.TaxClassResponse, error) {
+	return f.upsertFunc(ctx, code, req)
+}
+
+func TestTaxController_ListClasses(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	svc := &fakeTaxService{
+		listFunc: func(ctx context.Context) ([]tax.TaxClassResponse, error) {
+			return []tax.TaxClassResponse{{Code: "STANDARD", Name: "PPN", Rate: 11}}, nil
+		},
+	}
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/admin/tax-classes", nil)
+
+	tax.NewController(svc).ListClasses(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"rate":11`)
+}
+
+func TestTaxController_UpsertClass(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	perform := func(svc *fakeTaxService, body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "code", Value: "STANDARD"}}
+		c.Request = httptest.NewRequest(http.MethodPut, "/admin/tax-classes/STANDARD", bytes.NewBufferString(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		tax.NewController(svc).UpsertClass(c)
+		return w
+	}
+
+	t.Run("positive - rate updated", func(t *testing.T) {
+		svc := &fakeTaxService{
+			upsertFunc: func(ctx context.Context, code string, req tax.UpsertTaxClassRequest) (tax.TaxClassResponse, error) {
+				assert.Equal(t, "STANDARD", code)
+				assert.Equal(t, 12.0, req.Rate)
+				return tax.TaxClassResponse{Code: code, Name: req.Name, Rate: req.Rate}, nil
+			},
+		}
+
+		w := perform(svc, `{"name":"PPN","rate":12}`)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("negative - invalid body", func(t *testing.T) {
+		w := perform(&fakeTaxService{}, `{"rate":"x"}`)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("negative - invalid code", func(t *testing.T) {
+		svc := &fakeTaxService{
+			upsertFunc: func(ctx context.Context, code string, req tax.UpsertTaxClassRequest) (tax.TaxClassResponse, error) {
+				return tax.TaxClassResponse{}, taxerrors.ErrInvalidTaxClass
+			},
+		}
+
+		w := perform(svc, `{"name":"PPN","rate":11}`)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
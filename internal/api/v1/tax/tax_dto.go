@@ -0,0 +1,19 @@
+package tax
+
+import "time"
+
+// ==================== REQUEST STRUCTS ====================
+
+type UpsertTaxClassRequest struct {
+	Name string  `json:"name" validate:"required,max=100"`
+	Rate float64 `json:"rate" validate:"gte=0,lte=100"` // persen, contoh: 11 untuk PPN 11%
+}
+
+// ==================== RESPONSE STRUCTS ====================
+
+type TaxClassResponse struct {
+	Code      string    `json:"code"`
+	Name      string    `json:"name"`
+	Rate      float64   `json:"rate"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
@@ -0,0 +1,35 @@
+package tax
+
+import (
+	"context"
+	"go-sqlc-starter/internal/dbgen"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=tax_repo.go -destination=../mock/tax/tax_repo_mock.go -package=mock
+type Repository interface {
+	ListClasses(ctx context.Context) ([]dbgen.TaxClass, error)
+	UpsertClass(ctx context.Context, arg dbgen.UpsertTaxClassParams) (dbgen.TaxClass, error)
+	ListProductRates(ctx context.Context, productIDs []uuid.UUID) ([]dbgen.ListProductTaxRatesRow, error)
+}
+
+type repository struct {
+	queries *dbgen.Queries
+}
+
+func NewRepository(q *dbgen.Queries) Repository {
+	return &repository{queries: q}
+}
+
+func (r *repository) ListClasses(ctx context.Context) ([]dbgen.TaxClass, error) {
+	return r.queries.ListTaxClasses(ctx)
+}
+
+func (r *repository) UpsertClass(ctx context.Context, arg dbgen.UpsertTaxClassParams) (dbgen.TaxClass, error) {
+	return r.queries.UpsertTaxClass(ctx, arg)
+}
+
+func (r *repository) ListProductRates(ctx context.Context, productIDs []uuid.UUID) ([]dbgen.ListProductTaxRatesRow, error) {
+	return r.queries.ListProductTaxRates(ctx, productIDs)
+}
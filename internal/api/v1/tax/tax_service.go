@@ -0,0 +1,187 @@
+package tax
+
+import (
+	"context"
+	"fmt"
+	"go-sqlc-starter/internal/api/v1/cart"
+	taxerrors "go-sqlc-starter/internal/api/v1/tax/errors"
+	"go-sqlc-starter/internal/dbgen"
+	"go-sqlc-starter/internal/pkg/apperror"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+var classCodePattern = regexp.MustCompile(`^[A-Z0-9_]{1,32}$`)
+
+// Line satu baris keranjang; Amount = harga satuan x qty (sebelum diskon)
+type Line struct {
+	ProductID uuid.UUID
+	Amount    float64
+}
+
+// LineTax pajak satu baris, urutan sama dengan input Calculate
+type LineTax struct {
+	ProductID uuid.UUID
+	TaxClass  string
+	Rate      float64
+	Amount    float64
+}
+
+// Breakdown hasil hitung pajak keranjang
+type Breakdown struct {
+	PricesIncludeTax bool
+	Lines            []LineTax
+	Total            float64
+}
+
+// CartLines mengubah item cart menjadi Line (harga sama dengan yang dipakai checkout)
+func CartLines(items []cart.CartItemDetailResponse) []Line {
+	lines := make([]Line, 0, len(items))
+	for _, item := range items {
+		pid, _ := uuid.Parse(item.ProductID)
+		lines = append(lines, Line{
+			ProductID: pid,
+			Amount:    item.CurrentPrice * float64(item.Qty),
+		})
+	}
+	return lines
+}
+
+//go:generate mockgen -source=tax_service.go -destination=../mock/tax/tax_service_mock.go -package=mock
+type Service interface {
+	// Checkout: pajak per baris dari harga setelah diskon order
+	Calculate(ctx context.Context, lines []Line, discount float64) (Breakdown, error)
+
+	// Admin
+	ListClasses(ctx context.Context) ([]TaxClassResponse, error)
+	UpsertClass(ctx context.Context, code string, req UpsertTaxClassRequest) (TaxClassResponse, error)
+}
+
+type service struct {
+	repo             Repository
+	pricesIncludeTax bool
+	validate         *validator.Validate
+}
+
+// NewService pricesIncludeTax = mode toko: harga katalog sudah termasuk pajak (true)
+// atau pajak ditambahkan di atas harga saat checkout (false)
+func NewService(r Repository, pricesIncludeTax bool) Service {
+	return &service{
+		repo:             r,
+		pricesIncludeTax: pricesIncludeTax,
+		validate:         validator.New(),
+	}
+}
+
+func (s *service) Calculate(ctx context.Context, lines []Line, discount float64) (Breakdown, error) {
+	res := Breakdown{PricesIncludeTax: s.pricesIncludeTax, Lines: make([]LineTax, 0, len(lines))}
+	if len(lines) == 0 {
+		return res, nil
+	}
+
+	// 1. Tarif per produk sesuai kelas pajaknya
+	ids := make([]uuid.UUID, 0, len(lines))
+	var subtotal float64
+	for _, l := range lines {
+		ids = append(ids, l.ProductID)
+		subtotal += l.Amount
+	}
+	rows, err := s.repo.ListProductRates(ctx, ids)
+	if err != nil {
+		return Breakdown{}, taxerrors.ErrTaxFailed
+	}
+	rates := make(map[uuid.UUID]dbgen.ListProductTaxRatesRow, len(rows))
+	for _, r := range rows {
+		rates[r.ID] = r
+	}
+
+	// 2. Diskon order dibagi proporsional ke tiap baris (sisa pembulatan di baris terakhir)
+	discount = math.Min(discount, subtotal)
+	remaining := discount
+	for i, l := range lines {
+		row, ok := rates[l.ProductID]
+		if !ok {
+			return Breakdown{}, taxerrors.ErrTaxRateNotFound
+		}
+		rate, _ := strconv.ParseFloat(row.Rate, 64)
+
+		share := remaining
+		if i < len(lines)-1 && subtotal > 0 {
+			share = roundMoney(discount * l.Amount / subtotal)
+			remaining -= share
+		}
+		base := math.Max(l.Amount-share, 0)
+
+		// 3. Inklusif: pajak diambil dari dalam harga; eksklusif: ditambahkan di atas harga
+		var amount float64
+		if s.pricesIncludeTax {
+			amount = roundMoney(base * rate / (100 + rate))
+		} else {
+			amount = roundMoney(base * rate / 100)
+		}
+
+		res.Lines = append(res.Lines, LineTax{
+			ProductID: l.ProductID,
+			TaxClass:  row.TaxClass,
+			Rate:      rate,
+			Amount:    amount,
+		})
+		res.Total += amount
+	}
+	res.Total = roundMoney(res.Total)
+
+	return res, nil
+}
+
+func (s *service) ListClasses(ctx context.Context) ([]TaxClassResponse, error) {
+	rows, err := s.repo.ListClasses(ctx)
+	if err != nil {
+		return nil, taxerrors.ErrTaxFailed
+	}
+
+	res := make([]TaxClassResponse, 0, len(rows))
+	for _, r := range rows {
+		res = append(res, mapTaxClass(r))
+	}
+	return res, nil
+}
+
+func (s *service) UpsertClass(ctx context.Context, code string, req UpsertTaxClassRequest) (TaxClassResponse, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if !classCodePattern.MatchString(code) {
+		return TaxClassResponse{}, taxerrors.ErrInvalidTaxClass
+	}
+	if err := s.validate.Struct(req); err != nil {
+		return TaxClassResponse{}, apperror.MapValidationError(err)
+	}
+
+	row, err := s.repo.UpsertClass(ctx, dbgen.UpsertTaxClassParams{
+		Code: code,
+		Name: strings.TrimSpace(req.Name),
+		Rate: fmt.Sprintf("%.2f", req.Rate),
+	})
+	if err != nil {
+		return TaxClassResponse{}, taxerrors.ErrTaxFailed
+	}
+
+	return mapTaxClass(row), nil
+}
+
+func mapTaxClass(r dbgen.TaxClass) TaxClassResponse {
+	rate, _ := strconv.ParseFloat(r.Rate, 64)
+	return TaxClassResponse{
+		Code:      r.Code,
+		Name:      r.Name,
+		Rate:      rate,
+		UpdatedAt: r.UpdatedAt,
+	}
+}
+
+func roundMoney(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,125 @@
+package tax_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	taxMock "go-sqlc-starter/internal/api/v1/mock/tax"
+	"go-sqlc-starter/internal/api/v1/tax"
+	taxerrors "go-sqlc-starter/internal/api/v1/tax/errors"
+	"go-sqlc-starter/internal/dbgen"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupServiceTest(t *testing.T, pricesIncludeTax bool) (tax.Service, *taxMock.MockRepository) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	repo := taxMock.NewMockRepository(ctrl)
+	return tax.NewService(repo, pricesIncludeTax), repo
+}
+
+func TestTaxService_Calculate(t *testing.T) {
+	ctx := context.Background()
+	ppnItem, exemptItem := uuid.New(), uuid.New()
+	rates := []dbgen.ListProductTaxRatesRow{
+		{ID: ppnItem, TaxClass: "STANDARD", Rate: "11.00"},
+		{ID: exemptItem, TaxClass: "EXEMPT", Rate: "0.00"},
+	}
+	lines := []tax.Line{
+		{ProductID: ppnItem, Amount: 100000},
+		{ProductID: exemptItem, Amount: 50000},
+	}
+
+	t.Run("success - exclusive, tax added per line class", func(t *testing.T) {
+		svc, repo := setupServiceTest(t, false)
+		repo.EXPECT().ListProductRates(ctx, []uuid.UUID{ppnItem, exemptItem}).Return(rates, nil)
+
+		res, err := svc.Calculate(ctx, lines, 0)
+
+		assert.NoError(t, err)
+		assert.False(t, res.PricesIncludeTax)
+		assert.Equal(t, 11000.0, res.Lines[0].Amount)
+		assert.Equal(t, 0.0, res.Lines[1].Amount)
+		assert.Equal(t, 11000.0, res.Total)
+	})
+
+	t.Run("success - discount reduces taxable base proportionally", func(t *testing.T) {
+		svc, repo := setupServiceTest(t, false)
+		repo.EXPECT().ListProductRates(ctx, gomock.Any()).Return(rates, nil)
+
+		// Diskon 15.000 dari subtotal 150.000 -> baris PPN menanggung 10.000
+		res, err := svc.Calculate(ctx, lines, 15000)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 9900.0, res.Lines[0].Amount)
+		assert.Equal(t, 9900.0, res.Total)
+	})
+
+	t.Run("success - inclusive, tax extracted from price", func(t *testing.T) {
+		svc, repo := setupServiceTest(t, true)
+		repo.EXPECT().ListProductRates(ctx, gomock.Any()).Return(rates, nil)
+
+		res, err := svc.Calculate(ctx, []tax.Line{{ProductID: ppnItem, Amount: 111000}}, 0)
+
+		assert.NoError(t, err)
+		assert.True(t, res.PricesIncludeTax)
+		assert.Equal(t, 11000.0, res.Total)
+		assert.Equal(t, 11.0, res.Lines[0].Rate)
+	})
+
+	t.Run("error - product without tax rate", func(t *testing.T) {
+		svc, repo := setupServiceTest(t, false)
+		repo.EXPECT().ListProductRates(ctx, gomock.Any()).Return(rates[:1], nil)
+
+		_, err := svc.Calculate(ctx, lines, 0)
+
+		assert.Equal(t, taxerrors.ErrTaxRateNotFound, err)
+	})
+
+	t.Run("success - empty cart skips lookup", func(t *testing.T) {
+		svc, _ := setupServiceTest(t, false)
+
+		res, err := svc.Calculate(ctx, nil, 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0.0, res.Total)
+	})
+}
+
+func TestTaxService_UpsertClass(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success - code normalized", func(t *testing.T) {
+		svc, repo := setupServiceTest(t, false)
+		repo.EXPECT().
+			UpsertClass(ctx, dbgen.UpsertTaxClassParams{Code: "LUXURY", Name: "PPnBM", Rate: "20.00"}).
+			Return(dbgen.TaxClass{Code: "LUXURY", Name: "PPnBM", Rate: "20.00", UpdatedAt: time.Now()}, nil)
+
+		res, err := svc.UpsertClass(ctx, " luxury ", tax.UpsertTaxClassRequest{Name: "PPnBM", Rate: 20})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "LUXURY", res.Code)
+		assert.Equal(t, 20.0, res.Rate)
+	})
+
+	t.Run("error - invalid code", func(t *testing.T) {
+		svc, _ := setupServiceTest(t, false)
+
+		_, err := svc.UpsertClass(ctx, "ppn-11%", tax.UpsertTaxClassRequest{Name: "PPN", Rate: 11})
+
+		assert.Equal(t, taxerrors.ErrInvalidTaxClass, err)
+	})
+
+	t.Run("error - rate above 100", func(t *testing.T) {
+		svc, _ := setupServiceTest(t, false)
+
+		_, err := svc.UpsertClass(ctx, "STANDARD", tax.UpsertTaxClassRequest{Name: "PPN", Rate: 110})
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,80 @@
+package vouchererrors
+
+import (
+	"go-sqlc-starter/internal/pkg/apperror"
+	"net/http"
+)
+
+var (
+	ErrInvalidVoucherID = apperror.New(
+		apperror.CodeInvalidInput,
+		"Invalid voucher ID",
+		http.StatusBadRequest,
+	)
+
+	ErrInvalidDiscount = apperror.New(
+		apperror.CodeInvalidInput,
+		"Percentage discount must be between 0 and 100",
+		http.StatusBadRequest,
+	)
+
+	ErrInvalidSchedule = apperror.New(
+		apperror.CodeInvalidInput,
+		"Voucher end time must be after start time",
+		http.StatusBadRequest,
+	)
+
+	ErrVoucherNotFound = apperror.New(
+		apperror.CodeNotFound,
+		"Voucher not found",
+		http.StatusNotFound,
+	)
+
+	ErrVoucherCodeExists = apperror.New(
+		apperror.CodeConflict,
+		"Voucher code already exists",
+		http.StatusConflict,
+	)
+
+	ErrVoucherNotActive = apperror.New(
+		apperror.CodeInvalidState,
+		"Voucher is not active or has expired",
+		http.StatusBadRequest,
+	)
+
+	ErrMinSpendNotMet = apperror.New(
+		apperror.CodeInvalidState,
+		"Cart total does not meet the voucher minimum spend",
+		http.StatusBadRequest,
+	)
+
+	ErrVoucherNotApplicable = apperror.New(
+		apperror.CodeInvalidState,
+		"Voucher does not apply to any item in your cart",
+		http.StatusBadRequest,
+	)
+
+	ErrUsageLimitReached = apperror.New(
+		apperror.CodeConflict,
+		"Voucher usage limit has been reached",
+		http.StatusConflict,
+	)
+
+	ErrUserLimitReached = apperror.New(
+		apperror.CodeConflict,
+		"You have already used this voucher the maximum number of times",
+		http.StatusConflict,
+	)
+
+	ErrCartEmpty = apperror.New(
+		apperror.CodeInvalidState,
+		"Your shopping cart is empty",
+		http.StatusBadRequest,
+	)
+
+	ErrVoucherFailed = apperror.New(
+		apperror.CodeInternalError,
+		"Failed to process voucher",
+		http.StatusInternalServerError,
+	)
+)
@@ -0,0 +1,162 @@
+package voucher
+
+import (
+	"go-sqlc-starter/internal/pkg/apperror"
+	"go-sqlc-starter/internal/pkg/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Controller struct {
+	service Service
+}
+
+func NewController(svc Service) *Controller {
+	return &Controller{service: svc}
+}
+
+// ==================== CUSTOMER ENDPOINTS ====================
+
+// Apply preview diskon voucher terhadap keranjang user (tanpa mencatat pemakaian)
+// POST /cart/apply-voucher
+func (ctrl *Controller) Apply(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		response.Error(c, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated", nil)
+		return
+	}
+
+	var req ApplyVoucherRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		appErr := apperror.Wrap(err, apperror.CodeInvalidInput, "Invalid request body", http.StatusBadRequest)
+		httpErr := apperror.ToHTTP(appErr)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, err.Error())
+		return
+	}
+
+	res, err := ctrl.service.Preview(c.Request.Context(), userID.(string), req)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// ==================== ADMIN ENDPOINTS ====================
+
+// ListAdmin GET /admin/vouchers?page=1&limit=20&search=
+func (ctrl *Controller) ListAdmin(c *gin.Context) {
+	page, limit := parsePage(c)
+
+	data, total, err := ctrl.service.ListAdmin(c.Request.Context(), c.Query("search"), page, limit)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, data, paginationMeta(total, page, limit))
+}
+
+// GetByID GET /admin/vouchers/:id
+func (ctrl *Controller) GetByID(c *gin.Context) {
+	res, err := ctrl.service.GetByID(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// Create POST /admin/vouchers
+func (ctrl *Controller) Create(c *gin.Context) {
+	var req VoucherRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		appErr := apperror.Wrap(err, apperror.CodeInvalidInput, "Invalid request body", http.StatusBadRequest)
+		httpErr := apperror.ToHTTP(appErr)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, err.Error())
+		return
+	}
+
+	res, err := ctrl.service.Create(c.Request.Context(), req)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusCreated, res, nil)
+}
+
+// Update PUT /admin/vouchers/:id
+func (ctrl *Controller) Update(c *gin.Context) {
+	var req VoucherRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		appErr := apperror.Wrap(err, apperror.CodeInvalidInput, "Invalid request body", http.StatusBadRequest)
+		httpErr := apperror.ToHTTP(appErr)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, err.Error())
+		return
+	}
+
+	res, err := ctrl.service.Update(c.Request.Context(), c.Param("id"), req)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// Delete DELETE /admin/vouchers/:id
+func (ctrl *Controller) Delete(c *gin.Context) {
+	if err := ctrl.service.Delete(c.Request.Context(), c.Param("id")); err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, nil, nil)
+}
+
+// Usage laporan pemakaian voucher
+// GET /admin/vouchers/:id/usage?page=1&limit=20
+func (ctrl *Controller) Usage(c *gin.Context) {
+	page, limit := parsePage(c)
+
+	res, total, err := ctrl.service.Usage(c.Request.Context(), c.Param("id"), page, limit)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, paginationMeta(total, page, limit))
+}
+
+func parsePage(c *gin.Context) (int, int) {
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if err != nil || limit < 1 || limit > 100 {
+		limit = 20
+	}
+	return page, limit
+}
+
+func paginationMeta(total int64, page, limit int) *response.PaginationMeta {
+	return &response.PaginationMeta{
+		Total:      total,
+		TotalPages: int((total + int64(limit) - 1) / int64(limit)),
+		Page:       page,
+		PageSize:   limit,
+	}
+}
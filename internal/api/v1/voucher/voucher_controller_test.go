@@ -0,0 +1,213 @@
+package voucher_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"go-sqlc-starter/internal/api/v1/voucher"
+	vouchererrors "go-sqlc-starter/internal/api/v1/voucher/errors"
+	"go-sqlc-starter/internal/dbgen"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== FAKE SERVICE (Mock Manual) ====================
+
+type fakeVoucherService struct {
+	previewFunc   func(ctx context.Context, userID string, req voucher.ApplyVoucherRequest) (voucher.VoucherPreviewResponse, error)
+	createFunc    func(ctx context.Context, req voucher.VoucherRequest) (voucher.VoucherResponse, error)
+	updateFunc    func(ctx context.Context, id string, req voucher.VoucherRequest) (voucher.VoucherResponse, error)
+	deleteFunc    func(ctx context.Context, id string) error
+	getByIDFunc   func(ctx context.Context, id string) (voucher.VoucherResponse, error)
+	listAdminFunc func(ctx context.Context, search string, page, limit int) ([]voucher.VoucherResponse, int64, error)
+	usageFunc     func(ctx context.Context, id string, page, limit int) (voucher.VoucherUsageResponse, int64, error)
+}
+
+func (f *fakeVoucherService) Preview(ctx context.Context, userID string, req voucher.ApplyVoucherRequest) (voucher.VoucherPreviewResponse, error) {
+	return f.previewFunc(ctx, userID, req)
+}
+func (f *fakeVoucherService) Apply(ctx context.Context, tx dbgen.DBTX, userID uuid.UUID, code string, lines []voucher.Line) (voucher.Application, error) {
+	return voucher.Application{}, nil
+}
+func (f *fakeVoucherService) Redeem(ctx context.Context, tx dbgen.DBTX, userID, orderID uuid.UUID, app voucher.Application) error {
+	return nil
+}
+func (f *fakeVoucherService) Release(ctx context.Context, tx dbgen.DBTX, orderID uuid.UUID) error {
+	return nil
+}
+func (f *fakeVoucherService) Create(ctx context.Context, req voucher.VoucherRequest) (voucher.VoucherResponse, error) {
+	return f.createFunc(ctx, req)
+}
+func (f *fakeVoucherService) Update(ctx context.Context, id string, req voucher.VoucherRequest) (voucher.VoucherResponse, error) {
+	return f.updateFunc(ctx, id, req)
+}
+func (f *fakeVoucherService) Delete(ctx context.Context, id string) error {
+	return f.deleteFunc(ctx, id)
+}
+func (f *fakeVoucherService) GetByID(ctx context.Context, id string) (voucher.VoucherResponse, error) {
+	return f.getByIDFunc(ctx, id)
+}
+func (f *fakeVoucherService) ListAdmin(ctx context.Context, search string, page, limit int) ([]voucher.VoucherResponse, int64, error) {
+	return f.listAdminFunc(ctx, search, page, limit)
+}
+func (f *fakeVoucherService) Usage(ctx context.Context, id string, page, limit int) (voucher.VoucherUsageResponse, int64, error) {
+	return f.usageFunc(ctx, id, page, limit)
+}
+
+// ==================== REUSABLE HELPERS ====================
+
+type voucherTestDeps struct {
+	svc  *fakeVoucherService
+	ctrl *voucher.Controller
+	w    *httptest.ResponseRecorder
+	ctx  *gin.Context
+}
+
+func setupVoucherControllerTest() *voucherTestDeps {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	svc := &fakeVoucherService{}
+
+	return &voucherTestDeps{
+		svc:  svc,
+		ctrl: voucher.NewController(svc),
+		w:    w,
+		ctx:  ctx,
+	}
+}
+
+func (d *voucherTestDeps) performRequest(method, path string, body interface{}) {
+	var jsonBody []byte
+	if body != nil {
+		jsonBody, _ = json.Marshal(body)
+	}
+	d.ctx.Request = httptest.NewRequest(method, path, bytes.NewBuffer(jsonBody))
+	d.ctx.Request.Header.Set("Content-Type", "application/json")
+}
+
+// ==================== APPLY ====================
+
+func TestVoucherController_Apply(t *testing.T) {
+	userID := uuid.New().String()
+
+	t.Run("positive - preview for logged in user", func(t *testing.T) {
+		d := setupVoucherControllerTest()
+		d.ctx.Set("user_id", userID)
+
+		d.svc.previewFunc = func(ctx context.Context, u string, req voucher.ApplyVoucherRequest) (voucher.VoucherPreviewResponse, error) {
+			assert.Equal(t, userID, u)
+			assert.Equal(t, "HEMAT", req.Code)
+			return voucher.VoucherPreviewResponse{Code: req.Code, Subtotal: 100000, Discount: 10000, Total: 90000}, nil
+		}
+
+		d.performRequest(http.MethodPost, "/cart/apply-voucher", map[string]string{"code": "HEMAT"})
+		d.ctrl.Apply(d.ctx)
+
+		assert.Equal(t, http.StatusOK, d.w.Code)
+		assert.Contains(t, d.w.Body.String(), `"discount":10000`)
+	})
+
+	t.Run("negative - unauthenticated", func(t *testing.T) {
+		d := setupVoucherControllerTest()
+
+		d.performRequest(http.MethodPost, "/cart/apply-voucher", map[string]string{"code": "HEMAT"})
+		d.ctrl.Apply(d.ctx)
+
+		assert.Equal(t, http.StatusUnauthorized, d.w.Code)
+	})
+
+	t.Run("negative - usage limit reached", func(t *testing.T) {
+		d := setupVoucherControllerTest()
+		d.ctx.Set("user_id", userID)
+
+		d.svc.previewFunc = func(ctx context.Context, u string, req voucher.ApplyVoucherRequest) (voucher.VoucherPreviewResponse, error) {
+			return voucher.VoucherPreviewResponse{}, vouchererrors.ErrUsageLimitReached
+		}
+
+		d.performRequest(http.MethodPost, "/cart/apply-voucher", map[string]string{"code": "HEMAT"})
+		d.ctrl.Apply(d.ctx)
+
+		assert.Equal(t, http.StatusConflict, d.w.Code)
+	})
+}
+
+// ==================== ADMIN ====================
+
+func TestVoucherController_Create(t *testing.T) {
+	t.Run("positive - created", func(t *testing.T) {
+		d := setupVoucherControllerTest()
+
+		d.svc.createFunc = func(ctx context.Context, req voucher.VoucherRequest) (voucher.VoucherResponse, error) {
+			assert.Equal(t, "FIXED", req.DiscountType)
+			return voucher.VoucherResponse{ID: uuid.New().String(), Code: req.Code}, nil
+		}
+
+		d.performRequest(http.MethodPost, "/admin/vouchers", map[string]interface{}{
+			"code":          "HEMAT",
+			"discountType":  "FIXED",
+			"discountValue": 10000,
+		})
+		d.ctrl.Create(d.ctx)
+
+		assert.Equal(t, http.StatusCreated, d.w.Code)
+	})
+
+	t.Run("negative - duplicate code", func(t *testing.T) {
+		d := setupVoucherControllerTest()
+
+		d.svc.createFunc = func(ctx context.Context, req voucher.VoucherRequest) (voucher.VoucherResponse, error) {
+			return voucher.VoucherResponse{}, vouchererrors.ErrVoucherCodeExists
+		}
+
+		d.performRequest(http.MethodPost, "/admin/vouchers", map[string]interface{}{
+			"code":          "HEMAT",
+			"discountType":  "FIXED",
+			"discountValue": 10000,
+		})
+		d.ctrl.Create(d.ctx)
+
+		assert.Equal(t, http.StatusConflict, d.w.Code)
+	})
+}
+
+func TestVoucherController_Usage(t *testing.T) {
+	voucherID := uuid.New().String()
+
+	t.Run("positive - paginated usage report", func(t *testing.T) {
+		d := setupVoucherControllerTest()
+		d.ctx.Params = gin.Params{{Key: "id", Value: voucherID}}
+
+		d.svc.usageFunc = func(ctx context.Context, id string, page, limit int) (voucher.VoucherUsageResponse, int64, error) {
+			assert.Equal(t, voucherID, id)
+			assert.Equal(t, 1, page)
+			assert.Equal(t, 20, limit)
+			return voucher.VoucherUsageResponse{VoucherID: id, RedemptionCount: 3, TotalDiscount: 30000}, 3, nil
+		}
+
+		d.performRequest(http.MethodGet, "/admin/vouchers/"+voucherID+"/usage", nil)
+		d.ctrl.Usage(d.ctx)
+
+		assert.Equal(t, http.StatusOK, d.w.Code)
+		assert.Contains(t, d.w.Body.String(), `"redemptionCount":3`)
+	})
+
+	t.Run("negative - not found", func(t *testing.T) {
+		d := setupVoucherControllerTest()
+		d.ctx.Params = gin.Params{{Key: "id", Value: voucherID}}
+
+		d.svc.usageFunc = func(ctx context.Context, id string, page, limit int) (voucher.VoucherUsageResponse, int64, error) {
+			return voucher.VoucherUsageResponse{}, 0, vouchererrors.ErrVoucherNotFound
+		}
+
+		d.performRequest(http.MethodGet, "/admin/vouchers/"+voucherID+"/usage", nil)
+		d.ctrl.Usage(d.ctx)
+
+		assert.Equal(t, http.StatusNotFound, d.w.Code)
+	})
+}
@@ -0,0 +1,76 @@
+package voucher
+
+import "time"
+
+// ==================== REQUEST STRUCTS ====================
+
+type ApplyVoucherRequest struct {
+	Code string `json:"code" validate:"required,max=50"`
+}
+
+// VoucherRequest dipakai untuk create dan update (PUT mengganti seluruh field)
+type VoucherRequest struct {
+	Code          string     `json:"code" validate:"required,min=3,max=50"`
+	Description   string     `json:"description" validate:"max=255"`
+	DiscountType  string     `json:"discountType" validate:"required,oneof=PERCENTAGE FIXED"`
+	DiscountValue float64    `json:"discountValue" validate:"required,gt=0"`
+	MaxDiscount   *float64   `json:"maxDiscount" validate:"omitempty,gt=0"` // hanya untuk PERCENTAGE
+	MinSpend      float64    `json:"minSpend" validate:"gte=0"`
+	UsageLimit    *int32     `json:"usageLimit" validate:"omitempty,gt=0"`
+	PerUserLimit  *int32     `json:"perUserLimit" validate:"omitempty,gt=0"`
+	StartsAt      *time.Time `json:"startsAt"` // default: sekarang
+	EndsAt        *time.Time `json:"endsAt"`
+	IsActive      *bool      `json:"isActive"` // default: true
+	ProductIDs    []string   `json:"productIds" validate:"dive,uuid"`
+	CategoryIDs   []string   `json:"categoryIds" validate:"dive,uuid"` // termasuk sub-kategori
+}
+
+// ==================== RESPONSE STRUCTS ====================
+
+type VoucherPreviewResponse struct {
+	Code             string  `json:"code"`
+	Subtotal         float64 `json:"subtotal"`
+	EligibleSubtotal float64 `json:"eligibleSubtotal"`
+	Discount         float64 `json:"discount"`
+	Total            float64 `json:"total"`
+}
+
+type VoucherResponse struct {
+	ID            string     `json:"id"`
+	Code          string     `json:"code"`
+	Description   string     `json:"description"`
+	DiscountType  string     `json:"discountType"`
+	DiscountValue float64    `json:"discountValue"`
+	MaxDiscount   *float64   `json:"maxDiscount"`
+	MinSpend      float64    `json:"minSpend"`
+	UsageLimit    *int32     `json:"usageLimit"`
+	PerUserLimit  *int32     `json:"perUserLimit"`
+	UsedCount     int32      `json:"usedCount"`
+	StartsAt      time.Time  `json:"startsAt"`
+	EndsAt        *time.Time `json:"endsAt"`
+	IsActive      bool       `json:"isActive"`
+	ProductIDs    []string   `json:"productIds,omitempty"`
+	CategoryIDs   []string   `json:"categoryIds,omitempty"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	UpdatedAt     time.Time  `json:"updatedAt"`
+}
+
+type VoucherRedemptionResponse struct {
+	ID             string    `json:"id"`
+	UserID         string    `json:"userId"`
+	OrderID        string    `json:"orderId"`
+	OrderNumber    string    `json:"orderNumber"`
+	DiscountAmount float64   `json:"discountAmount"`
+	CreatedAt      time.Time `json:"createdAt"`
+}
+
+type VoucherUsageResponse struct {
+	VoucherID       string                      `json:"voucherId"`
+	Code            string                      `json:"code"`
+	UsedCount       int32                       `json:"usedCount"`
+	UsageLimit      *int32                      `json:"usageLimit"`
+	RedemptionCount int64                       `json:"redemptionCount"`
+	UniqueUsers     int64                       `json:"uniqueUsers"`
+	TotalDiscount   float64                     `json:"totalDiscount"`
+	Redemptions     []VoucherRedemptionResponse `json:"redemptions"`
+}
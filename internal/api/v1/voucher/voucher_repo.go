@@ -0,0 +1,151 @@
+package voucher
+
+import (
+	"context"
+	"database/sql"
+	"go-sqlc-starter/internal/dbgen"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=voucher_repo.go -destination=../mock/voucher/voucher_repo_mock.go -package=mock
+type Repository interface {
+	WithTx(tx dbgen.DBTX) Repository
+
+	// Admin
+	Create(ctx context.Context, arg dbgen.CreateVoucherParams) (dbgen.Voucher, error)
+	Update(ctx context.Context, arg dbgen.UpdateVoucherParams) (dbgen.Voucher, error)
+	SoftDelete(ctx context.Context, id uuid.UUID) (int64, error)
+	GetByID(ctx context.Context, id uuid.UUID) (dbgen.Voucher, error)
+	ListAdmin(ctx context.Context, arg dbgen.ListVouchersAdminParams) ([]dbgen.ListVouchersAdminRow, error)
+	ListProductIDs(ctx context.Context, voucherID uuid.UUID) ([]uuid.UUID, error)
+	ListCategoryIDs(ctx context.Context, voucherID uuid.UUID) ([]uuid.UUID, error)
+	DeleteProducts(ctx context.Context, voucherID uuid.UUID) error
+	DeleteCategories(ctx context.Context, voucherID uuid.UUID) error
+	AddProducts(ctx context.Context, voucherID uuid.UUID, productIDs []uuid.UUID) error
+	AddCategories(ctx context.Context, voucherID uuid.UUID, categoryIDs []uuid.UUID) error
+	GetUsageSummary(ctx context.Context, voucherID uuid.UUID) (dbgen.GetVoucherUsageSummaryRow, error)
+	ListRedemptions(ctx context.Context, voucherID uuid.UUID, limit, offset int32) ([]dbgen.ListVoucherRedemptionsRow, error)
+
+	// Checkout
+	GetByCodeForUpdate(ctx context.Context, code string) (dbgen.Voucher, error)
+	ListEligibleProducts(ctx context.Context, voucherID uuid.UUID, productIDs []uuid.UUID) ([]uuid.UUID, error)
+	CountUserRedemptions(ctx context.Context, voucherID, userID uuid.UUID) (int64, error)
+	IncrementUsage(ctx context.Context, voucherID uuid.UUID) (int32, error)
+	DecrementUsage(ctx context.Context, voucherID uuid.UUID) error
+	CreateRedemption(ctx context.Context, arg dbgen.CreateVoucherRedemptionParams) (dbgen.VoucherRedemption, error)
+	DeleteRedemptionByOrder(ctx context.Context, orderID uuid.UUID) (uuid.UUID, error)
+}
+
+type repository struct {
+	queries *dbgen.Queries
+}
+
+func NewRepository(q *dbgen.Queries) Repository {
+	return &repository{queries: q}
+}
+
+func (r *repository) WithTx(tx dbgen.DBTX) Repository {
+	if sqlTx, ok := tx.(*sql.Tx); ok {
+		return &repository{
+			queries: r.queries.WithTx(sqlTx),
+		}
+	}
+	return r
+}
+
+func (r *repository) Create(ctx context.Context, arg dbgen.CreateVoucherParams) (dbgen.Voucher, error) {
+	return r.queries.CreateVoucher(ctx, arg)
+}
+
+func (r *repository) Update(ctx context.Context, arg dbgen.UpdateVoucherParams) (dbgen.Voucher, error) {
+	return r.queries.UpdateVoucher(ctx, arg)
+}
+
+func (r *repository) SoftDelete(ctx context.Context, id uuid.UUID) (int64, error) {
+	return r.queries.SoftDeleteVoucher(ctx, id)
+}
+
+func (r *repository) GetByID(ctx context.Context, id uuid.UUID) (dbgen.Voucher, error) {
+	return r.queries.GetVoucherByID(ctx, id)
+}
+
+func (r *repository) ListAdmin(ctx context.Context, arg dbgen.ListVouchersAdminParams) ([]dbgen.ListVouchersAdminRow, error) {
+	return r.queries.ListVouchersAdmin(ctx, arg)
+}
+
+func (r *repository) ListProductIDs(ctx context.Context, voucherID uuid.UUID) ([]uuid.UUID, error) {
+	return r.queries.ListVoucherProductIDs(ctx, voucherID)
+}
+
+func (r *repository) ListCategoryIDs(ctx context.Context, voucherID uuid.UUID) ([]uuid.UUID, error) {
+	return r.queries.ListVoucherCategoryIDs(ctx, voucherID)
+}
+
+func (r *repository) DeleteProducts(ctx context.Context, voucherID uuid.UUID) error {
+	return r.queries.DeleteVoucherProducts(ctx, voucherID)
+}
+
+func (r *repository) DeleteCategories(ctx context.Context, voucherID uuid.UUID) error {
+	return r.queries.DeleteVoucherCategories(ctx, voucherID)
+}
+
+func (r *repository) AddProducts(ctx context.Context, voucherID uuid.UUID, productIDs []uuid.UUID) error {
+	return r.queries.AddVoucherProducts(ctx, dbgen.AddVoucherProductsParams{
+		VoucherID:  voucherID,
+		ProductIds: productIDs,
+	})
+}
+
+func (r *repository) AddCategories(ctx context.Context, voucherID uuid.UUID, categoryIDs []uuid.UUID) error {
+	return r.queries.AddVoucherCategories(ctx, dbgen.AddVoucherCategoriesParams{
+		VoucherID:   voucherID,
+		CategoryIds: categoryIDs,
+	})
+}
+
+func (r *repository) GetUsageSummary(ctx context.Context, voucherID uuid.UUID) (dbgen.GetVoucherUsageSummaryRow, error) {
+	return r.queries.GetVoucherUsageSummary(ctx, voucherID)
+}
+
+func (r *repository) ListRedemptions(ctx context.Context, voucherID uuid.UUID, limit, offset int32) ([]dbgen.ListVoucherRedemptionsRow, error) {
+	return r.queries.ListVoucherRedemptions(ctx, dbgen.ListVoucherRedemptionsParams{
+		VoucherID: voucherID,
+		Limit:     limit,
+		Offset:    offset,
+	})
+}
+
+func (r *repository) GetByCodeForUpdate(ctx context.Context, code string) (dbgen.Voucher, error) {
+	return r.queries.GetVoucherByCodeForUpdate(ctx, code)
+}
+
+func (r *repository) ListEligibleProducts(ctx context.Context, voucherID uuid.UUID, productIDs []uuid.UUID) ([]uuid.UUID, error) {
+	return r.queries.ListEligibleVoucherProducts(ctx, dbgen.ListEligibleVoucherProductsParams{
+		VoucherID:  voucherID,
+		ProductIds: productIDs,
+	})
+}
+
+func (r *repository) CountUserRedemptions(ctx context.Context, voucherID, userID uuid.UUID) (int64, error) {
+	return r.queries.CountVoucherRedemptionsByUser(ctx, dbgen.CountVoucherRedemptionsByUserParams{
+		VoucherID: voucherID,
+		UserID:    userID,
+	})
+}
+
+func (r *repository) IncrementUsage(ctx context.Context, voucherID uuid.UUID) (int32, error) {
+	return r.queries.IncrementVoucherUsage(ctx, voucherID)
+}
+
+func (r *repository) DecrementUsage(ctx context.Context, voucherID uuid.UUID) error {
+	return r.queries.DecrementVoucherUsage(ctx, voucherID)
+}
+
+func (r *repository) CreateRedemption(ctx context.Context, arg dbgen.CreateVoucherRedemptionParams) (dbgen.VoucherRedemption, error) {
+	return r.queries.CreateVoucherRedemption(ctx, arg)
+}
+
+func (r *repository) DeleteRedemptionByOrder(ctx context.Context, orderID uuid.UUID) (uuid.UUID, error) {
+	return r.queries.DeleteVoucherRedemptionByOrder(ctx, orderID)
+}
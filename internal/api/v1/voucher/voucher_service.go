@@ -0,0 +1,619 @@
+package voucher
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"go-sqlc-starter/internal/api/v1/auth"
+	"go-sqlc-starter/internal/api/v1/cart"
+	vouchererrors "go-sqlc-starter/internal/api/v1/voucher/errors"
+	"go-sqlc-starter/internal/dbgen"
+	"go-sqlc-starter/internal/pkg/apperror"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const (
+	DiscountTypePercentage = "PERCENTAGE"
+	DiscountTypeFixed      = "FIXED"
+)
+
+// Line satu baris keranjang yang dihitung diskonnya
+type Line struct {
+	ProductID uuid.UUID
+	Qty       int32
+	UnitPrice float64
+}
+
+// Application hasil validasi voucher terhadap keranjang
+type Application struct {
+	VoucherID        uuid.UUID
+	Code             string
+	Subtotal         float64
+	EligibleSubtotal float64
+	Discount         float64
+}
+
+// CartLines mengubah item cart menjadi Line (harga sama dengan yang dipakai checkout)
+func CartLines(items []cart.CartItemDetailResponse) []Line {
+	lines := make([]Line, 0, len(items))
+	for _, item := range items {
+		pid, _ := uuid.Parse(item.ProductID)
+		lines = append(lines, Line{
+			ProductID: pid,
+			Qty:       item.Qty,
+			UnitPrice: item.CurrentPrice,
+		})
+	}
+	return lines
+}
+
+//go:generate mockgen -source=voucher_service.go -destination=../mock/voucher/voucher_service_mock.go -package=mock
+type Service interface {
+	// Customer
+	Preview(ctx context.Context, userID string, req ApplyVoucherRequest) (VoucherPreviewResponse, error)
+
+	// Checkout: dipanggil di dalam transaksi milik order
+	Apply(ctx context.Context, tx dbgen.DBTX, userID uuid.UUID, code string, lines []Line) (Application, error)
+	Redeem(ctx context.Context, tx dbgen.DBTX, userID, orderID uuid.UUID, app Application) error
+	Release(ctx context.Context, tx dbgen.DBTX, orderID uuid.UUID) error
+
+	// Admin
+	Create(ctx context.Context, req VoucherRequest) (VoucherResponse, error)
+	Update(ctx context.Context, id string, req VoucherRequest) (VoucherResponse, error)
+	Delete(ctx context.Context, id string) error
+	GetByID(ctx context.Context, id string) (VoucherResponse, error)
+	ListAdmin(ctx context.Context, search string, page, limit int) ([]VoucherResponse, int64, error)
+	Usage(ctx context.Context, id string, page, limit int) (VoucherUsageResponse, int64, error)
+}
+
+type service struct {
+	db       *sql.DB
+	repo     Repository
+	cartSvc  cart.Service
+	validate *validator.Validate
+}
+
+func NewService(db *sql.DB, r Repository, c cart.Service) Service {
+	return &service{
+		db:       db,
+		repo:     r,
+		cartSvc:  c,
+		validate: validator.New(),
+	}
+}
+
+func (s *service) Preview(ctx context.Context, userID string, req ApplyVoucherRequest) (VoucherPreviewResponse, error) {
+	if err := s.validate.Struct(req); err != nil {
+		return VoucherPreviewResponse{}, apperror.MapValidationError(err)
+	}
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return VoucherPreviewResponse{}, auth.ErrUnauthorized
+	}
+
+	cartData, err := s.cartSvc.Detail(ctx, cart.UserOwner(userID))
+	if err != nil {
+		return VoucherPreviewResponse{}, err
+	}
+	if len(cartData.Items) == 0 {
+		return VoucherPreviewResponse{}, vouchererrors.ErrCartEmpty
+	}
+
+	// Preview tidak mencatat apa pun; kuota dicek ulang saat checkout
+	app, err := s.Apply(ctx, nil, uid, req.Code, CartLines(cartData.Items))
+	if err != nil {
+		return VoucherPreviewResponse{}, err
+	}
+
+	return VoucherPreviewResponse{
+		Code:             app.Code,
+		Subtotal:         app.Subtotal,
+		EligibleSubtotal: app.EligibleSubtotal,
+		Discount:         app.Discount,
+		Total:            roundMoney(app.Subtotal - app.Discount),
+	}, nil
+}
+
+func (s *service) Apply(ctx context.Context, tx dbgen.DBTX, userID uuid.UUID, code string, lines []Line) (Application, error) {
+	qtx := s.repo
+	if tx != nil {
+		qtx = s.repo.WithTx(tx)
+	}
+
+	// 1. Kunci row voucher (FOR UPDATE) agar checkout paralel antre di sini
+	v, err := qtx.GetByCodeForUpdate(ctx, strings.TrimSpace(code))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Application{}, vouchererrors.ErrVoucherNotFound
+		}
+		return Application{}, vouchererrors.ErrVoucherFailed
+	}
+
+	// 2. Status & masa berlaku
+	now := time.Now()
+	if !v.IsActive || now.Before(v.StartsAt) || (v.EndsAt.Valid && !now.Before(v.EndsAt.Time)) {
+		return Application{}, vouchererrors.ErrVoucherNotActive
+	}
+
+	// 3. Kuota global & per user
+	if v.UsageLimit.Valid && v.UsedCount >= v.UsageLimit.Int32 {
+		return Application{}, vouchererrors.ErrUsageLimitReached
+	}
+	if v.PerUserLimit.Valid {
+		used, err := qtx.CountUserRedemptions(ctx, v.ID, userID)
+		if err != nil {
+			return Application{}, vouchererrors.ErrVoucherFailed
+		}
+		if used >= int64(v.PerUserLimit.Int32) {
+			return Application{}, vouchererrors.ErrUserLimitReached
+		}
+	}
+
+	// 4. Minimal belanja dihitung dari seluruh keranjang
+	var subtotal float64
+	productIDs := make([]uuid.UUID, 0, len(lines))
+	for _, l := range lines {
+		subtotal += l.UnitPrice * float64(l.Qty)
+		productIDs = append(productIDs, l.ProductID)
+	}
+	minSpend, _ := strconv.ParseFloat(v.MinSpend, 64)
+	if subtotal < minSpend {
+		return Application{}, vouchererrors.ErrMinSpendNotMet
+	}
+
+	// 5. Diskon hanya untuk produk yang lolos batasan produk/kategori
+	eligibleIDs, err := qtx.ListEligibleProducts(ctx, v.ID, productIDs)
+	if err != nil {
+		return Application{}, vouchererrors.ErrVoucherFailed
+	}
+	eligible := make(map[uuid.UUID]bool, len(eligibleIDs))
+	for _, id := range eligibleIDs {
+		eligible[id] = true
+	}
+	var eligibleSubtotal float64
+	for _, l := range lines {
+		if eligible[l.ProductID] {
+			eligibleSubtotal += l.UnitPrice * float64(l.Qty)
+		}
+	}
+	if eligibleSubtotal <= 0 {
+		return Application{}, vouchererrors.ErrVoucherNotApplicable
+	}
+
+	return Application{
+		VoucherID:        v.ID,
+		Code:             v.Code,
+		Subtotal:         roundMoney(subtotal),
+		EligibleSubtotal: roundMoney(eligibleSubtotal),
+		Discount:         calculateDiscount(v, eligibleSubtotal),
+	}, nil
+}
+
+func (s *service) Redeem(ctx context.Context, tx dbgen.DBTX, userID, orderID uuid.UUID, app Application) error {
+	qtx := s.repo
+	if tx != nil {
+		qtx = s.repo.WithTx(tx)
+	}
+
+	// Guard atomik terakhir: gagal jika kuota global sudah habis
+	if _, err := qtx.IncrementUsage(ctx, app.VoucherID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return vouchererrors.ErrUsageLimitReached
+		}
+		return vouchererrors.ErrVoucherFailed
+	}
+
+	if _, err := qtx.CreateRedemption(ctx, dbgen.CreateVoucherRedemptionParams{
+		VoucherID:      app.VoucherID,
+		UserID:         userID,
+		OrderID:        orderID,
+		DiscountAmount: formatMoney(app.Discount),
+	}); err != nil {
+		return vouchererrors.ErrVoucherFailed
+	}
+
+	return nil
+}
+
+func (s *service) Release(ctx context.Context, tx dbgen.DBTX, orderID uuid.UUID) error {
+	qtx := s.repo
+	if tx != nil {
+		qtx = s.repo.WithTx(tx)
+	}
+
+	voucherID, err := qtx.DeleteRedemptionByOrder(ctx, orderID)
+	if err != nil {
+		// Order tanpa voucher
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return vouchererrors.ErrVoucherFailed
+	}
+
+	if err := qtx.DecrementUsage(ctx, voucherID); err != nil {
+		return vouchererrors.ErrVoucherFailed
+	}
+	return nil
+}
+
+func (s *service) Create(ctx context.Context, req VoucherRequest) (VoucherResponse, error) {
+	params, productIDs, categoryIDs, err := s.prepare(req)
+	if err != nil {
+		return VoucherResponse{}, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return VoucherResponse{}, vouchererrors.ErrVoucherFailed
+	}
+	defer tx.Rollback()
+
+	qtx := s.repo.WithTx(tx)
+
+	v, err := qtx.Create(ctx, params)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return VoucherResponse{}, vouchererrors.ErrVoucherCodeExists
+		}
+		return VoucherResponse{}, vouchererrors.ErrVoucherFailed
+	}
+
+	if err := s.saveRestrictions(ctx, qtx, v.ID, productIDs, categoryIDs); err != nil {
+		return VoucherResponse{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return VoucherResponse{}, vouchererrors.ErrVoucherFailed
+	}
+
+	return mapVoucher(v, productIDs, categoryIDs), nil
+}
+
+func (s *service) Update(ctx context.Context, id string, req VoucherRequest) (VoucherResponse, error) {
+	vid, err := uuid.Parse(id)
+	if err != nil {
+		return VoucherResponse{}, vouchererrors.ErrInvalidVoucherID
+	}
+
+	params, productIDs, categoryIDs, err := s.prepare(req)
+	if err != nil {
+		return VoucherResponse{}, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return VoucherResponse{}, vouchererrors.ErrVoucherFailed
+	}
+	defer tx.Rollback()
+
+	qtx := s.repo.WithTx(tx)
+
+	v, err := qtx.Update(ctx, dbgen.UpdateVoucherParams{
+		ID:            vid,
+		Code:          params.Code,
+		Description:   params.Description,
+		DiscountType:  params.DiscountType,
+		DiscountValue: params.DiscountValue,
+		MaxDiscount:   params.MaxDiscount,
+		MinSpend:      params.MinSpend,
+		UsageLimit:    params.UsageLimit,
+		PerUserLimit:  params.PerUserLimit,
+		StartsAt:      params.StartsAt,
+		EndsAt:        params.EndsAt,
+		IsActive:      params.IsActive,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return VoucherResponse{}, vouchererrors.ErrVoucherNotFound
+		}
+		if isUniqueViolation(err) {
+			return VoucherResponse{}, vouchererrors.ErrVoucherCodeExists
+		}
+		return VoucherResponse{}, vouchererrors.ErrVoucherFailed
+	}
+
+	// Batasan lama diganti seluruhnya
+	if err := qtx.DeleteProducts(ctx, vid); err != nil {
+		return VoucherResponse{}, vouchererrors.ErrVoucherFailed
+	}
+	if err := qtx.DeleteCategories(ctx, vid); err != nil {
+		return VoucherResponse{}, vouchererrors.ErrVoucherFailed
+	}
+	if err := s.saveRestrictions(ctx, qtx, vid, productIDs, categoryIDs); err != nil {
+		return VoucherResponse{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return VoucherResponse{}, vouchererrors.ErrVoucherFailed
+	}
+
+	return mapVoucher(v, productIDs, categoryIDs), nil
+}
+
+func (s *service) Delete(ctx context.Context, id string) error {
+	vid, err := uuid.Parse(id)
+	if err != nil {
+		return vouchererrors.ErrInvalidVoucherID
+	}
+
+	affected, err := s.repo.SoftDelete(ctx, vid)
+	if err != nil {
+		return vouchererrors.ErrVoucherFailed
+	}
+	if affected == 0 {
+		return vouchererrors.ErrVoucherNotFound
+	}
+	return nil
+}
+
+func (s *service) GetByID(ctx context.Context, id string) (VoucherResponse, error) {
+	vid, err := uuid.Parse(id)
+	if err != nil {
+		return VoucherResponse{}, vouchererrors.ErrInvalidVoucherID
+	}
+
+	v, err := s.repo.GetByID(ctx, vid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return VoucherResponse{}, vouchererrors.ErrVoucherNotFound
+		}
+		return VoucherResponse{}, vouchererrors.ErrVoucherFailed
+	}
+
+	productIDs, err := s.repo.ListProductIDs(ctx, vid)
+	if err != nil {
+		return VoucherResponse{}, vouchererrors.ErrVoucherFailed
+	}
+	categoryIDs, err := s.repo.ListCategoryIDs(ctx, vid)
+	if err != nil {
+		return VoucherResponse{}, vouchererrors.ErrVoucherFailed
+	}
+
+	return mapVoucher(v, productIDs, categoryIDs), nil
+}
+
+func (s *service) ListAdmin(ctx context.Context, search string, page, limit int) ([]VoucherResponse, int64, error) {
+	rows, err := s.repo.ListAdmin(ctx, dbgen.ListVouchersAdminParams{
+		Limit:  int32(limit),
+		Offset: int32((page - 1) * limit),
+		Search: dbgen.ToText(search),
+	})
+	if err != nil {
+		return nil, 0, vouchererrors.ErrVoucherFailed
+	}
+
+	var total int64
+	res := make([]VoucherResponse, 0, len(rows))
+	for _, r := range rows {
+		total = r.TotalCount
+		res = append(res, mapVoucher(dbgen.Voucher{
+			ID:            r.ID,
+			Code:          r.Code,
+			Description:   r.Description,
+			DiscountType:  r.DiscountType,
+			DiscountValue: r.DiscountValue,
+			MaxDiscount:   r.MaxDiscount,
+			MinSpend:      r.MinSpend,
+			UsageLimit:    r.UsageLimit,
+			PerUserLimit:  r.PerUserLimit,
+			UsedCount:     r.UsedCount,
+			StartsAt:      r.StartsAt,
+			EndsAt:        r.EndsAt,
+			IsActive:      r.IsActive,
+			CreatedAt:     r.CreatedAt,
+			UpdatedAt:     r.UpdatedAt,
+		}, nil, nil))
+	}
+
+	return res, total, nil
+}
+
+func (s *service) Usage(ctx context.Context, id string, page, limit int) (VoucherUsageResponse, int64, error) {
+	vid, err := uuid.Parse(id)
+	if err != nil {
+		return VoucherUsageResponse{}, 0, vouchererrors.ErrInvalidVoucherID
+	}
+
+	v, err := s.repo.GetByID(ctx, vid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return VoucherUsageResponse{}, 0, vouchererrors.ErrVoucherNotFound
+		}
+		return VoucherUsageResponse{}, 0, vouchererrors.ErrVoucherFailed
+	}
+
+	summary, err := s.repo.GetUsageSummary(ctx, vid)
+	if err != nil {
+		return VoucherUsageResponse{}, 0, vouchererrors.ErrVoucherFailed
+	}
+
+	rows, err := s.repo.ListRedemptions(ctx, vid, int32(limit), int32((page-1)*limit))
+	if err != nil {
+		return VoucherUsageResponse{}, 0, vouchererrors.ErrVoucherFailed
+	}
+
+	totalDiscount, _ := strconv.ParseFloat(summary.TotalDiscount, 64)
+	res := VoucherUsageResponse{
+		VoucherID:       v.ID.String(),
+		Code:            v.Code,
+		UsedCount:       v.UsedCount,
+		UsageLimit:      nullInt32Ptr(v.UsageLimit),
+		RedemptionCount: summary.RedemptionCount,
+		UniqueUsers:     summary.UniqueUsers,
+		TotalDiscount:   totalDiscount,
+		Redemptions:     make([]VoucherRedemptionResponse, 0, len(rows)),
+	}
+
+	var total int64
+	for _, r := range rows {
+		total = r.TotalCount
+		amount, _ := strconv.ParseFloat(r.DiscountAmount, 64)
+		res.Redemptions = append(res.Redemptions, VoucherRedemptionResponse{
+			ID:             r.ID.String(),
+			UserID:         r.UserID.String(),
+			OrderID:        r.OrderID.String(),
+			OrderNumber:    r.OrderNumber,
+			DiscountAmount: amount,
+			CreatedAt:      r.CreatedAt,
+		})
+	}
+
+	return res, total, nil
+}
+
+// prepare validasi request admin dan ubah ke parameter insert
+func (s *service) prepare(req VoucherRequest) (dbgen.CreateVoucherParams, []uuid.UUID, []uuid.UUID, error) {
+	if err := s.validate.Struct(req); err != nil {
+		return dbgen.CreateVoucherParams{}, nil, nil, apperror.MapValidationError(err)
+	}
+	if req.DiscountType == DiscountTypePercentage && req.DiscountValue > 100 {
+		return dbgen.CreateVoucherParams{}, nil, nil, vouchererrors.ErrInvalidDiscount
+	}
+
+	startsAt := time.Now()
+	if req.StartsAt != nil {
+		startsAt = *req.StartsAt
+	}
+	if req.EndsAt != nil && !req.EndsAt.After(startsAt) {
+		return dbgen.CreateVoucherParams{}, nil, nil, vouchererrors.ErrInvalidSchedule
+	}
+
+	isActive := true
+	if req.IsActive != nil {
+		isActive = *req.IsActive
+	}
+
+	params := dbgen.CreateVoucherParams{
+		Code:          strings.ToUpper(strings.TrimSpace(req.Code)),
+		Description:   dbgen.ToText(req.Description),
+		DiscountType:  req.DiscountType,
+		DiscountValue: formatMoney(req.DiscountValue),
+		MinSpend:      formatMoney(req.MinSpend),
+		StartsAt:      startsAt,
+		IsActive:      isActive,
+	}
+	// max_discount hanya relevan untuk PERCENTAGE
+	if req.MaxDiscount != nil && req.DiscountType == DiscountTypePercentage {
+		params.MaxDiscount = sql.NullString{String: formatMoney(*req.MaxDiscount), Valid: true}
+	}
+	if req.UsageLimit != nil {
+		params.UsageLimit = sql.NullInt32{Int32: *req.UsageLimit, Valid: true}
+	}
+	if req.PerUserLimit != nil {
+		params.PerUserLimit = sql.NullInt32{Int32: *req.PerUserLimit, Valid: true}
+	}
+	if req.EndsAt != nil {
+		params.EndsAt = sql.NullTime{Time: *req.EndsAt, Valid: true}
+	}
+
+	return params, parseIDs(req.ProductIDs), parseIDs(req.CategoryIDs), nil
+}
+
+func (s *service) saveRestrictions(ctx context.Context, qtx Repository, voucherID uuid.UUID, productIDs, categoryIDs []uuid.UUID) error {
+	if len(productIDs) > 0 {
+		if err := qtx.AddProducts(ctx, voucherID, productIDs); err != nil {
+			return vouchererrors.ErrVoucherFailed
+		}
+	}
+	if len(categoryIDs) > 0 {
+		if err := qtx.AddCategories(ctx, voucherID, categoryIDs); err != nil {
+			return vouchererrors.ErrVoucherFailed
+		}
+	}
+	return nil
+}
+
+func calculateDiscount(v dbgen.Voucher, eligibleSubtotal float64) float64 {
+	value, _ := strconv.ParseFloat(v.DiscountValue, 64)
+
+	var discount float64
+	switch v.DiscountType {
+	case DiscountTypePercentage:
+		discount = eligibleSubtotal * value / 100
+		if v.MaxDiscount.Valid {
+			maxDiscount, _ := strconv.ParseFloat(v.MaxDiscount.String, 64)
+			discount = math.Min(discount, maxDiscount)
+		}
+	default:
+		discount = value
+	}
+
+	// Diskon tidak boleh melebihi nilai produk yang didiskon
+	return roundMoney(math.Min(discount, eligibleSubtotal))
+}
+
+func mapVoucher(v dbgen.Voucher, productIDs, categoryIDs []uuid.UUID) VoucherResponse {
+	value, _ := strconv.ParseFloat(v.DiscountValue, 64)
+	minSpend, _ := strconv.ParseFloat(v.MinSpend, 64)
+
+	res := VoucherResponse{
+		ID:            v.ID.String(),
+		Code:          v.Code,
+		Description:   v.Description.String,
+		DiscountType:  v.DiscountType,
+		DiscountValue: value,
+		MinSpend:      minSpend,
+		UsageLimit:    nullInt32Ptr(v.UsageLimit),
+		PerUserLimit:  nullInt32Ptr(v.PerUserLimit),
+		UsedCount:     v.UsedCount,
+		StartsAt:      v.StartsAt,
+		IsActive:      v.IsActive,
+		CreatedAt:     v.CreatedAt,
+		UpdatedAt:     v.UpdatedAt,
+	}
+	if v.MaxDiscount.Valid {
+		maxDiscount, _ := strconv.ParseFloat(v.MaxDiscount.String, 64)
+		res.MaxDiscount = &maxDiscount
+	}
+	if v.EndsAt.Valid {
+		endsAt := v.EndsAt.Time
+		res.EndsAt = &endsAt
+	}
+	for _, id := range productIDs {
+		res.ProductIDs = append(res.ProductIDs, id.String())
+	}
+	for _, id := range categoryIDs {
+		res.CategoryIDs = append(res.CategoryIDs, id.String())
+	}
+	return res
+}
+
+func parseIDs(ids []string) []uuid.UUID {
+	res := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		// Format sudah divalidasi (dive,uuid)
+		if uid, err := uuid.Parse(id); err == nil {
+			res = append(res, uid)
+		}
+	}
+	return res
+}
+
+func nullInt32Ptr(n sql.NullInt32) *int32 {
+	if !n.Valid {
+		return nil
+	}
+	v := n.Int32
+	return &v
+}
+
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505"
+}
+
+func roundMoney(v float64) float64 {
+	return math.Round(v*100) / 100
+}
+
+func formatMoney(v float64) string {
+	return fmt.Sprintf("%.2f", v)
+}
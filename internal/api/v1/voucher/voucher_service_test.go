@@ -0,0 +1,364 @@
+package voucher_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"go-sqlc-starter/internal/api/v1/cart"
+	cartMock "go-sqlc-starter/internal/api/v1/mock/cart"
+	voucherMock "go-sqlc-starter/internal/api/v1/mock/voucher"
+	"go-sqlc-starter/internal/api/v1/voucher"
+	vouchererrors "go-sqlc-starter/internal/api/v1/voucher/errors"
+	"go-sqlc-starter/internal/dbgen"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+type serviceDeps struct {
+	db      *sql.DB
+	sqlMock sqlmock.Sqlmock
+	service voucher.Service
+	repo    *voucherMock.MockRepository
+	cartSvc *cartMock.MockService
+}
+
+func setupServiceTest(t *testing.T) *serviceDeps {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	db, sqlMock, _ := sqlmock.New()
+	repo := voucherMock.NewMockRepository(ctrl)
+	cartSvc := cartMock.NewMockService(ctrl)
+
+	return &serviceDeps{
+		db:      db,
+		sqlMock: sqlMock,
+		service: voucher.NewService(db, repo, cartSvc),
+		repo:    repo,
+		cartSvc: cartSvc,
+	}
+}
+
+func expectTx(t *testing.T, mock sqlmock.Sqlmock, commit bool) {
+	t.Helper()
+
+	mock.ExpectBegin()
+	if commit {
+		mock.ExpectCommit()
+	} else {
+		mock.ExpectRollback()
+	}
+}
+
+func activeVoucher(discountType, value string) dbgen.Voucher {
+	return dbgen.Voucher{
+		ID:            uuid.New(),
+		Code:          "HEMAT",
+		DiscountType:  discountType,
+		DiscountValue: value,
+		MinSpend:      "0.00",
+		StartsAt:      time.Now().Add(-time.Hour),
+		IsActive:      true,
+	}
+}
+
+func TestVoucherService_Apply(t *testing.T) {
+	deps := setupServiceTest(t)
+	defer deps.db.Close()
+
+	ctx := context.Background()
+	userID := uuid.New()
+	shoe, shirt := uuid.New(), uuid.New()
+	lines := []voucher.Line{
+		{ProductID: shoe, Qty: 2, UnitPrice: 50000},
+		{ProductID: shirt, Qty: 1, UnitPrice: 100000},
+	}
+
+	t.Run("success - percentage capped by max discount on eligible items only", func(t *testing.T) {
+		v := activeVoucher(voucher.DiscountTypePercentage, "20.00")
+		v.MaxDiscount = sql.NullString{String: "15000.00", Valid: true}
+
+		deps.repo.EXPECT().GetByCodeForUpdate(ctx, "HEMAT").Return(v, nil)
+		deps.repo.EXPECT().
+			ListEligibleProducts(ctx, v.ID, []uuid.UUID{shoe, shirt}).
+			Return([]uuid.UUID{shoe}, nil)
+
+		app, err := deps.service.Apply(ctx, nil, userID, " HEMAT ", lines)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 200000.0, app.Subtotal)
+		assert.Equal(t, 100000.0, app.EligibleSubtotal)
+		assert.Equal(t, 15000.0, app.Discount)
+	})
+
+	t.Run("success - fixed discount never exceeds eligible subtotal", func(t *testing.T) {
+		v := activeVoucher(voucher.DiscountTypeFixed, "150000.00")
+
+		deps.repo.EXPECT().GetByCodeForUpdate(ctx, "HEMAT").Return(v, nil)
+		deps.repo.EXPECT().ListEligibleProducts(ctx, v.ID, gomock.Any()).Return([]uuid.UUID{shoe}, nil)
+
+		app, err := deps.service.Apply(ctx, nil, userID, "HEMAT", lines)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 100000.0, app.Discount)
+	})
+
+	t.Run("error - code not found", func(t *testing.T) {
+		deps.repo.EXPECT().GetByCodeForUpdate(ctx, "NOPE").Return(dbgen.Voucher{}, sql.ErrNoRows)
+
+		_, err := deps.service.Apply(ctx, nil, userID, "NOPE", lines)
+
+		assert.ErrorIs(t, err, vouchererrors.ErrVoucherNotFound)
+	})
+
+	t.Run("error - expired", func(t *testing.T) {
+		v := activeVoucher(voucher.DiscountTypeFixed, "10000.00")
+		v.EndsAt = sql.NullTime{Time: time.Now().Add(-time.Minute), Valid: true}
+
+		deps.repo.EXPECT().GetByCodeForUpdate(ctx, "HEMAT").Return(v, nil)
+
+		_, err := deps.service.Apply(ctx, nil, userID, "HEMAT", lines)
+
+		assert.ErrorIs(t, err, vouchererrors.ErrVoucherNotActive)
+	})
+
+	t.Run("error - global usage limit reached", func(t *testing.T) {
+		v := activeVoucher(voucher.DiscountTypeFixed, "10000.00")
+		v.UsageLimit = sql.NullInt32{Int32: 100, Valid: true}
+		v.UsedCount = 100
+
+		deps.repo.EXPECT().GetByCodeForUpdate(ctx, "HEMAT").Return(v, nil)
+
+		_, err := deps.service.Apply(ctx, nil, userID, "HEMAT", lines)
+
+		assert.ErrorIs(t, err, vouchererrors.ErrUsageLimitReached)
+	})
+
+	t.Run("error - per user limit reached", func(t *testing.T) {
+		v := activeVoucher(voucher.DiscountTypeFixed, "10000.00")
+		v.PerUserLimit = sql.NullInt32{Int32: 1, Valid: true}
+
+		deps.repo.EXPECT().GetByCodeForUpdate(ctx, "HEMAT").Return(v, nil)
+		deps.repo.EXPECT().CountUserRedemptions(ctx, v.ID, userID).Return(int64(1), nil)
+
+		_, err := deps.service.Apply(ctx, nil, userID, "HEMAT", lines)
+
+		assert.ErrorIs(t, err, vouchererrors.ErrUserLimitReached)
+	})
+
+	t.Run("error - min spend not met", func(t *testing.T) {
+		v := activeVoucher(voucher.DiscountTypeFixed, "10000.00")
+		v.MinSpend = "500000.00"
+
+		deps.repo.EXPECT().GetByCodeForUpdate(ctx, "HEMAT").Return(v, nil)
+
+		_, err := deps.service.Apply(ctx, nil, userID, "HEMAT", lines)
+
+		assert.ErrorIs(t, err, vouchererrors.ErrMinSpendNotMet)
+	})
+
+	t.Run("error - no eligible item", func(t *testing.T) {
+		v := activeVoucher(voucher.DiscountTypeFixed, "10000.00")
+
+		deps.repo.EXPECT().GetByCodeForUpdate(ctx, "HEMAT").Return(v, nil)
+		deps.repo.EXPECT().ListEligibleProducts(ctx, v.ID, gomock.Any()).Return([]uuid.UUID{}, nil)
+
+		_, err := deps.service.Apply(ctx, nil, userID, "HEMAT", lines)
+
+		assert.ErrorIs(t, err, vouchererrors.ErrVoucherNotApplicable)
+	})
+}
+
+func TestVoucherService_Redeem(t *testing.T) {
+	deps := setupServiceTest(t)
+	defer deps.db.Close()
+
+	ctx := context.Background()
+	userID, orderID := uuid.New(), uuid.New()
+	app := voucher.Application{VoucherID: uuid.New(), Code: "HEMAT", Discount: 12500}
+
+	t.Run("success - usage incremented and redemption recorded", func(t *testing.T) {
+		deps.repo.EXPECT().IncrementUsage(ctx, app.VoucherID).Return(int32(1), nil)
+		deps.repo.EXPECT().
+			CreateRedemption(ctx, dbgen.CreateVoucherRedemptionParams{
+				VoucherID:      app.VoucherID,
+				UserID:         userID,
+				OrderID:        orderID,
+				DiscountAmount: "12500.00",
+			}).
+			Return(dbgen.VoucherRedemption{}, nil)
+
+		err := deps.service.Redeem(ctx, nil, userID, orderID, app)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - quota taken by concurrent checkout", func(t *testing.T) {
+		deps.repo.EXPECT().IncrementUsage(ctx, app.VoucherID).Return(int32(0), sql.ErrNoRows)
+
+		err := deps.service.Redeem(ctx, nil, userID, orderID, app)
+
+		assert.ErrorIs(t, err, vouchererrors.ErrUsageLimitReached)
+	})
+}
+
+func TestVoucherService_Release(t *testing.T) {
+	deps := setupServiceTest(t)
+	defer deps.db.Close()
+
+	ctx := context.Background()
+	orderID, voucherID := uuid.New(), uuid.New()
+
+	t.Run("success - usage decremented", func(t *testing.T) {
+		deps.repo.EXPECT().DeleteRedemptionByOrder(ctx, orderID).Return(voucherID, nil)
+		deps.repo.EXPECT().DecrementUsage(ctx, voucherID).Return(nil)
+
+		assert.NoError(t, deps.service.Release(ctx, nil, orderID))
+	})
+
+	t.Run("success - order without voucher is a no-op", func(t *testing.T) {
+		deps.repo.EXPECT().DeleteRedemptionByOrder(ctx, orderID).Return(uuid.Nil, sql.ErrNoRows)
+
+		assert.NoError(t, deps.service.Release(ctx, nil, orderID))
+	})
+}
+
+func TestVoucherService_Preview(t *testing.T) {
+	deps := setupServiceTest(t)
+	defer deps.db.Close()
+
+	ctx := context.Background()
+	userID := uuid.New()
+
+	t.Run("success - discount previewed from cart", func(t *testing.T) {
+		productID := uuid.New()
+		v := activeVoucher(voucher.DiscountTypePercentage, "10.00")
+
+		deps.cartSvc.EXPECT().Detail(ctx, cart.UserOwner(userID.String())).Return(cart.CartDetailResponse{
+			Items: []cart.CartItemDetailResponse{{ProductID: productID.String(), Qty: 3, CurrentPrice: 10000}},
+		}, nil)
+		deps.repo.EXPECT().GetByCodeForUpdate(ctx, "HEMAT").Return(v, nil)
+		deps.repo.EXPECT().ListEligibleProducts(ctx, v.ID, []uuid.UUID{productID}).Return([]uuid.UUID{productID}, nil)
+
+		res, err := deps.service.Preview(ctx, userID.String(), voucher.ApplyVoucherRequest{Code: "HEMAT"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3000.0, res.Discount)
+		assert.Equal(t, 27000.0, res.Total)
+	})
+
+	t.Run("error - empty cart", func(t *testing.T) {
+		deps.cartSvc.EXPECT().Detail(ctx, cart.UserOwner(userID.String())).Return(cart.CartDetailResponse{}, nil)
+
+		_, err := deps.service.Preview(ctx, userID.String(), voucher.ApplyVoucherRequest{Code: "HEMAT"})
+
+		assert.ErrorIs(t, err, vouchererrors.ErrCartEmpty)
+	})
+}
+
+func TestVoucherService_Create(t *testing.T) {
+	deps := setupServiceTest(t)
+	defer deps.db.Close()
+
+	ctx := context.Background()
+	maxDiscount := 50000.0
+	categoryID := uuid.New()
+
+	t.Run("success - code normalized and restrictions saved", func(t *testing.T) {
+		expectTx(t, deps.sqlMock, true)
+		voucherID := uuid.New()
+
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo).AnyTimes()
+		deps.repo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, arg dbgen.CreateVoucherParams) (dbgen.Voucher, error) {
+				assert.Equal(t, "PAYDAY", arg.Code)
+				assert.Equal(t, "10.00", arg.DiscountValue)
+				assert.Equal(t, "50000.00", arg.MaxDiscount.String)
+				assert.True(t, arg.IsActive)
+				return dbgen.Voucher{ID: voucherID, Code: arg.Code, DiscountType: arg.DiscountType, DiscountValue: arg.DiscountValue, MinSpend: arg.MinSpend}, nil
+			})
+		deps.repo.EXPECT().AddCategories(ctx, voucherID, []uuid.UUID{categoryID}).Return(nil)
+
+		res, err := deps.service.Create(ctx, voucher.VoucherRequest{
+			Code:          " payday ",
+			DiscountType:  voucher.DiscountTypePercentage,
+			DiscountValue: 10,
+			MaxDiscount:   &maxDiscount,
+			CategoryIDs:   []string{categoryID.String()},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "PAYDAY", res.Code)
+		assert.Equal(t, []string{categoryID.String()}, res.CategoryIDs)
+		assert.NoError(t, deps.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("error - percentage above 100", func(t *testing.T) {
+		_, err := deps.service.Create(ctx, voucher.VoucherRequest{
+			Code:          "BROKEN",
+			DiscountType:  voucher.DiscountTypePercentage,
+			DiscountValue: 120,
+		})
+
+		assert.ErrorIs(t, err, vouchererrors.ErrInvalidDiscount)
+	})
+
+	t.Run("error - duplicate code", func(t *testing.T) {
+		expectTx(t, deps.sqlMock, false)
+
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo).AnyTimes()
+		deps.repo.EXPECT().Create(ctx, gomock.Any()).Return(dbgen.Voucher{}, &pq.Error{Code: "23505"})
+
+		_, err := deps.service.Create(ctx, voucher.VoucherRequest{
+			Code:          "PAYDAY",
+			DiscountType:  voucher.DiscountTypeFixed,
+			DiscountValue: 10000,
+		})
+
+		assert.ErrorIs(t, err, vouchererrors.ErrVoucherCodeExists)
+		assert.NoError(t, deps.sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestVoucherService_Usage(t *testing.T) {
+	deps := setupServiceTest(t)
+	defer deps.db.Close()
+
+	ctx := context.Background()
+	voucherID := uuid.New()
+
+	t.Run("success - summary with redemptions", func(t *testing.T) {
+		deps.repo.EXPECT().GetByID(ctx, voucherID).Return(dbgen.Voucher{ID: voucherID, Code: "HEMAT", UsedCount: 2}, nil)
+		deps.repo.EXPECT().GetUsageSummary(ctx, voucherID).Return(dbgen.GetVoucherUsageSummaryRow{
+			RedemptionCount: 2,
+			UniqueUsers:     1,
+			TotalDiscount:   "25000.00",
+		}, nil)
+		deps.repo.EXPECT().ListRedemptions(ctx, voucherID, int32(20), int32(0)).Return([]dbgen.ListVoucherRedemptionsRow{
+			{ID: uuid.New(), OrderNumber: "ORD-1", DiscountAmount: "12500.00", TotalCount: 2},
+			{ID: uuid.New(), OrderNumber: "ORD-2", DiscountAmount: "12500.00", TotalCount: 2},
+		}, nil)
+
+		res, total, err := deps.service.Usage(ctx, voucherID.String(), 1, 20)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+		assert.Equal(t, 25000.0, res.TotalDiscount)
+		assert.Len(t, res.Redemptions, 2)
+	})
+
+	t.Run("error - voucher not found", func(t *testing.T) {
+		deps.repo.EXPECT().GetByID(ctx, voucherID).Return(dbgen.Voucher{}, sql.ErrNoRows)
+
+		_, _, err := deps.service.Usage(ctx, voucherID.String(), 1, 20)
+
+		assert.ErrorIs(t, err, vouchererrors.ErrVoucherNotFound)
+	})
+}
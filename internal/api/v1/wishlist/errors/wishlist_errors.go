@@ -0,0 +1,32 @@
+package wishlisterrors
+
+import (
+	"go-sqlc-starter/internal/pkg/apperror"
+	"net/http"
+)
+
+var (
+	ErrWishlistItemNotFound = apperror.New(
+		apperror.CodeNotFound,
+		"Product is not in wishlist",
+		http.StatusNotFound,
+	)
+
+	ErrSharedWishlistNotFound = apperror.New(
+		apperror.CodeNotFound,
+		"Shared wishlist not found",
+		http.StatusNotFound,
+	)
+
+	ErrProductUnavailable = apperror.New(
+		apperror.CodeInvalidState,
+		"Product is not available for purchase",
+		http.StatusBadRequest,
+	)
+
+	ErrShareFailed = apperror.New(
+		apperror.CodeInternalError,
+		"Failed to create share link",
+		http.StatusInternalServerError,
+	)
+)
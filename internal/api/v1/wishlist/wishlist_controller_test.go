@@ -0,0 +1,152 @@
+package wishlist_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go-sqlc-starter/internal/api/v1/wishlist"
+	wishlisterrors "go-sqlc-starter/internal/api/v1/wishlist/errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== FAKE SERVICE (Mock Manual) ====================
+
+type fakeWishlistService struct {
+	addItemFn    func(ctx context.Context, userID string, req wishlist.AddItemRequest) error
+	moveToCartFn func(ctx context.Context, userID, productID string, req wishlist.MoveToCartRequest) error
+	getSharedFn  func(ctx context.Context, token string) (wishlist.WishlistResponse, error)
+}
+
+func (f *fakeWishlistService) List(ctx context.Context, userID string) (wishlist.WishlistResponse, error) {
+	return wishlist.WishlistResponse{}, nil
+}
+func (f *fakeWishlistService) AddItem(ctx context.Context, userID string, req wishlist.AddItemRequest) error {
+	return f.addItemFn(ctx, userID, req)
+}
+func (f *fakeWishlistService) RemoveItem(ctx context.Context, userID, productID string) error {
+	return nil
+}
+func (f *fakeWishlistService) MoveToCart(ctx context.Context, userID, productID string, req wishlist.MoveToCartRequest) error {
+	return f.moveToCartFn(ctx, userID, productID, req)
+}
+func (f *fakeWishlistService) MoveFromCart(ctx context.Context, userID, productID string) error {
+	return nil
+}
+func (f *fakeWishlistService) Share(ctx context.Context, userID string) (wishlist.ShareResponse, error) {
+	return wishlist.ShareResponse{}, nil
+}
+func (f *fakeWishlistService) Unshare(ctx context.Context, userID string) error {
+	return nil
+}
+func (f *fakeWishlistService) GetShared(ctx context.Context, token string) (wishlist.WishlistResponse, error) {
+	return f.getSharedFn(ctx, token)
+}
+
+func TestWishlistController_AddItem(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	perform := func(svc *fakeWishlistService, userID, body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		if userID != "" {
+			c.Set("user_id", userID)
+		}
+		c.Request = httptest.NewRequest(http.MethodPost, "/wishlist/items", bytes.NewBufferString(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		wishlist.NewController(svc).AddItem(c)
+		return w
+	}
+
+	t.Run("positive - created", func(t *testing.T) {
+		svc := &fakeWishlistService{
+			addItemFn: func(ctx context.Context, userID string, req wishlist.AddItemRequest) error {
+				assert.Equal(t, "user-1", userID)
+				assert.Equal(t, "p-1", req.ProductID)
+				return nil
+			},
+		}
+
+		w := perform(svc, "user-1", `{"productId":"p-1"}`)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("negative - not authenticated", func(t *testing.T) {
+		w := perform(&fakeWishlistService{}, "", `{"productId":"p-1"}`)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestWishlistController_MoveToCart(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	perform := func(svc *fakeWishlistService, body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Set("user_id", "user-1")
+		c.Params = gin.Params{{Key: "id", Value: "p-1"}}
+		c.Request = httptest.NewRequest(http.MethodPost, "/wishlist/items/p-1/move-to-cart", bytes.NewBufferString(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		wishlist.NewController(svc).MoveToCart(c)
+		return w
+	}
+
+	t.Run("positive - empty body defaults qty", func(t *testing.T) {
+		svc := &fakeWishlistService{
+			moveToCartFn: func(ctx context.Context, userID, productID string, req wishlist.MoveToCartRequest) error {
+				assert.Equal(t, "p-1", productID)
+				assert.Zero(t, req.Qty)
+				return nil
+			},
+		}
+
+		w := perform(svc, "")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("negative - product unavailable", func(t *testing.T) {
+		svc := &fakeWishlistService{
+			moveToCartFn: func(ctx context.Context, userID, productID string, req wishlist.MoveToCartRequest) error {
+				return wishlisterrors.ErrProductUnavailable
+			},
+		}
+
+		w := perform(svc, `{"qty":2}`)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestWishlistController_GetShared(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	svc := &fakeWishlistService{
+		getSharedFn: func(ctx context.Context, token string) (wishlist.WishlistResponse, error) {
+			if token == "valid" {
+				return wishlist.WishlistResponse{Items: []wishlist.WishlistItemResponse{{Name: "Kaos"}}}, nil
+			}
+			return wishlist.WishlistResponse{}, wishlisterrors.ErrSharedWishlistNotFound
+		},
+	}
+
+	r := gin.New()
+	r.GET("/wishlists/shared/:token", wishlist.NewController(svc).GetShared)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/wishlists/shared/valid", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"name":"Kaos"`)
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/wishlists/shared/revoked", nil))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,41 @@
+package wishlist
+
+import "time"
+
+// ==================== REQUEST STRUCTS ====================
+
+type AddItemRequest struct {
+	ProductID string `json:"productId" validate:"required,uuid"`
+}
+
+// MoveToCartRequest qty opsional, default 1
+type MoveToCartRequest struct {
+	Qty int32 `json:"qty" validate:"omitempty,min=1"`
+}
+
+// ==================== RESPONSE STRUCTS ====================
+
+type WishlistItemResponse struct {
+	ID             string    `json:"id"`
+	ProductID      string    `json:"productId"`
+	Name           string    `json:"name"`
+	Slug           string    `json:"slug"`
+	ImageURL       string    `json:"imageUrl"`
+	Price          float64   `json:"price"`
+	CompareAtPrice float64   `json:"compareAtPrice,omitempty"`
+	Stock          int32     `json:"stock"`
+	InStock        bool      `json:"inStock"`
+	IsAvailable    bool      `json:"isAvailable"`
+	AddedAt        time.Time `json:"addedAt"`
+}
+
+type WishlistResponse struct {
+	Items []WishlistItemResponse `json:"items"`
+	// ShareToken hanya untuk pemilik; kosong = belum dibagikan
+	ShareToken string `json:"shareToken,omitempty"`
+}
+
+type ShareResponse struct {
+	Token string `json:"token"`
+	Path  string `json:"path"`
+}
@@ -0,0 +1,74 @@
+package wishlist
+
+import (
+	"context"
+	"database/sql"
+	"go-sqlc-starter/internal/dbgen"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=wishlist_repo.go -destination=../mock/wishlist/wishlist_repo_mock.go -package=mock
+type Repository interface {
+	Upsert(ctx context.Context, userID uuid.UUID) (dbgen.Wishlist, error)
+	GetByUserID(ctx context.Context, userID uuid.UUID) (dbgen.Wishlist, error)
+	GetByShareToken(ctx context.Context, token string) (dbgen.Wishlist, error)
+	SetShareToken(ctx context.Context, id uuid.UUID, token sql.NullString) (dbgen.Wishlist, error)
+
+	AddItem(ctx context.Context, wishlistID, productID uuid.UUID) error
+	DeleteItem(ctx context.Context, wishlistID, productID uuid.UUID) (int64, error)
+	GetItem(ctx context.Context, wishlistID, productID uuid.UUID) (dbgen.GetWishlistItemRow, error)
+	ListItems(ctx context.Context, wishlistID uuid.UUID) ([]dbgen.ListWishlistItemsRow, error)
+}
+
+type repository struct {
+	q *dbgen.Queries
+}
+
+func NewRepository(q *dbgen.Queries) Repository {
+	return &repository{q: q}
+}
+
+func (r *repository) Upsert(ctx context.Context, userID uuid.UUID) (dbgen.Wishlist, error) {
+	return r.q.UpsertWishlist(ctx, userID)
+}
+
+func (r *repository) GetByUserID(ctx context.Context, userID uuid.UUID) (dbgen.Wishlist, error) {
+	return r.q.GetWishlistByUserID(ctx, userID)
+}
+
+func (r *repository) GetByShareToken(ctx context.Context, token string) (dbgen.Wishlist, error) {
+	return r.q.GetWishlistByShareToken(ctx, sql.NullString{String: token, Valid: true})
+}
+
+func (r *repository) SetShareToken(ctx context.Context, id uuid.UUID, token sql.NullString) (dbgen.Wishlist, error) {
+	return r.q.SetWishlistShareToken(ctx, dbgen.SetWishlistShareTokenParams{
+		ID:         id,
+		ShareToken: token,
+	})
+}
+
+func (r *repository) AddItem(ctx context.Context, wishlistID, productID uuid.UUID) error {
+	return r.q.AddWishlistItem(ctx, dbgen.AddWishlistItemParams{
+		WishlistID: wishlistID,
+		ProductID:  productID,
+	})
+}
+
+func (r *repository) DeleteItem(ctx context.Context, wishlistID, productID uuid.UUID) (int64, error) {
+	return r.q.DeleteWishlistItem(ctx, dbgen.DeleteWishlistItemParams{
+		WishlistID: wishlistID,
+		ProductID:  productID,
+	})
+}
+
+func (r *repository) GetItem(ctx context.Context, wishlistID, productID uuid.UUID) (dbgen.GetWishlistItemRow, error) {
+	return r.q.GetWishlistItem(ctx, dbgen.GetWishlistItemParams{
+		WishlistID: wishlistID,
+		ProductID:  productID,
+	})
+}
+
+func (r *repository) ListItems(ctx context.Context, wishlistID uuid.UUID) ([]dbgen.ListWishlistItemsRow, error) {
+	return r.q.ListWishlistItems(ctx, wishlistID)
+}
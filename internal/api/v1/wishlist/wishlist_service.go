@@ -0,0 +1,346 @@
+package wishlist
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	autherrors "go-sqlc-starter/internal/api/v1/auth/errors"
+	"go-sqlc-starter/internal/api/v1/cart"
+	carterrors "go-sqlc-starter/internal/api/v1/cart/errors"
+	"go-sqlc-starter/internal/api/v1/product"
+	producterrors "go-sqlc-starter/internal/api/v1/product/errors"
+	wishlisterrors "go-sqlc-starter/internal/api/v1/wishlist/errors"
+	"go-sqlc-starter/internal/dbgen"
+	"go-sqlc-starter/internal/pkg/apperror"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+// SharedPathPrefix path publik wishlist yang dibagikan (lihat routes)
+const SharedPathPrefix = "/api/v1/wishlists/shared/"
+
+//go:generate mockgen -source=wishlist_service.go -destination=../mock/wishlist/wishlist_service_mock.go -package=mock
+type Service interface {
+	List(ctx context.Context, userID string) (WishlistResponse, error)
+	AddItem(ctx context.Context, userID string, req AddItemRequest) error
+	RemoveItem(ctx context.Context, userID, productID string) error
+
+	// Pindah antar wishlist <-> cart (pakai cart.Service)
+	MoveToCart(ctx context.Context, userID, productID string, req MoveToCartRequest) error
+	MoveFromCart(ctx context.Context, userID, productID string) error
+
+	// Share link publik
+	Share(ctx context.Context, userID string) (ShareResponse, error)
+	Unshare(ctx context.Context, userID string) error
+	GetShared(ctx context.Context, token string) (WishlistResponse, error)
+}
+
+type service struct {
+	repo        Repository
+	productRepo product.Repository
+	cartSvc     cart.Service
+	validate    *validator.Validate
+}
+
+func NewService(r Repository, pr product.Repository, cs cart.Service) Service {
+	return &service{
+		repo:        r,
+		productRepo: pr,
+		cartSvc:     cs,
+		validate:    validator.New(),
+	}
+}
+
+// ==================== HELPERS ====================
+
+func parseIDs(userID, productID string) (uuid.UUID, uuid.UUID, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, autherrors.ErrInvalidUserID
+	}
+	pid, err := uuid.Parse(productID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, producterrors.ErrInvalidProductID
+	}
+	return uid, pid, nil
+}
+
+// getWishlist wishlist milik user; belum punya wishlist = item pasti tidak ada
+func (s *service) getWishlist(ctx context.Context, uid uuid.UUID) (dbgen.Wishlist, error) {
+	w, err := s.repo.GetByUserID(ctx, uid)
+	if err == sql.ErrNoRows {
+		return dbgen.Wishlist{}, wishlisterrors.ErrWishlistItemNotFound
+	}
+	return w, err
+}
+
+func (s *service) items(ctx context.Context, wishlistID uuid.UUID) ([]WishlistItemResponse, error) {
+	rows, err := s.repo.ListItems(ctx, wishlistID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]WishlistItemResponse, 0, len(rows))
+	for _, r := range rows {
+		price, _ := strconv.ParseFloat(r.CurrentPrice, 64)
+		compareAt, _ := strconv.ParseFloat(r.CompareAtPrice.String, 64)
+
+		items = append(items, WishlistItemResponse{
+			ID:             r.ID.String(),
+			ProductID:      r.ProductID.String(),
+			Name:           r.ProductName,
+			Slug:           r.ProductSlug,
+			ImageURL:       r.ImageUrl,
+			Price:          price,
+			CompareAtPrice: compareAt,
+			Stock:          r.Stock,
+			InStock:        r.Stock > 0,
+			IsAvailable:    r.IsAvailable,
+			AddedAt:        r.CreatedAt,
+		})
+	}
+	return items, nil
+}
+
+func newShareToken() (string, error) {
+	b := make([]byte, 18)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// ==================== WISHLIST ====================
+
+func (s *service) List(ctx context.Context, userID string) (WishlistResponse, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return WishlistResponse{}, autherrors.ErrInvalidUserID
+	}
+
+	w, err := s.repo.GetByUserID(ctx, uid)
+	if err != nil {
+		// Belum pernah menyimpan produk = wishlist kosong
+		if err == sql.ErrNoRows {
+			return WishlistResponse{Items: []WishlistItemResponse{}}, nil
+		}
+		return WishlistResponse{}, err
+	}
+
+	items, err := s.items(ctx, w.ID)
+	if err != nil {
+		return WishlistResponse{}, err
+	}
+	return WishlistResponse{Items: items, ShareToken: w.ShareToken.String}, nil
+}
+
+func (s *service) AddItem(ctx context.Context, userID string, req AddItemRequest) error {
+	if err := s.validate.Struct(req); err != nil {
+		return apperror.MapValidationError(err)
+	}
+
+	uid, pid, err := parseIDs(userID, req.ProductID)
+	if err != nil {
+		return err
+	}
+
+	// 1. Produk harus ada (produk nonaktif tetap boleh disimpan)
+	if _, err := s.productRepo.GetByID(ctx, pid); err != nil {
+		if err == sql.ErrNoRows {
+			return producterrors.ErrProductNotFound
+		}
+		return err
+	}
+
+	// 2. Wishlist dibuat saat produk pertama disimpan
+	w, err := s.repo.Upsert(ctx, uid)
+	if err != nil {
+		return err
+	}
+	return s.repo.AddItem(ctx, w.ID, pid)
+}
+
+func (s *service) RemoveItem(ctx context.Context, userID, productID string) error {
+	uid, pid, err := parseIDs(userID, productID)
+	if err != nil {
+		return err
+	}
+
+	w, err := s.getWishlist(ctx, uid)
+	if err != nil {
+		return err
+	}
+
+	n, err := s.repo.DeleteItem(ctx, w.ID, pid)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return wishlisterrors.ErrWishlistItemNotFound
+	}
+	return nil
+}
+
+// ==================== MOVE ====================
+
+// MoveToCart tambahkan produk ke cart dengan harga terkini lalu hapus dari wishlist.
+// Batas stok / qty mengikuti aturan cart; gagal tambah ke cart = item dikembalikan ke wishlist.
+func (s *service) MoveToCart(ctx context.Context, userID, productID string, req MoveToCartRequest) error {
+	if err := s.validate.Struct(req); err != nil {
+		return apperror.MapValidationError(err)
+	}
+	if req.Qty == 0 {
+		req.Qty = 1
+	}
+
+	uid, pid, err := parseIDs(userID, productID)
+	if err != nil {
+		return err
+	}
+
+	w, err := s.getWishlist(ctx, uid)
+	if err != nil {
+		return err
+	}
+
+	item, err := s.repo.GetItem(ctx, w.ID, pid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return wishlisterrors.ErrWishlistItemNotFound
+		}
+		return err
+	}
+	if !item.IsAvailable || item.Stock <= 0 {
+		return wishlisterrors.ErrProductUnavailable
+	}
+
+	// Item diklaim dulu dengan menghapusnya dari wishlist: request ganda / retry
+	// hanya satu yang lolos, sehingga qty cart tidak bertambah dua kali
+	deleted, err := s.repo.DeleteItem(ctx, w.ID, pid)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return wishlisterrors.ErrWishlistItemNotFound
+	}
+
+	if err := s.cartSvc.AddItem(ctx, cart.UserOwner(userID), cart.AddItemRequest{
+		ProductID: pid.String(),
+		Qty:       req.Qty,
+	}); err != nil {
+		// Kembalikan ke wishlist (AddItem idempotent); error cart tetap yang dilaporkan
+		_ = s.repo.AddItem(ctx, w.ID, pid)
+		return err
+	}
+
+	return nil
+}
+
+// MoveFromCart simpan item cart ke wishlist lalu hapus dari cart (save for later)
+func (s *service) MoveFromCart(ctx context.Context, userID, productID string) error {
+	uid, pid, err := parseIDs(userID, productID)
+	if err != nil {
+		return err
+	}
+
+	owner := cart.UserOwner(userID)
+	cartData, err := s.cartSvc.Detail(ctx, owner)
+	if err != nil {
+		return err
+	}
+
+	inCart := false
+	for _, item := range cartData.Items {
+		if item.ProductID == pid.String() {
+			inCart = true
+			break
+		}
+	}
+	if !inCart {
+		return carterrors.ErrCartItemNotFound
+	}
+
+	w, err := s.repo.Upsert(ctx, uid)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.AddItem(ctx, w.ID, pid); err != nil {
+		return err
+	}
+
+	return s.cartSvc.DeleteItem(ctx, owner, pid.String())
+}
+
+// ==================== SHARE ====================
+
+// Share buat link publik (read-only); link yang sudah ada dipakai ulang
+func (s *service) Share(ctx context.Context, userID string) (ShareResponse, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return ShareResponse{}, autherrors.ErrInvalidUserID
+	}
+
+	w, err := s.repo.Upsert(ctx, uid)
+	if err != nil {
+		return ShareResponse{}, err
+	}
+
+	if !w.ShareToken.Valid {
+		token, err := newShareToken()
+		if err != nil {
+			return ShareResponse{}, wishlisterrors.ErrShareFailed
+		}
+
+		w, err = s.repo.SetShareToken(ctx, w.ID, sql.NullString{String: token, Valid: true})
+		if err != nil {
+			return ShareResponse{}, wishlisterrors.ErrShareFailed
+		}
+	}
+
+	return ShareResponse{
+		Token: w.ShareToken.String,
+		Path:  SharedPathPrefix + w.ShareToken.String,
+	}, nil
+}
+
+// Unshare cabut link publik; link lama langsung tidak berlaku
+func (s *service) Unshare(ctx context.Context, userID string) error {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return autherrors.ErrInvalidUserID
+	}
+
+	w, err := s.repo.GetByUserID(ctx, uid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		return err
+	}
+
+	_, err = s.repo.SetShareToken(ctx, w.ID, sql.NullString{})
+	return err
+}
+
+func (s *service) GetShared(ctx context.Context, token string) (WishlistResponse, error) {
+	if token == "" {
+		return WishlistResponse{}, wishlisterrors.ErrSharedWishlistNotFound
+	}
+
+	w, err := s.repo.GetByShareToken(ctx, token)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return WishlistResponse{}, wishlisterrors.ErrSharedWishlistNotFound
+		}
+		return WishlistResponse{}, err
+	}
+
+	items, err := s.items(ctx, w.ID)
+	if err != nil {
+		return WishlistResponse{}, err
+	}
+	return WishlistResponse{Items: items}, nil
+}
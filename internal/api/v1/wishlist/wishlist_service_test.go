@@ -0,0 +1,285 @@
+package wishlist_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"go-sqlc-starter/internal/api/v1/cart"
+	carterrors "go-sqlc-starter/internal/api/v1/cart/errors"
+	cartMock "go-sqlc-starter/internal/api/v1/mock/cart"
+	productMock "go-sqlc-starter/internal/api/v1/mock/product"
+	wishlistMock "go-sqlc-starter/internal/api/v1/mock/wishlist"
+	producterrors "go-sqlc-starter/internal/api/v1/product/errors"
+	"go-sqlc-starter/internal/api/v1/wishlist"
+	wishlisterrors "go-sqlc-starter/internal/api/v1/wishlist/errors"
+	"go-sqlc-starter/internal/dbgen"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type serviceDeps struct {
+	service     wishlist.Service
+	repo        *wishlistMock.MockRepository
+	productRepo *productMock.MockRepository
+	cartSvc     *cartMock.MockService
+}
+
+func setupServiceTest(t *testing.T) *serviceDeps {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	repo := wishlistMock.NewMockRepository(ctrl)
+	productRepo := productMock.NewMockRepository(ctrl)
+	cartSvc := cartMock.NewMockService(ctrl)
+
+	return &serviceDeps{
+		service:     wishlist.NewService(repo, productRepo, cartSvc),
+		repo:        repo,
+		productRepo: productRepo,
+		cartSvc:     cartSvc,
+	}
+}
+
+func TestWishlistService_List(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+
+	t.Run("success - live product summary", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		w := dbgen.Wishlist{ID: uuid.New(), UserID: userID, ShareToken: sql.NullString{String: "tok", Valid: true}}
+
+		deps.repo.EXPECT().GetByUserID(ctx, userID).Return(w, nil)
+		deps.repo.EXPECT().ListItems(ctx, w.ID).Return([]dbgen.ListWishlistItemsRow{
+			{
+				ID: uuid.New(), ProductID: uuid.New(), CreatedAt: time.Now(),
+				ProductName: "Kaos", ProductSlug: "kaos", CurrentPrice: "80000.00",
+				CompareAtPrice: sql.NullString{String: "100000.00", Valid: true}, Stock: 0, IsAvailable: true,
+			},
+		}, nil)
+
+		res, err := deps.service.List(ctx, userID.String())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "tok", res.ShareToken)
+		assert.Len(t, res.Items, 1)
+		assert.Equal(t, 80000.0, res.Items[0].Price)
+		assert.Equal(t, 100000.0, res.Items[0].CompareAtPrice)
+		assert.False(t, res.Items[0].InStock)
+	})
+
+	t.Run("no wishlist yet - empty", func(t *testing.T) {
+		deps := setupServiceTest(t)
+
+		deps.repo.EXPECT().GetByUserID(ctx, userID).Return(dbgen.Wishlist{}, sql.ErrNoRows)
+
+		res, err := deps.service.List(ctx, userID.String())
+
+		assert.NoError(t, err)
+		assert.Empty(t, res.Items)
+	})
+}
+
+func TestWishlistService_AddRemove(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	productID := uuid.New()
+	w := dbgen.Wishlist{ID: uuid.New(), UserID: userID}
+
+	t.Run("add - wishlist created on first item", func(t *testing.T) {
+		deps := setupServiceTest(t)
+
+		deps.productRepo.EXPECT().GetByID(ctx, productID).Return(dbgen.GetProductByIDRow{ID: productID}, nil)
+		deps.repo.EXPECT().Upsert(ctx, userID).Return(w, nil)
+		deps.repo.EXPECT().AddItem(ctx, w.ID, productID).Return(nil)
+
+		err := deps.service.AddItem(ctx, userID.String(), wishlist.AddItemRequest{ProductID: productID.String()})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("add - product not found", func(t *testing.T) {
+		deps := setupServiceTest(t)
+
+		deps.productRepo.EXPECT().GetByID(ctx, productID).Return(dbgen.GetProductByIDRow{}, sql.ErrNoRows)
+
+		err := deps.service.AddItem(ctx, userID.String(), wishlist.AddItemRequest{ProductID: productID.String()})
+
+		assert.Equal(t, producterrors.ErrProductNotFound, err)
+	})
+
+	t.Run("remove - item not in wishlist", func(t *testing.T) {
+		deps := setupServiceTest(t)
+
+		deps.repo.EXPECT().GetByUserID(ctx, userID).Return(w, nil)
+		deps.repo.EXPECT().DeleteItem(ctx, w.ID, productID).Return(int64(0), nil)
+
+		err := deps.service.RemoveItem(ctx, userID.String(), productID.String())
+
+		assert.Equal(t, wishlisterrors.ErrWishlistItemNotFound, err)
+	})
+}
+
+func TestWishlistService_MoveToCart(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	productID := uuid.New()
+	w := dbgen.Wishlist{ID: uuid.New(), UserID: userID}
+
+	t.Run("success - added with current price then removed", func(t *testing.T) {
+		deps := setupServiceTest(t)
+
+		deps.repo.EXPECT().GetByUserID(ctx, userID).Return(w, nil)
+		deps.repo.EXPECT().GetItem(ctx, w.ID, productID).Return(dbgen.GetWishlistItemRow{
+			ProductID: productID, CurrentPrice: "75000.00", Stock: 4, IsAvailable: true,
+		}, nil)
+		gomock.InOrder(
+			deps.repo.EXPECT().DeleteItem(ctx, w.ID, productID).Return(int64(1), nil),
+			deps.cartSvc.EXPECT().AddItem(ctx, cart.UserOwner(userID.String()), cart.AddItemRequest{
+				ProductID: productID.String(), Qty: 1,
+			}).Return(nil),
+		)
+
+		err := deps.service.MoveToCart(ctx, userID.String(), productID.String(), wishlist.MoveToCartRequest{})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("cart rejects quantity - item restored to wishlist", func(t *testing.T) {
+		deps := setupServiceTest(t)
+
+		deps.repo.EXPECT().GetByUserID(ctx, userID).Return(w, nil)
+		deps.repo.EXPECT().GetItem(ctx, w.ID, productID).Return(dbgen.GetWishlistItemRow{
+			ProductID: productID, CurrentPrice: "75000.00", Stock: 1, IsAvailable: true,
+		}, nil)
+		deps.repo.EXPECT().DeleteItem(ctx, w.ID, productID).Return(int64(1), nil)
+		deps.cartSvc.EXPECT().AddItem(ctx, gomock.Any(), gomock.Any()).Return(carterrors.ErrQtyExceedsLimit)
+		deps.repo.EXPECT().AddItem(ctx, w.ID, productID).Return(nil)
+
+		err := deps.service.MoveToCart(ctx, userID.String(), productID.String(), wishlist.MoveToCartRequest{Qty: 3})
+
+		assert.Equal(t, carterrors.ErrQtyExceedsLimit, err)
+	})
+
+	t.Run("already moved by concurrent request - cart untouched", func(t *testing.T) {
+		deps := setupServiceTest(t)
+
+		deps.repo.EXPECT().GetByUserID(ctx, userID).Return(w, nil)
+		deps.repo.EXPECT().GetItem(ctx, w.ID, productID).Return(dbgen.GetWishlistItemRow{
+			ProductID: productID, CurrentPrice: "75000.00", Stock: 4, IsAvailable: true,
+		}, nil)
+		deps.repo.EXPECT().DeleteItem(ctx, w.ID, productID).Return(int64(0), nil)
+
+		err := deps.service.MoveToCart(ctx, userID.String(), productID.String(), wishlist.MoveToCartRequest{})
+
+		assert.Equal(t, wishlisterrors.ErrWishlistItemNotFound, err)
+	})
+
+	t.Run("unavailable product", func(t *testing.T) {
+		deps := setupServiceTest(t)
+
+		deps.repo.EXPECT().GetByUserID(ctx, userID).Return(w, nil)
+		deps.repo.EXPECT().GetItem(ctx, w.ID, productID).Return(dbgen.GetWishlistItemRow{Stock: 5, IsAvailable: false}, nil)
+
+		err := deps.service.MoveToCart(ctx, userID.String(), productID.String(), wishlist.MoveToCartRequest{})
+
+		assert.Equal(t, wishlisterrors.ErrProductUnavailable, err)
+	})
+}
+
+func TestWishlistService_MoveFromCart(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	productID := uuid.New()
+	owner := cart.UserOwner(userID.String())
+
+	t.Run("success - saved then removed from cart", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		w := dbgen.Wishlist{ID: uuid.New(), UserID: userID}
+
+		deps.cartSvc.EXPECT().Detail(ctx, owner).Return(cart.CartDetailResponse{
+			Items: []cart.CartItemDetailResponse{{ProductID: productID.String(), Qty: 2}},
+		}, nil)
+		deps.repo.EXPECT().Upsert(ctx, userID).Return(w, nil)
+		deps.repo.EXPECT().AddItem(ctx, w.ID, productID).Return(nil)
+		deps.cartSvc.EXPECT().DeleteItem(ctx, owner, productID.String()).Return(nil)
+
+		err := deps.service.MoveFromCart(ctx, userID.String(), productID.String())
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("product not in cart", func(t *testing.T) {
+		deps := setupServiceTest(t)
+
+		deps.cartSvc.EXPECT().Detail(ctx, owner).Return(cart.CartDetailResponse{}, nil)
+
+		err := deps.service.MoveFromCart(ctx, userID.String(), productID.String())
+
+		assert.Equal(t, carterrors.ErrCartItemNotFound, err)
+	})
+}
+
+func TestWishlistService_Share(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+
+	t.Run("new link generated", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		w := dbgen.Wishlist{ID: uuid.New(), UserID: userID}
+
+		deps.repo.EXPECT().Upsert(ctx, userID).Return(w, nil)
+		deps.repo.EXPECT().
+			SetShareToken(ctx, w.ID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uuid.UUID, token sql.NullString) (dbgen.Wishlist, error) {
+				assert.True(t, token.Valid)
+				assert.Len(t, token.String, 24)
+				w.ShareToken = token
+				return w, nil
+			})
+
+		res, err := deps.service.Share(ctx, userID.String())
+
+		assert.NoError(t, err)
+		assert.Equal(t, wishlist.SharedPathPrefix+res.Token, res.Path)
+	})
+
+	t.Run("existing link reused", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		w := dbgen.Wishlist{ID: uuid.New(), UserID: userID, ShareToken: sql.NullString{String: "existing", Valid: true}}
+
+		deps.repo.EXPECT().Upsert(ctx, userID).Return(w, nil)
+
+		res, err := deps.service.Share(ctx, userID.String())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "existing", res.Token)
+	})
+
+	t.Run("shared view hides token", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		w := dbgen.Wishlist{ID: uuid.New(), ShareToken: sql.NullString{String: "tok", Valid: true}}
+
+		deps.repo.EXPECT().GetByShareToken(ctx, "tok").Return(w, nil)
+		deps.repo.EXPECT().ListItems(ctx, w.ID).Return(nil, nil)
+
+		res, err := deps.service.GetShared(ctx, "tok")
+
+		assert.NoError(t, err)
+		assert.Empty(t, res.ShareToken)
+		assert.Empty(t, res.Items)
+	})
+
+	t.Run("revoked link", func(t *testing.T) {
+		deps := setupServiceTest(t)
+
+		deps.repo.EXPECT().GetByShareToken(ctx, "old").Return(dbgen.Wishlist{}, sql.ErrNoRows)
+
+		_, err := deps.service.GetShared(ctx, "old")
+
+		assert.Equal(t, wishlisterrors.ErrSharedWishlistNotFound, err)
+	})
+}
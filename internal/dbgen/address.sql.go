@@ -72,6 +72,42 @@ func (q *Queries) CreateAddress(ctx context.Context, arg CreateAddressParams) (A
 	return i, err
 }
 
+const getAddressByIDForUser = `-- name: GetAddressByIDForUser :one
+SELECT id, user_id, label, recipient_name, recipient_phone, street, subdistrict, district, city, province, postal_code, is_primary, created_at, updated_at, deleted_at FROM addresses
+WHERE id = $1
+  AND user_id = $2
+  AND deleted_at IS NULL
+LIMIT 1
+`
+
+type GetAddressByIDForUserParams struct {
+	ID     uuid.UUID `json:"id"`
+	UserID uuid.UUID `json:"user_id"`
+}
+
+func (q *Queries) GetAddressByIDForUser(ctx context.Context, arg GetAddressByIDForUserParams) (Address, error) {
+	row := q.queryRow(ctx, q.getAddressByIDForUserStmt, getAddressByIDForUser, arg.ID, arg.UserID)
+	var i Address
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Label,
+		&i.RecipientName,
+		&i.RecipientPhone,
+		&i.Street,
+		&i.Subdistrict,
+		&i.District,
+		&i.City,
+		&i.Province,
+		&i.PostalCode,
+		&i.IsPrimary,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
 const listAddressesAdmin = `-- name: ListAddressesAdmin :many
 SELECT a.id, a.user_id, a.label, a.recipient_name, a.recipient_phone, a.street, a.subdistrict, a.district, a.city, a.province, a.postal_code, a.is_primary, a.created_at, a.updated_at, a.deleted_at, u.email, count(*) OVER() AS total_count
 FROM addresses a
@@ -13,17 +13,41 @@ import (
 	"github.com/google/uuid"
 )
 
+const brandSlugExists = `-- name: BrandSlugExists :one
+SELECT EXISTS (
+    SELECT 1 FROM brands t
+    WHERE t.slug = $1::text AND t.id <> $2::uuid
+    UNION ALL
+    SELECT 1 FROM slug_redirects r
+    WHERE r.entity_type = 'brand' AND r.old_slug = $1::text AND r.entity_id <> $2::uuid
+) AS slug_exists
+`
+
+type BrandSlugExistsParams struct {
+	Slug      string    `json:"slug"`
+	ExcludeID uuid.UUID `json:"exclude_id"`
+}
+
+func (q *Queries) BrandSlugExists(ctx context.Context, arg BrandSlugExistsParams) (bool, error) {
+	row := q.queryRow(ctx, q.brandSlugExistsStmt, brandSlugExists, arg.Slug, arg.ExcludeID)
+	var slug_exists bool
+	err := row.Scan(&slug_exists)
+	return slug_exists, err
+}
+
 const createBrand = `-- name: CreateBrand :one
-INSERT INTO brands (name, slug, description, image_url)
-VALUES ($1, $2, $3, $4)
-RETURNING id, name, slug, description, image_url, is_active, created_at, updated_at, deleted_at
+INSERT INTO brands (name, slug, description, image_url, meta_title, meta_description)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, name, slug, description, image_url, is_active, created_at, updated_at, deleted_at, meta_title, meta_description
 `
 
 type CreateBrandParams struct {
-	Name        string         `json:"name"`
-	Slug        string         `json:"slug"`
-	Description sql.NullString `json:"description"`
-	ImageUrl    sql.NullString `json:"image_url"`
+	Name            string         `json:"name"`
+	Slug            string         `json:"slug"`
+	Description     sql.NullString `json:"description"`
+	ImageUrl        sql.NullString `json:"image_url"`
+	MetaTitle       sql.NullString `json:"meta_title"`
+	MetaDescription sql.NullString `json:"meta_description"`
 }
 
 func (q *Queries) CreateBrand(ctx context.Context, arg CreateBrandParams) (Brand, error) {
@@ -32,6 +56,8 @@ func (q *Queries) CreateBrand(ctx context.Context, arg CreateBrandParams) (Brand
 		arg.Slug,
 		arg.Description,
 		arg.ImageUrl,
+		arg.MetaTitle,
+		arg.MetaDescription,
 	)
 	var i Brand
 	err := row.Scan(
@@ -44,12 +70,14 @@ func (q *Queries) CreateBrand(ctx context.Context, arg CreateBrandParams) (Brand
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.MetaTitle,
+		&i.MetaDescription,
 	)
 	return i, err
 }
 
 const getBrandByID = `-- name: GetBrandByID :one
-SELECT id, name, slug, description, image_url, is_active, created_at, updated_at, deleted_at FROM brands WHERE id = $1 AND deleted_at IS NULL LIMIT 1
+SELECT id, name, slug, description, image_url, is_active, created_at, updated_at, deleted_at, meta_title, meta_description FROM brands WHERE id = $1 AND deleted_at IS NULL LIMIT 1
 `
 
 func (q *Queries) GetBrandByID(ctx context.Context, id uuid.UUID) (Brand, error) {
@@ -65,13 +93,53 @@ func (q *Queries) GetBrandByID(ctx context.Context, id uuid.UUID) (Brand, error)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.MetaTitle,
+		&i.MetaDescription,
 	)
 	return i, err
 }
 
+const getBrandBySlug = `-- name: GetBrandBySlug :one
+SELECT id, name, slug, description, image_url, is_active, created_at, updated_at, deleted_at, meta_title, meta_description FROM brands WHERE slug = $1 AND deleted_at IS NULL LIMIT 1
+`
+
+func (q *Queries) GetBrandBySlug(ctx context.Context, slug string) (Brand, error) {
+	row := q.queryRow(ctx, q.getBrandBySlugStmt, getBrandBySlug, slug)
+	var i Brand
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Slug,
+		&i.Description,
+		&i.ImageUrl,
+		&i.IsActive,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.MetaTitle,
+		&i.MetaDescription,
+	)
+	return i, err
+}
+
+const getBrandSlugRedirect = `-- name: GetBrandSlugRedirect :one
+SELECT t.slug
+FROM slug_redirects r
+JOIN brands t ON t.id = r.entity_id
+WHERE r.entity_type = 'brand' AND r.old_slug = $1 AND t.deleted_at IS NULL
+LIMIT 1
+`
+
+func (q *Queries) GetBrandSlugRedirect(ctx context.Context, oldSlug string) (string, error) {
+	row := q.queryRow(ctx, q.getBrandSlugRedirectStmt, getBrandSlugRedirect, oldSlug)
+	var slug string
+	err := row.Scan(&slug)
+	return slug, err
+}
+
 const listBrandsAdmin = `-- name: ListBrandsAdmin :many
 SELECT 
-    id, name, slug, description, image_url, is_active, created_at, updated_at, deleted_at, 
+    id, name, slug, description, image_url, is_active, created_at, updated_at, deleted_at, meta_title, meta_description, 
     COUNT(*) OVER() AS total_count
 FROM brands
 WHERE 
@@ -112,16 +180,18 @@ type ListBrandsAdminParams struct {
 }
 
 type ListBrandsAdminRow struct {
-	ID          uuid.UUID      `json:"id"`
-	Name        string         `json:"name"`
-	Slug        string         `json:"slug"`
-	Description sql.NullString `json:"description"`
-	ImageUrl    sql.NullString `json:"image_url"`
-	IsActive    sql.NullBool   `json:"is_active"`
-	CreatedAt   time.Time      `json:"created_at"`
-	UpdatedAt   time.Time      `json:"updated_at"`
-	DeletedAt   sql.NullTime   `json:"deleted_at"`
-	TotalCount  int64          `json:"total_count"`
+	ID              uuid.UUID      `json:"id"`
+	Name            string         `json:"name"`
+	Slug            string         `json:"slug"`
+	Description     sql.NullString `json:"description"`
+	ImageUrl        sql.NullString `json:"image_url"`
+	IsActive        sql.NullBool   `json:"is_active"`
+	CreatedAt       time.Time      `json:"created_at"`
+	UpdatedAt       time.Time      `json:"updated_at"`
+	DeletedAt       sql.NullTime   `json:"deleted_at"`
+	MetaTitle       sql.NullString `json:"meta_title"`
+	MetaDescription sql.NullString `json:"meta_description"`
+	TotalCount      int64          `json:"total_count"`
 }
 
 func (q *Queries) ListBrandsAdmin(ctx context.Context, arg ListBrandsAdminParams) ([]ListBrandsAdminRow, error) {
@@ -149,6 +219,8 @@ func (q *Queries) ListBrandsAdmin(ctx context.Context, arg ListBrandsAdminParams
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.MetaTitle,
+			&i.MetaDescription,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -165,7 +237,7 @@ func (q *Queries) ListBrandsAdmin(ctx context.Context, arg ListBrandsAdminParams
 }
 
 const listBrandsPublic = `-- name: ListBrandsPublic :many
-SELECT id, name, slug, description, image_url, is_active, created_at, updated_at, deleted_at, count(*) OVER() AS total_count
+SELECT id, name, slug, description, image_url, is_active, created_at, updated_at, deleted_at, meta_title, meta_description, count(*) OVER() AS total_count
 FROM brands
 WHERE deleted_at IS NULL
 ORDER BY created_at DESC
@@ -178,16 +250,18 @@ type ListBrandsPublicParams struct {
 }
 
 type ListBrandsPublicRow struct {
-	ID          uuid.UUID      `json:"id"`
-	Name        string         `json:"name"`
-	Slug        string         `json:"slug"`
-	Description sql.NullString `json:"description"`
-	ImageUrl    sql.NullString `json:"image_url"`
-	IsActive    sql.NullBool   `json:"is_active"`
-	CreatedAt   time.Time      `json:"created_at"`
-	UpdatedAt   time.Time      `json:"updated_at"`
-	DeletedAt   sql.NullTime   `json:"deleted_at"`
-	TotalCount  int64          `json:"total_count"`
+	ID              uuid.UUID      `json:"id"`
+	Name            string         `json:"name"`
+	Slug            string         `json:"slug"`
+	Description     sql.NullString `json:"description"`
+	ImageUrl        sql.NullString `json:"image_url"`
+	IsActive        sql.NullBool   `json:"is_active"`
+	CreatedAt       time.Time      `json:"created_at"`
+	UpdatedAt       time.Time      `json:"updated_at"`
+	DeletedAt       sql.NullTime   `json:"deleted_at"`
+	MetaTitle       sql.NullString `json:"meta_title"`
+	MetaDescription sql.NullString `json:"meta_description"`
+	TotalCount      int64          `json:"total_count"`
 }
 
 func (q *Queries) ListBrandsPublic(ctx context.Context, arg ListBrandsPublicParams) ([]ListBrandsPublicRow, error) {
@@ -209,6 +283,8 @@ func (q *Queries) ListBrandsPublic(ctx context.Context, arg ListBrandsPublicPara
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.MetaTitle,
+			&i.MetaDescription,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -225,7 +301,7 @@ func (q *Queries) ListBrandsPublic(ctx context.Context, arg ListBrandsPublicPara
 }
 
 const restoreBrand = `-- name: RestoreBrand :one
-UPDATE brands SET deleted_at = NULL WHERE id = $1 RETURNING id, name, slug, description, image_url, is_active, created_at, updated_at, deleted_at
+UPDATE brands SET deleted_at = NULL WHERE id = $1 RETURNING id, name, slug, description, image_url, is_active, created_at, updated_at, deleted_at, meta_title, meta_description
 `
 
 func (q *Queries) RestoreBrand(ctx context.Context, id uuid.UUID) (Brand, error) {
@@ -241,6 +317,8 @@ func (q *Queries) RestoreBrand(ctx context.Context, id uuid.UUID) (Brand, error)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.MetaTitle,
+		&i.MetaDescription,
 	)
 	return i, err
 }
@@ -256,18 +334,21 @@ func (q *Queries) SoftDeleteBrand(ctx context.Context, id uuid.UUID) error {
 
 const updateBrand = `-- name: UpdateBrand :one
 UPDATE brands 
-SET name = $2, slug = $3, description = $4, image_url = $5, is_active = $6, updated_at = NOW()
+SET name = $2, slug = $3, description = $4, image_url = $5, is_active = $6,
+    meta_title = $7, meta_description = $8, updated_at = NOW()
 WHERE id = $1 AND deleted_at IS NULL
-RETURNING id, name, slug, description, image_url, is_active, created_at, updated_at, deleted_at
+RETURNING id, name, slug, description, image_url, is_active, created_at, updated_at, deleted_at, meta_title, meta_description
 `
 
 type UpdateBrandParams struct {
-	ID          uuid.UUID      `json:"id"`
-	Name        string         `json:"name"`
-	Slug        string         `json:"slug"`
-	Description sql.NullString `json:"description"`
-	ImageUrl    sql.NullString `json:"image_url"`
-	IsActive    sql.NullBool   `json:"is_active"`
+	ID              uuid.UUID      `json:"id"`
+	Name            string         `json:"name"`
+	Slug            string         `json:"slug"`
+	Description     sql.NullString `json:"description"`
+	ImageUrl        sql.NullString `json:"image_url"`
+	IsActive        sql.NullBool   `json:"is_active"`
+	MetaTitle       sql.NullString `json:"meta_title"`
+	MetaDescription sql.NullString `json:"meta_description"`
 }
 
 func (q *Queries) UpdateBrand(ctx context.Context, arg UpdateBrandParams) (Brand, error) {
@@ -278,6 +359,8 @@ func (q *Queries) UpdateBrand(ctx context.Context, arg UpdateBrandParams) (Brand
 		arg.Description,
 		arg.ImageUrl,
 		arg.IsActive,
+		arg.MetaTitle,
+		arg.MetaDescription,
 	)
 	var i Brand
 	err := row.Scan(
@@ -290,6 +373,8 @@ func (q *Queries) UpdateBrand(ctx context.Context, arg UpdateBrandParams) (Brand
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.MetaTitle,
+		&i.MetaDescription,
 	)
 	return i, err
 }
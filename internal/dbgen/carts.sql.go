@@ -12,30 +12,110 @@ import (
 	"github.com/google/uuid"
 )
 
-const addCartItem = `-- name: AddCartItem :exec
+const addCartItem = `-- name: AddCartItem :one
 INSERT INTO cart_items (cart_id, product_id, quantity, price_at_add)
-VALUES ($1, $2, $3, $4)
+SELECT $1::uuid, p.id, $2::int, ROUND(COALESCE(ap.price, p.price))::int
+FROM products p
+LEFT JOIN LATERAL (
+  SELECT pp.price
+  FROM product_prices pp
+  WHERE pp.product_id = p.id
+    AND pp.starts_at <= NOW()
+    AND (pp.ends_at IS NULL OR pp.ends_at > NOW())
+  ORDER BY pp.starts_at DESC
+  LIMIT 1
+) ap ON true
+WHERE p.id = $3::uuid
+  AND p.deleted_at IS NULL
+  AND p.is_active = true
+  AND (p.published_at IS NULL OR p.published_at <= NOW())
+  AND (p.unpublished_at IS NULL OR p.unpublished_at > NOW())
+  AND $2::int <= LEAST($4::int, p.stock)
 ON CONFLICT (cart_id, product_id)
 DO UPDATE SET
   quantity = cart_items.quantity + EXCLUDED.quantity,
   updated_at = NOW()
+WHERE cart_items.quantity + EXCLUDED.quantity <= LEAST(
+  $4::int,
+  (SELECT stock FROM products WHERE id = EXCLUDED.product_id)
+)
+RETURNING cart_items.id, cart_items.cart_id, cart_items.product_id, cart_items.quantity, cart_items.price_at_add, cart_items.created_at, cart_items.updated_at, cart_items.deleted_at
 `
 
 type AddCartItemParams struct {
-	CartID     uuid.UUID `json:"cart_id"`
-	ProductID  uuid.UUID `json:"product_id"`
-	Quantity   int32     `json:"quantity"`
-	PriceAtAdd int32     `json:"price_at_add"`
+	CartID    uuid.UUID `json:"cart_id"`
+	Quantity  int32     `json:"quantity"`
+	ProductID uuid.UUID `json:"product_id"`
+	MaxQty    int32     `json:"max_qty"`
 }
 
-func (q *Queries) AddCartItem(ctx context.Context, arg AddCartItemParams) error {
-	_, err := q.exec(ctx, q.addCartItemStmt, addCartItem,
+// Qty akhir (baru / dijumlah dengan yang sudah ada) dibatasi max_qty & stok produk.
+// price_at_add selalu diambil dari harga efektif produk (jadwal harga aktif / harga dasar), bukan dari client.
+// Tidak ada row = produk tidak tersedia (termasuk di luar jadwal tayang) atau qty melebihi batas.
+func (q *Queries) AddCartItem(ctx context.Context, arg AddCartItemParams) (CartItem, error) {
+	row := q.queryRow(ctx, q.addCartItemStmt, addCartItem,
 		arg.CartID,
-		arg.ProductID,
 		arg.Quantity,
-		arg.PriceAtAdd,
+		arg.ProductID,
+		arg.MaxQty,
 	)
-	return err
+	var i CartItem
+	err := row.Scan(
+		&i.ID,
+		&i.CartID,
+		&i.ProductID,
+		&i.Quantity,
+		&i.PriceAtAdd,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const adjustCartItemQty = `-- name: AdjustCartItemQty :one
+UPDATE cart_items
+SET quantity = quantity + $1::int, updated_at = NOW()
+WHERE cart_id = $2::uuid
+  AND product_id = $3::uuid
+  AND (
+    $1::int <= 0
+    OR quantity + $1::int <= LEAST(
+      $4::int,
+      COALESCE((SELECT stock FROM products WHERE id = cart_items.product_id), 0)
+    )
+  )
+RETURNING id, cart_id, product_id, quantity, price_at_add, created_at, updated_at, deleted_at
+`
+
+type AdjustCartItemQtyParams struct {
+	Delta     int32     `json:"delta"`
+	CartID    uuid.UUID `json:"cart_id"`
+	ProductID uuid.UUID `json:"product_id"`
+	MaxQty    int32     `json:"max_qty"`
+}
+
+// Ubah qty relatif secara atomic (quantity = quantity + delta).
+// delta > 0 dibatasi max_qty & stok; hasil <= 0 tetap dikembalikan, service yang menghapus item.
+func (q *Queries) AdjustCartItemQty(ctx context.Context, arg AdjustCartItemQtyParams) (CartItem, error) {
+	row := q.queryRow(ctx, q.adjustCartItemQtyStmt, adjustCartItemQty,
+		arg.Delta,
+		arg.CartID,
+		arg.ProductID,
+		arg.MaxQty,
+	)
+	var i CartItem
+	err := row.Scan(
+		&i.ID,
+		&i.CartID,
+		&i.ProductID,
+		&i.Quantity,
+		&i.PriceAtAdd,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
 }
 
 const countCartItems = `-- name: CountCartItems :one
@@ -53,9 +133,9 @@ func (q *Queries) CountCartItems(ctx context.Context, cartID uuid.UUID) (int64,
 
 const createCart = `-- name: CreateCart :one
 INSERT INTO carts (user_id)
-VALUES ($1)
+VALUES ($1::uuid)
 ON CONFLICT (user_id) DO UPDATE SET user_id = EXCLUDED.user_id
-RETURNING id, user_id, created_at, updated_at, deleted_at
+RETURNING id, user_id, created_at, updated_at, deleted_at, guest_id, expires_at
 `
 
 func (q *Queries) CreateCart(ctx context.Context, userID uuid.UUID) (Cart, error) {
@@ -67,6 +147,38 @@ func (q *Queries) CreateCart(ctx context.Context, userID uuid.UUID) (Cart, error
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.GuestID,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const createGuestCart = `-- name: CreateGuestCart :one
+INSERT INTO carts (guest_id, expires_at)
+VALUES ($1::uuid, $2::timestamp)
+ON CONFLICT (guest_id) DO UPDATE
+SET expires_at = EXCLUDED.expires_at,
+    updated_at = NOW()
+RETURNING id, user_id, created_at, updated_at, deleted_at, guest_id, expires_at
+`
+
+type CreateGuestCartParams struct {
+	GuestID   uuid.UUID `json:"guest_id"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// Dipanggil setiap guest menambah item: sekaligus memperpanjang masa berlaku cart
+func (q *Queries) CreateGuestCart(ctx context.Context, arg CreateGuestCartParams) (Cart, error) {
+	row := q.queryRow(ctx, q.createGuestCartStmt, createGuestCart, arg.GuestID, arg.ExpiresAt)
+	var i Cart
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.GuestID,
+		&i.ExpiresAt,
 	)
 	return i, err
 }
@@ -96,10 +208,58 @@ func (q *Queries) DeleteCartItem(ctx context.Context, arg DeleteCartItemParams)
 	return err
 }
 
+const deleteExpiredGuestCarts = `-- name: DeleteExpiredGuestCarts :execrows
+DELETE FROM carts
+WHERE id IN (
+  SELECT id FROM carts
+  WHERE guest_id IS NOT NULL
+    AND expires_at <= $1::timestamp
+  ORDER BY expires_at
+  LIMIT $2::int
+)
+`
+
+type DeleteExpiredGuestCartsParams struct {
+	Before    time.Time `json:"before"`
+	BatchSize int32     `json:"batch_size"`
+}
+
+// cart_items ikut terhapus (ON DELETE CASCADE)
+func (q *Queries) DeleteExpiredGuestCarts(ctx context.Context, arg DeleteExpiredGuestCartsParams) (int64, error) {
+	result, err := q.exec(ctx, q.deleteExpiredGuestCartsStmt, deleteExpiredGuestCarts, arg.Before, arg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getCartByGuestID = `-- name: GetCartByGuestID :one
+SELECT id, user_id, created_at, updated_at, deleted_at, guest_id, expires_at
+FROM carts
+WHERE guest_id = $1::uuid
+  AND expires_at > NOW()
+LIMIT 1
+`
+
+func (q *Queries) GetCartByGuestID(ctx context.Context, guestID uuid.UUID) (Cart, error) {
+	row := q.queryRow(ctx, q.getCartByGuestIDStmt, getCartByGuestID, guestID)
+	var i Cart
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.GuestID,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
 const getCartByUserID = `-- name: GetCartByUserID :one
-SELECT id, user_id, created_at, updated_at, deleted_at
+SELECT id, user_id, created_at, updated_at, deleted_at, guest_id, expires_at
 FROM carts
-WHERE user_id = $1
+WHERE user_id = $1::uuid
 LIMIT 1
 `
 
@@ -112,6 +272,8 @@ func (q *Queries) GetCartByUserID(ctx context.Context, userID uuid.UUID) (Cart,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.GuestID,
+		&i.ExpiresAt,
 	)
 	return i, err
 }
@@ -122,23 +284,52 @@ SELECT
   ci.product_id,
   ci.quantity,
   ci.price_at_add,
-  ci.created_at
-FROM carts c
-JOIN cart_items ci ON ci.cart_id = c.id
-WHERE c.user_id = $1
+  ci.created_at,
+  COALESCE(p.name, '')::text AS product_name,
+  COALESCE(p.slug, '')::text AS product_slug,
+  COALESCE(p.image_url, '')::text AS image_url,
+  COALESCE(ap.price, p.price, 0)::decimal AS current_price,
+  COALESCE(p.stock, 0)::int AS stock,
+  (p.id IS NOT NULL AND p.deleted_at IS NULL)::boolean AS product_exists,
+  (
+    COALESCE(p.is_active, false)
+    AND (p.published_at IS NULL OR p.published_at <= NOW())
+    AND (p.unpublished_at IS NULL OR p.unpublished_at > NOW())
+  )::boolean AS is_active
+FROM cart_items ci
+LEFT JOIN products p ON p.id = ci.product_id
+LEFT JOIN LATERAL (
+  -- Jadwal harga aktif; jika overlap, yang mulai paling akhir menang
+  SELECT pp.price
+  FROM product_prices pp
+  WHERE pp.product_id = p.id
+    AND pp.starts_at <= NOW()
+    AND (pp.ends_at IS NULL OR pp.ends_at > NOW())
+  ORDER BY pp.starts_at DESC
+  LIMIT 1
+) ap ON true
+WHERE ci.cart_id = $1
 ORDER BY ci.created_at DESC
 `
 
 type GetCartDetailRow struct {
-	ID         uuid.UUID `json:"id"`
-	ProductID  uuid.UUID `json:"product_id"`
-	Quantity   int32     `json:"quantity"`
-	PriceAtAdd int32     `json:"price_at_add"`
-	CreatedAt  time.Time `json:"created_at"`
+	ID            uuid.UUID `json:"id"`
+	ProductID     uuid.UUID `json:"product_id"`
+	Quantity      int32     `json:"quantity"`
+	PriceAtAdd    int32     `json:"price_at_add"`
+	CreatedAt     time.Time `json:"created_at"`
+	ProductName   string    `json:"product_name"`
+	ProductSlug   string    `json:"product_slug"`
+	ImageUrl      string    `json:"image_url"`
+	CurrentPrice  string    `json:"current_price"`
+	Stock         int32     `json:"stock"`
+	ProductExists bool      `json:"product_exists"`
+	IsActive      bool      `json:"is_active"`
 }
 
-func (q *Queries) GetCartDetail(ctx context.Context, userID uuid.UUID) ([]GetCartDetailRow, error) {
-	rows, err := q.query(ctx, q.getCartDetailStmt, getCartDetail, userID)
+// Data produk live (harga aktif, stok, status); LEFT JOIN agar item dengan produk terhapus tetap muncul
+func (q *Queries) GetCartDetail(ctx context.Context, cartID uuid.UUID) ([]GetCartDetailRow, error) {
+	rows, err := q.query(ctx, q.getCartDetailStmt, getCartDetail, cartID)
 	if err != nil {
 		return nil, err
 	}
@@ -152,6 +343,13 @@ func (q *Queries) GetCartDetail(ctx context.Context, userID uuid.UUID) ([]GetCar
 			&i.Quantity,
 			&i.PriceAtAdd,
 			&i.CreatedAt,
+			&i.ProductName,
+			&i.ProductSlug,
+			&i.ImageUrl,
+			&i.CurrentPrice,
+			&i.Stock,
+			&i.ProductExists,
+			&i.IsActive,
 		); err != nil {
 			return nil, err
 		}
@@ -166,21 +364,123 @@ func (q *Queries) GetCartDetail(ctx context.Context, userID uuid.UUID) ([]GetCar
 	return items, nil
 }
 
+const getCartItem = `-- name: GetCartItem :one
+SELECT id, cart_id, product_id, quantity, price_at_add, created_at, updated_at, deleted_at FROM cart_items
+WHERE cart_id = $1 AND product_id = $2
+`
+
+type GetCartItemParams struct {
+	CartID    uuid.UUID `json:"cart_id"`
+	ProductID uuid.UUID `json:"product_id"`
+}
+
+func (q *Queries) GetCartItem(ctx context.Context, arg GetCartItemParams) (CartItem, error) {
+	row := q.queryRow(ctx, q.getCartItemStmt, getCartItem, arg.CartID, arg.ProductID)
+	var i CartItem
+	err := row.Scan(
+		&i.ID,
+		&i.CartID,
+		&i.ProductID,
+		&i.Quantity,
+		&i.PriceAtAdd,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const lockGuestCart = `-- name: LockGuestCart :one
+SELECT id, user_id, created_at, updated_at, deleted_at, guest_id, expires_at
+FROM carts
+WHERE guest_id = $1::uuid
+  AND expires_at > NOW()
+FOR UPDATE
+`
+
+// Merge: kunci cart guest agar login paralel tidak menggabungkan item dua kali
+func (q *Queries) LockGuestCart(ctx context.Context, guestID uuid.UUID) (Cart, error) {
+	row := q.queryRow(ctx, q.lockGuestCartStmt, lockGuestCart, guestID)
+	var i Cart
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.GuestID,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const mergeCartItems = `-- name: MergeCartItems :execrows
+INSERT INTO cart_items (cart_id, product_id, quantity, price_at_add)
+SELECT $1::uuid, gi.product_id, LEAST(gi.quantity, p.stock, $2::int), gi.price_at_add
+FROM cart_items gi
+JOIN products p ON p.id = gi.product_id
+WHERE gi.cart_id = $3::uuid
+  AND p.deleted_at IS NULL
+  AND p.is_active = true
+  AND (p.published_at IS NULL OR p.published_at <= NOW())
+  AND (p.unpublished_at IS NULL OR p.unpublished_at > NOW())
+  AND p.stock > 0
+ON CONFLICT (cart_id, product_id)
+DO UPDATE SET
+  quantity = LEAST(
+    cart_items.quantity + EXCLUDED.quantity,
+    (SELECT stock FROM products WHERE id = EXCLUDED.product_id),
+    $2::int
+  ),
+  updated_at = NOW()
+`
+
+type MergeCartItemsParams struct {
+	TargetCartID uuid.UUID `json:"target_cart_id"`
+	MaxQty       int32     `json:"max_qty"`
+	SourceCartID uuid.UUID `json:"source_cart_id"`
+}
+
+// Pindahkan item cart guest ke cart user: qty dijumlah lalu dibatasi stok produk & max_qty.
+// Produk yang dihapus / nonaktif / di luar jadwal tayang / stok habis dilewati.
+func (q *Queries) MergeCartItems(ctx context.Context, arg MergeCartItemsParams) (int64, error) {
+	result, err := q.exec(ctx, q.mergeCartItemsStmt, mergeCartItems, arg.TargetCartID, arg.MaxQty, arg.SourceCartID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const updateCartItemQty = `-- name: UpdateCartItemQty :one
 UPDATE cart_items
-SET quantity = $3, updated_at = NOW()
-WHERE cart_id = $1 AND product_id = $2
+SET quantity = $1::int, updated_at = NOW()
+WHERE cart_id = $2::uuid
+  AND product_id = $3::uuid
+  AND (
+    $1::int <= quantity
+    OR $1::int <= LEAST(
+      $4::int,
+      COALESCE((SELECT stock FROM products WHERE id = cart_items.product_id), 0)
+    )
+  )
 RETURNING id, cart_id, product_id, quantity, price_at_add, created_at, updated_at, deleted_at
 `
 
 type UpdateCartItemQtyParams struct {
+	Quantity  int32     `json:"quantity"`
 	CartID    uuid.UUID `json:"cart_id"`
 	ProductID uuid.UUID `json:"product_id"`
-	Quantity  int32     `json:"quantity"`
+	MaxQty    int32     `json:"max_qty"`
 }
 
+// Set qty absolut; menaikkan qty dibatasi max_qty & stok, menurunkan selalu boleh
 func (q *Queries) UpdateCartItemQty(ctx context.Context, arg UpdateCartItemQtyParams) (CartItem, error) {
-	row := q.queryRow(ctx, q.updateCartItemQtyStmt, updateCartItemQty, arg.CartID, arg.ProductID, arg.Quantity)
+	row := q.queryRow(ctx, q.updateCartItemQtyStmt, updateCartItemQty,
+		arg.Quantity,
+		arg.CartID,
+		arg.ProductID,
+		arg.MaxQty,
+	)
 	var i CartItem
 	err := row.Scan(
 		&i.ID,
@@ -13,17 +13,42 @@ import (
 	"github.com/google/uuid"
 )
 
+const categorySlugExists = `-- name: CategorySlugExists :one
+SELECT EXISTS (
+    SELECT 1 FROM categories t
+    WHERE t.slug = $1::text AND t.id <> $2::uuid
+    UNION ALL
+    SELECT 1 FROM slug_redirects r
+    WHERE r.entity_type = 'category' AND r.old_slug = $1::text AND r.entity_id <> $2::uuid
+) AS slug_exists
+`
+
+type CategorySlugExistsParams struct {
+	Slug      string    `json:"slug"`
+	ExcludeID uuid.UUID `json:"exclude_id"`
+}
+
+func (q *Queries) CategorySlugExists(ctx context.Context, arg CategorySlugExistsParams) (bool, error) {
+	row := q.queryRow(ctx, q.categorySlugExistsStmt, categorySlugExists, arg.Slug, arg.ExcludeID)
+	var slug_exists bool
+	err := row.Scan(&slug_exists)
+	return slug_exists, err
+}
+
 const createCategory = `-- name: CreateCategory :one
-INSERT INTO categories (name, slug, description, image_url)
-VALUES ($1, $2, $3, $4)
-RETURNING id, name, slug, description, image_url, is_active, created_at, updated_at, deleted_at
+INSERT INTO categories (name, slug, description, image_url, meta_title, meta_description, parent_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, name, slug, description, image_url, is_active, created_at, updated_at, deleted_at, meta_title, meta_description, parent_id
 `
 
 type CreateCategoryParams struct {
-	Name        string         `json:"name"`
-	Slug        string         `json:"slug"`
-	Description sql.NullString `json:"description"`
-	ImageUrl    sql.NullString `json:"image_url"`
+	Name            string         `json:"name"`
+	Slug            string         `json:"slug"`
+	Description     sql.NullString `json:"description"`
+	ImageUrl        sql.NullString `json:"image_url"`
+	MetaTitle       sql.NullString `json:"meta_title"`
+	MetaDescription sql.NullString `json:"meta_description"`
+	ParentID        uuid.NullUUID  `json:"parent_id"`
 }
 
 func (q *Queries) CreateCategory(ctx context.Context, arg CreateCategoryParams) (Category, error) {
@@ -32,6 +57,9 @@ func (q *Queries) CreateCategory(ctx context.Context, arg CreateCategoryParams)
 		arg.Slug,
 		arg.Description,
 		arg.ImageUrl,
+		arg.MetaTitle,
+		arg.MetaDescription,
+		arg.ParentID,
 	)
 	var i Category
 	err := row.Scan(
@@ -44,12 +72,65 @@ func (q *Queries) CreateCategory(ctx context.Context, arg CreateCategoryParams)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.MetaTitle,
+		&i.MetaDescription,
+		&i.ParentID,
 	)
 	return i, err
 }
 
+const getCategoryBreadcrumbs = `-- name: GetCategoryBreadcrumbs :many
+WITH RECURSIVE ancestors AS (
+    SELECT c.id, c.parent_id, c.name, c.slug, 0::int AS depth
+    FROM categories c
+    WHERE c.id = $1
+    UNION ALL
+    SELECT p.id, p.parent_id, p.name, p.slug, a.depth + 1
+    FROM categories p
+    JOIN ancestors a ON p.id = a.parent_id
+    WHERE a.depth < 20
+)
+SELECT id, name, slug
+FROM ancestors
+ORDER BY depth DESC
+`
+
+type GetCategoryBreadcrumbsRow struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+	Slug string    `json:"slug"`
+}
+
+// Jalur dari root sampai kategori tersebut
+func (q *Queries) GetCategoryBreadcrumbs(ctx context.Context, id uuid.UUID) ([]GetCategoryBreadcrumbsRow, error) {
+	rows, err := q.query(ctx, q.getCategoryBreadcrumbsStmt, getCategoryBreadcrumbs, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCategoryBreadcrumbsRow
+	for rows.Next() {
+		var i GetCategoryBreadcrumbsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Slug,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getCategoryByID = `-- name: GetCategoryByID :one
-SELECT id, name, slug, description, image_url, is_active, created_at, updated_at, deleted_at FROM categories WHERE id = $1 AND deleted_at IS NULL LIMIT 1
+SELECT id, name, slug, description, image_url, is_active, created_at, updated_at, deleted_at, meta_title, meta_description, parent_id FROM categories WHERE id = $1 AND deleted_at IS NULL LIMIT 1
 `
 
 func (q *Queries) GetCategoryByID(ctx context.Context, id uuid.UUID) (Category, error) {
@@ -65,12 +146,15 @@ func (q *Queries) GetCategoryByID(ctx context.Context, id uuid.UUID) (Category,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.MetaTitle,
+		&i.MetaDescription,
+		&i.ParentID,
 	)
 	return i, err
 }
 
 const getCategoryBySlug = `-- name: GetCategoryBySlug :one
-SELECT id, name, slug, description, image_url, is_active, created_at, updated_at, deleted_at FROM categories WHERE slug = $1 AND deleted_at IS NULL LIMIT 1
+SELECT id, name, slug, description, image_url, is_active, created_at, updated_at, deleted_at, meta_title, meta_description, parent_id FROM categories WHERE slug = $1 AND deleted_at IS NULL LIMIT 1
 `
 
 func (q *Queries) GetCategoryBySlug(ctx context.Context, slug string) (Category, error) {
@@ -86,13 +170,56 @@ func (q *Queries) GetCategoryBySlug(ctx context.Context, slug string) (Category,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.MetaTitle,
+		&i.MetaDescription,
+		&i.ParentID,
 	)
 	return i, err
 }
 
+const getCategorySlugRedirect = `-- name: GetCategorySlugRedirect :one
+SELECT t.slug
+FROM slug_redirects r
+JOIN categories t ON t.id = r.entity_id
+WHERE r.entity_type = 'category' AND r.old_slug = $1 AND t.deleted_at IS NULL
+LIMIT 1
+`
+
+func (q *Queries) GetCategorySlugRedirect(ctx context.Context, oldSlug string) (string, error) {
+	row := q.queryRow(ctx, q.getCategorySlugRedirectStmt, getCategorySlugRedirect, oldSlug)
+	var slug string
+	err := row.Scan(&slug)
+	return slug, err
+}
+
+const isCategoryDescendant = `-- name: IsCategoryDescendant :one
+WITH RECURSIVE descendants AS (
+    SELECT c.id FROM categories c WHERE c.id = $1::uuid
+    UNION
+    SELECT ch.id FROM categories ch
+    JOIN descendants d ON ch.parent_id = d.id
+)
+SELECT EXISTS (
+    SELECT 1 FROM descendants WHERE id = $2::uuid
+) AS is_descendant
+`
+
+type IsCategoryDescendantParams struct {
+	CategoryID  uuid.UUID `json:"category_id"`
+	CandidateID uuid.UUID `json:"candidate_id"`
+}
+
+// TRUE jika candidate_id adalah category_id sendiri atau turunannya (cegah siklus)
+func (q *Queries) IsCategoryDescendant(ctx context.Context, arg IsCategoryDescendantParams) (bool, error) {
+	row := q.queryRow(ctx, q.isCategoryDescendantStmt, isCategoryDescendant, arg.CategoryID, arg.CandidateID)
+	var is_descendant bool
+	err := row.Scan(&is_descendant)
+	return is_descendant, err
+}
+
 const listCategoriesAdmin = `-- name: ListCategoriesAdmin :many
 SELECT 
-    id, name, slug, description, image_url, is_active, created_at, updated_at, deleted_at, 
+    id, name, slug, description, image_url, is_active, created_at, updated_at, deleted_at, meta_title, meta_description, parent_id, 
     COUNT(*) OVER() AS total_count
 FROM categories
 WHERE 
@@ -133,16 +260,19 @@ type ListCategoriesAdminParams struct {
 }
 
 type ListCategoriesAdminRow struct {
-	ID          uuid.UUID      `json:"id"`
-	Name        string         `json:"name"`
-	Slug        string         `json:"slug"`
-	Description sql.NullString `json:"description"`
-	ImageUrl    sql.NullString `json:"image_url"`
-	IsActive    sql.NullBool   `json:"is_active"`
-	CreatedAt   time.Time      `json:"created_at"`
-	UpdatedAt   time.Time      `json:"updated_at"`
-	DeletedAt   sql.NullTime   `json:"deleted_at"`
-	TotalCount  int64          `json:"total_count"`
+	ID              uuid.UUID      `json:"id"`
+	Name            string         `json:"name"`
+	Slug            string         `json:"slug"`
+	Description     sql.NullString `json:"description"`
+	ImageUrl        sql.NullString `json:"image_url"`
+	IsActive        sql.NullBool   `json:"is_active"`
+	CreatedAt       time.Time      `json:"created_at"`
+	UpdatedAt       time.Time      `json:"updated_at"`
+	DeletedAt       sql.NullTime   `json:"deleted_at"`
+	MetaTitle       sql.NullString `json:"meta_title"`
+	MetaDescription sql.NullString `json:"meta_description"`
+	ParentID        uuid.NullUUID  `json:"parent_id"`
+	TotalCount      int64          `json:"total_count"`
 }
 
 func (q *Queries) ListCategoriesAdmin(ctx context.Context, arg ListCategoriesAdminParams) ([]ListCategoriesAdminRow, error) {
@@ -170,6 +300,9 @@ func (q *Queries) ListCategoriesAdmin(ctx context.Context, arg ListCategoriesAdm
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.MetaTitle,
+			&i.MetaDescription,
+			&i.ParentID,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -186,7 +319,7 @@ func (q *Queries) ListCategoriesAdmin(ctx context.Context, arg ListCategoriesAdm
 }
 
 const listCategoriesPublic = `-- name: ListCategoriesPublic :many
-SELECT id, name, slug, description, image_url, is_active, created_at, updated_at, deleted_at, count(*) OVER() AS total_count
+SELECT id, name, slug, description, image_url, is_active, created_at, updated_at, deleted_at, meta_title, meta_description, parent_id, count(*) OVER() AS total_count
 FROM categories
 WHERE deleted_at IS NULL
 ORDER BY created_at DESC
@@ -199,16 +332,19 @@ type ListCategoriesPublicParams struct {
 }
 
 type ListCategoriesPublicRow struct {
-	ID          uuid.UUID      `json:"id"`
-	Name        string         `json:"name"`
-	Slug        string         `json:"slug"`
-	Description sql.NullString `json:"description"`
-	ImageUrl    sql.NullString `json:"image_url"`
-	IsActive    sql.NullBool   `json:"is_active"`
-	CreatedAt   time.Time      `json:"created_at"`
-	UpdatedAt   time.Time      `json:"updated_at"`
-	DeletedAt   sql.NullTime   `json:"deleted_at"`
-	TotalCount  int64          `json:"total_count"`
+	ID              uuid.UUID      `json:"id"`
+	Name            string         `json:"name"`
+	Slug            string         `json:"slug"`
+	Description     sql.NullString `json:"description"`
+	ImageUrl        sql.NullString `json:"image_url"`
+	IsActive        sql.NullBool   `json:"is_active"`
+	CreatedAt       time.Time      `json:"created_at"`
+	UpdatedAt       time.Time      `json:"updated_at"`
+	DeletedAt       sql.NullTime   `json:"deleted_at"`
+	MetaTitle       sql.NullString `json:"meta_title"`
+	MetaDescription sql.NullString `json:"meta_description"`
+	ParentID        uuid.NullUUID  `json:"parent_id"`
+	TotalCount      int64          `json:"total_count"`
 }
 
 func (q *Queries) ListCategoriesPublic(ctx context.Context, arg ListCategoriesPublicParams) ([]ListCategoriesPublicRow, error) {
@@ -230,6 +366,9 @@ func (q *Queries) ListCategoriesPublic(ctx context.Context, arg ListCategoriesPu
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.MetaTitle,
+			&i.MetaDescription,
+			&i.ParentID,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -245,8 +384,53 @@ func (q *Queries) ListCategoriesPublic(ctx context.Context, arg ListCategoriesPu
 	return items, nil
 }
 
+const listCategoryTree = `-- name: ListCategoryTree :many
+SELECT id, parent_id, name, slug, image_url
+FROM categories
+WHERE deleted_at IS NULL AND is_active = true
+ORDER BY name ASC
+`
+
+type ListCategoryTreeRow struct {
+	ID       uuid.UUID      `json:"id"`
+	ParentID uuid.NullUUID  `json:"parent_id"`
+	Name     string         `json:"name"`
+	Slug     string         `json:"slug"`
+	ImageUrl sql.NullString `json:"image_url"`
+}
+
+// Semua kategori aktif, dirangkai menjadi tree di service
+func (q *Queries) ListCategoryTree(ctx context.Context) ([]ListCategoryTreeRow, error) {
+	rows, err := q.query(ctx, q.listCategoryTreeStmt, listCategoryTree)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCategoryTreeRow
+	for rows.Next() {
+		var i ListCategoryTreeRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ParentID,
+			&i.Name,
+			&i.Slug,
+			&i.ImageUrl,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const restoreCategory = `-- name: RestoreCategory :one
-UPDATE categories SET deleted_at = NULL WHERE id = $1 RETURNING id, name, slug, description, image_url, is_active, created_at, updated_at, deleted_at
+UPDATE categories SET deleted_at = NULL WHERE id = $1 RETURNING id, name, slug, description, image_url, is_active, created_at, updated_at, deleted_at, meta_title, meta_description, parent_id
 `
 
 func (q *Queries) RestoreCategory(ctx context.Context, id uuid.UUID) (Category, error) {
@@ -262,6 +446,9 @@ func (q *Queries) RestoreCategory(ctx context.Context, id uuid.UUID) (Category,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.MetaTitle,
+		&i.MetaDescription,
+		&i.ParentID,
 	)
 	return i, err
 }
@@ -277,18 +464,22 @@ func (q *Queries) SoftDeleteCategory(ctx context.Context, id uuid.UUID) error {
 
 const updateCategory = `-- name: UpdateCategory :one
 UPDATE categories 
-SET name = $2, slug = $3, description = $4, image_url = $5, is_active = $6, updated_at = NOW()
+SET name = $2, slug = $3, description = $4, image_url = $5, is_active = $6,
+    meta_title = $7, meta_description = $8, parent_id = $9, updated_at = NOW()
 WHERE id = $1 AND deleted_at IS NULL
-RETURNING id, name, slug, description, image_url, is_active, created_at, updated_at, deleted_at
+RETURNING id, name, slug, description, image_url, is_active, created_at, updated_at, deleted_at, meta_title, meta_description, parent_id
 `
 
 type UpdateCategoryParams struct {
-	ID          uuid.UUID      `json:"id"`
-	Name        string         `json:"name"`
-	Slug        string         `json:"slug"`
-	Description sql.NullString `json:"description"`
-	ImageUrl    sql.NullString `json:"image_url"`
-	IsActive    sql.NullBool   `json:"is_active"`
+	ID              uuid.UUID      `json:"id"`
+	Name            string         `json:"name"`
+	Slug            string         `json:"slug"`
+	Description     sql.NullString `json:"description"`
+	ImageUrl        sql.NullString `json:"image_url"`
+	IsActive        sql.NullBool   `json:"is_active"`
+	MetaTitle       sql.NullString `json:"meta_title"`
+	MetaDescription sql.NullString `json:"meta_description"`
+	ParentID        uuid.NullUUID  `json:"parent_id"`
 }
 
 func (q *Queries) UpdateCategory(ctx context.Context, arg UpdateCategoryParams) (Category, error) {
@@ -299,6 +490,9 @@ func (q *Queries) UpdateCategory(ctx context.Context, arg UpdateCategoryParams)
 		arg.Description,
 		arg.ImageUrl,
 		arg.IsActive,
+		arg.MetaTitle,
+		arg.MetaDescription,
+		arg.ParentID,
 	)
 	var i Category
 	err := row.Scan(
@@ -311,6 +505,9 @@ func (q *Queries) UpdateCategory(ctx context.Context, arg UpdateCategoryParams)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.MetaTitle,
+		&i.MetaDescription,
+		&i.ParentID,
 	)
 	return i, err
 }
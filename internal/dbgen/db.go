@@ -27,21 +27,78 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.addCartItemStmt, err = db.PrepareContext(ctx, addCartItem); err != nil {
 		return nil, fmt.Errorf("error preparing query AddCartItem: %w", err)
 	}
+	if q.addVoucherCategoriesStmt, err = db.PrepareContext(ctx, addVoucherCategories); err != nil {
+		return nil, fmt.Errorf("error preparing query AddVoucherCategories: %w", err)
+	}
+	if q.addVoucherProductsStmt, err = db.PrepareContext(ctx, addVoucherProducts); err != nil {
+		return nil, fmt.Errorf("error preparing query AddVoucherProducts: %w", err)
+	}
+	if q.addWishlistItemStmt, err = db.PrepareContext(ctx, addWishlistItem); err != nil {
+		return nil, fmt.Errorf("error preparing query AddWishlistItem: %w", err)
+	}
+	if q.adjustCartItemQtyStmt, err = db.PrepareContext(ctx, adjustCartItemQty); err != nil {
+		return nil, fmt.Errorf("error preparing query AdjustCartItemQty: %w", err)
+	}
+	if q.adjustProductStockStmt, err = db.PrepareContext(ctx, adjustProductStock); err != nil {
+		return nil, fmt.Errorf("error preparing query AdjustProductStock: %w", err)
+	}
+	if q.advisoryUnlockStmt, err = db.PrepareContext(ctx, advisoryUnlock); err != nil {
+		return nil, fmt.Errorf("error preparing query AdvisoryUnlock: %w", err)
+	}
+	if q.applyOrderRefundStmt, err = db.PrepareContext(ctx, applyOrderRefund); err != nil {
+		return nil, fmt.Errorf("error preparing query ApplyOrderRefund: %w", err)
+	}
+	if q.approveReturnStmt, err = db.PrepareContext(ctx, approveReturn); err != nil {
+		return nil, fmt.Errorf("error preparing query ApproveReturn: %w", err)
+	}
+	if q.brandSlugExistsStmt, err = db.PrepareContext(ctx, brandSlugExists); err != nil {
+		return nil, fmt.Errorf("error preparing query BrandSlugExists: %w", err)
+	}
+	if q.cancelOrderStmt, err = db.PrepareContext(ctx, cancelOrder); err != nil {
+		return nil, fmt.Errorf("error preparing query CancelOrder: %w", err)
+	}
+	if q.categorySlugExistsStmt, err = db.PrepareContext(ctx, categorySlugExists); err != nil {
+		return nil, fmt.Errorf("error preparing query CategorySlugExists: %w", err)
+	}
 	if q.checkReviewExistsStmt, err = db.PrepareContext(ctx, checkReviewExists); err != nil {
 		return nil, fmt.Errorf("error preparing query CheckReviewExists: %w", err)
 	}
 	if q.checkUserPurchasedProductStmt, err = db.PrepareContext(ctx, checkUserPurchasedProduct); err != nil {
 		return nil, fmt.Errorf("error preparing query CheckUserPurchasedProduct: %w", err)
 	}
+	if q.claimBackInStockSubscriptionsStmt, err = db.PrepareContext(ctx, claimBackInStockSubscriptions); err != nil {
+		return nil, fmt.Errorf("error preparing query ClaimBackInStockSubscriptions: %w", err)
+	}
+	if q.claimPriceDropSubscriptionsStmt, err = db.PrepareContext(ctx, claimPriceDropSubscriptions); err != nil {
+		return nil, fmt.Errorf("error preparing query ClaimPriceDropSubscriptions: %w", err)
+	}
+	if q.completeDeliveredOrdersStmt, err = db.PrepareContext(ctx, completeDeliveredOrders); err != nil {
+		return nil, fmt.Errorf("error preparing query CompleteDeliveredOrders: %w", err)
+	}
+	if q.completeOrderStmt, err = db.PrepareContext(ctx, completeOrder); err != nil {
+		return nil, fmt.Errorf("error preparing query CompleteOrder: %w", err)
+	}
 	if q.countCartItemsStmt, err = db.PrepareContext(ctx, countCartItems); err != nil {
 		return nil, fmt.Errorf("error preparing query CountCartItems: %w", err)
 	}
+	if q.countReturnsAdminStmt, err = db.PrepareContext(ctx, countReturnsAdmin); err != nil {
+		return nil, fmt.Errorf("error preparing query CountReturnsAdmin: %w", err)
+	}
+	if q.countReturnsByUserStmt, err = db.PrepareContext(ctx, countReturnsByUser); err != nil {
+		return nil, fmt.Errorf("error preparing query CountReturnsByUser: %w", err)
+	}
+	if q.countReviewsAdminStmt, err = db.PrepareContext(ctx, countReviewsAdmin); err != nil {
+		return nil, fmt.Errorf("error preparing query CountReviewsAdmin: %w", err)
+	}
 	if q.countReviewsByProductIDStmt, err = db.PrepareContext(ctx, countReviewsByProductID); err != nil {
 		return nil, fmt.Errorf("error preparing query CountReviewsByProductID: %w", err)
 	}
 	if q.countReviewsByUserIDStmt, err = db.PrepareContext(ctx, countReviewsByUserID); err != nil {
 		return nil, fmt.Errorf("error preparing query CountReviewsByUserID: %w", err)
 	}
+	if q.countVoucherRedemptionsByUserStmt, err = db.PrepareContext(ctx, countVoucherRedemptionsByUser); err != nil {
+		return nil, fmt.Errorf("error preparing query CountVoucherRedemptionsByUser: %w", err)
+	}
 	if q.createAddressStmt, err = db.PrepareContext(ctx, createAddress); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateAddress: %w", err)
 	}
@@ -51,9 +108,18 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.createCartStmt, err = db.PrepareContext(ctx, createCart); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateCart: %w", err)
 	}
+	if q.createCartReminderStmt, err = db.PrepareContext(ctx, createCartReminder); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateCartReminder: %w", err)
+	}
 	if q.createCategoryStmt, err = db.PrepareContext(ctx, createCategory); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateCategory: %w", err)
 	}
+	if q.createGuestCartStmt, err = db.PrepareContext(ctx, createGuestCart); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateGuestCart: %w", err)
+	}
+	if q.createNotificationStmt, err = db.PrepareContext(ctx, createNotification); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateNotification: %w", err)
+	}
 	if q.createOrderStmt, err = db.PrepareContext(ctx, createOrder); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateOrder: %w", err)
 	}
@@ -63,45 +129,150 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.createProductStmt, err = db.PrepareContext(ctx, createProduct); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateProduct: %w", err)
 	}
+	if q.createProductPriceStmt, err = db.PrepareContext(ctx, createProductPrice); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateProductPrice: %w", err)
+	}
+	if q.createRefundStmt, err = db.PrepareContext(ctx, createRefund); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateRefund: %w", err)
+	}
+	if q.createReturnPhotoStmt, err = db.PrepareContext(ctx, createReturnPhoto); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateReturnPhoto: %w", err)
+	}
+	if q.createReturnRequestStmt, err = db.PrepareContext(ctx, createReturnRequest); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateReturnRequest: %w", err)
+	}
 	if q.createReviewStmt, err = db.PrepareContext(ctx, createReview); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateReview: %w", err)
 	}
+	if q.createReviewPhotoStmt, err = db.PrepareContext(ctx, createReviewPhoto); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateReviewPhoto: %w", err)
+	}
+	if q.createReviewReplyStmt, err = db.PrepareContext(ctx, createReviewReply); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateReviewReply: %w", err)
+	}
+	if q.createReviewReportStmt, err = db.PrepareContext(ctx, createReviewReport); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateReviewReport: %w", err)
+	}
+	if q.createShipmentStmt, err = db.PrepareContext(ctx, createShipment); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateShipment: %w", err)
+	}
+	if q.createShipmentTrackingEventStmt, err = db.PrepareContext(ctx, createShipmentTrackingEvent); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateShipmentTrackingEvent: %w", err)
+	}
+	if q.createStockMovementStmt, err = db.PrepareContext(ctx, createStockMovement); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateStockMovement: %w", err)
+	}
 	if q.createUserStmt, err = db.PrepareContext(ctx, createUser); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateUser: %w", err)
 	}
+	if q.createVoucherStmt, err = db.PrepareContext(ctx, createVoucher); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateVoucher: %w", err)
+	}
+	if q.createVoucherRedemptionStmt, err = db.PrepareContext(ctx, createVoucherRedemption); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateVoucherRedemption: %w", err)
+	}
+	if q.decrementVoucherUsageStmt, err = db.PrepareContext(ctx, decrementVoucherUsage); err != nil {
+		return nil, fmt.Errorf("error preparing query DecrementVoucherUsage: %w", err)
+	}
 	if q.deleteCartStmt, err = db.PrepareContext(ctx, deleteCart); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteCart: %w", err)
 	}
 	if q.deleteCartItemStmt, err = db.PrepareContext(ctx, deleteCartItem); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteCartItem: %w", err)
 	}
+	if q.deleteExpiredGuestCartsStmt, err = db.PrepareContext(ctx, deleteExpiredGuestCarts); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteExpiredGuestCarts: %w", err)
+	}
+	if q.deleteProductPriceStmt, err = db.PrepareContext(ctx, deleteProductPrice); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteProductPrice: %w", err)
+	}
+	if q.deleteProductSubscriptionStmt, err = db.PrepareContext(ctx, deleteProductSubscription); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteProductSubscription: %w", err)
+	}
 	if q.deleteReviewStmt, err = db.PrepareContext(ctx, deleteReview); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteReview: %w", err)
 	}
+	if q.deleteReviewPhotosStmt, err = db.PrepareContext(ctx, deleteReviewPhotos); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteReviewPhotos: %w", err)
+	}
+	if q.deleteReviewReplyStmt, err = db.PrepareContext(ctx, deleteReviewReply); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteReviewReply: %w", err)
+	}
+	if q.deleteReviewVoteStmt, err = db.PrepareContext(ctx, deleteReviewVote); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteReviewVote: %w", err)
+	}
+	if q.deleteSlugRedirectStmt, err = db.PrepareContext(ctx, deleteSlugRedirect); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteSlugRedirect: %w", err)
+	}
+	if q.deleteVoucherCategoriesStmt, err = db.PrepareContext(ctx, deleteVoucherCategories); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteVoucherCategories: %w", err)
+	}
+	if q.deleteVoucherProductsStmt, err = db.PrepareContext(ctx, deleteVoucherProducts); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteVoucherProducts: %w", err)
+	}
+	if q.deleteVoucherRedemptionByOrderStmt, err = db.PrepareContext(ctx, deleteVoucherRedemptionByOrder); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteVoucherRedemptionByOrder: %w", err)
+	}
+	if q.deleteWishlistItemStmt, err = db.PrepareContext(ctx, deleteWishlistItem); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteWishlistItem: %w", err)
+	}
+	if q.expireOrderStmt, err = db.PrepareContext(ctx, expireOrder); err != nil {
+		return nil, fmt.Errorf("error preparing query ExpireOrder: %w", err)
+	}
+	if q.exportProductsStmt, err = db.PrepareContext(ctx, exportProducts); err != nil {
+		return nil, fmt.Errorf("error preparing query ExportProducts: %w", err)
+	}
+	if q.getAbandonedCartSummaryStmt, err = db.PrepareContext(ctx, getAbandonedCartSummary); err != nil {
+		return nil, fmt.Errorf("error preparing query GetAbandonedCartSummary: %w", err)
+	}
+	if q.getAddressByIDForUserStmt, err = db.PrepareContext(ctx, getAddressByIDForUser); err != nil {
+		return nil, fmt.Errorf("error preparing query GetAddressByIDForUser: %w", err)
+	}
 	if q.getAverageRatingByProductIDStmt, err = db.PrepareContext(ctx, getAverageRatingByProductID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetAverageRatingByProductID: %w", err)
 	}
 	if q.getBrandByIDStmt, err = db.PrepareContext(ctx, getBrandByID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetBrandByID: %w", err)
 	}
+	if q.getBrandBySlugStmt, err = db.PrepareContext(ctx, getBrandBySlug); err != nil {
+		return nil, fmt.Errorf("error preparing query GetBrandBySlug: %w", err)
+	}
+	if q.getBrandSlugRedirectStmt, err = db.PrepareContext(ctx, getBrandSlugRedirect); err != nil {
+		return nil, fmt.Errorf("error preparing query GetBrandSlugRedirect: %w", err)
+	}
+	if q.getCartByGuestIDStmt, err = db.PrepareContext(ctx, getCartByGuestID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCartByGuestID: %w", err)
+	}
 	if q.getCartByUserIDStmt, err = db.PrepareContext(ctx, getCartByUserID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetCartByUserID: %w", err)
 	}
 	if q.getCartDetailStmt, err = db.PrepareContext(ctx, getCartDetail); err != nil {
 		return nil, fmt.Errorf("error preparing query GetCartDetail: %w", err)
 	}
+	if q.getCartItemStmt, err = db.PrepareContext(ctx, getCartItem); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCartItem: %w", err)
+	}
+	if q.getCategoryBreadcrumbsStmt, err = db.PrepareContext(ctx, getCategoryBreadcrumbs); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCategoryBreadcrumbs: %w", err)
+	}
 	if q.getCategoryByIDStmt, err = db.PrepareContext(ctx, getCategoryByID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetCategoryByID: %w", err)
 	}
 	if q.getCategoryBySlugStmt, err = db.PrepareContext(ctx, getCategoryBySlug); err != nil {
 		return nil, fmt.Errorf("error preparing query GetCategoryBySlug: %w", err)
 	}
+	if q.getCategorySlugRedirectStmt, err = db.PrepareContext(ctx, getCategorySlugRedirect); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCategorySlugRedirect: %w", err)
+	}
 	if q.getCompletedOrderForReviewStmt, err = db.PrepareContext(ctx, getCompletedOrderForReview); err != nil {
 		return nil, fmt.Errorf("error preparing query GetCompletedOrderForReview: %w", err)
 	}
 	if q.getOrderByIDStmt, err = db.PrepareContext(ctx, getOrderByID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetOrderByID: %w", err)
 	}
+	if q.getOrderItemForReturnStmt, err = db.PrepareContext(ctx, getOrderItemForReturn); err != nil {
+		return nil, fmt.Errorf("error preparing query GetOrderItemForReturn: %w", err)
+	}
 	if q.getOrderItemsStmt, err = db.PrepareContext(ctx, getOrderItems); err != nil {
 		return nil, fmt.Errorf("error preparing query GetOrderItems: %w", err)
 	}
@@ -111,21 +282,81 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getProductBySlugStmt, err = db.PrepareContext(ctx, getProductBySlug); err != nil {
 		return nil, fmt.Errorf("error preparing query GetProductBySlug: %w", err)
 	}
+	if q.getProductSlugRedirectStmt, err = db.PrepareContext(ctx, getProductSlugRedirect); err != nil {
+		return nil, fmt.Errorf("error preparing query GetProductSlugRedirect: %w", err)
+	}
+	if q.getProductStockStmt, err = db.PrepareContext(ctx, getProductStock); err != nil {
+		return nil, fmt.Errorf("error preparing query GetProductStock: %w", err)
+	}
+	if q.getRefundByReturnIDStmt, err = db.PrepareContext(ctx, getRefundByReturnID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetRefundByReturnID: %w", err)
+	}
+	if q.getReturnByIDStmt, err = db.PrepareContext(ctx, getReturnByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetReturnByID: %w", err)
+	}
+	if q.getReturnByIDForUpdateStmt, err = db.PrepareContext(ctx, getReturnByIDForUpdate); err != nil {
+		return nil, fmt.Errorf("error preparing query GetReturnByIDForUpdate: %w", err)
+	}
 	if q.getReviewByIDStmt, err = db.PrepareContext(ctx, getReviewByID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetReviewByID: %w", err)
 	}
+	if q.getReviewReplyStmt, err = db.PrepareContext(ctx, getReviewReply); err != nil {
+		return nil, fmt.Errorf("error preparing query GetReviewReply: %w", err)
+	}
 	if q.getReviewsByProductIDStmt, err = db.PrepareContext(ctx, getReviewsByProductID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetReviewsByProductID: %w", err)
 	}
+	if q.getReviewsByProductIDKeysetStmt, err = db.PrepareContext(ctx, getReviewsByProductIDKeyset); err != nil {
+		return nil, fmt.Errorf("error preparing query GetReviewsByProductIDKeyset: %w", err)
+	}
 	if q.getReviewsByUserIDStmt, err = db.PrepareContext(ctx, getReviewsByUserID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetReviewsByUserID: %w", err)
 	}
+	if q.getShipmentByOrderIDStmt, err = db.PrepareContext(ctx, getShipmentByOrderID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetShipmentByOrderID: %w", err)
+	}
+	if q.getShipmentByReceiptForUpdateStmt, err = db.PrepareContext(ctx, getShipmentByReceiptForUpdate); err != nil {
+		return nil, fmt.Errorf("error preparing query GetShipmentByReceiptForUpdate: %w", err)
+	}
+	if q.getSubscribableProductStmt, err = db.PrepareContext(ctx, getSubscribableProduct); err != nil {
+		return nil, fmt.Errorf("error preparing query GetSubscribableProduct: %w", err)
+	}
 	if q.getUserByEmailStmt, err = db.PrepareContext(ctx, getUserByEmail); err != nil {
 		return nil, fmt.Errorf("error preparing query GetUserByEmail: %w", err)
 	}
 	if q.getUserByIDStmt, err = db.PrepareContext(ctx, getUserByID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetUserByID: %w", err)
 	}
+	if q.getVoucherByCodeForUpdateStmt, err = db.PrepareContext(ctx, getVoucherByCodeForUpdate); err != nil {
+		return nil, fmt.Errorf("error preparing query GetVoucherByCodeForUpdate: %w", err)
+	}
+	if q.getVoucherByIDStmt, err = db.PrepareContext(ctx, getVoucherByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetVoucherByID: %w", err)
+	}
+	if q.getVoucherUsageSummaryStmt, err = db.PrepareContext(ctx, getVoucherUsageSummary); err != nil {
+		return nil, fmt.Errorf("error preparing query GetVoucherUsageSummary: %w", err)
+	}
+	if q.getWishlistByShareTokenStmt, err = db.PrepareContext(ctx, getWishlistByShareToken); err != nil {
+		return nil, fmt.Errorf("error preparing query GetWishlistByShareToken: %w", err)
+	}
+	if q.getWishlistByUserIDStmt, err = db.PrepareContext(ctx, getWishlistByUserID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetWishlistByUserID: %w", err)
+	}
+	if q.getWishlistItemStmt, err = db.PrepareContext(ctx, getWishlistItem); err != nil {
+		return nil, fmt.Errorf("error preparing query GetWishlistItem: %w", err)
+	}
+	if q.incrementReviewReportCountStmt, err = db.PrepareContext(ctx, incrementReviewReportCount); err != nil {
+		return nil, fmt.Errorf("error preparing query IncrementReviewReportCount: %w", err)
+	}
+	if q.incrementVoucherUsageStmt, err = db.PrepareContext(ctx, incrementVoucherUsage); err != nil {
+		return nil, fmt.Errorf("error preparing query IncrementVoucherUsage: %w", err)
+	}
+	if q.isCategoryDescendantStmt, err = db.PrepareContext(ctx, isCategoryDescendant); err != nil {
+		return nil, fmt.Errorf("error preparing query IsCategoryDescendant: %w", err)
+	}
+	if q.listAbandonedCartsStmt, err = db.PrepareContext(ctx, listAbandonedCarts); err != nil {
+		return nil, fmt.Errorf("error preparing query ListAbandonedCarts: %w", err)
+	}
 	if q.listAddressesAdminStmt, err = db.PrepareContext(ctx, listAddressesAdmin); err != nil {
 		return nil, fmt.Errorf("error preparing query ListAddressesAdmin: %w", err)
 	}
@@ -144,18 +375,156 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.listCategoriesPublicStmt, err = db.PrepareContext(ctx, listCategoriesPublic); err != nil {
 		return nil, fmt.Errorf("error preparing query ListCategoriesPublic: %w", err)
 	}
+	if q.listCategoryTreeStmt, err = db.PrepareContext(ctx, listCategoryTree); err != nil {
+		return nil, fmt.Errorf("error preparing query ListCategoryTree: %w", err)
+	}
+	if q.listDueCartRemindersStmt, err = db.PrepareContext(ctx, listDueCartReminders); err != nil {
+		return nil, fmt.Errorf("error preparing query ListDueCartReminders: %w", err)
+	}
+	if q.listEligibleVoucherProductsStmt, err = db.PrepareContext(ctx, listEligibleVoucherProducts); err != nil {
+		return nil, fmt.Errorf("error preparing query ListEligibleVoucherProducts: %w", err)
+	}
+	if q.listExpiredPendingOrderIDsStmt, err = db.PrepareContext(ctx, listExpiredPendingOrderIDs); err != nil {
+		return nil, fmt.Errorf("error preparing query ListExpiredPendingOrderIDs: %w", err)
+	}
+	if q.listLowStockProductsStmt, err = db.PrepareContext(ctx, listLowStockProducts); err != nil {
+		return nil, fmt.Errorf("error preparing query ListLowStockProducts: %w", err)
+	}
 	if q.listOrdersStmt, err = db.PrepareContext(ctx, listOrders); err != nil {
 		return nil, fmt.Errorf("error preparing query ListOrders: %w", err)
 	}
 	if q.listOrdersAdminStmt, err = db.PrepareContext(ctx, listOrdersAdmin); err != nil {
 		return nil, fmt.Errorf("error preparing query ListOrdersAdmin: %w", err)
 	}
+	if q.listOrdersAdminKeysetStmt, err = db.PrepareContext(ctx, listOrdersAdminKeyset); err != nil {
+		return nil, fmt.Errorf("error preparing query ListOrdersAdminKeyset: %w", err)
+	}
+	if q.listOrdersKeysetStmt, err = db.PrepareContext(ctx, listOrdersKeyset); err != nil {
+		return nil, fmt.Errorf("error preparing query ListOrdersKeyset: %w", err)
+	}
+	if q.listPendingNotificationsStmt, err = db.PrepareContext(ctx, listPendingNotifications); err != nil {
+		return nil, fmt.Errorf("error preparing query ListPendingNotifications: %w", err)
+	}
+	if q.listProductPricesStmt, err = db.PrepareContext(ctx, listProductPrices); err != nil {
+		return nil, fmt.Errorf("error preparing query ListProductPrices: %w", err)
+	}
+	if q.listProductSKUsExistingStmt, err = db.PrepareContext(ctx, listProductSKUsExisting); err != nil {
+		return nil, fmt.Errorf("error preparing query ListProductSKUsExisting: %w", err)
+	}
+	if q.listProductSubscriptionsByUserStmt, err = db.PrepareContext(ctx, listProductSubscriptionsByUser); err != nil {
+		return nil, fmt.Errorf("error preparing query ListProductSubscriptionsByUser: %w", err)
+	}
+	if q.listProductTaxRatesStmt, err = db.PrepareContext(ctx, listProductTaxRates); err != nil {
+		return nil, fmt.Errorf("error preparing query ListProductTaxRates: %w", err)
+	}
+	if q.listProductWeightsStmt, err = db.PrepareContext(ctx, listProductWeights); err != nil {
+		return nil, fmt.Errorf("error preparing query ListProductWeights: %w", err)
+	}
 	if q.listProductsAdminStmt, err = db.PrepareContext(ctx, listProductsAdmin); err != nil {
 		return nil, fmt.Errorf("error preparing query ListProductsAdmin: %w", err)
 	}
+	if q.listProductsAdminKeysetStmt, err = db.PrepareContext(ctx, listProductsAdminKeyset); err != nil {
+		return nil, fmt.Errorf("error preparing query ListProductsAdminKeyset: %w", err)
+	}
 	if q.listProductsPublicStmt, err = db.PrepareContext(ctx, listProductsPublic); err != nil {
 		return nil, fmt.Errorf("error preparing query ListProductsPublic: %w", err)
 	}
+	if q.listProductsPublicKeysetStmt, err = db.PrepareContext(ctx, listProductsPublicKeyset); err != nil {
+		return nil, fmt.Errorf("error preparing query ListProductsPublicKeyset: %w", err)
+	}
+	if q.listReturnPhotosStmt, err = db.PrepareContext(ctx, listReturnPhotos); err != nil {
+		return nil, fmt.Errorf("error preparing query ListReturnPhotos: %w", err)
+	}
+	if q.listReturnsAdminStmt, err = db.PrepareContext(ctx, listReturnsAdmin); err != nil {
+		return nil, fmt.Errorf("error preparing query ListReturnsAdmin: %w", err)
+	}
+	if q.listReturnsByUserStmt, err = db.PrepareContext(ctx, listReturnsByUser); err != nil {
+		return nil, fmt.Errorf("error preparing query ListReturnsByUser: %w", err)
+	}
+	if q.listReviewReportsStmt, err = db.PrepareContext(ctx, listReviewReports); err != nil {
+		return nil, fmt.Errorf("error preparing query ListReviewReports: %w", err)
+	}
+	if q.listReviewsAdminStmt, err = db.PrepareContext(ctx, listReviewsAdmin); err != nil {
+		return nil, fmt.Errorf("error preparing query ListReviewsAdmin: %w", err)
+	}
+	if q.listShipmentTrackingEventsStmt, err = db.PrepareContext(ctx, listShipmentTrackingEvents); err != nil {
+		return nil, fmt.Errorf("error preparing query ListShipmentTrackingEvents: %w", err)
+	}
+	if q.listShippingRatesForDestinationStmt, err = db.PrepareContext(ctx, listShippingRatesForDestination); err != nil {
+		return nil, fmt.Errorf("error preparing query ListShippingRatesForDestination: %w", err)
+	}
+	if q.listStockMovementsByProductStmt, err = db.PrepareContext(ctx, listStockMovementsByProduct); err != nil {
+		return nil, fmt.Errorf("error preparing query ListStockMovementsByProduct: %w", err)
+	}
+	if q.listTaxClassesStmt, err = db.PrepareContext(ctx, listTaxClasses); err != nil {
+		return nil, fmt.Errorf("error preparing query ListTaxClasses: %w", err)
+	}
+	if q.listVoucherCategoryIDsStmt, err = db.PrepareContext(ctx, listVoucherCategoryIDs); err != nil {
+		return nil, fmt.Errorf("error preparing query ListVoucherCategoryIDs: %w", err)
+	}
+	if q.listVoucherProductIDsStmt, err = db.PrepareContext(ctx, listVoucherProductIDs); err != nil {
+		return nil, fmt.Errorf("error preparing query ListVoucherProductIDs: %w", err)
+	}
+	if q.listVoucherRedemptionsStmt, err = db.PrepareContext(ctx, listVoucherRedemptions); err != nil {
+		return nil, fmt.Errorf("error preparing query ListVoucherRedemptions: %w", err)
+	}
+	if q.listVouchersAdminStmt, err = db.PrepareContext(ctx, listVouchersAdmin); err != nil {
+		return nil, fmt.Errorf("error preparing query ListVouchersAdmin: %w", err)
+	}
+	if q.listWishlistItemsStmt, err = db.PrepareContext(ctx, listWishlistItems); err != nil {
+		return nil, fmt.Errorf("error preparing query ListWishlistItems: %w", err)
+	}
+	if q.lockGuestCartStmt, err = db.PrepareContext(ctx, lockGuestCart); err != nil {
+		return nil, fmt.Errorf("error preparing query LockGuestCart: %w", err)
+	}
+	if q.lockProductForRatingStmt, err = db.PrepareContext(ctx, lockProductForRating); err != nil {
+		return nil, fmt.Errorf("error preparing query LockProductForRating: %w", err)
+	}
+	if q.lockProductStockStmt, err = db.PrepareContext(ctx, lockProductStock); err != nil {
+		return nil, fmt.Errorf("error preparing query LockProductStock: %w", err)
+	}
+	if q.markNotificationFailedStmt, err = db.PrepareContext(ctx, markNotificationFailed); err != nil {
+		return nil, fmt.Errorf("error preparing query MarkNotificationFailed: %w", err)
+	}
+	if q.markNotificationSentStmt, err = db.PrepareContext(ctx, markNotificationSent); err != nil {
+		return nil, fmt.Errorf("error preparing query MarkNotificationSent: %w", err)
+	}
+	if q.markOrderDeliveredStmt, err = db.PrepareContext(ctx, markOrderDelivered); err != nil {
+		return nil, fmt.Errorf("error preparing query MarkOrderDelivered: %w", err)
+	}
+	if q.markReturnRefundedStmt, err = db.PrepareContext(ctx, markReturnRefunded); err != nil {
+		return nil, fmt.Errorf("error preparing query MarkReturnRefunded: %w", err)
+	}
+	if q.markShipmentDeliveredStmt, err = db.PrepareContext(ctx, markShipmentDelivered); err != nil {
+		return nil, fmt.Errorf("error preparing query MarkShipmentDelivered: %w", err)
+	}
+	if q.mergeCartItemsStmt, err = db.PrepareContext(ctx, mergeCartItems); err != nil {
+		return nil, fmt.Errorf("error preparing query MergeCartItems: %w", err)
+	}
+	if q.optOutCartRemindersStmt, err = db.PrepareContext(ctx, optOutCartReminders); err != nil {
+		return nil, fmt.Errorf("error preparing query OptOutCartReminders: %w", err)
+	}
+	if q.productSlugExistsStmt, err = db.PrepareContext(ctx, productSlugExists); err != nil {
+		return nil, fmt.Errorf("error preparing query ProductSlugExists: %w", err)
+	}
+	if q.receiveReturnStmt, err = db.PrepareContext(ctx, receiveReturn); err != nil {
+		return nil, fmt.Errorf("error preparing query ReceiveReturn: %w", err)
+	}
+	if q.recomputeAllProductRatingsStmt, err = db.PrepareContext(ctx, recomputeAllProductRatings); err != nil {
+		return nil, fmt.Errorf("error preparing query RecomputeAllProductRatings: %w", err)
+	}
+	if q.recomputeProductRatingStmt, err = db.PrepareContext(ctx, recomputeProductRating); err != nil {
+		return nil, fmt.Errorf("error preparing query RecomputeProductRating: %w", err)
+	}
+	if q.recomputeReviewVotesStmt, err = db.PrepareContext(ctx, recomputeReviewVotes); err != nil {
+		return nil, fmt.Errorf("error preparing query RecomputeReviewVotes: %w", err)
+	}
+	if q.rejectReturnStmt, err = db.PrepareContext(ctx, rejectReturn); err != nil {
+		return nil, fmt.Errorf("error preparing query RejectReturn: %w", err)
+	}
+	if q.resolveReviewReportsStmt, err = db.PrepareContext(ctx, resolveReviewReports); err != nil {
+		return nil, fmt.Errorf("error preparing query ResolveReviewReports: %w", err)
+	}
 	if q.restoreBrandStmt, err = db.PrepareContext(ctx, restoreBrand); err != nil {
 		return nil, fmt.Errorf("error preparing query RestoreBrand: %w", err)
 	}
@@ -165,6 +534,18 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.restoreProductStmt, err = db.PrepareContext(ctx, restoreProduct); err != nil {
 		return nil, fmt.Errorf("error preparing query RestoreProduct: %w", err)
 	}
+	if q.setOrderReceiptNoStmt, err = db.PrepareContext(ctx, setOrderReceiptNo); err != nil {
+		return nil, fmt.Errorf("error preparing query SetOrderReceiptNo: %w", err)
+	}
+	if q.setReviewStatusStmt, err = db.PrepareContext(ctx, setReviewStatus); err != nil {
+		return nil, fmt.Errorf("error preparing query SetReviewStatus: %w", err)
+	}
+	if q.setWishlistShareTokenStmt, err = db.PrepareContext(ctx, setWishlistShareToken); err != nil {
+		return nil, fmt.Errorf("error preparing query SetWishlistShareToken: %w", err)
+	}
+	if q.shipReturnStmt, err = db.PrepareContext(ctx, shipReturn); err != nil {
+		return nil, fmt.Errorf("error preparing query ShipReturn: %w", err)
+	}
 	if q.softDeleteAddressStmt, err = db.PrepareContext(ctx, softDeleteAddress); err != nil {
 		return nil, fmt.Errorf("error preparing query SoftDeleteAddress: %w", err)
 	}
@@ -177,6 +558,18 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.softDeleteProductStmt, err = db.PrepareContext(ctx, softDeleteProduct); err != nil {
 		return nil, fmt.Errorf("error preparing query SoftDeleteProduct: %w", err)
 	}
+	if q.softDeleteVoucherStmt, err = db.PrepareContext(ctx, softDeleteVoucher); err != nil {
+		return nil, fmt.Errorf("error preparing query SoftDeleteVoucher: %w", err)
+	}
+	if q.suggestProductsStmt, err = db.PrepareContext(ctx, suggestProducts); err != nil {
+		return nil, fmt.Errorf("error preparing query SuggestProducts: %w", err)
+	}
+	if q.sumActiveReturnQuantityStmt, err = db.PrepareContext(ctx, sumActiveReturnQuantity); err != nil {
+		return nil, fmt.Errorf("error preparing query SumActiveReturnQuantity: %w", err)
+	}
+	if q.tryAdvisoryLockStmt, err = db.PrepareContext(ctx, tryAdvisoryLock); err != nil {
+		return nil, fmt.Errorf("error preparing query TryAdvisoryLock: %w", err)
+	}
 	if q.unsetPrimaryAddressByUserStmt, err = db.PrepareContext(ctx, unsetPrimaryAddressByUser); err != nil {
 		return nil, fmt.Errorf("error preparing query UnsetPrimaryAddressByUser: %w", err)
 	}
@@ -198,9 +591,36 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.updateProductStmt, err = db.PrepareContext(ctx, updateProduct); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateProduct: %w", err)
 	}
+	if q.updateProductLowStockThresholdStmt, err = db.PrepareContext(ctx, updateProductLowStockThreshold); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateProductLowStockThreshold: %w", err)
+	}
 	if q.updateReviewStmt, err = db.PrepareContext(ctx, updateReview); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateReview: %w", err)
 	}
+	if q.updateReviewReplyStmt, err = db.PrepareContext(ctx, updateReviewReply); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateReviewReply: %w", err)
+	}
+	if q.updateVoucherStmt, err = db.PrepareContext(ctx, updateVoucher); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateVoucher: %w", err)
+	}
+	if q.upsertProductSubscriptionStmt, err = db.PrepareContext(ctx, upsertProductSubscription); err != nil {
+		return nil, fmt.Errorf("error preparing query UpsertProductSubscription: %w", err)
+	}
+	if q.upsertProductsBatchStmt, err = db.PrepareContext(ctx, upsertProductsBatch); err != nil {
+		return nil, fmt.Errorf("error preparing query UpsertProductsBatch: %w", err)
+	}
+	if q.upsertReviewVoteStmt, err = db.PrepareContext(ctx, upsertReviewVote); err != nil {
+		return nil, fmt.Errorf("error preparing query UpsertReviewVote: %w", err)
+	}
+	if q.upsertSlugRedirectStmt, err = db.PrepareContext(ctx, upsertSlugRedirect); err != nil {
+		return nil, fmt.Errorf("error preparing query UpsertSlugRedirect: %w", err)
+	}
+	if q.upsertTaxClassStmt, err = db.PrepareContext(ctx, upsertTaxClass); err != nil {
+		return nil, fmt.Errorf("error preparing query UpsertTaxClass: %w", err)
+	}
+	if q.upsertWishlistStmt, err = db.PrepareContext(ctx, upsertWishlist); err != nil {
+		return nil, fmt.Errorf("error preparing query UpsertWishlist: %w", err)
+	}
 	return &q, nil
 }
 
@@ -211,6 +631,61 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing addCartItemStmt: %w", cerr)
 		}
 	}
+	if q.addVoucherCategoriesStmt != nil {
+		if cerr := q.addVoucherCategoriesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addVoucherCategoriesStmt: %w", cerr)
+		}
+	}
+	if q.addVoucherProductsStmt != nil {
+		if cerr := q.addVoucherProductsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addVoucherProductsStmt: %w", cerr)
+		}
+	}
+	if q.addWishlistItemStmt != nil {
+		if cerr := q.addWishlistItemStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addWishlistItemStmt: %w", cerr)
+		}
+	}
+	if q.adjustCartItemQtyStmt != nil {
+		if cerr := q.adjustCartItemQtyStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing adjustCartItemQtyStmt: %w", cerr)
+		}
+	}
+	if q.adjustProductStockStmt != nil {
+		if cerr := q.adjustProductStockStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing adjustProductStockStmt: %w", cerr)
+		}
+	}
+	if q.advisoryUnlockStmt != nil {
+		if cerr := q.advisoryUnlockStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing advisoryUnlockStmt: %w", cerr)
+		}
+	}
+	if q.applyOrderRefundStmt != nil {
+		if cerr := q.applyOrderRefundStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing applyOrderRefundStmt: %w", cerr)
+		}
+	}
+	if q.approveReturnStmt != nil {
+		if cerr := q.approveReturnStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing approveReturnStmt: %w", cerr)
+		}
+	}
+	if q.brandSlugExistsStmt != nil {
+		if cerr := q.brandSlugExistsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing brandSlugExistsStmt: %w", cerr)
+		}
+	}
+	if q.cancelOrderStmt != nil {
+		if cerr := q.cancelOrderStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing cancelOrderStmt: %w", cerr)
+		}
+	}
+	if q.categorySlugExistsStmt != nil {
+		if cerr := q.categorySlugExistsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing categorySlugExistsStmt: %w", cerr)
+		}
+	}
 	if q.checkReviewExistsStmt != nil {
 		if cerr := q.checkReviewExistsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing checkReviewExistsStmt: %w", cerr)
@@ -221,11 +696,46 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing checkUserPurchasedProductStmt: %w", cerr)
 		}
 	}
+	if q.claimBackInStockSubscriptionsStmt != nil {
+		if cerr := q.claimBackInStockSubscriptionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing claimBackInStockSubscriptionsStmt: %w", cerr)
+		}
+	}
+	if q.claimPriceDropSubscriptionsStmt != nil {
+		if cerr := q.claimPriceDropSubscriptionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing claimPriceDropSubscriptionsStmt: %w", cerr)
+		}
+	}
+	if q.completeDeliveredOrdersStmt != nil {
+		if cerr := q.completeDeliveredOrdersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing completeDeliveredOrdersStmt: %w", cerr)
+		}
+	}
+	if q.completeOrderStmt != nil {
+		if cerr := q.completeOrderStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing completeOrderStmt: %w", cerr)
+		}
+	}
 	if q.countCartItemsStmt != nil {
 		if cerr := q.countCartItemsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing countCartItemsStmt: %w", cerr)
 		}
 	}
+	if q.countReturnsAdminStmt != nil {
+		if cerr := q.countReturnsAdminStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countReturnsAdminStmt: %w", cerr)
+		}
+	}
+	if q.countReturnsByUserStmt != nil {
+		if cerr := q.countReturnsByUserStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countReturnsByUserStmt: %w", cerr)
+		}
+	}
+	if q.countReviewsAdminStmt != nil {
+		if cerr := q.countReviewsAdminStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countReviewsAdminStmt: %w", cerr)
+		}
+	}
 	if q.countReviewsByProductIDStmt != nil {
 		if cerr := q.countReviewsByProductIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing countReviewsByProductIDStmt: %w", cerr)
@@ -236,6 +746,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing countReviewsByUserIDStmt: %w", cerr)
 		}
 	}
+	if q.countVoucherRedemptionsByUserStmt != nil {
+		if cerr := q.countVoucherRedemptionsByUserStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countVoucherRedemptionsByUserStmt: %w", cerr)
+		}
+	}
 	if q.createAddressStmt != nil {
 		if cerr := q.createAddressStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createAddressStmt: %w", cerr)
@@ -251,11 +766,26 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing createCartStmt: %w", cerr)
 		}
 	}
+	if q.createCartReminderStmt != nil {
+		if cerr := q.createCartReminderStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createCartReminderStmt: %w", cerr)
+		}
+	}
 	if q.createCategoryStmt != nil {
 		if cerr := q.createCategoryStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createCategoryStmt: %w", cerr)
 		}
 	}
+	if q.createGuestCartStmt != nil {
+		if cerr := q.createGuestCartStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createGuestCartStmt: %w", cerr)
+		}
+	}
+	if q.createNotificationStmt != nil {
+		if cerr := q.createNotificationStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createNotificationStmt: %w", cerr)
+		}
+	}
 	if q.createOrderStmt != nil {
 		if cerr := q.createOrderStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createOrderStmt: %w", cerr)
@@ -271,16 +801,81 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing createProductStmt: %w", cerr)
 		}
 	}
+	if q.createProductPriceStmt != nil {
+		if cerr := q.createProductPriceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createProductPriceStmt: %w", cerr)
+		}
+	}
+	if q.createRefundStmt != nil {
+		if cerr := q.createRefundStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createRefundStmt: %w", cerr)
+		}
+	}
+	if q.createReturnPhotoStmt != nil {
+		if cerr := q.createReturnPhotoStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createReturnPhotoStmt: %w", cerr)
+		}
+	}
+	if q.createReturnRequestStmt != nil {
+		if cerr := q.createReturnRequestStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createReturnRequestStmt: %w", cerr)
+		}
+	}
 	if q.createReviewStmt != nil {
 		if cerr := q.createReviewStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createReviewStmt: %w", cerr)
 		}
 	}
+	if q.createReviewPhotoStmt != nil {
+		if cerr := q.createReviewPhotoStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createReviewPhotoStmt: %w", cerr)
+		}
+	}
+	if q.createReviewReplyStmt != nil {
+		if cerr := q.createReviewReplyStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createReviewReplyStmt: %w", cerr)
+		}
+	}
+	if q.createReviewReportStmt != nil {
+		if cerr := q.createReviewReportStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createReviewReportStmt: %w", cerr)
+		}
+	}
+	if q.createShipmentStmt != nil {
+		if cerr := q.createShipmentStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createShipmentStmt: %w", cerr)
+		}
+	}
+	if q.createShipmentTrackingEventStmt != nil {
+		if cerr := q.createShipmentTrackingEventStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createShipmentTrackingEventStmt: %w", cerr)
+		}
+	}
+	if q.createStockMovementStmt != nil {
+		if cerr := q.createStockMovementStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createStockMovementStmt: %w", cerr)
+		}
+	}
 	if q.createUserStmt != nil {
 		if cerr := q.createUserStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createUserStmt: %w", cerr)
 		}
 	}
+	if q.createVoucherStmt != nil {
+		if cerr := q.createVoucherStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createVoucherStmt: %w", cerr)
+		}
+	}
+	if q.createVoucherRedemptionStmt != nil {
+		if cerr := q.createVoucherRedemptionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createVoucherRedemptionStmt: %w", cerr)
+		}
+	}
+	if q.decrementVoucherUsageStmt != nil {
+		if cerr := q.decrementVoucherUsageStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing decrementVoucherUsageStmt: %w", cerr)
+		}
+	}
 	if q.deleteCartStmt != nil {
 		if cerr := q.deleteCartStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteCartStmt: %w", cerr)
@@ -291,11 +886,86 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing deleteCartItemStmt: %w", cerr)
 		}
 	}
+	if q.deleteExpiredGuestCartsStmt != nil {
+		if cerr := q.deleteExpiredGuestCartsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteExpiredGuestCartsStmt: %w", cerr)
+		}
+	}
+	if q.deleteProductPriceStmt != nil {
+		if cerr := q.deleteProductPriceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteProductPriceStmt: %w", cerr)
+		}
+	}
+	if q.deleteProductSubscriptionStmt != nil {
+		if cerr := q.deleteProductSubscriptionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteProductSubscriptionStmt: %w", cerr)
+		}
+	}
 	if q.deleteReviewStmt != nil {
 		if cerr := q.deleteReviewStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteReviewStmt: %w", cerr)
 		}
 	}
+	if q.deleteReviewPhotosStmt != nil {
+		if cerr := q.deleteReviewPhotosStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteReviewPhotosStmt: %w", cerr)
+		}
+	}
+	if q.deleteReviewReplyStmt != nil {
+		if cerr := q.deleteReviewReplyStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteReviewReplyStmt: %w", cerr)
+		}
+	}
+	if q.deleteReviewVoteStmt != nil {
+		if cerr := q.deleteReviewVoteStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteReviewVoteStmt: %w", cerr)
+		}
+	}
+	if q.deleteSlugRedirectStmt != nil {
+		if cerr := q.deleteSlugRedirectStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteSlugRedirectStmt: %w", cerr)
+		}
+	}
+	if q.deleteVoucherCategoriesStmt != nil {
+		if cerr := q.deleteVoucherCategoriesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteVoucherCategoriesStmt: %w", cerr)
+		}
+	}
+	if q.deleteVoucherProductsStmt != nil {
+		if cerr := q.deleteVoucherProductsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteVoucherProductsStmt: %w", cerr)
+		}
+	}
+	if q.deleteVoucherRedemptionByOrderStmt != nil {
+		if cerr := q.deleteVoucherRedemptionByOrderStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteVoucherRedemptionByOrderStmt: %w", cerr)
+		}
+	}
+	if q.deleteWishlistItemStmt != nil {
+		if cerr := q.deleteWishlistItemStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteWishlistItemStmt: %w", cerr)
+		}
+	}
+	if q.expireOrderStmt != nil {
+		if cerr := q.expireOrderStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing expireOrderStmt: %w", cerr)
+		}
+	}
+	if q.exportProductsStmt != nil {
+		if cerr := q.exportProductsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing exportProductsStmt: %w", cerr)
+		}
+	}
+	if q.getAbandonedCartSummaryStmt != nil {
+		if cerr := q.getAbandonedCartSummaryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getAbandonedCartSummaryStmt: %w", cerr)
+		}
+	}
+	if q.getAddressByIDForUserStmt != nil {
+		if cerr := q.getAddressByIDForUserStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getAddressByIDForUserStmt: %w", cerr)
+		}
+	}
 	if q.getAverageRatingByProductIDStmt != nil {
 		if cerr := q.getAverageRatingByProductIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getAverageRatingByProductIDStmt: %w", cerr)
@@ -306,6 +976,21 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getBrandByIDStmt: %w", cerr)
 		}
 	}
+	if q.getBrandBySlugStmt != nil {
+		if cerr := q.getBrandBySlugStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getBrandBySlugStmt: %w", cerr)
+		}
+	}
+	if q.getBrandSlugRedirectStmt != nil {
+		if cerr := q.getBrandSlugRedirectStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getBrandSlugRedirectStmt: %w", cerr)
+		}
+	}
+	if q.getCartByGuestIDStmt != nil {
+		if cerr := q.getCartByGuestIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCartByGuestIDStmt: %w", cerr)
+		}
+	}
 	if q.getCartByUserIDStmt != nil {
 		if cerr := q.getCartByUserIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getCartByUserIDStmt: %w", cerr)
@@ -316,6 +1001,16 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getCartDetailStmt: %w", cerr)
 		}
 	}
+	if q.getCartItemStmt != nil {
+		if cerr := q.getCartItemStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCartItemStmt: %w", cerr)
+		}
+	}
+	if q.getCategoryBreadcrumbsStmt != nil {
+		if cerr := q.getCategoryBreadcrumbsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCategoryBreadcrumbsStmt: %w", cerr)
+		}
+	}
 	if q.getCategoryByIDStmt != nil {
 		if cerr := q.getCategoryByIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getCategoryByIDStmt: %w", cerr)
@@ -326,6 +1021,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getCategoryBySlugStmt: %w", cerr)
 		}
 	}
+	if q.getCategorySlugRedirectStmt != nil {
+		if cerr := q.getCategorySlugRedirectStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCategorySlugRedirectStmt: %w", cerr)
+		}
+	}
 	if q.getCompletedOrderForReviewStmt != nil {
 		if cerr := q.getCompletedOrderForReviewStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getCompletedOrderForReviewStmt: %w", cerr)
@@ -336,6 +1036,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getOrderByIDStmt: %w", cerr)
 		}
 	}
+	if q.getOrderItemForReturnStmt != nil {
+		if cerr := q.getOrderItemForReturnStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getOrderItemForReturnStmt: %w", cerr)
+		}
+	}
 	if q.getOrderItemsStmt != nil {
 		if cerr := q.getOrderItemsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getOrderItemsStmt: %w", cerr)
@@ -351,21 +1056,71 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getProductBySlugStmt: %w", cerr)
 		}
 	}
+	if q.getProductSlugRedirectStmt != nil {
+		if cerr := q.getProductSlugRedirectStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getProductSlugRedirectStmt: %w", cerr)
+		}
+	}
+	if q.getProductStockStmt != nil {
+		if cerr := q.getProductStockStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getProductStockStmt: %w", cerr)
+		}
+	}
+	if q.getRefundByReturnIDStmt != nil {
+		if cerr := q.getRefundByReturnIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getRefundByReturnIDStmt: %w", cerr)
+		}
+	}
+	if q.getReturnByIDStmt != nil {
+		if cerr := q.getReturnByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getReturnByIDStmt: %w", cerr)
+		}
+	}
+	if q.getReturnByIDForUpdateStmt != nil {
+		if cerr := q.getReturnByIDForUpdateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getReturnByIDForUpdateStmt: %w", cerr)
+		}
+	}
 	if q.getReviewByIDStmt != nil {
 		if cerr := q.getReviewByIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getReviewByIDStmt: %w", cerr)
 		}
 	}
+	if q.getReviewReplyStmt != nil {
+		if cerr := q.getReviewReplyStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getReviewReplyStmt: %w", cerr)
+		}
+	}
 	if q.getReviewsByProductIDStmt != nil {
 		if cerr := q.getReviewsByProductIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getReviewsByProductIDStmt: %w", cerr)
 		}
 	}
+	if q.getReviewsByProductIDKeysetStmt != nil {
+		if cerr := q.getReviewsByProductIDKeysetStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getReviewsByProductIDKeysetStmt: %w", cerr)
+		}
+	}
 	if q.getReviewsByUserIDStmt != nil {
 		if cerr := q.getReviewsByUserIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getReviewsByUserIDStmt: %w", cerr)
 		}
 	}
+	if q.getShipmentByOrderIDStmt != nil {
+		if cerr := q.getShipmentByOrderIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getShipmentByOrderIDStmt: %w", cerr)
+		}
+	}
+	if q.getShipmentByReceiptForUpdateStmt != nil {
+		if cerr := q.getShipmentByReceiptForUpdateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getShipmentByReceiptForUpdateStmt: %w", cerr)
+		}
+	}
+	if q.getSubscribableProductStmt != nil {
+		if cerr := q.getSubscribableProductStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getSubscribableProductStmt: %w", cerr)
+		}
+	}
 	if q.getUserByEmailStmt != nil {
 		if cerr := q.getUserByEmailStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getUserByEmailStmt: %w", cerr)
@@ -376,6 +1131,56 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getUserByIDStmt: %w", cerr)
 		}
 	}
+	if q.getVoucherByCodeForUpdateStmt != nil {
+		if cerr := q.getVoucherByCodeForUpdateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getVoucherByCodeForUpdateStmt: %w", cerr)
+		}
+	}
+	if q.getVoucherByIDStmt != nil {
+		if cerr := q.getVoucherByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getVoucherByIDStmt: %w", cerr)
+		}
+	}
+	if q.getVoucherUsageSummaryStmt != nil {
+		if cerr := q.getVoucherUsageSummaryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getVoucherUsageSummaryStmt: %w", cerr)
+		}
+	}
+	if q.getWishlistByShareTokenStmt != nil {
+		if cerr := q.getWishlistByShareTokenStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getWishlistByShareTokenStmt: %w", cerr)
+		}
+	}
+	if q.getWishlistByUserIDStmt != nil {
+		if cerr := q.getWishlistByUserIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getWishlistByUserIDStmt: %w", cerr)
+		}
+	}
+	if q.getWishlistItemStmt != nil {
+		if cerr := q.getWishlistItemStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getWishlistItemStmt: %w", cerr)
+		}
+	}
+	if q.incrementReviewReportCountStmt != nil {
+		if cerr := q.incrementReviewReportCountStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing incrementReviewReportCountStmt: %w", cerr)
+		}
+	}
+	if q.incrementVoucherUsageStmt != nil {
+		if cerr := q.incrementVoucherUsageStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing incrementVoucherUsageStmt: %w", cerr)
+		}
+	}
+	if q.isCategoryDescendantStmt != nil {
+		if cerr := q.isCategoryDescendantStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing isCategoryDescendantStmt: %w", cerr)
+		}
+	}
+	if q.listAbandonedCartsStmt != nil {
+		if cerr := q.listAbandonedCartsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listAbandonedCartsStmt: %w", cerr)
+		}
+	}
 	if q.listAddressesAdminStmt != nil {
 		if cerr := q.listAddressesAdminStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listAddressesAdminStmt: %w", cerr)
@@ -406,6 +1211,31 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing listCategoriesPublicStmt: %w", cerr)
 		}
 	}
+	if q.listCategoryTreeStmt != nil {
+		if cerr := q.listCategoryTreeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listCategoryTreeStmt: %w", cerr)
+		}
+	}
+	if q.listDueCartRemindersStmt != nil {
+		if cerr := q.listDueCartRemindersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listDueCartRemindersStmt: %w", cerr)
+		}
+	}
+	if q.listEligibleVoucherProductsStmt != nil {
+		if cerr := q.listEligibleVoucherProductsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listEligibleVoucherProductsStmt: %w", cerr)
+		}
+	}
+	if q.listExpiredPendingOrderIDsStmt != nil {
+		if cerr := q.listExpiredPendingOrderIDsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listExpiredPendingOrderIDsStmt: %w", cerr)
+		}
+	}
+	if q.listLowStockProductsStmt != nil {
+		if cerr := q.listLowStockProductsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listLowStockProductsStmt: %w", cerr)
+		}
+	}
 	if q.listOrdersStmt != nil {
 		if cerr := q.listOrdersStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listOrdersStmt: %w", cerr)
@@ -416,16 +1246,221 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing listOrdersAdminStmt: %w", cerr)
 		}
 	}
+	if q.listOrdersAdminKeysetStmt != nil {
+		if cerr := q.listOrdersAdminKeysetStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listOrdersAdminKeysetStmt: %w", cerr)
+		}
+	}
+	if q.listOrdersKeysetStmt != nil {
+		if cerr := q.listOrdersKeysetStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listOrdersKeysetStmt: %w", cerr)
+		}
+	}
+	if q.listPendingNotificationsStmt != nil {
+		if cerr := q.listPendingNotificationsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listPendingNotificationsStmt: %w", cerr)
+		}
+	}
+	if q.listProductPricesStmt != nil {
+		if cerr := q.listProductPricesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listProductPricesStmt: %w", cerr)
+		}
+	}
+	if q.listProductSKUsExistingStmt != nil {
+		if cerr := q.listProductSKUsExistingStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listProductSKUsExistingStmt: %w", cerr)
+		}
+	}
+	if q.listProductSubscriptionsByUserStmt != nil {
+		if cerr := q.listProductSubscriptionsByUserStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listProductSubscriptionsByUserStmt: %w", cerr)
+		}
+	}
+	if q.listProductTaxRatesStmt != nil {
+		if cerr := q.listProductTaxRatesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listProductTaxRatesStmt: %w", cerr)
+		}
+	}
+	if q.listProductWeightsStmt != nil {
+		if cerr := q.listProductWeightsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listProductWeightsStmt: %w", cerr)
+		}
+	}
 	if q.listProductsAdminStmt != nil {
 		if cerr := q.listProductsAdminStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listProductsAdminStmt: %w", cerr)
 		}
 	}
+	if q.listProductsAdminKeysetStmt != nil {
+		if cerr := q.listProductsAdminKeysetStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listProductsAdminKeysetStmt: %w", cerr)
+		}
+	}
 	if q.listProductsPublicStmt != nil {
 		if cerr := q.listProductsPublicStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listProductsPublicStmt: %w", cerr)
 		}
 	}
+	if q.listProductsPublicKeysetStmt != nil {
+		if cerr := q.listProductsPublicKeysetStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listProductsPublicKeysetStmt: %w", cerr)
+		}
+	}
+	if q.listReturnPhotosStmt != nil {
+		if cerr := q.listReturnPhotosStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listReturnPhotosStmt: %w", cerr)
+		}
+	}
+	if q.listReturnsAdminStmt != nil {
+		if cerr := q.listReturnsAdminStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listReturnsAdminStmt: %w", cerr)
+		}
+	}
+	if q.listReturnsByUserStmt != nil {
+		if cerr := q.listReturnsByUserStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listReturnsByUserStmt: %w", cerr)
+		}
+	}
+	if q.listReviewReportsStmt != nil {
+		if cerr := q.listReviewReportsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listReviewReportsStmt: %w", cerr)
+		}
+	}
+	if q.listReviewsAdminStmt != nil {
+		if cerr := q.listReviewsAdminStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listReviewsAdminStmt: %w", cerr)
+		}
+	}
+	if q.listShipmentTrackingEventsStmt != nil {
+		if cerr := q.listShipmentTrackingEventsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listShipmentTrackingEventsStmt: %w", cerr)
+		}
+	}
+	if q.listShippingRatesForDestinationStmt != nil {
+		if cerr := q.listShippingRatesForDestinationStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listShippingRatesForDestinationStmt: %w", cerr)
+		}
+	}
+	if q.listStockMovementsByProductStmt != nil {
+		if cerr := q.listStockMovementsByProductStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listStockMovementsByProductStmt: %w", cerr)
+		}
+	}
+	if q.listTaxClassesStmt != nil {
+		if cerr := q.listTaxClassesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listTaxClassesStmt: %w", cerr)
+		}
+	}
+	if q.listVoucherCategoryIDsStmt != nil {
+		if cerr := q.listVoucherCategoryIDsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listVoucherCategoryIDsStmt: %w", cerr)
+		}
+	}
+	if q.listVoucherProductIDsStmt != nil {
+		if cerr := q.listVoucherProductIDsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listVoucherProductIDsStmt: %w", cerr)
+		}
+	}
+	if q.listVoucherRedemptionsStmt != nil {
+		if cerr := q.listVoucherRedemptionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listVoucherRedemptionsStmt: %w", cerr)
+		}
+	}
+	if q.listVouchersAdminStmt != nil {
+		if cerr := q.listVouchersAdminStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listVouchersAdminStmt: %w", cerr)
+		}
+	}
+	if q.listWishlistItemsStmt != nil {
+		if cerr := q.listWishlistItemsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listWishlistItemsStmt: %w", cerr)
+		}
+	}
+	if q.lockGuestCartStmt != nil {
+		if cerr := q.lockGuestCartStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing lockGuestCartStmt: %w", cerr)
+		}
+	}
+	if q.lockProductForRatingStmt != nil {
+		if cerr := q.lockProductForRatingStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing lockProductForRatingStmt: %w", cerr)
+		}
+	}
+	if q.lockProductStockStmt != nil {
+		if cerr := q.lockProductStockStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing lockProductStockStmt: %w", cerr)
+		}
+	}
+	if q.markNotificationFailedStmt != nil {
+		if cerr := q.markNotificationFailedStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing markNotificationFailedStmt: %w", cerr)
+		}
+	}
+	if q.markNotificationSentStmt != nil {
+		if cerr := q.markNotificationSentStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing markNotificationSentStmt: %w", cerr)
+		}
+	}
+	if q.markOrderDeliveredStmt != nil {
+		if cerr := q.markOrderDeliveredStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing markOrderDeliveredStmt: %w", cerr)
+		}
+	}
+	if q.markReturnRefundedStmt != nil {
+		if cerr := q.markReturnRefundedStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing markReturnRefundedStmt: %w", cerr)
+		}
+	}
+	if q.markShipmentDeliveredStmt != nil {
+		if cerr := q.markShipmentDeliveredStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing markShipmentDeliveredStmt: %w", cerr)
+		}
+	}
+	if q.mergeCartItemsStmt != nil {
+		if cerr := q.mergeCartItemsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing mergeCartItemsStmt: %w", cerr)
+		}
+	}
+	if q.optOutCartRemindersStmt != nil {
+		if cerr := q.optOutCartRemindersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing optOutCartRemindersStmt: %w", cerr)
+		}
+	}
+	if q.productSlugExistsStmt != nil {
+		if cerr := q.productSlugExistsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing productSlugExistsStmt: %w", cerr)
+		}
+	}
+	if q.receiveReturnStmt != nil {
+		if cerr := q.receiveReturnStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing receiveReturnStmt: %w", cerr)
+		}
+	}
+	if q.recomputeAllProductRatingsStmt != nil {
+		if cerr := q.recomputeAllProductRatingsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing recomputeAllProductRatingsStmt: %w", cerr)
+		}
+	}
+	if q.recomputeProductRatingStmt != nil {
+		if cerr := q.recomputeProductRatingStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing recomputeProductRatingStmt: %w", cerr)
+		}
+	}
+	if q.recomputeReviewVotesStmt != nil {
+		if cerr := q.recomputeReviewVotesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing recomputeReviewVotesStmt: %w", cerr)
+		}
+	}
+	if q.rejectReturnStmt != nil {
+		if cerr := q.rejectReturnStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing rejectReturnStmt: %w", cerr)
+		}
+	}
+	if q.resolveReviewReportsStmt != nil {
+		if cerr := q.resolveReviewReportsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing resolveReviewReportsStmt: %w", cerr)
+		}
+	}
 	if q.restoreBrandStmt != nil {
 		if cerr := q.restoreBrandStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing restoreBrandStmt: %w", cerr)
@@ -441,6 +1476,26 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing restoreProductStmt: %w", cerr)
 		}
 	}
+	if q.setOrderReceiptNoStmt != nil {
+		if cerr := q.setOrderReceiptNoStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setOrderReceiptNoStmt: %w", cerr)
+		}
+	}
+	if q.setReviewStatusStmt != nil {
+		if cerr := q.setReviewStatusStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setReviewStatusStmt: %w", cerr)
+		}
+	}
+	if q.setWishlistShareTokenStmt != nil {
+		if cerr := q.setWishlistShareTokenStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setWishlistShareTokenStmt: %w", cerr)
+		}
+	}
+	if q.shipReturnStmt != nil {
+		if cerr := q.shipReturnStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing shipReturnStmt: %w", cerr)
+		}
+	}
 	if q.softDeleteAddressStmt != nil {
 		if cerr := q.softDeleteAddressStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing softDeleteAddressStmt: %w", cerr)
@@ -461,6 +1516,26 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing softDeleteProductStmt: %w", cerr)
 		}
 	}
+	if q.softDeleteVoucherStmt != nil {
+		if cerr := q.softDeleteVoucherStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing softDeleteVoucherStmt: %w", cerr)
+		}
+	}
+	if q.suggestProductsStmt != nil {
+		if cerr := q.suggestProductsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing suggestProductsStmt: %w", cerr)
+		}
+	}
+	if q.sumActiveReturnQuantityStmt != nil {
+		if cerr := q.sumActiveReturnQuantityStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing sumActiveReturnQuantityStmt: %w", cerr)
+		}
+	}
+	if q.tryAdvisoryLockStmt != nil {
+		if cerr := q.tryAdvisoryLockStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing tryAdvisoryLockStmt: %w", cerr)
+		}
+	}
 	if q.unsetPrimaryAddressByUserStmt != nil {
 		if cerr := q.unsetPrimaryAddressByUserStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing unsetPrimaryAddressByUserStmt: %w", cerr)
@@ -496,11 +1571,56 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing updateProductStmt: %w", cerr)
 		}
 	}
+	if q.updateProductLowStockThresholdStmt != nil {
+		if cerr := q.updateProductLowStockThresholdStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateProductLowStockThresholdStmt: %w", cerr)
+		}
+	}
 	if q.updateReviewStmt != nil {
 		if cerr := q.updateReviewStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateReviewStmt: %w", cerr)
 		}
 	}
+	if q.updateReviewReplyStmt != nil {
+		if cerr := q.updateReviewReplyStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateReviewReplyStmt: %w", cerr)
+		}
+	}
+	if q.updateVoucherStmt != nil {
+		if cerr := q.updateVoucherStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateVoucherStmt: %w", cerr)
+		}
+	}
+	if q.upsertProductSubscriptionStmt != nil {
+		if cerr := q.upsertProductSubscriptionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing upsertProductSubscriptionStmt: %w", cerr)
+		}
+	}
+	if q.upsertProductsBatchStmt != nil {
+		if cerr := q.upsertProductsBatchStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing upsertProductsBatchStmt: %w", cerr)
+		}
+	}
+	if q.upsertReviewVoteStmt != nil {
+		if cerr := q.upsertReviewVoteStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing upsertReviewVoteStmt: %w", cerr)
+		}
+	}
+	if q.upsertSlugRedirectStmt != nil {
+		if cerr := q.upsertSlugRedirectStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing upsertSlugRedirectStmt: %w", cerr)
+		}
+	}
+	if q.upsertTaxClassStmt != nil {
+		if cerr := q.upsertTaxClassStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing upsertTaxClassStmt: %w", cerr)
+		}
+	}
+	if q.upsertWishlistStmt != nil {
+		if cerr := q.upsertWishlistStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing upsertWishlistStmt: %w", cerr)
+		}
+	}
 	return err
 }
 
@@ -538,131 +1658,411 @@ func (q *Queries) queryRow(ctx context.Context, stmt *sql.Stmt, query string, ar
 }
 
 type Queries struct {
-	db                              DBTX
-	tx                              *sql.Tx
-	addCartItemStmt                 *sql.Stmt
-	checkReviewExistsStmt           *sql.Stmt
-	checkUserPurchasedProductStmt   *sql.Stmt
-	countCartItemsStmt              *sql.Stmt
-	countReviewsByProductIDStmt     *sql.Stmt
-	countReviewsByUserIDStmt        *sql.Stmt
-	createAddressStmt               *sql.Stmt
-	createBrandStmt                 *sql.Stmt
-	createCartStmt                  *sql.Stmt
-	createCategoryStmt              *sql.Stmt
-	createOrderStmt                 *sql.Stmt
-	createOrderItemStmt             *sql.Stmt
-	createProductStmt               *sql.Stmt
-	createReviewStmt                *sql.Stmt
-	createUserStmt                  *sql.Stmt
-	deleteCartStmt                  *sql.Stmt
-	deleteCartItemStmt              *sql.Stmt
-	deleteReviewStmt                *sql.Stmt
-	getAverageRatingByProductIDStmt *sql.Stmt
-	getBrandByIDStmt                *sql.Stmt
-	getCartByUserIDStmt             *sql.Stmt
-	getCartDetailStmt               *sql.Stmt
-	getCategoryByIDStmt             *sql.Stmt
-	getCategoryBySlugStmt           *sql.Stmt
-	getCompletedOrderForReviewStmt  *sql.Stmt
-	getOrderByIDStmt                *sql.Stmt
-	getOrderItemsStmt               *sql.Stmt
-	getProductByIDStmt              *sql.Stmt
-	getProductBySlugStmt            *sql.Stmt
-	getReviewByIDStmt               *sql.Stmt
-	getReviewsByProductIDStmt       *sql.Stmt
-	getReviewsByUserIDStmt          *sql.Stmt
-	getUserByEmailStmt              *sql.Stmt
-	getUserByIDStmt                 *sql.Stmt
-	listAddressesAdminStmt          *sql.Stmt
-	listAddressesByUserStmt         *sql.Stmt
-	listBrandsAdminStmt             *sql.Stmt
-	listBrandsPublicStmt            *sql.Stmt
-	listCategoriesAdminStmt         *sql.Stmt
-	listCategoriesPublicStmt        *sql.Stmt
-	listOrdersStmt                  *sql.Stmt
-	listOrdersAdminStmt             *sql.Stmt
-	listProductsAdminStmt           *sql.Stmt
-	listProductsPublicStmt          *sql.Stmt
-	restoreBrandStmt                *sql.Stmt
-	restoreCategoryStmt             *sql.Stmt
-	restoreProductStmt              *sql.Stmt
-	softDeleteAddressStmt           *sql.Stmt
-	softDeleteBrandStmt             *sql.Stmt
-	softDeleteCategoryStmt          *sql.Stmt
-	softDeleteProductStmt           *sql.Stmt
-	unsetPrimaryAddressByUserStmt   *sql.Stmt
-	updateAddressStmt               *sql.Stmt
-	updateBrandStmt                 *sql.Stmt
-	updateCartItemQtyStmt           *sql.Stmt
-	updateCategoryStmt              *sql.Stmt
-	updateOrderStatusStmt           *sql.Stmt
-	updateProductStmt               *sql.Stmt
-	updateReviewStmt                *sql.Stmt
+	db                                  DBTX
+	tx                                  *sql.Tx
+	addCartItemStmt                     *sql.Stmt
+	addVoucherCategoriesStmt            *sql.Stmt
+	addVoucherProductsStmt              *sql.Stmt
+	addWishlistItemStmt                 *sql.Stmt
+	adjustCartItemQtyStmt               *sql.Stmt
+	adjustProductStockStmt              *sql.Stmt
+	advisoryUnlockStmt                  *sql.Stmt
+	applyOrderRefundStmt                *sql.Stmt
+	approveReturnStmt                   *sql.Stmt
+	brandSlugExistsStmt                 *sql.Stmt
+	cancelOrderStmt                     *sql.Stmt
+	categorySlugExistsStmt              *sql.Stmt
+	checkReviewExistsStmt               *sql.Stmt
+	checkUserPurchasedProductStmt       *sql.Stmt
+	claimBackInStockSubscriptionsStmt   *sql.Stmt
+	claimPriceDropSubscriptionsStmt     *sql.Stmt
+	completeDeliveredOrdersStmt         *sql.Stmt
+	completeOrderStmt                   *sql.Stmt
+	countCartItemsStmt                  *sql.Stmt
+	countReturnsAdminStmt               *sql.Stmt
+	countReturnsByUserStmt              *sql.Stmt
+	countReviewsAdminStmt               *sql.Stmt
+	countReviewsByProductIDStmt         *sql.Stmt
+	countReviewsByUserIDStmt            *sql.Stmt
+	countVoucherRedemptionsByUserStmt   *sql.Stmt
+	createAddressStmt                   *sql.Stmt
+	createBrandStmt                     *sql.Stmt
+	createCartStmt                      *sql.Stmt
+	createCartReminderStmt              *sql.Stmt
+	createCategoryStmt                  *sql.Stmt
+	createGuestCartStmt                 *sql.Stmt
+	createNotificationStmt              *sql.Stmt
+	createOrderStmt                     *sql.Stmt
+	createOrderItemStmt                 *sql.Stmt
+	createProductStmt                   *sql.Stmt
+	createProductPriceStmt              *sql.Stmt
+	createRefundStmt                    *sql.Stmt
+	createReturnPhotoStmt               *sql.Stmt
+	createReturnRequestStmt             *sql.Stmt
+	createReviewStmt                    *sql.Stmt
+	createReviewPhotoStmt               *sql.Stmt
+	createReviewReplyStmt               *sql.Stmt
+	createReviewReportStmt              *sql.Stmt
+	createShipmentStmt                  *sql.Stmt
+	createShipmentTrackingEventStmt     *sql.Stmt
+	createStockMovementStmt             *sql.Stmt
+	createUserStmt                      *sql.Stmt
+	createVoucherStmt                   *sql.Stmt
+	createVoucherRedemptionStmt         *sql.Stmt
+	decrementVoucherUsageStmt           *sql.Stmt
+	deleteCartStmt                      *sql.Stmt
+	deleteCartItemStmt                  *sql.Stmt
+	deleteExpiredGuestCartsStmt         *sql.Stmt
+	deleteProductPriceStmt              *sql.Stmt
+	deleteProductSubscriptionStmt       *sql.Stmt
+	deleteReviewStmt                    *sql.Stmt
+	deleteReviewPhotosStmt              *sql.Stmt
+	deleteReviewReplyStmt               *sql.Stmt
+	deleteReviewVoteStmt                *sql.Stmt
+	deleteSlugRedirectStmt              *sql.Stmt
+	deleteVoucherCategoriesStmt         *sql.Stmt
+	deleteVoucherProductsStmt           *sql.Stmt
+	deleteVoucherRedemptionByOrderStmt  *sql.Stmt
+	deleteWishlistItemStmt              *sql.Stmt
+	expireOrderStmt                     *sql.Stmt
+	exportProductsStmt                  *sql.Stmt
+	getAbandonedCartSummaryStmt         *sql.Stmt
+	getAddressByIDForUserStmt           *sql.Stmt
+	getAverageRatingByProductIDStmt     *sql.Stmt
+	getBrandByIDStmt                    *sql.Stmt
+	getBrandBySlugStmt                  *sql.Stmt
+	getBrandSlugRedirectStmt            *sql.Stmt
+	getCartByGuestIDStmt                *sql.Stmt
+	getCartByUserIDStmt                 *sql.Stmt
+	getCartDetailStmt                   *sql.Stmt
+	getCartItemStmt                     *sql.Stmt
+	getCategoryBreadcrumbsStmt          *sql.Stmt
+	getCategoryByIDStmt                 *sql.Stmt
+	getCategoryBySlugStmt               *sql.Stmt
+	getCategorySlugRedirectStmt         *sql.Stmt
+	getCompletedOrderForReviewStmt      *sql.Stmt
+	getOrderByIDStmt                    *sql.Stmt
+	getOrderItemForReturnStmt           *sql.Stmt
+	getOrderItemsStmt                   *sql.Stmt
+	getProductByIDStmt                  *sql.Stmt
+	getProductBySlugStmt                *sql.Stmt
+	getProductSlugRedirectStmt          *sql.Stmt
+	getProductStockStmt                 *sql.Stmt
+	getRefundByReturnIDStmt             *sql.Stmt
+	getReturnByIDStmt                   *sql.Stmt
+	getReturnByIDForUpdateStmt          *sql.Stmt
+	getReviewByIDStmt                   *sql.Stmt
+	getReviewReplyStmt                  *sql.Stmt
+	getReviewsByProductIDStmt           *sql.Stmt
+	getReviewsByProductIDKeysetStmt     *sql.Stmt
+	getReviewsByUserIDStmt              *sql.Stmt
+	getShipmentByOrderIDStmt            *sql.Stmt
+	getShipmentByReceiptForUpdateStmt   *sql.Stmt
+	getSubscribableProductStmt          *sql.Stmt
+	getUserByEmailStmt                  *sql.Stmt
+	getUserByIDStmt                     *sql.Stmt
+	getVoucherByCodeForUpdateStmt       *sql.Stmt
+	getVoucherByIDStmt                  *sql.Stmt
+	getVoucherUsageSummaryStmt          *sql.Stmt
+	getWishlistByShareTokenStmt         *sql.Stmt
+	getWishlistByUserIDStmt             *sql.Stmt
+	getWishlistItemStmt                 *sql.Stmt
+	incrementReviewReportCountStmt      *sql.Stmt
+	incrementVoucherUsageStmt           *sql.Stmt
+	isCategoryDescendantStmt            *sql.Stmt
+	listAbandonedCartsStmt              *sql.Stmt
+	listAddressesAdminStmt              *sql.Stmt
+	listAddressesByUserStmt             *sql.Stmt
+	listBrandsAdminStmt                 *sql.Stmt
+	listBrandsPublicStmt                *sql.Stmt
+	listCategoriesAdminStmt             *sql.Stmt
+	listCategoriesPublicStmt            *sql.Stmt
+	listCategoryTreeStmt                *sql.Stmt
+	listDueCartRemindersStmt            *sql.Stmt
+	listEligibleVoucherProductsStmt     *sql.Stmt
+	listExpiredPendingOrderIDsStmt      *sql.Stmt
+	listLowStockProductsStmt            *sql.Stmt
+	listOrdersStmt                      *sql.Stmt
+	listOrdersAdminStmt                 *sql.Stmt
+	listOrdersAdminKeysetStmt           *sql.Stmt
+	listOrdersKeysetStmt                *sql.Stmt
+	listPendingNotificationsStmt        *sql.Stmt
+	listProductPricesStmt               *sql.Stmt
+	listProductSKUsExistingStmt         *sql.Stmt
+	listProductSubscriptionsByUserStmt  *sql.Stmt
+	listProductTaxRatesStmt             *sql.Stmt
+	listProductWeightsStmt              *sql.Stmt
+	listProductsAdminStmt               *sql.Stmt
+	listProductsAdminKeysetStmt         *sql.Stmt
+	listProductsPublicStmt              *sql.Stmt
+	listProductsPublicKeysetStmt        *sql.Stmt
+	listReturnPhotosStmt                *sql.Stmt
+	listReturnsAdminStmt                *sql.Stmt
+	listReturnsByUserStmt               *sql.Stmt
+	listReviewReportsStmt               *sql.Stmt
+	listReviewsAdminStmt                *sql.Stmt
+	listShipmentTrackingEventsStmt      *sql.Stmt
+	listShippingRatesForDestinationStmt *sql.Stmt
+	listStockMovementsByProductStmt     *sql.Stmt
+	listTaxClassesStmt                  *sql.Stmt
+	listVoucherCategoryIDsStmt          *sql.Stmt
+	listVoucherProductIDsStmt           *sql.Stmt
+	listVoucherRedemptionsStmt          *sql.Stmt
+	listVouchersAdminStmt               *sql.Stmt
+	listWishlistItemsStmt               *sql.Stmt
+	lockGuestCartStmt                   *sql.Stmt
+	lockProductForRatingStmt            *sql.Stmt
+	lockProductStockStmt                *sql.Stmt
+	markNotificationFailedStmt          *sql.Stmt
+	markNotificationSentStmt            *sql.Stmt
+	markOrderDeliveredStmt              *sql.Stmt
+	markReturnRefundedStmt              *sql.Stmt
+	markShipmentDeliveredStmt           *sql.Stmt
+	mergeCartItemsStmt                  *sql.Stmt
+	optOutCartRemindersStmt             *sql.Stmt
+	productSlugExistsStmt               *sql.Stmt
+	receiveReturnStmt                   *sql.Stmt
+	recomputeAllProductRatingsStmt      *sql.Stmt
+	recomputeProductRatingStmt          *sql.Stmt
+	recomputeReviewVotesStmt            *sql.Stmt
+	rejectReturnStmt                    *sql.Stmt
+	resolveReviewReportsStmt            *sql.Stmt
+	restoreBrandStmt                    *sql.Stmt
+	restoreCategoryStmt                 *sql.Stmt
+	restoreProductStmt                  *sql.Stmt
+	setOrderReceiptNoStmt               *sql.Stmt
+	setReviewStatusStmt                 *sql.Stmt
+	setWishlistShareTokenStmt           *sql.Stmt
+	shipReturnStmt                      *sql.Stmt
+	softDeleteAddressStmt               *sql.Stmt
+	softDeleteBrandStmt                 *sql.Stmt
+	softDeleteCategoryStmt              *sql.Stmt
+	softDeleteProductStmt               *sql.Stmt
+	softDeleteVoucherStmt               *sql.Stmt
+	suggestProductsStmt                 *sql.Stmt
+	sumActiveReturnQuantityStmt         *sql.Stmt
+	tryAdvisoryLockStmt                 *sql.Stmt
+	unsetPrimaryAddressByUserStmt       *sql.Stmt
+	updateAddressStmt                   *sql.Stmt
+	updateBrandStmt                     *sql.Stmt
+	updateCartItemQtyStmt               *sql.Stmt
+	updateCategoryStmt                  *sql.Stmt
+	updateOrderStatusStmt               *sql.Stmt
+	updateProductStmt                   *sql.Stmt
+	updateProductLowStockThresholdStmt  *sql.Stmt
+	updateReviewStmt                    *sql.Stmt
+	updateReviewReplyStmt               *sql.Stmt
+	updateVoucherStmt                   *sql.Stmt
+	upsertProductSubscriptionStmt       *sql.Stmt
+	upsertProductsBatchStmt             *sql.Stmt
+	upsertReviewVoteStmt                *sql.Stmt
+	upsertSlugRedirectStmt              *sql.Stmt
+	upsertTaxClassStmt                  *sql.Stmt
+	upsertWishlistStmt                  *sql.Stmt
 }
 
 func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 	return &Queries{
-		db:                              tx,
-		tx:                              tx,
-		addCartItemStmt:                 q.addCartItemStmt,
-		checkReviewExistsStmt:           q.checkReviewExistsStmt,
-		checkUserPurchasedProductStmt:   q.checkUserPurchasedProductStmt,
-		countCartItemsStmt:              q.countCartItemsStmt,
-		countReviewsByProductIDStmt:     q.countReviewsByProductIDStmt,
-		countReviewsByUserIDStmt:        q.countReviewsByUserIDStmt,
-		createAddressStmt:               q.createAddressStmt,
-		createBrandStmt:                 q.createBrandStmt,
-		createCartStmt:                  q.createCartStmt,
-		createCategoryStmt:              q.createCategoryStmt,
-		createOrderStmt:                 q.createOrderStmt,
-		createOrderItemStmt:             q.createOrderItemStmt,
-		createProductStmt:               q.createProductStmt,
-		createReviewStmt:                q.createReviewStmt,
-		createUserStmt:                  q.createUserStmt,
-		deleteCartStmt:                  q.deleteCartStmt,
-		deleteCartItemStmt:              q.deleteCartItemStmt,
-		deleteReviewStmt:                q.deleteReviewStmt,
-		getAverageRatingByProductIDStmt: q.getAverageRatingByProductIDStmt,
-		getBrandByIDStmt:                q.getBrandByIDStmt,
-		getCartByUserIDStmt:             q.getCartByUserIDStmt,
-		getCartDetailStmt:               q.getCartDetailStmt,
-		getCategoryByIDStmt:             q.getCategoryByIDStmt,
-		getCategoryBySlugStmt:           q.getCategoryBySlugStmt,
-		getCompletedOrderForReviewStmt:  q.getCompletedOrderForReviewStmt,
-		getOrderByIDStmt:                q.getOrderByIDStmt,
-		getOrderItemsStmt:               q.getOrderItemsStmt,
-		getProductByIDStmt:              q.getProductByIDStmt,
-		getProductBySlugStmt:            q.getProductBySlugStmt,
-		getReviewByIDStmt:               q.getReviewByIDStmt,
-		getReviewsByProductIDStmt:       q.getReviewsByProductIDStmt,
-		getReviewsByUserIDStmt:          q.getReviewsByUserIDStmt,
-		getUserByEmailStmt:              q.getUserByEmailStmt,
-		getUserByIDStmt:                 q.getUserByIDStmt,
-		listAddressesAdminStmt:          q.listAddressesAdminStmt,
-		listAddressesByUserStmt:         q.listAddressesByUserStmt,
-		listBrandsAdminStmt:             q.listBrandsAdminStmt,
-		listBrandsPublicStmt:            q.listBrandsPublicStmt,
-		listCategoriesAdminStmt:         q.listCategoriesAdminStmt,
-		listCategoriesPublicStmt:        q.listCategoriesPublicStmt,
-		listOrdersStmt:                  q.listOrdersStmt,
-		listOrdersAdminStmt:             q.listOrdersAdminStmt,
-		listProductsAdminStmt:           q.listProductsAdminStmt,
-		listProductsPublicStmt:          q.listProductsPublicStmt,
-		restoreBrandStmt:                q.restoreBrandStmt,
-		restoreCategoryStmt:             q.restoreCategoryStmt,
-		restoreProductStmt:              q.restoreProductStmt,
-		softDeleteAddressStmt:           q.softDeleteAddressStmt,
-		softDeleteBrandStmt:             q.softDeleteBrandStmt,
-		softDeleteCategoryStmt:          q.softDeleteCategoryStmt,
-		softDeleteProductStmt:           q.softDeleteProductStmt,
-		unsetPrimaryAddressByUserStmt:   q.unsetPrimaryAddressByUserStmt,
-		updateAddressStmt:               q.updateAddressStmt,
-		updateBrandStmt:                 q.updateBrandStmt,
-		updateCartItemQtyStmt:           q.updateCartItemQtyStmt,
-		updateCategoryStmt:              q.updateCategoryStmt,
-		updateOrderStatusStmt:           q.updateOrderStatusStmt,
-		updateProductStmt:               q.updateProductStmt,
-		updateReviewStmt:                q.updateReviewStmt,
+		db:                                  tx,
+		tx:                                  tx,
+		addCartItemStmt:                     q.addCartItemStmt,
+		addVoucherCategoriesStmt:            q.addVoucherCategoriesStmt,
+		addVoucherProductsStmt:              q.addVoucherProductsStmt,
+		addWishlistItemStmt:                 q.addWishlistItemStmt,
+		adjustCartItemQtyStmt:               q.adjustCartItemQtyStmt,
+		adjustProductStockStmt:              q.adjustProductStockStmt,
+		advisoryUnlockStmt:                  q.advisoryUnlockStmt,
+		applyOrderRefundStmt:                q.applyOrderRefundStmt,
+		approveReturnStmt:                   q.approveReturnStmt,
+		brandSlugExistsStmt:                 q.brandSlugExistsStmt,
+		cancelOrderStmt:                     q.cancelOrderStmt,
+		categorySlugExistsStmt:              q.categorySlugExistsStmt,
+		checkReviewExistsStmt:               q.checkReviewExistsStmt,
+		checkUserPurchasedProductStmt:       q.checkUserPurchasedProductStmt,
+		claimBackInStockSubscriptionsStmt:   q.claimBackInStockSubscriptionsStmt,
+		claimPriceDropSubscriptionsStmt:     q.claimPriceDropSubscriptionsStmt,
+		completeDeliveredOrdersStmt:         q.completeDeliveredOrdersStmt,
+		completeOrderStmt:                   q.completeOrderStmt,
+		countCartItemsStmt:                  q.countCartItemsStmt,
+		countReturnsAdminStmt:               q.countReturnsAdminStmt,
+		countReturnsByUserStmt:              q.countReturnsByUserStmt,
+		countReviewsAdminStmt:               q.countReviewsAdminStmt,
+		countReviewsByProductIDStmt:         q.countReviewsByProductIDStmt,
+		countReviewsByUserIDStmt:            q.countReviewsByUserIDStmt,
+		countVoucherRedemptionsByUserStmt:   q.countVoucherRedemptionsByUserStmt,
+		createAddressStmt:                   q.createAddressStmt,
+		createBrandStmt:                     q.createBrandStmt,
+		createCartStmt:                      q.createCartStmt,
+		createCartReminderStmt:              q.createCartReminderStmt,
+		createCategoryStmt:                  q.createCategoryStmt,
+		createGuestCartStmt:                 q.createGuestCartStmt,
+		createNotificationStmt:              q.createNotificationStmt,
+		createOrderStmt:                     q.createOrderStmt,
+		createOrderItemStmt:                 q.createOrderItemStmt,
+		createProductStmt:                   q.createProductStmt,
+		createProductPriceStmt:              q.createProductPriceStmt,
+		createRefundStmt:                    q.createRefundStmt,
+		createReturnPhotoStmt:               q.createReturnPhotoStmt,
+		createReturnRequestStmt:             q.createReturnRequestStmt,
+		createReviewStmt:                    q.createReviewStmt,
+		createReviewPhotoStmt:               q.createReviewPhotoStmt,
+		createReviewReplyStmt:               q.createReviewReplyStmt,
+		createReviewReportStmt:              q.createReviewReportStmt,
+		createShipmentStmt:                  q.createShipmentStmt,
+		createShipmentTrackingEventStmt:     q.createShipmentTrackingEventStmt,
+		createStockMovementStmt:             q.createStockMovementStmt,
+		createUserStmt:                      q.createUserStmt,
+		createVoucherStmt:                   q.createVoucherStmt,
+		createVoucherRedemptionStmt:         q.createVoucherRedemptionStmt,
+		decrementVoucherUsageStmt:           q.decrementVoucherUsageStmt,
+		deleteCartStmt:                      q.deleteCartStmt,
+		deleteCartItemStmt:                  q.deleteCartItemStmt,
+		deleteExpiredGuestCartsStmt:         q.deleteExpiredGuestCartsStmt,
+		deleteProductPriceStmt:              q.deleteProductPriceStmt,
+		deleteProductSubscriptionStmt:       q.deleteProductSubscriptionStmt,
+		deleteReviewStmt:                    q.deleteReviewStmt,
+		deleteReviewPhotosStmt:              q.deleteReviewPhotosStmt,
+		deleteReviewReplyStmt:               q.deleteReviewReplyStmt,
+		deleteReviewVoteStmt:                q.deleteReviewVoteStmt,
+		deleteSlugRedirectStmt:              q.deleteSlugRedirectStmt,
+		deleteVoucherCategoriesStmt:         q.deleteVoucherCategoriesStmt,
+		deleteVoucherProductsStmt:           q.deleteVoucherProductsStmt,
+		deleteVoucherRedemptionByOrderStmt:  q.deleteVoucherRedemptionByOrderStmt,
+		deleteWishlistItemStmt:              q.deleteWishlistItemStmt,
+		expireOrderStmt:                     q.expireOrderStmt,
+		exportProductsStmt:                  q.exportProductsStmt,
+		getAbandonedCartSummaryStmt:         q.getAbandonedCartSummaryStmt,
+		getAddressByIDForUserStmt:           q.getAddressByIDForUserStmt,
+		getAverageRatingByProductIDStmt:     q.getAverageRatingByProductIDStmt,
+		getBrandByIDStmt:                    q.getBrandByIDStmt,
+		getBrandBySlugStmt:                  q.getBrandBySlugStmt,
+		getBrandSlugRedirectStmt:            q.getBrandSlugRedirectStmt,
+		getCartByGuestIDStmt:                q.getCartByGuestIDStmt,
+		getCartByUserIDStmt:                 q.getCartByUserIDStmt,
+		getCartDetailStmt:                   q.getCartDetailStmt,
+		getCartItemStmt:                     q.getCartItemStmt,
+		getCategoryBreadcrumbsStmt:          q.getCategoryBreadcrumbsStmt,
+		getCategoryByIDStmt:                 q.getCategoryByIDStmt,
+		getCategoryBySlugStmt:               q.getCategoryBySlugStmt,
+		getCategorySlugRedirectStmt:         q.getCategorySlugRedirectStmt,
+		getCompletedOrderForReviewStmt:      q.getCompletedOrderForReviewStmt,
+		getOrderByIDStmt:                    q.getOrderByIDStmt,
+		getOrderItemForReturnStmt:           q.getOrderItemForReturnStmt,
+		getOrderItemsStmt:                   q.getOrderItemsStmt,
+		getProductByIDStmt:                  q.getProductByIDStmt,
+		getProductBySlugStmt:                q.getProductBySlugStmt,
+		getProductSlugRedirectStmt:          q.getProductSlugRedirectStmt,
+		getProductStockStmt:                 q.getProductStockStmt,
+		getRefundByReturnIDStmt:             q.getRefundByReturnIDStmt,
+		getReturnByIDStmt:                   q.getReturnByIDStmt,
+		getReturnByIDForUpdateStmt:          q.getReturnByIDForUpdateStmt,
+		getReviewByIDStmt:                   q.getReviewByIDStmt,
+		getReviewReplyStmt:                  q.getReviewReplyStmt,
+		getReviewsByProductIDStmt:           q.getReviewsByProductIDStmt,
+		getReviewsByProductIDKeysetStmt:     q.getReviewsByProductIDKeysetStmt,
+		getReviewsByUserIDStmt:              q.getReviewsByUserIDStmt,
+		getShipmentByOrderIDStmt:            q.getShipmentByOrderIDStmt,
+		getShipmentByReceiptForUpdateStmt:   q.getShipmentByReceiptForUpdateStmt,
+		getSubscribableProductStmt:          q.getSubscribableProductStmt,
+		getUserByEmailStmt:                  q.getUserByEmailStmt,
+		getUserByIDStmt:                     q.getUserByIDStmt,
+		getVoucherByCodeForUpdateStmt:       q.getVoucherByCodeForUpdateStmt,
+		getVoucherByIDStmt:                  q.getVoucherByIDStmt,
+		getVoucherUsageSummaryStmt:          q.getVoucherUsageSummaryStmt,
+		getWishlistByShareTokenStmt:         q.getWishlistByShareTokenStmt,
+		getWishlistByUserIDStmt:             q.getWishlistByUserIDStmt,
+		getWishlistItemStmt:                 q.getWishlistItemStmt,
+		incrementReviewReportCountStmt:      q.incrementReviewReportCountStmt,
+		incrementVoucherUsageStmt:           q.incrementVoucherUsageStmt,
+		isCategoryDescendantStmt:            q.isCategoryDescendantStmt,
+		listAbandonedCartsStmt:              q.listAbandonedCartsStmt,
+		listAddressesAdminStmt:              q.listAddressesAdminStmt,
+		listAddressesByUserStmt:             q.listAddressesByUserStmt,
+		listBrandsAdminStmt:                 q.listBrandsAdminStmt,
+		listBrandsPublicStmt:                q.listBrandsPublicStmt,
+		listCategoriesAdminStmt:             q.listCategoriesAdminStmt,
+		listCategoriesPublicStmt:            q.listCategoriesPublicStmt,
+		listCategoryTreeStmt:                q.listCategoryTreeStmt,
+		listDueCartRemindersStmt:            q.listDueCartRemindersStmt,
+		listEligibleVoucherProductsStmt:     q.listEligibleVoucherProductsStmt,
+		listExpiredPendingOrderIDsStmt:      q.listExpiredPendingOrderIDsStmt,
+		listLowStockProductsStmt:            q.listLowStockProductsStmt,
+		listOrdersStmt:                      q.listOrdersStmt,
+		listOrdersAdminStmt:                 q.listOrdersAdminStmt,
+		listOrdersAdminKeysetStmt:           q.listOrdersAdminKeysetStmt,
+		listOrdersKeysetStmt:                q.listOrdersKeysetStmt,
+		listPendingNotificationsStmt:        q.listPendingNotificationsStmt,
+		listProductPricesStmt:               q.listProductPricesStmt,
+		listProductSKUsExistingStmt:         q.listProductSKUsExistingStmt,
+		listProductSubscriptionsByUserStmt:  q.listProductSubscriptionsByUserStmt,
+		listProductTaxRatesStmt:             q.listProductTaxRatesStmt,
+		listProductWeightsStmt:              q.listProductWeightsStmt,
+		listProductsAdminStmt:               q.listProductsAdminStmt,
+		listProductsAdminKeysetStmt:         q.listProductsAdminKeysetStmt,
+		listProductsPublicStmt:              q.listProductsPublicStmt,
+		listProductsPublicKeysetStmt:        q.listProductsPublicKeysetStmt,
+		listReturnPhotosStmt:                q.listReturnPhotosStmt,
+		listReturnsAdminStmt:                q.listReturnsAdminStmt,
+		listReturnsByUserStmt:               q.listReturnsByUserStmt,
+		listReviewReportsStmt:               q.listReviewReportsStmt,
+		listReviewsAdminStmt:                q.listReviewsAdminStmt,
+		listShipmentTrackingEventsStmt:      q.listShipmentTrackingEventsStmt,
+		listShippingRatesForDestinationStmt: q.listShippingRatesForDestinationStmt,
+		listStockMovementsByProductStmt:     q.listStockMovementsByProductStmt,
+		listTaxClassesStmt:                  q.listTaxClassesStmt,
+		listVoucherCategoryIDsStmt:          q.listVoucherCategoryIDsStmt,
+		listVoucherProductIDsStmt:           q.listVoucherProductIDsStmt,
+		listVoucherRedemptionsStmt:          q.listVoucherRedemptionsStmt,
+		listVouchersAdminStmt:               q.listVouchersAdminStmt,
+		listWishlistItemsStmt:               q.listWishlistItemsStmt,
+		lockGuestCartStmt:                   q.lockGuestCartStmt,
+		lockProductForRatingStmt:            q.lockProductForRatingStmt,
+		lockProductStockStmt:                q.lockProductStockStmt,
+		markNotificationFailedStmt:          q.markNotificationFailedStmt,
+		markNotificationSentStmt:            q.markNotificationSentStmt,
+		markOrderDeliveredStmt:              q.markOrderDeliveredStmt,
+		markReturnRefundedStmt:              q.markReturnRefundedStmt,
+		markShipmentDeliveredStmt:           q.markShipmentDeliveredStmt,
+		mergeCartItemsStmt:                  q.mergeCartItemsStmt,
+		optOutCartRemindersStmt:             q.optOutCartRemindersStmt,
+		productSlugExistsStmt:               q.productSlugExistsStmt,
+		receiveReturnStmt:                   q.receiveReturnStmt,
+		recomputeAllProductRatingsStmt:      q.recomputeAllProductRatingsStmt,
+		recomputeProductRatingStmt:          q.recomputeProductRatingStmt,
+		recomputeReviewVotesStmt:            q.recomputeReviewVotesStmt,
+		rejectReturnStmt:                    q.rejectReturnStmt,
+		resolveReviewReportsStmt:            q.resolveReviewReportsStmt,
+		restoreBrandStmt:                    q.restoreBrandStmt,
+		restoreCategoryStmt:                 q.restoreCategoryStmt,
+		restoreProductStmt:                  q.restoreProductStmt,
+		setOrderReceiptNoStmt:               q.setOrderReceiptNoStmt,
+		setReviewStatusStmt:                 q.setReviewStatusStmt,
+		setWishlistShareTokenStmt:           q.setWishlistShareTokenStmt,
+		shipReturnStmt:                      q.shipReturnStmt,
+		softDeleteAddressStmt:               q.softDeleteAddressStmt,
+		softDeleteBrandStmt:                 q.softDeleteBrandStmt,
+		softDeleteCategoryStmt:              q.softDeleteCategoryStmt,
+		softDeleteProductStmt:               q.softDeleteProductStmt,
+		softDeleteVoucherStmt:               q.softDeleteVoucherStmt,
+		suggestProductsStmt:                 q.suggestProductsStmt,
+		sumActiveReturnQuantityStmt:         q.sumActiveReturnQuantityStmt,
+		tryAdvisoryLockStmt:                 q.tryAdvisoryLockStmt,
+		unsetPrimaryAddressByUserStmt:       q.unsetPrimaryAddressByUserStmt,
+		updateAddressStmt:                   q.updateAddressStmt,
+		updateBrandStmt:                     q.updateBrandStmt,
+		updateCartItemQtyStmt:               q.updateCartItemQtyStmt,
+		updateCategoryStmt:                  q.updateCategoryStmt,
+		updateOrderStatusStmt:               q.updateOrderStatusStmt,
+		updateProductStmt:                   q.updateProductStmt,
+		updateProductLowStockThresholdStmt:  q.updateProductLowStockThresholdStmt,
+		updateReviewStmt:                    q.updateReviewStmt,
+		updateReviewReplyStmt:               q.updateReviewReplyStmt,
+		updateVoucherStmt:                   q.updateVoucherStmt,
+		upsertProductSubscriptionStmt:       q.upsertProductSubscriptionStmt,
+		upsertProductsBatchStmt:             q.upsertProductsBatchStmt,
+		upsertReviewVoteStmt:                q.upsertReviewVoteStmt,
+		upsertSlugRedirectStmt:              q.upsertSlugRedirectStmt,
+		upsertTaxClassStmt:                  q.upsertTaxClassStmt,
+		upsertWishlistStmt:                  q.upsertWishlistStmt,
 	}
 }
@@ -31,23 +31,27 @@ type Address struct {
 }
 
 type Brand struct {
-	ID          uuid.UUID      `json:"id"`
-	Name        string         `json:"name"`
-	Slug        string         `json:"slug"`
-	Description sql.NullString `json:"description"`
-	ImageUrl    sql.NullString `json:"image_url"`
-	IsActive    sql.NullBool   `json:"is_active"`
-	CreatedAt   time.Time      `json:"created_at"`
-	UpdatedAt   time.Time      `json:"updated_at"`
-	DeletedAt   sql.NullTime   `json:"deleted_at"`
+	ID              uuid.UUID      `json:"id"`
+	Name            string         `json:"name"`
+	Slug            string         `json:"slug"`
+	Description     sql.NullString `json:"description"`
+	ImageUrl        sql.NullString `json:"image_url"`
+	IsActive        sql.NullBool   `json:"is_active"`
+	CreatedAt       time.Time      `json:"created_at"`
+	UpdatedAt       time.Time      `json:"updated_at"`
+	DeletedAt       sql.NullTime   `json:"deleted_at"`
+	MetaTitle       sql.NullString `json:"meta_title"`
+	MetaDescription sql.NullString `json:"meta_description"`
 }
 
 type Cart struct {
-	ID        uuid.UUID    `json:"id"`
-	UserID    uuid.UUID    `json:"user_id"`
-	CreatedAt time.Time    `json:"created_at"`
-	UpdatedAt time.Time    `json:"updated_at"`
-	DeletedAt sql.NullTime `json:"deleted_at"`
+	ID        uuid.UUID     `json:"id"`
+	UserID    uuid.NullUUID `json:"user_id"`
+	CreatedAt time.Time     `json:"created_at"`
+	UpdatedAt time.Time     `json:"updated_at"`
+	DeletedAt sql.NullTime  `json:"deleted_at"`
+	GuestID   uuid.NullUUID `json:"guest_id"`
+	ExpiresAt sql.NullTime  `json:"expires_at"`
 }
 
 type CartItem struct {
@@ -61,42 +65,89 @@ type CartItem struct {
 	DeletedAt  sql.NullTime `json:"deleted_at"`
 }
 
+type CartReminder struct {
+	ID             uuid.UUID `json:"id"`
+	CartID         uuid.UUID `json:"cart_id"`
+	UserID         uuid.UUID `json:"user_id"`
+	ReminderNumber int32     `json:"reminder_number"`
+	CartActivityAt time.Time `json:"cart_activity_at"`
+	ItemCount      int32     `json:"item_count"`
+	CartValue      string    `json:"cart_value"`
+	SentAt         time.Time `json:"sent_at"`
+}
+
+type CartReminderOptOut struct {
+	UserID    uuid.UUID `json:"user_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
 type Category struct {
-	ID          uuid.UUID      `json:"id"`
-	Name        string         `json:"name"`
-	Slug        string         `json:"slug"`
-	Description sql.NullString `json:"description"`
-	ImageUrl    sql.NullString `json:"image_url"`
-	IsActive    sql.NullBool   `json:"is_active"`
-	CreatedAt   time.Time      `json:"created_at"`
-	UpdatedAt   time.Time      `json:"updated_at"`
-	DeletedAt   sql.NullTime   `json:"deleted_at"`
+	ID              uuid.UUID      `json:"id"`
+	Name            string         `json:"name"`
+	Slug            string         `json:"slug"`
+	Description     sql.NullString `json:"description"`
+	ImageUrl        sql.NullString `json:"image_url"`
+	IsActive        sql.NullBool   `json:"is_active"`
+	CreatedAt       time.Time      `json:"created_at"`
+	UpdatedAt       time.Time      `json:"updated_at"`
+	DeletedAt       sql.NullTime   `json:"deleted_at"`
+	MetaTitle       sql.NullString `json:"meta_title"`
+	MetaDescription sql.NullString `json:"meta_description"`
+	ParentID        uuid.NullUUID  `json:"parent_id"`
+}
+
+type InvoiceSequence struct {
+	Year       int32 `json:"year"`
+	LastNumber int32 `json:"last_number"`
+}
+
+type Notification struct {
+	ID        uuid.UUID      `json:"id"`
+	UserID    uuid.NullUUID  `json:"user_id"`
+	Channel   string         `json:"channel"`
+	Type      string         `json:"type"`
+	Recipient string         `json:"recipient"`
+	Subject   string         `json:"subject"`
+	Body      string         `json:"body"`
+	Status    string         `json:"status"`
+	Attempts  int32          `json:"attempts"`
+	LastError sql.NullString `json:"last_error"`
+	CreatedAt time.Time      `json:"created_at"`
+	SentAt    sql.NullTime   `json:"sent_at"`
 }
 
 type Order struct {
-	ID              uuid.UUID       `json:"id"`
-	OrderNumber     string          `json:"order_number"`
-	UserID          uuid.UUID       `json:"user_id"`
-	Status          string          `json:"status"`
-	PaymentMethod   sql.NullString  `json:"payment_method"`
-	PaymentStatus   string          `json:"payment_status"`
-	AddressSnapshot json.RawMessage `json:"address_snapshot"`
-	SubtotalPrice   string          `json:"subtotal_price"`
-	DiscountPrice   string          `json:"discount_price"`
-	ShippingPrice   string          `json:"shipping_price"`
-	TotalPrice      string          `json:"total_price"`
-	Note            sql.NullString  `json:"note"`
-	PlacedAt        time.Time       `json:"placed_at"`
-	PaidAt          sql.NullTime    `json:"paid_at"`
-	CancelledAt     sql.NullTime    `json:"cancelled_at"`
-	CancelReason    sql.NullString  `json:"cancel_reason"`
-	CompletedAt     sql.NullTime    `json:"completed_at"`
-	ReceiptNo       sql.NullString  `json:"receipt_no"`
-	SnapToken       sql.NullString  `json:"snap_token"`
-	SnapRedirectUrl sql.NullString  `json:"snap_redirect_url"`
-	CreatedAt       time.Time       `json:"created_at"`
-	UpdatedAt       time.Time       `json:"updated_at"`
-	DeletedAt       sql.NullTime    `json:"deleted_at"`
+	ID               uuid.UUID       `json:"id"`
+	OrderNumber      string          `json:"order_number"`
+	UserID           uuid.UUID       `json:"user_id"`
+	Status           string          `json:"status"`
+	PaymentMethod    sql.NullString  `json:"payment_method"`
+	PaymentStatus    string          `json:"payment_status"`
+	AddressSnapshot  json.RawMessage `json:"address_snapshot"`
+	SubtotalPrice    string          `json:"subtotal_price"`
+	DiscountPrice    string          `json:"discount_price"`
+	ShippingPrice    string          `json:"shipping_price"`
+	TotalPrice       string          `json:"total_price"`
+	Note             sql.NullString  `json:"note"`
+	PlacedAt         time.Time       `json:"placed_at"`
+	PaidAt           sql.NullTime    `json:"paid_at"`
+	CancelledAt      sql.NullTime    `json:"cancelled_at"`
+	CancelReason     sql.NullString  `json:"cancel_reason"`
+	CompletedAt      sql.NullTime    `json:"completed_at"`
+	ReceiptNo        sql.NullString  `json:"receipt_no"`
+	SnapToken        sql.NullString  `json:"snap_token"`
+	SnapRedirectUrl  sql.NullString  `json:"snap_redirect_url"`
+	CreatedAt        time.Time       `json:"created_at"`
+	UpdatedAt        time.Time       `json:"updated_at"`
+	DeletedAt        sql.NullTime    `json:"deleted_at"`
+	VoucherCode      sql.NullString  `json:"voucher_code"`
+	ShippingCourier  sql.NullString  `json:"shipping_courier"`
+	ShippingService  sql.NullString  `json:"shipping_service"`
+	RefundedAmount   string          `json:"refunded_amount"`
+	InvoiceNumber    sql.NullString  `json:"invoice_number"`
+	InvoicedAt       sql.NullTime    `json:"invoiced_at"`
+	TaxPrice         string          `json:"tax_price"`
+	PricesIncludeTax bool            `json:"prices_include_tax"`
 }
 
 type OrderItem struct {
@@ -109,35 +160,227 @@ type OrderItem struct {
 	TotalPrice   string    `json:"total_price"`
 	CreatedAt    time.Time `json:"created_at"`
 	UpdatedAt    time.Time `json:"updated_at"`
+	TaxRate      string    `json:"tax_rate"`
+	TaxAmount    string    `json:"tax_amount"`
 }
 
 type Product struct {
+	ID                uuid.UUID      `json:"id"`
+	CategoryID        uuid.UUID      `json:"category_id"`
+	Name              string         `json:"name"`
+	Slug              string         `json:"slug"`
+	Description       sql.NullString `json:"description"`
+	Price             string         `json:"price"`
+	Stock             int32          `json:"stock"`
+	Sku               sql.NullString `json:"sku"`
+	ImageUrl          sql.NullString `json:"image_url"`
+	IsActive          sql.NullBool   `json:"is_active"`
+	CreatedAt         time.Time      `json:"created_at"`
+	UpdatedAt         time.Time      `json:"updated_at"`
+	DeletedAt         sql.NullTime   `json:"deleted_at"`
+	BrandID           uuid.NullUUID  `json:"brand_id"`
+	SearchVector      interface{}    `json:"search_vector"`
+	MetaTitle         sql.NullString `json:"meta_title"`
+	MetaDescription   sql.NullString `json:"meta_description"`
+	LowStockThreshold int32          `json:"low_stock_threshold"`
+	PublishedAt       sql.NullTime   `json:"published_at"`
+	UnpublishedAt     sql.NullTime   `json:"unpublished_at"`
+	WeightGrams       int32          `json:"weight_grams"`
+	TaxClass          string         `json:"tax_class"`
+	RatingAvg         float64        `json:"rating_avg"`
+	RatingCount       int32          `json:"rating_count"`
+	Rating1Count      int32          `json:"rating_1_count"`
+	Rating2Count      int32          `json:"rating_2_count"`
+	Rating3Count      int32          `json:"rating_3_count"`
+	Rating4Count      int32          `json:"rating_4_count"`
+	Rating5Count      int32          `json:"rating_5_count"`
+}
+
+type ProductPrice struct {
+	ID             uuid.UUID      `json:"id"`
+	ProductID      uuid.UUID      `json:"product_id"`
+	Price          string         `json:"price"`
+	CompareAtPrice sql.NullString `json:"compare_at_price"`
+	StartsAt       time.Time      `json:"starts_at"`
+	EndsAt         sql.NullTime   `json:"ends_at"`
+	CreatedAt      time.Time      `json:"created_at"`
+}
+
+type ProductSubscription struct {
+	ID               uuid.UUID    `json:"id"`
+	UserID           uuid.UUID    `json:"user_id"`
+	ProductID        uuid.UUID    `json:"product_id"`
+	Type             string       `json:"type"`
+	PriceAtSubscribe string       `json:"price_at_subscribe"`
+	NotifiedAt       sql.NullTime `json:"notified_at"`
+	CreatedAt        time.Time    `json:"created_at"`
+	UpdatedAt        time.Time    `json:"updated_at"`
+}
+
+type Refund struct {
 	ID          uuid.UUID      `json:"id"`
-	CategoryID  uuid.UUID      `json:"category_id"`
-	Name        string         `json:"name"`
-	Slug        string         `json:"slug"`
-	Description sql.NullString `json:"description"`
-	Price       string         `json:"price"`
-	Stock       int32          `json:"stock"`
-	Sku         sql.NullString `json:"sku"`
-	ImageUrl    sql.NullString `json:"image_url"`
-	IsActive    sql.NullBool   `json:"is_active"`
+	ReturnID    uuid.UUID      `json:"return_id"`
+	OrderID     uuid.UUID      `json:"order_id"`
+	Amount      string         `json:"amount"`
+	Method      string         `json:"method"`
+	Reference   sql.NullString `json:"reference"`
+	Note        sql.NullString `json:"note"`
+	ProcessedBy uuid.NullUUID  `json:"processed_by"`
 	CreatedAt   time.Time      `json:"created_at"`
-	UpdatedAt   time.Time      `json:"updated_at"`
-	DeletedAt   sql.NullTime   `json:"deleted_at"`
+}
+
+type ReturnPhoto struct {
+	ID        uuid.UUID `json:"id"`
+	ReturnID  uuid.UUID `json:"return_id"`
+	ImageUrl  string    `json:"image_url"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ReturnRequest struct {
+	ID              uuid.UUID      `json:"id"`
+	RmaNumber       string         `json:"rma_number"`
+	OrderID         uuid.UUID      `json:"order_id"`
+	OrderItemID     uuid.UUID      `json:"order_item_id"`
+	UserID          uuid.UUID      `json:"user_id"`
+	ProductID       uuid.UUID      `json:"product_id"`
+	NameSnapshot    string         `json:"name_snapshot"`
+	UnitPrice       string         `json:"unit_price"`
+	Quantity        int32          `json:"quantity"`
+	Reason          string         `json:"reason"`
+	Description     sql.NullString `json:"description"`
+	Status          string         `json:"status"`
+	AdminNote       sql.NullString `json:"admin_note"`
+	ReturnCourier   sql.NullString `json:"return_courier"`
+	ReturnReceiptNo sql.NullString `json:"return_receipt_no"`
+	ApprovedAt      sql.NullTime   `json:"approved_at"`
+	RejectedAt      sql.NullTime   `json:"rejected_at"`
+	ShippedBackAt   sql.NullTime   `json:"shipped_back_at"`
+	ReceivedAt      sql.NullTime   `json:"received_at"`
+	RefundedAt      sql.NullTime   `json:"refunded_at"`
+	CreatedAt       time.Time      `json:"created_at"`
+	UpdatedAt       time.Time      `json:"updated_at"`
 }
 
 type Review struct {
-	ID                 uuid.UUID    `json:"id"`
-	UserID             uuid.UUID    `json:"user_id"`
-	ProductID          uuid.UUID    `json:"product_id"`
-	OrderID            uuid.UUID    `json:"order_id"`
-	Rating             int32        `json:"rating"`
-	Comment            string       `json:"comment"`
-	IsVerifiedPurchase bool         `json:"is_verified_purchase"`
-	CreatedAt          time.Time    `json:"created_at"`
-	UpdatedAt          time.Time    `json:"updated_at"`
-	DeletedAt          sql.NullTime `json:"deleted_at"`
+	ID                 uuid.UUID      `json:"id"`
+	UserID             uuid.UUID      `json:"user_id"`
+	ProductID          uuid.UUID      `json:"product_id"`
+	OrderID            uuid.UUID      `json:"order_id"`
+	Rating             int32          `json:"rating"`
+	Comment            string         `json:"comment"`
+	IsVerifiedPurchase bool           `json:"is_verified_purchase"`
+	CreatedAt          time.Time      `json:"created_at"`
+	UpdatedAt          time.Time      `json:"updated_at"`
+	DeletedAt          sql.NullTime   `json:"deleted_at"`
+	Status             string         `json:"status"`
+	ModerationNote     sql.NullString `json:"moderation_note"`
+	ModeratedBy        uuid.NullUUID  `json:"moderated_by"`
+	ModeratedAt        sql.NullTime   `json:"moderated_at"`
+	HelpfulCount       int32          `json:"helpful_count"`
+	NotHelpfulCount    int32          `json:"not_helpful_count"`
+	ReportCount        int32          `json:"report_count"`
+}
+
+type ReviewPhoto struct {
+	ID        uuid.UUID `json:"id"`
+	ReviewID  uuid.UUID `json:"review_id"`
+	ImageUrl  string    `json:"image_url"`
+	PublicID  string    `json:"public_id"`
+	SortOrder int32     `json:"sort_order"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ReviewReply struct {
+	ID        uuid.UUID     `json:"id"`
+	ReviewID  uuid.UUID     `json:"review_id"`
+	AdminID   uuid.NullUUID `json:"admin_id"`
+	Body      string        `json:"body"`
+	CreatedAt time.Time     `json:"created_at"`
+	UpdatedAt time.Time     `json:"updated_at"`
+}
+
+type ReviewReport struct {
+	ID         uuid.UUID      `json:"id"`
+	ReviewID   uuid.UUID      `json:"review_id"`
+	UserID     uuid.UUID      `json:"user_id"`
+	Reason     string         `json:"reason"`
+	Note       sql.NullString `json:"note"`
+	ResolvedAt sql.NullTime   `json:"resolved_at"`
+	CreatedAt  time.Time      `json:"created_at"`
+}
+
+type ReviewVote struct {
+	ID        uuid.UUID `json:"id"`
+	ReviewID  uuid.UUID `json:"review_id"`
+	UserID    uuid.UUID `json:"user_id"`
+	Helpful   bool      `json:"helpful"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type Shipment struct {
+	ID          uuid.UUID    `json:"id"`
+	OrderID     uuid.UUID    `json:"order_id"`
+	Courier     string       `json:"courier"`
+	Service     string       `json:"service"`
+	ReceiptNo   string       `json:"receipt_no"`
+	ShippedAt   time.Time    `json:"shipped_at"`
+	DeliveredAt sql.NullTime `json:"delivered_at"`
+	CreatedAt   time.Time    `json:"created_at"`
+	UpdatedAt   time.Time    `json:"updated_at"`
+}
+
+type ShipmentTrackingEvent struct {
+	ID          uuid.UUID      `json:"id"`
+	ShipmentID  uuid.UUID      `json:"shipment_id"`
+	Status      string         `json:"status"`
+	Description string         `json:"description"`
+	Location    sql.NullString `json:"location"`
+	OccurredAt  time.Time      `json:"occurred_at"`
+	CreatedAt   time.Time      `json:"created_at"`
+}
+
+type ShippingRate struct {
+	ID             uuid.UUID      `json:"id"`
+	Courier        string         `json:"courier"`
+	Service        string         `json:"service"`
+	Province       sql.NullString `json:"province"`
+	City           sql.NullString `json:"city"`
+	MinWeightGrams int32          `json:"min_weight_grams"`
+	MaxWeightGrams sql.NullInt32  `json:"max_weight_grams"`
+	Price          string         `json:"price"`
+	Etd            sql.NullString `json:"etd"`
+	IsActive       bool           `json:"is_active"`
+	CreatedAt      time.Time      `json:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at"`
+}
+
+type SlugRedirect struct {
+	ID         uuid.UUID `json:"id"`
+	EntityType string    `json:"entity_type"`
+	OldSlug    string    `json:"old_slug"`
+	EntityID   uuid.UUID `json:"entity_id"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type StockMovement struct {
+	ID         uuid.UUID      `json:"id"`
+	ProductID  uuid.UUID      `json:"product_id"`
+	Delta      int32          `json:"delta"`
+	StockAfter int32          `json:"stock_after"`
+	Reason     string         `json:"reason"`
+	OrderID    uuid.NullUUID  `json:"order_id"`
+	ActorID    uuid.NullUUID  `json:"actor_id"`
+	Note       sql.NullString `json:"note"`
+	CreatedAt  time.Time      `json:"created_at"`
+}
+
+type TaxClass struct {
+	Code      string    `json:"code"`
+	Name      string    `json:"name"`
+	Rate      string    `json:"rate"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
 type User struct {
@@ -149,3 +392,56 @@ type User struct {
 	Role      string    `json:"role"`
 	CreatedAt time.Time `json:"created_at"`
 }
+
+type Voucher struct {
+	ID            uuid.UUID      `json:"id"`
+	Code          string         `json:"code"`
+	Description   sql.NullString `json:"description"`
+	DiscountType  string         `json:"discount_type"`
+	DiscountValue string         `json:"discount_value"`
+	MaxDiscount   sql.NullString `json:"max_discount"`
+	MinSpend      string         `json:"min_spend"`
+	UsageLimit    sql.NullInt32  `json:"usage_limit"`
+	PerUserLimit  sql.NullInt32  `json:"per_user_limit"`
+	UsedCount     int32          `json:"used_count"`
+	StartsAt      time.Time      `json:"starts_at"`
+	EndsAt        sql.NullTime   `json:"ends_at"`
+	IsActive      bool           `json:"is_active"`
+	CreatedAt     time.Time      `json:"created_at"`
+	UpdatedAt     time.Time      `json:"updated_at"`
+	DeletedAt     sql.NullTime   `json:"deleted_at"`
+}
+
+type VoucherCategory struct {
+	VoucherID  uuid.UUID `json:"voucher_id"`
+	CategoryID uuid.UUID `json:"category_id"`
+}
+
+type VoucherProduct struct {
+	VoucherID uuid.UUID `json:"voucher_id"`
+	ProductID uuid.UUID `json:"product_id"`
+}
+
+type VoucherRedemption struct {
+	ID             uuid.UUID `json:"id"`
+	VoucherID      uuid.UUID `json:"voucher_id"`
+	UserID         uuid.UUID `json:"user_id"`
+	OrderID        uuid.UUID `json:"order_id"`
+	DiscountAmount string    `json:"discount_amount"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+type Wishlist struct {
+	ID         uuid.UUID      `json:"id"`
+	UserID     uuid.UUID      `json:"user_id"`
+	ShareToken sql.NullString `json:"share_token"`
+	CreatedAt  time.Time      `json:"created_at"`
+	UpdatedAt  time.Time      `json:"updated_at"`
+}
+
+type WishlistItem struct {
+	ID         uuid.UUID `json:"id"`
+	WishlistID uuid.UUID `json:"wishlist_id"`
+	ProductID  uuid.UUID `json:"product_id"`
+	CreatedAt  time.Time `json:"created_at"`
+}
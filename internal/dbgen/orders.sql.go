@@ -14,23 +14,212 @@ import (
 	"github.com/google/uuid"
 )
 
+const cancelOrder = `-- name: CancelOrder :one
+UPDATE orders
+SET status = 'CANCELLED',
+    cancelled_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1
+  AND status = 'PENDING'
+  AND payment_status = 'UNPAID'
+RETURNING id, order_number, user_id, status, payment_method, payment_status, address_snapshot, subtotal_price, discount_price, shipping_price, total_price, note, placed_at, paid_at, cancelled_at, cancel_reason, completed_at, receipt_no, snap_token, snap_redirect_url, created_at, updated_at, deleted_at, voucher_code, shipping_courier, shipping_service, refunded_amount, invoice_number, invoiced_at, tax_price, prices_include_tax
+`
+
+// Guard status: cancel ganda / balapan dengan expire / pembayaran masuk tidak mengubah apa pun
+func (q *Queries) CancelOrder(ctx context.Context, id uuid.UUID) (Order, error) {
+	row := q.queryRow(ctx, q.cancelOrderStmt, cancelOrder, id)
+	var i Order
+	err := row.Scan(
+		&i.ID,
+		&i.OrderNumber,
+		&i.UserID,
+		&i.Status,
+		&i.PaymentMethod,
+		&i.PaymentStatus,
+		&i.AddressSnapshot,
+		&i.SubtotalPrice,
+		&i.DiscountPrice,
+		&i.ShippingPrice,
+		&i.TotalPrice,
+		&i.Note,
+		&i.PlacedAt,
+		&i.PaidAt,
+		&i.CancelledAt,
+		&i.CancelReason,
+		&i.CompletedAt,
+		&i.ReceiptNo,
+		&i.SnapToken,
+		&i.SnapRedirectUrl,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.VoucherCode,
+		&i.ShippingCourier,
+		&i.ShippingService,
+		&i.RefundedAmount,
+		&i.InvoiceNumber,
+		&i.InvoicedAt,
+		&i.TaxPrice,
+		&i.PricesIncludeTax,
+	)
+	return i, err
+}
+
+const completeDeliveredOrders = `-- name: CompleteDeliveredOrders :many
+UPDATE orders
+SET status = 'COMPLETED',
+    completed_at = NOW(),
+    updated_at = NOW()
+WHERE id IN (
+    SELECT o.id
+    FROM orders o
+    LEFT JOIN shipments s ON s.order_id = o.id
+    WHERE o.status = 'DELIVERED'
+      AND COALESCE(s.delivered_at, o.updated_at) < $1::timestamp
+    ORDER BY o.updated_at
+    LIMIT $2::int
+    FOR UPDATE OF o SKIP LOCKED
+)
+  AND status = 'DELIVERED'
+RETURNING id, order_number, user_id, status, payment_method, payment_status, address_snapshot, subtotal_price, discount_price, shipping_price, total_price, note, placed_at, paid_at, cancelled_at, cancel_reason, completed_at, receipt_no, snap_token, snap_redirect_url, created_at, updated_at, deleted_at, voucher_code, shipping_courier, shipping_service, refunded_amount, invoice_number, invoiced_at, tax_price, prices_include_tax
+`
+
+type CompleteDeliveredOrdersParams struct {
+	Cutoff    time.Time `json:"cutoff"`
+	BatchSize int32     `json:"batch_size"`
+}
+
+// Waktu terima diambil dari shipment, fallback ke updated_at order
+func (q *Queries) CompleteDeliveredOrders(ctx context.Context, arg CompleteDeliveredOrdersParams) ([]Order, error) {
+	rows, err := q.query(ctx, q.completeDeliveredOrdersStmt, completeDeliveredOrders, arg.Cutoff, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Order
+	for rows.Next() {
+		var i Order
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrderNumber,
+			&i.UserID,
+			&i.Status,
+			&i.PaymentMethod,
+			&i.PaymentStatus,
+			&i.AddressSnapshot,
+			&i.SubtotalPrice,
+			&i.DiscountPrice,
+			&i.ShippingPrice,
+			&i.TotalPrice,
+			&i.Note,
+			&i.PlacedAt,
+			&i.PaidAt,
+			&i.CancelledAt,
+			&i.CancelReason,
+			&i.CompletedAt,
+			&i.ReceiptNo,
+			&i.SnapToken,
+			&i.SnapRedirectUrl,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.VoucherCode,
+			&i.ShippingCourier,
+			&i.ShippingService,
+			&i.RefundedAmount,
+			&i.InvoiceNumber,
+			&i.InvoicedAt,
+			&i.TaxPrice,
+			&i.PricesIncludeTax,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const completeOrder = `-- name: CompleteOrder :one
+UPDATE orders
+SET status = 'COMPLETED',
+    completed_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1
+  AND status = 'DELIVERED'
+RETURNING id, order_number, user_id, status, payment_method, payment_status, address_snapshot, subtotal_price, discount_price, shipping_price, total_price, note, placed_at, paid_at, cancelled_at, cancel_reason, completed_at, receipt_no, snap_token, snap_redirect_url, created_at, updated_at, deleted_at, voucher_code, shipping_courier, shipping_service, refunded_amount, invoice_number, invoiced_at, tax_price, prices_include_tax
+`
+
+// Guard status: customer hanya boleh menyelesaikan order yang sudah DELIVERED
+func (q *Queries) CompleteOrder(ctx context.Context, id uuid.UUID) (Order, error) {
+	row := q.queryRow(ctx, q.completeOrderStmt, completeOrder, id)
+	var i Order
+	err := row.Scan(
+		&i.ID,
+		&i.OrderNumber,
+		&i.UserID,
+		&i.Status,
+		&i.PaymentMethod,
+		&i.PaymentStatus,
+		&i.AddressSnapshot,
+		&i.SubtotalPrice,
+		&i.DiscountPrice,
+		&i.ShippingPrice,
+		&i.TotalPrice,
+		&i.Note,
+		&i.PlacedAt,
+		&i.PaidAt,
+		&i.CancelledAt,
+		&i.CancelReason,
+		&i.CompletedAt,
+		&i.ReceiptNo,
+		&i.SnapToken,
+		&i.SnapRedirectUrl,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.VoucherCode,
+		&i.ShippingCourier,
+		&i.ShippingService,
+		&i.RefundedAmount,
+		&i.InvoiceNumber,
+		&i.InvoicedAt,
+		&i.TaxPrice,
+		&i.PricesIncludeTax,
+	)
+	return i, err
+}
+
 const createOrder = `-- name: CreateOrder :one
 INSERT INTO orders (
     order_number, user_id, status, address_snapshot, 
-    subtotal_price, shipping_price, total_price, note, placed_at
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW())
-RETURNING id, order_number, user_id, status, payment_method, payment_status, address_snapshot, subtotal_price, discount_price, shipping_price, total_price, note, placed_at, paid_at, cancelled_at, cancel_reason, completed_at, receipt_no, snap_token, snap_redirect_url, created_at, updated_at, deleted_at
+    subtotal_price, shipping_price, total_price, note,
+    discount_price, voucher_code, shipping_courier, shipping_service,
+    tax_price, prices_include_tax, placed_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, NOW())
+RETURNING id, order_number, user_id, status, payment_method, payment_status, address_snapshot, subtotal_price, discount_price, shipping_price, total_price, note, placed_at, paid_at, cancelled_at, cancel_reason, completed_at, receipt_no, snap_token, snap_redirect_url, created_at, updated_at, deleted_at, voucher_code, shipping_courier, shipping_service, refunded_amount, invoice_number, invoiced_at, tax_price, prices_include_tax
 `
 
 type CreateOrderParams struct {
-	OrderNumber     string          `json:"order_number"`
-	UserID          uuid.UUID       `json:"user_id"`
-	Status          string          `json:"status"`
-	AddressSnapshot json.RawMessage `json:"address_snapshot"`
-	SubtotalPrice   string          `json:"subtotal_price"`
-	ShippingPrice   string          `json:"shipping_price"`
-	TotalPrice      string          `json:"total_price"`
-	Note            sql.NullString  `json:"note"`
+	OrderNumber      string          `json:"order_number"`
+	UserID           uuid.UUID       `json:"user_id"`
+	Status           string          `json:"status"`
+	AddressSnapshot  json.RawMessage `json:"address_snapshot"`
+	SubtotalPrice    string          `json:"subtotal_price"`
+	ShippingPrice    string          `json:"shipping_price"`
+	TotalPrice       string          `json:"total_price"`
+	Note             sql.NullString  `json:"note"`
+	DiscountPrice    string          `json:"discount_price"`
+	VoucherCode      sql.NullString  `json:"voucher_code"`
+	ShippingCourier  sql.NullString  `json:"shipping_courier"`
+	ShippingService  sql.NullString  `json:"shipping_service"`
+	TaxPrice         string          `json:"tax_price"`
+	PricesIncludeTax bool            `json:"prices_include_tax"`
 }
 
 func (q *Queries) CreateOrder(ctx context.Context, arg CreateOrderParams) (Order, error) {
@@ -43,6 +232,12 @@ func (q *Queries) CreateOrder(ctx context.Context, arg CreateOrderParams) (Order
 		arg.ShippingPrice,
 		arg.TotalPrice,
 		arg.Note,
+		arg.DiscountPrice,
+		arg.VoucherCode,
+		arg.ShippingCourier,
+		arg.ShippingService,
+		arg.TaxPrice,
+		arg.PricesIncludeTax,
 	)
 	var i Order
 	err := row.Scan(
@@ -69,14 +264,23 @@ func (q *Queries) CreateOrder(ctx context.Context, arg CreateOrderParams) (Order
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.VoucherCode,
+		&i.ShippingCourier,
+		&i.ShippingService,
+		&i.RefundedAmount,
+		&i.InvoiceNumber,
+		&i.InvoicedAt,
+		&i.TaxPrice,
+		&i.PricesIncludeTax,
 	)
 	return i, err
 }
 
 const createOrderItem = `-- name: CreateOrderItem :exec
 INSERT INTO order_items (
-    order_id, product_id, name_snapshot, unit_price, quantity, total_price
-) VALUES ($1, $2, $3, $4, $5, $6)
+    order_id, product_id, name_snapshot, unit_price, quantity, total_price,
+    tax_rate, tax_amount
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 `
 
 type CreateOrderItemParams struct {
@@ -86,6 +290,8 @@ type CreateOrderItemParams struct {
 	UnitPrice    string    `json:"unit_price"`
 	Quantity     int32     `json:"quantity"`
 	TotalPrice   string    `json:"total_price"`
+	TaxRate      string    `json:"tax_rate"`
+	TaxAmount    string    `json:"tax_amount"`
 }
 
 func (q *Queries) CreateOrderItem(ctx context.Context, arg CreateOrderItemParams) error {
@@ -96,12 +302,71 @@ func (q *Queries) CreateOrderItem(ctx context.Context, arg CreateOrderItemParams
 		arg.UnitPrice,
 		arg.Quantity,
 		arg.TotalPrice,
+		arg.TaxRate,
+		arg.TaxAmount,
 	)
 	return err
 }
 
+const expireOrder = `-- name: ExpireOrder :one
+UPDATE orders
+SET status = 'CANCELLED',
+    cancel_reason = $1,
+    cancelled_at = NOW(),
+    updated_at = NOW()
+WHERE id = $2
+  AND status = 'PENDING'
+  AND payment_status = 'UNPAID'
+RETURNING id, order_number, user_id, status, payment_method, payment_status, address_snapshot, subtotal_price, discount_price, shipping_price, total_price, note, placed_at, paid_at, cancelled_at, cancel_reason, completed_at, receipt_no, snap_token, snap_redirect_url, created_at, updated_at, deleted_at, voucher_code, shipping_courier, shipping_service, refunded_amount, invoice_number, invoiced_at, tax_price, prices_include_tax
+`
+
+type ExpireOrderParams struct {
+	CancelReason sql.NullString `json:"cancel_reason"`
+	ID           uuid.UUID      `json:"id"`
+}
+
+// Guard status: order yang keburu dibayar tidak ikut dibatalkan
+func (q *Queries) ExpireOrder(ctx context.Context, arg ExpireOrderParams) (Order, error) {
+	row := q.queryRow(ctx, q.expireOrderStmt, expireOrder, arg.CancelReason, arg.ID)
+	var i Order
+	err := row.Scan(
+		&i.ID,
+		&i.OrderNumber,
+		&i.UserID,
+		&i.Status,
+		&i.PaymentMethod,
+		&i.PaymentStatus,
+		&i.AddressSnapshot,
+		&i.SubtotalPrice,
+		&i.DiscountPrice,
+		&i.ShippingPrice,
+		&i.TotalPrice,
+		&i.Note,
+		&i.PlacedAt,
+		&i.PaidAt,
+		&i.CancelledAt,
+		&i.CancelReason,
+		&i.CompletedAt,
+		&i.ReceiptNo,
+		&i.SnapToken,
+		&i.SnapRedirectUrl,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.VoucherCode,
+		&i.ShippingCourier,
+		&i.ShippingService,
+		&i.RefundedAmount,
+		&i.InvoiceNumber,
+		&i.InvoicedAt,
+		&i.TaxPrice,
+		&i.PricesIncludeTax,
+	)
+	return i, err
+}
+
 const getOrderByID = `-- name: GetOrderByID :one
-SELECT id, order_number, user_id, status, payment_method, payment_status, address_snapshot, subtotal_price, discount_price, shipping_price, total_price, note, placed_at, paid_at, cancelled_at, cancel_reason, completed_at, receipt_no, snap_token, snap_redirect_url, created_at, updated_at, deleted_at FROM orders WHERE id = $1 LIMIT 1
+SELECT id, order_number, user_id, status, payment_method, payment_status, address_snapshot, subtotal_price, discount_price, shipping_price, total_price, note, placed_at, paid_at, cancelled_at, cancel_reason, completed_at, receipt_no, snap_token, snap_redirect_url, created_at, updated_at, deleted_at, voucher_code, shipping_courier, shipping_service, refunded_amount, invoice_number, invoiced_at, tax_price, prices_include_tax FROM orders WHERE id = $1 LIMIT 1
 `
 
 func (q *Queries) GetOrderByID(ctx context.Context, id uuid.UUID) (Order, error) {
@@ -131,12 +396,20 @@ func (q *Queries) GetOrderByID(ctx context.Context, id uuid.UUID) (Order, error)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.VoucherCode,
+		&i.ShippingCourier,
+		&i.ShippingService,
+		&i.RefundedAmount,
+		&i.InvoiceNumber,
+		&i.InvoicedAt,
+		&i.TaxPrice,
+		&i.PricesIncludeTax,
 	)
 	return i, err
 }
 
 const getOrderItems = `-- name: GetOrderItems :many
-SELECT id, order_id, product_id, name_snapshot, unit_price, quantity, total_price, created_at, updated_at FROM order_items WHERE order_id = $1
+SELECT id, order_id, product_id, name_snapshot, unit_price, quantity, total_price, created_at, updated_at, tax_rate, tax_amount FROM order_items WHERE order_id = $1
 `
 
 func (q *Queries) GetOrderItems(ctx context.Context, orderID uuid.UUID) ([]OrderItem, error) {
@@ -158,6 +431,8 @@ func (q *Queries) GetOrderItems(ctx context.Context, orderID uuid.UUID) ([]Order
 			&i.TotalPrice,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TaxRate,
+			&i.TaxAmount,
 		); err != nil {
 			return nil, err
 		}
@@ -172,8 +447,46 @@ func (q *Queries) GetOrderItems(ctx context.Context, orderID uuid.UUID) ([]Order
 	return items, nil
 }
 
+const listExpiredPendingOrderIDs = `-- name: ListExpiredPendingOrderIDs :many
+SELECT id FROM orders
+WHERE status = 'PENDING'
+  AND payment_status = 'UNPAID'
+  AND placed_at < $1::timestamp
+ORDER BY placed_at
+LIMIT $2::int
+`
+
+type ListExpiredPendingOrderIDsParams struct {
+	Cutoff    time.Time `json:"cutoff"`
+	BatchSize int32     `json:"batch_size"`
+}
+
+// Order PENDING yang belum dibayar melewati TTL (diproses per batch oleh scheduler)
+func (q *Queries) ListExpiredPendingOrderIDs(ctx context.Context, arg ListExpiredPendingOrderIDsParams) ([]uuid.UUID, error) {
+	rows, err := q.query(ctx, q.listExpiredPendingOrderIDsStmt, listExpiredPendingOrderIDs, arg.Cutoff, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listOrders = `-- name: ListOrders :many
-SELECT o.id, o.order_number, o.user_id, o.status, o.payment_method, o.payment_status, o.address_snapshot, o.subtotal_price, o.discount_price, o.shipping_price, o.total_price, o.note, o.placed_at, o.paid_at, o.cancelled_at, o.cancel_reason, o.completed_at, o.receipt_no, o.snap_token, o.snap_redirect_url, o.created_at, o.updated_at, o.deleted_at, count(*) OVER() AS total_count
+SELECT o.id, o.order_number, o.user_id, o.status, o.payment_method, o.payment_status, o.address_snapshot, o.subtotal_price, o.discount_price, o.shipping_price, o.total_price, o.note, o.placed_at, o.paid_at, o.cancelled_at, o.cancel_reason, o.completed_at, o.receipt_no, o.snap_token, o.snap_redirect_url, o.created_at, o.updated_at, o.deleted_at, o.voucher_code, o.shipping_courier, o.shipping_service, o.refunded_amount, o.invoice_number, o.invoiced_at, o.tax_price, o.prices_include_tax, count(*) OVER() AS total_count
 FROM orders o
 WHERE o.user_id = $3
   AND ($4::text IS NULL OR o.status = $4::text)
@@ -189,30 +502,38 @@ type ListOrdersParams struct {
 }
 
 type ListOrdersRow struct {
-	ID              uuid.UUID       `json:"id"`
-	OrderNumber     string          `json:"order_number"`
-	UserID          uuid.UUID       `json:"user_id"`
-	Status          string          `json:"status"`
-	PaymentMethod   sql.NullString  `json:"payment_method"`
-	PaymentStatus   string          `json:"payment_status"`
-	AddressSnapshot json.RawMessage `json:"address_snapshot"`
-	SubtotalPrice   string          `json:"subtotal_price"`
-	DiscountPrice   string          `json:"discount_price"`
-	ShippingPrice   string          `json:"shipping_price"`
-	TotalPrice      string          `json:"total_price"`
-	Note            sql.NullString  `json:"note"`
-	PlacedAt        time.Time       `json:"placed_at"`
-	PaidAt          sql.NullTime    `json:"paid_at"`
-	CancelledAt     sql.NullTime    `json:"cancelled_at"`
-	CancelReason    sql.NullString  `json:"cancel_reason"`
-	CompletedAt     sql.NullTime    `json:"completed_at"`
-	ReceiptNo       sql.NullString  `json:"receipt_no"`
-	SnapToken       sql.NullString  `json:"snap_token"`
-	SnapRedirectUrl sql.NullString  `json:"snap_redirect_url"`
-	CreatedAt       time.Time       `json:"created_at"`
-	UpdatedAt       time.Time       `json:"updated_at"`
-	DeletedAt       sql.NullTime    `json:"deleted_at"`
-	TotalCount      int64           `json:"total_count"`
+	ID               uuid.UUID       `json:"id"`
+	OrderNumber      string          `json:"order_number"`
+	UserID           uuid.UUID       `json:"user_id"`
+	Status           string          `json:"status"`
+	PaymentMethod    sql.NullString  `json:"payment_method"`
+	PaymentStatus    string          `json:"payment_status"`
+	AddressSnapshot  json.RawMessage `json:"address_snapshot"`
+	SubtotalPrice    string          `json:"subtotal_price"`
+	DiscountPrice    string          `json:"discount_price"`
+	ShippingPrice    string          `json:"shipping_price"`
+	TotalPrice       string          `json:"total_price"`
+	Note             sql.NullString  `json:"note"`
+	PlacedAt         time.Time       `json:"placed_at"`
+	PaidAt           sql.NullTime    `json:"paid_at"`
+	CancelledAt      sql.NullTime    `json:"cancelled_at"`
+	CancelReason     sql.NullString  `json:"cancel_reason"`
+	CompletedAt      sql.NullTime    `json:"completed_at"`
+	ReceiptNo        sql.NullString  `json:"receipt_no"`
+	SnapToken        sql.NullString  `json:"snap_token"`
+	SnapRedirectUrl  sql.NullString  `json:"snap_redirect_url"`
+	CreatedAt        time.Time       `json:"created_at"`
+	UpdatedAt        time.Time       `json:"updated_at"`
+	DeletedAt        sql.NullTime    `json:"deleted_at"`
+	VoucherCode      sql.NullString  `json:"voucher_code"`
+	ShippingCourier  sql.NullString  `json:"shipping_courier"`
+	ShippingService  sql.NullString  `json:"shipping_service"`
+	RefundedAmount   string          `json:"refunded_amount"`
+	InvoiceNumber    sql.NullString  `json:"invoice_number"`
+	InvoicedAt       sql.NullTime    `json:"invoiced_at"`
+	TaxPrice         string          `json:"tax_price"`
+	PricesIncludeTax bool            `json:"prices_include_tax"`
+	TotalCount       int64           `json:"total_count"`
 }
 
 func (q *Queries) ListOrders(ctx context.Context, arg ListOrdersParams) ([]ListOrdersRow, error) {
@@ -253,6 +574,14 @@ func (q *Queries) ListOrders(ctx context.Context, arg ListOrdersParams) ([]ListO
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.VoucherCode,
+			&i.ShippingCourier,
+			&i.ShippingService,
+			&i.RefundedAmount,
+			&i.InvoiceNumber,
+			&i.InvoicedAt,
+			&i.TaxPrice,
+			&i.PricesIncludeTax,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -269,7 +598,7 @@ func (q *Queries) ListOrders(ctx context.Context, arg ListOrdersParams) ([]ListO
 }
 
 const listOrdersAdmin = `-- name: ListOrdersAdmin :many
-SELECT o.id, o.order_number, o.user_id, o.status, o.payment_method, o.payment_status, o.address_snapshot, o.subtotal_price, o.discount_price, o.shipping_price, o.total_price, o.note, o.placed_at, o.paid_at, o.cancelled_at, o.cancel_reason, o.completed_at, o.receipt_no, o.snap_token, o.snap_redirect_url, o.created_at, o.updated_at, o.deleted_at, count(*) OVER() AS total_count
+SELECT o.id, o.order_number, o.user_id, o.status, o.payment_method, o.payment_status, o.address_snapshot, o.subtotal_price, o.discount_price, o.shipping_price, o.total_price, o.note, o.placed_at, o.paid_at, o.cancelled_at, o.cancel_reason, o.completed_at, o.receipt_no, o.snap_token, o.snap_redirect_url, o.created_at, o.updated_at, o.deleted_at, o.voucher_code, o.shipping_courier, o.shipping_service, o.refunded_amount, o.invoice_number, o.invoiced_at, o.tax_price, o.prices_include_tax, count(*) OVER() AS total_count
 FROM orders o
 WHERE ($3::text IS NULL OR o.status = $3::text)
   AND ($4::text IS NULL OR o.order_number ILIKE '%' || $4::text || '%')
@@ -285,30 +614,38 @@ type ListOrdersAdminParams struct {
 }
 
 type ListOrdersAdminRow struct {
-	ID              uuid.UUID       `json:"id"`
-	OrderNumber     string          `json:"order_number"`
-	UserID          uuid.UUID       `json:"user_id"`
-	Status          string          `json:"status"`
-	PaymentMethod   sql.NullString  `json:"payment_method"`
-	PaymentStatus   string          `json:"payment_status"`
-	AddressSnapshot json.RawMessage `json:"address_snapshot"`
-	SubtotalPrice   string          `json:"subtotal_price"`
-	DiscountPrice   string          `json:"discount_price"`
-	ShippingPrice   string          `json:"shipping_price"`
-	TotalPrice      string          `json:"total_price"`
-	Note            sql.NullString  `json:"note"`
-	PlacedAt        time.Time       `json:"placed_at"`
-	PaidAt          sql.NullTime    `json:"paid_at"`
-	CancelledAt     sql.NullTime    `json:"cancelled_at"`
-	CancelReason    sql.NullString  `json:"cancel_reason"`
-	CompletedAt     sql.NullTime    `json:"completed_at"`
-	ReceiptNo       sql.NullString  `json:"receipt_no"`
-	SnapToken       sql.NullString  `json:"snap_token"`
-	SnapRedirectUrl sql.NullString  `json:"snap_redirect_url"`
-	CreatedAt       time.Time       `json:"created_at"`
-	UpdatedAt       time.Time       `json:"updated_at"`
-	DeletedAt       sql.NullTime    `json:"deleted_at"`
-	TotalCount      int64           `json:"total_count"`
+	ID               uuid.UUID       `json:"id"`
+	OrderNumber      string          `json:"order_number"`
+	UserID           uuid.UUID       `json:"user_id"`
+	Status           string          `json:"status"`
+	PaymentMethod    sql.NullString  `json:"payment_method"`
+	PaymentStatus    string          `json:"payment_status"`
+	AddressSnapshot  json.RawMessage `json:"address_snapshot"`
+	SubtotalPrice    string          `json:"subtotal_price"`
+	DiscountPrice    string          `json:"discount_price"`
+	ShippingPrice    string          `json:"shipping_price"`
+	TotalPrice       string          `json:"total_price"`
+	Note             sql.NullString  `json:"note"`
+	PlacedAt         time.Time       `json:"placed_at"`
+	PaidAt           sql.NullTime    `json:"paid_at"`
+	CancelledAt      sql.NullTime    `json:"cancelled_at"`
+	CancelReason     sql.NullString  `json:"cancel_reason"`
+	CompletedAt      sql.NullTime    `json:"completed_at"`
+	ReceiptNo        sql.NullString  `json:"receipt_no"`
+	SnapToken        sql.NullString  `json:"snap_token"`
+	SnapRedirectUrl  sql.NullString  `json:"snap_redirect_url"`
+	CreatedAt        time.Time       `json:"created_at"`
+	UpdatedAt        time.Time       `json:"updated_at"`
+	DeletedAt        sql.NullTime    `json:"deleted_at"`
+	VoucherCode      sql.NullString  `json:"voucher_code"`
+	ShippingCourier  sql.NullString  `json:"shipping_courier"`
+	ShippingService  sql.NullString  `json:"shipping_service"`
+	RefundedAmount   string          `json:"refunded_amount"`
+	InvoiceNumber    sql.NullString  `json:"invoice_number"`
+	InvoicedAt       sql.NullTime    `json:"invoiced_at"`
+	TaxPrice         string          `json:"tax_price"`
+	PricesIncludeTax bool            `json:"prices_include_tax"`
+	TotalCount       int64           `json:"total_count"`
 }
 
 func (q *Queries) ListOrdersAdmin(ctx context.Context, arg ListOrdersAdminParams) ([]ListOrdersAdminRow, error) {
@@ -349,6 +686,14 @@ func (q *Queries) ListOrdersAdmin(ctx context.Context, arg ListOrdersAdminParams
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.VoucherCode,
+			&i.ShippingCourier,
+			&i.ShippingService,
+			&i.RefundedAmount,
+			&i.InvoiceNumber,
+			&i.InvoicedAt,
+			&i.TaxPrice,
+			&i.PricesIncludeTax,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -364,6 +709,240 @@ func (q *Queries) ListOrdersAdmin(ctx context.Context, arg ListOrdersAdminParams
 	return items, nil
 }
 
+const listOrdersAdminKeyset = `-- name: ListOrdersAdminKeyset :many
+SELECT o.id, o.order_number, o.user_id, o.status, o.payment_method, o.payment_status, o.address_snapshot, o.subtotal_price, o.discount_price, o.shipping_price, o.total_price, o.note, o.placed_at, o.paid_at, o.cancelled_at, o.cancel_reason, o.completed_at, o.receipt_no, o.snap_token, o.snap_redirect_url, o.created_at, o.updated_at, o.deleted_at, o.voucher_code, o.shipping_courier, o.shipping_service, o.refunded_amount, o.invoice_number, o.invoiced_at, o.tax_price, o.prices_include_tax
+FROM orders o
+WHERE ($2::text IS NULL OR o.status = $2::text)
+  AND ($3::text IS NULL OR o.order_number ILIKE '%' || $3::text || '%')
+  AND (
+    $4::uuid IS NULL
+    OR ($5::text = 'desc'
+        AND (o.placed_at, o.id) < ($6::timestamp, $4::uuid))
+    OR ($5::text = 'asc'
+        AND (o.placed_at, o.id) > ($6::timestamp, $4::uuid))
+  )
+ORDER BY
+    CASE WHEN $5::text = 'asc' THEN o.placed_at END ASC,
+    CASE WHEN $5::text = 'asc' THEN o.id END ASC,
+    o.placed_at DESC,
+    o.id DESC
+LIMIT $1
+`
+
+type ListOrdersAdminKeysetParams struct {
+	Limit          int32          `json:"limit"`
+	Status         sql.NullString `json:"status"`
+	Search         sql.NullString `json:"search"`
+	CursorID       uuid.NullUUID  `json:"cursor_id"`
+	SortDir        string         `json:"sort_dir"`
+	CursorPlacedAt sql.NullTime   `json:"cursor_placed_at"`
+}
+
+func (q *Queries) ListOrdersAdminKeyset(ctx context.Context, arg ListOrdersAdminKeysetParams) ([]Order, error) {
+	rows, err := q.query(ctx, q.listOrdersAdminKeysetStmt, listOrdersAdminKeyset,
+		arg.Limit,
+		arg.Status,
+		arg.Search,
+		arg.CursorID,
+		arg.SortDir,
+		arg.CursorPlacedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Order
+	for rows.Next() {
+		var i Order
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrderNumber,
+			&i.UserID,
+			&i.Status,
+			&i.PaymentMethod,
+			&i.PaymentStatus,
+			&i.AddressSnapshot,
+			&i.SubtotalPrice,
+			&i.DiscountPrice,
+			&i.ShippingPrice,
+			&i.TotalPrice,
+			&i.Note,
+			&i.PlacedAt,
+			&i.PaidAt,
+			&i.CancelledAt,
+			&i.CancelReason,
+			&i.CompletedAt,
+			&i.ReceiptNo,
+			&i.SnapToken,
+			&i.SnapRedirectUrl,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.VoucherCode,
+			&i.ShippingCourier,
+			&i.ShippingService,
+			&i.RefundedAmount,
+			&i.InvoiceNumber,
+			&i.InvoicedAt,
+			&i.TaxPrice,
+			&i.PricesIncludeTax,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOrdersKeyset = `-- name: ListOrdersKeyset :many
+SELECT o.id, o.order_number, o.user_id, o.status, o.payment_method, o.payment_status, o.address_snapshot, o.subtotal_price, o.discount_price, o.shipping_price, o.total_price, o.note, o.placed_at, o.paid_at, o.cancelled_at, o.cancel_reason, o.completed_at, o.receipt_no, o.snap_token, o.snap_redirect_url, o.created_at, o.updated_at, o.deleted_at, o.voucher_code, o.shipping_courier, o.shipping_service, o.refunded_amount, o.invoice_number, o.invoiced_at, o.tax_price, o.prices_include_tax
+FROM orders o
+WHERE o.user_id = $2
+  AND ($3::text IS NULL OR o.status = $3::text)
+  AND (
+    $4::uuid IS NULL
+    OR ($5::text = 'desc'
+        AND (o.placed_at, o.id) < ($6::timestamp, $4::uuid))
+    OR ($5::text = 'asc'
+        AND (o.placed_at, o.id) > ($6::timestamp, $4::uuid))
+  )
+ORDER BY
+    CASE WHEN $5::text = 'asc' THEN o.placed_at END ASC,
+    CASE WHEN $5::text = 'asc' THEN o.id END ASC,
+    o.placed_at DESC,
+    o.id DESC
+LIMIT $1
+`
+
+type ListOrdersKeysetParams struct {
+	Limit          int32          `json:"limit"`
+	UserID         uuid.UUID      `json:"user_id"`
+	Status         sql.NullString `json:"status"`
+	CursorID       uuid.NullUUID  `json:"cursor_id"`
+	SortDir        string         `json:"sort_dir"`
+	CursorPlacedAt sql.NullTime   `json:"cursor_placed_at"`
+}
+
+func (q *Queries) ListOrdersKeyset(ctx context.Context, arg ListOrdersKeysetParams) ([]Order, error) {
+	rows, err := q.query(ctx, q.listOrdersKeysetStmt, listOrdersKeyset,
+		arg.Limit,
+		arg.UserID,
+		arg.Status,
+		arg.CursorID,
+		arg.SortDir,
+		arg.CursorPlacedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Order
+	for rows.Next() {
+		var i Order
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrderNumber,
+			&i.UserID,
+			&i.Status,
+			&i.PaymentMethod,
+			&i.PaymentStatus,
+			&i.AddressSnapshot,
+			&i.SubtotalPrice,
+			&i.DiscountPrice,
+			&i.ShippingPrice,
+			&i.TotalPrice,
+			&i.Note,
+			&i.PlacedAt,
+			&i.PaidAt,
+			&i.CancelledAt,
+			&i.CancelReason,
+			&i.CompletedAt,
+			&i.ReceiptNo,
+			&i.SnapToken,
+			&i.SnapRedirectUrl,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.VoucherCode,
+			&i.ShippingCourier,
+			&i.ShippingService,
+			&i.RefundedAmount,
+			&i.InvoiceNumber,
+			&i.InvoicedAt,
+			&i.TaxPrice,
+			&i.PricesIncludeTax,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setOrderReceiptNo = `-- name: SetOrderReceiptNo :one
+UPDATE orders
+SET receipt_no = $2,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING id, order_number, user_id, status, payment_method, payment_status, address_snapshot, subtotal_price, discount_price, shipping_price, total_price, note, placed_at, paid_at, cancelled_at, cancel_reason, completed_at, receipt_no, snap_token, snap_redirect_url, created_at, updated_at, deleted_at, voucher_code, shipping_courier, shipping_service, refunded_amount, invoice_number, invoiced_at, tax_price, prices_include_tax
+`
+
+type SetOrderReceiptNoParams struct {
+	ID        uuid.UUID      `json:"id"`
+	ReceiptNo sql.NullString `json:"receipt_no"`
+}
+
+func (q *Queries) SetOrderReceiptNo(ctx context.Context, arg SetOrderReceiptNoParams) (Order, error) {
+	row := q.queryRow(ctx, q.setOrderReceiptNoStmt, setOrderReceiptNo, arg.ID, arg.ReceiptNo)
+	var i Order
+	err := row.Scan(
+		&i.ID,
+		&i.OrderNumber,
+		&i.UserID,
+		&i.Status,
+		&i.PaymentMethod,
+		&i.PaymentStatus,
+		&i.AddressSnapshot,
+		&i.SubtotalPrice,
+		&i.DiscountPrice,
+		&i.ShippingPrice,
+		&i.TotalPrice,
+		&i.Note,
+		&i.PlacedAt,
+		&i.PaidAt,
+		&i.CancelledAt,
+		&i.CancelReason,
+		&i.CompletedAt,
+		&i.ReceiptNo,
+		&i.SnapToken,
+		&i.SnapRedirectUrl,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.VoucherCode,
+		&i.ShippingCourier,
+		&i.ShippingService,
+		&i.RefundedAmount,
+		&i.InvoiceNumber,
+		&i.InvoicedAt,
+		&i.TaxPrice,
+		&i.PricesIncludeTax,
+	)
+	return i, err
+}
+
 const updateOrderStatus = `-- name: UpdateOrderStatus :one
 UPDATE orders 
 SET status = $2, 
@@ -371,7 +950,7 @@ SET status = $2,
     completed_at = CASE WHEN $2 = 'COMPLETED' THEN NOW() ELSE completed_at END,
     cancelled_at = CASE WHEN $2 = 'CANCELLED' THEN NOW() ELSE cancelled_at END
 WHERE id = $1
-RETURNING id, order_number, user_id, status, payment_method, payment_status, address_snapshot, subtotal_price, discount_price, shipping_price, total_price, note, placed_at, paid_at, cancelled_at, cancel_reason, completed_at, receipt_no, snap_token, snap_redirect_url, created_at, updated_at, deleted_at
+RETURNING id, order_number, user_id, status, payment_method, payment_status, address_snapshot, subtotal_price, discount_price, shipping_price, total_price, note, placed_at, paid_at, cancelled_at, cancel_reason, completed_at, receipt_no, snap_token, snap_redirect_url, created_at, updated_at, deleted_at, voucher_code, shipping_courier, shipping_service, refunded_amount, invoice_number, invoiced_at, tax_price, prices_include_tax
 `
 
 type UpdateOrderStatusParams struct {
@@ -406,6 +985,14 @@ func (q *Queries) UpdateOrderStatus(ctx context.Context, arg UpdateOrderStatusPa
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.VoucherCode,
+		&i.ShippingCourier,
+		&i.ShippingService,
+		&i.RefundedAmount,
+		&i.InvoiceNumber,
+		&i.InvoicedAt,
+		&i.TaxPrice,
+		&i.PricesIncludeTax,
 	)
 	return i, err
 }
@@ -11,25 +11,33 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 const createProduct = `-- name: CreateProduct :one
-INSERT INTO products (category_id, name, slug, description, price, stock, sku, image_url)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
-RETURNING id, category_id, name, slug, description, price, stock, sku, image_url, is_active, created_at, updated_at, deleted_at
+INSERT INTO products (category_id, name, slug, description, price, sku, image_url, brand_id, meta_title, meta_description, published_at, unpublished_at, weight_grams, tax_class)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+RETURNING id, category_id, name, slug, description, price, stock, sku, image_url, is_active, created_at, updated_at, deleted_at, brand_id, search_vector, meta_title, meta_description, low_stock_threshold, published_at, unpublished_at, weight_grams, tax_class, rating_avg, rating_count, rating_1_count, rating_2_count, rating_3_count, rating_4_count, rating_5_count
 `
 
 type CreateProductParams struct {
-	CategoryID  uuid.UUID      `json:"category_id"`
-	Name        string         `json:"name"`
-	Slug        string         `json:"slug"`
-	Description sql.NullString `json:"description"`
-	Price       string         `json:"price"`
-	Stock       int32          `json:"stock"`
-	Sku         sql.NullString `json:"sku"`
-	ImageUrl    sql.NullString `json:"image_url"`
+	CategoryID      uuid.UUID      `json:"category_id"`
+	Name            string         `json:"name"`
+	Slug            string         `json:"slug"`
+	Description     sql.NullString `json:"description"`
+	Price           string         `json:"price"`
+	Sku             sql.NullString `json:"sku"`
+	ImageUrl        sql.NullString `json:"image_url"`
+	BrandID         uuid.NullUUID  `json:"brand_id"`
+	MetaTitle       sql.NullString `json:"meta_title"`
+	MetaDescription sql.NullString `json:"meta_description"`
+	PublishedAt     sql.NullTime   `json:"published_at"`
+	UnpublishedAt   sql.NullTime   `json:"unpublished_at"`
+	WeightGrams     int32          `json:"weight_grams"`
+	TaxClass        string         `json:"tax_class"`
 }
 
+// Stok awal selalu 0; stok masuk dicatat lewat ledger inventory
 func (q *Queries) CreateProduct(ctx context.Context, arg CreateProductParams) (Product, error) {
 	row := q.queryRow(ctx, q.createProductStmt, createProduct,
 		arg.CategoryID,
@@ -37,9 +45,15 @@ func (q *Queries) CreateProduct(ctx context.Context, arg CreateProductParams) (P
 		arg.Slug,
 		arg.Description,
 		arg.Price,
-		arg.Stock,
 		arg.Sku,
 		arg.ImageUrl,
+		arg.BrandID,
+		arg.MetaTitle,
+		arg.MetaDescription,
+		arg.PublishedAt,
+		arg.UnpublishedAt,
+		arg.WeightGrams,
+		arg.TaxClass,
 	)
 	var i Product
 	err := row.Scan(
@@ -56,32 +70,147 @@ func (q *Queries) CreateProduct(ctx context.Context, arg CreateProductParams) (P
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.BrandID,
+		&i.SearchVector,
+		&i.MetaTitle,
+		&i.MetaDescription,
+		&i.LowStockThreshold,
+		&i.PublishedAt,
+		&i.UnpublishedAt,
+		&i.WeightGrams,
+		&i.TaxClass,
+		&i.RatingAvg,
+		&i.RatingCount,
+		&i.Rating1Count,
+		&i.Rating2Count,
+		&i.Rating3Count,
+		&i.Rating4Count,
+		&i.Rating5Count,
 	)
 	return i, err
 }
 
-const getProductByID = `-- name: GetProductByID :one
-SELECT p.id, p.category_id, p.name, p.slug, p.description, p.price, p.stock, p.sku, p.image_url, p.is_active, p.created_at, p.updated_at, p.deleted_at, c.name as category_name 
+const exportProducts = `-- name: ExportProducts :many
+SELECT
+    p.id,
+    p.sku,
+    p.name,
+    p.description,
+    p.price,
+    p.stock,
+    c.slug AS category_slug,
+    b.slug AS brand_slug,
+    p.is_active
 FROM products p
 JOIN categories c ON p.category_id = c.id
-WHERE p.id = $1 AND p.deleted_at IS NULL LIMIT 1
+LEFT JOIN brands b ON p.brand_id = b.id
+WHERE p.deleted_at IS NULL
+    AND ($3::uuid IS NULL OR p.category_id = $3::uuid)
+    AND (
+        $4::text IS NULL
+        OR p.name ILIKE '%' || $4::text || '%'
+        OR p.sku  ILIKE '%' || $4::text || '%'
+    )
+ORDER BY p.created_at DESC, p.id DESC
+LIMIT $1 OFFSET $2
 `
 
-type GetProductByIDRow struct {
+type ExportProductsParams struct {
+	Limit      int32          `json:"limit"`
+	Offset     int32          `json:"offset"`
+	CategoryID uuid.NullUUID  `json:"category_id"`
+	Search     sql.NullString `json:"search"`
+}
+
+type ExportProductsRow struct {
 	ID           uuid.UUID      `json:"id"`
-	CategoryID   uuid.UUID      `json:"category_id"`
+	Sku          sql.NullString `json:"sku"`
 	Name         string         `json:"name"`
-	Slug         string         `json:"slug"`
 	Description  sql.NullString `json:"description"`
 	Price        string         `json:"price"`
 	Stock        int32          `json:"stock"`
-	Sku          sql.NullString `json:"sku"`
-	ImageUrl     sql.NullString `json:"image_url"`
+	CategorySlug string         `json:"category_slug"`
+	BrandSlug    sql.NullString `json:"brand_slug"`
 	IsActive     sql.NullBool   `json:"is_active"`
-	CreatedAt    time.Time      `json:"created_at"`
-	UpdatedAt    time.Time      `json:"updated_at"`
-	DeletedAt    sql.NullTime   `json:"deleted_at"`
-	CategoryName string         `json:"category_name"`
+}
+
+// Kolom sama dengan format import agar file bisa di-roundtrip
+func (q *Queries) ExportProducts(ctx context.Context, arg ExportProductsParams) ([]ExportProductsRow, error) {
+	rows, err := q.query(ctx, q.exportProductsStmt, exportProducts,
+		arg.Limit,
+		arg.Offset,
+		arg.CategoryID,
+		arg.Search,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ExportProductsRow
+	for rows.Next() {
+		var i ExportProductsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Sku,
+			&i.Name,
+			&i.Description,
+			&i.Price,
+			&i.Stock,
+			&i.CategorySlug,
+			&i.BrandSlug,
+			&i.IsActive,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getProductByID = `-- name: GetProductByID :one
+SELECT p.id, p.category_id, p.name, p.slug, p.description, p.price, p.stock, p.sku, p.image_url, p.is_active, p.created_at, p.updated_at, p.deleted_at, p.brand_id, p.search_vector, p.meta_title, p.meta_description, p.low_stock_threshold, p.published_at, p.unpublished_at, p.weight_grams, p.tax_class, p.rating_avg, p.rating_count, p.rating_1_count, p.rating_2_count, p.rating_3_count, p.rating_4_count, p.rating_5_count, c.name as category_name 
+FROM products p
+JOIN categories c ON p.category_id = c.id
+WHERE p.id = $1 AND p.deleted_at IS NULL LIMIT 1
+`
+
+type GetProductByIDRow struct {
+	ID                uuid.UUID      `json:"id"`
+	CategoryID        uuid.UUID      `json:"category_id"`
+	Name              string         `json:"name"`
+	Slug              string         `json:"slug"`
+	Description       sql.NullString `json:"description"`
+	Price             string         `json:"price"`
+	Stock             int32          `json:"stock"`
+	Sku               sql.NullString `json:"sku"`
+	ImageUrl          sql.NullString `json:"image_url"`
+	IsActive          sql.NullBool   `json:"is_active"`
+	CreatedAt         time.Time      `json:"created_at"`
+	UpdatedAt         time.Time      `json:"updated_at"`
+	DeletedAt         sql.NullTime   `json:"deleted_at"`
+	BrandID           uuid.NullUUID  `json:"brand_id"`
+	SearchVector      interface{}    `json:"search_vector"`
+	MetaTitle         sql.NullString `json:"meta_title"`
+	MetaDescription   sql.NullString `json:"meta_description"`
+	LowStockThreshold int32          `json:"low_stock_threshold"`
+	PublishedAt       sql.NullTime   `json:"published_at"`
+	UnpublishedAt     sql.NullTime   `json:"unpublished_at"`
+	WeightGrams       int32          `json:"weight_grams"`
+	TaxClass          string         `json:"tax_class"`
+	RatingAvg         float64        `json:"rating_avg"`
+	RatingCount       int32          `json:"rating_count"`
+	Rating1Count      int32          `json:"rating_1_count"`
+	Rating2Count      int32          `json:"rating_2_count"`
+	Rating3Count      int32          `json:"rating_3_count"`
+	Rating4Count      int32          `json:"rating_4_count"`
+	Rating5Count      int32          `json:"rating_5_count"`
+	CategoryName      string         `json:"category_name"`
 }
 
 func (q *Queries) GetProductByID(ctx context.Context, id uuid.UUID) (GetProductByIDRow, error) {
@@ -101,35 +230,90 @@ func (q *Queries) GetProductByID(ctx context.Context, id uuid.UUID) (GetProductB
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.BrandID,
+		&i.SearchVector,
+		&i.MetaTitle,
+		&i.MetaDescription,
+		&i.LowStockThreshold,
+		&i.PublishedAt,
+		&i.UnpublishedAt,
+		&i.WeightGrams,
+		&i.TaxClass,
+		&i.RatingAvg,
+		&i.RatingCount,
+		&i.Rating1Count,
+		&i.Rating2Count,
+		&i.Rating3Count,
+		&i.Rating4Count,
+		&i.Rating5Count,
 		&i.CategoryName,
 	)
 	return i, err
 }
 
 const getProductBySlug = `-- name: GetProductBySlug :one
-SELECT p.id, p.category_id, p.name, p.slug, p.description, p.price, p.stock, p.sku, p.image_url, p.is_active, p.created_at, p.updated_at, p.deleted_at, c.name as category_name 
+SELECT
+    p.id, p.category_id, p.name, p.slug, p.description, p.price, p.stock, p.sku, p.image_url, p.is_active, p.created_at, p.updated_at, p.deleted_at, p.brand_id, p.search_vector, p.meta_title, p.meta_description, p.low_stock_threshold, p.published_at, p.unpublished_at, p.weight_grams, p.tax_class, p.rating_avg, p.rating_count, p.rating_1_count, p.rating_2_count, p.rating_3_count, p.rating_4_count, p.rating_5_count,
+    c.name as category_name,
+    b.name as brand_name,
+    COALESCE(ap.price, p.price)::decimal AS effective_price,
+    ap.compare_at_price
 FROM products p
 JOIN categories c ON p.category_id = c.id
-WHERE p.slug = $1 AND p.deleted_at IS NULL LIMIT 1
+LEFT JOIN brands b ON p.brand_id = b.id
+LEFT JOIN LATERAL (
+    -- Jadwal harga aktif; jika overlap, yang mulai paling akhir menang
+    SELECT pp.price, pp.compare_at_price
+    FROM product_prices pp
+    WHERE pp.product_id = p.id
+      AND pp.starts_at <= NOW()
+      AND (pp.ends_at IS NULL OR pp.ends_at > NOW())
+    ORDER BY pp.starts_at DESC
+    LIMIT 1
+) ap ON true
+WHERE p.slug = $1 AND p.deleted_at IS NULL
+  AND (p.published_at IS NULL OR p.published_at <= NOW())
+  AND (p.unpublished_at IS NULL OR p.unpublished_at > NOW())
+LIMIT 1
 `
 
 type GetProductBySlugRow struct {
-	ID           uuid.UUID      `json:"id"`
-	CategoryID   uuid.UUID      `json:"category_id"`
-	Name         string         `json:"name"`
-	Slug         string         `json:"slug"`
-	Description  sql.NullString `json:"description"`
-	Price        string         `json:"price"`
-	Stock        int32          `json:"stock"`
-	Sku          sql.NullString `json:"sku"`
-	ImageUrl     sql.NullString `json:"image_url"`
-	IsActive     sql.NullBool   `json:"is_active"`
-	CreatedAt    time.Time      `json:"created_at"`
-	UpdatedAt    time.Time      `json:"updated_at"`
-	DeletedAt    sql.NullTime   `json:"deleted_at"`
-	CategoryName string         `json:"category_name"`
+	ID                uuid.UUID      `json:"id"`
+	CategoryID        uuid.UUID      `json:"category_id"`
+	Name              string         `json:"name"`
+	Slug              string         `json:"slug"`
+	Description       sql.NullString `json:"description"`
+	Price             string         `json:"price"`
+	Stock             int32          `json:"stock"`
+	Sku               sql.NullString `json:"sku"`
+	ImageUrl          sql.NullString `json:"image_url"`
+	IsActive          sql.NullBool   `json:"is_active"`
+	CreatedAt         time.Time      `json:"created_at"`
+	UpdatedAt         time.Time      `json:"updated_at"`
+	DeletedAt         sql.NullTime   `json:"deleted_at"`
+	BrandID           uuid.NullUUID  `json:"brand_id"`
+	SearchVector      interface{}    `json:"search_vector"`
+	MetaTitle         sql.NullString `json:"meta_title"`
+	MetaDescription   sql.NullString `json:"meta_description"`
+	LowStockThreshold int32          `json:"low_stock_threshold"`
+	PublishedAt       sql.NullTime   `json:"published_at"`
+	UnpublishedAt     sql.NullTime   `json:"unpublished_at"`
+	WeightGrams       int32          `json:"weight_grams"`
+	TaxClass          string         `json:"tax_class"`
+	RatingAvg         float64        `json:"rating_avg"`
+	RatingCount       int32          `json:"rating_count"`
+	Rating1Count      int32          `json:"rating_1_count"`
+	Rating2Count      int32          `json:"rating_2_count"`
+	Rating3Count      int32          `json:"rating_3_count"`
+	Rating4Count      int32          `json:"rating_4_count"`
+	Rating5Count      int32          `json:"rating_5_count"`
+	CategoryName      string         `json:"category_name"`
+	BrandName         sql.NullString `json:"brand_name"`
+	EffectivePrice    string         `json:"effective_price"`
+	CompareAtPrice    sql.NullString `json:"compare_at_price"`
 }
 
+// Publik: hanya produk dalam jendela tayang
 func (q *Queries) GetProductBySlug(ctx context.Context, slug string) (GetProductBySlugRow, error) {
 	row := q.queryRow(ctx, q.getProductBySlugStmt, getProductBySlug, slug)
 	var i GetProductBySlugRow
@@ -147,14 +331,79 @@ func (q *Queries) GetProductBySlug(ctx context.Context, slug string) (GetProduct
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.BrandID,
+		&i.SearchVector,
+		&i.MetaTitle,
+		&i.MetaDescription,
+		&i.LowStockThreshold,
+		&i.PublishedAt,
+		&i.UnpublishedAt,
+		&i.WeightGrams,
+		&i.TaxClass,
+		&i.RatingAvg,
+		&i.RatingCount,
+		&i.Rating1Count,
+		&i.Rating2Count,
+		&i.Rating3Count,
+		&i.Rating4Count,
+		&i.Rating5Count,
 		&i.CategoryName,
+		&i.BrandName,
+		&i.EffectivePrice,
+		&i.CompareAtPrice,
 	)
 	return i, err
 }
 
+const getProductSlugRedirect = `-- name: GetProductSlugRedirect :one
+SELECT p.slug
+FROM slug_redirects r
+JOIN products p ON p.id = r.entity_id
+WHERE r.entity_type = 'product' AND r.old_slug = $1 AND p.deleted_at IS NULL
+LIMIT 1
+`
+
+// Mengembalikan slug terbaru untuk slug lama (rantai rename langsung ke slug terakhir)
+func (q *Queries) GetProductSlugRedirect(ctx context.Context, oldSlug string) (string, error) {
+	row := q.queryRow(ctx, q.getProductSlugRedirectStmt, getProductSlugRedirect, oldSlug)
+	var slug string
+	err := row.Scan(&slug)
+	return slug, err
+}
+
+const listProductSKUsExisting = `-- name: ListProductSKUsExisting :many
+SELECT sku::text AS sku
+FROM products
+WHERE sku = ANY($1::text[])
+`
+
+// SKU yang sudah ada (untuk laporan dry-run: create vs update)
+func (q *Queries) ListProductSKUsExisting(ctx context.Context, skus []string) ([]string, error) {
+	rows, err := q.query(ctx, q.listProductSKUsExistingStmt, listProductSKUsExisting, pq.Array(skus))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var sku string
+		if err := rows.Scan(&sku); err != nil {
+			return nil, err
+		}
+		items = append(items, sku)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listProductsAdmin = `-- name: ListProductsAdmin :many
 SELECT
-    p.id, p.category_id, p.name, p.slug, p.description, p.price, p.stock, p.sku, p.image_url, p.is_active, p.created_at, p.updated_at, p.deleted_at,
+    p.id, p.category_id, p.name, p.slug, p.description, p.price, p.stock, p.sku, p.image_url, p.is_active, p.created_at, p.updated_at, p.deleted_at, p.brand_id, p.search_vector, p.meta_title, p.meta_description, p.low_stock_threshold, p.published_at, p.unpublished_at, p.weight_grams, p.tax_class, p.rating_avg, p.rating_count, p.rating_1_count, p.rating_2_count, p.rating_3_count, p.rating_4_count, p.rating_5_count,
     c.name AS category_name,
     COUNT(*) OVER() AS total_count
 FROM products p
@@ -212,21 +461,37 @@ type ListProductsAdminParams struct {
 }
 
 type ListProductsAdminRow struct {
-	ID           uuid.UUID      `json:"id"`
-	CategoryID   uuid.UUID      `json:"category_id"`
-	Name         string         `json:"name"`
-	Slug         string         `json:"slug"`
-	Description  sql.NullString `json:"description"`
-	Price        string         `json:"price"`
-	Stock        int32          `json:"stock"`
-	Sku          sql.NullString `json:"sku"`
-	ImageUrl     sql.NullString `json:"image_url"`
-	IsActive     sql.NullBool   `json:"is_active"`
-	CreatedAt    time.Time      `json:"created_at"`
-	UpdatedAt    time.Time      `json:"updated_at"`
-	DeletedAt    sql.NullTime   `json:"deleted_at"`
-	CategoryName string         `json:"category_name"`
-	TotalCount   int64          `json:"total_count"`
+	ID                uuid.UUID      `json:"id"`
+	CategoryID        uuid.UUID      `json:"category_id"`
+	Name              string         `json:"name"`
+	Slug              string         `json:"slug"`
+	Description       sql.NullString `json:"description"`
+	Price             string         `json:"price"`
+	Stock             int32          `json:"stock"`
+	Sku               sql.NullString `json:"sku"`
+	ImageUrl          sql.NullString `json:"image_url"`
+	IsActive          sql.NullBool   `json:"is_active"`
+	CreatedAt         time.Time      `json:"created_at"`
+	UpdatedAt         time.Time      `json:"updated_at"`
+	DeletedAt         sql.NullTime   `json:"deleted_at"`
+	BrandID           uuid.NullUUID  `json:"brand_id"`
+	SearchVector      interface{}    `json:"search_vector"`
+	MetaTitle         sql.NullString `json:"meta_title"`
+	MetaDescription   sql.NullString `json:"meta_description"`
+	LowStockThreshold int32          `json:"low_stock_threshold"`
+	PublishedAt       sql.NullTime   `json:"published_at"`
+	UnpublishedAt     sql.NullTime   `json:"unpublished_at"`
+	WeightGrams       int32          `json:"weight_grams"`
+	TaxClass          string         `json:"tax_class"`
+	RatingAvg         float64        `json:"rating_avg"`
+	RatingCount       int32          `json:"rating_count"`
+	Rating1Count      int32          `json:"rating_1_count"`
+	Rating2Count      int32          `json:"rating_2_count"`
+	Rating3Count      int32          `json:"rating_3_count"`
+	Rating4Count      int32          `json:"rating_4_count"`
+	Rating5Count      int32          `json:"rating_5_count"`
+	CategoryName      string         `json:"category_name"`
+	TotalCount        int64          `json:"total_count"`
 }
 
 func (q *Queries) ListProductsAdmin(ctx context.Context, arg ListProductsAdminParams) ([]ListProductsAdminRow, error) {
@@ -259,6 +524,22 @@ func (q *Queries) ListProductsAdmin(ctx context.Context, arg ListProductsAdminPa
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.BrandID,
+			&i.SearchVector,
+			&i.MetaTitle,
+			&i.MetaDescription,
+			&i.LowStockThreshold,
+			&i.PublishedAt,
+			&i.UnpublishedAt,
+			&i.WeightGrams,
+			&i.TaxClass,
+			&i.RatingAvg,
+			&i.RatingCount,
+			&i.Rating1Count,
+			&i.Rating2Count,
+			&i.Rating3Count,
+			&i.Rating4Count,
+			&i.Rating5Count,
 			&i.CategoryName,
 			&i.TotalCount,
 		); err != nil {
@@ -275,62 +556,276 @@ func (q *Queries) ListProductsAdmin(ctx context.Context, arg ListProductsAdminPa
 	return items, nil
 }
 
+const listProductsAdminKeyset = `-- name: ListProductsAdminKeyset :many
+SELECT p.id, p.category_id, p.name, p.slug, p.description, p.price, p.stock, p.sku, p.image_url, p.is_active, p.created_at, p.updated_at, p.deleted_at, p.brand_id, p.search_vector, p.meta_title, p.meta_description, p.low_stock_threshold, p.published_at, p.unpublished_at, p.weight_grams, p.tax_class, p.rating_avg, p.rating_count, p.rating_1_count, p.rating_2_count, p.rating_3_count, p.rating_4_count, p.rating_5_count, c.name AS category_name
+FROM products p
+JOIN categories c ON p.category_id = c.id
+WHERE
+    ($2::uuid IS NULL OR p.category_id = $2::uuid)
+    AND (
+        $3::text IS NULL
+        OR p.name ILIKE '%' || $3::text || '%'
+        OR p.sku  ILIKE '%' || $3::text || '%'
+    )
+    AND (
+        $4::uuid IS NULL
+        OR ($5::text = 'name' AND $6::text = 'asc'
+            AND (p.name, p.id) > ($7::text, $4::uuid))
+        OR ($5::text = 'name' AND $6::text = 'desc'
+            AND (p.name, p.id) < ($7::text, $4::uuid))
+        OR ($5::text = 'stock' AND $6::text = 'asc'
+            AND (p.stock, p.id) > ($8::int, $4::uuid))
+        OR ($5::text = 'stock' AND $6::text = 'desc'
+            AND (p.stock, p.id) < ($8::int, $4::uuid))
+        OR ($5::text = 'price' AND $6::text = 'asc'
+            AND (p.price, p.id) > ($9::decimal, $4::uuid))
+        OR ($5::text = 'price' AND $6::text = 'desc'
+            AND (p.price, p.id) < ($9::decimal, $4::uuid))
+        OR ($5::text = 'created_at' AND $6::text = 'asc'
+            AND (p.created_at, p.id) > ($10::timestamp, $4::uuid))
+        OR ($5::text = 'created_at' AND $6::text = 'desc'
+            AND (p.created_at, p.id) < ($10::timestamp, $4::uuid))
+    )
+ORDER BY
+    CASE WHEN $5::text = 'name' AND $6::text = 'asc' THEN p.name END ASC,
+    CASE WHEN $5::text = 'name' AND $6::text = 'desc' THEN p.name END DESC,
+    CASE WHEN $5::text = 'stock' AND $6::text = 'asc' THEN p.stock END ASC,
+    CASE WHEN $5::text = 'stock' AND $6::text = 'desc' THEN p.stock END DESC,
+    CASE WHEN $5::text = 'price' AND $6::text = 'asc' THEN p.price END ASC,
+    CASE WHEN $5::text = 'price' AND $6::text = 'desc' THEN p.price END DESC,
+    CASE WHEN $5::text = 'created_at' AND $6::text = 'asc' THEN p.created_at END ASC,
+    CASE WHEN $5::text = 'created_at' AND $6::text = 'desc' THEN p.created_at END DESC,
+    CASE WHEN $6::text = 'asc' THEN p.id END ASC,
+    p.id DESC
+LIMIT $1
+`
+
+type ListProductsAdminKeysetParams struct {
+	Limit           int32          `json:"limit"`
+	CategoryID      uuid.NullUUID  `json:"category_id"`
+	Search          sql.NullString `json:"search"`
+	CursorID        uuid.NullUUID  `json:"cursor_id"`
+	SortCol         string         `json:"sort_col"`
+	SortDir         string         `json:"sort_dir"`
+	CursorName      sql.NullString `json:"cursor_name"`
+	CursorStock     sql.NullInt32  `json:"cursor_stock"`
+	CursorPrice     sql.NullString `json:"cursor_price"`
+	CursorCreatedAt sql.NullTime   `json:"cursor_created_at"`
+}
+
+type ListProductsAdminKeysetRow struct {
+	ID                uuid.UUID      `json:"id"`
+	CategoryID        uuid.UUID      `json:"category_id"`
+	Name              string         `json:"name"`
+	Slug              string         `json:"slug"`
+	Description       sql.NullString `json:"description"`
+	Price             string         `json:"price"`
+	Stock             int32          `json:"stock"`
+	Sku               sql.NullString `json:"sku"`
+	ImageUrl          sql.NullString `json:"image_url"`
+	IsActive          sql.NullBool   `json:"is_active"`
+	CreatedAt         time.Time      `json:"created_at"`
+	UpdatedAt         time.Time      `json:"updated_at"`
+	DeletedAt         sql.NullTime   `json:"deleted_at"`
+	BrandID           uuid.NullUUID  `json:"brand_id"`
+	SearchVector      interface{}    `json:"search_vector"`
+	MetaTitle         sql.NullString `json:"meta_title"`
+	MetaDescription   sql.NullString `json:"meta_description"`
+	LowStockThreshold int32          `json:"low_stock_threshold"`
+	PublishedAt       sql.NullTime   `json:"published_at"`
+	UnpublishedAt     sql.NullTime   `json:"unpublished_at"`
+	WeightGrams       int32          `json:"weight_grams"`
+	TaxClass          string         `json:"tax_class"`
+	RatingAvg         float64        `json:"rating_avg"`
+	RatingCount       int32          `json:"rating_count"`
+	Rating1Count      int32          `json:"rating_1_count"`
+	Rating2Count      int32          `json:"rating_2_count"`
+	Rating3Count      int32          `json:"rating_3_count"`
+	Rating4Count      int32          `json:"rating_4_count"`
+	Rating5Count      int32          `json:"rating_5_count"`
+	CategoryName      string         `json:"category_name"`
+}
+
+func (q *Queries) ListProductsAdminKeyset(ctx context.Context, arg ListProductsAdminKeysetParams) ([]ListProductsAdminKeysetRow, error) {
+	rows, err := q.query(ctx, q.listProductsAdminKeysetStmt, listProductsAdminKeyset,
+		arg.Limit,
+		arg.CategoryID,
+		arg.Search,
+		arg.CursorID,
+		arg.SortCol,
+		arg.SortDir,
+		arg.CursorName,
+		arg.CursorStock,
+		arg.CursorPrice,
+		arg.CursorCreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListProductsAdminKeysetRow
+	for rows.Next() {
+		var i ListProductsAdminKeysetRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CategoryID,
+			&i.Name,
+			&i.Slug,
+			&i.Description,
+			&i.Price,
+			&i.Stock,
+			&i.Sku,
+			&i.ImageUrl,
+			&i.IsActive,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.BrandID,
+			&i.SearchVector,
+			&i.MetaTitle,
+			&i.MetaDescription,
+			&i.LowStockThreshold,
+			&i.PublishedAt,
+			&i.UnpublishedAt,
+			&i.WeightGrams,
+			&i.TaxClass,
+			&i.RatingAvg,
+			&i.RatingCount,
+			&i.Rating1Count,
+			&i.Rating2Count,
+			&i.Rating3Count,
+			&i.Rating4Count,
+			&i.Rating5Count,
+			&i.CategoryName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listProductsPublic = `-- name: ListProductsPublic :many
-SELECT p.id, p.category_id, p.name, p.slug, p.description, p.price, p.stock, p.sku, p.image_url, p.is_active, p.created_at, p.updated_at, p.deleted_at, c.name as category_name, count(*) OVER() AS total_count
+WITH RECURSIVE category_tree AS (
+    SELECT c.id FROM categories c WHERE c.id = $3::uuid
+    UNION
+    SELECT ch.id FROM categories ch
+    JOIN category_tree t ON ch.parent_id = t.id
+    WHERE ch.deleted_at IS NULL
+)
+SELECT
+    p.id, p.category_id, p.name, p.slug, p.description, p.price, p.stock, p.sku, p.image_url, p.is_active, p.created_at, p.updated_at, p.deleted_at, p.brand_id, p.search_vector, p.meta_title, p.meta_description, p.low_stock_threshold, p.published_at, p.unpublished_at, p.weight_grams, p.tax_class, p.rating_avg, p.rating_count, p.rating_1_count, p.rating_2_count, p.rating_3_count, p.rating_4_count, p.rating_5_count,
+    c.name as category_name,
+    COALESCE(ap.price, p.price)::decimal AS effective_price,
+    ap.compare_at_price,
+    count(*) OVER() AS total_count
 FROM products p
 JOIN categories c ON p.category_id = c.id
+LEFT JOIN LATERAL (
+    -- Jadwal harga aktif; jika overlap, yang mulai paling akhir menang
+    SELECT pp.price, pp.compare_at_price
+    FROM product_prices pp
+    WHERE pp.product_id = p.id
+      AND pp.starts_at <= NOW()
+      AND (pp.ends_at IS NULL OR pp.ends_at > NOW())
+    ORDER BY pp.starts_at DESC
+    LIMIT 1
+) ap ON true
 WHERE p.deleted_at IS NULL 
   AND p.is_active = true
+  AND (p.published_at IS NULL OR p.published_at <= NOW())
+  AND (p.unpublished_at IS NULL OR p.unpublished_at > NOW())
   -- Gunakan sintaks ini agar sqlc membuat field CategoryID (NullUUID)
-  AND ($3::uuid IS NULL OR p.category_id = $3::uuid)
-  AND ($4::text IS NULL OR p.name ILIKE '%' || $4::text || '%')
-  AND (p.price >= $5::decimal)
-  AND (p.price <= $6::decimal)
+  AND ($3::uuid IS NULL OR p.category_id IN (SELECT id FROM category_tree))
+  -- Full-text (prefix) match, fallback ke trigram similarity untuk typo
+  AND (
+    $4::text IS NULL
+    OR p.search_vector @@ to_tsquery('simple', $5::text)
+    OR p.name % $4::text
+  )
+  AND (COALESCE(ap.price, p.price) >= $6::decimal)
+  AND (COALESCE(ap.price, p.price) <= $7::decimal)
+  AND p.rating_avg >= $8::float8
 ORDER BY 
-    CASE WHEN $7::text = 'newest' THEN p.created_at END DESC,
-    CASE WHEN $7::text = 'oldest' THEN p.created_at END ASC,
-    CASE WHEN $7::text = 'price_high' THEN p.price END DESC,
-    CASE WHEN $7::text = 'price_low' THEN p.price END ASC,
+    CASE WHEN $9::text = 'relevance' THEN
+        ts_rank(p.search_vector, to_tsquery('simple', $5::text))
+        + similarity(p.name, $4::text)
+    END DESC,
+    CASE WHEN $9::text = 'newest' THEN p.created_at END DESC,
+    CASE WHEN $9::text = 'oldest' THEN p.created_at END ASC,
+    CASE WHEN $9::text = 'price_high' THEN COALESCE(ap.price, p.price) END DESC,
+    CASE WHEN $9::text = 'price_low' THEN COALESCE(ap.price, p.price) END ASC,
+    CASE WHEN $9::text = 'rating' THEN p.rating_avg END DESC,
+    CASE WHEN $9::text = 'rating' THEN p.rating_count END DESC,
     p.created_at DESC
 LIMIT $1 OFFSET $2
 `
 
 type ListProductsPublicParams struct {
-	Limit      int32          `json:"limit"`
-	Offset     int32          `json:"offset"`
-	CategoryID uuid.NullUUID  `json:"category_id"`
-	Search     sql.NullString `json:"search"`
-	MinPrice   string         `json:"min_price"`
-	MaxPrice   string         `json:"max_price"`
-	SortBy     string         `json:"sort_by"`
+	Limit       int32          `json:"limit"`
+	Offset      int32          `json:"offset"`
+	CategoryID  uuid.NullUUID  `json:"category_id"`
+	Search      sql.NullString `json:"search"`
+	SearchQuery sql.NullString `json:"search_query"`
+	MinPrice    string         `json:"min_price"`
+	MaxPrice    string         `json:"max_price"`
+	MinRating   float64        `json:"min_rating"`
+	SortBy      string         `json:"sort_by"`
 }
 
 type ListProductsPublicRow struct {
-	ID           uuid.UUID      `json:"id"`
-	CategoryID   uuid.UUID      `json:"category_id"`
-	Name         string         `json:"name"`
-	Slug         string         `json:"slug"`
-	Description  sql.NullString `json:"description"`
-	Price        string         `json:"price"`
-	Stock        int32          `json:"stock"`
-	Sku          sql.NullString `json:"sku"`
-	ImageUrl     sql.NullString `json:"image_url"`
-	IsActive     sql.NullBool   `json:"is_active"`
-	CreatedAt    time.Time      `json:"created_at"`
-	UpdatedAt    time.Time      `json:"updated_at"`
-	DeletedAt    sql.NullTime   `json:"deleted_at"`
-	CategoryName string         `json:"category_name"`
-	TotalCount   int64          `json:"total_count"`
+	ID                uuid.UUID      `json:"id"`
+	CategoryID        uuid.UUID      `json:"category_id"`
+	Name              string         `json:"name"`
+	Slug              string         `json:"slug"`
+	Description       sql.NullString `json:"description"`
+	Price             string         `json:"price"`
+	Stock             int32          `json:"stock"`
+	Sku               sql.NullString `json:"sku"`
+	ImageUrl          sql.NullString `json:"image_url"`
+	IsActive          sql.NullBool   `json:"is_active"`
+	CreatedAt         time.Time      `json:"created_at"`
+	UpdatedAt         time.Time      `json:"updated_at"`
+	DeletedAt         sql.NullTime   `json:"deleted_at"`
+	BrandID           uuid.NullUUID  `json:"brand_id"`
+	SearchVector      interface{}    `json:"search_vector"`
+	MetaTitle         sql.NullString `json:"meta_title"`
+	MetaDescription   sql.NullString `json:"meta_description"`
+	LowStockThreshold int32          `json:"low_stock_threshold"`
+	PublishedAt       sql.NullTime   `json:"published_at"`
+	UnpublishedAt     sql.NullTime   `json:"unpublished_at"`
+	WeightGrams       int32          `json:"weight_grams"`
+	TaxClass          string         `json:"tax_class"`
+	RatingAvg         float64        `json:"rating_avg"`
+	RatingCount       int32          `json:"rating_count"`
+	Rating1Count      int32          `json:"rating_1_count"`
+	Rating2Count      int32          `json:"rating_2_count"`
+	Rating3Count      int32          `json:"rating_3_count"`
+	Rating4Count      int32          `json:"rating_4_count"`
+	Rating5Count      int32          `json:"rating_5_count"`
+	CategoryName      string         `json:"category_name"`
+	EffectivePrice    string         `json:"effective_price"`
+	CompareAtPrice    sql.NullString `json:"compare_at_price"`
+	TotalCount        int64          `json:"total_count"`
 }
 
+// Filter kategori mencakup seluruh sub-kategori (recursive CTE)
 func (q *Queries) ListProductsPublic(ctx context.Context, arg ListProductsPublicParams) ([]ListProductsPublicRow, error) {
 	rows, err := q.query(ctx, q.listProductsPublicStmt, listProductsPublic,
 		arg.Limit,
 		arg.Offset,
 		arg.CategoryID,
 		arg.Search,
+		arg.SearchQuery,
 		arg.MinPrice,
 		arg.MaxPrice,
+		arg.MinRating,
 		arg.SortBy,
 	)
 	if err != nil {
@@ -354,7 +849,25 @@ func (q *Queries) ListProductsPublic(ctx context.Context, arg ListProductsPublic
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.BrandID,
+			&i.SearchVector,
+			&i.MetaTitle,
+			&i.MetaDescription,
+			&i.LowStockThreshold,
+			&i.PublishedAt,
+			&i.UnpublishedAt,
+			&i.WeightGrams,
+			&i.TaxClass,
+			&i.RatingAvg,
+			&i.RatingCount,
+			&i.Rating1Count,
+			&i.Rating2Count,
+			&i.Rating3Count,
+			&i.Rating4Count,
+			&i.Rating5Count,
 			&i.CategoryName,
+			&i.EffectivePrice,
+			&i.CompareAtPrice,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -370,8 +883,221 @@ func (q *Queries) ListProductsPublic(ctx context.Context, arg ListProductsPublic
 	return items, nil
 }
 
+const listProductsPublicKeyset = `-- name: ListProductsPublicKeyset :many
+WITH RECURSIVE category_tree AS (
+    SELECT c.id FROM categories c WHERE c.id = $2::uuid
+    UNION
+    SELECT ch.id FROM categories ch
+    JOIN category_tree t ON ch.parent_id = t.id
+    WHERE ch.deleted_at IS NULL
+)
+SELECT
+    p.id, p.category_id, p.name, p.slug, p.description, p.price, p.stock, p.sku, p.image_url, p.is_active, p.created_at, p.updated_at, p.deleted_at, p.brand_id, p.search_vector, p.meta_title, p.meta_description, p.low_stock_threshold, p.published_at, p.unpublished_at, p.weight_grams, p.tax_class, p.rating_avg, p.rating_count, p.rating_1_count, p.rating_2_count, p.rating_3_count, p.rating_4_count, p.rating_5_count,
+    c.name as category_name,
+    COALESCE(ap.price, p.price)::decimal AS effective_price,
+    ap.compare_at_price
+FROM products p
+JOIN categories c ON p.category_id = c.id
+LEFT JOIN LATERAL (
+    -- Jadwal harga aktif; jika overlap, yang mulai paling akhir menang
+    SELECT pp.price, pp.compare_at_price
+    FROM product_prices pp
+    WHERE pp.product_id = p.id
+      AND pp.starts_at <= NOW()
+      AND (pp.ends_at IS NULL OR pp.ends_at > NOW())
+    ORDER BY pp.starts_at DESC
+    LIMIT 1
+) ap ON true
+WHERE p.deleted_at IS NULL 
+  AND p.is_active = true
+  AND (p.published_at IS NULL OR p.published_at <= NOW())
+  AND (p.unpublished_at IS NULL OR p.unpublished_at > NOW())
+  AND ($2::uuid IS NULL OR p.category_id IN (SELECT id FROM category_tree))
+  AND (
+    $3::text IS NULL
+    OR p.search_vector @@ to_tsquery('simple', $4::text)
+    OR p.name % $3::text
+  )
+  AND (COALESCE(ap.price, p.price) >= $5::decimal)
+  AND (COALESCE(ap.price, p.price) <= $6::decimal)
+  AND p.rating_avg >= $7::float8
+  AND (
+    $8::uuid IS NULL
+    OR ($9::text = 'newest'
+        AND (p.created_at, p.id) < ($10::timestamp, $8::uuid))
+    OR ($9::text = 'oldest'
+        AND (p.created_at, p.id) > ($10::timestamp, $8::uuid))
+    OR ($9::text = 'price_high'
+        AND (COALESCE(ap.price, p.price), p.id) < ($11::decimal, $8::uuid))
+    OR ($9::text = 'price_low'
+        AND (COALESCE(ap.price, p.price), p.id) > ($11::decimal, $8::uuid))
+  )
+ORDER BY 
+    CASE WHEN $9::text = 'newest' THEN p.created_at END DESC,
+    CASE WHEN $9::text = 'oldest' THEN p.created_at END ASC,
+    CASE WHEN $9::text = 'price_high' THEN COALESCE(ap.price, p.price) END DESC,
+    CASE WHEN $9::text = 'price_low' THEN COALESCE(ap.price, p.price) END ASC,
+    CASE WHEN $9::text IN ('oldest', 'price_low') THEN p.id END ASC,
+    p.id DESC
+LIMIT $1
+`
+
+type ListProductsPublicKeysetParams struct {
+	Limit           int32          `json:"limit"`
+	CategoryID      uuid.NullUUID  `json:"category_id"`
+	Search          sql.NullString `json:"search"`
+	SearchQuery     sql.NullString `json:"search_query"`
+	MinPrice        string         `json:"min_price"`
+	MaxPrice        string         `json:"max_price"`
+	MinRating       float64        `json:"min_rating"`
+	CursorID        uuid.NullUUID  `json:"cursor_id"`
+	SortBy          string         `json:"sort_by"`
+	CursorCreatedAt sql.NullTime   `json:"cursor_created_at"`
+	CursorPrice     sql.NullString `json:"cursor_price"`
+}
+
+type ListProductsPublicKeysetRow struct {
+	ID                uuid.UUID      `json:"id"`
+	CategoryID        uuid.UUID      `json:"category_id"`
+	Name              string         `json:"name"`
+	Slug              string         `json:"slug"`
+	Description       sql.NullString `json:"description"`
+	Price             string         `json:"price"`
+	Stock             int32          `json:"stock"`
+	Sku               sql.NullString `json:"sku"`
+	ImageUrl          sql.NullString `json:"image_url"`
+	IsActive          sql.NullBool   `json:"is_active"`
+	CreatedAt         time.Time      `json:"created_at"`
+	UpdatedAt         time.Time      `json:"updated_at"`
+	DeletedAt         sql.NullTime   `json:"deleted_at"`
+	BrandID           uuid.NullUUID  `json:"brand_id"`
+	SearchVector      interface{}    `json:"search_vector"`
+	MetaTitle         sql.NullString `json:"meta_title"`
+	MetaDescription   sql.NullString `json:"meta_description"`
+	LowStockThreshold int32          `json:"low_stock_threshold"`
+	PublishedAt       sql.NullTime   `json:"published_at"`
+	UnpublishedAt     sql.NullTime   `json:"unpublished_at"`
+	WeightGrams       int32          `json:"weight_grams"`
+	TaxClass          string         `json:"tax_class"`
+	RatingAvg         float64        `json:"rating_avg"`
+	RatingCount       int32          `json:"rating_count"`
+	Rating1Count      int32          `json:"rating_1_count"`
+	Rating2Count      int32          `json:"rating_2_count"`
+	Rating3Count      int32          `json:"rating_3_count"`
+	Rating4Count      int32          `json:"rating_4_count"`
+	Rating5Count      int32          `json:"rating_5_count"`
+	CategoryName      string         `json:"category_name"`
+	EffectivePrice    string         `json:"effective_price"`
+	CompareAtPrice    sql.NullString `json:"compare_at_price"`
+}
+
+// Keyset pagination: sort_by sudah dinormalisasi service (arah dibalik untuk prev page)
+func (q *Queries) ListProductsPublicKeyset(ctx context.Context, arg ListProductsPublicKeysetParams) ([]ListProductsPublicKeysetRow, error) {
+	rows, err := q.query(ctx, q.listProductsPublicKeysetStmt, listProductsPublicKeyset,
+		arg.Limit,
+		arg.CategoryID,
+		arg.Search,
+		arg.SearchQuery,
+		arg.MinPrice,
+		arg.MaxPrice,
+		arg.MinRating,
+		arg.CursorID,
+		arg.SortBy,
+		arg.CursorCreatedAt,
+		arg.CursorPrice,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListProductsPublicKeysetRow
+	for rows.Next() {
+		var i ListProductsPublicKeysetRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CategoryID,
+			&i.Name,
+			&i.Slug,
+			&i.Description,
+			&i.Price,
+			&i.Stock,
+			&i.Sku,
+			&i.ImageUrl,
+			&i.IsActive,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.BrandID,
+			&i.SearchVector,
+			&i.MetaTitle,
+			&i.MetaDescription,
+			&i.LowStockThreshold,
+			&i.PublishedAt,
+			&i.UnpublishedAt,
+			&i.WeightGrams,
+			&i.TaxClass,
+			&i.RatingAvg,
+			&i.RatingCount,
+			&i.Rating1Count,
+			&i.Rating2Count,
+			&i.Rating3Count,
+			&i.Rating4Count,
+			&i.Rating5Count,
+			&i.CategoryName,
+			&i.EffectivePrice,
+			&i.CompareAtPrice,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockProductStock = `-- name: LockProductStock :one
+SELECT stock FROM products WHERE id = $1 FOR UPDATE
+`
+
+// Kunci baris produk dalam transaksi; stok terkini untuk menghitung selisih ledger
+func (q *Queries) LockProductStock(ctx context.Context, id uuid.UUID) (int32, error) {
+	row := q.queryRow(ctx, q.lockProductStockStmt, lockProductStock, id)
+	var stock int32
+	err := row.Scan(&stock)
+	return stock, err
+}
+
+const productSlugExists = `-- name: ProductSlugExists :one
+SELECT EXISTS (
+    SELECT 1 FROM products p
+    WHERE p.slug = $1::text AND p.id <> $2::uuid
+    UNION ALL
+    SELECT 1 FROM slug_redirects r
+    WHERE r.entity_type = 'product' AND r.old_slug = $1::text AND r.entity_id <> $2::uuid
+) AS slug_exists
+`
+
+type ProductSlugExistsParams struct {
+	Slug      string    `json:"slug"`
+	ExcludeID uuid.UUID `json:"exclude_id"`
+}
+
+// Slug dianggap terpakai jika dipakai produk lain (termasuk soft-deleted)
+// atau masih tercatat sebagai slug lama produk lain di slug_redirects
+func (q *Queries) ProductSlugExists(ctx context.Context, arg ProductSlugExistsParams) (bool, error) {
+	row := q.queryRow(ctx, q.productSlugExistsStmt, productSlugExists, arg.Slug, arg.ExcludeID)
+	var slug_exists bool
+	err := row.Scan(&slug_exists)
+	return slug_exists, err
+}
+
 const restoreProduct = `-- name: RestoreProduct :one
-UPDATE products SET deleted_at = NULL WHERE id = $1 RETURNING id, category_id, name, slug, description, price, stock, sku, image_url, is_active, created_at, updated_at, deleted_at
+UPDATE products SET deleted_at = NULL WHERE id = $1 RETURNING id, category_id, name, slug, description, price, stock, sku, image_url, is_active, created_at, updated_at, deleted_at, brand_id, search_vector, meta_title, meta_description, low_stock_threshold, published_at, unpublished_at, weight_grams, tax_class, rating_avg, rating_count, rating_1_count, rating_2_count, rating_3_count, rating_4_count, rating_5_count
 `
 
 func (q *Queries) RestoreProduct(ctx context.Context, id uuid.UUID) (Product, error) {
@@ -391,6 +1117,22 @@ func (q *Queries) RestoreProduct(ctx context.Context, id uuid.UUID) (Product, er
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.BrandID,
+		&i.SearchVector,
+		&i.MetaTitle,
+		&i.MetaDescription,
+		&i.LowStockThreshold,
+		&i.PublishedAt,
+		&i.UnpublishedAt,
+		&i.WeightGrams,
+		&i.TaxClass,
+		&i.RatingAvg,
+		&i.RatingCount,
+		&i.Rating1Count,
+		&i.Rating2Count,
+		&i.Rating3Count,
+		&i.Rating4Count,
+		&i.Rating5Count,
 	)
 	return i, err
 }
@@ -404,6 +1146,65 @@ func (q *Queries) SoftDeleteProduct(ctx context.Context, id uuid.UUID) error {
 	return err
 }
 
+const suggestProducts = `-- name: SuggestProducts :many
+SELECT p.id, p.name, p.slug, p.image_url
+FROM products p
+WHERE p.deleted_at IS NULL
+  AND p.is_active = true
+  AND (p.published_at IS NULL OR p.published_at <= NOW())
+  AND (p.unpublished_at IS NULL OR p.unpublished_at > NOW())
+  AND (
+    p.search_vector @@ to_tsquery('simple', $2::text)
+    OR p.name % $3::text
+  )
+ORDER BY
+    ts_rank(p.search_vector, to_tsquery('simple', $2::text)) DESC,
+    similarity(p.name, $3::text) DESC,
+    p.name ASC
+LIMIT $1
+`
+
+type SuggestProductsParams struct {
+	Limit       int32  `json:"limit"`
+	SearchQuery string `json:"search_query"`
+	Search      string `json:"search"`
+}
+
+type SuggestProductsRow struct {
+	ID       uuid.UUID      `json:"id"`
+	Name     string         `json:"name"`
+	Slug     string         `json:"slug"`
+	ImageUrl sql.NullString `json:"image_url"`
+}
+
+func (q *Queries) SuggestProducts(ctx context.Context, arg SuggestProductsParams) ([]SuggestProductsRow, error) {
+	rows, err := q.query(ctx, q.suggestProductsStmt, suggestProducts, arg.Limit, arg.SearchQuery, arg.Search)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SuggestProductsRow
+	for rows.Next() {
+		var i SuggestProductsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Slug,
+			&i.ImageUrl,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateProduct = `-- name: UpdateProduct :one
 UPDATE products
 SET 
@@ -411,27 +1212,42 @@ SET
     name = $3,
     description = $4,
     price = $5,
-    stock = $6,
-    sku = $7,
-    image_url = $8,
-    is_active = $9,
+    sku = $6,
+    image_url = $7,
+    is_active = $8,
+    brand_id = $9,
+    slug = $10,
+    meta_title = $11,
+    meta_description = $12,
+    published_at = $13,
+    unpublished_at = $14,
+    weight_grams = $15,
+    tax_class = $16,
     updated_at = NOW()
 WHERE id = $1
-RETURNING id, category_id, name, slug, description, price, stock, sku, image_url, is_active, created_at, updated_at, deleted_at
+RETURNING id, category_id, name, slug, description, price, stock, sku, image_url, is_active, created_at, updated_at, deleted_at, brand_id, search_vector, meta_title, meta_description, low_stock_threshold, published_at, unpublished_at, weight_grams, tax_class, rating_avg, rating_count, rating_1_count, rating_2_count, rating_3_count, rating_4_count, rating_5_count
 `
 
 type UpdateProductParams struct {
-	ID          uuid.UUID      `json:"id"`
-	CategoryID  uuid.UUID      `json:"category_id"`
-	Name        string         `json:"name"`
-	Description sql.NullString `json:"description"`
-	Price       string         `json:"price"`
-	Stock       int32          `json:"stock"`
-	Sku         sql.NullString `json:"sku"`
-	ImageUrl    sql.NullString `json:"image_url"`
-	IsActive    sql.NullBool   `json:"is_active"`
+	ID              uuid.UUID      `json:"id"`
+	CategoryID      uuid.UUID      `json:"category_id"`
+	Name            string         `json:"name"`
+	Description     sql.NullString `json:"description"`
+	Price           string         `json:"price"`
+	Sku             sql.NullString `json:"sku"`
+	ImageUrl        sql.NullString `json:"image_url"`
+	IsActive        sql.NullBool   `json:"is_active"`
+	BrandID         uuid.NullUUID  `json:"brand_id"`
+	Slug            string         `json:"slug"`
+	MetaTitle       sql.NullString `json:"meta_title"`
+	MetaDescription sql.NullString `json:"meta_description"`
+	PublishedAt     sql.NullTime   `json:"published_at"`
+	UnpublishedAt   sql.NullTime   `json:"unpublished_at"`
+	WeightGrams     int32          `json:"weight_grams"`
+	TaxClass        string         `json:"tax_class"`
 }
 
+// Stok tidak diubah di sini; gunakan ledger inventory
 func (q *Queries) UpdateProduct(ctx context.Context, arg UpdateProductParams) (Product, error) {
 	row := q.queryRow(ctx, q.updateProductStmt, updateProduct,
 		arg.ID,
@@ -439,10 +1255,17 @@ func (q *Queries) UpdateProduct(ctx context.Context, arg UpdateProductParams) (P
 		arg.Name,
 		arg.Description,
 		arg.Price,
-		arg.Stock,
 		arg.Sku,
 		arg.ImageUrl,
 		arg.IsActive,
+		arg.BrandID,
+		arg.Slug,
+		arg.MetaTitle,
+		arg.MetaDescription,
+		arg.PublishedAt,
+		arg.UnpublishedAt,
+		arg.WeightGrams,
+		arg.TaxClass,
 	)
 	var i Product
 	err := row.Scan(
@@ -459,6 +1282,132 @@ func (q *Queries) UpdateProduct(ctx context.Context, arg UpdateProductParams) (P
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.BrandID,
+		&i.SearchVector,
+		&i.MetaTitle,
+		&i.MetaDescription,
+		&i.LowStockThreshold,
+		&i.PublishedAt,
+		&i.UnpublishedAt,
+		&i.WeightGrams,
+		&i.TaxClass,
+		&i.RatingAvg,
+		&i.RatingCount,
+		&i.Rating1Count,
+		&i.Rating2Count,
+		&i.Rating3Count,
+		&i.Rating4Count,
+		&i.Rating5Count,
 	)
 	return i, err
 }
+
+const upsertProductsBatch = `-- name: UpsertProductsBatch :many
+WITH input AS (
+    SELECT
+        unnest($1::text[])::text AS sku,
+        unnest($2::text[])::text AS name,
+        unnest($3::text[])::text AS slug,
+        unnest($4::text[])::text AS description,
+        unnest($5::decimal[])::decimal AS price,
+        unnest($6::uuid[])::uuid AS category_id,
+        unnest($7::text[])::text AS brand_id,
+        unnest($8::boolean[])::boolean AS is_active
+),
+previous AS (
+    SELECT p.sku, p.price
+    FROM products p
+    JOIN input i ON i.sku = p.sku
+),
+upserted AS (
+    INSERT INTO products (sku, name, slug, description, price, category_id, brand_id, is_active)
+    SELECT
+        i.sku,
+        i.name,
+        i.slug,
+        NULLIF(i.description, ''),
+        i.price,
+        i.category_id,
+        NULLIF(i.brand_id, '')::uuid,
+        i.is_active
+    FROM input i
+    ON CONFLICT (sku) DO UPDATE SET
+        name = EXCLUDED.name,
+        description = EXCLUDED.description,
+        price = EXCLUDED.price,
+        category_id = EXCLUDED.category_id,
+        brand_id = EXCLUDED.brand_id,
+        is_active = EXCLUDED.is_active,
+        deleted_at = NULL,
+        updated_at = NOW()
+    RETURNING id, sku, stock, price, (xmax = 0)::boolean AS inserted
+)
+SELECT
+    u.id,
+    u.sku::text AS sku,
+    u.stock,
+    u.inserted,
+    COALESCE(u.price < pv.price, false)::boolean AS price_decreased
+FROM upserted u
+LEFT JOIN previous pv ON pv.sku = u.sku
+`
+
+type UpsertProductsBatchParams struct {
+	Skus         []string    `json:"skus"`
+	Names        []string    `json:"names"`
+	Slugs        []string    `json:"slugs"`
+	Descriptions []string    `json:"descriptions"`
+	Prices       []string    `json:"prices"`
+	CategoryIds  []uuid.UUID `json:"category_ids"`
+	BrandIds     []string    `json:"brand_ids"`
+	IsActives    []bool      `json:"is_actives"`
+}
+
+type UpsertProductsBatchRow struct {
+	ID             uuid.UUID `json:"id"`
+	Sku            string    `json:"sku"`
+	Stock          int32     `json:"stock"`
+	Inserted       bool      `json:"inserted"`
+	PriceDecreased bool      `json:"price_decreased"`
+}
+
+// Bulk import: upsert per batch berdasarkan SKU (produk terhapus ikut dipulihkan)
+// Stok tidak ditulis di sini; selisih ke target stok dicatat lewat ledger inventory.
+// Slug hanya dipakai untuk produk baru; price_decreased = harga produk lama turun
+func (q *Queries) UpsertProductsBatch(ctx context.Context, arg UpsertProductsBatchParams) ([]UpsertProductsBatchRow, error) {
+	rows, err := q.query(ctx, q.upsertProductsBatchStmt, upsertProductsBatch,
+		pq.Array(arg.Skus),
+		pq.Array(arg.Names),
+		pq.Array(arg.Slugs),
+		pq.Array(arg.Descriptions),
+		pq.Array(arg.Prices),
+		pq.Array(arg.CategoryIds),
+		pq.Array(arg.BrandIds),
+		pq.Array(arg.IsActives),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UpsertProductsBatchRow
+	for rows.Next() {
+		var i UpsertProductsBatchRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Sku,
+			&i.Stock,
+			&i.Inserted,
+			&i.PriceDecreased,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 const checkReviewExists = `-- name: CheckReviewExists :one
@@ -55,13 +56,51 @@ func (q *Queries) CheckUserPurchasedProduct(ctx context.Context, arg CheckUserPu
 	return exists, err
 }
 
+const countReviewsAdmin = `-- name: CountReviewsAdmin :one
+SELECT COUNT(*)
+FROM reviews r
+WHERE r.deleted_at IS NULL
+  AND ($1::text IS NULL OR r.status = $1::text)
+  AND ($2::uuid IS NULL OR r.product_id = $2::uuid)
+  AND ($3::int IS NULL OR r.rating = $3::int)
+  AND (NOT $4::bool OR r.report_count > 0)
+`
+
+type CountReviewsAdminParams struct {
+	Status    sql.NullString `json:"status"`
+	ProductID uuid.NullUUID  `json:"product_id"`
+	Rating    sql.NullInt32  `json:"rating"`
+	Reported  bool           `json:"reported"`
+}
+
+func (q *Queries) CountReviewsAdmin(ctx context.Context, arg CountReviewsAdminParams) (int64, error) {
+	row := q.queryRow(ctx, q.countReviewsAdminStmt, countReviewsAdmin,
+		arg.Status,
+		arg.ProductID,
+		arg.Rating,
+		arg.Reported,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const countReviewsByProductID = `-- name: CountReviewsByProductID :one
-SELECT COUNT(*) FROM reviews
-WHERE product_id = $1 AND deleted_at IS NULL
+SELECT COUNT(*) FROM reviews r
+WHERE r.product_id = $1 AND r.deleted_at IS NULL AND r.status = 'APPROVED'
+  AND ($2::int IS NULL OR r.rating = $2::int)
+  AND (NOT $3::bool OR EXISTS (SELECT 1 FROM review_photos rp WHERE rp.review_id = r.id))
 `
 
-func (q *Queries) CountReviewsByProductID(ctx context.Context, productID uuid.UUID) (int64, error) {
-	row := q.queryRow(ctx, q.countReviewsByProductIDStmt, countReviewsByProductID, productID)
+type CountReviewsByProductIDParams struct {
+	ProductID  uuid.UUID     `json:"product_id"`
+	Rating     sql.NullInt32 `json:"rating"`
+	WithPhotos bool          `json:"with_photos"`
+}
+
+// Filter sama dengan GetReviewsByProductID supaya total halaman konsisten
+func (q *Queries) CountReviewsByProductID(ctx context.Context, arg CountReviewsByProductIDParams) (int64, error) {
+	row := q.queryRow(ctx, q.countReviewsByProductIDStmt, countReviewsByProductID, arg.ProductID, arg.Rating, arg.WithPhotos)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
@@ -80,28 +119,36 @@ func (q *Queries) CountReviewsByUserID(ctx context.Context, userID uuid.UUID) (i
 }
 
 const createReview = `-- name: CreateReview :one
-INSERT INTO reviews (user_id, product_id, order_id, rating, comment, is_verified_purchase)
-VALUES ($1, $2, $3, $4, $5, $6)
-RETURNING id, user_id, product_id, order_id, rating, comment, is_verified_purchase, created_at, updated_at, deleted_at
+INSERT INTO reviews (id, user_id, product_id, order_id, rating, comment, is_verified_purchase, status, moderation_note)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING id, user_id, product_id, order_id, rating, comment, is_verified_purchase, created_at, updated_at, deleted_at, status, moderation_note, moderated_by, moderated_at, helpful_count, not_helpful_count, report_count
 `
 
 type CreateReviewParams struct {
-	UserID             uuid.UUID `json:"user_id"`
-	ProductID          uuid.UUID `json:"product_id"`
-	OrderID            uuid.UUID `json:"order_id"`
-	Rating             int32     `json:"rating"`
-	Comment            string    `json:"comment"`
-	IsVerifiedPurchase bool      `json:"is_verified_purchase"`
+	ID                 uuid.UUID      `json:"id"`
+	UserID             uuid.UUID      `json:"user_id"`
+	ProductID          uuid.UUID      `json:"product_id"`
+	OrderID            uuid.UUID      `json:"order_id"`
+	Rating             int32          `json:"rating"`
+	Comment            string         `json:"comment"`
+	IsVerifiedPurchase bool           `json:"is_verified_purchase"`
+	Status             string         `json:"status"`
+	ModerationNote     sql.NullString `json:"moderation_note"`
 }
 
+// status: APPROVED (auto-approve) atau PENDING (antrian moderasi)
+// id dibuat service agar foto bisa diupload sebelum transaksi dimulai
 func (q *Queries) CreateReview(ctx context.Context, arg CreateReviewParams) (Review, error) {
 	row := q.queryRow(ctx, q.createReviewStmt, createReview,
+		arg.ID,
 		arg.UserID,
 		arg.ProductID,
 		arg.OrderID,
 		arg.Rating,
 		arg.Comment,
 		arg.IsVerifiedPurchase,
+		arg.Status,
+		arg.ModerationNote,
 	)
 	var i Review
 	err := row.Scan(
@@ -115,6 +162,94 @@ func (q *Queries) CreateReview(ctx context.Context, arg CreateReviewParams) (Rev
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Status,
+		&i.ModerationNote,
+		&i.ModeratedBy,
+		&i.ModeratedAt,
+		&i.HelpfulCount,
+		&i.NotHelpfulCount,
+		&i.ReportCount,
+	)
+	return i, err
+}
+
+const createReviewPhoto = `-- name: CreateReviewPhoto :exec
+INSERT INTO review_photos (review_id, image_url, public_id, sort_order)
+VALUES ($1, $2, $3, $4)
+`
+
+type CreateReviewPhotoParams struct {
+	ReviewID  uuid.UUID `json:"review_id"`
+	ImageUrl  string    `json:"image_url"`
+	PublicID  string    `json:"public_id"`
+	SortOrder int32     `json:"sort_order"`
+}
+
+func (q *Queries) CreateReviewPhoto(ctx context.Context, arg CreateReviewPhotoParams) error {
+	_, err := q.exec(ctx, q.createReviewPhotoStmt, createReviewPhoto,
+		arg.ReviewID,
+		arg.ImageUrl,
+		arg.PublicID,
+		arg.SortOrder,
+	)
+	return err
+}
+
+const createReviewReply = `-- name: CreateReviewReply :one
+INSERT INTO review_replies (review_id, admin_id, body)
+VALUES ($1, $2, $3)
+RETURNING id, review_id, admin_id, body, created_at, updated_at
+`
+
+type CreateReviewReplyParams struct {
+	ReviewID uuid.UUID     `json:"review_id"`
+	AdminID  uuid.NullUUID `json:"admin_id"`
+	Body     string        `json:"body"`
+}
+
+func (q *Queries) CreateReviewReply(ctx context.Context, arg CreateReviewReplyParams) (ReviewReply, error) {
+	row := q.queryRow(ctx, q.createReviewReplyStmt, createReviewReply, arg.ReviewID, arg.AdminID, arg.Body)
+	var i ReviewReply
+	err := row.Scan(
+		&i.ID,
+		&i.ReviewID,
+		&i.AdminID,
+		&i.Body,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createReviewReport = `-- name: CreateReviewReport :one
+INSERT INTO review_reports (review_id, user_id, reason, note)
+VALUES ($1, $2, $3, $4)
+RETURNING id, review_id, user_id, reason, note, resolved_at, created_at
+`
+
+type CreateReviewReportParams struct {
+	ReviewID uuid.UUID      `json:"review_id"`
+	UserID   uuid.UUID      `json:"user_id"`
+	Reason   string         `json:"reason"`
+	Note     sql.NullString `json:"note"`
+}
+
+func (q *Queries) CreateReviewReport(ctx context.Context, arg CreateReviewReportParams) (ReviewReport, error) {
+	row := q.queryRow(ctx, q.createReviewReportStmt, createReviewReport,
+		arg.ReviewID,
+		arg.UserID,
+		arg.Reason,
+		arg.Note,
+	)
+	var i ReviewReport
+	err := row.Scan(
+		&i.ID,
+		&i.ReviewID,
+		&i.UserID,
+		&i.Reason,
+		&i.Note,
+		&i.ResolvedAt,
+		&i.CreatedAt,
 	)
 	return i, err
 }
@@ -130,10 +265,71 @@ func (q *Queries) DeleteReview(ctx context.Context, id uuid.UUID) error {
 	return err
 }
 
+const deleteReviewPhotos = `-- name: DeleteReviewPhotos :many
+DELETE FROM review_photos
+WHERE review_id = $1
+RETURNING public_id
+`
+
+// Dipanggil saat review dihapus; public_id dipakai untuk hapus file di Cloudinary
+func (q *Queries) DeleteReviewPhotos(ctx context.Context, reviewID uuid.UUID) ([]string, error) {
+	rows, err := q.query(ctx, q.deleteReviewPhotosStmt, deleteReviewPhotos, reviewID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var public_id string
+		if err := rows.Scan(&public_id); err != nil {
+			return nil, err
+		}
+		items = append(items, public_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteReviewReply = `-- name: DeleteReviewReply :execrows
+DELETE FROM review_replies
+WHERE review_id = $1
+`
+
+func (q *Queries) DeleteReviewReply(ctx context.Context, reviewID uuid.UUID) (int64, error) {
+	result, err := q.exec(ctx, q.deleteReviewReplyStmt, deleteReviewReply, reviewID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteReviewVote = `-- name: DeleteReviewVote :execrows
+DELETE FROM review_votes
+WHERE review_id = $1 AND user_id = $2
+`
+
+type DeleteReviewVoteParams struct {
+	ReviewID uuid.UUID `json:"review_id"`
+	UserID   uuid.UUID `json:"user_id"`
+}
+
+func (q *Queries) DeleteReviewVote(ctx context.Context, arg DeleteReviewVoteParams) (int64, error) {
+	result, err := q.exec(ctx, q.deleteReviewVoteStmt, deleteReviewVote, arg.ReviewID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const getAverageRatingByProductID = `-- name: GetAverageRatingByProductID :one
 SELECT COALESCE(AVG(rating), 0) as average_rating
 FROM reviews
-WHERE product_id = $1 AND deleted_at IS NULL
+WHERE product_id = $1 AND deleted_at IS NULL AND status = 'APPROVED'
 `
 
 func (q *Queries) GetAverageRatingByProductID(ctx context.Context, productID uuid.UUID) (interface{}, error) {
@@ -168,28 +364,48 @@ func (q *Queries) GetCompletedOrderForReview(ctx context.Context, arg GetComplet
 }
 
 const getReviewByID = `-- name: GetReviewByID :one
-SELECT r.id, r.user_id, r.product_id, r.order_id, r.rating, r.comment, r.is_verified_purchase, r.created_at, r.updated_at, r.deleted_at, u.first_name as user_name, u.email as user_email
+SELECT r.id, r.user_id, r.product_id, r.order_id, r.rating, r.comment, r.is_verified_purchase, r.created_at, r.updated_at, r.deleted_at, r.status, r.moderation_note, r.moderated_by, r.moderated_at, r.helpful_count, r.not_helpful_count, r.report_count, u.first_name as user_name, u.email as user_email, p.name as product_name, p.slug as product_slug,
+  COALESCE((SELECT array_agg(rp.image_url ORDER BY rp.sort_order) FROM review_photos rp WHERE rp.review_id = r.id), '{}')::text[] AS photo_urls,
+  rr.body AS reply_body,
+  rr.created_at AS reply_created_at,
+  rr.updated_at AS reply_updated_at
 FROM reviews r
 JOIN users u ON r.user_id = u.id
+JOIN products p ON r.product_id = p.id
+LEFT JOIN review_replies rr ON rr.review_id = r.id
 WHERE r.id = $1 AND r.deleted_at IS NULL
 LIMIT 1
 `
 
 type GetReviewByIDRow struct {
-	ID                 uuid.UUID    `json:"id"`
-	UserID             uuid.UUID    `json:"user_id"`
-	ProductID          uuid.UUID    `json:"product_id"`
-	OrderID            uuid.UUID    `json:"order_id"`
-	Rating             int32        `json:"rating"`
-	Comment            string       `json:"comment"`
-	IsVerifiedPurchase bool         `json:"is_verified_purchase"`
-	CreatedAt          time.Time    `json:"created_at"`
-	UpdatedAt          time.Time    `json:"updated_at"`
-	DeletedAt          sql.NullTime `json:"deleted_at"`
-	UserName           string       `json:"user_name"`
-	UserEmail          string       `json:"user_email"`
+	ID                 uuid.UUID      `json:"id"`
+	UserID             uuid.UUID      `json:"user_id"`
+	ProductID          uuid.UUID      `json:"product_id"`
+	OrderID            uuid.UUID      `json:"order_id"`
+	Rating             int32          `json:"rating"`
+	Comment            string         `json:"comment"`
+	IsVerifiedPurchase bool           `json:"is_verified_purchase"`
+	CreatedAt          time.Time      `json:"created_at"`
+	UpdatedAt          time.Time      `json:"updated_at"`
+	DeletedAt          sql.NullTime   `json:"deleted_at"`
+	Status             string         `json:"status"`
+	ModerationNote     sql.NullString `json:"moderation_note"`
+	ModeratedBy        uuid.NullUUID  `json:"moderated_by"`
+	ModeratedAt        sql.NullTime   `json:"moderated_at"`
+	HelpfulCount       int32          `json:"helpful_count"`
+	NotHelpfulCount    int32          `json:"not_helpful_count"`
+	ReportCount        int32          `json:"report_count"`
+	UserName           string         `json:"user_name"`
+	UserEmail          string         `json:"user_email"`
+	ProductName        string         `json:"product_name"`
+	ProductSlug        string         `json:"product_slug"`
+	PhotoUrls          []string       `json:"photo_urls"`
+	ReplyBody          sql.NullString `json:"reply_body"`
+	ReplyCreatedAt     sql.NullTime   `json:"reply_created_at"`
+	ReplyUpdatedAt     sql.NullTime   `json:"reply_updated_at"`
 }
 
+// Foto & balasan merchant ikut di-select supaya tidak perlu query terpisah per review
 func (q *Queries) GetReviewByID(ctx context.Context, id uuid.UUID) (GetReviewByIDRow, error) {
 	row := q.queryRow(ctx, q.getReviewByIDStmt, getReviewByID, id)
 	var i GetReviewByIDRow
@@ -204,43 +420,111 @@ func (q *Queries) GetReviewByID(ctx context.Context, id uuid.UUID) (GetReviewByI
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Status,
+		&i.ModerationNote,
+		&i.ModeratedBy,
+		&i.ModeratedAt,
+		&i.HelpfulCount,
+		&i.NotHelpfulCount,
+		&i.ReportCount,
 		&i.UserName,
 		&i.UserEmail,
+		&i.ProductName,
+		&i.ProductSlug,
+		pq.Array(&i.PhotoUrls),
+		&i.ReplyBody,
+		&i.ReplyCreatedAt,
+		&i.ReplyUpdatedAt,
+	)
+	return i, err
+}
+
+const getReviewReply = `-- name: GetReviewReply :one
+SELECT id, review_id, admin_id, body, created_at, updated_at FROM review_replies
+WHERE review_id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetReviewReply(ctx context.Context, reviewID uuid.UUID) (ReviewReply, error) {
+	row := q.queryRow(ctx, q.getReviewReplyStmt, getReviewReply, reviewID)
+	var i ReviewReply
+	err := row.Scan(
+		&i.ID,
+		&i.ReviewID,
+		&i.AdminID,
+		&i.Body,
+		&i.CreatedAt,
+		&i.UpdatedAt,
 	)
 	return i, err
 }
 
 const getReviewsByProductID = `-- name: GetReviewsByProductID :many
-SELECT r.id, r.user_id, r.product_id, r.order_id, r.rating, r.comment, r.is_verified_purchase, r.created_at, r.updated_at, r.deleted_at, u.first_name as user_name
+SELECT r.id, r.user_id, r.product_id, r.order_id, r.rating, r.comment, r.is_verified_purchase, r.created_at, r.updated_at, r.deleted_at, r.status, r.moderation_note, r.moderated_by, r.moderated_at, r.helpful_count, r.not_helpful_count, r.report_count, u.first_name as user_name,
+  COALESCE((SELECT array_agg(rp.image_url ORDER BY rp.sort_order) FROM review_photos rp WHERE rp.review_id = r.id), '{}')::text[] AS photo_urls,
+  rr.body AS reply_body,
+  rr.created_at AS reply_created_at,
+  rr.updated_at AS reply_updated_at
 FROM reviews r
 JOIN users u ON r.user_id = u.id
-WHERE r.product_id = $1 AND r.deleted_at IS NULL
-ORDER BY r.created_at DESC
-LIMIT $2 OFFSET $3
+LEFT JOIN review_replies rr ON rr.review_id = r.id
+WHERE r.product_id = $1 AND r.deleted_at IS NULL AND r.status = 'APPROVED'
+  AND ($2::int IS NULL OR r.rating = $2::int)
+  AND (NOT $3::bool OR EXISTS (SELECT 1 FROM review_photos rp WHERE rp.review_id = r.id))
+ORDER BY
+    CASE WHEN $4::text = 'helpful' THEN r.helpful_count END DESC,
+    CASE WHEN $4::text = 'rating_high' THEN r.rating END DESC,
+    CASE WHEN $4::text = 'rating_low' THEN r.rating END ASC,
+    r.created_at DESC,
+    r.id DESC
+LIMIT $5::int OFFSET $6::int
 `
 
 type GetReviewsByProductIDParams struct {
-	ProductID uuid.UUID `json:"product_id"`
-	Limit     int32     `json:"limit"`
-	Offset    int32     `json:"offset"`
+	ProductID  uuid.UUID     `json:"product_id"`
+	Rating     sql.NullInt32 `json:"rating"`
+	WithPhotos bool          `json:"with_photos"`
+	SortBy     string        `json:"sort_by"`
+	Limit      int32         `json:"limit"`
+	Offset     int32         `json:"offset"`
 }
 
 type GetReviewsByProductIDRow struct {
-	ID                 uuid.UUID    `json:"id"`
-	UserID             uuid.UUID    `json:"user_id"`
-	ProductID          uuid.UUID    `json:"product_id"`
-	OrderID            uuid.UUID    `json:"order_id"`
-	Rating             int32        `json:"rating"`
-	Comment            string       `json:"comment"`
-	IsVerifiedPurchase bool         `json:"is_verified_purchase"`
-	CreatedAt          time.Time    `json:"created_at"`
-	UpdatedAt          time.Time    `json:"updated_at"`
-	DeletedAt          sql.NullTime `json:"deleted_at"`
-	UserName           string       `json:"user_name"`
+	ID                 uuid.UUID      `json:"id"`
+	UserID             uuid.UUID      `json:"user_id"`
+	ProductID          uuid.UUID      `json:"product_id"`
+	OrderID            uuid.UUID      `json:"order_id"`
+	Rating             int32          `json:"rating"`
+	Comment            string         `json:"comment"`
+	IsVerifiedPurchase bool           `json:"is_verified_purchase"`
+	CreatedAt          time.Time      `json:"created_at"`
+	UpdatedAt          time.Time      `json:"updated_at"`
+	DeletedAt          sql.NullTime   `json:"deleted_at"`
+	Status             string         `json:"status"`
+	ModerationNote     sql.NullString `json:"moderation_note"`
+	ModeratedBy        uuid.NullUUID  `json:"moderated_by"`
+	ModeratedAt        sql.NullTime   `json:"moderated_at"`
+	HelpfulCount       int32          `json:"helpful_count"`
+	NotHelpfulCount    int32          `json:"not_helpful_count"`
+	ReportCount        int32          `json:"report_count"`
+	UserName           string         `json:"user_name"`
+	PhotoUrls          []string       `json:"photo_urls"`
+	ReplyBody          sql.NullString `json:"reply_body"`
+	ReplyCreatedAt     sql.NullTime   `json:"reply_created_at"`
+	ReplyUpdatedAt     sql.NullTime   `json:"reply_updated_at"`
 }
 
+// Listing publik: hanya review yang sudah disetujui.
+// sort_by: newest | helpful | rating_high | rating_low (dinormalisasi service); filter rating & with_photos opsional
 func (q *Queries) GetReviewsByProductID(ctx context.Context, arg GetReviewsByProductIDParams) ([]GetReviewsByProductIDRow, error) {
-	rows, err := q.query(ctx, q.getReviewsByProductIDStmt, getReviewsByProductID, arg.ProductID, arg.Limit, arg.Offset)
+	rows, err := q.query(ctx, q.getReviewsByProductIDStmt, getReviewsByProductID,
+		arg.ProductID,
+		arg.Rating,
+		arg.WithPhotos,
+		arg.SortBy,
+		arg.Limit,
+		arg.Offset,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -259,7 +543,134 @@ func (q *Queries) GetReviewsByProductID(ctx context.Context, arg GetReviewsByPro
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.Status,
+			&i.ModerationNote,
+			&i.ModeratedBy,
+			&i.ModeratedAt,
+			&i.HelpfulCount,
+			&i.NotHelpfulCount,
+			&i.ReportCount,
+			&i.UserName,
+			pq.Array(&i.PhotoUrls),
+			&i.ReplyBody,
+			&i.ReplyCreatedAt,
+			&i.ReplyUpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getReviewsByProductIDKeyset = `-- name: GetReviewsByProductIDKeyset :many
+SELECT r.id, r.user_id, r.product_id, r.order_id, r.rating, r.comment, r.is_verified_purchase, r.created_at, r.updated_at, r.deleted_at, r.status, r.moderation_note, r.moderated_by, r.moderated_at, r.helpful_count, r.not_helpful_count, r.report_count, u.first_name as user_name,
+  COALESCE((SELECT array_agg(rp.image_url ORDER BY rp.sort_order) FROM review_photos rp WHERE rp.review_id = r.id), '{}')::text[] AS photo_urls,
+  rr.body AS reply_body,
+  rr.created_at AS reply_created_at,
+  rr.updated_at AS reply_updated_at
+FROM reviews r
+JOIN users u ON r.user_id = u.id
+LEFT JOIN review_replies rr ON rr.review_id = r.id
+WHERE r.product_id = $2 AND r.deleted_at IS NULL AND r.status = 'APPROVED'
+  AND ($3::int IS NULL OR r.rating = $3::int)
+  AND (NOT $4::bool OR EXISTS (SELECT 1 FROM review_photos rp WHERE rp.review_id = r.id))
+  AND (
+    $5::uuid IS NULL
+    OR ($6::text = 'desc'
+        AND (r.created_at, r.id) < ($7::timestamp, $5::uuid))
+    OR ($6::text = 'asc'
+        AND (r.created_at, r.id) > ($7::timestamp, $5::uuid))
+  )
+ORDER BY
+    CASE WHEN $6::text = 'asc' THEN r.created_at END ASC,
+    CASE WHEN $6::text = 'asc' THEN r.id END ASC,
+    r.created_at DESC,
+    r.id DESC
+LIMIT $1
+`
+
+type GetReviewsByProductIDKeysetParams struct {
+	Limit           int32         `json:"limit"`
+	ProductID       uuid.UUID     `json:"product_id"`
+	Rating          sql.NullInt32 `json:"rating"`
+	WithPhotos      bool          `json:"with_photos"`
+	CursorID        uuid.NullUUID `json:"cursor_id"`
+	SortDir         string        `json:"sort_dir"`
+	CursorCreatedAt sql.NullTime  `json:"cursor_created_at"`
+}
+
+type GetReviewsByProductIDKeysetRow struct {
+	ID                 uuid.UUID      `json:"id"`
+	UserID             uuid.UUID      `json:"user_id"`
+	ProductID          uuid.UUID      `json:"product_id"`
+	OrderID            uuid.UUID      `json:"order_id"`
+	Rating             int32          `json:"rating"`
+	Comment            string         `json:"comment"`
+	IsVerifiedPurchase bool           `json:"is_verified_purchase"`
+	CreatedAt          time.Time      `json:"created_at"`
+	UpdatedAt          time.Time      `json:"updated_at"`
+	DeletedAt          sql.NullTime   `json:"deleted_at"`
+	Status             string         `json:"status"`
+	ModerationNote     sql.NullString `json:"moderation_note"`
+	ModeratedBy        uuid.NullUUID  `json:"moderated_by"`
+	ModeratedAt        sql.NullTime   `json:"moderated_at"`
+	HelpfulCount       int32          `json:"helpful_count"`
+	NotHelpfulCount    int32          `json:"not_helpful_count"`
+	ReportCount        int32          `json:"report_count"`
+	UserName           string         `json:"user_name"`
+	PhotoUrls          []string       `json:"photo_urls"`
+	ReplyBody          sql.NullString `json:"reply_body"`
+	ReplyCreatedAt     sql.NullTime   `json:"reply_created_at"`
+	ReplyUpdatedAt     sql.NullTime   `json:"reply_updated_at"`
+}
+
+func (q *Queries) GetReviewsByProductIDKeyset(ctx context.Context, arg GetReviewsByProductIDKeysetParams) ([]GetReviewsByProductIDKeysetRow, error) {
+	rows, err := q.query(ctx, q.getReviewsByProductIDKeysetStmt, getReviewsByProductIDKeyset,
+		arg.Limit,
+		arg.ProductID,
+		arg.Rating,
+		arg.WithPhotos,
+		arg.CursorID,
+		arg.SortDir,
+		arg.CursorCreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetReviewsByProductIDKeysetRow
+	for rows.Next() {
+		var i GetReviewsByProductIDKeysetRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.ProductID,
+			&i.OrderID,
+			&i.Rating,
+			&i.Comment,
+			&i.IsVerifiedPurchase,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.Status,
+			&i.ModerationNote,
+			&i.ModeratedBy,
+			&i.ModeratedAt,
+			&i.HelpfulCount,
+			&i.NotHelpfulCount,
+			&i.ReportCount,
 			&i.UserName,
+			pq.Array(&i.PhotoUrls),
+			&i.ReplyBody,
+			&i.ReplyCreatedAt,
+			&i.ReplyUpdatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -275,7 +686,7 @@ func (q *Queries) GetReviewsByProductID(ctx context.Context, arg GetReviewsByPro
 }
 
 const getReviewsByUserID = `-- name: GetReviewsByUserID :many
-SELECT r.id, r.user_id, r.product_id, r.order_id, r.rating, r.comment, r.is_verified_purchase, r.created_at, r.updated_at, r.deleted_at, p.name as product_name, p.slug as product_slug
+SELECT r.id, r.user_id, r.product_id, r.order_id, r.rating, r.comment, r.is_verified_purchase, r.created_at, r.updated_at, r.deleted_at, r.status, r.moderation_note, r.moderated_by, r.moderated_at, r.helpful_count, r.not_helpful_count, r.report_count, p.name as product_name, p.slug as product_slug
 FROM reviews r
 JOIN products p ON r.product_id = p.id
 WHERE r.user_id = $1 AND r.deleted_at IS NULL
@@ -290,18 +701,25 @@ type GetReviewsByUserIDParams struct {
 }
 
 type GetReviewsByUserIDRow struct {
-	ID                 uuid.UUID    `json:"id"`
-	UserID             uuid.UUID    `json:"user_id"`
-	ProductID          uuid.UUID    `json:"product_id"`
-	OrderID            uuid.UUID    `json:"order_id"`
-	Rating             int32        `json:"rating"`
-	Comment            string       `json:"comment"`
-	IsVerifiedPurchase bool         `json:"is_verified_purchase"`
-	CreatedAt          time.Time    `json:"created_at"`
-	UpdatedAt          time.Time    `json:"updated_at"`
-	DeletedAt          sql.NullTime `json:"deleted_at"`
-	ProductName        string       `json:"product_name"`
-	ProductSlug        string       `json:"product_slug"`
+	ID                 uuid.UUID      `json:"id"`
+	UserID             uuid.UUID      `json:"user_id"`
+	ProductID          uuid.UUID      `json:"product_id"`
+	OrderID            uuid.UUID      `json:"order_id"`
+	Rating             int32          `json:"rating"`
+	Comment            string         `json:"comment"`
+	IsVerifiedPurchase bool           `json:"is_verified_purchase"`
+	CreatedAt          time.Time      `json:"created_at"`
+	UpdatedAt          time.Time      `json:"updated_at"`
+	DeletedAt          sql.NullTime   `json:"deleted_at"`
+	Status             string         `json:"status"`
+	ModerationNote     sql.NullString `json:"moderation_note"`
+	ModeratedBy        uuid.NullUUID  `json:"moderated_by"`
+	ModeratedAt        sql.NullTime   `json:"moderated_at"`
+	HelpfulCount       int32          `json:"helpful_count"`
+	NotHelpfulCount    int32          `json:"not_helpful_count"`
+	ReportCount        int32          `json:"report_count"`
+	ProductName        string         `json:"product_name"`
+	ProductSlug        string         `json:"product_slug"`
 }
 
 func (q *Queries) GetReviewsByUserID(ctx context.Context, arg GetReviewsByUserIDParams) ([]GetReviewsByUserIDRow, error) {
@@ -324,8 +742,221 @@ func (q *Queries) GetReviewsByUserID(ctx context.Context, arg GetReviewsByUserID
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.Status,
+			&i.ModerationNote,
+			&i.ModeratedBy,
+			&i.ModeratedAt,
+			&i.HelpfulCount,
+			&i.NotHelpfulCount,
+			&i.ReportCount,
+			&i.ProductName,
+			&i.ProductSlug,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const incrementReviewReportCount = `-- name: IncrementReviewReportCount :one
+UPDATE reviews
+SET report_count = report_count + 1
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING id, user_id, product_id, order_id, rating, comment, is_verified_purchase, created_at, updated_at, deleted_at, status, moderation_note, moderated_by, moderated_at, helpful_count, not_helpful_count, report_count
+`
+
+func (q *Queries) IncrementReviewReportCount(ctx context.Context, id uuid.UUID) (Review, error) {
+	row := q.queryRow(ctx, q.incrementReviewReportCountStmt, incrementReviewReportCount, id)
+	var i Review
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.ProductID,
+		&i.OrderID,
+		&i.Rating,
+		&i.Comment,
+		&i.IsVerifiedPurchase,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Status,
+		&i.ModerationNote,
+		&i.ModeratedBy,
+		&i.ModeratedAt,
+		&i.HelpfulCount,
+		&i.NotHelpfulCount,
+		&i.ReportCount,
+	)
+	return i, err
+}
+
+const listReviewReports = `-- name: ListReviewReports :many
+SELECT rr.id, rr.review_id, rr.user_id, rr.reason, rr.note, rr.resolved_at, rr.created_at, u.email AS user_email
+FROM review_reports rr
+JOIN users u ON u.id = rr.user_id
+WHERE rr.review_id = $1 AND rr.resolved_at IS NULL
+ORDER BY rr.created_at DESC
+`
+
+type ListReviewReportsRow struct {
+	ID         uuid.UUID      `json:"id"`
+	ReviewID   uuid.UUID      `json:"review_id"`
+	UserID     uuid.UUID      `json:"user_id"`
+	Reason     string         `json:"reason"`
+	Note       sql.NullString `json:"note"`
+	ResolvedAt sql.NullTime   `json:"resolved_at"`
+	CreatedAt  time.Time      `json:"created_at"`
+	UserEmail  string         `json:"user_email"`
+}
+
+// Laporan terbuka (belum ditangani admin), terbaru dulu
+func (q *Queries) ListReviewReports(ctx context.Context, reviewID uuid.UUID) ([]ListReviewReportsRow, error) {
+	rows, err := q.query(ctx, q.listReviewReportsStmt, listReviewReports, reviewID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListReviewReportsRow
+	for rows.Next() {
+		var i ListReviewReportsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ReviewID,
+			&i.UserID,
+			&i.Reason,
+			&i.Note,
+			&i.ResolvedAt,
+			&i.CreatedAt,
+			&i.UserEmail,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReviewsAdmin = `-- name: ListReviewsAdmin :many
+SELECT
+  r.id, r.user_id, r.product_id, r.order_id, r.rating, r.comment, r.is_verified_purchase, r.created_at, r.updated_at, r.deleted_at, r.status, r.moderation_note, r.moderated_by, r.moderated_at, r.helpful_count, r.not_helpful_count, r.report_count,
+  u.first_name AS user_name,
+  u.email AS user_email,
+  p.name AS product_name,
+  p.slug AS product_slug,
+  COALESCE((SELECT array_agg(rp.image_url ORDER BY rp.sort_order) FROM review_photos rp WHERE rp.review_id = r.id), '{}')::text[] AS photo_urls,
+  rr.body AS reply_body,
+  rr.created_at AS reply_created_at,
+  rr.updated_at AS reply_updated_at
+FROM reviews r
+JOIN users u ON u.id = r.user_id
+JOIN products p ON p.id = r.product_id
+LEFT JOIN review_replies rr ON rr.review_id = r.id
+WHERE r.deleted_at IS NULL
+  AND ($1::text IS NULL OR r.status = $1::text)
+  AND ($2::uuid IS NULL OR r.product_id = $2::uuid)
+  AND ($3::int IS NULL OR r.rating = $3::int)
+  AND (NOT $4::bool OR r.report_count > 0)
+ORDER BY
+    CASE WHEN $4::bool THEN r.report_count END DESC,
+    r.created_at ASC,
+    r.id ASC
+LIMIT $5::int OFFSET $6::int
+`
+
+type ListReviewsAdminParams struct {
+	Status    sql.NullString `json:"status"`
+	ProductID uuid.NullUUID  `json:"product_id"`
+	Rating    sql.NullInt32  `json:"rating"`
+	Reported  bool           `json:"reported"`
+	Limit     int32          `json:"limit"`
+	Offset    int32          `json:"offset"`
+}
+
+type ListReviewsAdminRow struct {
+	ID                 uuid.UUID      `json:"id"`
+	UserID             uuid.UUID      `json:"user_id"`
+	ProductID          uuid.UUID      `json:"product_id"`
+	OrderID            uuid.UUID      `json:"order_id"`
+	Rating             int32          `json:"rating"`
+	Comment            string         `json:"comment"`
+	IsVerifiedPurchase bool           `json:"is_verified_purchase"`
+	CreatedAt          time.Time      `json:"created_at"`
+	UpdatedAt          time.Time      `json:"updated_at"`
+	DeletedAt          sql.NullTime   `json:"deleted_at"`
+	Status             string         `json:"status"`
+	ModerationNote     sql.NullString `json:"moderation_note"`
+	ModeratedBy        uuid.NullUUID  `json:"moderated_by"`
+	ModeratedAt        sql.NullTime   `json:"moderated_at"`
+	HelpfulCount       int32          `json:"helpful_count"`
+	NotHelpfulCount    int32          `json:"not_helpful_count"`
+	ReportCount        int32          `json:"report_count"`
+	UserName           string         `json:"user_name"`
+	UserEmail          string         `json:"user_email"`
+	ProductName        string         `json:"product_name"`
+	ProductSlug        string         `json:"product_slug"`
+	PhotoUrls          []string       `json:"photo_urls"`
+	ReplyBody          sql.NullString `json:"reply_body"`
+	ReplyCreatedAt     sql.NullTime   `json:"reply_created_at"`
+	ReplyUpdatedAt     sql.NullTime   `json:"reply_updated_at"`
+}
+
+// Antrian moderasi: filter opsional status / produk / rating, terlama dulu.
+// reported=true: hanya review dengan laporan terbuka, laporan terbanyak dulu
+func (q *Queries) ListReviewsAdmin(ctx context.Context, arg ListReviewsAdminParams) ([]ListReviewsAdminRow, error) {
+	rows, err := q.query(ctx, q.listReviewsAdminStmt, listReviewsAdmin,
+		arg.Status,
+		arg.ProductID,
+		arg.Rating,
+		arg.Reported,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListReviewsAdminRow
+	for rows.Next() {
+		var i ListReviewsAdminRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.ProductID,
+			&i.OrderID,
+			&i.Rating,
+			&i.Comment,
+			&i.IsVerifiedPurchase,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.Status,
+			&i.ModerationNote,
+			&i.ModeratedBy,
+			&i.ModeratedAt,
+			&i.HelpfulCount,
+			&i.NotHelpfulCount,
+			&i.ReportCount,
+			&i.UserName,
+			&i.UserEmail,
 			&i.ProductName,
 			&i.ProductSlug,
+			pq.Array(&i.PhotoUrls),
+			&i.ReplyBody,
+			&i.ReplyCreatedAt,
+			&i.ReplyUpdatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -340,23 +971,212 @@ func (q *Queries) GetReviewsByUserID(ctx context.Context, arg GetReviewsByUserID
 	return items, nil
 }
 
+const lockProductForRating = `-- name: LockProductForRating :exec
+SELECT id FROM products WHERE id = $1 FOR UPDATE
+`
+
+// Serialisasi recompute agregat per produk (dipanggil dalam transaksi sebelum RecomputeProductRating)
+func (q *Queries) LockProductForRating(ctx context.Context, id uuid.UUID) error {
+	_, err := q.exec(ctx, q.lockProductForRatingStmt, lockProductForRating, id)
+	return err
+}
+
+const recomputeAllProductRatings = `-- name: RecomputeAllProductRatings :execrows
+UPDATE products p
+SET rating_avg = COALESCE(agg.rating_avg, 0),
+    rating_count = COALESCE(agg.rating_count, 0),
+    rating_1_count = COALESCE(agg.r1, 0),
+    rating_2_count = COALESCE(agg.r2, 0),
+    rating_3_count = COALESCE(agg.r3, 0),
+    rating_4_count = COALESCE(agg.r4, 0),
+    rating_5_count = COALESCE(agg.r5, 0)
+FROM products p2
+LEFT JOIN (
+    SELECT r.product_id,
+        ROUND(AVG(r.rating)::numeric, 2)::float8 AS rating_avg,
+        COUNT(*)::int AS rating_count,
+        (COUNT(*) FILTER (WHERE r.rating = 1))::int AS r1,
+        (COUNT(*) FILTER (WHERE r.rating = 2))::int AS r2,
+        (COUNT(*) FILTER (WHERE r.rating = 3))::int AS r3,
+        (COUNT(*) FILTER (WHERE r.rating = 4))::int AS r4,
+        (COUNT(*) FILTER (WHERE r.rating = 5))::int AS r5
+    FROM reviews r
+    WHERE r.deleted_at IS NULL AND r.status = 'APPROVED'
+    GROUP BY r.product_id
+) agg ON agg.product_id = p2.id
+WHERE p.id = p2.id
+`
+
+// Backfill: hitung ulang agregat semua produk (produk tanpa review -> 0)
+func (q *Queries) RecomputeAllProductRatings(ctx context.Context) (int64, error) {
+	result, err := q.exec(ctx, q.recomputeAllProductRatingsStmt, recomputeAllProductRatings)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const recomputeProductRating = `-- name: RecomputeProductRating :exec
+UPDATE products p
+SET rating_avg = agg.rating_avg,
+    rating_count = agg.rating_count,
+    rating_1_count = agg.r1,
+    rating_2_count = agg.r2,
+    rating_3_count = agg.r3,
+    rating_4_count = agg.r4,
+    rating_5_count = agg.r5
+FROM (
+    SELECT
+        COALESCE(ROUND(AVG(r.rating)::numeric, 2), 0)::float8 AS rating_avg,
+        COUNT(*)::int AS rating_count,
+        (COUNT(*) FILTER (WHERE r.rating = 1))::int AS r1,
+        (COUNT(*) FILTER (WHERE r.rating = 2))::int AS r2,
+        (COUNT(*) FILTER (WHERE r.rating = 3))::int AS r3,
+        (COUNT(*) FILTER (WHERE r.rating = 4))::int AS r4,
+        (COUNT(*) FILTER (WHERE r.rating = 5))::int AS r5
+    FROM reviews r
+    WHERE r.product_id = $1 AND r.deleted_at IS NULL AND r.status = 'APPROVED'
+) agg
+WHERE p.id = $1
+`
+
+// Hitung ulang agregat rating produk dari review APPROVED yang belum dihapus
+func (q *Queries) RecomputeProductRating(ctx context.Context, productID uuid.UUID) error {
+	_, err := q.exec(ctx, q.recomputeProductRatingStmt, recomputeProductRating, productID)
+	return err
+}
+
+const recomputeReviewVotes = `-- name: RecomputeReviewVotes :one
+UPDATE reviews r
+SET helpful_count = (SELECT COUNT(*) FROM review_votes v WHERE v.review_id = r.id AND v.helpful),
+    not_helpful_count = (SELECT COUNT(*) FROM review_votes v WHERE v.review_id = r.id AND NOT v.helpful)
+WHERE r.id = $1
+RETURNING r.helpful_count, r.not_helpful_count
+`
+
+type RecomputeReviewVotesRow struct {
+	HelpfulCount    int32 `json:"helpful_count"`
+	NotHelpfulCount int32 `json:"not_helpful_count"`
+}
+
+// Hitung ulang counter dari tabel vote (update baris review sekaligus mengunci vote bersamaan)
+func (q *Queries) RecomputeReviewVotes(ctx context.Context, id uuid.UUID) (RecomputeReviewVotesRow, error) {
+	row := q.queryRow(ctx, q.recomputeReviewVotesStmt, recomputeReviewVotes, id)
+	var i RecomputeReviewVotesRow
+	err := row.Scan(
+		&i.HelpfulCount,
+		&i.NotHelpfulCount,
+	)
+	return i, err
+}
+
+const resolveReviewReports = `-- name: ResolveReviewReports :exec
+WITH resolved AS (
+    UPDATE review_reports
+    SET resolved_at = NOW()
+    WHERE review_id = $1 AND resolved_at IS NULL
+)
+UPDATE reviews
+SET report_count = 0
+WHERE id = $1
+`
+
+// Dipanggil saat admin memoderasi review: laporan terbuka ditutup & counter direset
+func (q *Queries) ResolveReviewReports(ctx context.Context, reviewID uuid.UUID) error {
+	_, err := q.exec(ctx, q.resolveReviewReportsStmt, resolveReviewReports, reviewID)
+	return err
+}
+
+const setReviewStatus = `-- name: SetReviewStatus :one
+UPDATE reviews
+SET status = $1,
+    moderation_note = $2,
+    moderated_by = $3,
+    moderated_at = NOW()
+WHERE id = $4
+  AND deleted_at IS NULL
+  AND status = ANY($5::text[])
+RETURNING id, user_id, product_id, order_id, rating, comment, is_verified_purchase, created_at, updated_at, deleted_at, status, moderation_note, moderated_by, moderated_at, helpful_count, not_helpful_count, report_count
+`
+
+type SetReviewStatusParams struct {
+	Status         string         `json:"status"`
+	ModerationNote sql.NullString `json:"moderation_note"`
+	ModeratedBy    uuid.NullUUID  `json:"moderated_by"`
+	ID             uuid.UUID      `json:"id"`
+	FromStatuses   []string       `json:"from_statuses"`
+}
+
+// Guard transisi: hanya berubah jika status saat ini termasuk from_statuses
+func (q *Queries) SetReviewStatus(ctx context.Context, arg SetReviewStatusParams) (Review, error) {
+	row := q.queryRow(ctx, q.setReviewStatusStmt, setReviewStatus,
+		arg.Status,
+		arg.ModerationNote,
+		arg.ModeratedBy,
+		arg.ID,
+		pq.Array(arg.FromStatuses),
+	)
+	var i Review
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.ProductID,
+		&i.OrderID,
+		&i.Rating,
+		&i.Comment,
+		&i.IsVerifiedPurchase,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Status,
+		&i.ModerationNote,
+		&i.ModeratedBy,
+		&i.ModeratedAt,
+		&i.HelpfulCount,
+		&i.NotHelpfulCount,
+		&i.ReportCount,
+	)
+	return i, err
+}
+
 const updateReview = `-- name: UpdateReview :one
 UPDATE reviews
-SET rating = $2,
-    comment = $3,
+SET rating = $1,
+    comment = $2,
+    status = $3,
+    moderation_note = $4,
+    moderated_by = CASE WHEN $5::boolean THEN NULL ELSE moderated_by END,
+    moderated_at = CASE WHEN $5::boolean THEN NULL ELSE moderated_at END,
     updated_at = NOW()
-WHERE id = $1 AND deleted_at IS NULL
-RETURNING id, user_id, product_id, order_id, rating, comment, is_verified_purchase, created_at, updated_at, deleted_at
+WHERE id = $6
+  AND deleted_at IS NULL
+  AND status = $7::text
+RETURNING id, user_id, product_id, order_id, rating, comment, is_verified_purchase, created_at, updated_at, deleted_at, status, moderation_note, moderated_by, moderated_at, helpful_count, not_helpful_count, report_count
 `
 
 type UpdateReviewParams struct {
-	ID      uuid.UUID `json:"id"`
-	Rating  int32     `json:"rating"`
-	Comment string    `json:"comment"`
+	Rating          int32          `json:"rating"`
+	Comment         string         `json:"comment"`
+	Status          string         `json:"status"`
+	ModerationNote  sql.NullString `json:"moderation_note"`
+	ResetModeration bool           `json:"reset_moderation"`
+	ID              uuid.UUID      `json:"id"`
+	FromStatus      string         `json:"from_status"`
 }
 
+// Edit oleh pemilik; status & catatan dihitung service dari status saat ini.
+// Data moderasi hanya dikosongkan jika review masuk antrian lagi (reset_moderation).
+// Guard from_status: status berubah sejak dibaca (mis. dilaporkan / dimoderasi) -> 0 row
 func (q *Queries) UpdateReview(ctx context.Context, arg UpdateReviewParams) (Review, error) {
-	row := q.queryRow(ctx, q.updateReviewStmt, updateReview, arg.ID, arg.Rating, arg.Comment)
+	row := q.queryRow(ctx, q.updateReviewStmt, updateReview,
+		arg.Rating,
+		arg.Comment,
+		arg.Status,
+		arg.ModerationNote,
+		arg.ResetModeration,
+		arg.ID,
+		arg.FromStatus,
+	)
 	var i Review
 	err := row.Scan(
 		&i.ID,
@@ -369,6 +1189,61 @@ func (q *Queries) UpdateReview(ctx context.Context, arg UpdateReviewParams) (Rev
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Status,
+		&i.ModerationNote,
+		&i.ModeratedBy,
+		&i.ModeratedAt,
+		&i.HelpfulCount,
+		&i.NotHelpfulCount,
+		&i.ReportCount,
 	)
 	return i, err
 }
+
+const updateReviewReply = `-- name: UpdateReviewReply :one
+UPDATE review_replies
+SET body = $2,
+    admin_id = $3,
+    updated_at = NOW()
+WHERE review_id = $1
+RETURNING id, review_id, admin_id, body, created_at, updated_at
+`
+
+type UpdateReviewReplyParams struct {
+	ReviewID uuid.UUID     `json:"review_id"`
+	Body     string        `json:"body"`
+	AdminID  uuid.NullUUID `json:"admin_id"`
+}
+
+func (q *Queries) UpdateReviewReply(ctx context.Context, arg UpdateReviewReplyParams) (ReviewReply, error) {
+	row := q.queryRow(ctx, q.updateReviewReplyStmt, updateReviewReply, arg.ReviewID, arg.Body, arg.AdminID)
+	var i ReviewReply
+	err := row.Scan(
+		&i.ID,
+		&i.ReviewID,
+		&i.AdminID,
+		&i.Body,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertReviewVote = `-- name: UpsertReviewVote :exec
+INSERT INTO review_votes (review_id, user_id, helpful)
+VALUES ($1, $2, $3)
+ON CONFLICT (review_id, user_id)
+DO UPDATE SET helpful = EXCLUDED.helpful, updated_at = NOW()
+`
+
+type UpsertReviewVoteParams struct {
+	ReviewID uuid.UUID `json:"review_id"`
+	UserID   uuid.UUID `json:"user_id"`
+	Helpful  bool      `json:"helpful"`
+}
+
+// Satu vote per user per review; vote ulang mengganti pilihan sebelumnya
+func (q *Queries) UpsertReviewVote(ctx context.Context, arg UpsertReviewVoteParams) error {
+	_, err := q.exec(ctx, q.upsertReviewVoteStmt, upsertReviewVote, arg.ReviewID, arg.UserID, arg.Helpful)
+	return err
+}
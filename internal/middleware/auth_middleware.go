@@ -44,6 +44,36 @@ func AuthMiddleware() gin.HandlerFunc {
 
 		claims, _ := token.Claims.(jwt.MapClaims)
 		c.Set("role", claims["role"])
+		if userID, ok := claims["user_id"].(string); ok {
+			c.Set("user_id", userID)
+		}
+		c.Next()
+	}
+}
+
+// OptionalAuthMiddleware untuk route yang boleh diakses guest (mis. cart):
+// token valid -> user_id & role di-set, tanpa token / token tidak valid -> lanjut sebagai guest.
+func OptionalAuthMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		tokenString, err := c.Cookie("access_token")
+		if err != nil {
+			c.Next()
+			return
+		}
+
+		token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+				return nil, fmt.Errorf("unexpected signing method")
+			}
+			return []byte(os.Getenv("JWT_SECRET")), nil
+		})
+		if err == nil && token.Valid {
+			claims, _ := token.Claims.(jwt.MapClaims)
+			c.Set("role", claims["role"])
+			if userID, ok := claims["user_id"].(string); ok {
+				c.Set("user_id", userID)
+			}
+		}
 		c.Next()
 	}
 }
@@ -0,0 +1,29 @@
+package constants
+
+import "time"
+
+// Cart guest: token dikirim lewat cookie (web) atau header (mobile)
+const (
+	CartTokenCookie = "cart_token"
+	CartTokenHeader = "X-Cart-Token"
+
+	GuestCartDefaultTTL = 30 * 24 * time.Hour
+
+	// Batas qty per produk dalam satu cart (selain stok)
+	CartMaxItemQty = 99
+)
+
+// Warning per item di detail cart (ditampilkan storefront sebelum checkout)
+const (
+	CartWarningProductRemoved    = "PRODUCT_REMOVED"
+	CartWarningProductInactive   = "PRODUCT_INACTIVE"
+	CartWarningOutOfStock        = "OUT_OF_STOCK"
+	CartWarningInsufficientStock = "INSUFFICIENT_STOCK"
+	CartWarningPriceChanged      = "PRICE_CHANGED"
+)
+
+// Pengingat cart terbengkalai (default jika env kosong)
+const (
+	CartReminderDefaultIdle = 24 * time.Hour
+	CartReminderDefaultMax  = 2
+)
@@ -5,4 +5,6 @@ const (
 	CloudinaryBrandFolder    = CloudinaryBaseFolder + "/brands"
 	CloudinaryProductFolder  = CloudinaryBaseFolder + "/products"
 	CloudinaryCategoryFolder = CloudinaryBaseFolder + "/categories"
+	CloudinaryReturnFolder   = CloudinaryBaseFolder + "/returns"
+	CloudinaryReviewFolder   = CloudinaryBaseFolder + "/reviews"
 )
@@ -0,0 +1,20 @@
+package constants
+
+// Nilai product_subscriptions.type
+const (
+	SubscriptionBackInStock = "BACK_IN_STOCK"
+	SubscriptionPriceDrop   = "PRICE_DROP"
+)
+
+// Nilai notifications.status
+const (
+	NotificationStatusPending = "PENDING"
+	NotificationStatusSent    = "SENT"
+	NotificationStatusFailed  = "FAILED"
+
+	// Gagal kirim sebanyak ini -> FAILED, tidak dicoba lagi
+	NotificationMaxAttempts = 5
+)
+
+// Nilai notifications.type di luar langganan produk
+const NotificationCartReminder = "CART_REMINDER"
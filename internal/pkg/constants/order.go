@@ -0,0 +1,11 @@
+package constants
+
+// Nilai orders.cancel_reason untuk pembatalan otomatis
+const (
+	OrderCancelReasonExpired = "PAYMENT_EXPIRED"
+)
+
+// Retur (RMA)
+const (
+	ReturnMaxPhotos = 5
+)
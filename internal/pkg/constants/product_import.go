@@ -0,0 +1,11 @@
+package constants
+
+// Bulk import / export produk
+const (
+	ProductImportDefaultBatchSize = 500
+	ProductImportMaxBatchSize     = 1000
+	ProductImportMaxRows          = 5000
+	ProductImportMaxFileSize      = 10 << 20 // 10 MB
+
+	ProductExportPageSize = 500
+)
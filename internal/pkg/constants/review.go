@@ -0,0 +1,23 @@
+package constants
+
+// Nilai reviews.status
+const (
+	ReviewStatusPending  = "PENDING"
+	ReviewStatusApproved = "APPROVED"
+	ReviewStatusRejected = "REJECTED"
+	ReviewStatusHidden   = "HIDDEN"
+)
+
+// Maksimal foto per review
+const ReviewMaxPhotos = 5
+
+// Jumlah laporan user sebelum review APPROVED otomatis dikembalikan ke antrian moderasi
+const ReviewReportThreshold = 3
+
+// Sort listing review publik
+const (
+	ReviewSortNewest     = "newest"
+	ReviewSortHelpful    = "helpful"
+	ReviewSortRatingHigh = "rating_high"
+	ReviewSortRatingLow  = "rating_low"
+)
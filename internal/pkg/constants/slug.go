@@ -0,0 +1,8 @@
+package constants
+
+// Nilai slug_redirects.entity_type
+const (
+	SlugEntityProduct  = "product"
+	SlugEntityCategory = "category"
+	SlugEntityBrand    = "brand"
+)
@@ -0,0 +1,11 @@
+package constants
+
+// Nilai stock_movements.reason
+const (
+	StockReasonInitial    = "INITIAL"
+	StockReasonPurchase   = "PURCHASE"
+	StockReasonCancel     = "CANCEL"
+	StockReasonRestock    = "RESTOCK"
+	StockReasonAdjustment = "ADJUSTMENT"
+	StockReasonReturn     = "RETURN"
+)
@@ -0,0 +1,7 @@
+package constants
+
+// Kode kelas pajak bawaan (seed migration 000021)
+const (
+	TaxClassStandard = "STANDARD" // PPN
+	TaxClassExempt   = "EXEMPT"
+)
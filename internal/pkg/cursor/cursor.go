@@ -0,0 +1,109 @@
+package cursor
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"go-sqlc-starter/internal/pkg/apperror"
+	"go-sqlc-starter/internal/pkg/signing"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// Cursor menyimpan posisi keyset (nilai sort key + id) yang dikirim ke client
+// sebagai token opaque. Token ditandatangani HMAC agar tidak bisa dimanipulasi.
+type Cursor struct {
+	Sort     string   `json:"s"`           // mode sort saat cursor dibuat, harus sama dengan request
+	Values   []string `json:"v"`           // nilai sort key berurutan, id selalu terakhir
+	Backward bool     `json:"b,omitempty"` // true = cursor untuk halaman sebelumnya
+}
+
+// Page berisi token halaman berikut / sebelumnya (kosong jika tidak ada)
+type Page struct {
+	Next string
+	Prev string
+}
+
+var ErrInvalidCursor = apperror.New(
+	apperror.CodeInvalidInput,
+	"Invalid cursor",
+	http.StatusBadRequest,
+)
+
+func sign(payload string) string {
+	mac := hmac.New(sha256.New, signing.Key(signing.CursorSecretEnv))
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// Encode mengubah cursor menjadi token "<payload>.<signature>"
+func Encode(c Cursor) string {
+	raw, _ := json.Marshal(c)
+	payload := base64.RawURLEncoding.EncodeToString(raw)
+	return payload + "." + sign(payload)
+}
+
+// Decode memvalidasi signature lalu membaca isi token
+func Decode(token string) (Cursor, error) {
+	payload, sig, ok := strings.Cut(token, ".")
+	if !ok || !hmac.Equal([]byte(sig), []byte(sign(payload))) {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	var c Cursor
+	if err := json.Unmarshal(raw, &c); err != nil || len(c.Values) == 0 {
+		return Cursor{}, ErrInvalidCursor
+	}
+	return c, nil
+}
+
+// Parse decode token (boleh kosong = halaman pertama) dan memastikan
+// cursor dibuat untuk mode sort yang sama serta jumlah nilai sesuai.
+func Parse(token, sort string, size int) (*Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	c, err := Decode(token)
+	if err != nil {
+		return nil, err
+	}
+	if c.Sort != sort || len(c.Values) != size {
+		return nil, ErrInvalidCursor
+	}
+	return &c, nil
+}
+
+// Window memproses hasil query keyset yang diambil dengan LIMIT limit+1:
+// memotong baris ekstra, membalik urutan untuk halaman sebelumnya,
+// lalu membuat token next/prev dari baris pertama & terakhir.
+func Window[T any](rows []T, limit int, cur *Cursor, sort string, key func(T) []string) ([]T, Page) {
+	hasMore := len(rows) > limit
+	if hasMore {
+		rows = rows[:limit]
+	}
+
+	backward := cur != nil && cur.Backward
+	if backward {
+		slices.Reverse(rows)
+	}
+
+	var page Page
+	if len(rows) == 0 {
+		return rows, page
+	}
+
+	if hasMore || backward {
+		page.Next = Encode(Cursor{Sort: sort, Values: key(rows[len(rows)-1])})
+	}
+	if (cur != nil && !backward) || (backward && hasMore) {
+		page.Prev = Encode(Cursor{Sort: sort, Values: key(rows[0]), Backward: true})
+	}
+	return rows, page
+}
@@ -0,0 +1,22 @@
+package httpx
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+type CursorResult struct {
+	Enabled bool
+	Cursor  string
+}
+
+// ParseCursor mendukung:
+// - ?cursor=<token>         -> halaman berikut / sebelumnya (keyset)
+// - ?pagination=cursor      -> halaman pertama dalam mode keyset
+// Tanpa keduanya, endpoint tetap memakai page/limit (offset).
+func ParseCursor(c *gin.Context) CursorResult {
+	token := c.Query("cursor")
+	return CursorResult{
+		Enabled: token != "" || c.Query("pagination") == "cursor",
+		Cursor:  token,
+	}
+}
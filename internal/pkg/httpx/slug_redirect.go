@@ -0,0 +1,18 @@
+package httpx
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RedirectSlug mengirim 301 ke URL yang sama dengan slug lama diganti slug terbaru,
+// query string tetap dipertahankan. Contoh:
+// /api/v1/products/slug/iphone-lama?x=1 -> /api/v1/products/slug/iphone-baru?x=1
+func RedirectSlug(c *gin.Context, oldSlug, newSlug string) {
+	u := *c.Request.URL
+	u.Path = strings.TrimSuffix(u.Path, oldSlug) + newSlug
+	u.RawPath = ""
+	c.Redirect(http.StatusMovedPermanently, u.RequestURI())
+}
@@ -0,0 +1,52 @@
+package moderation
+
+import (
+	"os"
+	"strings"
+	"unicode"
+)
+
+// Filter pemeriksa konten teks buatan user (review, balasan, dst).
+// Implementasi bisa diganti (daftar kata, layanan eksternal) tanpa mengubah pemakai.
+type Filter interface {
+	// Check mengembalikan kata terlarang pertama yang ditemukan; ok=false jika konten bersih
+	Check(text string) (word string, ok bool)
+}
+
+// WordListFilter filter sederhana berbasis daftar kata terlarang (case-insensitive, per kata utuh)
+type WordListFilter struct {
+	words map[string]struct{}
+}
+
+func NewWordListFilter(words []string) *WordListFilter {
+	f := &WordListFilter{words: make(map[string]struct{}, len(words))}
+	for _, w := range words {
+		w = strings.ToLower(strings.TrimSpace(w))
+		if w != "" {
+			f.words[w] = struct{}{}
+		}
+	}
+	return f
+}
+
+// NewWordListFilterFromEnv baca REVIEW_BLOCKED_WORDS (dipisah koma)
+func NewWordListFilterFromEnv() *WordListFilter {
+	return NewWordListFilter(strings.Split(os.Getenv("REVIEW_BLOCKED_WORDS"), ","))
+}
+
+func (f *WordListFilter) Check(text string) (string, bool) {
+	if len(f.words) == 0 {
+		return "", false
+	}
+
+	// Pecah per kata supaya "class" tidak kena filter "ass"
+	tokens := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+	for _, t := range tokens {
+		if _, blocked := f.words[t]; blocked {
+			return t, true
+		}
+	}
+	return "", false
+}
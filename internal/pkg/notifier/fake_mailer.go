@@ -0,0 +1,45 @@
+package notifier
+
+import (
+	"context"
+	"sync"
+)
+
+// SentMail email yang "terkirim" lewat FakeMailer
+type SentMail struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// FakeMailer mailer in-memory untuk test; Err diisi untuk simulasi gagal kirim
+type FakeMailer struct {
+	mu   sync.Mutex
+	Sent []SentMail
+	Err  error
+}
+
+func NewFakeMailer() *FakeMailer {
+	return &FakeMailer{}
+}
+
+func (m *FakeMailer) Send(ctx context.Context, to, subject, body string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.Err != nil {
+		return m.Err
+	}
+	m.Sent = append(m.Sent, SentMail{To: to, Subject: subject, Body: body})
+	return nil
+}
+
+// Outbox salinan email yang sudah terkirim
+func (m *FakeMailer) Outbox() []SentMail {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	out := make([]SentMail, len(m.Sent))
+	copy(out, m.Sent)
+	return out
+}
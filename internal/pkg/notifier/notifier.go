@@ -0,0 +1,43 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+)
+
+// Channel pengiriman notifikasi (kolom notifications.channel)
+const ChannelEmail = "EMAIL"
+
+// Message satu notifikasi siap kirim
+type Message struct {
+	Channel   string
+	Recipient string
+	Subject   string
+	Body      string
+}
+
+// Notifier pengirim notifikasi; implementasi dipilih di main (email, push, dst)
+type Notifier interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// Mailer pengirim email mentah (SMTP, provider API, atau fake untuk test)
+type Mailer interface {
+	Send(ctx context.Context, to, subject, body string) error
+}
+
+// EmailNotifier kirim notifikasi channel EMAIL lewat Mailer
+type EmailNotifier struct {
+	mailer Mailer
+}
+
+func NewEmailNotifier(m Mailer) *EmailNotifier {
+	return &EmailNotifier{mailer: m}
+}
+
+func (n *EmailNotifier) Send(ctx context.Context, msg Message) error {
+	if msg.Channel != ChannelEmail {
+		return fmt.Errorf("unsupported notification channel: %s", msg.Channel)
+	}
+	return n.mailer.Send(ctx, msg.Recipient, msg.Subject, msg.Body)
+}
@@ -5,10 +5,12 @@ import (
 )
 
 type PaginationMeta struct {
-	Total      int64 `json:"total,omitempty"`
-	TotalPages int   `json:"totalPages,omitempty"`
-	Page       int   `json:"page,omitempty"`
-	PageSize   int   `json:"pageSize,omitempty"`
+	Total      int64  `json:"total,omitempty"`
+	TotalPages int    `json:"totalPages,omitempty"`
+	Page       int    `json:"page,omitempty"`
+	PageSize   int    `json:"pageSize,omitempty"`
+	NextCursor string `json:"nextCursor,omitempty"`
+	PrevCursor string `json:"prevCursor,omitempty"`
 }
 
 type ApiEnvelope struct {
@@ -0,0 +1,46 @@
+package signing
+
+import (
+	"fmt"
+	"os"
+)
+
+// Env secret HMAC per jenis token; jika kosong memakai JWT_SECRET
+const (
+	CursorSecretEnv       = "CURSOR_SECRET"
+	CartTokenSecretEnv    = "CART_TOKEN_SECRET"
+	CartReminderSecretEnv = "CART_REMINDER_SECRET"
+
+	fallbackEnv = "JWT_SECRET"
+)
+
+// Lookup secret dari env (fallback JWT_SECRET). Key kosong ditolak:
+// HMAC dengan key kosong bisa dibuat ulang siapa saja.
+func Lookup(env string) ([]byte, error) {
+	if key := os.Getenv(env); key != "" {
+		return []byte(key), nil
+	}
+	if key := os.Getenv(fallbackEnv); key != "" {
+		return []byte(key), nil
+	}
+	return nil, fmt.Errorf("signing key is empty: set %s or %s", env, fallbackEnv)
+}
+
+// Key seperti Lookup, panic jika kosong. Validate saat startup memastikan ini tidak terjadi.
+func Key(env string) []byte {
+	key, err := Lookup(env)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+// Validate dipanggil saat startup agar server tidak jalan tanpa secret token
+func Validate() error {
+	for _, env := range []string{CursorSecretEnv, CartTokenSecretEnv, CartReminderSecretEnv} {
+		if _, err := Lookup(env); err != nil {
+			return err
+		}
+	}
+	return nil
+}
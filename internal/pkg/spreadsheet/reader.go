@@ -0,0 +1,159 @@
+package spreadsheet
+
+import (
+	"archive/zip"
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported spreadsheet format")
+	ErrEmptySheet        = errors.New("spreadsheet has no sheet")
+)
+
+// Read membaca file CSV / XLSX menjadi baris-baris string.
+// Format ditentukan dari ekstensi filename.
+func Read(r io.ReaderAt, size int64, filename string) ([][]string, error) {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".csv":
+		return ReadCSV(io.NewSectionReader(r, 0, size))
+	case ".xlsx":
+		return ReadXLSX(r, size)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+func ReadCSV(r io.Reader) ([][]string, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1 // jumlah kolom boleh beda, divalidasi di caller
+	reader.TrimLeadingSpace = true
+	return reader.ReadAll()
+}
+
+// --- XLSX (minimal: sheet pertama, shared & inline string) ---
+
+type xlsxSharedStrings struct {
+	Items []xlsxRichText `xml:"si"`
+}
+
+type xlsxRichText struct {
+	Text string         `xml:"t"`
+	Runs []xlsxTextRuns `xml:"r"`
+}
+
+type xlsxTextRuns struct {
+	Text string `xml:"t"`
+}
+
+func (t xlsxRichText) String() string {
+	if len(t.Runs) == 0 {
+		return t.Text
+	}
+	var b strings.Builder
+	for _, r := range t.Runs {
+		b.WriteString(r.Text)
+	}
+	return b.String()
+}
+
+type xlsxWorksheet struct {
+	Rows []struct {
+		Cells []struct {
+			Ref    string       `xml:"r,attr"`
+			Type   string       `xml:"t,attr"`
+			Value  string       `xml:"v"`
+			Inline xlsxRichText `xml:"is"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+func ReadXLSX(r io.ReaderAt, size int64) ([][]string, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. Shared strings (opsional)
+	var shared xlsxSharedStrings
+	if f := findZipFile(zr, "xl/sharedStrings.xml"); f != nil {
+		if err := decodeZipXML(f, &shared); err != nil {
+			return nil, err
+		}
+	}
+
+	// 2. Sheet pertama
+	sheet := findZipFile(zr, "xl/worksheets/sheet1.xml")
+	if sheet == nil {
+		return nil, ErrEmptySheet
+	}
+	var ws xlsxWorksheet
+	if err := decodeZipXML(sheet, &ws); err != nil {
+		return nil, err
+	}
+
+	// 3. Susun cell sesuai posisi kolom (cell kosong tidak ditulis di XLSX)
+	rows := make([][]string, 0, len(ws.Rows))
+	for _, row := range ws.Rows {
+		var out []string
+		for i, cell := range row.Cells {
+			col := i
+			if idx := columnIndex(cell.Ref); idx >= 0 {
+				col = idx
+			}
+			for len(out) <= col {
+				out = append(out, "")
+			}
+
+			switch cell.Type {
+			case "s":
+				idx, err := strconv.Atoi(cell.Value)
+				if err == nil && idx >= 0 && idx < len(shared.Items) {
+					out[col] = shared.Items[idx].String()
+				}
+			case "inlineStr":
+				out[col] = cell.Inline.String()
+			default:
+				out[col] = cell.Value
+			}
+		}
+		rows = append(rows, out)
+	}
+
+	return rows, nil
+}
+
+func findZipFile(zr *zip.Reader, name string) *zip.File {
+	for _, f := range zr.File {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+func decodeZipXML(f *zip.File, v interface{}) error {
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	return xml.NewDecoder(rc).Decode(v)
+}
+
+// columnIndex "C12" -> 2
+func columnIndex(ref string) int {
+	idx := 0
+	for _, ch := range ref {
+		if ch < 'A' || ch > 'Z' {
+			break
+		}
+		idx = idx*26 + int(ch-'A'+1)
+	}
+	return idx - 1
+}
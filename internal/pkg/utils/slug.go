@@ -2,13 +2,18 @@
 package utils
 
 import (
-	"log"
+	"context"
+	"fmt"
 	"regexp"
 	"strings"
+
+	"github.com/google/uuid"
 )
 
+// maxSlugAttempts: jumlah percobaan suffix angka sebelum memakai suffix acak
+const maxSlugAttempts = 5
+
 func GenerateSlug(s string) string {
-	log.Println("GEN SLUG")
 	// 1. Ubah ke lowercase
 	slug := strings.ToLower(s)
 
@@ -28,3 +33,27 @@ func GenerateSlug(s string) string {
 
 	return slug
 }
+
+// UniqueSlug membuat slug dari teks lalu mengecek ke database lewat exists.
+// Jika bentrok dicoba "slug-2", "slug-3", ... dan setelah maxSlugAttempts
+// memakai suffix acak pendek agar tidak looping terus.
+func UniqueSlug(ctx context.Context, text string, exists func(ctx context.Context, slug string) (bool, error)) (string, error) {
+	base := GenerateSlug(text)
+	if base == "" {
+		base = uuid.New().String()[:8]
+	}
+
+	candidate := base
+	for i := 1; i <= maxSlugAttempts; i++ {
+		taken, err := exists(ctx, candidate)
+		if err != nil {
+			return "", err
+		}
+		if !taken {
+			return candidate, nil
+		}
+		candidate = fmt.Sprintf("%s-%d", base, i+1)
+	}
+
+	return fmt.Sprintf("%s-%s", base, uuid.New().String()[:5]), nil
+}
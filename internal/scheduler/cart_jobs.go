@@ -0,0 +1,86 @@
+package scheduler
+
+import (
+	"context"
+	"go-sqlc-starter/internal/api/v1/cart"
+	"go-sqlc-starter/internal/api/v1/cartreminder"
+	"go-sqlc-starter/internal/bootstrap"
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	LockKeyGuestCartPurge int64 = 10_003
+	LockKeyCartReminder   int64 = 10_005
+)
+
+type CartJobConfig struct {
+	Interval         time.Duration // jeda antar run purge
+	ReminderInterval time.Duration // jeda antar run pengingat cart terbengkalai
+	BatchSize        int32
+}
+
+// LoadCartJobConfig baca konfigurasi dari env, fallback ke default
+func LoadCartJobConfig() CartJobConfig {
+	cfg := CartJobConfig{
+		Interval:         time.Hour,
+		ReminderInterval: 15 * time.Minute,
+		BatchSize:        100,
+	}
+
+	if d, err := time.ParseDuration(os.Getenv("GUEST_CART_PURGE_INTERVAL")); err == nil && d > 0 {
+		cfg.Interval = d
+	}
+	if d, err := time.ParseDuration(os.Getenv("CART_REMINDER_INTERVAL")); err == nil && d > 0 {
+		cfg.ReminderInterval = d
+	}
+	if n, err := strconv.Atoi(os.Getenv("SCHEDULER_BATCH_SIZE")); err == nil && n > 0 {
+		cfg.BatchSize = int32(n)
+	}
+	return cfg
+}
+
+// GuestCartPurgeJob hapus cart guest yang sudah melewati expires_at
+func GuestCartPurgeJob(svc cart.Service, audit bootstrap.AuditLogger, cfg CartJobConfig) Job {
+	return Job{
+		Name:     "guest-cart-purge",
+		Interval: cfg.Interval,
+		LockKey:  LockKeyGuestCartPurge,
+		Run: func(ctx context.Context) error {
+			purged, err := svc.PurgeExpiredGuests(ctx, time.Now(), cfg.BatchSize)
+			if purged > 0 {
+				audit.Log(ctx, bootstrap.AuditLog{
+					Action:  "GUEST_CARTS_PURGED",
+					Message: "Expired guest carts deleted",
+					Meta: map[string]any{
+						"count": purged,
+					},
+				})
+			}
+			return err
+		},
+	}
+}
+
+// CartReminderJob antre email pengingat untuk cart user yang terbengkalai
+func CartReminderJob(svc cartreminder.Service, audit bootstrap.AuditLogger, cfg CartJobConfig) Job {
+	return Job{
+		Name:     "cart-reminder",
+		Interval: cfg.ReminderInterval,
+		LockKey:  LockKeyCartReminder,
+		Run: func(ctx context.Context) error {
+			queued, err := svc.SendReminders(ctx, time.Now(), cfg.BatchSize)
+			if queued > 0 {
+				audit.Log(ctx, bootstrap.AuditLog{
+					Action:  "CART_REMINDERS_QUEUED",
+					Message: "Abandoned cart reminders queued",
+					Meta: map[string]any{
+						"count": queued,
+					},
+				})
+			}
+			return err
+		},
+	}
+}
@@ -0,0 +1,78 @@
+package scheduler_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cartMock "go-sqlc-starter/internal/api/v1/mock/cart"
+	cartreminderMock "go-sqlc-starter/internal/api/v1/mock/cartreminder"
+	"go-sqlc-starter/internal/scheduler"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuestCartPurgeJob(t *testing.T) {
+	ctx := context.Background()
+	cfg := scheduler.CartJobConfig{Interval: time.Hour, BatchSize: 20}
+
+	t.Run("success - purged carts audited", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		svc := cartMock.NewMockService(ctrl)
+		audit := &fakeAuditLogger{}
+
+		svc.EXPECT().
+			PurgeExpiredGuests(ctx, gomock.Any(), int32(20)).
+			DoAndReturn(func(_ context.Context, before time.Time, _ int32) (int64, error) {
+				assert.WithinDuration(t, time.Now(), before, time.Minute)
+				return 3, nil
+			})
+
+		job := scheduler.GuestCartPurgeJob(svc, audit, cfg)
+		err := job.Run(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, scheduler.LockKeyGuestCartPurge, job.LockKey)
+		assert.Len(t, audit.entries, 1)
+		assert.Equal(t, "GUEST_CARTS_PURGED", audit.entries[0].Action)
+		assert.Equal(t, int64(3), audit.entries[0].Meta["count"])
+	})
+
+	t.Run("nothing expired - no audit", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		svc := cartMock.NewMockService(ctrl)
+		audit := &fakeAuditLogger{}
+
+		svc.EXPECT().PurgeExpiredGuests(ctx, gomock.Any(), int32(20)).Return(int64(0), nil)
+
+		err := scheduler.GuestCartPurgeJob(svc, audit, cfg).Run(ctx)
+
+		assert.NoError(t, err)
+		assert.Empty(t, audit.entries)
+	})
+}
+
+func TestCartReminderJob(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	svc := cartreminderMock.NewMockService(ctrl)
+	audit := &fakeAuditLogger{}
+	cfg := scheduler.CartJobConfig{ReminderInterval: 15 * time.Minute, BatchSize: 20}
+
+	svc.EXPECT().
+		SendReminders(ctx, gomock.Any(), int32(20)).
+		DoAndReturn(func(_ context.Context, now time.Time, _ int32) (int, error) {
+			assert.WithinDuration(t, time.Now(), now, time.Minute)
+			return 2, nil
+		})
+
+	job := scheduler.CartReminderJob(svc, audit, cfg)
+	err := job.Run(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, scheduler.LockKeyCartReminder, job.LockKey)
+	assert.Equal(t, 15*time.Minute, job.Interval)
+	assert.Len(t, audit.entries, 1)
+	assert.Equal(t, "CART_REMINDERS_QUEUED", audit.entries[0].Action)
+}
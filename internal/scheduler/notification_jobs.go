@@ -0,0 +1,62 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"go-sqlc-starter/internal/api/v1/subscription"
+	"go-sqlc-starter/internal/bootstrap"
+	"os"
+	"strconv"
+	"time"
+)
+
+const LockKeyNotificationDelivery int64 = 10_004
+
+type NotificationJobConfig struct {
+	Interval  time.Duration // jeda antar run delivery
+	BatchSize int32
+}
+
+// LoadNotificationJobConfig baca konfigurasi dari env, fallback ke default
+func LoadNotificationJobConfig() NotificationJobConfig {
+	cfg := NotificationJobConfig{
+		Interval:  time.Minute,
+		BatchSize: 100,
+	}
+
+	if d, err := time.ParseDuration(os.Getenv("NOTIFICATION_DELIVERY_INTERVAL")); err == nil && d > 0 {
+		cfg.Interval = d
+	}
+	if n, err := strconv.Atoi(os.Getenv("SCHEDULER_BATCH_SIZE")); err == nil && n > 0 {
+		cfg.BatchSize = int32(n)
+	}
+	return cfg
+}
+
+// NotificationDeliveryJob antre harga turun dari jadwal harga yang baru mulai,
+// lalu kirim outbox notifikasi yang masih PENDING
+func NotificationDeliveryJob(svc subscription.Service, audit bootstrap.AuditLogger, cfg NotificationJobConfig) Job {
+	return Job{
+		Name:     "notification-delivery",
+		Interval: cfg.Interval,
+		LockKey:  LockKeyNotificationDelivery,
+		Run: func(ctx context.Context) error {
+			// 1. Sapu harga turun; gagal tetap lanjut kirim outbox yang sudah ada
+			_, sweepErr := svc.EnqueueDuePriceDrops(ctx)
+
+			// 2. Kirim
+			sent, failed, err := svc.DeliverPending(ctx, cfg.BatchSize)
+			if sent > 0 || failed > 0 {
+				audit.Log(ctx, bootstrap.AuditLog{
+					Action:  "NOTIFICATIONS_DELIVERED",
+					Message: "Pending notifications delivered",
+					Meta: map[string]any{
+						"sent":   sent,
+						"failed": failed,
+					},
+				})
+			}
+			return errors.Join(sweepErr, err)
+		},
+	}
+}
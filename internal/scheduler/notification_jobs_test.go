@@ -0,0 +1,55 @@
+package scheduler_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	subscriptionMock "go-sqlc-starter/internal/api/v1/mock/subscription"
+	"go-sqlc-starter/internal/scheduler"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationDeliveryJob(t *testing.T) {
+	ctx := context.Background()
+	cfg := scheduler.NotificationJobConfig{Interval: time.Minute, BatchSize: 25}
+
+	t.Run("success - sweep then deliver, audited", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		svc := subscriptionMock.NewMockService(ctrl)
+		audit := &fakeAuditLogger{}
+
+		gomock.InOrder(
+			svc.EXPECT().EnqueueDuePriceDrops(ctx).Return(1, nil),
+			svc.EXPECT().DeliverPending(ctx, int32(25)).Return(2, 1, nil),
+		)
+
+		job := scheduler.NotificationDeliveryJob(svc, audit, cfg)
+		err := job.Run(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, scheduler.LockKeyNotificationDelivery, job.LockKey)
+		assert.Len(t, audit.entries, 1)
+		assert.Equal(t, "NOTIFICATIONS_DELIVERED", audit.entries[0].Action)
+		assert.Equal(t, 2, audit.entries[0].Meta["sent"])
+		assert.Equal(t, 1, audit.entries[0].Meta["failed"])
+	})
+
+	t.Run("sweep failure - outbox still delivered", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		svc := subscriptionMock.NewMockService(ctrl)
+		audit := &fakeAuditLogger{}
+		sweepErr := errors.New("sweep failed")
+
+		svc.EXPECT().EnqueueDuePriceDrops(ctx).Return(0, sweepErr)
+		svc.EXPECT().DeliverPending(ctx, int32(25)).Return(0, 0, nil)
+
+		err := scheduler.NotificationDeliveryJob(svc, audit, cfg).Run(ctx)
+
+		assert.ErrorIs(t, err, sweepErr)
+		assert.Empty(t, audit.entries)
+	})
+}
@@ -0,0 +1,97 @@
+package scheduler
+
+import (
+	"context"
+	"go-sqlc-starter/internal/api/v1/order"
+	"go-sqlc-starter/internal/bootstrap"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Key advisory lock per job (harus unik di seluruh aplikasi)
+const (
+	LockKeyOrderExpiry       int64 = 10_001
+	LockKeyOrderAutoComplete int64 = 10_002
+)
+
+type OrderJobConfig struct {
+	Interval          time.Duration // jeda antar run
+	PendingTTL        time.Duration // batas waktu bayar order PENDING
+	AutoCompleteAfter time.Duration // jeda DELIVERED -> COMPLETED otomatis
+	BatchSize         int32
+}
+
+// LoadOrderJobConfig baca konfigurasi dari env, fallback ke default
+func LoadOrderJobConfig() OrderJobConfig {
+	cfg := OrderJobConfig{
+		Interval:          time.Minute,
+		PendingTTL:        24 * time.Hour,
+		AutoCompleteAfter: 7 * 24 * time.Hour,
+		BatchSize:         100,
+	}
+
+	if d, err := time.ParseDuration(os.Getenv("SCHEDULER_INTERVAL")); err == nil && d > 0 {
+		cfg.Interval = d
+	}
+	if d, err := time.ParseDuration(os.Getenv("ORDER_PENDING_TTL")); err == nil && d > 0 {
+		cfg.PendingTTL = d
+	}
+	if days, err := strconv.Atoi(os.Getenv("ORDER_AUTO_COMPLETE_DAYS")); err == nil && days > 0 {
+		cfg.AutoCompleteAfter = time.Duration(days) * 24 * time.Hour
+	}
+	if n, err := strconv.Atoi(os.Getenv("SCHEDULER_BATCH_SIZE")); err == nil && n > 0 {
+		cfg.BatchSize = int32(n)
+	}
+	return cfg
+}
+
+// OrderExpiryJob batalkan order PENDING yang belum dibayar melewati TTL (stok dikembalikan)
+func OrderExpiryJob(svc order.Service, audit bootstrap.AuditLogger, cfg OrderJobConfig) Job {
+	return Job{
+		Name:     "order-expiry",
+		Interval: cfg.Interval,
+		LockKey:  LockKeyOrderExpiry,
+		Run: func(ctx context.Context) error {
+			expired, err := svc.ExpireUnpaid(ctx, time.Now().Add(-cfg.PendingTTL), cfg.BatchSize)
+			// Order yang sudah berhasil tetap diaudit walau sebagian batch gagal
+			for _, o := range expired {
+				audit.Log(ctx, bootstrap.AuditLog{
+					Action:  "ORDER_EXPIRED",
+					Message: "Unpaid order cancelled automatically",
+					Meta: map[string]any{
+						"order_id":     o.ID,
+						"order_number": o.OrderNumber,
+						"placed_at":    o.PlacedAt,
+						"ttl":          cfg.PendingTTL.String(),
+					},
+				})
+			}
+			return err
+		},
+	}
+}
+
+// OrderAutoCompleteJob selesaikan order DELIVERED setelah N hari
+func OrderAutoCompleteJob(svc order.Service, audit bootstrap.AuditLogger, cfg OrderJobConfig) Job {
+	return Job{
+		Name:     "order-auto-complete",
+		Interval: cfg.Interval,
+		LockKey:  LockKeyOrderAutoComplete,
+		Run: func(ctx context.Context) error {
+			completed, err := svc.AutoComplete(ctx, time.Now().Add(-cfg.AutoCompleteAfter), cfg.BatchSize)
+			for _, o := range completed {
+				audit.Log(ctx, bootstrap.AuditLog{
+					Action:  "ORDER_AUTO_COMPLETED",
+					Message: "Delivered order completed automatically",
+					Meta: map[string]any{
+						"order_id":     o.ID,
+						"order_number": o.OrderNumber,
+						"after":        cfg.AutoCompleteAfter.String(),
+					},
+				})
+			}
+			return err
+		},
+	}
+}
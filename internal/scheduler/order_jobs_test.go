@@ -0,0 +1,95 @@
+package scheduler_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	orderMock "go-sqlc-starter/internal/api/v1/mock/order"
+	"go-sqlc-starter/internal/api/v1/order"
+	"go-sqlc-starter/internal/bootstrap"
+	"go-sqlc-starter/internal/scheduler"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeAuditLogger struct {
+	entries []bootstrap.AuditLog
+}
+
+func (f *fakeAuditLogger) Log(ctx context.Context, entry bootstrap.AuditLog) {
+	f.entries = append(f.entries, entry)
+}
+
+var jobCfg = scheduler.OrderJobConfig{
+	Interval:          time.Minute,
+	PendingTTL:        2 * time.Hour,
+	AutoCompleteAfter: 7 * 24 * time.Hour,
+	BatchSize:         50,
+}
+
+func TestOrderExpiryJob(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success - cutoff from TTL and audit per expired order", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		svc := orderMock.NewMockService(ctrl)
+		audit := &fakeAuditLogger{}
+
+		svc.EXPECT().
+			ExpireUnpaid(ctx, gomock.Any(), int32(50)).
+			DoAndReturn(func(_ context.Context, cutoff time.Time, _ int32) ([]order.OrderResponse, error) {
+				assert.WithinDuration(t, time.Now().Add(-2*time.Hour), cutoff, time.Minute)
+				return []order.OrderResponse{{ID: "o-1", OrderNumber: "ORD-1"}, {ID: "o-2", OrderNumber: "ORD-2"}}, nil
+			})
+
+		job := scheduler.OrderExpiryJob(svc, audit, jobCfg)
+		err := job.Run(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, scheduler.LockKeyOrderExpiry, job.LockKey)
+		assert.Len(t, audit.entries, 2)
+		assert.Equal(t, "ORDER_EXPIRED", audit.entries[0].Action)
+		assert.Equal(t, "ORD-1", audit.entries[0].Meta["order_number"])
+	})
+
+	t.Run("partial failure - processed orders still audited", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		svc := orderMock.NewMockService(ctrl)
+		audit := &fakeAuditLogger{}
+		batchErr := errors.New("expire order failed")
+
+		svc.EXPECT().
+			ExpireUnpaid(ctx, gomock.Any(), int32(50)).
+			Return([]order.OrderResponse{{ID: "o-1"}}, batchErr)
+
+		err := scheduler.OrderExpiryJob(svc, audit, jobCfg).Run(ctx)
+
+		assert.ErrorIs(t, err, batchErr)
+		assert.Len(t, audit.entries, 1)
+	})
+}
+
+func TestOrderAutoCompleteJob(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	svc := orderMock.NewMockService(ctrl)
+	audit := &fakeAuditLogger{}
+
+	svc.EXPECT().
+		AutoComplete(ctx, gomock.Any(), int32(50)).
+		DoAndReturn(func(_ context.Context, cutoff time.Time, _ int32) ([]order.OrderResponse, error) {
+			assert.WithinDuration(t, time.Now().Add(-7*24*time.Hour), cutoff, time.Minute)
+			return []order.OrderResponse{{ID: "o-1", OrderNumber: "ORD-1"}}, nil
+		})
+
+	job := scheduler.OrderAutoCompleteJob(svc, audit, jobCfg)
+	err := job.Run(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, scheduler.LockKeyOrderAutoComplete, job.LockKey)
+	assert.Len(t, audit.entries, 1)
+	assert.Equal(t, "ORDER_AUTO_COMPLETED", audit.entries[0].Action)
+}
@@ -0,0 +1,103 @@
+package scheduler
+
+import (
+	"context"
+	"database/sql"
+	"go-sqlc-starter/internal/dbgen"
+	"log"
+	"sync"
+	"time"
+)
+
+// Job dijalankan berkala oleh Scheduler.
+// LockKey harus unik per job: dipakai sebagai key advisory lock Postgres.
+type Job struct {
+	Name     string
+	Interval time.Duration
+	LockKey  int64
+	Run      func(ctx context.Context) error
+}
+
+// Locker menjalankan fn hanya jika lock didapat (leader election antar replika).
+// acquired = false berarti replika lain sedang menjalankan job yang sama.
+type Locker interface {
+	WithLock(ctx context.Context, key int64, fn func(ctx context.Context) error) (acquired bool, err error)
+}
+
+type advisoryLocker struct {
+	db *sql.DB
+}
+
+// NewAdvisoryLocker locker berbasis pg_try_advisory_lock
+func NewAdvisoryLocker(db *sql.DB) Locker {
+	return &advisoryLocker{db: db}
+}
+
+func (l *advisoryLocker) WithLock(ctx context.Context, key int64, fn func(ctx context.Context) error) (bool, error) {
+	// Advisory lock level session: lock & unlock wajib di koneksi yang sama
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Close()
+
+	q := dbgen.New(conn)
+	acquired, err := q.TryAdvisoryLock(ctx, key)
+	if err != nil || !acquired {
+		return false, err
+	}
+	// Unlock tetap jalan walau ctx sudah dibatalkan (shutdown)
+	defer q.AdvisoryUnlock(context.WithoutCancel(ctx), key)
+
+	return true, fn(ctx)
+}
+
+type Scheduler struct {
+	locker Locker
+	jobs   []Job
+	wg     sync.WaitGroup
+}
+
+func New(locker Locker, jobs ...Job) *Scheduler {
+	return &Scheduler{locker: locker, jobs: jobs}
+}
+
+// Start jalankan setiap job di goroutine sendiri sampai ctx dibatalkan
+func (s *Scheduler) Start(ctx context.Context) {
+	for _, job := range s.jobs {
+		s.wg.Add(1)
+		go func(job Job) {
+			defer s.wg.Done()
+			s.loop(ctx, job)
+		}(job)
+	}
+}
+
+// Wait tunggu semua job selesai setelah ctx dibatalkan
+func (s *Scheduler) Wait() {
+	s.wg.Wait()
+}
+
+func (s *Scheduler) loop(ctx context.Context, job Job) {
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	log.Printf("⏱️  Job %s scheduled every %s", job.Name, job.Interval)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.RunOnce(ctx, job)
+		}
+	}
+}
+
+// RunOnce jalankan job sekali jika replika ini memegang lock-nya
+func (s *Scheduler) RunOnce(ctx context.Context, job Job) bool {
+	acquired, err := s.locker.WithLock(ctx, job.LockKey, job.Run)
+	if err != nil {
+		log.Printf("❌ Job %s failed: %v", job.Name, err)
+	}
+	return acquired
+}
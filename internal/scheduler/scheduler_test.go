@@ -0,0 +1,76 @@
+package scheduler_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go-sqlc-starter/internal/scheduler"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdvisoryLocker_WithLock(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success - lock acquired, job run and lock released", func(t *testing.T) {
+		db, mock, _ := sqlmock.New()
+		defer db.Close()
+
+		mock.ExpectQuery("pg_try_advisory_lock").
+			WithArgs(int64(42)).
+			WillReturnRows(sqlmock.NewRows([]string{"acquired"}).AddRow(true))
+		mock.ExpectExec("pg_advisory_unlock").
+			WithArgs(int64(42)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		ran := false
+		acquired, err := scheduler.NewAdvisoryLocker(db).WithLock(ctx, 42, func(ctx context.Context) error {
+			ran = true
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.True(t, acquired)
+		assert.True(t, ran)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("skip - lock held by another replica", func(t *testing.T) {
+		db, mock, _ := sqlmock.New()
+		defer db.Close()
+
+		mock.ExpectQuery("pg_try_advisory_lock").
+			WithArgs(int64(42)).
+			WillReturnRows(sqlmock.NewRows([]string{"acquired"}).AddRow(false))
+
+		acquired, err := scheduler.NewAdvisoryLocker(db).WithLock(ctx, 42, func(ctx context.Context) error {
+			t.Fatal("job must not run without the lock")
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.False(t, acquired)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - job error returned, lock still released", func(t *testing.T) {
+		db, mock, _ := sqlmock.New()
+		defer db.Close()
+
+		mock.ExpectQuery("pg_try_advisory_lock").
+			WillReturnRows(sqlmock.NewRows([]string{"acquired"}).AddRow(true))
+		mock.ExpectExec("pg_advisory_unlock").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		jobErr := errors.New("boom")
+		acquired, err := scheduler.NewAdvisoryLocker(db).WithLock(ctx, 42, func(ctx context.Context) error {
+			return jobErr
+		})
+
+		assert.True(t, acquired)
+		assert.ErrorIs(t, err, jobErr)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}